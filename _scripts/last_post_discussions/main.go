@@ -106,7 +106,7 @@ func doWork(ctx context.Context, delphisBackend backend.DelphisBackend) {
 			LastPostCreatedAt: &post.CreatedAt,
 		}
 
-		if _, err := delphisBackend.UpdateDiscussion(ctx, disc.ID, discInput); err != nil {
+		if _, err := delphisBackend.UpdateDiscussion(ctx, disc.ID, model.ConciergeUser, discInput); err != nil {
 			logrus.WithError(err).Error("failed to update last post")
 			panic(err)
 		}
@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS discussion_events (
+    sequence bigserial PRIMARY KEY,
+    discussion_id varchar(36) not null,
+    event_type varchar(32) not null,
+    entity_type varchar(32) not null,
+    entity_id varchar(36) not null,
+    created_at timestamp with time zone default current_timestamp not null
+);
+
+ALTER TABLE discussion_events
+    ADD CONSTRAINT discussion_events_discussions_fk_3b9e0c71d2a4 FOREIGN KEY (discussion_id) REFERENCES discussions(id) MATCH FULL ON DELETE CASCADE;
+
+CREATE INDEX discussion_events_discussion_id_sequence_idx ON discussion_events (discussion_id, sequence);
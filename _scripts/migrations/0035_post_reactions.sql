@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS post_reactions (
+    post_id varchar(36) not null,
+    participant_id varchar(36) not null,
+    reaction varchar(32) not null,
+    created_at timestamp with time zone default current_timestamp not null,
+    PRIMARY KEY(post_id, participant_id, reaction)
+);
+
+ALTER TABLE post_reactions ADD CONSTRAINT post_reactions_posts_fk_7c1d5e2a90b4 FOREIGN KEY (post_id) REFERENCES posts (id) MATCH FULL ON DELETE CASCADE;
+ALTER TABLE post_reactions ADD CONSTRAINT post_reactions_participants_fk_e48f0b36a2c7 FOREIGN KEY (participant_id) REFERENCES participants (id) MATCH FULL ON DELETE CASCADE;
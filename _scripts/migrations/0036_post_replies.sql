@@ -0,0 +1,5 @@
+ALTER TABLE posts ADD COLUMN IF NOT EXISTS parent_post_id varchar(36);
+
+ALTER TABLE posts ADD CONSTRAINT posts_parent_posts_fk_5a2e91c4d7f3 FOREIGN KEY (parent_post_id) REFERENCES posts (id) MATCH FULL;
+
+CREATE INDEX IF NOT EXISTS posts_parent_post_id_created_at_idx ON posts (parent_post_id, created_at);
@@ -0,0 +1,3 @@
+ALTER TABLE posts ADD COLUMN IF NOT EXISTS pinned_at timestamp with time zone;
+
+CREATE INDEX IF NOT EXISTS posts_discussion_id_pinned_at_idx ON posts (discussion_id, pinned_at) WHERE pinned_at IS NOT NULL;
@@ -0,0 +1 @@
+CREATE INDEX IF NOT EXISTS post_contents_content_search_idx ON post_contents USING GIN (to_tsvector('english', content));
@@ -0,0 +1 @@
+CREATE INDEX IF NOT EXISTS activity_post_content_id_idx ON activity (post_content_id);
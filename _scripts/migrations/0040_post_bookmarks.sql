@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS post_bookmarks (
+    user_id varchar(36) not null,
+    post_id varchar(36) not null,
+    created_at timestamp with time zone default current_timestamp not null,
+    PRIMARY KEY(user_id, post_id)
+);
+
+ALTER TABLE post_bookmarks ADD CONSTRAINT post_bookmarks_users_fk_3b8e2d71c6a9 FOREIGN KEY (user_id) REFERENCES users (id) MATCH FULL ON DELETE CASCADE;
+ALTER TABLE post_bookmarks ADD CONSTRAINT post_bookmarks_posts_fk_d94a0f5e12b7 FOREIGN KEY (post_id) REFERENCES posts (id) MATCH FULL ON DELETE CASCADE;
+
+CREATE INDEX IF NOT EXISTS post_bookmarks_user_id_created_at_idx ON post_bookmarks (user_id, created_at);
@@ -0,0 +1,33 @@
+CREATE TABLE IF NOT EXISTS polls (
+    post_id varchar(36) PRIMARY KEY,
+    allow_multiple_votes boolean default false not null,
+    is_anonymous boolean default false not null,
+    closes_at timestamp with time zone,
+    created_at timestamp with time zone default current_timestamp not null
+);
+
+ALTER TABLE polls ADD CONSTRAINT polls_posts_fk_61d0c7a3e5b2 FOREIGN KEY (post_id) REFERENCES posts (id) MATCH FULL ON DELETE CASCADE;
+
+CREATE TABLE IF NOT EXISTS poll_options (
+    id varchar(36) PRIMARY KEY,
+    post_id varchar(36) not null,
+    position integer not null,
+    text varchar(140) not null,
+    UNIQUE(post_id, position)
+);
+
+ALTER TABLE poll_options ADD CONSTRAINT poll_options_polls_fk_b27e4a90d1c8 FOREIGN KEY (post_id) REFERENCES polls (post_id) MATCH FULL ON DELETE CASCADE;
+
+CREATE TABLE IF NOT EXISTS poll_votes (
+    post_id varchar(36) not null,
+    option_id varchar(36) not null,
+    participant_id varchar(36) not null,
+    created_at timestamp with time zone default current_timestamp not null,
+    PRIMARY KEY(option_id, participant_id)
+);
+
+ALTER TABLE poll_votes ADD CONSTRAINT poll_votes_polls_fk_8f3c1b6e2d47 FOREIGN KEY (post_id) REFERENCES polls (post_id) MATCH FULL ON DELETE CASCADE;
+ALTER TABLE poll_votes ADD CONSTRAINT poll_votes_poll_options_fk_4ae90d7c35f1 FOREIGN KEY (option_id) REFERENCES poll_options (id) MATCH FULL ON DELETE CASCADE;
+ALTER TABLE poll_votes ADD CONSTRAINT poll_votes_participants_fk_c5d82e1f9a36 FOREIGN KEY (participant_id) REFERENCES participants (id) MATCH FULL ON DELETE CASCADE;
+
+CREATE INDEX IF NOT EXISTS poll_votes_post_id_participant_id_idx ON poll_votes (post_id, participant_id);
@@ -0,0 +1,21 @@
+CREATE TABLE IF NOT EXISTS scheduled_posts (
+    id varchar(36) PRIMARY KEY,
+    discussion_id varchar(36) not null,
+    participant_id varchar(36) not null,
+    user_id varchar(36) not null,
+    post_content jsonb not null,
+    publish_at timestamp with time zone not null,
+    status varchar(16) default 'PENDING' not null,
+    post_id varchar(36),
+    failure_reason varchar(256),
+    created_at timestamp with time zone default current_timestamp not null,
+    updated_at timestamp with time zone default current_timestamp not null
+);
+
+ALTER TABLE scheduled_posts ADD CONSTRAINT scheduled_posts_discussions_fk_0e7b3c95a1d6 FOREIGN KEY (discussion_id) REFERENCES discussions (id) MATCH FULL ON DELETE CASCADE;
+ALTER TABLE scheduled_posts ADD CONSTRAINT scheduled_posts_participants_fk_9a42d6e1b7c0 FOREIGN KEY (participant_id) REFERENCES participants (id) MATCH FULL ON DELETE CASCADE;
+ALTER TABLE scheduled_posts ADD CONSTRAINT scheduled_posts_users_fk_d3f18b2c64e5 FOREIGN KEY (user_id) REFERENCES users (id) MATCH FULL ON DELETE CASCADE;
+ALTER TABLE scheduled_posts ADD CONSTRAINT scheduled_posts_posts_fk_6c5e0a9f2b83 FOREIGN KEY (post_id) REFERENCES posts (id) MATCH FULL;
+
+CREATE INDEX IF NOT EXISTS scheduled_posts_status_publish_at_idx ON scheduled_posts (status, publish_at);
+CREATE INDEX IF NOT EXISTS scheduled_posts_discussion_id_status_idx ON scheduled_posts (discussion_id, status);
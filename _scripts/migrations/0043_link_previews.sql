@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS link_previews (
+    url varchar(2048) not null,
+    title varchar(256),
+    description varchar(1024),
+    image_url varchar(2048),
+    site_name varchar(256),
+    fetched_at timestamp with time zone default current_timestamp not null,
+    PRIMARY KEY(url)
+);
+
+CREATE TABLE IF NOT EXISTS post_link_previews (
+    post_id varchar(36) not null,
+    url varchar(2048) not null,
+    position int not null,
+    PRIMARY KEY(post_id, url)
+);
+
+ALTER TABLE post_link_previews ADD CONSTRAINT post_link_previews_posts_fk_5c1e7a9b3d20 FOREIGN KEY (post_id) REFERENCES posts (id) MATCH FULL ON DELETE CASCADE;
+ALTER TABLE post_link_previews ADD CONSTRAINT post_link_previews_link_previews_fk_e86f2b04a917 FOREIGN KEY (url) REFERENCES link_previews (url) MATCH FULL ON DELETE CASCADE;
@@ -0,0 +1,2 @@
+-- Tags are recorded in activity next to mentions with entity_type 'tag'
+CREATE INDEX IF NOT EXISTS activity_entity_type_entity_id_idx ON activity (entity_type, entity_id);
@@ -0,0 +1,3 @@
+-- Who may notify the whole discussion with an @everyone mention
+ALTER TABLE discussions
+    ADD COLUMN IF NOT EXISTS everyone_mention_policy varchar(36) default 'MODERATORS' not null;
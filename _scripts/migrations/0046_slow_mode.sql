@@ -0,0 +1,3 @@
+-- Minimum seconds between posts by the same user, 0 when slow mode is off
+ALTER TABLE discussions
+    ADD COLUMN IF NOT EXISTS slow_mode_seconds integer default 0 not null;
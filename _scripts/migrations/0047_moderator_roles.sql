@@ -0,0 +1,15 @@
+-- Co-moderators and helpers. The owner stays the discussion's moderator_id
+-- and is never stored here.
+CREATE TABLE IF NOT EXISTS moderator_roles (
+    discussion_id varchar(36) not null,
+    user_id varchar(36) not null,
+    role varchar(16) not null,
+    granted_by_user_id varchar(36) not null,
+    created_at timestamp with time zone default current_timestamp not null,
+    updated_at timestamp with time zone default current_timestamp not null,
+    PRIMARY KEY(discussion_id, user_id)
+);
+
+ALTER TABLE moderator_roles ADD CONSTRAINT moderator_roles_discussions_fk_4b7e19c2d08a FOREIGN KEY (discussion_id) REFERENCES discussions (id) MATCH FULL ON DELETE CASCADE;
+ALTER TABLE moderator_roles ADD CONSTRAINT moderator_roles_users_fk_a63d0f85e21c FOREIGN KEY (user_id) REFERENCES users (id) MATCH FULL ON DELETE CASCADE;
+ALTER TABLE moderator_roles ADD CONSTRAINT moderator_roles_granted_by_users_fk_1f9c2e7b5a46 FOREIGN KEY (granted_by_user_id) REFERENCES users (id) MATCH FULL;
@@ -0,0 +1,16 @@
+-- One row per change of a discussion's owner, kept after later transfers
+CREATE TABLE IF NOT EXISTS discussion_ownership_transfers (
+    id varchar(36) PRIMARY KEY,
+    discussion_id varchar(36) not null,
+    from_user_id varchar(36) not null,
+    to_user_id varchar(36) not null,
+    transferred_by_user_id varchar(36) not null,
+    created_at timestamp with time zone default current_timestamp not null
+);
+
+ALTER TABLE discussion_ownership_transfers ADD CONSTRAINT discussion_ownership_transfers_discussions_fk_7d2a5c19e0b4 FOREIGN KEY (discussion_id) REFERENCES discussions (id) MATCH FULL ON DELETE CASCADE;
+ALTER TABLE discussion_ownership_transfers ADD CONSTRAINT discussion_ownership_transfers_from_users_fk_c4e81f06a3d7 FOREIGN KEY (from_user_id) REFERENCES users (id) MATCH FULL;
+ALTER TABLE discussion_ownership_transfers ADD CONSTRAINT discussion_ownership_transfers_to_users_fk_59b3d7e2f18c FOREIGN KEY (to_user_id) REFERENCES users (id) MATCH FULL;
+ALTER TABLE discussion_ownership_transfers ADD CONSTRAINT discussion_ownership_transfers_by_users_fk_0a6f4b8d2c95 FOREIGN KEY (transferred_by_user_id) REFERENCES users (id) MATCH FULL;
+
+CREATE INDEX IF NOT EXISTS discussion_ownership_transfers_discussion_id_idx ON discussion_ownership_transfers (discussion_id, created_at);
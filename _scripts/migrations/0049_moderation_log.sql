@@ -0,0 +1,16 @@
+-- Append-only record of the actions moderators take in a discussion
+CREATE TABLE IF NOT EXISTS moderation_log_entries (
+    id varchar(36) PRIMARY KEY,
+    discussion_id varchar(36) not null,
+    actor_user_id varchar(36) not null,
+    action varchar(32) not null,
+    target_type varchar(16) not null,
+    target_id varchar(36),
+    reason text,
+    created_at timestamp with time zone default current_timestamp not null
+);
+
+ALTER TABLE moderation_log_entries ADD CONSTRAINT moderation_log_entries_discussions_fk_3e8b1d6a9c20 FOREIGN KEY (discussion_id) REFERENCES discussions (id) MATCH FULL ON DELETE CASCADE;
+ALTER TABLE moderation_log_entries ADD CONSTRAINT moderation_log_entries_users_fk_b52f07c4e91d FOREIGN KEY (actor_user_id) REFERENCES users (id) MATCH FULL;
+
+CREATE INDEX IF NOT EXISTS moderation_log_entries_discussion_id_idx ON moderation_log_entries (discussion_id, created_at);
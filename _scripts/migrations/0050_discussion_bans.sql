@@ -0,0 +1,16 @@
+-- One row per banned participant, removed again on unban
+CREATE TABLE IF NOT EXISTS discussion_bans (
+    participant_id varchar(36) PRIMARY KEY,
+    discussion_id varchar(36) not null,
+    user_id varchar(36) not null,
+    reason text,
+    banned_by_user_id varchar(36) not null,
+    created_at timestamp with time zone default current_timestamp not null
+);
+
+ALTER TABLE discussion_bans ADD CONSTRAINT discussion_bans_participants_fk_8c1e5a2f07d3 FOREIGN KEY (participant_id) REFERENCES participants (id) MATCH FULL ON DELETE CASCADE;
+ALTER TABLE discussion_bans ADD CONSTRAINT discussion_bans_discussions_fk_e46b93d0a1c7 FOREIGN KEY (discussion_id) REFERENCES discussions (id) MATCH FULL ON DELETE CASCADE;
+ALTER TABLE discussion_bans ADD CONSTRAINT discussion_bans_users_fk_2d7f0c8b6e15 FOREIGN KEY (user_id) REFERENCES users (id) MATCH FULL ON DELETE CASCADE;
+ALTER TABLE discussion_bans ADD CONSTRAINT discussion_bans_banned_by_users_fk_9a3c4e61b2f8 FOREIGN KEY (banned_by_user_id) REFERENCES users (id) MATCH FULL;
+
+CREATE INDEX IF NOT EXISTS discussion_bans_discussion_id_user_id_idx ON discussion_bans (discussion_id, user_id);
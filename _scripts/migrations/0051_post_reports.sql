@@ -0,0 +1,23 @@
+-- Reports filed by participants on posts, reviewed by the discussion's moderators
+CREATE TABLE IF NOT EXISTS post_reports (
+    id varchar(36) PRIMARY KEY,
+    discussion_id varchar(36) not null,
+    post_id varchar(36) not null,
+    reporter_user_id varchar(36) not null,
+    reason varchar(32) not null,
+    note text,
+    status varchar(16) not null,
+    action varchar(16),
+    resolved_by_user_id varchar(36),
+    resolved_at timestamp with time zone,
+    created_at timestamp with time zone default current_timestamp not null
+);
+
+ALTER TABLE post_reports ADD CONSTRAINT post_reports_discussions_fk_5b7e2c90d14a FOREIGN KEY (discussion_id) REFERENCES discussions (id) MATCH FULL ON DELETE CASCADE;
+ALTER TABLE post_reports ADD CONSTRAINT post_reports_posts_fk_c3a81f6e20b9 FOREIGN KEY (post_id) REFERENCES posts (id) MATCH FULL ON DELETE CASCADE;
+ALTER TABLE post_reports ADD CONSTRAINT post_reports_reporter_users_fk_0e4d9b7a3f62 FOREIGN KEY (reporter_user_id) REFERENCES users (id) MATCH FULL ON DELETE CASCADE;
+ALTER TABLE post_reports ADD CONSTRAINT post_reports_resolved_by_users_fk_a92f5c1d8e07 FOREIGN KEY (resolved_by_user_id) REFERENCES users (id);
+
+-- A user can only report a post once
+CREATE UNIQUE INDEX IF NOT EXISTS post_reports_post_id_reporter_user_id_idx ON post_reports (post_id, reporter_user_id);
+CREATE INDEX IF NOT EXISTS post_reports_discussion_id_status_idx ON post_reports (discussion_id, status, created_at);
@@ -43,12 +43,17 @@ type ResolverRoot interface {
 	DiscussionAccessRequest() DiscussionAccessRequestResolver
 	DiscussionArchive() DiscussionArchiveResolver
 	DiscussionUserAccess() DiscussionUserAccessResolver
+	ModerationLogEntry() ModerationLogEntryResolver
 	Moderator() ModeratorResolver
 	Mutation() MutationResolver
 	Participant() ParticipantResolver
 	ParticipantsConnection() ParticipantsConnectionResolver
+	Poll() PollResolver
+	PollOption() PollOptionResolver
 	Post() PostResolver
+	PostReport() PostReportResolver
 	Query() QueryResolver
+	ScheduledPost() ScheduledPostResolver
 	Subscription() SubscriptionResolver
 	User() UserResolver
 	UserDevice() UserDeviceResolver
@@ -76,23 +81,36 @@ type ComplexityRoot struct {
 		DescriptionHistory      func(childComplexity int) int
 		DiscussionAccessLink    func(childComplexity int) int
 		DiscussionJoinability   func(childComplexity int) int
+		EveryoneMentionPolicy   func(childComplexity int) int
 		ID                      func(childComplexity int) int
 		IconURL                 func(childComplexity int) int
 		LockStatus              func(childComplexity int) int
 		MeAvailableParticipants func(childComplexity int) int
+		MeBanReason             func(childComplexity int) int
 		MeCanJoinDiscussion     func(childComplexity int) int
 		MeDiscussionStatus      func(childComplexity int) int
+		MeModeratorRole         func(childComplexity int) int
 		MeNotificationSettings  func(childComplexity int) int
 		MeParticipant           func(childComplexity int) int
+		MeUnreadCount           func(childComplexity int) int
+		MeUnreadMentionCount    func(childComplexity int) int
 		MeViewer                func(childComplexity int) int
+		ModerationLog           func(childComplexity int, after *string) int
 		Moderator               func(childComplexity int) int
 		Participants            func(childComplexity int) int
+		PinnedPosts             func(childComplexity int) int
 		Posts                   func(childComplexity int) int
-		PostsConnection         func(childComplexity int, after *string) int
+		PostsByTag              func(childComplexity int, tag string, after *string) int
+		PostsConnection         func(childComplexity int, after *string, before *string, around *string, first *int, last *int, topLevelOnly *bool) int
+		Reports                 func(childComplexity int, status *model.PostReportStatus, after *string) int
+		ScheduledPosts          func(childComplexity int) int
+		SearchPosts             func(childComplexity int, query string, after *string) int
 		SecondsUntilShuffle     func(childComplexity int) int
 		ShuffleCount            func(childComplexity int) int
+		SlowModeSeconds         func(childComplexity int) int
 		Title                   func(childComplexity int) int
 		TitleHistory            func(childComplexity int) int
+		TopTags                 func(childComplexity int, limit *int) int
 		UpdatedAt               func(childComplexity int) int
 	}
 
@@ -123,6 +141,7 @@ type ComplexityRoot struct {
 	DiscussionSubscriptionEvent struct {
 		Entity    func(childComplexity int) int
 		EventType func(childComplexity int) int
+		Sequence  func(childComplexity int) int
 	}
 
 	DiscussionUserAccess struct {
@@ -135,11 +154,24 @@ type ComplexityRoot struct {
 		User       func(childComplexity int) int
 	}
 
+	GroupMention struct {
+		Group func(childComplexity int) int
+		ID    func(childComplexity int) int
+	}
+
 	HistoricalString struct {
 		CreatedAt func(childComplexity int) int
 		Value     func(childComplexity int) int
 	}
 
+	LinkPreview struct {
+		Description func(childComplexity int) int
+		ImageURL    func(childComplexity int) int
+		SiteName    func(childComplexity int) int
+		Title       func(childComplexity int) int
+		URL         func(childComplexity int) int
+	}
+
 	Media struct {
 		AssetLocation     func(childComplexity int) int
 		CreatedAt         func(childComplexity int) int
@@ -156,6 +188,26 @@ type ComplexityRoot struct {
 		Width  func(childComplexity int) int
 	}
 
+	ModerationLogConnection struct {
+		Edges    func(childComplexity int) int
+		PageInfo func(childComplexity int) int
+	}
+
+	ModerationLogEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
+	ModerationLogEntry struct {
+		Action     func(childComplexity int) int
+		Actor      func(childComplexity int) int
+		CreatedAt  func(childComplexity int) int
+		ID         func(childComplexity int) int
+		Reason     func(childComplexity int) int
+		TargetID   func(childComplexity int) int
+		TargetType func(childComplexity int) int
+	}
+
 	Moderator struct {
 		Discussion  func(childComplexity int) int
 		ID          func(childComplexity int) int
@@ -163,43 +215,64 @@ type ComplexityRoot struct {
 	}
 
 	Mutation struct {
+		ActionPostReport             func(childComplexity int, discussionID string, reportID string, action model.PostReportAction, mutedForSeconds *int) int
 		AddDiscussionParticipant     func(childComplexity int, discussionID string, userID string, discussionParticipantInput model.AddDiscussionParticipantInput) int
 		AddPost                      func(childComplexity int, discussionID string, participantID string, postContent model.PostContentInput) int
-		BanParticipant               func(childComplexity int, discussionID string, participantID string) int
+		AddReaction                  func(childComplexity int, discussionID string, participantID string, postID string, reaction string) int
+		BanParticipant               func(childComplexity int, discussionID string, participantID string, reason *string, keepPosts *bool) int
+		BookmarkPost                 func(childComplexity int, discussionID string, postID string) int
+		CancelScheduledPost          func(childComplexity int, scheduledPostID string) int
 		CreateDiscussion             func(childComplexity int, anonymityType model.AnonymityType, title string, description *string, publicAccess *bool, discussionSettings model.DiscussionCreationSettings) int
 		DeletePost                   func(childComplexity int, discussionID string, postID string) int
+		DismissPostReport            func(childComplexity int, discussionID string, reportID string) int
+		EditPost                     func(childComplexity int, discussionID string, postID string, postContent model.PostContentInput) int
+		GrantModeratorRole           func(childComplexity int, discussionID string, participantID string, role model.ModeratorRole) int
+		MarkAllDiscussionsRead       func(childComplexity int) int
 		MuteParticipants             func(childComplexity int, discussionID string, participantIDs []string, mutedForSeconds int) int
+		PinPost                      func(childComplexity int, discussionID string, postID string) int
+		RemoveReaction               func(childComplexity int, discussionID string, participantID string, postID string, reaction string) int
+		ReportPost                   func(childComplexity int, discussionID string, postID string, reason model.PostReportReason, note *string) int
 		RequestAccessToDiscussion    func(childComplexity int, discussionID string) int
 		RespondToRequestAccess       func(childComplexity int, requestID string, response model.InviteRequestStatus) int
+		RevokeModeratorRole          func(childComplexity int, discussionID string, participantID string) int
+		SchedulePost                 func(childComplexity int, discussionID string, participantID string, postContent model.PostContentInput, publishAt time.Time) int
 		SetLastPostViewed            func(childComplexity int, viewerID string, postID string) int
 		ShuffleDiscussion            func(childComplexity int, discussionID string, inFutureSeconds *int) int
+		TransferDiscussionOwnership  func(childComplexity int, discussionID string, toUserID string) int
+		UnbanParticipant             func(childComplexity int, discussionID string, participantID string, restorePosts *bool) int
+		UnbookmarkPost               func(childComplexity int, discussionID string, postID string) int
 		UnmuteParticipants           func(childComplexity int, discussionID string, participantIDs []string) int
+		UnpinPost                    func(childComplexity int, discussionID string, postID string) int
 		UpdateDiscussion             func(childComplexity int, discussionID string, input model.DiscussionInput) int
 		UpdateDiscussionUserSettings func(childComplexity int, discussionID string, settings model.DiscussionUserSettings) int
 		UpdateParticipant            func(childComplexity int, discussionID string, participantID string, updateInput model.UpdateParticipantInput) int
 		UpsertUserDevice             func(childComplexity int, userID *string, platform model.Platform, deviceID string, token *string) int
+		VotePoll                     func(childComplexity int, discussionID string, participantID string, postID string, optionIDs []string) int
 	}
 
 	PageInfo struct {
-		EndCursor   func(childComplexity int) int
-		HasNextPage func(childComplexity int) int
-		StartCursor func(childComplexity int) int
+		EndCursor       func(childComplexity int) int
+		HasNextPage     func(childComplexity int) int
+		HasPreviousPage func(childComplexity int) int
+		StartCursor     func(childComplexity int) int
 	}
 
 	Participant struct {
-		AnonDisplayName func(childComplexity int) int
-		Discussion      func(childComplexity int) int
-		GradientColor   func(childComplexity int) int
-		HasJoined       func(childComplexity int) int
-		ID              func(childComplexity int) int
-		Inviter         func(childComplexity int) int
-		IsAnonymous     func(childComplexity int) int
-		IsBanned        func(childComplexity int) int
-		MutedForSeconds func(childComplexity int) int
-		ParticipantID   func(childComplexity int) int
-		Posts           func(childComplexity int) int
-		UserProfile     func(childComplexity int) int
-		Viewer          func(childComplexity int) int
+		AnonDisplayName       func(childComplexity int) int
+		Discussion            func(childComplexity int) int
+		GradientColor         func(childComplexity int) int
+		HasJoined             func(childComplexity int) int
+		ID                    func(childComplexity int) int
+		Inviter               func(childComplexity int) int
+		IsAnonymous           func(childComplexity int) int
+		IsBanned              func(childComplexity int) int
+		MeSecondsUntilCanPost func(childComplexity int) int
+		ModeratorRole         func(childComplexity int) int
+		MutedForSeconds       func(childComplexity int) int
+		ParticipantID         func(childComplexity int) int
+		Posts                 func(childComplexity int) int
+		UserProfile           func(childComplexity int) int
+		Viewer                func(childComplexity int) int
 	}
 
 	ParticipantProfile struct {
@@ -218,44 +291,140 @@ type ComplexityRoot struct {
 		Node   func(childComplexity int) int
 	}
 
+	Poll struct {
+		AllowMultipleVotes func(childComplexity int) int
+		ClosesAt           func(childComplexity int) int
+		IsAnonymous        func(childComplexity int) int
+		IsClosed           func(childComplexity int) int
+		Options            func(childComplexity int) int
+	}
+
+	PollOption struct {
+		ID        func(childComplexity int) int
+		MeVoted   func(childComplexity int) int
+		Text      func(childComplexity int) int
+		VoteCount func(childComplexity int) int
+		Voters    func(childComplexity int) int
+	}
+
 	Post struct {
 		Content           func(childComplexity int) int
+		ContentHTML       func(childComplexity int) int
 		CreatedAt         func(childComplexity int) int
 		DeletedReasonCode func(childComplexity int) int
 		Discussion        func(childComplexity int) int
+		EditHistory       func(childComplexity int) int
 		ID                func(childComplexity int) int
 		IsDeleted         func(childComplexity int) int
+		IsEdited          func(childComplexity int) int
+		IsPinned          func(childComplexity int) int
+		LinkPreviews      func(childComplexity int) int
 		Media             func(childComplexity int) int
 		MentionedEntities func(childComplexity int) int
+		ParentPostID      func(childComplexity int) int
 		Participant       func(childComplexity int) int
+		Poll              func(childComplexity int) int
 		PostType          func(childComplexity int) int
 		QuotedPost        func(childComplexity int) int
+		Reactions         func(childComplexity int) int
+		RepliesConnection func(childComplexity int, after *string) int
+		ReplyCount        func(childComplexity int) int
+		Tags              func(childComplexity int) int
 		UpdatedAt         func(childComplexity int) int
 	}
 
-	PostsConnection struct {
+	PostBookmark struct {
+		CreatedAt func(childComplexity int) int
+		Post      func(childComplexity int) int
+	}
+
+	PostBookmarksConnection struct {
 		Edges    func(childComplexity int) int
 		PageInfo func(childComplexity int) int
 	}
 
-	PostsEdge struct {
+	PostBookmarksEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
+	PostReactionSummary struct {
+		Count     func(childComplexity int) int
+		MeReacted func(childComplexity int) int
+		Reaction  func(childComplexity int) int
+	}
+
+	PostReport struct {
+		Action     func(childComplexity int) int
+		CreatedAt  func(childComplexity int) int
+		ID         func(childComplexity int) int
+		Note       func(childComplexity int) int
+		Post       func(childComplexity int) int
+		Reason     func(childComplexity int) int
+		ResolvedAt func(childComplexity int) int
+		ResolvedBy func(childComplexity int) int
+		Status     func(childComplexity int) int
+	}
+
+	PostReportConnection struct {
+		Edges    func(childComplexity int) int
+		PageInfo func(childComplexity int) int
+	}
+
+	PostReportEdge struct {
 		Cursor func(childComplexity int) int
 		Node   func(childComplexity int) int
 	}
 
+	PostsConnection struct {
+		Edges    func(childComplexity int) int
+		PageInfo func(childComplexity int) int
+	}
+
+	PostsEdge struct {
+		Cursor    func(childComplexity int) int
+		Highlight func(childComplexity int) int
+		Node      func(childComplexity int) int
+	}
+
 	Query struct {
 		Discussion           func(childComplexity int, id string) int
 		DiscussionByLinkSlug func(childComplexity int, slug string) int
 		ListDiscussions      func(childComplexity int, state model.DiscussionUserAccessState) int
 		Me                   func(childComplexity int) int
+		SearchMyDiscussions  func(childComplexity int, query string, after *string) int
 		User                 func(childComplexity int, id string) int
 	}
 
+	ScheduledPost struct {
+		Content       func(childComplexity int) int
+		CreatedAt     func(childComplexity int) int
+		Discussion    func(childComplexity int) int
+		FailureReason func(childComplexity int) int
+		ID            func(childComplexity int) int
+		Participant   func(childComplexity int) int
+		Post          func(childComplexity int) int
+		PostType      func(childComplexity int) int
+		PublishAt     func(childComplexity int) int
+		Status        func(childComplexity int) int
+		UpdatedAt     func(childComplexity int) int
+	}
+
 	Subscription struct {
-		OnDiscussionEvent func(childComplexity int, discussionID string) int
+		OnDiscussionEvent func(childComplexity int, discussionID string, afterSequence *int) int
 		PostAdded         func(childComplexity int, discussionID string) int
 	}
 
+	Tag struct {
+		ID   func(childComplexity int) int
+		Name func(childComplexity int) int
+	}
+
+	TagSummary struct {
+		Name      func(childComplexity int) int
+		PostCount func(childComplexity int) int
+	}
+
 	URL struct {
 		DisplayText func(childComplexity int) int
 		URL         func(childComplexity int) int
@@ -266,6 +435,7 @@ type ComplexityRoot struct {
 	}
 
 	User struct {
+		Bookmarks                    func(childComplexity int, after *string) int
 		Devices                      func(childComplexity int) int
 		Discussions                  func(childComplexity int, state model.DiscussionUserAccessState) int
 		ID                           func(childComplexity int) int
@@ -273,6 +443,7 @@ type ComplexityRoot struct {
 		Participants                 func(childComplexity int) int
 		Profile                      func(childComplexity int) int
 		SentDiscussionAccessRequests func(childComplexity int) int
+		TotalUnreadCount             func(childComplexity int) int
 		Viewers                      func(childComplexity int) int
 	}
 
@@ -314,7 +485,11 @@ type DiscussionResolver interface {
 	Moderator(ctx context.Context, obj *model.Discussion) (*model.Moderator, error)
 
 	Posts(ctx context.Context, obj *model.Discussion) ([]*model.Post, error)
-	PostsConnection(ctx context.Context, obj *model.Discussion, after *string) (*model.PostsConnection, error)
+	PostsConnection(ctx context.Context, obj *model.Discussion, after *string, before *string, around *string, first *int, last *int, topLevelOnly *bool) (*model.PostsConnection, error)
+	PinnedPosts(ctx context.Context, obj *model.Discussion) ([]*model.Post, error)
+	SearchPosts(ctx context.Context, obj *model.Discussion, query string, after *string) (*model.PostsConnection, error)
+	PostsByTag(ctx context.Context, obj *model.Discussion, tag string, after *string) (*model.PostsConnection, error)
+	TopTags(ctx context.Context, obj *model.Discussion, limit *int) ([]*model.TagSummary, error)
 
 	Participants(ctx context.Context, obj *model.Discussion) ([]*model.Participant, error)
 
@@ -326,14 +501,23 @@ type DiscussionResolver interface {
 	MeAvailableParticipants(ctx context.Context, obj *model.Discussion) ([]*model.Participant, error)
 	MeCanJoinDiscussion(ctx context.Context, obj *model.Discussion) (*model.CanJoinDiscussionResponse, error)
 	MeViewer(ctx context.Context, obj *model.Discussion) (*model.Viewer, error)
+	MeUnreadCount(ctx context.Context, obj *model.Discussion) (int, error)
+	MeUnreadMentionCount(ctx context.Context, obj *model.Discussion) (int, error)
 	MeNotificationSettings(ctx context.Context, obj *model.Discussion) (*model.DiscussionUserNotificationSetting, error)
 	MeDiscussionStatus(ctx context.Context, obj *model.Discussion) (*model.DiscussionUserAccessState, error)
+	MeBanReason(ctx context.Context, obj *model.Discussion) (*string, error)
+	MeModeratorRole(ctx context.Context, obj *model.Discussion) (*model.ModeratorRole, error)
 	AccessRequests(ctx context.Context, obj *model.Discussion) ([]*model.DiscussionAccessRequest, error)
+	ScheduledPosts(ctx context.Context, obj *model.Discussion) ([]*model.ScheduledPost, error)
+	ModerationLog(ctx context.Context, obj *model.Discussion, after *string) (*model.ModerationLogConnection, error)
+	Reports(ctx context.Context, obj *model.Discussion, status *model.PostReportStatus, after *string) (*model.PostReportConnection, error)
 	DiscussionAccessLink(ctx context.Context, obj *model.Discussion) (*model.DiscussionAccessLink, error)
 	DiscussionJoinability(ctx context.Context, obj *model.Discussion) (model.DiscussionJoinabilitySetting, error)
 
 	SecondsUntilShuffle(ctx context.Context, obj *model.Discussion) (*int, error)
 
+	EveryoneMentionPolicy(ctx context.Context, obj *model.Discussion) (model.EveryoneMentionPolicy, error)
+
 	Archive(ctx context.Context, obj *model.Discussion) (*model.DiscussionArchive, error)
 }
 type DiscussionAccessLinkResolver interface {
@@ -357,6 +541,9 @@ type DiscussionUserAccessResolver interface {
 	IsDeleted(ctx context.Context, obj *model.DiscussionUserAccess) (bool, error)
 	Request(ctx context.Context, obj *model.DiscussionUserAccess) (*model.DiscussionAccessRequest, error)
 }
+type ModerationLogEntryResolver interface {
+	Actor(ctx context.Context, obj *model.ModerationLogEntry) (*model.UserProfile, error)
+}
 type ModeratorResolver interface {
 	Discussion(ctx context.Context, obj *model.Moderator) (*model.Discussion, error)
 	UserProfile(ctx context.Context, obj *model.Moderator) (*model.UserProfile, error)
@@ -372,11 +559,29 @@ type MutationResolver interface {
 	RequestAccessToDiscussion(ctx context.Context, discussionID string) (*model.DiscussionAccessRequest, error)
 	RespondToRequestAccess(ctx context.Context, requestID string, response model.InviteRequestStatus) (*model.DiscussionAccessRequest, error)
 	DeletePost(ctx context.Context, discussionID string, postID string) (*model.Post, error)
-	BanParticipant(ctx context.Context, discussionID string, participantID string) (*model.Participant, error)
+	EditPost(ctx context.Context, discussionID string, postID string, postContent model.PostContentInput) (*model.Post, error)
+	SchedulePost(ctx context.Context, discussionID string, participantID string, postContent model.PostContentInput, publishAt time.Time) (*model.ScheduledPost, error)
+	CancelScheduledPost(ctx context.Context, scheduledPostID string) (*model.ScheduledPost, error)
+	AddReaction(ctx context.Context, discussionID string, participantID string, postID string, reaction string) (*model.Post, error)
+	RemoveReaction(ctx context.Context, discussionID string, participantID string, postID string, reaction string) (*model.Post, error)
+	VotePoll(ctx context.Context, discussionID string, participantID string, postID string, optionIDs []string) (*model.Post, error)
+	PinPost(ctx context.Context, discussionID string, postID string) (*model.Post, error)
+	UnpinPost(ctx context.Context, discussionID string, postID string) (*model.Post, error)
+	BookmarkPost(ctx context.Context, discussionID string, postID string) (*model.Post, error)
+	UnbookmarkPost(ctx context.Context, discussionID string, postID string) (*model.Post, error)
+	ReportPost(ctx context.Context, discussionID string, postID string, reason model.PostReportReason, note *string) (*model.PostReport, error)
+	DismissPostReport(ctx context.Context, discussionID string, reportID string) (*model.PostReport, error)
+	ActionPostReport(ctx context.Context, discussionID string, reportID string, action model.PostReportAction, mutedForSeconds *int) (*model.PostReport, error)
+	BanParticipant(ctx context.Context, discussionID string, participantID string, reason *string, keepPosts *bool) (*model.Participant, error)
+	UnbanParticipant(ctx context.Context, discussionID string, participantID string, restorePosts *bool) (*model.Participant, error)
 	ShuffleDiscussion(ctx context.Context, discussionID string, inFutureSeconds *int) (*model.Discussion, error)
 	SetLastPostViewed(ctx context.Context, viewerID string, postID string) (*model.Viewer, error)
+	MarkAllDiscussionsRead(ctx context.Context) ([]*model.Viewer, error)
 	MuteParticipants(ctx context.Context, discussionID string, participantIDs []string, mutedForSeconds int) ([]*model.Participant, error)
 	UnmuteParticipants(ctx context.Context, discussionID string, participantIDs []string) ([]*model.Participant, error)
+	GrantModeratorRole(ctx context.Context, discussionID string, participantID string, role model.ModeratorRole) (*model.Participant, error)
+	RevokeModeratorRole(ctx context.Context, discussionID string, participantID string) (*model.Participant, error)
+	TransferDiscussionOwnership(ctx context.Context, discussionID string, toUserID string) (*model.Discussion, error)
 }
 type ParticipantResolver interface {
 	Discussion(ctx context.Context, obj *model.Participant) (*model.Discussion, error)
@@ -390,21 +595,46 @@ type ParticipantResolver interface {
 
 	AnonDisplayName(ctx context.Context, obj *model.Participant) (*string, error)
 	MutedForSeconds(ctx context.Context, obj *model.Participant) (*int, error)
+	MeSecondsUntilCanPost(ctx context.Context, obj *model.Participant) (*int, error)
+	ModeratorRole(ctx context.Context, obj *model.Participant) (*model.ModeratorRole, error)
 }
 type ParticipantsConnectionResolver interface {
 	Edges(ctx context.Context, obj *model.ParticipantsConnection) ([]*model.ParticipantsEdge, error)
 }
+type PollResolver interface {
+	IsClosed(ctx context.Context, obj *model.Poll) (bool, error)
+}
+type PollOptionResolver interface {
+	Voters(ctx context.Context, obj *model.PollOption) ([]*model.Participant, error)
+}
 type PostResolver interface {
 	IsDeleted(ctx context.Context, obj *model.Post) (bool, error)
 
 	Content(ctx context.Context, obj *model.Post) (*string, error)
+	ContentHTML(ctx context.Context, obj *model.Post) (*string, error)
 	Discussion(ctx context.Context, obj *model.Post) (*model.Discussion, error)
 	Participant(ctx context.Context, obj *model.Post) (*model.Participant, error)
 	CreatedAt(ctx context.Context, obj *model.Post) (string, error)
 	UpdatedAt(ctx context.Context, obj *model.Post) (string, error)
-
+	QuotedPost(ctx context.Context, obj *model.Post) (*model.Post, error)
 	MentionedEntities(ctx context.Context, obj *model.Post) ([]model.Entity, error)
+	Tags(ctx context.Context, obj *model.Post) ([]string, error)
 	Media(ctx context.Context, obj *model.Post) (*model.Media, error)
+
+	IsEdited(ctx context.Context, obj *model.Post) (bool, error)
+	EditHistory(ctx context.Context, obj *model.Post) ([]*model.HistoricalString, error)
+	Reactions(ctx context.Context, obj *model.Post) ([]*model.PostReactionSummary, error)
+	IsPinned(ctx context.Context, obj *model.Post) (bool, error)
+	Poll(ctx context.Context, obj *model.Post) (*model.Poll, error)
+	LinkPreviews(ctx context.Context, obj *model.Post) ([]*model.LinkPreview, error)
+
+	ReplyCount(ctx context.Context, obj *model.Post) (int, error)
+	RepliesConnection(ctx context.Context, obj *model.Post, after *string) (*model.PostsConnection, error)
+}
+type PostReportResolver interface {
+	Post(ctx context.Context, obj *model.PostReport) (*model.Post, error)
+
+	ResolvedBy(ctx context.Context, obj *model.PostReport) (*model.UserProfile, error)
 }
 type QueryResolver interface {
 	Discussion(ctx context.Context, id string) (*model.Discussion, error)
@@ -412,10 +642,19 @@ type QueryResolver interface {
 	ListDiscussions(ctx context.Context, state model.DiscussionUserAccessState) ([]*model.Discussion, error)
 	User(ctx context.Context, id string) (*model.User, error)
 	Me(ctx context.Context) (*model.User, error)
+	SearchMyDiscussions(ctx context.Context, query string, after *string) (*model.PostsConnection, error)
+}
+type ScheduledPostResolver interface {
+	Discussion(ctx context.Context, obj *model.ScheduledPost) (*model.Discussion, error)
+	Participant(ctx context.Context, obj *model.ScheduledPost) (*model.Participant, error)
+	Content(ctx context.Context, obj *model.ScheduledPost) (string, error)
+	PostType(ctx context.Context, obj *model.ScheduledPost) (model.PostType, error)
+
+	Post(ctx context.Context, obj *model.ScheduledPost) (*model.Post, error)
 }
 type SubscriptionResolver interface {
 	PostAdded(ctx context.Context, discussionID string) (<-chan *model.Post, error)
-	OnDiscussionEvent(ctx context.Context, discussionID string) (<-chan *model.DiscussionSubscriptionEvent, error)
+	OnDiscussionEvent(ctx context.Context, discussionID string, afterSequence *int) (<-chan *model.DiscussionSubscriptionEvent, error)
 }
 type UserResolver interface {
 	Participants(ctx context.Context, obj *model.User) ([]*model.Participant, error)
@@ -424,6 +663,8 @@ type UserResolver interface {
 	Devices(ctx context.Context, obj *model.User) ([]*model.UserDevice, error)
 	ModeratedDiscussions(ctx context.Context, obj *model.User) ([]*model.Discussion, error)
 	Discussions(ctx context.Context, obj *model.User, state model.DiscussionUserAccessState) ([]*model.Discussion, error)
+	TotalUnreadCount(ctx context.Context, obj *model.User) (int, error)
+	Bookmarks(ctx context.Context, obj *model.User, after *string) (*model.PostBookmarksConnection, error)
 	SentDiscussionAccessRequests(ctx context.Context, obj *model.User) ([]*model.DiscussionAccessRequest, error)
 }
 type UserDeviceResolver interface {
@@ -534,6 +775,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Discussion.DiscussionJoinability(childComplexity), true
 
+	case "Discussion.everyoneMentionPolicy":
+		if e.complexity.Discussion.EveryoneMentionPolicy == nil {
+			break
+		}
+
+		return e.complexity.Discussion.EveryoneMentionPolicy(childComplexity), true
+
 	case "Discussion.id":
 		if e.complexity.Discussion.ID == nil {
 			break
@@ -562,6 +810,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Discussion.MeAvailableParticipants(childComplexity), true
 
+	case "Discussion.meBanReason":
+		if e.complexity.Discussion.MeBanReason == nil {
+			break
+		}
+
+		return e.complexity.Discussion.MeBanReason(childComplexity), true
+
 	case "Discussion.meCanJoinDiscussion":
 		if e.complexity.Discussion.MeCanJoinDiscussion == nil {
 			break
@@ -576,6 +831,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Discussion.MeDiscussionStatus(childComplexity), true
 
+	case "Discussion.meModeratorRole":
+		if e.complexity.Discussion.MeModeratorRole == nil {
+			break
+		}
+
+		return e.complexity.Discussion.MeModeratorRole(childComplexity), true
+
 	case "Discussion.meNotificationSettings":
 		if e.complexity.Discussion.MeNotificationSettings == nil {
 			break
@@ -590,6 +852,20 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Discussion.MeParticipant(childComplexity), true
 
+	case "Discussion.meUnreadCount":
+		if e.complexity.Discussion.MeUnreadCount == nil {
+			break
+		}
+
+		return e.complexity.Discussion.MeUnreadCount(childComplexity), true
+
+	case "Discussion.meUnreadMentionCount":
+		if e.complexity.Discussion.MeUnreadMentionCount == nil {
+			break
+		}
+
+		return e.complexity.Discussion.MeUnreadMentionCount(childComplexity), true
+
 	case "Discussion.meViewer":
 		if e.complexity.Discussion.MeViewer == nil {
 			break
@@ -597,6 +873,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Discussion.MeViewer(childComplexity), true
 
+	case "Discussion.moderationLog":
+		if e.complexity.Discussion.ModerationLog == nil {
+			break
+		}
+
+		args, err := ec.field_Discussion_moderationLog_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Discussion.ModerationLog(childComplexity, args["after"].(*string)), true
+
 	case "Discussion.moderator":
 		if e.complexity.Discussion.Moderator == nil {
 			break
@@ -611,6 +899,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Discussion.Participants(childComplexity), true
 
+	case "Discussion.pinnedPosts":
+		if e.complexity.Discussion.PinnedPosts == nil {
+			break
+		}
+
+		return e.complexity.Discussion.PinnedPosts(childComplexity), true
+
 	case "Discussion.posts":
 		if e.complexity.Discussion.Posts == nil {
 			break
@@ -618,6 +913,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Discussion.Posts(childComplexity), true
 
+	case "Discussion.postsByTag":
+		if e.complexity.Discussion.PostsByTag == nil {
+			break
+		}
+
+		args, err := ec.field_Discussion_postsByTag_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Discussion.PostsByTag(childComplexity, args["tag"].(string), args["after"].(*string)), true
+
 	case "Discussion.postsConnection":
 		if e.complexity.Discussion.PostsConnection == nil {
 			break
@@ -628,7 +935,38 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Discussion.PostsConnection(childComplexity, args["after"].(*string)), true
+		return e.complexity.Discussion.PostsConnection(childComplexity, args["after"].(*string), args["before"].(*string), args["around"].(*string), args["first"].(*int), args["last"].(*int), args["topLevelOnly"].(*bool)), true
+
+	case "Discussion.reports":
+		if e.complexity.Discussion.Reports == nil {
+			break
+		}
+
+		args, err := ec.field_Discussion_reports_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Discussion.Reports(childComplexity, args["status"].(*model.PostReportStatus), args["after"].(*string)), true
+
+	case "Discussion.scheduledPosts":
+		if e.complexity.Discussion.ScheduledPosts == nil {
+			break
+		}
+
+		return e.complexity.Discussion.ScheduledPosts(childComplexity), true
+
+	case "Discussion.searchPosts":
+		if e.complexity.Discussion.SearchPosts == nil {
+			break
+		}
+
+		args, err := ec.field_Discussion_searchPosts_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Discussion.SearchPosts(childComplexity, args["query"].(string), args["after"].(*string)), true
 
 	case "Discussion.secondsUntilShuffle":
 		if e.complexity.Discussion.SecondsUntilShuffle == nil {
@@ -644,6 +982,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Discussion.ShuffleCount(childComplexity), true
 
+	case "Discussion.slowModeSeconds":
+		if e.complexity.Discussion.SlowModeSeconds == nil {
+			break
+		}
+
+		return e.complexity.Discussion.SlowModeSeconds(childComplexity), true
+
 	case "Discussion.title":
 		if e.complexity.Discussion.Title == nil {
 			break
@@ -658,6 +1003,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Discussion.TitleHistory(childComplexity), true
 
+	case "Discussion.topTags":
+		if e.complexity.Discussion.TopTags == nil {
+			break
+		}
+
+		args, err := ec.field_Discussion_topTags_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Discussion.TopTags(childComplexity, args["limit"].(*int)), true
+
 	case "Discussion.updatedAt":
 		if e.complexity.Discussion.UpdatedAt == nil {
 			break
@@ -784,6 +1141,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.DiscussionSubscriptionEvent.EventType(childComplexity), true
 
+	case "DiscussionSubscriptionEvent.sequence":
+		if e.complexity.DiscussionSubscriptionEvent.Sequence == nil {
+			break
+		}
+
+		return e.complexity.DiscussionSubscriptionEvent.Sequence(childComplexity), true
+
 	case "DiscussionUserAccess.createdAt":
 		if e.complexity.DiscussionUserAccess.CreatedAt == nil {
 			break
@@ -833,6 +1197,20 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.DiscussionUserAccess.User(childComplexity), true
 
+	case "GroupMention.group":
+		if e.complexity.GroupMention.Group == nil {
+			break
+		}
+
+		return e.complexity.GroupMention.Group(childComplexity), true
+
+	case "GroupMention.id":
+		if e.complexity.GroupMention.ID == nil {
+			break
+		}
+
+		return e.complexity.GroupMention.ID(childComplexity), true
+
 	case "HistoricalString.createdAt":
 		if e.complexity.HistoricalString.CreatedAt == nil {
 			break
@@ -847,6 +1225,41 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.HistoricalString.Value(childComplexity), true
 
+	case "LinkPreview.description":
+		if e.complexity.LinkPreview.Description == nil {
+			break
+		}
+
+		return e.complexity.LinkPreview.Description(childComplexity), true
+
+	case "LinkPreview.imageURL":
+		if e.complexity.LinkPreview.ImageURL == nil {
+			break
+		}
+
+		return e.complexity.LinkPreview.ImageURL(childComplexity), true
+
+	case "LinkPreview.siteName":
+		if e.complexity.LinkPreview.SiteName == nil {
+			break
+		}
+
+		return e.complexity.LinkPreview.SiteName(childComplexity), true
+
+	case "LinkPreview.title":
+		if e.complexity.LinkPreview.Title == nil {
+			break
+		}
+
+		return e.complexity.LinkPreview.Title(childComplexity), true
+
+	case "LinkPreview.url":
+		if e.complexity.LinkPreview.URL == nil {
+			break
+		}
+
+		return e.complexity.LinkPreview.URL(childComplexity), true
+
 	case "Media.assetLocation":
 		if e.complexity.Media.AssetLocation == nil {
 			break
@@ -917,6 +1330,83 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.MediaSize.Width(childComplexity), true
 
+	case "ModerationLogConnection.edges":
+		if e.complexity.ModerationLogConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.ModerationLogConnection.Edges(childComplexity), true
+
+	case "ModerationLogConnection.pageInfo":
+		if e.complexity.ModerationLogConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.ModerationLogConnection.PageInfo(childComplexity), true
+
+	case "ModerationLogEdge.cursor":
+		if e.complexity.ModerationLogEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.ModerationLogEdge.Cursor(childComplexity), true
+
+	case "ModerationLogEdge.node":
+		if e.complexity.ModerationLogEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.ModerationLogEdge.Node(childComplexity), true
+
+	case "ModerationLogEntry.action":
+		if e.complexity.ModerationLogEntry.Action == nil {
+			break
+		}
+
+		return e.complexity.ModerationLogEntry.Action(childComplexity), true
+
+	case "ModerationLogEntry.actor":
+		if e.complexity.ModerationLogEntry.Actor == nil {
+			break
+		}
+
+		return e.complexity.ModerationLogEntry.Actor(childComplexity), true
+
+	case "ModerationLogEntry.createdAt":
+		if e.complexity.ModerationLogEntry.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.ModerationLogEntry.CreatedAt(childComplexity), true
+
+	case "ModerationLogEntry.id":
+		if e.complexity.ModerationLogEntry.ID == nil {
+			break
+		}
+
+		return e.complexity.ModerationLogEntry.ID(childComplexity), true
+
+	case "ModerationLogEntry.reason":
+		if e.complexity.ModerationLogEntry.Reason == nil {
+			break
+		}
+
+		return e.complexity.ModerationLogEntry.Reason(childComplexity), true
+
+	case "ModerationLogEntry.targetID":
+		if e.complexity.ModerationLogEntry.TargetID == nil {
+			break
+		}
+
+		return e.complexity.ModerationLogEntry.TargetID(childComplexity), true
+
+	case "ModerationLogEntry.targetType":
+		if e.complexity.ModerationLogEntry.TargetType == nil {
+			break
+		}
+
+		return e.complexity.ModerationLogEntry.TargetType(childComplexity), true
+
 	case "Moderator.discussion":
 		if e.complexity.Moderator.Discussion == nil {
 			break
@@ -938,6 +1428,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Moderator.UserProfile(childComplexity), true
 
+	case "Mutation.actionPostReport":
+		if e.complexity.Mutation.ActionPostReport == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_actionPostReport_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ActionPostReport(childComplexity, args["discussionID"].(string), args["reportID"].(string), args["action"].(model.PostReportAction), args["mutedForSeconds"].(*int)), true
+
 	case "Mutation.addDiscussionParticipant":
 		if e.complexity.Mutation.AddDiscussionParticipant == nil {
 			break
@@ -962,6 +1464,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.AddPost(childComplexity, args["discussionID"].(string), args["participantID"].(string), args["postContent"].(model.PostContentInput)), true
 
+	case "Mutation.addReaction":
+		if e.complexity.Mutation.AddReaction == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_addReaction_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.AddReaction(childComplexity, args["discussionID"].(string), args["participantID"].(string), args["postID"].(string), args["reaction"].(string)), true
+
 	case "Mutation.banParticipant":
 		if e.complexity.Mutation.BanParticipant == nil {
 			break
@@ -972,7 +1486,31 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Mutation.BanParticipant(childComplexity, args["discussionID"].(string), args["participantID"].(string)), true
+		return e.complexity.Mutation.BanParticipant(childComplexity, args["discussionID"].(string), args["participantID"].(string), args["reason"].(*string), args["keepPosts"].(*bool)), true
+
+	case "Mutation.bookmarkPost":
+		if e.complexity.Mutation.BookmarkPost == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_bookmarkPost_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.BookmarkPost(childComplexity, args["discussionID"].(string), args["postID"].(string)), true
+
+	case "Mutation.cancelScheduledPost":
+		if e.complexity.Mutation.CancelScheduledPost == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_cancelScheduledPost_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CancelScheduledPost(childComplexity, args["scheduledPostID"].(string)), true
 
 	case "Mutation.createDiscussion":
 		if e.complexity.Mutation.CreateDiscussion == nil {
@@ -998,42 +1536,145 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.DeletePost(childComplexity, args["discussionID"].(string), args["postID"].(string)), true
 
-	case "Mutation.muteParticipants":
-		if e.complexity.Mutation.MuteParticipants == nil {
+	case "Mutation.dismissPostReport":
+		if e.complexity.Mutation.DismissPostReport == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_muteParticipants_args(context.TODO(), rawArgs)
+		args, err := ec.field_Mutation_dismissPostReport_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.MuteParticipants(childComplexity, args["discussionID"].(string), args["participantIDs"].([]string), args["mutedForSeconds"].(int)), true
+		return e.complexity.Mutation.DismissPostReport(childComplexity, args["discussionID"].(string), args["reportID"].(string)), true
 
-	case "Mutation.requestAccessToDiscussion":
-		if e.complexity.Mutation.RequestAccessToDiscussion == nil {
+	case "Mutation.editPost":
+		if e.complexity.Mutation.EditPost == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_requestAccessToDiscussion_args(context.TODO(), rawArgs)
+		args, err := ec.field_Mutation_editPost_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.RequestAccessToDiscussion(childComplexity, args["discussionID"].(string)), true
+		return e.complexity.Mutation.EditPost(childComplexity, args["discussionID"].(string), args["postID"].(string), args["postContent"].(model.PostContentInput)), true
 
-	case "Mutation.respondToRequestAccess":
-		if e.complexity.Mutation.RespondToRequestAccess == nil {
+	case "Mutation.grantModeratorRole":
+		if e.complexity.Mutation.GrantModeratorRole == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_respondToRequestAccess_args(context.TODO(), rawArgs)
+		args, err := ec.field_Mutation_grantModeratorRole_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.GrantModeratorRole(childComplexity, args["discussionID"].(string), args["participantID"].(string), args["role"].(model.ModeratorRole)), true
+
+	case "Mutation.markAllDiscussionsRead":
+		if e.complexity.Mutation.MarkAllDiscussionsRead == nil {
+			break
+		}
+
+		return e.complexity.Mutation.MarkAllDiscussionsRead(childComplexity), true
+
+	case "Mutation.muteParticipants":
+		if e.complexity.Mutation.MuteParticipants == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_muteParticipants_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.MuteParticipants(childComplexity, args["discussionID"].(string), args["participantIDs"].([]string), args["mutedForSeconds"].(int)), true
+
+	case "Mutation.pinPost":
+		if e.complexity.Mutation.PinPost == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_pinPost_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.PinPost(childComplexity, args["discussionID"].(string), args["postID"].(string)), true
+
+	case "Mutation.removeReaction":
+		if e.complexity.Mutation.RemoveReaction == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_removeReaction_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RemoveReaction(childComplexity, args["discussionID"].(string), args["participantID"].(string), args["postID"].(string), args["reaction"].(string)), true
+
+	case "Mutation.reportPost":
+		if e.complexity.Mutation.ReportPost == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_reportPost_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ReportPost(childComplexity, args["discussionID"].(string), args["postID"].(string), args["reason"].(model.PostReportReason), args["note"].(*string)), true
+
+	case "Mutation.requestAccessToDiscussion":
+		if e.complexity.Mutation.RequestAccessToDiscussion == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_requestAccessToDiscussion_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RequestAccessToDiscussion(childComplexity, args["discussionID"].(string)), true
+
+	case "Mutation.respondToRequestAccess":
+		if e.complexity.Mutation.RespondToRequestAccess == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_respondToRequestAccess_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
 		return e.complexity.Mutation.RespondToRequestAccess(childComplexity, args["requestID"].(string), args["response"].(model.InviteRequestStatus)), true
 
+	case "Mutation.revokeModeratorRole":
+		if e.complexity.Mutation.RevokeModeratorRole == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_revokeModeratorRole_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RevokeModeratorRole(childComplexity, args["discussionID"].(string), args["participantID"].(string)), true
+
+	case "Mutation.schedulePost":
+		if e.complexity.Mutation.SchedulePost == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_schedulePost_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.SchedulePost(childComplexity, args["discussionID"].(string), args["participantID"].(string), args["postContent"].(model.PostContentInput), args["publishAt"].(time.Time)), true
+
 	case "Mutation.setLastPostViewed":
 		if e.complexity.Mutation.SetLastPostViewed == nil {
 			break
@@ -1058,6 +1699,42 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.ShuffleDiscussion(childComplexity, args["discussionID"].(string), args["inFutureSeconds"].(*int)), true
 
+	case "Mutation.transferDiscussionOwnership":
+		if e.complexity.Mutation.TransferDiscussionOwnership == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_transferDiscussionOwnership_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.TransferDiscussionOwnership(childComplexity, args["discussionID"].(string), args["toUserID"].(string)), true
+
+	case "Mutation.unbanParticipant":
+		if e.complexity.Mutation.UnbanParticipant == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_unbanParticipant_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UnbanParticipant(childComplexity, args["discussionID"].(string), args["participantID"].(string), args["restorePosts"].(*bool)), true
+
+	case "Mutation.unbookmarkPost":
+		if e.complexity.Mutation.UnbookmarkPost == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_unbookmarkPost_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UnbookmarkPost(childComplexity, args["discussionID"].(string), args["postID"].(string)), true
+
 	case "Mutation.unmuteParticipants":
 		if e.complexity.Mutation.UnmuteParticipants == nil {
 			break
@@ -1070,6 +1747,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.UnmuteParticipants(childComplexity, args["discussionID"].(string), args["participantIDs"].([]string)), true
 
+	case "Mutation.unpinPost":
+		if e.complexity.Mutation.UnpinPost == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_unpinPost_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UnpinPost(childComplexity, args["discussionID"].(string), args["postID"].(string)), true
+
 	case "Mutation.updateDiscussion":
 		if e.complexity.Mutation.UpdateDiscussion == nil {
 			break
@@ -1118,6 +1807,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.UpsertUserDevice(childComplexity, args["userID"].(*string), args["platform"].(model.Platform), args["deviceID"].(string), args["token"].(*string)), true
 
+	case "Mutation.votePoll":
+		if e.complexity.Mutation.VotePoll == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_votePoll_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.VotePoll(childComplexity, args["discussionID"].(string), args["participantID"].(string), args["postID"].(string), args["optionIDs"].([]string)), true
+
 	case "PageInfo.endCursor":
 		if e.complexity.PageInfo.EndCursor == nil {
 			break
@@ -1132,6 +1833,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.PageInfo.HasNextPage(childComplexity), true
 
+	case "PageInfo.hasPreviousPage":
+		if e.complexity.PageInfo.HasPreviousPage == nil {
+			break
+		}
+
+		return e.complexity.PageInfo.HasPreviousPage(childComplexity), true
+
 	case "PageInfo.startCursor":
 		if e.complexity.PageInfo.StartCursor == nil {
 			break
@@ -1195,6 +1903,20 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Participant.IsBanned(childComplexity), true
 
+	case "Participant.meSecondsUntilCanPost":
+		if e.complexity.Participant.MeSecondsUntilCanPost == nil {
+			break
+		}
+
+		return e.complexity.Participant.MeSecondsUntilCanPost(childComplexity), true
+
+	case "Participant.moderatorRole":
+		if e.complexity.Participant.ModeratorRole == nil {
+			break
+		}
+
+		return e.complexity.Participant.ModeratorRole(childComplexity), true
+
 	case "Participant.mutedForSeconds":
 		if e.complexity.Participant.MutedForSeconds == nil {
 			break
@@ -1279,6 +2001,76 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.ParticipantsEdge.Node(childComplexity), true
 
+	case "Poll.allowMultipleVotes":
+		if e.complexity.Poll.AllowMultipleVotes == nil {
+			break
+		}
+
+		return e.complexity.Poll.AllowMultipleVotes(childComplexity), true
+
+	case "Poll.closesAt":
+		if e.complexity.Poll.ClosesAt == nil {
+			break
+		}
+
+		return e.complexity.Poll.ClosesAt(childComplexity), true
+
+	case "Poll.isAnonymous":
+		if e.complexity.Poll.IsAnonymous == nil {
+			break
+		}
+
+		return e.complexity.Poll.IsAnonymous(childComplexity), true
+
+	case "Poll.isClosed":
+		if e.complexity.Poll.IsClosed == nil {
+			break
+		}
+
+		return e.complexity.Poll.IsClosed(childComplexity), true
+
+	case "Poll.options":
+		if e.complexity.Poll.Options == nil {
+			break
+		}
+
+		return e.complexity.Poll.Options(childComplexity), true
+
+	case "PollOption.id":
+		if e.complexity.PollOption.ID == nil {
+			break
+		}
+
+		return e.complexity.PollOption.ID(childComplexity), true
+
+	case "PollOption.meVoted":
+		if e.complexity.PollOption.MeVoted == nil {
+			break
+		}
+
+		return e.complexity.PollOption.MeVoted(childComplexity), true
+
+	case "PollOption.text":
+		if e.complexity.PollOption.Text == nil {
+			break
+		}
+
+		return e.complexity.PollOption.Text(childComplexity), true
+
+	case "PollOption.voteCount":
+		if e.complexity.PollOption.VoteCount == nil {
+			break
+		}
+
+		return e.complexity.PollOption.VoteCount(childComplexity), true
+
+	case "PollOption.voters":
+		if e.complexity.PollOption.Voters == nil {
+			break
+		}
+
+		return e.complexity.PollOption.Voters(childComplexity), true
+
 	case "Post.content":
 		if e.complexity.Post.Content == nil {
 			break
@@ -1286,6 +2078,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Post.Content(childComplexity), true
 
+	case "Post.contentHTML":
+		if e.complexity.Post.ContentHTML == nil {
+			break
+		}
+
+		return e.complexity.Post.ContentHTML(childComplexity), true
+
 	case "Post.createdAt":
 		if e.complexity.Post.CreatedAt == nil {
 			break
@@ -1307,6 +2106,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Post.Discussion(childComplexity), true
 
+	case "Post.editHistory":
+		if e.complexity.Post.EditHistory == nil {
+			break
+		}
+
+		return e.complexity.Post.EditHistory(childComplexity), true
+
 	case "Post.id":
 		if e.complexity.Post.ID == nil {
 			break
@@ -1321,6 +2127,27 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Post.IsDeleted(childComplexity), true
 
+	case "Post.isEdited":
+		if e.complexity.Post.IsEdited == nil {
+			break
+		}
+
+		return e.complexity.Post.IsEdited(childComplexity), true
+
+	case "Post.isPinned":
+		if e.complexity.Post.IsPinned == nil {
+			break
+		}
+
+		return e.complexity.Post.IsPinned(childComplexity), true
+
+	case "Post.linkPreviews":
+		if e.complexity.Post.LinkPreviews == nil {
+			break
+		}
+
+		return e.complexity.Post.LinkPreviews(childComplexity), true
+
 	case "Post.media":
 		if e.complexity.Post.Media == nil {
 			break
@@ -1335,6 +2162,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Post.MentionedEntities(childComplexity), true
 
+	case "Post.parentPostID":
+		if e.complexity.Post.ParentPostID == nil {
+			break
+		}
+
+		return e.complexity.Post.ParentPostID(childComplexity), true
+
 	case "Post.participant":
 		if e.complexity.Post.Participant == nil {
 			break
@@ -1342,6 +2176,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Post.Participant(childComplexity), true
 
+	case "Post.poll":
+		if e.complexity.Post.Poll == nil {
+			break
+		}
+
+		return e.complexity.Post.Poll(childComplexity), true
+
 	case "Post.postType":
 		if e.complexity.Post.PostType == nil {
 			break
@@ -1356,95 +2197,378 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Post.QuotedPost(childComplexity), true
 
-	case "Post.updatedAt":
-		if e.complexity.Post.UpdatedAt == nil {
+	case "Post.reactions":
+		if e.complexity.Post.Reactions == nil {
 			break
 		}
 
-		return e.complexity.Post.UpdatedAt(childComplexity), true
+		return e.complexity.Post.Reactions(childComplexity), true
 
-	case "PostsConnection.edges":
-		if e.complexity.PostsConnection.Edges == nil {
+	case "Post.repliesConnection":
+		if e.complexity.Post.RepliesConnection == nil {
 			break
 		}
 
-		return e.complexity.PostsConnection.Edges(childComplexity), true
+		args, err := ec.field_Post_repliesConnection_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "PostsConnection.pageInfo":
-		if e.complexity.PostsConnection.PageInfo == nil {
+		return e.complexity.Post.RepliesConnection(childComplexity, args["after"].(*string)), true
+
+	case "Post.replyCount":
+		if e.complexity.Post.ReplyCount == nil {
 			break
 		}
 
-		return e.complexity.PostsConnection.PageInfo(childComplexity), true
+		return e.complexity.Post.ReplyCount(childComplexity), true
 
-	case "PostsEdge.cursor":
-		if e.complexity.PostsEdge.Cursor == nil {
+	case "Post.tags":
+		if e.complexity.Post.Tags == nil {
 			break
 		}
 
-		return e.complexity.PostsEdge.Cursor(childComplexity), true
+		return e.complexity.Post.Tags(childComplexity), true
 
-	case "PostsEdge.node":
-		if e.complexity.PostsEdge.Node == nil {
+	case "Post.updatedAt":
+		if e.complexity.Post.UpdatedAt == nil {
 			break
 		}
 
-		return e.complexity.PostsEdge.Node(childComplexity), true
+		return e.complexity.Post.UpdatedAt(childComplexity), true
 
-	case "Query.discussion":
-		if e.complexity.Query.Discussion == nil {
+	case "PostBookmark.createdAt":
+		if e.complexity.PostBookmark.CreatedAt == nil {
 			break
 		}
 
-		args, err := ec.field_Query_discussion_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.PostBookmark.CreatedAt(childComplexity), true
+
+	case "PostBookmark.post":
+		if e.complexity.PostBookmark.Post == nil {
+			break
 		}
 
-		return e.complexity.Query.Discussion(childComplexity, args["id"].(string)), true
+		return e.complexity.PostBookmark.Post(childComplexity), true
 
-	case "Query.discussionByLinkSlug":
-		if e.complexity.Query.DiscussionByLinkSlug == nil {
+	case "PostBookmarksConnection.edges":
+		if e.complexity.PostBookmarksConnection.Edges == nil {
 			break
 		}
 
-		args, err := ec.field_Query_discussionByLinkSlug_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.PostBookmarksConnection.Edges(childComplexity), true
+
+	case "PostBookmarksConnection.pageInfo":
+		if e.complexity.PostBookmarksConnection.PageInfo == nil {
+			break
 		}
 
-		return e.complexity.Query.DiscussionByLinkSlug(childComplexity, args["slug"].(string)), true
+		return e.complexity.PostBookmarksConnection.PageInfo(childComplexity), true
 
-	case "Query.listDiscussions":
-		if e.complexity.Query.ListDiscussions == nil {
+	case "PostBookmarksEdge.cursor":
+		if e.complexity.PostBookmarksEdge.Cursor == nil {
 			break
 		}
 
-		args, err := ec.field_Query_listDiscussions_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.PostBookmarksEdge.Cursor(childComplexity), true
+
+	case "PostBookmarksEdge.node":
+		if e.complexity.PostBookmarksEdge.Node == nil {
+			break
 		}
 
-		return e.complexity.Query.ListDiscussions(childComplexity, args["state"].(model.DiscussionUserAccessState)), true
+		return e.complexity.PostBookmarksEdge.Node(childComplexity), true
 
-	case "Query.me":
-		if e.complexity.Query.Me == nil {
+	case "PostReactionSummary.count":
+		if e.complexity.PostReactionSummary.Count == nil {
 			break
 		}
 
-		return e.complexity.Query.Me(childComplexity), true
+		return e.complexity.PostReactionSummary.Count(childComplexity), true
 
-	case "Query.user":
-		if e.complexity.Query.User == nil {
+	case "PostReactionSummary.meReacted":
+		if e.complexity.PostReactionSummary.MeReacted == nil {
 			break
 		}
 
-		args, err := ec.field_Query_user_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.PostReactionSummary.MeReacted(childComplexity), true
+
+	case "PostReactionSummary.reaction":
+		if e.complexity.PostReactionSummary.Reaction == nil {
+			break
 		}
 
-		return e.complexity.Query.User(childComplexity, args["id"].(string)), true
+		return e.complexity.PostReactionSummary.Reaction(childComplexity), true
+
+	case "PostReport.action":
+		if e.complexity.PostReport.Action == nil {
+			break
+		}
+
+		return e.complexity.PostReport.Action(childComplexity), true
+
+	case "PostReport.createdAt":
+		if e.complexity.PostReport.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.PostReport.CreatedAt(childComplexity), true
+
+	case "PostReport.id":
+		if e.complexity.PostReport.ID == nil {
+			break
+		}
+
+		return e.complexity.PostReport.ID(childComplexity), true
+
+	case "PostReport.note":
+		if e.complexity.PostReport.Note == nil {
+			break
+		}
+
+		return e.complexity.PostReport.Note(childComplexity), true
+
+	case "PostReport.post":
+		if e.complexity.PostReport.Post == nil {
+			break
+		}
+
+		return e.complexity.PostReport.Post(childComplexity), true
+
+	case "PostReport.reason":
+		if e.complexity.PostReport.Reason == nil {
+			break
+		}
+
+		return e.complexity.PostReport.Reason(childComplexity), true
+
+	case "PostReport.resolvedAt":
+		if e.complexity.PostReport.ResolvedAt == nil {
+			break
+		}
+
+		return e.complexity.PostReport.ResolvedAt(childComplexity), true
+
+	case "PostReport.resolvedBy":
+		if e.complexity.PostReport.ResolvedBy == nil {
+			break
+		}
+
+		return e.complexity.PostReport.ResolvedBy(childComplexity), true
+
+	case "PostReport.status":
+		if e.complexity.PostReport.Status == nil {
+			break
+		}
+
+		return e.complexity.PostReport.Status(childComplexity), true
+
+	case "PostReportConnection.edges":
+		if e.complexity.PostReportConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.PostReportConnection.Edges(childComplexity), true
+
+	case "PostReportConnection.pageInfo":
+		if e.complexity.PostReportConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.PostReportConnection.PageInfo(childComplexity), true
+
+	case "PostReportEdge.cursor":
+		if e.complexity.PostReportEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.PostReportEdge.Cursor(childComplexity), true
+
+	case "PostReportEdge.node":
+		if e.complexity.PostReportEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.PostReportEdge.Node(childComplexity), true
+
+	case "PostsConnection.edges":
+		if e.complexity.PostsConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.PostsConnection.Edges(childComplexity), true
+
+	case "PostsConnection.pageInfo":
+		if e.complexity.PostsConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.PostsConnection.PageInfo(childComplexity), true
+
+	case "PostsEdge.cursor":
+		if e.complexity.PostsEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.PostsEdge.Cursor(childComplexity), true
+
+	case "PostsEdge.highlight":
+		if e.complexity.PostsEdge.Highlight == nil {
+			break
+		}
+
+		return e.complexity.PostsEdge.Highlight(childComplexity), true
+
+	case "PostsEdge.node":
+		if e.complexity.PostsEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.PostsEdge.Node(childComplexity), true
+
+	case "Query.discussion":
+		if e.complexity.Query.Discussion == nil {
+			break
+		}
+
+		args, err := ec.field_Query_discussion_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Discussion(childComplexity, args["id"].(string)), true
+
+	case "Query.discussionByLinkSlug":
+		if e.complexity.Query.DiscussionByLinkSlug == nil {
+			break
+		}
+
+		args, err := ec.field_Query_discussionByLinkSlug_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.DiscussionByLinkSlug(childComplexity, args["slug"].(string)), true
+
+	case "Query.listDiscussions":
+		if e.complexity.Query.ListDiscussions == nil {
+			break
+		}
+
+		args, err := ec.field_Query_listDiscussions_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.ListDiscussions(childComplexity, args["state"].(model.DiscussionUserAccessState)), true
+
+	case "Query.me":
+		if e.complexity.Query.Me == nil {
+			break
+		}
+
+		return e.complexity.Query.Me(childComplexity), true
+
+	case "Query.searchMyDiscussions":
+		if e.complexity.Query.SearchMyDiscussions == nil {
+			break
+		}
+
+		args, err := ec.field_Query_searchMyDiscussions_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.SearchMyDiscussions(childComplexity, args["query"].(string), args["after"].(*string)), true
+
+	case "Query.user":
+		if e.complexity.Query.User == nil {
+			break
+		}
+
+		args, err := ec.field_Query_user_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.User(childComplexity, args["id"].(string)), true
+
+	case "ScheduledPost.content":
+		if e.complexity.ScheduledPost.Content == nil {
+			break
+		}
+
+		return e.complexity.ScheduledPost.Content(childComplexity), true
+
+	case "ScheduledPost.createdAt":
+		if e.complexity.ScheduledPost.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.ScheduledPost.CreatedAt(childComplexity), true
+
+	case "ScheduledPost.discussion":
+		if e.complexity.ScheduledPost.Discussion == nil {
+			break
+		}
+
+		return e.complexity.ScheduledPost.Discussion(childComplexity), true
+
+	case "ScheduledPost.failureReason":
+		if e.complexity.ScheduledPost.FailureReason == nil {
+			break
+		}
+
+		return e.complexity.ScheduledPost.FailureReason(childComplexity), true
+
+	case "ScheduledPost.id":
+		if e.complexity.ScheduledPost.ID == nil {
+			break
+		}
+
+		return e.complexity.ScheduledPost.ID(childComplexity), true
+
+	case "ScheduledPost.participant":
+		if e.complexity.ScheduledPost.Participant == nil {
+			break
+		}
+
+		return e.complexity.ScheduledPost.Participant(childComplexity), true
+
+	case "ScheduledPost.post":
+		if e.complexity.ScheduledPost.Post == nil {
+			break
+		}
+
+		return e.complexity.ScheduledPost.Post(childComplexity), true
+
+	case "ScheduledPost.postType":
+		if e.complexity.ScheduledPost.PostType == nil {
+			break
+		}
+
+		return e.complexity.ScheduledPost.PostType(childComplexity), true
+
+	case "ScheduledPost.publishAt":
+		if e.complexity.ScheduledPost.PublishAt == nil {
+			break
+		}
+
+		return e.complexity.ScheduledPost.PublishAt(childComplexity), true
+
+	case "ScheduledPost.status":
+		if e.complexity.ScheduledPost.Status == nil {
+			break
+		}
+
+		return e.complexity.ScheduledPost.Status(childComplexity), true
+
+	case "ScheduledPost.updatedAt":
+		if e.complexity.ScheduledPost.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.ScheduledPost.UpdatedAt(childComplexity), true
 
 	case "Subscription.onDiscussionEvent":
 		if e.complexity.Subscription.OnDiscussionEvent == nil {
@@ -1456,7 +2580,7 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Subscription.OnDiscussionEvent(childComplexity, args["discussionID"].(string)), true
+		return e.complexity.Subscription.OnDiscussionEvent(childComplexity, args["discussionID"].(string), args["afterSequence"].(*int)), true
 
 	case "Subscription.postAdded":
 		if e.complexity.Subscription.PostAdded == nil {
@@ -1470,6 +2594,34 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Subscription.PostAdded(childComplexity, args["discussionID"].(string)), true
 
+	case "Tag.id":
+		if e.complexity.Tag.ID == nil {
+			break
+		}
+
+		return e.complexity.Tag.ID(childComplexity), true
+
+	case "Tag.name":
+		if e.complexity.Tag.Name == nil {
+			break
+		}
+
+		return e.complexity.Tag.Name(childComplexity), true
+
+	case "TagSummary.name":
+		if e.complexity.TagSummary.Name == nil {
+			break
+		}
+
+		return e.complexity.TagSummary.Name(childComplexity), true
+
+	case "TagSummary.postCount":
+		if e.complexity.TagSummary.PostCount == nil {
+			break
+		}
+
+		return e.complexity.TagSummary.PostCount(childComplexity), true
+
 	case "URL.displayText":
 		if e.complexity.URL.DisplayText == nil {
 			break
@@ -1491,6 +2643,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.UnknownEntity.ID(childComplexity), true
 
+	case "User.bookmarks":
+		if e.complexity.User.Bookmarks == nil {
+			break
+		}
+
+		args, err := ec.field_User_bookmarks_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.User.Bookmarks(childComplexity, args["after"].(*string)), true
+
 	case "User.devices":
 		if e.complexity.User.Devices == nil {
 			break
@@ -1545,6 +2709,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.User.SentDiscussionAccessRequests(childComplexity), true
 
+	case "User.totalUnreadCount":
+		if e.complexity.User.TotalUnreadCount == nil {
+			break
+		}
+
+		return e.complexity.User.TotalUnreadCount(childComplexity), true
+
 	case "User.viewers":
 		if e.complexity.User.Viewers == nil {
 			break
@@ -1759,7 +2930,7 @@ func (ec *executionContext) introspectType(name string) (*introspection.Type, er
 }
 
 var sources = []*ast.Source{
-	&ast.Source{Name: "graph/types/discussion.graphqls", Input: `type Discussion implements Entity {
+	&ast.Source{Name: "graph/types/discussion.graphqls", Input: `type Discussion implements Entity & DiscussionSubscriptionEntity {
     # Unique id for this discussion
     id: ID!
     # We do not link to the user themselves, only the moderator view of a user.
@@ -1770,7 +2941,20 @@ var sources = []*ast.Source{
     
     # A link to all posts in the discussion, ordered chronologically.
     posts: [Post!]
-    postsConnection(after: ID): PostsConnection!
+    # Edges are always newest first. Page with first/after towards older posts or
+    # last/before towards newer ones. ` + "`" + `around` + "`" + ` takes a post ID, e.g. the viewer's
+    # lastViewedPost, and returns that post with up to ` + "`" + `last` + "`" + ` newer and ` + "`" + `first` + "`" + `
+    # older posts around it.
+    postsConnection(after: ID, before: ID, around: ID, first: Int, last: Int, topLevelOnly: Boolean = false): PostsConnection!
+    # Posts pinned by a moderator, most recently pinned first.
+    pinnedPosts: [Post!]!
+    # Full-text search over the content of non-deleted posts, newest first.
+    searchPosts(query: String!, after: ID): PostsConnection!
+    # Non-deleted posts whose current content mentions the tag, newest first.
+    # The tag may be given with or without the leading '#'.
+    postsByTag(tag: String!, after: ID): PostsConnection!
+    # The most used tags in the discussion.
+    topTags(limit: Int = 10): [TagSummary!]!
 
     iconURL: String
 
@@ -1795,11 +2979,26 @@ var sources = []*ast.Source{
     meCanJoinDiscussion: CanJoinDiscussionResponse!
 
     meViewer: Viewer
+    # Posts by others newer than meViewer's lastViewedPost.
+    meUnreadCount: Int!
+    # The unread posts that mention one of my participants, @everyone, or
+    # @moderator when I moderate the discussion.
+    meUnreadMentionCount: Int!
     # Notification setting for logged in user
     meNotificationSettings: DiscussionUserNotificationSetting
     meDiscussionStatus: DiscussionUserAccessState
+    # Why I was banned, null unless I am banned with a reason
+    meBanReason: String
+    # Null unless I own, moderate or help in this discussion
+    meModeratorRole: ModeratorRole
 
     accessRequests: [DiscussionAccessRequest!]
+    # Pending scheduled posts, soonest first. Moderator only.
+    scheduledPosts: [ScheduledPost!]
+    # Actions taken by moderators, newest first. Moderator only.
+    moderationLog(after: ID): ModerationLogConnection!
+    # Reports filed on posts, newest first. Helpers and above.
+    reports(status: PostReportStatus = OPEN, after: ID): PostReportConnection!
 
     discussionAccessLink: DiscussionAccessLink
 
@@ -1810,6 +3009,15 @@ var sources = []*ast.Source{
 
     lockStatus: Boolean!
 
+    # Who may notify the whole discussion with an @everyone mention.
+    everyoneMentionPolicy: EveryoneMentionPolicy!
+
+    # Minimum seconds between posts by the same user, 0 when slow mode is off.
+    # The wait is shared by the user's anonymous and non-anonymous
+    # participants so switching between them does not skip it. Moderators
+    # are exempt.
+    slowModeSeconds: Int!
+
     archive: DiscussionArchive
 }
 
@@ -1829,7 +3037,7 @@ type DiscussionArchive {
     createdAt: Time!
 }
 
-type DiscussionAccessRequest {
+type DiscussionAccessRequest implements DiscussionSubscriptionEntity {
     id: ID!
     userProfile: UserProfile
     discussion: Discussion!
@@ -1873,13 +3081,49 @@ type DiscussionUserAccess{
     id: ID!
 }
 
+# The entity sent with each event is:
+#   POST_*: Post
+#   PARTICIPANT_*: Participant
+#   DISCUSSION_* and SHUFFLE_*: Discussion
+#   ACCESS_REQUEST_*: DiscussionAccessRequest
+# POST_ADDED is also sent for each post restored when a participant is unbanned.
 enum DiscussionSubscriptionEventType {
     POST_ADDED,
     POST_DELETED,
-    PARTICIPANT_BANNED
+    POST_EDITED,
+    POST_REACTION_ADDED,
+    POST_REACTION_REMOVED,
+    POST_PINNED,
+    POST_UNPINNED,
+    # A vote was cast, changed or retracted on a poll
+    POST_POLL_VOTED,
+    # Link previews were fetched after the post was created or edited
+    POST_LINK_PREVIEWS_UPDATED,
+    PARTICIPANT_BANNED,
+    PARTICIPANT_UNBANNED,
+    PARTICIPANT_JOINED,
+    PARTICIPANT_MUTED,
+    PARTICIPANT_UNMUTED,
+    # A moderator role was granted or revoked
+    PARTICIPANT_ROLE_UPDATED,
+    # Title, description, icon, anonymity, joinability, @everyone policy, slow mode or owner changed
+    DISCUSSION_UPDATED,
+    DISCUSSION_LOCKED,
+    DISCUSSION_UNLOCKED,
+    # The next shuffle time was set or cleared
+    SHUFFLE_SCHEDULED,
+    SHUFFLE_COMPLETED,
+    ACCESS_REQUEST_CREATED,
+    # The request was accepted, rejected or cancelled
+    ACCESS_REQUEST_UPDATED
 }
 
 type DiscussionSubscriptionEvent {
+    # Increases across events, but events can arrive slightly out of order.
+    # Pass the highest sequence seen as afterSequence when resubscribing to
+    # replay anything that was missed. The replay starts a few sequences
+    # early to catch late events, so ignore sequences already seen.
+    sequence: Int!
     eventType: DiscussionSubscriptionEventType!
     entity: DiscussionSubscriptionEntity!
 }`, BuiltIn: false},
@@ -1889,7 +3133,26 @@ type DiscussionSubscriptionEvent {
 
 type UnknownEntity implements Entity{
     id: ID!
-}`, BuiltIn: false},
+}
+# A hashtag, mentioned as a ` + "`" + `tag:<name>` + "`" + ` entity. The id is the name.
+type Tag implements Entity {
+    id: ID!
+    name: String!
+}
+
+type TagSummary {
+    name: String!
+    # Non-deleted posts in the discussion whose current content has the tag.
+    postCount: Int!
+}
+
+# Mentioned as a ` + "`" + `group:everyone` + "`" + ` or ` + "`" + `group:moderator` + "`" + ` entity. The id is the
+# group name as it appears in the entity.
+type GroupMention implements Entity {
+    id: ID!
+    group: MentionGroup!
+}
+`, BuiltIn: false},
 	&ast.Source{Name: "graph/types/enums.graphqls", Input: `enum AnonymityType {
     UNKNOWN
     WEAK
@@ -1921,6 +3184,8 @@ enum PostDeletedReason {
     UNKNOWN
     MODERATOR_REMOVED
     PARTICIPANT_REMOVED
+    # Hidden when the author was banned. Unbanning can restore these.
+    PARTICIPANT_BANNED
 }
 
 enum Platform {
@@ -1934,7 +3199,8 @@ enum PostType {
     STANDARD,
     IMPORTED_CONTENT,
     ALERT,
-    CONCIERGE
+    CONCIERGE,
+    POLL
 }
 
 enum InviteRequestStatus {
@@ -1949,6 +3215,19 @@ enum DiscussionJoinabilitySetting {
     ALL_REQUIRE_APPROVAL,
 }
 
+enum EveryoneMentionPolicy {
+    MODERATORS,
+    PARTICIPANTS,
+    NOBODY,
+}
+
+enum MentionGroup {
+    # Every participant with MENTIONS notifications
+    EVERYONE,
+    # The discussion's moderator
+    MODERATOR,
+}
+
 enum DiscussionJoinabilityResponse {
     ALREADY_JOINED,
     APPROVED_NOT_JOINED,
@@ -1968,7 +3247,103 @@ enum DiscussionUserNotificationSetting {
     NONE,
     MENTIONS,
     EVERYTHING
-}`, BuiltIn: false},
+}
+
+# A due post is PUBLISHING from the moment it is claimed until it is published
+# or fails, so it is never published twice.
+enum ScheduledPostStatus {
+    PENDING,
+    PUBLISHING,
+    PUBLISHED,
+    CANCELLED,
+    FAILED
+}
+# Roles a user can hold in a discussion, from most to least privileged.
+# Helpers can only mute and unmute.
+enum ModeratorRole {
+    OWNER,
+    MODERATOR,
+    HELPER
+}
+
+# Set as the "code" extension on errors for rejected post mentions
+enum MentionErrorCode {
+    # The <n> tokens in the text do not line up with mentionedEntities
+    TOKEN_MISMATCH,
+    MALFORMED_ENTITY,
+    UNKNOWN_ENTITY,
+    # A participant mentioned from another discussion
+    CROSS_DISCUSSION,
+    BANNED_PARTICIPANT,
+    # A group mention the discussion's policy does not allow
+    NOT_ALLOWED,
+}
+
+enum ModerationAction {
+    BAN_PARTICIPANT,
+    UNBAN_PARTICIPANT,
+    MUTE_PARTICIPANT,
+    UNMUTE_PARTICIPANT,
+    DELETE_POST,
+    PIN_POST,
+    UNPIN_POST,
+    LOCK_DISCUSSION,
+    UNLOCK_DISCUSSION,
+    # Title, description, icon, anonymity, joinability, @everyone policy or slow mode changed
+    UPDATE_DISCUSSION,
+    SCHEDULE_SHUFFLE,
+    # Recorded against the concierge user when a scheduled shuffle runs
+    SHUFFLE_DISCUSSION,
+    ACCEPT_ACCESS_REQUEST,
+    REJECT_ACCESS_REQUEST,
+    GRANT_MODERATOR_ROLE,
+    REVOKE_MODERATOR_ROLE,
+    TRANSFER_OWNERSHIP,
+    DISMISS_POST_REPORT
+}
+
+# What the targetID of a moderation log entry refers to
+enum ModerationTargetType {
+    PARTICIPANT,
+    POST,
+    DISCUSSION,
+    ACCESS_REQUEST,
+    USER,
+    POST_REPORT
+}
+
+enum PostReportReason {
+    SPAM,
+    HARASSMENT,
+    HATE_SPEECH,
+    MISINFORMATION,
+    OFF_TOPIC,
+    OTHER
+}
+
+enum PostReportStatus {
+    OPEN,
+    DISMISSED,
+    # Resolved with a PostReportAction
+    ACTIONED
+}
+
+enum PostReportAction {
+    DELETE_POST,
+    MUTE_AUTHOR,
+    BAN_AUTHOR
+}
+`, BuiltIn: false},
+	&ast.Source{Name: "graph/types/link_preview.graphqls", Input: `# Metadata for a link in a post, fetched by the server so the poster is never
+# revealed to the linked site.
+type LinkPreview {
+    url: String!
+    title: String
+    description: String
+    imageURL: String
+    siteName: String
+}
+`, BuiltIn: false},
 	&ast.Source{Name: "graph/types/media.graphqls", Input: `# Maybe make a basePost interface and extend
 # Media can also just be data on a post
 type Media {
@@ -1986,17 +3361,39 @@ type MediaSize {
     width: Int!
     sizeKb: Float! # Should this be in bytes, kbs, something else?
 }`, BuiltIn: false},
-	&ast.Source{Name: "graph/types/moderator.graphqls", Input: `type Moderator {
+	&ast.Source{Name: "graph/types/moderation_log.graphqls", Input: `type ModerationLogEntry {
     id: ID!
+    # The moderator who took the action
+    actor: UserProfile
+    action: ModerationAction!
+    targetType: ModerationTargetType!
+    targetID: ID
+    reason: String
+    createdAt: Time!
+}
 
-    discussion: Discussion
-    
-    userProfile: UserProfile!
-}`, BuiltIn: false},
-	&ast.Source{Name: "graph/types/page_info.graphqls", Input: `type PageInfo {
-    startCursor: ID
+type ModerationLogEdge {
+    cursor: ID!
+    node: ModerationLogEntry
+}
+
+type ModerationLogConnection {
+    edges: [ModerationLogEdge!]
+    pageInfo: PageInfo!
+}
+`, BuiltIn: false},
+	&ast.Source{Name: "graph/types/moderator.graphqls", Input: `type Moderator {
+    id: ID!
+
+    discussion: Discussion
+    
+    userProfile: UserProfile!
+}`, BuiltIn: false},
+	&ast.Source{Name: "graph/types/page_info.graphqls", Input: `type PageInfo {
+    startCursor: ID
     endCursor: ID
     hasNextPage: Boolean!
+    hasPreviousPage: Boolean!
 }`, BuiltIn: false},
 	&ast.Source{Name: "graph/types/participant.graphqls", Input: `type Participant implements Entity & DiscussionSubscriptionEntity {
     # The UUID for this participant.
@@ -2029,6 +3426,15 @@ type MediaSize {
     # to avoid any timezone or clock time skew problems. The seconds
     # are calculated relatively to the timestamp of the query.
     mutedForSeconds: Int
+
+    # Seconds the viewer has to wait before posting again because of the
+    # discussion's slow mode, 0 if they can post now. The same for both of the
+    # viewer's participants. Null for participants that are not the viewer's.
+    meSecondsUntilCanPost: Int
+
+    # The role of the user behind this participant, null for regular
+    # participants. Anonymous participants only show it to their own user.
+    moderatorRole: ModeratorRole
 }
 `, BuiltIn: false},
 	&ast.Source{Name: "graph/types/participant_profile.graphqls", Input: `type ParticipantProfile {
@@ -2044,21 +3450,112 @@ type MediaSize {
     cursor: ID!
     node: Participant
 }`, BuiltIn: false},
+	&ast.Source{Name: "graph/types/poll.graphqls", Input: `# Attached to POLL posts. The post content is the question.
+type Poll {
+    allowMultipleVotes: Boolean!
+    # Voters are never revealed for anonymous polls, only the tallies.
+    isAnonymous: Boolean!
+    closesAt: Time
+    isClosed: Boolean!
+    options: [PollOption!]!
+}
+
+type PollOption {
+    id: ID!
+    text: String!
+    voteCount: Int!
+    # Whether the viewer voted for this option as either of their participants
+    # in the discussion.
+    meVoted: Boolean!
+    # Empty for anonymous polls.
+    voters: [Participant!]!
+}
+`, BuiltIn: false},
 	&ast.Source{Name: "graph/types/post.graphqls", Input: `type Post implements DiscussionSubscriptionEntity {
     id: ID!
     isDeleted: Boolean!
     deletedReasonCode: PostDeletedReason
+    # Raw text as the author wrote it.
     content: String
+    # The content's Markdown subset (bold, italics, code, links and block quotes)
+    # rendered as sanitized HTML. Mention tokens become <span data-mention="n">.
+    contentHTML: String
     discussion: Discussion!
     participant: Participant
     createdAt: String!
     updatedAt: String!
     quotedPost: Post
     mentionedEntities: [Entity!]
+    # Names of the tags mentioned in the content, without the leading '#'.
+    tags: [String!]!
     media: Media
     postType: PostType!
+    isEdited: Boolean!
+    # Prior revisions of the content, oldest first.
+    editHistory: [HistoricalString!]
+    reactions: [PostReactionSummary!]!
+    isPinned: Boolean!
+    # Only set for POLL posts
+    poll: Poll
+    # Filled in shortly after the post is created, see POST_LINK_PREVIEWS_UPDATED.
+    linkPreviews: [LinkPreview!]!
+
+    # Threads
+    parentPostID: ID
+    # Deleted replies are left out of both the count and the connection.
+    replyCount: Int!
+    repliesConnection(after: ID): PostsConnection!
+}
+
+`, BuiltIn: false},
+	&ast.Source{Name: "graph/types/post_bookmark.graphqls", Input: `# A post the user has bookmarked. Bookmarks are only visible to the user who
+# made them. Deleted posts are kept as tombstones until unbookmarked.
+type PostBookmark {
+    post: Post!
+    createdAt: Time!
+}
+
+type PostBookmarksEdge {
+    cursor: ID!
+    node: PostBookmark
+}
+
+type PostBookmarksConnection {
+    edges: [PostBookmarksEdge!]
+    pageInfo: PageInfo!
+}
+`, BuiltIn: false},
+	&ast.Source{Name: "graph/types/post_reaction_summary.graphqls", Input: `type PostReactionSummary {
+    reaction: String!
+    count: Int!
+    # Whether the viewer's meParticipant in the discussion left this reaction.
+    meReacted: Boolean!
+}
+`, BuiltIn: false},
+	&ast.Source{Name: "graph/types/post_report.graphqls", Input: `# Reporters are not shown to moderators.
+type PostReport {
+    id: ID!
+    post: Post
+    reason: PostReportReason!
+    note: String
+    status: PostReportStatus!
+    # Set once the report is actioned
+    action: PostReportAction
+    # Only shown to moderators
+    resolvedBy: UserProfile
+    resolvedAt: Time
+    createdAt: Time!
+}
+
+type PostReportEdge {
+    cursor: ID!
+    node: PostReport
 }
 
+type PostReportConnection {
+    edges: [PostReportEdge!]
+    pageInfo: PageInfo!
+}
 `, BuiltIn: false},
 	&ast.Source{Name: "graph/types/posts_connection.graphqls", Input: `type PostsConnection {
     edges: [PostsEdge!]
@@ -2067,7 +3564,29 @@ type MediaSize {
 	&ast.Source{Name: "graph/types/posts_edge.graphqls", Input: `type PostsEdge {
     cursor: ID!
     node: Post
+    # Only set for search results: an HTML-escaped excerpt of the post content
+    # with the matched terms wrapped in <b></b>.
+    highlight: String
 }`, BuiltIn: false},
+	&ast.Source{Name: "graph/types/scheduled_post.graphqls", Input: `# A post queued by a moderator to be published at publishAt. It is published
+# through the same path as addPost so the ban, mute and lock checks apply at
+# publish time.
+type ScheduledPost {
+    id: ID!
+    discussion: Discussion!
+    participant: Participant
+    content: String!
+    postType: PostType!
+    publishAt: Time!
+    status: ScheduledPostStatus!
+    # Set once the post is published.
+    post: Post
+    # Why publishing failed, if it did.
+    failureReason: String
+    createdAt: Time!
+    updatedAt: Time!
+}
+`, BuiltIn: false},
 	&ast.Source{Name: "graph/types/schema.graphqls", Input: `schema {
   query: Query
   mutation: Mutation
@@ -2082,6 +3601,8 @@ type Query {
   # Need to add verification that the caller is the user.
   user(id: ID!): User!
   me: User!
+  # Searches posts across every discussion the caller has ACTIVE access to, newest first.
+  searchMyDiscussions(query: String!, after: ID): PostsConnection!
 
 }
 
@@ -2104,7 +3625,18 @@ input PostContentInput {
   mentionedEntities:[String!],
   quotedPostID: ID,
   mediaID: ID,
-  preview: String
+  preview: String,
+  # Posts a reply in the thread of this post
+  parentPostID: ID
+  # Required for POLL posts, whose postText is the question
+  poll: PollInput
+}
+
+input PollInput {
+  options: [String!]!
+  allowMultipleVotes: Boolean
+  isAnonymous: Boolean
+  closesAt: Time
 }
 
 input DiscussionInput {
@@ -2115,6 +3647,9 @@ input DiscussionInput {
   iconURL: String
   discussionJoinability: DiscussionJoinabilitySetting
   lockStatus: Boolean
+  everyoneMentionPolicy: EveryoneMentionPolicy
+  # Between 0 (off) and 21600
+  slowModeSeconds: Int
 }
 
 input DiscussionCreationSettings {
@@ -2148,23 +3683,69 @@ type Mutation {
 
   # Posts
   deletePost(discussionID: ID!, postID: ID!): Post!
-
-  # Banning
-  banParticipant(discussionID: ID!, participantID: ID!): Participant!
+  # Only the author can edit their post. The replaced content is kept in ` + "`" + `editHistory` + "`" + `.
+  editPost(discussionID: ID!, postID: ID!, postContent: PostContentInput!): Post!
+
+  # Scheduled posts (moderator only)
+  schedulePost(discussionID: ID!, participantID: ID!, postContent: PostContentInput!, publishAt: Time!): ScheduledPost!
+  # Only pending scheduled posts can be cancelled.
+  cancelScheduledPost(scheduledPostID: ID!): ScheduledPost!
+
+  # Reactions
+  addReaction(discussionID: ID!, participantID: ID!, postID: ID!, reaction: String!): Post!
+  removeReaction(discussionID: ID!, participantID: ID!, postID: ID!, reaction: String!): Post!
+
+  # Polls
+  # Replaces the participant's votes on the poll. Passing no options retracts the vote.
+  votePoll(discussionID: ID!, participantID: ID!, postID: ID!, optionIDs: [ID!]!): Post!
+
+  # Pinning (moderator only)
+  pinPost(discussionID: ID!, postID: ID!): Post!
+  unpinPost(discussionID: ID!, postID: ID!): Post!
+
+  # Bookmarks
+  bookmarkPost(discussionID: ID!, postID: ID!): Post!
+  unbookmarkPost(discussionID: ID!, postID: ID!): Post!
+
+  # Reports. Filing a report notifies the moderators, and reporting the same
+  # post again returns the earlier report. Resolving a report resolves every
+  # open report on its post.
+  reportPost(discussionID: ID!, postID: ID!, reason: PostReportReason!, note: String): PostReport!
+  dismissPostReport(discussionID: ID!, reportID: ID!): PostReport!
+  # mutedForSeconds is required for MUTE_AUTHOR
+  actionPostReport(discussionID: ID!, reportID: ID!, action: PostReportAction!, mutedForSeconds: Int): PostReport!
+
+  # Banning. The reason is shown to the banned user. Unless keepPosts is set
+  # the participant's posts are deleted, and unbanning with restorePosts
+  # brings them back.
+  banParticipant(discussionID: ID!, participantID: ID!, reason: String, keepPosts: Boolean = false): Participant!
+  unbanParticipant(discussionID: ID!, participantID: ID!, restorePosts: Boolean = false): Participant!
 
   shuffleDiscussion(discussionID: ID!, inFutureSeconds: Int): Discussion!
 
   # Viewer
   setLastPostViewed(viewerID: ID!, postID: ID!): Viewer!
+  # Moves all of my viewers to the latest post of their discussion.
+  markAllDiscussionsRead: [Viewer!]!
 
   # Muting
   muteParticipants(discussionID: ID!, participantIDs: [ID!]!, mutedForSeconds: Int!): [Participant!]!
   unmuteParticipants(discussionID: ID!, participantIDs: [ID!]!): [Participant!]!
+
+  # Moderator roles. The owner can grant MODERATOR or HELPER and moderators
+  # can grant HELPER. Only holders of a lower role can be changed.
+  grantModeratorRole(discussionID: ID!, participantID: ID!, role: ModeratorRole!): Participant!
+  revokeModeratorRole(discussionID: ID!, participantID: ID!): Participant!
+
+  # Hands the discussion to a user with active access. Only the owner may
+  # transfer unless they have lost access. The previous owner stays on as a
+  # moderator.
+  transferDiscussionOwnership(discussionID: ID!, toUserID: ID!): Discussion!
 }
 
 type Subscription {
   postAdded(discussionID: String!): Post
-  onDiscussionEvent(discussionID: String!): DiscussionSubscriptionEvent
+  onDiscussionEvent(discussionID: String!, afterSequence: Int): DiscussionSubscriptionEvent
 }
 `, BuiltIn: false},
 	&ast.Source{Name: "graph/types/sudo_user.graphqls", Input: `# A SudoUser describes the unlocked version of a user. Due to implementation
@@ -2206,6 +3787,10 @@ type User {
     moderatedDiscussions: [Discussion!]
 
     discussions(state: DiscussionUserAccessState! = ACTIVE): [Discussion!]
+    # Sum of meUnreadCount across the user's ACTIVE discussions.
+    totalUnreadCount: Int!
+    # Most recently bookmarked first. Only visible to the user themselves.
+    bookmarks(after: ID): PostBookmarksConnection!
     sentDiscussionAccessRequests: [DiscussionAccessRequest!]
 }
 `, BuiltIn: false},
@@ -2254,7 +3839,7 @@ var parsedSchema = gqlparser.MustLoadSchema(sources...)
 
 // region    ***************************** args.gotpl *****************************
 
-func (ec *executionContext) field_Discussion_postsConnection_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Discussion_moderationLog_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
 	var arg0 *string
@@ -2268,135 +3853,141 @@ func (ec *executionContext) field_Discussion_postsConnection_args(ctx context.Co
 	return args, nil
 }
 
-func (ec *executionContext) field_Mutation_addDiscussionParticipant_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Discussion_postsByTag_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
 	var arg0 string
-	if tmp, ok := rawArgs["discussionID"]; ok {
+	if tmp, ok := rawArgs["tag"]; ok {
 		arg0, err = ec.unmarshalNString2string(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["discussionID"] = arg0
-	var arg1 string
-	if tmp, ok := rawArgs["userID"]; ok {
-		arg1, err = ec.unmarshalNString2string(ctx, tmp)
-		if err != nil {
-			return nil, err
-		}
-	}
-	args["userID"] = arg1
-	var arg2 model.AddDiscussionParticipantInput
-	if tmp, ok := rawArgs["discussionParticipantInput"]; ok {
-		arg2, err = ec.unmarshalNAddDiscussionParticipantInput2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐAddDiscussionParticipantInput(ctx, tmp)
+	args["tag"] = arg0
+	var arg1 *string
+	if tmp, ok := rawArgs["after"]; ok {
+		arg1, err = ec.unmarshalOID2ᚖstring(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["discussionParticipantInput"] = arg2
+	args["after"] = arg1
 	return args, nil
 }
 
-func (ec *executionContext) field_Mutation_addPost_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Discussion_postsConnection_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	var arg0 string
-	if tmp, ok := rawArgs["discussionID"]; ok {
-		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+	var arg0 *string
+	if tmp, ok := rawArgs["after"]; ok {
+		arg0, err = ec.unmarshalOID2ᚖstring(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["discussionID"] = arg0
-	var arg1 string
-	if tmp, ok := rawArgs["participantID"]; ok {
-		arg1, err = ec.unmarshalNID2string(ctx, tmp)
+	args["after"] = arg0
+	var arg1 *string
+	if tmp, ok := rawArgs["before"]; ok {
+		arg1, err = ec.unmarshalOID2ᚖstring(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["participantID"] = arg1
-	var arg2 model.PostContentInput
-	if tmp, ok := rawArgs["postContent"]; ok {
-		arg2, err = ec.unmarshalNPostContentInput2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPostContentInput(ctx, tmp)
+	args["before"] = arg1
+	var arg2 *string
+	if tmp, ok := rawArgs["around"]; ok {
+		arg2, err = ec.unmarshalOID2ᚖstring(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["postContent"] = arg2
-	return args, nil
-}
-
-func (ec *executionContext) field_Mutation_banParticipant_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	var err error
-	args := map[string]interface{}{}
-	var arg0 string
-	if tmp, ok := rawArgs["discussionID"]; ok {
-		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+	args["around"] = arg2
+	var arg3 *int
+	if tmp, ok := rawArgs["first"]; ok {
+		arg3, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["discussionID"] = arg0
-	var arg1 string
-	if tmp, ok := rawArgs["participantID"]; ok {
-		arg1, err = ec.unmarshalNID2string(ctx, tmp)
+	args["first"] = arg3
+	var arg4 *int
+	if tmp, ok := rawArgs["last"]; ok {
+		arg4, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["participantID"] = arg1
+	args["last"] = arg4
+	var arg5 *bool
+	if tmp, ok := rawArgs["topLevelOnly"]; ok {
+		arg5, err = ec.unmarshalOBoolean2ᚖbool(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["topLevelOnly"] = arg5
 	return args, nil
 }
 
-func (ec *executionContext) field_Mutation_createDiscussion_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Discussion_reports_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	var arg0 model.AnonymityType
-	if tmp, ok := rawArgs["anonymityType"]; ok {
-		arg0, err = ec.unmarshalNAnonymityType2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐAnonymityType(ctx, tmp)
+	var arg0 *model.PostReportStatus
+	if tmp, ok := rawArgs["status"]; ok {
+		arg0, err = ec.unmarshalOPostReportStatus2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPostReportStatus(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["anonymityType"] = arg0
-	var arg1 string
-	if tmp, ok := rawArgs["title"]; ok {
-		arg1, err = ec.unmarshalNString2string(ctx, tmp)
+	args["status"] = arg0
+	var arg1 *string
+	if tmp, ok := rawArgs["after"]; ok {
+		arg1, err = ec.unmarshalOID2ᚖstring(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["title"] = arg1
-	var arg2 *string
-	if tmp, ok := rawArgs["description"]; ok {
-		arg2, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+	args["after"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Discussion_searchPosts_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["query"]; ok {
+		arg0, err = ec.unmarshalNString2string(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["description"] = arg2
-	var arg3 *bool
-	if tmp, ok := rawArgs["publicAccess"]; ok {
-		arg3, err = ec.unmarshalOBoolean2ᚖbool(ctx, tmp)
+	args["query"] = arg0
+	var arg1 *string
+	if tmp, ok := rawArgs["after"]; ok {
+		arg1, err = ec.unmarshalOID2ᚖstring(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["publicAccess"] = arg3
-	var arg4 model.DiscussionCreationSettings
-	if tmp, ok := rawArgs["discussionSettings"]; ok {
-		arg4, err = ec.unmarshalNDiscussionCreationSettings2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussionCreationSettings(ctx, tmp)
+	args["after"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Discussion_topTags_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *int
+	if tmp, ok := rawArgs["limit"]; ok {
+		arg0, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["discussionSettings"] = arg4
+	args["limit"] = arg0
 	return args, nil
 }
 
-func (ec *executionContext) field_Mutation_deletePost_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Mutation_actionPostReport_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
 	var arg0 string
@@ -2408,47 +3999,63 @@ func (ec *executionContext) field_Mutation_deletePost_args(ctx context.Context,
 	}
 	args["discussionID"] = arg0
 	var arg1 string
-	if tmp, ok := rawArgs["postID"]; ok {
+	if tmp, ok := rawArgs["reportID"]; ok {
 		arg1, err = ec.unmarshalNID2string(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["postID"] = arg1
+	args["reportID"] = arg1
+	var arg2 model.PostReportAction
+	if tmp, ok := rawArgs["action"]; ok {
+		arg2, err = ec.unmarshalNPostReportAction2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPostReportAction(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["action"] = arg2
+	var arg3 *int
+	if tmp, ok := rawArgs["mutedForSeconds"]; ok {
+		arg3, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["mutedForSeconds"] = arg3
 	return args, nil
 }
 
-func (ec *executionContext) field_Mutation_muteParticipants_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Mutation_addDiscussionParticipant_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
 	var arg0 string
 	if tmp, ok := rawArgs["discussionID"]; ok {
-		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		arg0, err = ec.unmarshalNString2string(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
 	args["discussionID"] = arg0
-	var arg1 []string
-	if tmp, ok := rawArgs["participantIDs"]; ok {
-		arg1, err = ec.unmarshalNID2ᚕstringᚄ(ctx, tmp)
+	var arg1 string
+	if tmp, ok := rawArgs["userID"]; ok {
+		arg1, err = ec.unmarshalNString2string(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["participantIDs"] = arg1
-	var arg2 int
-	if tmp, ok := rawArgs["mutedForSeconds"]; ok {
-		arg2, err = ec.unmarshalNInt2int(ctx, tmp)
+	args["userID"] = arg1
+	var arg2 model.AddDiscussionParticipantInput
+	if tmp, ok := rawArgs["discussionParticipantInput"]; ok {
+		arg2, err = ec.unmarshalNAddDiscussionParticipantInput2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐAddDiscussionParticipantInput(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["mutedForSeconds"] = arg2
+	args["discussionParticipantInput"] = arg2
 	return args, nil
 }
 
-func (ec *executionContext) field_Mutation_requestAccessToDiscussion_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Mutation_addPost_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
 	var arg0 string
@@ -2459,54 +4066,64 @@ func (ec *executionContext) field_Mutation_requestAccessToDiscussion_args(ctx co
 		}
 	}
 	args["discussionID"] = arg0
-	return args, nil
-}
-
-func (ec *executionContext) field_Mutation_respondToRequestAccess_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	var err error
-	args := map[string]interface{}{}
-	var arg0 string
-	if tmp, ok := rawArgs["requestID"]; ok {
-		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+	var arg1 string
+	if tmp, ok := rawArgs["participantID"]; ok {
+		arg1, err = ec.unmarshalNID2string(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["requestID"] = arg0
-	var arg1 model.InviteRequestStatus
-	if tmp, ok := rawArgs["response"]; ok {
-		arg1, err = ec.unmarshalNInviteRequestStatus2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐInviteRequestStatus(ctx, tmp)
+	args["participantID"] = arg1
+	var arg2 model.PostContentInput
+	if tmp, ok := rawArgs["postContent"]; ok {
+		arg2, err = ec.unmarshalNPostContentInput2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPostContentInput(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["response"] = arg1
+	args["postContent"] = arg2
 	return args, nil
 }
 
-func (ec *executionContext) field_Mutation_setLastPostViewed_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Mutation_addReaction_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
 	var arg0 string
-	if tmp, ok := rawArgs["viewerID"]; ok {
+	if tmp, ok := rawArgs["discussionID"]; ok {
 		arg0, err = ec.unmarshalNID2string(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["viewerID"] = arg0
+	args["discussionID"] = arg0
 	var arg1 string
-	if tmp, ok := rawArgs["postID"]; ok {
+	if tmp, ok := rawArgs["participantID"]; ok {
 		arg1, err = ec.unmarshalNID2string(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["postID"] = arg1
+	args["participantID"] = arg1
+	var arg2 string
+	if tmp, ok := rawArgs["postID"]; ok {
+		arg2, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["postID"] = arg2
+	var arg3 string
+	if tmp, ok := rawArgs["reaction"]; ok {
+		arg3, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["reaction"] = arg3
 	return args, nil
 }
 
-func (ec *executionContext) field_Mutation_shuffleDiscussion_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Mutation_banParticipant_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
 	var arg0 string
@@ -2517,18 +4134,34 @@ func (ec *executionContext) field_Mutation_shuffleDiscussion_args(ctx context.Co
 		}
 	}
 	args["discussionID"] = arg0
-	var arg1 *int
-	if tmp, ok := rawArgs["inFutureSeconds"]; ok {
-		arg1, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+	var arg1 string
+	if tmp, ok := rawArgs["participantID"]; ok {
+		arg1, err = ec.unmarshalNID2string(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["inFutureSeconds"] = arg1
+	args["participantID"] = arg1
+	var arg2 *string
+	if tmp, ok := rawArgs["reason"]; ok {
+		arg2, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["reason"] = arg2
+	var arg3 *bool
+	if tmp, ok := rawArgs["keepPosts"]; ok {
+		arg3, err = ec.unmarshalOBoolean2ᚖbool(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["keepPosts"] = arg3
 	return args, nil
 }
 
-func (ec *executionContext) field_Mutation_unmuteParticipants_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Mutation_bookmarkPost_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
 	var arg0 string
@@ -2539,40 +4172,78 @@ func (ec *executionContext) field_Mutation_unmuteParticipants_args(ctx context.C
 		}
 	}
 	args["discussionID"] = arg0
-	var arg1 []string
-	if tmp, ok := rawArgs["participantIDs"]; ok {
-		arg1, err = ec.unmarshalNID2ᚕstringᚄ(ctx, tmp)
+	var arg1 string
+	if tmp, ok := rawArgs["postID"]; ok {
+		arg1, err = ec.unmarshalNID2string(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["participantIDs"] = arg1
+	args["postID"] = arg1
 	return args, nil
 }
 
-func (ec *executionContext) field_Mutation_updateDiscussionUserSettings_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Mutation_cancelScheduledPost_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
 	var arg0 string
-	if tmp, ok := rawArgs["discussionID"]; ok {
+	if tmp, ok := rawArgs["scheduledPostID"]; ok {
 		arg0, err = ec.unmarshalNID2string(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["discussionID"] = arg0
-	var arg1 model.DiscussionUserSettings
-	if tmp, ok := rawArgs["settings"]; ok {
-		arg1, err = ec.unmarshalNDiscussionUserSettings2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussionUserSettings(ctx, tmp)
+	args["scheduledPostID"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_createDiscussion_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 model.AnonymityType
+	if tmp, ok := rawArgs["anonymityType"]; ok {
+		arg0, err = ec.unmarshalNAnonymityType2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐAnonymityType(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["settings"] = arg1
+	args["anonymityType"] = arg0
+	var arg1 string
+	if tmp, ok := rawArgs["title"]; ok {
+		arg1, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["title"] = arg1
+	var arg2 *string
+	if tmp, ok := rawArgs["description"]; ok {
+		arg2, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["description"] = arg2
+	var arg3 *bool
+	if tmp, ok := rawArgs["publicAccess"]; ok {
+		arg3, err = ec.unmarshalOBoolean2ᚖbool(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["publicAccess"] = arg3
+	var arg4 model.DiscussionCreationSettings
+	if tmp, ok := rawArgs["discussionSettings"]; ok {
+		arg4, err = ec.unmarshalNDiscussionCreationSettings2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussionCreationSettings(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["discussionSettings"] = arg4
 	return args, nil
 }
 
-func (ec *executionContext) field_Mutation_updateDiscussion_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Mutation_deletePost_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
 	var arg0 string
@@ -2583,18 +4254,18 @@ func (ec *executionContext) field_Mutation_updateDiscussion_args(ctx context.Con
 		}
 	}
 	args["discussionID"] = arg0
-	var arg1 model.DiscussionInput
-	if tmp, ok := rawArgs["input"]; ok {
-		arg1, err = ec.unmarshalNDiscussionInput2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussionInput(ctx, tmp)
+	var arg1 string
+	if tmp, ok := rawArgs["postID"]; ok {
+		arg1, err = ec.unmarshalNID2string(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["input"] = arg1
+	args["postID"] = arg1
 	return args, nil
 }
 
-func (ec *executionContext) field_Mutation_updateParticipant_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Mutation_dismissPostReport_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
 	var arg0 string
@@ -2606,152 +4277,210 @@ func (ec *executionContext) field_Mutation_updateParticipant_args(ctx context.Co
 	}
 	args["discussionID"] = arg0
 	var arg1 string
-	if tmp, ok := rawArgs["participantID"]; ok {
+	if tmp, ok := rawArgs["reportID"]; ok {
 		arg1, err = ec.unmarshalNID2string(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["participantID"] = arg1
-	var arg2 model.UpdateParticipantInput
-	if tmp, ok := rawArgs["updateInput"]; ok {
-		arg2, err = ec.unmarshalNUpdateParticipantInput2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐUpdateParticipantInput(ctx, tmp)
-		if err != nil {
-			return nil, err
-		}
-	}
-	args["updateInput"] = arg2
+	args["reportID"] = arg1
 	return args, nil
 }
 
-func (ec *executionContext) field_Mutation_upsertUserDevice_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Mutation_editPost_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	var arg0 *string
-	if tmp, ok := rawArgs["userID"]; ok {
-		arg0, err = ec.unmarshalOID2ᚖstring(ctx, tmp)
-		if err != nil {
-			return nil, err
-		}
-	}
-	args["userID"] = arg0
-	var arg1 model.Platform
-	if tmp, ok := rawArgs["platform"]; ok {
-		arg1, err = ec.unmarshalNPlatform2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPlatform(ctx, tmp)
+	var arg0 string
+	if tmp, ok := rawArgs["discussionID"]; ok {
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["platform"] = arg1
-	var arg2 string
-	if tmp, ok := rawArgs["deviceID"]; ok {
-		arg2, err = ec.unmarshalNString2string(ctx, tmp)
+	args["discussionID"] = arg0
+	var arg1 string
+	if tmp, ok := rawArgs["postID"]; ok {
+		arg1, err = ec.unmarshalNID2string(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["deviceID"] = arg2
-	var arg3 *string
-	if tmp, ok := rawArgs["token"]; ok {
-		arg3, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+	args["postID"] = arg1
+	var arg2 model.PostContentInput
+	if tmp, ok := rawArgs["postContent"]; ok {
+		arg2, err = ec.unmarshalNPostContentInput2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPostContentInput(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["token"] = arg3
+	args["postContent"] = arg2
 	return args, nil
 }
 
-func (ec *executionContext) field_Query___type_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Mutation_grantModeratorRole_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
 	var arg0 string
-	if tmp, ok := rawArgs["name"]; ok {
-		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+	if tmp, ok := rawArgs["discussionID"]; ok {
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["name"] = arg0
+	args["discussionID"] = arg0
+	var arg1 string
+	if tmp, ok := rawArgs["participantID"]; ok {
+		arg1, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["participantID"] = arg1
+	var arg2 model.ModeratorRole
+	if tmp, ok := rawArgs["role"]; ok {
+		arg2, err = ec.unmarshalNModeratorRole2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐModeratorRole(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["role"] = arg2
 	return args, nil
 }
 
-func (ec *executionContext) field_Query_discussionByLinkSlug_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Mutation_muteParticipants_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
 	var arg0 string
-	if tmp, ok := rawArgs["slug"]; ok {
-		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+	if tmp, ok := rawArgs["discussionID"]; ok {
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["slug"] = arg0
+	args["discussionID"] = arg0
+	var arg1 []string
+	if tmp, ok := rawArgs["participantIDs"]; ok {
+		arg1, err = ec.unmarshalNID2ᚕstringᚄ(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["participantIDs"] = arg1
+	var arg2 int
+	if tmp, ok := rawArgs["mutedForSeconds"]; ok {
+		arg2, err = ec.unmarshalNInt2int(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["mutedForSeconds"] = arg2
 	return args, nil
 }
 
-func (ec *executionContext) field_Query_discussion_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Mutation_pinPost_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
 	var arg0 string
-	if tmp, ok := rawArgs["id"]; ok {
+	if tmp, ok := rawArgs["discussionID"]; ok {
 		arg0, err = ec.unmarshalNID2string(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["id"] = arg0
-	return args, nil
-}
-
-func (ec *executionContext) field_Query_listDiscussions_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	var err error
-	args := map[string]interface{}{}
-	var arg0 model.DiscussionUserAccessState
-	if tmp, ok := rawArgs["state"]; ok {
-		arg0, err = ec.unmarshalNDiscussionUserAccessState2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussionUserAccessState(ctx, tmp)
+	args["discussionID"] = arg0
+	var arg1 string
+	if tmp, ok := rawArgs["postID"]; ok {
+		arg1, err = ec.unmarshalNID2string(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["state"] = arg0
+	args["postID"] = arg1
 	return args, nil
 }
 
-func (ec *executionContext) field_Query_user_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Mutation_removeReaction_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
 	var arg0 string
-	if tmp, ok := rawArgs["id"]; ok {
+	if tmp, ok := rawArgs["discussionID"]; ok {
 		arg0, err = ec.unmarshalNID2string(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["id"] = arg0
+	args["discussionID"] = arg0
+	var arg1 string
+	if tmp, ok := rawArgs["participantID"]; ok {
+		arg1, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["participantID"] = arg1
+	var arg2 string
+	if tmp, ok := rawArgs["postID"]; ok {
+		arg2, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["postID"] = arg2
+	var arg3 string
+	if tmp, ok := rawArgs["reaction"]; ok {
+		arg3, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["reaction"] = arg3
 	return args, nil
 }
 
-func (ec *executionContext) field_Subscription_onDiscussionEvent_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Mutation_reportPost_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
 	var arg0 string
 	if tmp, ok := rawArgs["discussionID"]; ok {
-		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
 	args["discussionID"] = arg0
+	var arg1 string
+	if tmp, ok := rawArgs["postID"]; ok {
+		arg1, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["postID"] = arg1
+	var arg2 model.PostReportReason
+	if tmp, ok := rawArgs["reason"]; ok {
+		arg2, err = ec.unmarshalNPostReportReason2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPostReportReason(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["reason"] = arg2
+	var arg3 *string
+	if tmp, ok := rawArgs["note"]; ok {
+		arg3, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["note"] = arg3
 	return args, nil
 }
 
-func (ec *executionContext) field_Subscription_postAdded_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Mutation_requestAccessToDiscussion_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
 	var arg0 string
 	if tmp, ok := rawArgs["discussionID"]; ok {
-		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
@@ -2760,618 +4489,607 @@ func (ec *executionContext) field_Subscription_postAdded_args(ctx context.Contex
 	return args, nil
 }
 
-func (ec *executionContext) field_User_discussions_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Mutation_respondToRequestAccess_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	var arg0 model.DiscussionUserAccessState
-	if tmp, ok := rawArgs["state"]; ok {
-		arg0, err = ec.unmarshalNDiscussionUserAccessState2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussionUserAccessState(ctx, tmp)
+	var arg0 string
+	if tmp, ok := rawArgs["requestID"]; ok {
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["state"] = arg0
+	args["requestID"] = arg0
+	var arg1 model.InviteRequestStatus
+	if tmp, ok := rawArgs["response"]; ok {
+		arg1, err = ec.unmarshalNInviteRequestStatus2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐInviteRequestStatus(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["response"] = arg1
 	return args, nil
 }
 
-func (ec *executionContext) field___Type_enumValues_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Mutation_revokeModeratorRole_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	var arg0 bool
-	if tmp, ok := rawArgs["includeDeprecated"]; ok {
-		arg0, err = ec.unmarshalOBoolean2bool(ctx, tmp)
+	var arg0 string
+	if tmp, ok := rawArgs["discussionID"]; ok {
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["includeDeprecated"] = arg0
+	args["discussionID"] = arg0
+	var arg1 string
+	if tmp, ok := rawArgs["participantID"]; ok {
+		arg1, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["participantID"] = arg1
 	return args, nil
 }
 
-func (ec *executionContext) field___Type_fields_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Mutation_schedulePost_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	var arg0 bool
-	if tmp, ok := rawArgs["includeDeprecated"]; ok {
-		arg0, err = ec.unmarshalOBoolean2bool(ctx, tmp)
+	var arg0 string
+	if tmp, ok := rawArgs["discussionID"]; ok {
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["includeDeprecated"] = arg0
-	return args, nil
-}
-
-// endregion ***************************** args.gotpl *****************************
-
-// region    ************************** directives.gotpl **************************
-
-// endregion ************************** directives.gotpl **************************
-
-// region    **************************** field.gotpl *****************************
-
-func (ec *executionContext) _CanJoinDiscussionResponse_response(ctx context.Context, field graphql.CollectedField, obj *model.CanJoinDiscussionResponse) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	args["discussionID"] = arg0
+	var arg1 string
+	if tmp, ok := rawArgs["participantID"]; ok {
+		arg1, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:   "CanJoinDiscussionResponse",
-		Field:    field,
-		Args:     nil,
-		IsMethod: false,
 	}
-
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Response, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
+	args["participantID"] = arg1
+	var arg2 model.PostContentInput
+	if tmp, ok := rawArgs["postContent"]; ok {
+		arg2, err = ec.unmarshalNPostContentInput2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPostContentInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
 	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+	args["postContent"] = arg2
+	var arg3 time.Time
+	if tmp, ok := rawArgs["publishAt"]; ok {
+		arg3, err = ec.unmarshalNTime2timeᚐTime(ctx, tmp)
+		if err != nil {
+			return nil, err
 		}
-		return graphql.Null
 	}
-	res := resTmp.(model.DiscussionJoinabilityResponse)
-	fc.Result = res
-	return ec.marshalNDiscussionJoinabilityResponse2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussionJoinabilityResponse(ctx, field.Selections, res)
+	args["publishAt"] = arg3
+	return args, nil
 }
 
-func (ec *executionContext) _CanJoinDiscussionResponse_reason(ctx context.Context, field graphql.CollectedField, obj *model.CanJoinDiscussionResponse) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+func (ec *executionContext) field_Mutation_setLastPostViewed_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["viewerID"]; ok {
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:   "CanJoinDiscussionResponse",
-		Field:    field,
-		Args:     nil,
-		IsMethod: false,
-	}
-
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Reason, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
 	}
-	if resTmp == nil {
-		return graphql.Null
+	args["viewerID"] = arg0
+	var arg1 string
+	if tmp, ok := rawArgs["postID"]; ok {
+		arg1, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
 	}
-	res := resTmp.(*string)
-	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	args["postID"] = arg1
+	return args, nil
 }
 
-func (ec *executionContext) _CanJoinDiscussionResponse_reasonCode(ctx context.Context, field graphql.CollectedField, obj *model.CanJoinDiscussionResponse) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+func (ec *executionContext) field_Mutation_shuffleDiscussion_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["discussionID"]; ok {
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:   "CanJoinDiscussionResponse",
-		Field:    field,
-		Args:     nil,
-		IsMethod: false,
-	}
-
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.ReasonCode, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
 	}
-	if resTmp == nil {
-		return graphql.Null
+	args["discussionID"] = arg0
+	var arg1 *int
+	if tmp, ok := rawArgs["inFutureSeconds"]; ok {
+		arg1, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
 	}
-	res := resTmp.(*int)
-	fc.Result = res
-	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
+	args["inFutureSeconds"] = arg1
+	return args, nil
 }
 
-func (ec *executionContext) _Discussion_id(ctx context.Context, field graphql.CollectedField, obj *model.Discussion) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+func (ec *executionContext) field_Mutation_transferDiscussionOwnership_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["discussionID"]; ok {
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:   "Discussion",
-		Field:    field,
-		Args:     nil,
-		IsMethod: false,
-	}
-
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
 	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+	args["discussionID"] = arg0
+	var arg1 string
+	if tmp, ok := rawArgs["toUserID"]; ok {
+		arg1, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
 		}
-		return graphql.Null
 	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNID2string(ctx, field.Selections, res)
+	args["toUserID"] = arg1
+	return args, nil
 }
 
-func (ec *executionContext) _Discussion_moderator(ctx context.Context, field graphql.CollectedField, obj *model.Discussion) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+func (ec *executionContext) field_Mutation_unbanParticipant_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["discussionID"]; ok {
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:   "Discussion",
-		Field:    field,
-		Args:     nil,
-		IsMethod: true,
 	}
-
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Discussion().Moderator(rctx, obj)
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
+	args["discussionID"] = arg0
+	var arg1 string
+	if tmp, ok := rawArgs["participantID"]; ok {
+		arg1, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
 	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+	args["participantID"] = arg1
+	var arg2 *bool
+	if tmp, ok := rawArgs["restorePosts"]; ok {
+		arg2, err = ec.unmarshalOBoolean2ᚖbool(ctx, tmp)
+		if err != nil {
+			return nil, err
 		}
-		return graphql.Null
 	}
-	res := resTmp.(*model.Moderator)
-	fc.Result = res
-	return ec.marshalNModerator2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐModerator(ctx, field.Selections, res)
+	args["restorePosts"] = arg2
+	return args, nil
 }
 
-func (ec *executionContext) _Discussion_anonymityType(ctx context.Context, field graphql.CollectedField, obj *model.Discussion) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+func (ec *executionContext) field_Mutation_unbookmarkPost_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["discussionID"]; ok {
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["discussionID"] = arg0
+	var arg1 string
+	if tmp, ok := rawArgs["postID"]; ok {
+		arg1, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:   "Discussion",
-		Field:    field,
-		Args:     nil,
-		IsMethod: false,
 	}
+	args["postID"] = arg1
+	return args, nil
+}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.AnonymityType, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
+func (ec *executionContext) field_Mutation_unmuteParticipants_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["discussionID"]; ok {
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
 	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+	args["discussionID"] = arg0
+	var arg1 []string
+	if tmp, ok := rawArgs["participantIDs"]; ok {
+		arg1, err = ec.unmarshalNID2ᚕstringᚄ(ctx, tmp)
+		if err != nil {
+			return nil, err
 		}
-		return graphql.Null
 	}
-	res := resTmp.(model.AnonymityType)
-	fc.Result = res
-	return ec.marshalNAnonymityType2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐAnonymityType(ctx, field.Selections, res)
+	args["participantIDs"] = arg1
+	return args, nil
 }
 
-func (ec *executionContext) _Discussion_posts(ctx context.Context, field graphql.CollectedField, obj *model.Discussion) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+func (ec *executionContext) field_Mutation_unpinPost_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["discussionID"]; ok {
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:   "Discussion",
-		Field:    field,
-		Args:     nil,
-		IsMethod: true,
-	}
-
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Discussion().Posts(rctx, obj)
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
 	}
-	if resTmp == nil {
-		return graphql.Null
+	args["discussionID"] = arg0
+	var arg1 string
+	if tmp, ok := rawArgs["postID"]; ok {
+		arg1, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
 	}
-	res := resTmp.([]*model.Post)
-	fc.Result = res
-	return ec.marshalOPost2ᚕᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPostᚄ(ctx, field.Selections, res)
+	args["postID"] = arg1
+	return args, nil
 }
 
-func (ec *executionContext) _Discussion_postsConnection(ctx context.Context, field graphql.CollectedField, obj *model.Discussion) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+func (ec *executionContext) field_Mutation_updateDiscussionUserSettings_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["discussionID"]; ok {
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:   "Discussion",
-		Field:    field,
-		Args:     nil,
-		IsMethod: true,
-	}
-
-	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Discussion_postsConnection_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Discussion().PostsConnection(rctx, obj, args["after"].(*string))
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
 	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+	args["discussionID"] = arg0
+	var arg1 model.DiscussionUserSettings
+	if tmp, ok := rawArgs["settings"]; ok {
+		arg1, err = ec.unmarshalNDiscussionUserSettings2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussionUserSettings(ctx, tmp)
+		if err != nil {
+			return nil, err
 		}
-		return graphql.Null
 	}
-	res := resTmp.(*model.PostsConnection)
-	fc.Result = res
-	return ec.marshalNPostsConnection2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPostsConnection(ctx, field.Selections, res)
+	args["settings"] = arg1
+	return args, nil
 }
 
-func (ec *executionContext) _Discussion_iconURL(ctx context.Context, field graphql.CollectedField, obj *model.Discussion) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+func (ec *executionContext) field_Mutation_updateDiscussion_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["discussionID"]; ok {
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:   "Discussion",
-		Field:    field,
-		Args:     nil,
-		IsMethod: false,
-	}
-
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.IconURL, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
 	}
-	if resTmp == nil {
-		return graphql.Null
+	args["discussionID"] = arg0
+	var arg1 model.DiscussionInput
+	if tmp, ok := rawArgs["input"]; ok {
+		arg1, err = ec.unmarshalNDiscussionInput2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussionInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
 	}
-	res := resTmp.(*string)
-	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	args["input"] = arg1
+	return args, nil
 }
 
-func (ec *executionContext) _Discussion_participants(ctx context.Context, field graphql.CollectedField, obj *model.Discussion) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+func (ec *executionContext) field_Mutation_updateParticipant_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["discussionID"]; ok {
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:   "Discussion",
-		Field:    field,
-		Args:     nil,
-		IsMethod: true,
 	}
-
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Discussion().Participants(rctx, obj)
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
+	args["discussionID"] = arg0
+	var arg1 string
+	if tmp, ok := rawArgs["participantID"]; ok {
+		arg1, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
 	}
-	if resTmp == nil {
-		return graphql.Null
+	args["participantID"] = arg1
+	var arg2 model.UpdateParticipantInput
+	if tmp, ok := rawArgs["updateInput"]; ok {
+		arg2, err = ec.unmarshalNUpdateParticipantInput2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐUpdateParticipantInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
 	}
-	res := resTmp.([]*model.Participant)
-	fc.Result = res
-	return ec.marshalOParticipant2ᚕᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐParticipantᚄ(ctx, field.Selections, res)
+	args["updateInput"] = arg2
+	return args, nil
 }
 
-func (ec *executionContext) _Discussion_title(ctx context.Context, field graphql.CollectedField, obj *model.Discussion) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+func (ec *executionContext) field_Mutation_upsertUserDevice_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *string
+	if tmp, ok := rawArgs["userID"]; ok {
+		arg0, err = ec.unmarshalOID2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:   "Discussion",
-		Field:    field,
-		Args:     nil,
-		IsMethod: false,
-	}
-
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Title, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
 	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+	args["userID"] = arg0
+	var arg1 model.Platform
+	if tmp, ok := rawArgs["platform"]; ok {
+		arg1, err = ec.unmarshalNPlatform2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPlatform(ctx, tmp)
+		if err != nil {
+			return nil, err
 		}
-		return graphql.Null
 	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
-}
-
-func (ec *executionContext) _Discussion_description(ctx context.Context, field graphql.CollectedField, obj *model.Discussion) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	args["platform"] = arg1
+	var arg2 string
+	if tmp, ok := rawArgs["deviceID"]; ok {
+		arg2, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:   "Discussion",
-		Field:    field,
-		Args:     nil,
-		IsMethod: false,
-	}
-
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Description, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
 	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+	args["deviceID"] = arg2
+	var arg3 *string
+	if tmp, ok := rawArgs["token"]; ok {
+		arg3, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
 		}
-		return graphql.Null
 	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	args["token"] = arg3
+	return args, nil
 }
 
-func (ec *executionContext) _Discussion_titleHistory(ctx context.Context, field graphql.CollectedField, obj *model.Discussion) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+func (ec *executionContext) field_Mutation_votePoll_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["discussionID"]; ok {
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:   "Discussion",
-		Field:    field,
-		Args:     nil,
-		IsMethod: true,
 	}
-
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Discussion().TitleHistory(rctx, obj)
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
+	args["discussionID"] = arg0
+	var arg1 string
+	if tmp, ok := rawArgs["participantID"]; ok {
+		arg1, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
 	}
-	if resTmp == nil {
-		return graphql.Null
+	args["participantID"] = arg1
+	var arg2 string
+	if tmp, ok := rawArgs["postID"]; ok {
+		arg2, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
 	}
-	res := resTmp.([]*model.HistoricalString)
-	fc.Result = res
-	return ec.marshalOHistoricalString2ᚕᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐHistoricalStringᚄ(ctx, field.Selections, res)
+	args["postID"] = arg2
+	var arg3 []string
+	if tmp, ok := rawArgs["optionIDs"]; ok {
+		arg3, err = ec.unmarshalNID2ᚕstringᚄ(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["optionIDs"] = arg3
+	return args, nil
 }
 
-func (ec *executionContext) _Discussion_descriptionHistory(ctx context.Context, field graphql.CollectedField, obj *model.Discussion) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+func (ec *executionContext) field_Post_repliesConnection_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *string
+	if tmp, ok := rawArgs["after"]; ok {
+		arg0, err = ec.unmarshalOID2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:   "Discussion",
-		Field:    field,
-		Args:     nil,
-		IsMethod: true,
 	}
+	args["after"] = arg0
+	return args, nil
+}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Discussion().DescriptionHistory(rctx, obj)
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
+func (ec *executionContext) field_Query___type_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["name"]; ok {
+		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
 	}
-	res := resTmp.([]*model.HistoricalString)
-	fc.Result = res
-	return ec.marshalOHistoricalString2ᚕᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐHistoricalStringᚄ(ctx, field.Selections, res)
+	args["name"] = arg0
+	return args, nil
 }
 
-func (ec *executionContext) _Discussion_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.Discussion) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+func (ec *executionContext) field_Query_discussionByLinkSlug_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["slug"]; ok {
+		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:   "Discussion",
-		Field:    field,
-		Args:     nil,
-		IsMethod: true,
 	}
+	args["slug"] = arg0
+	return args, nil
+}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Discussion().CreatedAt(rctx, obj)
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+func (ec *executionContext) field_Query_discussion_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["id"]; ok {
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
 		}
-		return graphql.Null
 	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	args["id"] = arg0
+	return args, nil
 }
 
-func (ec *executionContext) _Discussion_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model.Discussion) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+func (ec *executionContext) field_Query_listDiscussions_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 model.DiscussionUserAccessState
+	if tmp, ok := rawArgs["state"]; ok {
+		arg0, err = ec.unmarshalNDiscussionUserAccessState2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussionUserAccessState(ctx, tmp)
+		if err != nil {
+			return nil, err
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:   "Discussion",
-		Field:    field,
-		Args:     nil,
-		IsMethod: true,
 	}
+	args["state"] = arg0
+	return args, nil
+}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Discussion().UpdatedAt(rctx, obj)
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
+func (ec *executionContext) field_Query_searchMyDiscussions_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["query"]; ok {
+		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
 	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+	args["query"] = arg0
+	var arg1 *string
+	if tmp, ok := rawArgs["after"]; ok {
+		arg1, err = ec.unmarshalOID2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
 		}
-		return graphql.Null
 	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	args["after"] = arg1
+	return args, nil
 }
 
-func (ec *executionContext) _Discussion_meParticipant(ctx context.Context, field graphql.CollectedField, obj *model.Discussion) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+func (ec *executionContext) field_Query_user_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["id"]; ok {
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:   "Discussion",
-		Field:    field,
-		Args:     nil,
-		IsMethod: true,
 	}
-
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Discussion().MeParticipant(rctx, obj)
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
+	args["id"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Subscription_onDiscussionEvent_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["discussionID"]; ok {
+		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
 	}
-	if resTmp == nil {
-		return graphql.Null
+	args["discussionID"] = arg0
+	var arg1 *int
+	if tmp, ok := rawArgs["afterSequence"]; ok {
+		arg1, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
 	}
-	res := resTmp.(*model.Participant)
-	fc.Result = res
-	return ec.marshalOParticipant2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐParticipant(ctx, field.Selections, res)
+	args["afterSequence"] = arg1
+	return args, nil
 }
 
-func (ec *executionContext) _Discussion_meAvailableParticipants(ctx context.Context, field graphql.CollectedField, obj *model.Discussion) (ret graphql.Marshaler) {
+func (ec *executionContext) field_Subscription_postAdded_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["discussionID"]; ok {
+		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["discussionID"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_User_bookmarks_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *string
+	if tmp, ok := rawArgs["after"]; ok {
+		arg0, err = ec.unmarshalOID2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["after"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_User_discussions_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 model.DiscussionUserAccessState
+	if tmp, ok := rawArgs["state"]; ok {
+		arg0, err = ec.unmarshalNDiscussionUserAccessState2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussionUserAccessState(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["state"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field___Type_enumValues_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 bool
+	if tmp, ok := rawArgs["includeDeprecated"]; ok {
+		arg0, err = ec.unmarshalOBoolean2bool(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["includeDeprecated"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field___Type_fields_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 bool
+	if tmp, ok := rawArgs["includeDeprecated"]; ok {
+		arg0, err = ec.unmarshalOBoolean2bool(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["includeDeprecated"] = arg0
+	return args, nil
+}
+
+// endregion ***************************** args.gotpl *****************************
+
+// region    ************************** directives.gotpl **************************
+
+// endregion ************************** directives.gotpl **************************
+
+// region    **************************** field.gotpl *****************************
+
+func (ec *executionContext) _CanJoinDiscussionResponse_response(ctx context.Context, field graphql.CollectedField, obj *model.CanJoinDiscussionResponse) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3379,30 +5097,33 @@ func (ec *executionContext) _Discussion_meAvailableParticipants(ctx context.Cont
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Discussion",
+		Object:   "CanJoinDiscussionResponse",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Discussion().MeAvailableParticipants(rctx, obj)
+		return obj.Response, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.Participant)
+	res := resTmp.(model.DiscussionJoinabilityResponse)
 	fc.Result = res
-	return ec.marshalOParticipant2ᚕᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐParticipantᚄ(ctx, field.Selections, res)
+	return ec.marshalNDiscussionJoinabilityResponse2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussionJoinabilityResponse(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Discussion_meCanJoinDiscussion(ctx context.Context, field graphql.CollectedField, obj *model.Discussion) (ret graphql.Marshaler) {
+func (ec *executionContext) _CanJoinDiscussionResponse_reason(ctx context.Context, field graphql.CollectedField, obj *model.CanJoinDiscussionResponse) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3410,33 +5131,30 @@ func (ec *executionContext) _Discussion_meCanJoinDiscussion(ctx context.Context,
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Discussion",
+		Object:   "CanJoinDiscussionResponse",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Discussion().MeCanJoinDiscussion(rctx, obj)
+		return obj.Reason, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.CanJoinDiscussionResponse)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNCanJoinDiscussionResponse2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐCanJoinDiscussionResponse(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Discussion_meViewer(ctx context.Context, field graphql.CollectedField, obj *model.Discussion) (ret graphql.Marshaler) {
+func (ec *executionContext) _CanJoinDiscussionResponse_reasonCode(ctx context.Context, field graphql.CollectedField, obj *model.CanJoinDiscussionResponse) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3444,16 +5162,16 @@ func (ec *executionContext) _Discussion_meViewer(ctx context.Context, field grap
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Discussion",
+		Object:   "CanJoinDiscussionResponse",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Discussion().MeViewer(rctx, obj)
+		return obj.ReasonCode, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3462,12 +5180,12 @@ func (ec *executionContext) _Discussion_meViewer(ctx context.Context, field grap
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*model.Viewer)
+	res := resTmp.(*int)
 	fc.Result = res
-	return ec.marshalOViewer2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐViewer(ctx, field.Selections, res)
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Discussion_meNotificationSettings(ctx context.Context, field graphql.CollectedField, obj *model.Discussion) (ret graphql.Marshaler) {
+func (ec *executionContext) _Discussion_id(ctx context.Context, field graphql.CollectedField, obj *model.Discussion) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3478,27 +5196,30 @@ func (ec *executionContext) _Discussion_meNotificationSettings(ctx context.Conte
 		Object:   "Discussion",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Discussion().MeNotificationSettings(rctx, obj)
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.DiscussionUserNotificationSetting)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalODiscussionUserNotificationSetting2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussionUserNotificationSetting(ctx, field.Selections, res)
+	return ec.marshalNID2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Discussion_meDiscussionStatus(ctx context.Context, field graphql.CollectedField, obj *model.Discussion) (ret graphql.Marshaler) {
+func (ec *executionContext) _Discussion_moderator(ctx context.Context, field graphql.CollectedField, obj *model.Discussion) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3515,21 +5236,24 @@ func (ec *executionContext) _Discussion_meDiscussionStatus(ctx context.Context,
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Discussion().MeDiscussionStatus(rctx, obj)
+		return ec.resolvers.Discussion().Moderator(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.DiscussionUserAccessState)
+	res := resTmp.(*model.Moderator)
 	fc.Result = res
-	return ec.marshalODiscussionUserAccessState2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussionUserAccessState(ctx, field.Selections, res)
+	return ec.marshalNModerator2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐModerator(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Discussion_accessRequests(ctx context.Context, field graphql.CollectedField, obj *model.Discussion) (ret graphql.Marshaler) {
+func (ec *executionContext) _Discussion_anonymityType(ctx context.Context, field graphql.CollectedField, obj *model.Discussion) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3540,27 +5264,30 @@ func (ec *executionContext) _Discussion_accessRequests(ctx context.Context, fiel
 		Object:   "Discussion",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Discussion().AccessRequests(rctx, obj)
+		return obj.AnonymityType, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.DiscussionAccessRequest)
+	res := resTmp.(model.AnonymityType)
 	fc.Result = res
-	return ec.marshalODiscussionAccessRequest2ᚕᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussionAccessRequestᚄ(ctx, field.Selections, res)
+	return ec.marshalNAnonymityType2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐAnonymityType(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Discussion_discussionAccessLink(ctx context.Context, field graphql.CollectedField, obj *model.Discussion) (ret graphql.Marshaler) {
+func (ec *executionContext) _Discussion_posts(ctx context.Context, field graphql.CollectedField, obj *model.Discussion) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3577,7 +5304,7 @@ func (ec *executionContext) _Discussion_discussionAccessLink(ctx context.Context
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Discussion().DiscussionAccessLink(rctx, obj)
+		return ec.resolvers.Discussion().Posts(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3586,12 +5313,12 @@ func (ec *executionContext) _Discussion_discussionAccessLink(ctx context.Context
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*model.DiscussionAccessLink)
+	res := resTmp.([]*model.Post)
 	fc.Result = res
-	return ec.marshalODiscussionAccessLink2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussionAccessLink(ctx, field.Selections, res)
+	return ec.marshalOPost2ᚕᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPostᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Discussion_discussionJoinability(ctx context.Context, field graphql.CollectedField, obj *model.Discussion) (ret graphql.Marshaler) {
+func (ec *executionContext) _Discussion_postsConnection(ctx context.Context, field graphql.CollectedField, obj *model.Discussion) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3606,9 +5333,16 @@ func (ec *executionContext) _Discussion_discussionJoinability(ctx context.Contex
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Discussion_postsConnection_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Discussion().DiscussionJoinability(rctx, obj)
+		return ec.resolvers.Discussion().PostsConnection(rctx, obj, args["after"].(*string), args["before"].(*string), args["around"].(*string), args["first"].(*int), args["last"].(*int), args["topLevelOnly"].(*bool))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3620,12 +5354,12 @@ func (ec *executionContext) _Discussion_discussionJoinability(ctx context.Contex
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.DiscussionJoinabilitySetting)
+	res := resTmp.(*model.PostsConnection)
 	fc.Result = res
-	return ec.marshalNDiscussionJoinabilitySetting2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussionJoinabilitySetting(ctx, field.Selections, res)
+	return ec.marshalNPostsConnection2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPostsConnection(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Discussion_shuffleCount(ctx context.Context, field graphql.CollectedField, obj *model.Discussion) (ret graphql.Marshaler) {
+func (ec *executionContext) _Discussion_pinnedPosts(ctx context.Context, field graphql.CollectedField, obj *model.Discussion) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3636,13 +5370,13 @@ func (ec *executionContext) _Discussion_shuffleCount(ctx context.Context, field
 		Object:   "Discussion",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ShuffleCount, nil
+		return ec.resolvers.Discussion().PinnedPosts(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3654,12 +5388,12 @@ func (ec *executionContext) _Discussion_shuffleCount(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.([]*model.Post)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNPost2ᚕᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPostᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Discussion_secondsUntilShuffle(ctx context.Context, field graphql.CollectedField, obj *model.Discussion) (ret graphql.Marshaler) {
+func (ec *executionContext) _Discussion_searchPosts(ctx context.Context, field graphql.CollectedField, obj *model.Discussion) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3674,23 +5408,33 @@ func (ec *executionContext) _Discussion_secondsUntilShuffle(ctx context.Context,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Discussion_searchPosts_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Discussion().SecondsUntilShuffle(rctx, obj)
+		return ec.resolvers.Discussion().SearchPosts(rctx, obj, args["query"].(string), args["after"].(*string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*int)
+	res := resTmp.(*model.PostsConnection)
 	fc.Result = res
-	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
+	return ec.marshalNPostsConnection2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPostsConnection(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Discussion_lockStatus(ctx context.Context, field graphql.CollectedField, obj *model.Discussion) (ret graphql.Marshaler) {
+func (ec *executionContext) _Discussion_postsByTag(ctx context.Context, field graphql.CollectedField, obj *model.Discussion) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3701,13 +5445,20 @@ func (ec *executionContext) _Discussion_lockStatus(ctx context.Context, field gr
 		Object:   "Discussion",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Discussion_postsByTag_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.LockStatus, nil
+		return ec.resolvers.Discussion().PostsByTag(rctx, obj, args["tag"].(string), args["after"].(*string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3719,12 +5470,12 @@ func (ec *executionContext) _Discussion_lockStatus(ctx context.Context, field gr
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(*model.PostsConnection)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNPostsConnection2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPostsConnection(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Discussion_archive(ctx context.Context, field graphql.CollectedField, obj *model.Discussion) (ret graphql.Marshaler) {
+func (ec *executionContext) _Discussion_topTags(ctx context.Context, field graphql.CollectedField, obj *model.Discussion) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3739,23 +5490,33 @@ func (ec *executionContext) _Discussion_archive(ctx context.Context, field graph
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Discussion_topTags_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Discussion().Archive(rctx, obj)
+		return ec.resolvers.Discussion().TopTags(rctx, obj, args["limit"].(*int))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*model.DiscussionArchive)
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.TagSummary)
 	fc.Result = res
-	return ec.marshalODiscussionArchive2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussionArchive(ctx, field.Selections, res)
+	return ec.marshalNTagSummary2ᚕᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐTagSummaryᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _DiscussionAccessLink_discussion(ctx context.Context, field graphql.CollectedField, obj *model.DiscussionAccessLink) (ret graphql.Marshaler) {
+func (ec *executionContext) _Discussion_iconURL(ctx context.Context, field graphql.CollectedField, obj *model.Discussion) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3763,33 +5524,30 @@ func (ec *executionContext) _DiscussionAccessLink_discussion(ctx context.Context
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "DiscussionAccessLink",
+		Object:   "Discussion",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.DiscussionAccessLink().Discussion(rctx, obj)
+		return obj.IconURL, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Discussion)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNDiscussion2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussion(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _DiscussionAccessLink_linkSlug(ctx context.Context, field graphql.CollectedField, obj *model.DiscussionAccessLink) (ret graphql.Marshaler) {
+func (ec *executionContext) _Discussion_participants(ctx context.Context, field graphql.CollectedField, obj *model.Discussion) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3797,33 +5555,30 @@ func (ec *executionContext) _DiscussionAccessLink_linkSlug(ctx context.Context,
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "DiscussionAccessLink",
+		Object:   "Discussion",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.LinkSlug, nil
+		return ec.resolvers.Discussion().Participants(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]*model.Participant)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOParticipant2ᚕᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐParticipantᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _DiscussionAccessLink_url(ctx context.Context, field graphql.CollectedField, obj *model.DiscussionAccessLink) (ret graphql.Marshaler) {
+func (ec *executionContext) _Discussion_title(ctx context.Context, field graphql.CollectedField, obj *model.Discussion) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3831,16 +5586,16 @@ func (ec *executionContext) _DiscussionAccessLink_url(ctx context.Context, field
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "DiscussionAccessLink",
+		Object:   "Discussion",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.DiscussionAccessLink().URL(rctx, obj)
+		return obj.Title, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3857,7 +5612,7 @@ func (ec *executionContext) _DiscussionAccessLink_url(ctx context.Context, field
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _DiscussionAccessLink_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.DiscussionAccessLink) (ret graphql.Marshaler) {
+func (ec *executionContext) _Discussion_description(ctx context.Context, field graphql.CollectedField, obj *model.Discussion) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3865,7 +5620,7 @@ func (ec *executionContext) _DiscussionAccessLink_createdAt(ctx context.Context,
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "DiscussionAccessLink",
+		Object:   "Discussion",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -3874,7 +5629,7 @@ func (ec *executionContext) _DiscussionAccessLink_createdAt(ctx context.Context,
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CreatedAt, nil
+		return obj.Description, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3886,12 +5641,12 @@ func (ec *executionContext) _DiscussionAccessLink_createdAt(ctx context.Context,
 		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _DiscussionAccessLink_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model.DiscussionAccessLink) (ret graphql.Marshaler) {
+func (ec *executionContext) _Discussion_titleHistory(ctx context.Context, field graphql.CollectedField, obj *model.Discussion) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3899,33 +5654,30 @@ func (ec *executionContext) _DiscussionAccessLink_updatedAt(ctx context.Context,
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "DiscussionAccessLink",
+		Object:   "Discussion",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.UpdatedAt, nil
+		return ec.resolvers.Discussion().TitleHistory(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.([]*model.HistoricalString)
 	fc.Result = res
-	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalOHistoricalString2ᚕᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐHistoricalStringᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _DiscussionAccessLink_isDeleted(ctx context.Context, field graphql.CollectedField, obj *model.DiscussionAccessLink) (ret graphql.Marshaler) {
+func (ec *executionContext) _Discussion_descriptionHistory(ctx context.Context, field graphql.CollectedField, obj *model.Discussion) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3933,7 +5685,7 @@ func (ec *executionContext) _DiscussionAccessLink_isDeleted(ctx context.Context,
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "DiscussionAccessLink",
+		Object:   "Discussion",
 		Field:    field,
 		Args:     nil,
 		IsMethod: true,
@@ -3942,24 +5694,21 @@ func (ec *executionContext) _DiscussionAccessLink_isDeleted(ctx context.Context,
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.DiscussionAccessLink().IsDeleted(rctx, obj)
+		return ec.resolvers.Discussion().DescriptionHistory(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.([]*model.HistoricalString)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalOHistoricalString2ᚕᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐHistoricalStringᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _DiscussionAccessRequest_id(ctx context.Context, field graphql.CollectedField, obj *model.DiscussionAccessRequest) (ret graphql.Marshaler) {
+func (ec *executionContext) _Discussion_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.Discussion) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3967,16 +5716,16 @@ func (ec *executionContext) _DiscussionAccessRequest_id(ctx context.Context, fie
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "DiscussionAccessRequest",
+		Object:   "Discussion",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return ec.resolvers.Discussion().CreatedAt(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3990,10 +5739,10 @@ func (ec *executionContext) _DiscussionAccessRequest_id(ctx context.Context, fie
 	}
 	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNID2string(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _DiscussionAccessRequest_userProfile(ctx context.Context, field graphql.CollectedField, obj *model.DiscussionAccessRequest) (ret graphql.Marshaler) {
+func (ec *executionContext) _Discussion_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model.Discussion) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4001,7 +5750,7 @@ func (ec *executionContext) _DiscussionAccessRequest_userProfile(ctx context.Con
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "DiscussionAccessRequest",
+		Object:   "Discussion",
 		Field:    field,
 		Args:     nil,
 		IsMethod: true,
@@ -4010,21 +5759,24 @@ func (ec *executionContext) _DiscussionAccessRequest_userProfile(ctx context.Con
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.DiscussionAccessRequest().UserProfile(rctx, obj)
+		return ec.resolvers.Discussion().UpdatedAt(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.UserProfile)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOUserProfile2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐUserProfile(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _DiscussionAccessRequest_discussion(ctx context.Context, field graphql.CollectedField, obj *model.DiscussionAccessRequest) (ret graphql.Marshaler) {
+func (ec *executionContext) _Discussion_meParticipant(ctx context.Context, field graphql.CollectedField, obj *model.Discussion) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4032,7 +5784,7 @@ func (ec *executionContext) _DiscussionAccessRequest_discussion(ctx context.Cont
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "DiscussionAccessRequest",
+		Object:   "Discussion",
 		Field:    field,
 		Args:     nil,
 		IsMethod: true,
@@ -4041,24 +5793,21 @@ func (ec *executionContext) _DiscussionAccessRequest_discussion(ctx context.Cont
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.DiscussionAccessRequest().Discussion(rctx, obj)
+		return ec.resolvers.Discussion().MeParticipant(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Discussion)
+	res := resTmp.(*model.Participant)
 	fc.Result = res
-	return ec.marshalNDiscussion2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussion(ctx, field.Selections, res)
+	return ec.marshalOParticipant2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐParticipant(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _DiscussionAccessRequest_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.DiscussionAccessRequest) (ret graphql.Marshaler) {
+func (ec *executionContext) _Discussion_meAvailableParticipants(ctx context.Context, field graphql.CollectedField, obj *model.Discussion) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4066,33 +5815,30 @@ func (ec *executionContext) _DiscussionAccessRequest_createdAt(ctx context.Conte
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "DiscussionAccessRequest",
+		Object:   "Discussion",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CreatedAt, nil
+		return ec.resolvers.Discussion().MeAvailableParticipants(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]*model.Participant)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOParticipant2ᚕᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐParticipantᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _DiscussionAccessRequest_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model.DiscussionAccessRequest) (ret graphql.Marshaler) {
+func (ec *executionContext) _Discussion_meCanJoinDiscussion(ctx context.Context, field graphql.CollectedField, obj *model.Discussion) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4100,16 +5846,16 @@ func (ec *executionContext) _DiscussionAccessRequest_updatedAt(ctx context.Conte
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "DiscussionAccessRequest",
+		Object:   "Discussion",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.UpdatedAt, nil
+		return ec.resolvers.Discussion().MeCanJoinDiscussion(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4121,12 +5867,12 @@ func (ec *executionContext) _DiscussionAccessRequest_updatedAt(ctx context.Conte
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*model.CanJoinDiscussionResponse)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNCanJoinDiscussionResponse2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐCanJoinDiscussionResponse(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _DiscussionAccessRequest_isDeleted(ctx context.Context, field graphql.CollectedField, obj *model.DiscussionAccessRequest) (ret graphql.Marshaler) {
+func (ec *executionContext) _Discussion_meViewer(ctx context.Context, field graphql.CollectedField, obj *model.Discussion) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4134,33 +5880,30 @@ func (ec *executionContext) _DiscussionAccessRequest_isDeleted(ctx context.Conte
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "DiscussionAccessRequest",
+		Object:   "Discussion",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.IsDeleted, nil
+		return ec.resolvers.Discussion().MeViewer(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(*model.Viewer)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalOViewer2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐViewer(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _DiscussionAccessRequest_status(ctx context.Context, field graphql.CollectedField, obj *model.DiscussionAccessRequest) (ret graphql.Marshaler) {
+func (ec *executionContext) _Discussion_meUnreadCount(ctx context.Context, field graphql.CollectedField, obj *model.Discussion) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4168,16 +5911,16 @@ func (ec *executionContext) _DiscussionAccessRequest_status(ctx context.Context,
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "DiscussionAccessRequest",
+		Object:   "Discussion",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Status, nil
+		return ec.resolvers.Discussion().MeUnreadCount(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4189,12 +5932,12 @@ func (ec *executionContext) _DiscussionAccessRequest_status(ctx context.Context,
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.InviteRequestStatus)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNInviteRequestStatus2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐInviteRequestStatus(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _DiscussionArchive_archive(ctx context.Context, field graphql.CollectedField, obj *model.DiscussionArchive) (ret graphql.Marshaler) {
+func (ec *executionContext) _Discussion_meUnreadMentionCount(ctx context.Context, field graphql.CollectedField, obj *model.Discussion) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4202,7 +5945,7 @@ func (ec *executionContext) _DiscussionArchive_archive(ctx context.Context, fiel
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "DiscussionArchive",
+		Object:   "Discussion",
 		Field:    field,
 		Args:     nil,
 		IsMethod: true,
@@ -4211,7 +5954,7 @@ func (ec *executionContext) _DiscussionArchive_archive(ctx context.Context, fiel
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.DiscussionArchive().Archive(rctx, obj)
+		return ec.resolvers.Discussion().MeUnreadMentionCount(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4223,12 +5966,12 @@ func (ec *executionContext) _DiscussionArchive_archive(ctx context.Context, fiel
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _DiscussionArchive_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.DiscussionArchive) (ret graphql.Marshaler) {
+func (ec *executionContext) _Discussion_meNotificationSettings(ctx context.Context, field graphql.CollectedField, obj *model.Discussion) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4236,33 +5979,30 @@ func (ec *executionContext) _DiscussionArchive_createdAt(ctx context.Context, fi
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "DiscussionArchive",
+		Object:   "Discussion",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CreatedAt, nil
+		return ec.resolvers.Discussion().MeNotificationSettings(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.(*model.DiscussionUserNotificationSetting)
 	fc.Result = res
-	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalODiscussionUserNotificationSetting2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussionUserNotificationSetting(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _DiscussionSubscriptionEvent_eventType(ctx context.Context, field graphql.CollectedField, obj *model.DiscussionSubscriptionEvent) (ret graphql.Marshaler) {
+func (ec *executionContext) _Discussion_meDiscussionStatus(ctx context.Context, field graphql.CollectedField, obj *model.Discussion) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4270,33 +6010,30 @@ func (ec *executionContext) _DiscussionSubscriptionEvent_eventType(ctx context.C
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "DiscussionSubscriptionEvent",
+		Object:   "Discussion",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.EventType, nil
+		return ec.resolvers.Discussion().MeDiscussionStatus(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(model.DiscussionSubscriptionEventType)
+	res := resTmp.(*model.DiscussionUserAccessState)
 	fc.Result = res
-	return ec.marshalNDiscussionSubscriptionEventType2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussionSubscriptionEventType(ctx, field.Selections, res)
+	return ec.marshalODiscussionUserAccessState2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussionUserAccessState(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _DiscussionSubscriptionEvent_entity(ctx context.Context, field graphql.CollectedField, obj *model.DiscussionSubscriptionEvent) (ret graphql.Marshaler) {
+func (ec *executionContext) _Discussion_meBanReason(ctx context.Context, field graphql.CollectedField, obj *model.Discussion) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4304,33 +6041,30 @@ func (ec *executionContext) _DiscussionSubscriptionEvent_entity(ctx context.Cont
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "DiscussionSubscriptionEvent",
+		Object:   "Discussion",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Entity, nil
+		return ec.resolvers.Discussion().MeBanReason(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(model.DiscussionSubscriptionEntity)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNDiscussionSubscriptionEntity2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussionSubscriptionEntity(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _DiscussionUserAccess_discussion(ctx context.Context, field graphql.CollectedField, obj *model.DiscussionUserAccess) (ret graphql.Marshaler) {
+func (ec *executionContext) _Discussion_meModeratorRole(ctx context.Context, field graphql.CollectedField, obj *model.Discussion) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4338,7 +6072,7 @@ func (ec *executionContext) _DiscussionUserAccess_discussion(ctx context.Context
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "DiscussionUserAccess",
+		Object:   "Discussion",
 		Field:    field,
 		Args:     nil,
 		IsMethod: true,
@@ -4347,24 +6081,21 @@ func (ec *executionContext) _DiscussionUserAccess_discussion(ctx context.Context
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.DiscussionUserAccess().Discussion(rctx, obj)
+		return ec.resolvers.Discussion().MeModeratorRole(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Discussion)
+	res := resTmp.(*model.ModeratorRole)
 	fc.Result = res
-	return ec.marshalNDiscussion2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussion(ctx, field.Selections, res)
+	return ec.marshalOModeratorRole2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐModeratorRole(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _DiscussionUserAccess_user(ctx context.Context, field graphql.CollectedField, obj *model.DiscussionUserAccess) (ret graphql.Marshaler) {
+func (ec *executionContext) _Discussion_accessRequests(ctx context.Context, field graphql.CollectedField, obj *model.Discussion) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4372,7 +6103,7 @@ func (ec *executionContext) _DiscussionUserAccess_user(ctx context.Context, fiel
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "DiscussionUserAccess",
+		Object:   "Discussion",
 		Field:    field,
 		Args:     nil,
 		IsMethod: true,
@@ -4381,24 +6112,21 @@ func (ec *executionContext) _DiscussionUserAccess_user(ctx context.Context, fiel
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.DiscussionUserAccess().User(rctx, obj)
+		return ec.resolvers.Discussion().AccessRequests(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.User)
+	res := resTmp.([]*model.DiscussionAccessRequest)
 	fc.Result = res
-	return ec.marshalNUser2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐUser(ctx, field.Selections, res)
+	return ec.marshalODiscussionAccessRequest2ᚕᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussionAccessRequestᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _DiscussionUserAccess_state(ctx context.Context, field graphql.CollectedField, obj *model.DiscussionUserAccess) (ret graphql.Marshaler) {
+func (ec *executionContext) _Discussion_scheduledPosts(ctx context.Context, field graphql.CollectedField, obj *model.Discussion) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4406,33 +6134,30 @@ func (ec *executionContext) _DiscussionUserAccess_state(ctx context.Context, fie
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "DiscussionUserAccess",
+		Object:   "Discussion",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.State, nil
+		return ec.resolvers.Discussion().ScheduledPosts(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(model.DiscussionUserAccessState)
+	res := resTmp.([]*model.ScheduledPost)
 	fc.Result = res
-	return ec.marshalNDiscussionUserAccessState2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussionUserAccessState(ctx, field.Selections, res)
+	return ec.marshalOScheduledPost2ᚕᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐScheduledPostᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _DiscussionUserAccess_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.DiscussionUserAccess) (ret graphql.Marshaler) {
+func (ec *executionContext) _Discussion_moderationLog(ctx context.Context, field graphql.CollectedField, obj *model.Discussion) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4440,16 +6165,23 @@ func (ec *executionContext) _DiscussionUserAccess_createdAt(ctx context.Context,
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "DiscussionUserAccess",
+		Object:   "Discussion",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Discussion_moderationLog_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CreatedAt, nil
+		return ec.resolvers.Discussion().ModerationLog(rctx, obj, args["after"].(*string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4461,12 +6193,12 @@ func (ec *executionContext) _DiscussionUserAccess_createdAt(ctx context.Context,
 		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.(*model.ModerationLogConnection)
 	fc.Result = res
-	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNModerationLogConnection2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐModerationLogConnection(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _DiscussionUserAccess_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model.DiscussionUserAccess) (ret graphql.Marshaler) {
+func (ec *executionContext) _Discussion_reports(ctx context.Context, field graphql.CollectedField, obj *model.Discussion) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4474,16 +6206,23 @@ func (ec *executionContext) _DiscussionUserAccess_updatedAt(ctx context.Context,
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "DiscussionUserAccess",
+		Object:   "Discussion",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Discussion_reports_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.UpdatedAt, nil
+		return ec.resolvers.Discussion().Reports(rctx, obj, args["status"].(*model.PostReportStatus), args["after"].(*string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4495,12 +6234,12 @@ func (ec *executionContext) _DiscussionUserAccess_updatedAt(ctx context.Context,
 		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.(*model.PostReportConnection)
 	fc.Result = res
-	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNPostReportConnection2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPostReportConnection(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _DiscussionUserAccess_isDeleted(ctx context.Context, field graphql.CollectedField, obj *model.DiscussionUserAccess) (ret graphql.Marshaler) {
+func (ec *executionContext) _Discussion_discussionAccessLink(ctx context.Context, field graphql.CollectedField, obj *model.Discussion) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4508,7 +6247,7 @@ func (ec *executionContext) _DiscussionUserAccess_isDeleted(ctx context.Context,
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "DiscussionUserAccess",
+		Object:   "Discussion",
 		Field:    field,
 		Args:     nil,
 		IsMethod: true,
@@ -4517,24 +6256,21 @@ func (ec *executionContext) _DiscussionUserAccess_isDeleted(ctx context.Context,
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.DiscussionUserAccess().IsDeleted(rctx, obj)
+		return ec.resolvers.Discussion().DiscussionAccessLink(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(*model.DiscussionAccessLink)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalODiscussionAccessLink2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussionAccessLink(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _DiscussionUserAccess_request(ctx context.Context, field graphql.CollectedField, obj *model.DiscussionUserAccess) (ret graphql.Marshaler) {
+func (ec *executionContext) _Discussion_discussionJoinability(ctx context.Context, field graphql.CollectedField, obj *model.Discussion) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4542,7 +6278,7 @@ func (ec *executionContext) _DiscussionUserAccess_request(ctx context.Context, f
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "DiscussionUserAccess",
+		Object:   "Discussion",
 		Field:    field,
 		Args:     nil,
 		IsMethod: true,
@@ -4551,21 +6287,24 @@ func (ec *executionContext) _DiscussionUserAccess_request(ctx context.Context, f
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.DiscussionUserAccess().Request(rctx, obj)
+		return ec.resolvers.Discussion().DiscussionJoinability(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.DiscussionAccessRequest)
+	res := resTmp.(model.DiscussionJoinabilitySetting)
 	fc.Result = res
-	return ec.marshalODiscussionAccessRequest2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussionAccessRequest(ctx, field.Selections, res)
+	return ec.marshalNDiscussionJoinabilitySetting2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussionJoinabilitySetting(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _HistoricalString_value(ctx context.Context, field graphql.CollectedField, obj *model.HistoricalString) (ret graphql.Marshaler) {
+func (ec *executionContext) _Discussion_shuffleCount(ctx context.Context, field graphql.CollectedField, obj *model.Discussion) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4573,7 +6312,7 @@ func (ec *executionContext) _HistoricalString_value(ctx context.Context, field g
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "HistoricalString",
+		Object:   "Discussion",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -4582,7 +6321,7 @@ func (ec *executionContext) _HistoricalString_value(ctx context.Context, field g
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Value, nil
+		return obj.ShuffleCount, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4594,12 +6333,12 @@ func (ec *executionContext) _HistoricalString_value(ctx context.Context, field g
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _HistoricalString_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.HistoricalString) (ret graphql.Marshaler) {
+func (ec *executionContext) _Discussion_secondsUntilShuffle(ctx context.Context, field graphql.CollectedField, obj *model.Discussion) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4607,33 +6346,30 @@ func (ec *executionContext) _HistoricalString_createdAt(ctx context.Context, fie
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "HistoricalString",
+		Object:   "Discussion",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CreatedAt, nil
+		return ec.resolvers.Discussion().SecondsUntilShuffle(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.(*int)
 	fc.Result = res
-	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Media_id(ctx context.Context, field graphql.CollectedField, obj *model.Media) (ret graphql.Marshaler) {
+func (ec *executionContext) _Discussion_lockStatus(ctx context.Context, field graphql.CollectedField, obj *model.Discussion) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4641,7 +6377,7 @@ func (ec *executionContext) _Media_id(ctx context.Context, field graphql.Collect
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Media",
+		Object:   "Discussion",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -4650,7 +6386,7 @@ func (ec *executionContext) _Media_id(ctx context.Context, field graphql.Collect
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.LockStatus, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4662,12 +6398,12 @@ func (ec *executionContext) _Media_id(ctx context.Context, field graphql.Collect
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNID2string(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Media_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.Media) (ret graphql.Marshaler) {
+func (ec *executionContext) _Discussion_everyoneMentionPolicy(ctx context.Context, field graphql.CollectedField, obj *model.Discussion) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4675,16 +6411,16 @@ func (ec *executionContext) _Media_createdAt(ctx context.Context, field graphql.
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Media",
+		Object:   "Discussion",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CreatedAt, nil
+		return ec.resolvers.Discussion().EveryoneMentionPolicy(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4696,12 +6432,12 @@ func (ec *executionContext) _Media_createdAt(ctx context.Context, field graphql.
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(model.EveryoneMentionPolicy)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNEveryoneMentionPolicy2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐEveryoneMentionPolicy(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Media_isDeleted(ctx context.Context, field graphql.CollectedField, obj *model.Media) (ret graphql.Marshaler) {
+func (ec *executionContext) _Discussion_slowModeSeconds(ctx context.Context, field graphql.CollectedField, obj *model.Discussion) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4709,7 +6445,7 @@ func (ec *executionContext) _Media_isDeleted(ctx context.Context, field graphql.
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Media",
+		Object:   "Discussion",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -4718,7 +6454,7 @@ func (ec *executionContext) _Media_isDeleted(ctx context.Context, field graphql.
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.IsDeleted, nil
+		return obj.SlowModeSeconds, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4730,12 +6466,12 @@ func (ec *executionContext) _Media_isDeleted(ctx context.Context, field graphql.
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Media_deletedReasonCode(ctx context.Context, field graphql.CollectedField, obj *model.Media) (ret graphql.Marshaler) {
+func (ec *executionContext) _Discussion_archive(ctx context.Context, field graphql.CollectedField, obj *model.Discussion) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4743,16 +6479,16 @@ func (ec *executionContext) _Media_deletedReasonCode(ctx context.Context, field
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Media",
+		Object:   "Discussion",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DeletedReasonCode, nil
+		return ec.resolvers.Discussion().Archive(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4761,12 +6497,12 @@ func (ec *executionContext) _Media_deletedReasonCode(ctx context.Context, field
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*model.PostDeletedReason)
+	res := resTmp.(*model.DiscussionArchive)
 	fc.Result = res
-	return ec.marshalOPostDeletedReason2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPostDeletedReason(ctx, field.Selections, res)
+	return ec.marshalODiscussionArchive2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussionArchive(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Media_mediaType(ctx context.Context, field graphql.CollectedField, obj *model.Media) (ret graphql.Marshaler) {
+func (ec *executionContext) _DiscussionAccessLink_discussion(ctx context.Context, field graphql.CollectedField, obj *model.DiscussionAccessLink) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4774,30 +6510,33 @@ func (ec *executionContext) _Media_mediaType(ctx context.Context, field graphql.
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Media",
+		Object:   "DiscussionAccessLink",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.MediaType, nil
+		return ec.resolvers.DiscussionAccessLink().Discussion(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*model.Discussion)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNDiscussion2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussion(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Media_mediaSize(ctx context.Context, field graphql.CollectedField, obj *model.Media) (ret graphql.Marshaler) {
+func (ec *executionContext) _DiscussionAccessLink_linkSlug(ctx context.Context, field graphql.CollectedField, obj *model.DiscussionAccessLink) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4805,7 +6544,7 @@ func (ec *executionContext) _Media_mediaSize(ctx context.Context, field graphql.
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Media",
+		Object:   "DiscussionAccessLink",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -4814,21 +6553,24 @@ func (ec *executionContext) _Media_mediaSize(ctx context.Context, field graphql.
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.MediaSize, nil
+		return obj.LinkSlug, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.MediaSize)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOMediaSize2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐMediaSize(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Media_assetLocation(ctx context.Context, field graphql.CollectedField, obj *model.Media) (ret graphql.Marshaler) {
+func (ec *executionContext) _DiscussionAccessLink_url(ctx context.Context, field graphql.CollectedField, obj *model.DiscussionAccessLink) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4836,30 +6578,33 @@ func (ec *executionContext) _Media_assetLocation(ctx context.Context, field grap
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Media",
+		Object:   "DiscussionAccessLink",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.AssetLocation, nil
+		return ec.resolvers.DiscussionAccessLink().URL(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _MediaSize_height(ctx context.Context, field graphql.CollectedField, obj *model.MediaSize) (ret graphql.Marshaler) {
+func (ec *executionContext) _DiscussionAccessLink_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.DiscussionAccessLink) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4867,7 +6612,7 @@ func (ec *executionContext) _MediaSize_height(ctx context.Context, field graphql
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "MediaSize",
+		Object:   "DiscussionAccessLink",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -4876,7 +6621,7 @@ func (ec *executionContext) _MediaSize_height(ctx context.Context, field graphql
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Height, nil
+		return obj.CreatedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4888,12 +6633,12 @@ func (ec *executionContext) _MediaSize_height(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _MediaSize_width(ctx context.Context, field graphql.CollectedField, obj *model.MediaSize) (ret graphql.Marshaler) {
+func (ec *executionContext) _DiscussionAccessLink_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model.DiscussionAccessLink) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4901,7 +6646,7 @@ func (ec *executionContext) _MediaSize_width(ctx context.Context, field graphql.
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "MediaSize",
+		Object:   "DiscussionAccessLink",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -4910,7 +6655,7 @@ func (ec *executionContext) _MediaSize_width(ctx context.Context, field graphql.
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Width, nil
+		return obj.UpdatedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4922,12 +6667,12 @@ func (ec *executionContext) _MediaSize_width(ctx context.Context, field graphql.
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _MediaSize_sizeKb(ctx context.Context, field graphql.CollectedField, obj *model.MediaSize) (ret graphql.Marshaler) {
+func (ec *executionContext) _DiscussionAccessLink_isDeleted(ctx context.Context, field graphql.CollectedField, obj *model.DiscussionAccessLink) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4935,16 +6680,16 @@ func (ec *executionContext) _MediaSize_sizeKb(ctx context.Context, field graphql
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "MediaSize",
+		Object:   "DiscussionAccessLink",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.SizeKb, nil
+		return ec.resolvers.DiscussionAccessLink().IsDeleted(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4956,12 +6701,12 @@ func (ec *executionContext) _MediaSize_sizeKb(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.(float64)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Moderator_id(ctx context.Context, field graphql.CollectedField, obj *model.Moderator) (ret graphql.Marshaler) {
+func (ec *executionContext) _DiscussionAccessRequest_id(ctx context.Context, field graphql.CollectedField, obj *model.DiscussionAccessRequest) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4969,7 +6714,7 @@ func (ec *executionContext) _Moderator_id(ctx context.Context, field graphql.Col
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Moderator",
+		Object:   "DiscussionAccessRequest",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -4995,7 +6740,7 @@ func (ec *executionContext) _Moderator_id(ctx context.Context, field graphql.Col
 	return ec.marshalNID2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Moderator_discussion(ctx context.Context, field graphql.CollectedField, obj *model.Moderator) (ret graphql.Marshaler) {
+func (ec *executionContext) _DiscussionAccessRequest_userProfile(ctx context.Context, field graphql.CollectedField, obj *model.DiscussionAccessRequest) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5003,7 +6748,7 @@ func (ec *executionContext) _Moderator_discussion(ctx context.Context, field gra
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Moderator",
+		Object:   "DiscussionAccessRequest",
 		Field:    field,
 		Args:     nil,
 		IsMethod: true,
@@ -5012,7 +6757,7 @@ func (ec *executionContext) _Moderator_discussion(ctx context.Context, field gra
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Moderator().Discussion(rctx, obj)
+		return ec.resolvers.DiscussionAccessRequest().UserProfile(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5021,12 +6766,12 @@ func (ec *executionContext) _Moderator_discussion(ctx context.Context, field gra
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*model.Discussion)
+	res := resTmp.(*model.UserProfile)
 	fc.Result = res
-	return ec.marshalODiscussion2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussion(ctx, field.Selections, res)
+	return ec.marshalOUserProfile2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐUserProfile(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Moderator_userProfile(ctx context.Context, field graphql.CollectedField, obj *model.Moderator) (ret graphql.Marshaler) {
+func (ec *executionContext) _DiscussionAccessRequest_discussion(ctx context.Context, field graphql.CollectedField, obj *model.DiscussionAccessRequest) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5034,7 +6779,7 @@ func (ec *executionContext) _Moderator_userProfile(ctx context.Context, field gr
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Moderator",
+		Object:   "DiscussionAccessRequest",
 		Field:    field,
 		Args:     nil,
 		IsMethod: true,
@@ -5043,7 +6788,7 @@ func (ec *executionContext) _Moderator_userProfile(ctx context.Context, field gr
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Moderator().UserProfile(rctx, obj)
+		return ec.resolvers.DiscussionAccessRequest().Discussion(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5055,12 +6800,12 @@ func (ec *executionContext) _Moderator_userProfile(ctx context.Context, field gr
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.UserProfile)
+	res := resTmp.(*model.Discussion)
 	fc.Result = res
-	return ec.marshalNUserProfile2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐUserProfile(ctx, field.Selections, res)
+	return ec.marshalNDiscussion2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussion(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Mutation_addDiscussionParticipant(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _DiscussionAccessRequest_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.DiscussionAccessRequest) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5068,23 +6813,16 @@ func (ec *executionContext) _Mutation_addDiscussionParticipant(ctx context.Conte
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Mutation",
+		Object:   "DiscussionAccessRequest",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Mutation_addDiscussionParticipant_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().AddDiscussionParticipant(rctx, args["discussionID"].(string), args["userID"].(string), args["discussionParticipantInput"].(model.AddDiscussionParticipantInput))
+		return obj.CreatedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5096,12 +6834,12 @@ func (ec *executionContext) _Mutation_addDiscussionParticipant(ctx context.Conte
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Participant)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNParticipant2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐParticipant(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Mutation_addPost(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _DiscussionAccessRequest_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model.DiscussionAccessRequest) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5109,23 +6847,16 @@ func (ec *executionContext) _Mutation_addPost(ctx context.Context, field graphql
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Mutation",
+		Object:   "DiscussionAccessRequest",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Mutation_addPost_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().AddPost(rctx, args["discussionID"].(string), args["participantID"].(string), args["postContent"].(model.PostContentInput))
+		return obj.UpdatedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5137,12 +6868,12 @@ func (ec *executionContext) _Mutation_addPost(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Post)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNPost2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPost(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Mutation_createDiscussion(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _DiscussionAccessRequest_isDeleted(ctx context.Context, field graphql.CollectedField, obj *model.DiscussionAccessRequest) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5150,23 +6881,16 @@ func (ec *executionContext) _Mutation_createDiscussion(ctx context.Context, fiel
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Mutation",
+		Object:   "DiscussionAccessRequest",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Mutation_createDiscussion_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().CreateDiscussion(rctx, args["anonymityType"].(model.AnonymityType), args["title"].(string), args["description"].(*string), args["publicAccess"].(*bool), args["discussionSettings"].(model.DiscussionCreationSettings))
+		return obj.IsDeleted, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5178,12 +6902,12 @@ func (ec *executionContext) _Mutation_createDiscussion(ctx context.Context, fiel
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Discussion)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNDiscussion2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussion(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Mutation_updateParticipant(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _DiscussionAccessRequest_status(ctx context.Context, field graphql.CollectedField, obj *model.DiscussionAccessRequest) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5191,23 +6915,16 @@ func (ec *executionContext) _Mutation_updateParticipant(ctx context.Context, fie
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Mutation",
+		Object:   "DiscussionAccessRequest",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Mutation_updateParticipant_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().UpdateParticipant(rctx, args["discussionID"].(string), args["participantID"].(string), args["updateInput"].(model.UpdateParticipantInput))
+		return obj.Status, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5219,12 +6936,12 @@ func (ec *executionContext) _Mutation_updateParticipant(ctx context.Context, fie
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Participant)
+	res := resTmp.(model.InviteRequestStatus)
 	fc.Result = res
-	return ec.marshalNParticipant2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐParticipant(ctx, field.Selections, res)
+	return ec.marshalNInviteRequestStatus2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐInviteRequestStatus(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Mutation_upsertUserDevice(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _DiscussionArchive_archive(ctx context.Context, field graphql.CollectedField, obj *model.DiscussionArchive) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5232,23 +6949,16 @@ func (ec *executionContext) _Mutation_upsertUserDevice(ctx context.Context, fiel
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Mutation",
+		Object:   "DiscussionArchive",
 		Field:    field,
 		Args:     nil,
 		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Mutation_upsertUserDevice_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().UpsertUserDevice(rctx, args["userID"].(*string), args["platform"].(model.Platform), args["deviceID"].(string), args["token"].(*string))
+		return ec.resolvers.DiscussionArchive().Archive(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5260,12 +6970,12 @@ func (ec *executionContext) _Mutation_upsertUserDevice(ctx context.Context, fiel
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.UserDevice)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNUserDevice2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐUserDevice(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Mutation_updateDiscussion(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _DiscussionArchive_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.DiscussionArchive) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5273,23 +6983,16 @@ func (ec *executionContext) _Mutation_updateDiscussion(ctx context.Context, fiel
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Mutation",
+		Object:   "DiscussionArchive",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Mutation_updateDiscussion_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().UpdateDiscussion(rctx, args["discussionID"].(string), args["input"].(model.DiscussionInput))
+		return obj.CreatedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5301,12 +7004,12 @@ func (ec *executionContext) _Mutation_updateDiscussion(ctx context.Context, fiel
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Discussion)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalNDiscussion2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussion(ctx, field.Selections, res)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Mutation_updateDiscussionUserSettings(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _DiscussionSubscriptionEvent_sequence(ctx context.Context, field graphql.CollectedField, obj *model.DiscussionSubscriptionEvent) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5314,23 +7017,16 @@ func (ec *executionContext) _Mutation_updateDiscussionUserSettings(ctx context.C
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Mutation",
+		Object:   "DiscussionSubscriptionEvent",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Mutation_updateDiscussionUserSettings_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().UpdateDiscussionUserSettings(rctx, args["discussionID"].(string), args["settings"].(model.DiscussionUserSettings))
+		return obj.Sequence, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5342,12 +7038,12 @@ func (ec *executionContext) _Mutation_updateDiscussionUserSettings(ctx context.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.DiscussionUserAccess)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNDiscussionUserAccess2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussionUserAccess(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Mutation_requestAccessToDiscussion(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _DiscussionSubscriptionEvent_eventType(ctx context.Context, field graphql.CollectedField, obj *model.DiscussionSubscriptionEvent) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5355,23 +7051,16 @@ func (ec *executionContext) _Mutation_requestAccessToDiscussion(ctx context.Cont
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Mutation",
+		Object:   "DiscussionSubscriptionEvent",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Mutation_requestAccessToDiscussion_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().RequestAccessToDiscussion(rctx, args["discussionID"].(string))
+		return obj.EventType, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5383,12 +7072,12 @@ func (ec *executionContext) _Mutation_requestAccessToDiscussion(ctx context.Cont
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.DiscussionAccessRequest)
+	res := resTmp.(model.DiscussionSubscriptionEventType)
 	fc.Result = res
-	return ec.marshalNDiscussionAccessRequest2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussionAccessRequest(ctx, field.Selections, res)
+	return ec.marshalNDiscussionSubscriptionEventType2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussionSubscriptionEventType(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Mutation_respondToRequestAccess(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _DiscussionSubscriptionEvent_entity(ctx context.Context, field graphql.CollectedField, obj *model.DiscussionSubscriptionEvent) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5396,23 +7085,16 @@ func (ec *executionContext) _Mutation_respondToRequestAccess(ctx context.Context
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Mutation",
+		Object:   "DiscussionSubscriptionEvent",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Mutation_respondToRequestAccess_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().RespondToRequestAccess(rctx, args["requestID"].(string), args["response"].(model.InviteRequestStatus))
+		return obj.Entity, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5424,12 +7106,12 @@ func (ec *executionContext) _Mutation_respondToRequestAccess(ctx context.Context
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.DiscussionAccessRequest)
+	res := resTmp.(model.DiscussionSubscriptionEntity)
 	fc.Result = res
-	return ec.marshalNDiscussionAccessRequest2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussionAccessRequest(ctx, field.Selections, res)
+	return ec.marshalNDiscussionSubscriptionEntity2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussionSubscriptionEntity(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Mutation_deletePost(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _DiscussionUserAccess_discussion(ctx context.Context, field graphql.CollectedField, obj *model.DiscussionUserAccess) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5437,23 +7119,16 @@ func (ec *executionContext) _Mutation_deletePost(ctx context.Context, field grap
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Mutation",
+		Object:   "DiscussionUserAccess",
 		Field:    field,
 		Args:     nil,
 		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Mutation_deletePost_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().DeletePost(rctx, args["discussionID"].(string), args["postID"].(string))
+		return ec.resolvers.DiscussionUserAccess().Discussion(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5465,12 +7140,12 @@ func (ec *executionContext) _Mutation_deletePost(ctx context.Context, field grap
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Post)
+	res := resTmp.(*model.Discussion)
 	fc.Result = res
-	return ec.marshalNPost2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPost(ctx, field.Selections, res)
+	return ec.marshalNDiscussion2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussion(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Mutation_banParticipant(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _DiscussionUserAccess_user(ctx context.Context, field graphql.CollectedField, obj *model.DiscussionUserAccess) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5478,23 +7153,16 @@ func (ec *executionContext) _Mutation_banParticipant(ctx context.Context, field
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Mutation",
+		Object:   "DiscussionUserAccess",
 		Field:    field,
 		Args:     nil,
 		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Mutation_banParticipant_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().BanParticipant(rctx, args["discussionID"].(string), args["participantID"].(string))
+		return ec.resolvers.DiscussionUserAccess().User(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5506,12 +7174,12 @@ func (ec *executionContext) _Mutation_banParticipant(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Participant)
+	res := resTmp.(*model.User)
 	fc.Result = res
-	return ec.marshalNParticipant2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐParticipant(ctx, field.Selections, res)
+	return ec.marshalNUser2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐUser(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Mutation_shuffleDiscussion(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _DiscussionUserAccess_state(ctx context.Context, field graphql.CollectedField, obj *model.DiscussionUserAccess) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5519,23 +7187,16 @@ func (ec *executionContext) _Mutation_shuffleDiscussion(ctx context.Context, fie
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Mutation",
+		Object:   "DiscussionUserAccess",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Mutation_shuffleDiscussion_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().ShuffleDiscussion(rctx, args["discussionID"].(string), args["inFutureSeconds"].(*int))
+		return obj.State, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5547,12 +7208,12 @@ func (ec *executionContext) _Mutation_shuffleDiscussion(ctx context.Context, fie
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Discussion)
+	res := resTmp.(model.DiscussionUserAccessState)
 	fc.Result = res
-	return ec.marshalNDiscussion2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussion(ctx, field.Selections, res)
+	return ec.marshalNDiscussionUserAccessState2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussionUserAccessState(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Mutation_setLastPostViewed(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _DiscussionUserAccess_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.DiscussionUserAccess) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5560,23 +7221,16 @@ func (ec *executionContext) _Mutation_setLastPostViewed(ctx context.Context, fie
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Mutation",
+		Object:   "DiscussionUserAccess",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Mutation_setLastPostViewed_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().SetLastPostViewed(rctx, args["viewerID"].(string), args["postID"].(string))
+		return obj.CreatedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5588,12 +7242,12 @@ func (ec *executionContext) _Mutation_setLastPostViewed(ctx context.Context, fie
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Viewer)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalNViewer2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐViewer(ctx, field.Selections, res)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Mutation_muteParticipants(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _DiscussionUserAccess_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model.DiscussionUserAccess) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5601,23 +7255,16 @@ func (ec *executionContext) _Mutation_muteParticipants(ctx context.Context, fiel
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Mutation",
+		Object:   "DiscussionUserAccess",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Mutation_muteParticipants_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().MuteParticipants(rctx, args["discussionID"].(string), args["participantIDs"].([]string), args["mutedForSeconds"].(int))
+		return obj.UpdatedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5629,12 +7276,12 @@ func (ec *executionContext) _Mutation_muteParticipants(ctx context.Context, fiel
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.Participant)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalNParticipant2ᚕᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐParticipantᚄ(ctx, field.Selections, res)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Mutation_unmuteParticipants(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _DiscussionUserAccess_isDeleted(ctx context.Context, field graphql.CollectedField, obj *model.DiscussionUserAccess) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5642,23 +7289,16 @@ func (ec *executionContext) _Mutation_unmuteParticipants(ctx context.Context, fi
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Mutation",
+		Object:   "DiscussionUserAccess",
 		Field:    field,
 		Args:     nil,
 		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Mutation_unmuteParticipants_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().UnmuteParticipants(rctx, args["discussionID"].(string), args["participantIDs"].([]string))
+		return ec.resolvers.DiscussionUserAccess().IsDeleted(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5670,12 +7310,12 @@ func (ec *executionContext) _Mutation_unmuteParticipants(ctx context.Context, fi
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.Participant)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNParticipant2ᚕᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐParticipantᚄ(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _PageInfo_startCursor(ctx context.Context, field graphql.CollectedField, obj *model.PageInfo) (ret graphql.Marshaler) {
+func (ec *executionContext) _DiscussionUserAccess_request(ctx context.Context, field graphql.CollectedField, obj *model.DiscussionUserAccess) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5683,16 +7323,16 @@ func (ec *executionContext) _PageInfo_startCursor(ctx context.Context, field gra
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "PageInfo",
+		Object:   "DiscussionUserAccess",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.StartCursor, nil
+		return ec.resolvers.DiscussionUserAccess().Request(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5701,12 +7341,12 @@ func (ec *executionContext) _PageInfo_startCursor(ctx context.Context, field gra
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*model.DiscussionAccessRequest)
 	fc.Result = res
-	return ec.marshalOID2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalODiscussionAccessRequest2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussionAccessRequest(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _PageInfo_endCursor(ctx context.Context, field graphql.CollectedField, obj *model.PageInfo) (ret graphql.Marshaler) {
+func (ec *executionContext) _GroupMention_id(ctx context.Context, field graphql.CollectedField, obj *model.GroupMention) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5714,7 +7354,7 @@ func (ec *executionContext) _PageInfo_endCursor(ctx context.Context, field graph
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "PageInfo",
+		Object:   "GroupMention",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -5723,21 +7363,24 @@ func (ec *executionContext) _PageInfo_endCursor(ctx context.Context, field graph
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.EndCursor, nil
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOID2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNID2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _PageInfo_hasNextPage(ctx context.Context, field graphql.CollectedField, obj *model.PageInfo) (ret graphql.Marshaler) {
+func (ec *executionContext) _GroupMention_group(ctx context.Context, field graphql.CollectedField, obj *model.GroupMention) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5745,7 +7388,7 @@ func (ec *executionContext) _PageInfo_hasNextPage(ctx context.Context, field gra
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "PageInfo",
+		Object:   "GroupMention",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -5754,7 +7397,7 @@ func (ec *executionContext) _PageInfo_hasNextPage(ctx context.Context, field gra
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.HasNextPage, nil
+		return obj.Group, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5766,12 +7409,12 @@ func (ec *executionContext) _PageInfo_hasNextPage(ctx context.Context, field gra
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(model.MentionGroup)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNMentionGroup2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐMentionGroup(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Participant_id(ctx context.Context, field graphql.CollectedField, obj *model.Participant) (ret graphql.Marshaler) {
+func (ec *executionContext) _HistoricalString_value(ctx context.Context, field graphql.CollectedField, obj *model.HistoricalString) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5779,7 +7422,7 @@ func (ec *executionContext) _Participant_id(ctx context.Context, field graphql.C
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Participant",
+		Object:   "HistoricalString",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -5788,7 +7431,7 @@ func (ec *executionContext) _Participant_id(ctx context.Context, field graphql.C
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.Value, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5802,10 +7445,10 @@ func (ec *executionContext) _Participant_id(ctx context.Context, field graphql.C
 	}
 	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNID2string(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Participant_participantID(ctx context.Context, field graphql.CollectedField, obj *model.Participant) (ret graphql.Marshaler) {
+func (ec *executionContext) _HistoricalString_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.HistoricalString) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5813,7 +7456,7 @@ func (ec *executionContext) _Participant_participantID(ctx context.Context, fiel
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Participant",
+		Object:   "HistoricalString",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -5822,21 +7465,24 @@ func (ec *executionContext) _Participant_participantID(ctx context.Context, fiel
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ParticipantID, nil
+		return obj.CreatedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalOInt2int(ctx, field.Selections, res)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Participant_discussion(ctx context.Context, field graphql.CollectedField, obj *model.Participant) (ret graphql.Marshaler) {
+func (ec *executionContext) _LinkPreview_url(ctx context.Context, field graphql.CollectedField, obj *model.LinkPreview) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5844,30 +7490,33 @@ func (ec *executionContext) _Participant_discussion(ctx context.Context, field g
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Participant",
+		Object:   "LinkPreview",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Participant().Discussion(rctx, obj)
+		return obj.URL, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Discussion)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalODiscussion2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussion(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Participant_viewer(ctx context.Context, field graphql.CollectedField, obj *model.Participant) (ret graphql.Marshaler) {
+func (ec *executionContext) _LinkPreview_title(ctx context.Context, field graphql.CollectedField, obj *model.LinkPreview) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5875,33 +7524,30 @@ func (ec *executionContext) _Participant_viewer(ctx context.Context, field graph
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Participant",
+		Object:   "LinkPreview",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Participant().Viewer(rctx, obj)
+		return obj.Title, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Viewer)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNViewer2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐViewer(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Participant_posts(ctx context.Context, field graphql.CollectedField, obj *model.Participant) (ret graphql.Marshaler) {
+func (ec *executionContext) _LinkPreview_description(ctx context.Context, field graphql.CollectedField, obj *model.LinkPreview) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5909,16 +7555,16 @@ func (ec *executionContext) _Participant_posts(ctx context.Context, field graphq
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Participant",
+		Object:   "LinkPreview",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Participant().Posts(rctx, obj)
+		return obj.Description, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5927,12 +7573,12 @@ func (ec *executionContext) _Participant_posts(ctx context.Context, field graphq
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]*model.Post)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalOPost2ᚕᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPostᚄ(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Participant_isAnonymous(ctx context.Context, field graphql.CollectedField, obj *model.Participant) (ret graphql.Marshaler) {
+func (ec *executionContext) _LinkPreview_imageURL(ctx context.Context, field graphql.CollectedField, obj *model.LinkPreview) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5940,7 +7586,7 @@ func (ec *executionContext) _Participant_isAnonymous(ctx context.Context, field
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Participant",
+		Object:   "LinkPreview",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -5949,24 +7595,21 @@ func (ec *executionContext) _Participant_isAnonymous(ctx context.Context, field
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.IsAnonymous, nil
+		return obj.ImageURL, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Participant_gradientColor(ctx context.Context, field graphql.CollectedField, obj *model.Participant) (ret graphql.Marshaler) {
+func (ec *executionContext) _LinkPreview_siteName(ctx context.Context, field graphql.CollectedField, obj *model.LinkPreview) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5974,16 +7617,16 @@ func (ec *executionContext) _Participant_gradientColor(ctx context.Context, fiel
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Participant",
+		Object:   "LinkPreview",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Participant().GradientColor(rctx, obj)
+		return obj.SiteName, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5992,12 +7635,12 @@ func (ec *executionContext) _Participant_gradientColor(ctx context.Context, fiel
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*model.GradientColor)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalOGradientColor2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐGradientColor(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Participant_inviter(ctx context.Context, field graphql.CollectedField, obj *model.Participant) (ret graphql.Marshaler) {
+func (ec *executionContext) _Media_id(ctx context.Context, field graphql.CollectedField, obj *model.Media) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6005,16 +7648,16 @@ func (ec *executionContext) _Participant_inviter(ctx context.Context, field grap
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Participant",
+		Object:   "Media",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Participant().Inviter(rctx, obj)
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6026,12 +7669,12 @@ func (ec *executionContext) _Participant_inviter(ctx context.Context, field grap
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Participant)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNParticipant2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐParticipant(ctx, field.Selections, res)
+	return ec.marshalNID2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Participant_hasJoined(ctx context.Context, field graphql.CollectedField, obj *model.Participant) (ret graphql.Marshaler) {
+func (ec *executionContext) _Media_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.Media) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6039,7 +7682,7 @@ func (ec *executionContext) _Participant_hasJoined(ctx context.Context, field gr
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Participant",
+		Object:   "Media",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -6048,7 +7691,7 @@ func (ec *executionContext) _Participant_hasJoined(ctx context.Context, field gr
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.HasJoined, nil
+		return obj.CreatedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6060,12 +7703,12 @@ func (ec *executionContext) _Participant_hasJoined(ctx context.Context, field gr
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Participant_userProfile(ctx context.Context, field graphql.CollectedField, obj *model.Participant) (ret graphql.Marshaler) {
+func (ec *executionContext) _Media_isDeleted(ctx context.Context, field graphql.CollectedField, obj *model.Media) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6073,30 +7716,33 @@ func (ec *executionContext) _Participant_userProfile(ctx context.Context, field
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Participant",
+		Object:   "Media",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Participant().UserProfile(rctx, obj)
+		return obj.IsDeleted, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.UserProfile)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalOUserProfile2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐUserProfile(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Participant_isBanned(ctx context.Context, field graphql.CollectedField, obj *model.Participant) (ret graphql.Marshaler) {
+func (ec *executionContext) _Media_deletedReasonCode(ctx context.Context, field graphql.CollectedField, obj *model.Media) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6104,7 +7750,7 @@ func (ec *executionContext) _Participant_isBanned(ctx context.Context, field gra
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Participant",
+		Object:   "Media",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -6113,24 +7759,21 @@ func (ec *executionContext) _Participant_isBanned(ctx context.Context, field gra
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.IsBanned, nil
+		return obj.DeletedReasonCode, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(*model.PostDeletedReason)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalOPostDeletedReason2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPostDeletedReason(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Participant_anonDisplayName(ctx context.Context, field graphql.CollectedField, obj *model.Participant) (ret graphql.Marshaler) {
+func (ec *executionContext) _Media_mediaType(ctx context.Context, field graphql.CollectedField, obj *model.Media) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6138,16 +7781,16 @@ func (ec *executionContext) _Participant_anonDisplayName(ctx context.Context, fi
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Participant",
+		Object:   "Media",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Participant().AnonDisplayName(rctx, obj)
+		return obj.MediaType, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6161,7 +7804,7 @@ func (ec *executionContext) _Participant_anonDisplayName(ctx context.Context, fi
 	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Participant_mutedForSeconds(ctx context.Context, field graphql.CollectedField, obj *model.Participant) (ret graphql.Marshaler) {
+func (ec *executionContext) _Media_mediaSize(ctx context.Context, field graphql.CollectedField, obj *model.Media) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6169,16 +7812,16 @@ func (ec *executionContext) _Participant_mutedForSeconds(ctx context.Context, fi
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Participant",
+		Object:   "Media",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Participant().MutedForSeconds(rctx, obj)
+		return obj.MediaSize, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6187,12 +7830,12 @@ func (ec *executionContext) _Participant_mutedForSeconds(ctx context.Context, fi
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*int)
+	res := resTmp.(*model.MediaSize)
 	fc.Result = res
-	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
+	return ec.marshalOMediaSize2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐMediaSize(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _ParticipantProfile_isAnonymous(ctx context.Context, field graphql.CollectedField, obj *model.ParticipantProfile) (ret graphql.Marshaler) {
+func (ec *executionContext) _Media_assetLocation(ctx context.Context, field graphql.CollectedField, obj *model.Media) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6200,7 +7843,7 @@ func (ec *executionContext) _ParticipantProfile_isAnonymous(ctx context.Context,
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "ParticipantProfile",
+		Object:   "Media",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -6209,7 +7852,7 @@ func (ec *executionContext) _ParticipantProfile_isAnonymous(ctx context.Context,
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.IsAnonymous, nil
+		return obj.AssetLocation, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6218,12 +7861,12 @@ func (ec *executionContext) _ParticipantProfile_isAnonymous(ctx context.Context,
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*bool)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalOBoolean2ᚖbool(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _ParticipantProfile_gradientColor(ctx context.Context, field graphql.CollectedField, obj *model.ParticipantProfile) (ret graphql.Marshaler) {
+func (ec *executionContext) _MediaSize_height(ctx context.Context, field graphql.CollectedField, obj *model.MediaSize) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6231,7 +7874,7 @@ func (ec *executionContext) _ParticipantProfile_gradientColor(ctx context.Contex
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "ParticipantProfile",
+		Object:   "MediaSize",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -6240,21 +7883,24 @@ func (ec *executionContext) _ParticipantProfile_gradientColor(ctx context.Contex
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.GradientColor, nil
+		return obj.Height, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.GradientColor)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalOGradientColor2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐGradientColor(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _ParticipantsConnection_totalCount(ctx context.Context, field graphql.CollectedField, obj *model.ParticipantsConnection) (ret graphql.Marshaler) {
+func (ec *executionContext) _MediaSize_width(ctx context.Context, field graphql.CollectedField, obj *model.MediaSize) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6262,16 +7908,16 @@ func (ec *executionContext) _ParticipantsConnection_totalCount(ctx context.Conte
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "ParticipantsConnection",
+		Object:   "MediaSize",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TotalCount(), nil
+		return obj.Width, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6288,7 +7934,7 @@ func (ec *executionContext) _ParticipantsConnection_totalCount(ctx context.Conte
 	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _ParticipantsConnection_edges(ctx context.Context, field graphql.CollectedField, obj *model.ParticipantsConnection) (ret graphql.Marshaler) {
+func (ec *executionContext) _MediaSize_sizeKb(ctx context.Context, field graphql.CollectedField, obj *model.MediaSize) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6296,16 +7942,50 @@ func (ec *executionContext) _ParticipantsConnection_edges(ctx context.Context, f
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "ParticipantsConnection",
+		Object:   "MediaSize",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.ParticipantsConnection().Edges(rctx, obj)
+		return obj.SizeKb, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(float64)
+	fc.Result = res
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ModerationLogConnection_edges(ctx context.Context, field graphql.CollectedField, obj *model.ModerationLogConnection) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "ModerationLogConnection",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Edges, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6314,12 +7994,12 @@ func (ec *executionContext) _ParticipantsConnection_edges(ctx context.Context, f
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]*model.ParticipantsEdge)
+	res := resTmp.([]*model.ModerationLogEdge)
 	fc.Result = res
-	return ec.marshalOParticipantsEdge2ᚕᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐParticipantsEdgeᚄ(ctx, field.Selections, res)
+	return ec.marshalOModerationLogEdge2ᚕᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐModerationLogEdgeᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _ParticipantsConnection_pageInfo(ctx context.Context, field graphql.CollectedField, obj *model.ParticipantsConnection) (ret graphql.Marshaler) {
+func (ec *executionContext) _ModerationLogConnection_pageInfo(ctx context.Context, field graphql.CollectedField, obj *model.ModerationLogConnection) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6327,16 +8007,16 @@ func (ec *executionContext) _ParticipantsConnection_pageInfo(ctx context.Context
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "ParticipantsConnection",
+		Object:   "ModerationLogConnection",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.PageInfo(), nil
+		return obj.PageInfo, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6353,7 +8033,7 @@ func (ec *executionContext) _ParticipantsConnection_pageInfo(ctx context.Context
 	return ec.marshalNPageInfo2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPageInfo(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _ParticipantsEdge_cursor(ctx context.Context, field graphql.CollectedField, obj *model.ParticipantsEdge) (ret graphql.Marshaler) {
+func (ec *executionContext) _ModerationLogEdge_cursor(ctx context.Context, field graphql.CollectedField, obj *model.ModerationLogEdge) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6361,7 +8041,7 @@ func (ec *executionContext) _ParticipantsEdge_cursor(ctx context.Context, field
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "ParticipantsEdge",
+		Object:   "ModerationLogEdge",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -6387,7 +8067,7 @@ func (ec *executionContext) _ParticipantsEdge_cursor(ctx context.Context, field
 	return ec.marshalNID2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _ParticipantsEdge_node(ctx context.Context, field graphql.CollectedField, obj *model.ParticipantsEdge) (ret graphql.Marshaler) {
+func (ec *executionContext) _ModerationLogEdge_node(ctx context.Context, field graphql.CollectedField, obj *model.ModerationLogEdge) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6395,7 +8075,7 @@ func (ec *executionContext) _ParticipantsEdge_node(ctx context.Context, field gr
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "ParticipantsEdge",
+		Object:   "ModerationLogEdge",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -6413,12 +8093,12 @@ func (ec *executionContext) _ParticipantsEdge_node(ctx context.Context, field gr
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*model.Participant)
+	res := resTmp.(*model.ModerationLogEntry)
 	fc.Result = res
-	return ec.marshalOParticipant2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐParticipant(ctx, field.Selections, res)
+	return ec.marshalOModerationLogEntry2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐModerationLogEntry(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Post_id(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
+func (ec *executionContext) _ModerationLogEntry_id(ctx context.Context, field graphql.CollectedField, obj *model.ModerationLogEntry) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6426,7 +8106,7 @@ func (ec *executionContext) _Post_id(ctx context.Context, field graphql.Collecte
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Post",
+		Object:   "ModerationLogEntry",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -6452,7 +8132,7 @@ func (ec *executionContext) _Post_id(ctx context.Context, field graphql.Collecte
 	return ec.marshalNID2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Post_isDeleted(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
+func (ec *executionContext) _ModerationLogEntry_actor(ctx context.Context, field graphql.CollectedField, obj *model.ModerationLogEntry) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6460,7 +8140,7 @@ func (ec *executionContext) _Post_isDeleted(ctx context.Context, field graphql.C
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Post",
+		Object:   "ModerationLogEntry",
 		Field:    field,
 		Args:     nil,
 		IsMethod: true,
@@ -6469,24 +8149,21 @@ func (ec *executionContext) _Post_isDeleted(ctx context.Context, field graphql.C
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Post().IsDeleted(rctx, obj)
+		return ec.resolvers.ModerationLogEntry().Actor(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(*model.UserProfile)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalOUserProfile2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐUserProfile(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Post_deletedReasonCode(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
+func (ec *executionContext) _ModerationLogEntry_action(ctx context.Context, field graphql.CollectedField, obj *model.ModerationLogEntry) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6494,7 +8171,7 @@ func (ec *executionContext) _Post_deletedReasonCode(ctx context.Context, field g
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Post",
+		Object:   "ModerationLogEntry",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -6503,21 +8180,24 @@ func (ec *executionContext) _Post_deletedReasonCode(ctx context.Context, field g
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DeletedReasonCode, nil
+		return obj.Action, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.PostDeletedReason)
+	res := resTmp.(model.ModerationAction)
 	fc.Result = res
-	return ec.marshalOPostDeletedReason2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPostDeletedReason(ctx, field.Selections, res)
+	return ec.marshalNModerationAction2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐModerationAction(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Post_content(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
+func (ec *executionContext) _ModerationLogEntry_targetType(ctx context.Context, field graphql.CollectedField, obj *model.ModerationLogEntry) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6525,30 +8205,33 @@ func (ec *executionContext) _Post_content(ctx context.Context, field graphql.Col
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Post",
+		Object:   "ModerationLogEntry",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Post().Content(rctx, obj)
+		return obj.TargetType, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(model.ModerationTargetType)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNModerationTargetType2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐModerationTargetType(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Post_discussion(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
+func (ec *executionContext) _ModerationLogEntry_targetID(ctx context.Context, field graphql.CollectedField, obj *model.ModerationLogEntry) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6556,33 +8239,30 @@ func (ec *executionContext) _Post_discussion(ctx context.Context, field graphql.
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Post",
+		Object:   "ModerationLogEntry",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Post().Discussion(rctx, obj)
+		return obj.TargetID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Discussion)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNDiscussion2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussion(ctx, field.Selections, res)
+	return ec.marshalOID2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Post_participant(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
+func (ec *executionContext) _ModerationLogEntry_reason(ctx context.Context, field graphql.CollectedField, obj *model.ModerationLogEntry) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6590,16 +8270,16 @@ func (ec *executionContext) _Post_participant(ctx context.Context, field graphql
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Post",
+		Object:   "ModerationLogEntry",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Post().Participant(rctx, obj)
+		return obj.Reason, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6608,12 +8288,12 @@ func (ec *executionContext) _Post_participant(ctx context.Context, field graphql
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*model.Participant)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalOParticipant2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐParticipant(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Post_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
+func (ec *executionContext) _ModerationLogEntry_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.ModerationLogEntry) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6621,16 +8301,16 @@ func (ec *executionContext) _Post_createdAt(ctx context.Context, field graphql.C
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Post",
+		Object:   "ModerationLogEntry",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Post().CreatedAt(rctx, obj)
+		return obj.CreatedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6642,12 +8322,12 @@ func (ec *executionContext) _Post_createdAt(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Post_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
+func (ec *executionContext) _Moderator_id(ctx context.Context, field graphql.CollectedField, obj *model.Moderator) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6655,16 +8335,16 @@ func (ec *executionContext) _Post_updatedAt(ctx context.Context, field graphql.C
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Post",
+		Object:   "Moderator",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Post().UpdatedAt(rctx, obj)
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6678,10 +8358,10 @@ func (ec *executionContext) _Post_updatedAt(ctx context.Context, field graphql.C
 	}
 	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNID2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Post_quotedPost(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
+func (ec *executionContext) _Moderator_discussion(ctx context.Context, field graphql.CollectedField, obj *model.Moderator) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6689,16 +8369,16 @@ func (ec *executionContext) _Post_quotedPost(ctx context.Context, field graphql.
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Post",
+		Object:   "Moderator",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.QuotedPost, nil
+		return ec.resolvers.Moderator().Discussion(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6707,12 +8387,12 @@ func (ec *executionContext) _Post_quotedPost(ctx context.Context, field graphql.
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*model.Post)
+	res := resTmp.(*model.Discussion)
 	fc.Result = res
-	return ec.marshalOPost2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPost(ctx, field.Selections, res)
+	return ec.marshalODiscussion2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussion(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Post_mentionedEntities(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
+func (ec *executionContext) _Moderator_userProfile(ctx context.Context, field graphql.CollectedField, obj *model.Moderator) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6720,7 +8400,7 @@ func (ec *executionContext) _Post_mentionedEntities(ctx context.Context, field g
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Post",
+		Object:   "Moderator",
 		Field:    field,
 		Args:     nil,
 		IsMethod: true,
@@ -6729,21 +8409,24 @@ func (ec *executionContext) _Post_mentionedEntities(ctx context.Context, field g
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Post().MentionedEntities(rctx, obj)
+		return ec.resolvers.Moderator().UserProfile(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]model.Entity)
+	res := resTmp.(*model.UserProfile)
 	fc.Result = res
-	return ec.marshalOEntity2ᚕgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐEntityᚄ(ctx, field.Selections, res)
+	return ec.marshalNUserProfile2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐUserProfile(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Post_media(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_addDiscussionParticipant(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6751,30 +8434,40 @@ func (ec *executionContext) _Post_media(ctx context.Context, field graphql.Colle
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Post",
+		Object:   "Mutation",
 		Field:    field,
 		Args:     nil,
 		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_addDiscussionParticipant_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Post().Media(rctx, obj)
+		return ec.resolvers.Mutation().AddDiscussionParticipant(rctx, args["discussionID"].(string), args["userID"].(string), args["discussionParticipantInput"].(model.AddDiscussionParticipantInput))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Media)
+	res := resTmp.(*model.Participant)
 	fc.Result = res
-	return ec.marshalOMedia2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐMedia(ctx, field.Selections, res)
+	return ec.marshalNParticipant2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐParticipant(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Post_postType(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_addPost(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6782,16 +8475,23 @@ func (ec *executionContext) _Post_postType(ctx context.Context, field graphql.Co
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Post",
+		Object:   "Mutation",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_addPost_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.PostType, nil
+		return ec.resolvers.Mutation().AddPost(rctx, args["discussionID"].(string), args["participantID"].(string), args["postContent"].(model.PostContentInput))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6803,12 +8503,12 @@ func (ec *executionContext) _Post_postType(ctx context.Context, field graphql.Co
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.PostType)
+	res := resTmp.(*model.Post)
 	fc.Result = res
-	return ec.marshalNPostType2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPostType(ctx, field.Selections, res)
+	return ec.marshalNPost2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPost(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _PostsConnection_edges(ctx context.Context, field graphql.CollectedField, obj *model.PostsConnection) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_createDiscussion(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6816,30 +8516,40 @@ func (ec *executionContext) _PostsConnection_edges(ctx context.Context, field gr
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "PostsConnection",
+		Object:   "Mutation",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_createDiscussion_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Edges, nil
+		return ec.resolvers.Mutation().CreateDiscussion(rctx, args["anonymityType"].(model.AnonymityType), args["title"].(string), args["description"].(*string), args["publicAccess"].(*bool), args["discussionSettings"].(model.DiscussionCreationSettings))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.PostsEdge)
+	res := resTmp.(*model.Discussion)
 	fc.Result = res
-	return ec.marshalOPostsEdge2ᚕᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPostsEdgeᚄ(ctx, field.Selections, res)
+	return ec.marshalNDiscussion2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussion(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _PostsConnection_pageInfo(ctx context.Context, field graphql.CollectedField, obj *model.PostsConnection) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_updateParticipant(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6847,16 +8557,23 @@ func (ec *executionContext) _PostsConnection_pageInfo(ctx context.Context, field
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "PostsConnection",
+		Object:   "Mutation",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_updateParticipant_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.PageInfo, nil
+		return ec.resolvers.Mutation().UpdateParticipant(rctx, args["discussionID"].(string), args["participantID"].(string), args["updateInput"].(model.UpdateParticipantInput))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6868,12 +8585,12 @@ func (ec *executionContext) _PostsConnection_pageInfo(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.PageInfo)
+	res := resTmp.(*model.Participant)
 	fc.Result = res
-	return ec.marshalNPageInfo2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPageInfo(ctx, field.Selections, res)
+	return ec.marshalNParticipant2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐParticipant(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _PostsEdge_cursor(ctx context.Context, field graphql.CollectedField, obj *model.PostsEdge) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_upsertUserDevice(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6881,16 +8598,23 @@ func (ec *executionContext) _PostsEdge_cursor(ctx context.Context, field graphql
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "PostsEdge",
+		Object:   "Mutation",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_upsertUserDevice_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Cursor, nil
+		return ec.resolvers.Mutation().UpsertUserDevice(rctx, args["userID"].(*string), args["platform"].(model.Platform), args["deviceID"].(string), args["token"].(*string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6902,12 +8626,12 @@ func (ec *executionContext) _PostsEdge_cursor(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*model.UserDevice)
 	fc.Result = res
-	return ec.marshalNID2string(ctx, field.Selections, res)
+	return ec.marshalNUserDevice2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐUserDevice(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _PostsEdge_node(ctx context.Context, field graphql.CollectedField, obj *model.PostsEdge) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_updateDiscussion(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6915,30 +8639,40 @@ func (ec *executionContext) _PostsEdge_node(ctx context.Context, field graphql.C
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "PostsEdge",
+		Object:   "Mutation",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_updateDiscussion_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Node, nil
+		return ec.resolvers.Mutation().UpdateDiscussion(rctx, args["discussionID"].(string), args["input"].(model.DiscussionInput))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Post)
+	res := resTmp.(*model.Discussion)
 	fc.Result = res
-	return ec.marshalOPost2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPost(ctx, field.Selections, res)
+	return ec.marshalNDiscussion2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussion(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_discussion(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_updateDiscussionUserSettings(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6946,7 +8680,7 @@ func (ec *executionContext) _Query_discussion(ctx context.Context, field graphql
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Query",
+		Object:   "Mutation",
 		Field:    field,
 		Args:     nil,
 		IsMethod: true,
@@ -6954,7 +8688,7 @@ func (ec *executionContext) _Query_discussion(ctx context.Context, field graphql
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query_discussion_args(ctx, rawArgs)
+	args, err := ec.field_Mutation_updateDiscussionUserSettings_args(ctx, rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
@@ -6962,21 +8696,24 @@ func (ec *executionContext) _Query_discussion(ctx context.Context, field graphql
 	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Discussion(rctx, args["id"].(string))
+		return ec.resolvers.Mutation().UpdateDiscussionUserSettings(rctx, args["discussionID"].(string), args["settings"].(model.DiscussionUserSettings))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Discussion)
+	res := resTmp.(*model.DiscussionUserAccess)
 	fc.Result = res
-	return ec.marshalODiscussion2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussion(ctx, field.Selections, res)
+	return ec.marshalNDiscussionUserAccess2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussionUserAccess(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_discussionByLinkSlug(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_requestAccessToDiscussion(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6984,7 +8721,7 @@ func (ec *executionContext) _Query_discussionByLinkSlug(ctx context.Context, fie
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Query",
+		Object:   "Mutation",
 		Field:    field,
 		Args:     nil,
 		IsMethod: true,
@@ -6992,7 +8729,7 @@ func (ec *executionContext) _Query_discussionByLinkSlug(ctx context.Context, fie
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query_discussionByLinkSlug_args(ctx, rawArgs)
+	args, err := ec.field_Mutation_requestAccessToDiscussion_args(ctx, rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
@@ -7000,21 +8737,24 @@ func (ec *executionContext) _Query_discussionByLinkSlug(ctx context.Context, fie
 	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().DiscussionByLinkSlug(rctx, args["slug"].(string))
+		return ec.resolvers.Mutation().RequestAccessToDiscussion(rctx, args["discussionID"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Discussion)
+	res := resTmp.(*model.DiscussionAccessRequest)
 	fc.Result = res
-	return ec.marshalODiscussion2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussion(ctx, field.Selections, res)
+	return ec.marshalNDiscussionAccessRequest2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussionAccessRequest(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_listDiscussions(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_respondToRequestAccess(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7022,7 +8762,7 @@ func (ec *executionContext) _Query_listDiscussions(ctx context.Context, field gr
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Query",
+		Object:   "Mutation",
 		Field:    field,
 		Args:     nil,
 		IsMethod: true,
@@ -7030,7 +8770,7 @@ func (ec *executionContext) _Query_listDiscussions(ctx context.Context, field gr
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query_listDiscussions_args(ctx, rawArgs)
+	args, err := ec.field_Mutation_respondToRequestAccess_args(ctx, rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
@@ -7038,21 +8778,24 @@ func (ec *executionContext) _Query_listDiscussions(ctx context.Context, field gr
 	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().ListDiscussions(rctx, args["state"].(model.DiscussionUserAccessState))
+		return ec.resolvers.Mutation().RespondToRequestAccess(rctx, args["requestID"].(string), args["response"].(model.InviteRequestStatus))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.Discussion)
+	res := resTmp.(*model.DiscussionAccessRequest)
 	fc.Result = res
-	return ec.marshalODiscussion2ᚕᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussionᚄ(ctx, field.Selections, res)
+	return ec.marshalNDiscussionAccessRequest2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussionAccessRequest(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_user(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_deletePost(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7060,7 +8803,7 @@ func (ec *executionContext) _Query_user(ctx context.Context, field graphql.Colle
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Query",
+		Object:   "Mutation",
 		Field:    field,
 		Args:     nil,
 		IsMethod: true,
@@ -7068,7 +8811,7 @@ func (ec *executionContext) _Query_user(ctx context.Context, field graphql.Colle
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query_user_args(ctx, rawArgs)
+	args, err := ec.field_Mutation_deletePost_args(ctx, rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
@@ -7076,7 +8819,7 @@ func (ec *executionContext) _Query_user(ctx context.Context, field graphql.Colle
 	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().User(rctx, args["id"].(string))
+		return ec.resolvers.Mutation().DeletePost(rctx, args["discussionID"].(string), args["postID"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7088,12 +8831,12 @@ func (ec *executionContext) _Query_user(ctx context.Context, field graphql.Colle
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.User)
+	res := resTmp.(*model.Post)
 	fc.Result = res
-	return ec.marshalNUser2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐUser(ctx, field.Selections, res)
+	return ec.marshalNPost2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPost(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_me(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_editPost(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7101,16 +8844,23 @@ func (ec *executionContext) _Query_me(ctx context.Context, field graphql.Collect
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Query",
+		Object:   "Mutation",
 		Field:    field,
 		Args:     nil,
 		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_editPost_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Me(rctx)
+		return ec.resolvers.Mutation().EditPost(rctx, args["discussionID"].(string), args["postID"].(string), args["postContent"].(model.PostContentInput))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7122,12 +8872,12 @@ func (ec *executionContext) _Query_me(ctx context.Context, field graphql.Collect
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.User)
+	res := resTmp.(*model.Post)
 	fc.Result = res
-	return ec.marshalNUser2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐUser(ctx, field.Selections, res)
+	return ec.marshalNPost2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPost(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_schedulePost(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7135,7 +8885,7 @@ func (ec *executionContext) _Query___type(ctx context.Context, field graphql.Col
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Query",
+		Object:   "Mutation",
 		Field:    field,
 		Args:     nil,
 		IsMethod: true,
@@ -7143,7 +8893,7 @@ func (ec *executionContext) _Query___type(ctx context.Context, field graphql.Col
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query___type_args(ctx, rawArgs)
+	args, err := ec.field_Mutation_schedulePost_args(ctx, rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
@@ -7151,21 +8901,24 @@ func (ec *executionContext) _Query___type(ctx context.Context, field graphql.Col
 	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.introspectType(args["name"].(string))
+		return ec.resolvers.Mutation().SchedulePost(rctx, args["discussionID"].(string), args["participantID"].(string), args["postContent"].(model.PostContentInput), args["publishAt"].(time.Time))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.(*model.ScheduledPost)
 	fc.Result = res
-	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalNScheduledPost2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐScheduledPost(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_cancelScheduledPost(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7173,38 +8926,48 @@ func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.C
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Query",
+		Object:   "Mutation",
 		Field:    field,
 		Args:     nil,
 		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_cancelScheduledPost_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.introspectSchema()
+		return ec.resolvers.Mutation().CancelScheduledPost(rctx, args["scheduledPostID"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Schema)
+	res := resTmp.(*model.ScheduledPost)
 	fc.Result = res
-	return ec.marshalO__Schema2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐSchema(ctx, field.Selections, res)
+	return ec.marshalNScheduledPost2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐScheduledPost(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Subscription_postAdded(ctx context.Context, field graphql.CollectedField) (ret func() graphql.Marshaler) {
+func (ec *executionContext) _Mutation_addReaction(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = nil
+			ret = graphql.Null
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Subscription",
+		Object:   "Mutation",
 		Field:    field,
 		Args:     nil,
 		IsMethod: true,
@@ -7212,47 +8975,40 @@ func (ec *executionContext) _Subscription_postAdded(ctx context.Context, field g
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Subscription_postAdded_args(ctx, rawArgs)
+	args, err := ec.field_Mutation_addReaction_args(ctx, rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
-		return nil
+		return graphql.Null
 	}
 	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Subscription().PostAdded(rctx, args["discussionID"].(string))
+		return ec.resolvers.Mutation().AddReaction(rctx, args["discussionID"].(string), args["participantID"].(string), args["postID"].(string), args["reaction"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
-		return nil
+		return graphql.Null
 	}
 	if resTmp == nil {
-		return nil
-	}
-	return func() graphql.Marshaler {
-		res, ok := <-resTmp.(<-chan *model.Post)
-		if !ok {
-			return nil
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
 		}
-		return graphql.WriterFunc(func(w io.Writer) {
-			w.Write([]byte{'{'})
-			graphql.MarshalString(field.Alias).MarshalGQL(w)
-			w.Write([]byte{':'})
-			ec.marshalOPost2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPost(ctx, field.Selections, res).MarshalGQL(w)
-			w.Write([]byte{'}'})
-		})
+		return graphql.Null
 	}
+	res := resTmp.(*model.Post)
+	fc.Result = res
+	return ec.marshalNPost2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPost(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Subscription_onDiscussionEvent(ctx context.Context, field graphql.CollectedField) (ret func() graphql.Marshaler) {
+func (ec *executionContext) _Mutation_removeReaction(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = nil
+			ret = graphql.Null
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Subscription",
+		Object:   "Mutation",
 		Field:    field,
 		Args:     nil,
 		IsMethod: true,
@@ -7260,39 +9016,32 @@ func (ec *executionContext) _Subscription_onDiscussionEvent(ctx context.Context,
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Subscription_onDiscussionEvent_args(ctx, rawArgs)
+	args, err := ec.field_Mutation_removeReaction_args(ctx, rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
-		return nil
+		return graphql.Null
 	}
 	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Subscription().OnDiscussionEvent(rctx, args["discussionID"].(string))
+		return ec.resolvers.Mutation().RemoveReaction(rctx, args["discussionID"].(string), args["participantID"].(string), args["postID"].(string), args["reaction"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
-		return nil
+		return graphql.Null
 	}
 	if resTmp == nil {
-		return nil
-	}
-	return func() graphql.Marshaler {
-		res, ok := <-resTmp.(<-chan *model.DiscussionSubscriptionEvent)
-		if !ok {
-			return nil
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
 		}
-		return graphql.WriterFunc(func(w io.Writer) {
-			w.Write([]byte{'{'})
-			graphql.MarshalString(field.Alias).MarshalGQL(w)
-			w.Write([]byte{':'})
-			ec.marshalODiscussionSubscriptionEvent2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussionSubscriptionEvent(ctx, field.Selections, res).MarshalGQL(w)
-			w.Write([]byte{'}'})
-		})
+		return graphql.Null
 	}
+	res := resTmp.(*model.Post)
+	fc.Result = res
+	return ec.marshalNPost2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPost(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _URL_displayText(ctx context.Context, field graphql.CollectedField, obj *model.URL) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_votePoll(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7300,16 +9049,23 @@ func (ec *executionContext) _URL_displayText(ctx context.Context, field graphql.
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "URL",
+		Object:   "Mutation",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_votePoll_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DisplayText, nil
+		return ec.resolvers.Mutation().VotePoll(rctx, args["discussionID"].(string), args["participantID"].(string), args["postID"].(string), args["optionIDs"].([]string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7321,12 +9077,12 @@ func (ec *executionContext) _URL_displayText(ctx context.Context, field graphql.
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*model.Post)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNPost2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPost(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _URL_url(ctx context.Context, field graphql.CollectedField, obj *model.URL) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_pinPost(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7334,16 +9090,23 @@ func (ec *executionContext) _URL_url(ctx context.Context, field graphql.Collecte
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "URL",
+		Object:   "Mutation",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_pinPost_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.URL, nil
+		return ec.resolvers.Mutation().PinPost(rctx, args["discussionID"].(string), args["postID"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7355,12 +9118,12 @@ func (ec *executionContext) _URL_url(ctx context.Context, field graphql.Collecte
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*model.Post)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNPost2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPost(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _UnknownEntity_id(ctx context.Context, field graphql.CollectedField, obj *model.UnknownEntity) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_unpinPost(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7368,16 +9131,23 @@ func (ec *executionContext) _UnknownEntity_id(ctx context.Context, field graphql
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "UnknownEntity",
+		Object:   "Mutation",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_unpinPost_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return ec.resolvers.Mutation().UnpinPost(rctx, args["discussionID"].(string), args["postID"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7389,12 +9159,12 @@ func (ec *executionContext) _UnknownEntity_id(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*model.Post)
 	fc.Result = res
-	return ec.marshalNID2string(ctx, field.Selections, res)
+	return ec.marshalNPost2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPost(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _User_id(ctx context.Context, field graphql.CollectedField, obj *model.User) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_bookmarkPost(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7402,16 +9172,23 @@ func (ec *executionContext) _User_id(ctx context.Context, field graphql.Collecte
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "User",
+		Object:   "Mutation",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_bookmarkPost_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return ec.resolvers.Mutation().BookmarkPost(rctx, args["discussionID"].(string), args["postID"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7423,12 +9200,12 @@ func (ec *executionContext) _User_id(ctx context.Context, field graphql.Collecte
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*model.Post)
 	fc.Result = res
-	return ec.marshalNID2string(ctx, field.Selections, res)
+	return ec.marshalNPost2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPost(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _User_participants(ctx context.Context, field graphql.CollectedField, obj *model.User) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_unbookmarkPost(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7436,30 +9213,40 @@ func (ec *executionContext) _User_participants(ctx context.Context, field graphq
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "User",
+		Object:   "Mutation",
 		Field:    field,
 		Args:     nil,
 		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_unbookmarkPost_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.User().Participants(rctx, obj)
+		return ec.resolvers.Mutation().UnbookmarkPost(rctx, args["discussionID"].(string), args["postID"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.Participant)
+	res := resTmp.(*model.Post)
 	fc.Result = res
-	return ec.marshalOParticipant2ᚕᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐParticipantᚄ(ctx, field.Selections, res)
+	return ec.marshalNPost2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPost(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _User_viewers(ctx context.Context, field graphql.CollectedField, obj *model.User) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_reportPost(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7467,30 +9254,40 @@ func (ec *executionContext) _User_viewers(ctx context.Context, field graphql.Col
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "User",
+		Object:   "Mutation",
 		Field:    field,
 		Args:     nil,
 		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_reportPost_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.User().Viewers(rctx, obj)
+		return ec.resolvers.Mutation().ReportPost(rctx, args["discussionID"].(string), args["postID"].(string), args["reason"].(model.PostReportReason), args["note"].(*string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.Viewer)
+	res := resTmp.(*model.PostReport)
 	fc.Result = res
-	return ec.marshalOViewer2ᚕᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐViewerᚄ(ctx, field.Selections, res)
+	return ec.marshalNPostReport2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPostReport(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _User_profile(ctx context.Context, field graphql.CollectedField, obj *model.User) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_dismissPostReport(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7498,16 +9295,23 @@ func (ec *executionContext) _User_profile(ctx context.Context, field graphql.Col
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "User",
+		Object:   "Mutation",
 		Field:    field,
 		Args:     nil,
 		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_dismissPostReport_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.User().Profile(rctx, obj)
+		return ec.resolvers.Mutation().DismissPostReport(rctx, args["discussionID"].(string), args["reportID"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7519,12 +9323,12 @@ func (ec *executionContext) _User_profile(ctx context.Context, field graphql.Col
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.UserProfile)
+	res := resTmp.(*model.PostReport)
 	fc.Result = res
-	return ec.marshalNUserProfile2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐUserProfile(ctx, field.Selections, res)
+	return ec.marshalNPostReport2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPostReport(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _User_devices(ctx context.Context, field graphql.CollectedField, obj *model.User) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_actionPostReport(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7532,30 +9336,40 @@ func (ec *executionContext) _User_devices(ctx context.Context, field graphql.Col
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "User",
+		Object:   "Mutation",
 		Field:    field,
 		Args:     nil,
 		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_actionPostReport_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.User().Devices(rctx, obj)
+		return ec.resolvers.Mutation().ActionPostReport(rctx, args["discussionID"].(string), args["reportID"].(string), args["action"].(model.PostReportAction), args["mutedForSeconds"].(*int))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.UserDevice)
+	res := resTmp.(*model.PostReport)
 	fc.Result = res
-	return ec.marshalOUserDevice2ᚕᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐUserDeviceᚄ(ctx, field.Selections, res)
+	return ec.marshalNPostReport2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPostReport(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _User_moderatedDiscussions(ctx context.Context, field graphql.CollectedField, obj *model.User) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_banParticipant(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7563,30 +9377,40 @@ func (ec *executionContext) _User_moderatedDiscussions(ctx context.Context, fiel
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "User",
+		Object:   "Mutation",
 		Field:    field,
 		Args:     nil,
 		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_banParticipant_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.User().ModeratedDiscussions(rctx, obj)
+		return ec.resolvers.Mutation().BanParticipant(rctx, args["discussionID"].(string), args["participantID"].(string), args["reason"].(*string), args["keepPosts"].(*bool))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.Discussion)
+	res := resTmp.(*model.Participant)
 	fc.Result = res
-	return ec.marshalODiscussion2ᚕᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussionᚄ(ctx, field.Selections, res)
+	return ec.marshalNParticipant2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐParticipant(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _User_discussions(ctx context.Context, field graphql.CollectedField, obj *model.User) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_unbanParticipant(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7594,7 +9418,7 @@ func (ec *executionContext) _User_discussions(ctx context.Context, field graphql
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "User",
+		Object:   "Mutation",
 		Field:    field,
 		Args:     nil,
 		IsMethod: true,
@@ -7602,7 +9426,7 @@ func (ec *executionContext) _User_discussions(ctx context.Context, field graphql
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_User_discussions_args(ctx, rawArgs)
+	args, err := ec.field_Mutation_unbanParticipant_args(ctx, rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
@@ -7610,21 +9434,24 @@ func (ec *executionContext) _User_discussions(ctx context.Context, field graphql
 	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.User().Discussions(rctx, obj, args["state"].(model.DiscussionUserAccessState))
+		return ec.resolvers.Mutation().UnbanParticipant(rctx, args["discussionID"].(string), args["participantID"].(string), args["restorePosts"].(*bool))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.Discussion)
+	res := resTmp.(*model.Participant)
 	fc.Result = res
-	return ec.marshalODiscussion2ᚕᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussionᚄ(ctx, field.Selections, res)
+	return ec.marshalNParticipant2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐParticipant(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _User_sentDiscussionAccessRequests(ctx context.Context, field graphql.CollectedField, obj *model.User) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_shuffleDiscussion(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7632,30 +9459,40 @@ func (ec *executionContext) _User_sentDiscussionAccessRequests(ctx context.Conte
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "User",
+		Object:   "Mutation",
 		Field:    field,
 		Args:     nil,
 		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.User().SentDiscussionAccessRequests(rctx, obj)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_shuffleDiscussion_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().ShuffleDiscussion(rctx, args["discussionID"].(string), args["inFutureSeconds"].(*int))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.DiscussionAccessRequest)
+	res := resTmp.(*model.Discussion)
 	fc.Result = res
-	return ec.marshalODiscussionAccessRequest2ᚕᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussionAccessRequestᚄ(ctx, field.Selections, res)
+	return ec.marshalNDiscussion2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussion(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _UserDevice_id(ctx context.Context, field graphql.CollectedField, obj *model.UserDevice) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_setLastPostViewed(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7663,16 +9500,23 @@ func (ec *executionContext) _UserDevice_id(ctx context.Context, field graphql.Co
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "UserDevice",
+		Object:   "Mutation",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_setLastPostViewed_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return ec.resolvers.Mutation().SetLastPostViewed(rctx, args["viewerID"].(string), args["postID"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7684,12 +9528,12 @@ func (ec *executionContext) _UserDevice_id(ctx context.Context, field graphql.Co
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*model.Viewer)
 	fc.Result = res
-	return ec.marshalNID2string(ctx, field.Selections, res)
+	return ec.marshalNViewer2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐViewer(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _UserDevice_user(ctx context.Context, field graphql.CollectedField, obj *model.UserDevice) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_markAllDiscussionsRead(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7697,16 +9541,16 @@ func (ec *executionContext) _UserDevice_user(ctx context.Context, field graphql.
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "UserDevice",
+		Object:   "Mutation",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.User, nil
+		return ec.resolvers.Mutation().MarkAllDiscussionsRead(rctx)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7718,12 +9562,12 @@ func (ec *executionContext) _UserDevice_user(ctx context.Context, field graphql.
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.User)
+	res := resTmp.([]*model.Viewer)
 	fc.Result = res
-	return ec.marshalNUser2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐUser(ctx, field.Selections, res)
+	return ec.marshalNViewer2ᚕᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐViewerᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _UserDevice_platform(ctx context.Context, field graphql.CollectedField, obj *model.UserDevice) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_muteParticipants(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7731,16 +9575,23 @@ func (ec *executionContext) _UserDevice_platform(ctx context.Context, field grap
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "UserDevice",
+		Object:   "Mutation",
 		Field:    field,
 		Args:     nil,
 		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_muteParticipants_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.UserDevice().Platform(rctx, obj)
+		return ec.resolvers.Mutation().MuteParticipants(rctx, args["discussionID"].(string), args["participantIDs"].([]string), args["mutedForSeconds"].(int))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7752,12 +9603,12 @@ func (ec *executionContext) _UserDevice_platform(ctx context.Context, field grap
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.Platform)
+	res := resTmp.([]*model.Participant)
 	fc.Result = res
-	return ec.marshalNPlatform2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPlatform(ctx, field.Selections, res)
+	return ec.marshalNParticipant2ᚕᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐParticipantᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _UserDevice_lastSeen(ctx context.Context, field graphql.CollectedField, obj *model.UserDevice) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_unmuteParticipants(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7765,16 +9616,23 @@ func (ec *executionContext) _UserDevice_lastSeen(ctx context.Context, field grap
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "UserDevice",
+		Object:   "Mutation",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_unmuteParticipants_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.LastSeen, nil
+		return ec.resolvers.Mutation().UnmuteParticipants(rctx, args["discussionID"].(string), args["participantIDs"].([]string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7786,12 +9644,12 @@ func (ec *executionContext) _UserDevice_lastSeen(ctx context.Context, field grap
 		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.([]*model.Participant)
 	fc.Result = res
-	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNParticipant2ᚕᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐParticipantᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _UserProfile_id(ctx context.Context, field graphql.CollectedField, obj *model.UserProfile) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_grantModeratorRole(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7799,16 +9657,23 @@ func (ec *executionContext) _UserProfile_id(ctx context.Context, field graphql.C
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "UserProfile",
+		Object:   "Mutation",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_grantModeratorRole_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return ec.resolvers.Mutation().GrantModeratorRole(rctx, args["discussionID"].(string), args["participantID"].(string), args["role"].(model.ModeratorRole))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7820,12 +9685,12 @@ func (ec *executionContext) _UserProfile_id(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*model.Participant)
 	fc.Result = res
-	return ec.marshalNID2string(ctx, field.Selections, res)
+	return ec.marshalNParticipant2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐParticipant(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _UserProfile_displayName(ctx context.Context, field graphql.CollectedField, obj *model.UserProfile) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_revokeModeratorRole(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7833,16 +9698,23 @@ func (ec *executionContext) _UserProfile_displayName(ctx context.Context, field
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "UserProfile",
+		Object:   "Mutation",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_revokeModeratorRole_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DisplayName, nil
+		return ec.resolvers.Mutation().RevokeModeratorRole(rctx, args["discussionID"].(string), args["participantID"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7854,12 +9726,12 @@ func (ec *executionContext) _UserProfile_displayName(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*model.Participant)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNParticipant2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐParticipant(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _UserProfile_twitterURL(ctx context.Context, field graphql.CollectedField, obj *model.UserProfile) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_transferDiscussionOwnership(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7867,16 +9739,23 @@ func (ec *executionContext) _UserProfile_twitterURL(ctx context.Context, field g
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "UserProfile",
+		Object:   "Mutation",
 		Field:    field,
 		Args:     nil,
 		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_transferDiscussionOwnership_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TwitterURL(), nil
+		return ec.resolvers.Mutation().TransferDiscussionOwnership(rctx, args["discussionID"].(string), args["toUserID"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7888,12 +9767,12 @@ func (ec *executionContext) _UserProfile_twitterURL(ctx context.Context, field g
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.URL)
+	res := resTmp.(*model.Discussion)
 	fc.Result = res
-	return ec.marshalNURL2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐURL(ctx, field.Selections, res)
+	return ec.marshalNDiscussion2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussion(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _UserProfile_profileImageURL(ctx context.Context, field graphql.CollectedField, obj *model.UserProfile) (ret graphql.Marshaler) {
+func (ec *executionContext) _PageInfo_startCursor(ctx context.Context, field graphql.CollectedField, obj *model.PageInfo) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7901,33 +9780,30 @@ func (ec *executionContext) _UserProfile_profileImageURL(ctx context.Context, fi
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "UserProfile",
+		Object:   "PageInfo",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.UserProfile().ProfileImageURL(rctx, obj)
+		return obj.StartCursor, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOID2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _UserProfile_authenticatedWithTwitter(ctx context.Context, field graphql.CollectedField, obj *model.UserProfile) (ret graphql.Marshaler) {
+func (ec *executionContext) _PageInfo_endCursor(ctx context.Context, field graphql.CollectedField, obj *model.PageInfo) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7935,33 +9811,30 @@ func (ec *executionContext) _UserProfile_authenticatedWithTwitter(ctx context.Co
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "UserProfile",
+		Object:   "PageInfo",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.UserProfile().AuthenticatedWithTwitter(rctx, obj)
+		return obj.EndCursor, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalOID2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Viewer_id(ctx context.Context, field graphql.CollectedField, obj *model.Viewer) (ret graphql.Marshaler) {
+func (ec *executionContext) _PageInfo_hasNextPage(ctx context.Context, field graphql.CollectedField, obj *model.PageInfo) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7969,7 +9842,7 @@ func (ec *executionContext) _Viewer_id(ctx context.Context, field graphql.Collec
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Viewer",
+		Object:   "PageInfo",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -7978,7 +9851,7 @@ func (ec *executionContext) _Viewer_id(ctx context.Context, field graphql.Collec
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.HasNextPage, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7990,12 +9863,12 @@ func (ec *executionContext) _Viewer_id(ctx context.Context, field graphql.Collec
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNID2string(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Viewer_discussion(ctx context.Context, field graphql.CollectedField, obj *model.Viewer) (ret graphql.Marshaler) {
+func (ec *executionContext) _PageInfo_hasPreviousPage(ctx context.Context, field graphql.CollectedField, obj *model.PageInfo) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -8003,30 +9876,33 @@ func (ec *executionContext) _Viewer_discussion(ctx context.Context, field graphq
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Viewer",
+		Object:   "PageInfo",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Viewer().Discussion(rctx, obj)
+		return obj.HasPreviousPage, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Discussion)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalODiscussion2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussion(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Viewer_lastViewed(ctx context.Context, field graphql.CollectedField, obj *model.Viewer) (ret graphql.Marshaler) {
+func (ec *executionContext) _Participant_id(ctx context.Context, field graphql.CollectedField, obj *model.Participant) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -8034,7 +9910,7 @@ func (ec *executionContext) _Viewer_lastViewed(ctx context.Context, field graphq
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Viewer",
+		Object:   "Participant",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -8043,21 +9919,24 @@ func (ec *executionContext) _Viewer_lastViewed(ctx context.Context, field graphq
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.LastViewed, nil
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*time.Time)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNID2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Viewer_lastViewedPost(ctx context.Context, field graphql.CollectedField, obj *model.Viewer) (ret graphql.Marshaler) {
+func (ec *executionContext) _Participant_participantID(ctx context.Context, field graphql.CollectedField, obj *model.Participant) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -8065,16 +9944,16 @@ func (ec *executionContext) _Viewer_lastViewedPost(ctx context.Context, field gr
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Viewer",
+		Object:   "Participant",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Viewer().LastViewedPost(rctx, obj)
+		return obj.ParticipantID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8083,12 +9962,12 @@ func (ec *executionContext) _Viewer_lastViewedPost(ctx context.Context, field gr
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*model.Post)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalOPost2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPost(ctx, field.Selections, res)
+	return ec.marshalOInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _ViewersConnection_totalCount(ctx context.Context, field graphql.CollectedField, obj *model.ViewersConnection) (ret graphql.Marshaler) {
+func (ec *executionContext) _Participant_discussion(ctx context.Context, field graphql.CollectedField, obj *model.Participant) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -8096,7 +9975,7 @@ func (ec *executionContext) _ViewersConnection_totalCount(ctx context.Context, f
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "ViewersConnection",
+		Object:   "Participant",
 		Field:    field,
 		Args:     nil,
 		IsMethod: true,
@@ -8105,24 +9984,21 @@ func (ec *executionContext) _ViewersConnection_totalCount(ctx context.Context, f
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TotalCount(), nil
+		return ec.resolvers.Participant().Discussion(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(*model.Discussion)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalODiscussion2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussion(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _ViewersConnection_edges(ctx context.Context, field graphql.CollectedField, obj *model.ViewersConnection) (ret graphql.Marshaler) {
+func (ec *executionContext) _Participant_viewer(ctx context.Context, field graphql.CollectedField, obj *model.Participant) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -8130,7 +10006,7 @@ func (ec *executionContext) _ViewersConnection_edges(ctx context.Context, field
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "ViewersConnection",
+		Object:   "Participant",
 		Field:    field,
 		Args:     nil,
 		IsMethod: true,
@@ -8139,21 +10015,24 @@ func (ec *executionContext) _ViewersConnection_edges(ctx context.Context, field
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.ViewersConnection().Edges(rctx, obj)
+		return ec.resolvers.Participant().Viewer(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.ViewersEdge)
+	res := resTmp.(*model.Viewer)
 	fc.Result = res
-	return ec.marshalOViewersEdge2ᚕᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐViewersEdgeᚄ(ctx, field.Selections, res)
+	return ec.marshalNViewer2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐViewer(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _ViewersConnection_pageInfo(ctx context.Context, field graphql.CollectedField, obj *model.ViewersConnection) (ret graphql.Marshaler) {
+func (ec *executionContext) _Participant_posts(ctx context.Context, field graphql.CollectedField, obj *model.Participant) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -8161,7 +10040,7 @@ func (ec *executionContext) _ViewersConnection_pageInfo(ctx context.Context, fie
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "ViewersConnection",
+		Object:   "Participant",
 		Field:    field,
 		Args:     nil,
 		IsMethod: true,
@@ -8170,24 +10049,21 @@ func (ec *executionContext) _ViewersConnection_pageInfo(ctx context.Context, fie
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.PageInfo(), nil
+		return ec.resolvers.Participant().Posts(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(model.PageInfo)
+	res := resTmp.([]*model.Post)
 	fc.Result = res
-	return ec.marshalNPageInfo2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPageInfo(ctx, field.Selections, res)
+	return ec.marshalOPost2ᚕᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPostᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _ViewersEdge_cursor(ctx context.Context, field graphql.CollectedField, obj *model.ViewersEdge) (ret graphql.Marshaler) {
+func (ec *executionContext) _Participant_isAnonymous(ctx context.Context, field graphql.CollectedField, obj *model.Participant) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -8195,7 +10071,7 @@ func (ec *executionContext) _ViewersEdge_cursor(ctx context.Context, field graph
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "ViewersEdge",
+		Object:   "Participant",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -8204,7 +10080,7 @@ func (ec *executionContext) _ViewersEdge_cursor(ctx context.Context, field graph
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Cursor, nil
+		return obj.IsAnonymous, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8216,12 +10092,12 @@ func (ec *executionContext) _ViewersEdge_cursor(ctx context.Context, field graph
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNID2string(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _ViewersEdge_node(ctx context.Context, field graphql.CollectedField, obj *model.ViewersEdge) (ret graphql.Marshaler) {
+func (ec *executionContext) _Participant_gradientColor(ctx context.Context, field graphql.CollectedField, obj *model.Participant) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -8229,16 +10105,16 @@ func (ec *executionContext) _ViewersEdge_node(ctx context.Context, field graphql
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "ViewersEdge",
+		Object:   "Participant",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Node, nil
+		return ec.resolvers.Participant().GradientColor(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8247,12 +10123,12 @@ func (ec *executionContext) _ViewersEdge_node(ctx context.Context, field graphql
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*model.Viewer)
+	res := resTmp.(*model.GradientColor)
 	fc.Result = res
-	return ec.marshalOViewer2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐViewer(ctx, field.Selections, res)
+	return ec.marshalOGradientColor2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐGradientColor(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+func (ec *executionContext) _Participant_inviter(ctx context.Context, field graphql.CollectedField, obj *model.Participant) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -8260,16 +10136,16 @@ func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "__Directive",
+		Object:   "Participant",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return ec.resolvers.Participant().Inviter(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8281,12 +10157,12 @@ func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*model.Participant)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNParticipant2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐParticipant(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Directive_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+func (ec *executionContext) _Participant_hasJoined(ctx context.Context, field graphql.CollectedField, obj *model.Participant) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -8294,7 +10170,7 @@ func (ec *executionContext) ___Directive_description(ctx context.Context, field
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "__Directive",
+		Object:   "Participant",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -8303,21 +10179,24 @@ func (ec *executionContext) ___Directive_description(ctx context.Context, field
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description, nil
+		return obj.HasJoined, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Directive_locations(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+func (ec *executionContext) _Participant_userProfile(ctx context.Context, field graphql.CollectedField, obj *model.Participant) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -8325,33 +10204,30 @@ func (ec *executionContext) ___Directive_locations(ctx context.Context, field gr
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "__Directive",
+		Object:   "Participant",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Locations, nil
+		return ec.resolvers.Participant().UserProfile(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.([]string)
+	res := resTmp.(*model.UserProfile)
 	fc.Result = res
-	return ec.marshalN__DirectiveLocation2ᚕstringᚄ(ctx, field.Selections, res)
+	return ec.marshalOUserProfile2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐUserProfile(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Directive_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+func (ec *executionContext) _Participant_isBanned(ctx context.Context, field graphql.CollectedField, obj *model.Participant) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -8359,7 +10235,7 @@ func (ec *executionContext) ___Directive_args(ctx context.Context, field graphql
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "__Directive",
+		Object:   "Participant",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -8368,7 +10244,7 @@ func (ec *executionContext) ___Directive_args(ctx context.Context, field graphql
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Args, nil
+		return obj.IsBanned, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8380,12 +10256,12 @@ func (ec *executionContext) ___Directive_args(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.InputValue)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___EnumValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+func (ec *executionContext) _Participant_anonDisplayName(ctx context.Context, field graphql.CollectedField, obj *model.Participant) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -8393,33 +10269,30 @@ func (ec *executionContext) ___EnumValue_name(ctx context.Context, field graphql
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "__EnumValue",
+		Object:   "Participant",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return ec.resolvers.Participant().AnonDisplayName(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___EnumValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+func (ec *executionContext) _Participant_mutedForSeconds(ctx context.Context, field graphql.CollectedField, obj *model.Participant) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -8427,16 +10300,16 @@ func (ec *executionContext) ___EnumValue_description(ctx context.Context, field
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "__EnumValue",
+		Object:   "Participant",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description, nil
+		return ec.resolvers.Participant().MutedForSeconds(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8445,12 +10318,12 @@ func (ec *executionContext) ___EnumValue_description(ctx context.Context, field
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*int)
 	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___EnumValue_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+func (ec *executionContext) _Participant_meSecondsUntilCanPost(ctx context.Context, field graphql.CollectedField, obj *model.Participant) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -8458,7 +10331,7 @@ func (ec *executionContext) ___EnumValue_isDeprecated(ctx context.Context, field
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "__EnumValue",
+		Object:   "Participant",
 		Field:    field,
 		Args:     nil,
 		IsMethod: true,
@@ -8467,24 +10340,21 @@ func (ec *executionContext) ___EnumValue_isDeprecated(ctx context.Context, field
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.IsDeprecated(), nil
+		return ec.resolvers.Participant().MeSecondsUntilCanPost(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(*int)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___EnumValue_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+func (ec *executionContext) _Participant_moderatorRole(ctx context.Context, field graphql.CollectedField, obj *model.Participant) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -8492,7 +10362,7 @@ func (ec *executionContext) ___EnumValue_deprecationReason(ctx context.Context,
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "__EnumValue",
+		Object:   "Participant",
 		Field:    field,
 		Args:     nil,
 		IsMethod: true,
@@ -8501,7 +10371,7 @@ func (ec *executionContext) ___EnumValue_deprecationReason(ctx context.Context,
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DeprecationReason(), nil
+		return ec.resolvers.Participant().ModeratorRole(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8510,12 +10380,12 @@ func (ec *executionContext) ___EnumValue_deprecationReason(ctx context.Context,
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*model.ModeratorRole)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalOModeratorRole2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐModeratorRole(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Field_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+func (ec *executionContext) _ParticipantProfile_isAnonymous(ctx context.Context, field graphql.CollectedField, obj *model.ParticipantProfile) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -8523,7 +10393,7 @@ func (ec *executionContext) ___Field_name(ctx context.Context, field graphql.Col
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "__Field",
+		Object:   "ParticipantProfile",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -8532,24 +10402,21 @@ func (ec *executionContext) ___Field_name(ctx context.Context, field graphql.Col
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.IsAnonymous, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*bool)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOBoolean2ᚖbool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Field_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+func (ec *executionContext) _ParticipantProfile_gradientColor(ctx context.Context, field graphql.CollectedField, obj *model.ParticipantProfile) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -8557,7 +10424,7 @@ func (ec *executionContext) ___Field_description(ctx context.Context, field grap
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "__Field",
+		Object:   "ParticipantProfile",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -8566,7 +10433,7 @@ func (ec *executionContext) ___Field_description(ctx context.Context, field grap
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description, nil
+		return obj.GradientColor, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8575,12 +10442,12 @@ func (ec *executionContext) ___Field_description(ctx context.Context, field grap
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*model.GradientColor)
 	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	return ec.marshalOGradientColor2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐGradientColor(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+func (ec *executionContext) _ParticipantsConnection_totalCount(ctx context.Context, field graphql.CollectedField, obj *model.ParticipantsConnection) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -8588,16 +10455,16 @@ func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.Col
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "__Field",
+		Object:   "ParticipantsConnection",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Args, nil
+		return obj.TotalCount(), nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8609,12 +10476,12 @@ func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.Col
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.InputValue)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Field_type(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+func (ec *executionContext) _ParticipantsConnection_edges(ctx context.Context, field graphql.CollectedField, obj *model.ParticipantsConnection) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -8622,33 +10489,30 @@ func (ec *executionContext) ___Field_type(ctx context.Context, field graphql.Col
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "__Field",
+		Object:   "ParticipantsConnection",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Type, nil
+		return ec.resolvers.ParticipantsConnection().Edges(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.([]*model.ParticipantsEdge)
 	fc.Result = res
-	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalOParticipantsEdge2ᚕᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐParticipantsEdgeᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Field_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+func (ec *executionContext) _ParticipantsConnection_pageInfo(ctx context.Context, field graphql.CollectedField, obj *model.ParticipantsConnection) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -8656,7 +10520,7 @@ func (ec *executionContext) ___Field_isDeprecated(ctx context.Context, field gra
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "__Field",
+		Object:   "ParticipantsConnection",
 		Field:    field,
 		Args:     nil,
 		IsMethod: true,
@@ -8665,7 +10529,7 @@ func (ec *executionContext) ___Field_isDeprecated(ctx context.Context, field gra
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.IsDeprecated(), nil
+		return obj.PageInfo(), nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8677,12 +10541,12 @@ func (ec *executionContext) ___Field_isDeprecated(ctx context.Context, field gra
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(model.PageInfo)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNPageInfo2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPageInfo(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Field_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+func (ec *executionContext) _ParticipantsEdge_cursor(ctx context.Context, field graphql.CollectedField, obj *model.ParticipantsEdge) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -8690,30 +10554,33 @@ func (ec *executionContext) ___Field_deprecationReason(ctx context.Context, fiel
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "__Field",
+		Object:   "ParticipantsEdge",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DeprecationReason(), nil
+		return obj.Cursor, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNID2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___InputValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+func (ec *executionContext) _ParticipantsEdge_node(ctx context.Context, field graphql.CollectedField, obj *model.ParticipantsEdge) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -8721,7 +10588,7 @@ func (ec *executionContext) ___InputValue_name(ctx context.Context, field graphq
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "__InputValue",
+		Object:   "ParticipantsEdge",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -8730,24 +10597,21 @@ func (ec *executionContext) ___InputValue_name(ctx context.Context, field graphq
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.Node, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*model.Participant)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOParticipant2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐParticipant(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___InputValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+func (ec *executionContext) _Poll_allowMultipleVotes(ctx context.Context, field graphql.CollectedField, obj *model.Poll) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -8755,7 +10619,7 @@ func (ec *executionContext) ___InputValue_description(ctx context.Context, field
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "__InputValue",
+		Object:   "Poll",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -8764,21 +10628,24 @@ func (ec *executionContext) ___InputValue_description(ctx context.Context, field
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description, nil
+		return obj.AllowMultipleVotes, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___InputValue_type(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+func (ec *executionContext) _Poll_isAnonymous(ctx context.Context, field graphql.CollectedField, obj *model.Poll) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -8786,7 +10653,7 @@ func (ec *executionContext) ___InputValue_type(ctx context.Context, field graphq
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "__InputValue",
+		Object:   "Poll",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -8795,7 +10662,7 @@ func (ec *executionContext) ___InputValue_type(ctx context.Context, field graphq
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Type, nil
+		return obj.IsAnonymous, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8807,12 +10674,12 @@ func (ec *executionContext) ___InputValue_type(ctx context.Context, field graphq
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___InputValue_defaultValue(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+func (ec *executionContext) _Poll_closesAt(ctx context.Context, field graphql.CollectedField, obj *model.Poll) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -8820,7 +10687,7 @@ func (ec *executionContext) ___InputValue_defaultValue(ctx context.Context, fiel
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "__InputValue",
+		Object:   "Poll",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -8829,7 +10696,7 @@ func (ec *executionContext) ___InputValue_defaultValue(ctx context.Context, fiel
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DefaultValue, nil
+		return obj.ClosesAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8838,12 +10705,12 @@ func (ec *executionContext) ___InputValue_defaultValue(ctx context.Context, fiel
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*time.Time)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Schema_types(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+func (ec *executionContext) _Poll_isClosed(ctx context.Context, field graphql.CollectedField, obj *model.Poll) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -8851,7 +10718,7 @@ func (ec *executionContext) ___Schema_types(ctx context.Context, field graphql.C
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "__Schema",
+		Object:   "Poll",
 		Field:    field,
 		Args:     nil,
 		IsMethod: true,
@@ -8860,7 +10727,7 @@ func (ec *executionContext) ___Schema_types(ctx context.Context, field graphql.C
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Types(), nil
+		return ec.resolvers.Poll().IsClosed(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8872,12 +10739,12 @@ func (ec *executionContext) ___Schema_types(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.Type)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Schema_queryType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+func (ec *executionContext) _Poll_options(ctx context.Context, field graphql.CollectedField, obj *model.Poll) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -8885,16 +10752,16 @@ func (ec *executionContext) ___Schema_queryType(ctx context.Context, field graph
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "__Schema",
+		Object:   "Poll",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.QueryType(), nil
+		return obj.Options, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8906,12 +10773,12 @@ func (ec *executionContext) ___Schema_queryType(ctx context.Context, field graph
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.([]*model.PollOption)
 	fc.Result = res
-	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalNPollOption2ᚕᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPollOptionᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Schema_mutationType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+func (ec *executionContext) _PollOption_id(ctx context.Context, field graphql.CollectedField, obj *model.PollOption) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -8919,30 +10786,33 @@ func (ec *executionContext) ___Schema_mutationType(ctx context.Context, field gr
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "__Schema",
+		Object:   "PollOption",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.MutationType(), nil
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalNID2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Schema_subscriptionType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+func (ec *executionContext) _PollOption_text(ctx context.Context, field graphql.CollectedField, obj *model.PollOption) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -8950,30 +10820,33 @@ func (ec *executionContext) ___Schema_subscriptionType(ctx context.Context, fiel
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "__Schema",
+		Object:   "PollOption",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.SubscriptionType(), nil
+		return obj.Text, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Schema_directives(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+func (ec *executionContext) _PollOption_voteCount(ctx context.Context, field graphql.CollectedField, obj *model.PollOption) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -8981,16 +10854,16 @@ func (ec *executionContext) ___Schema_directives(ctx context.Context, field grap
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "__Schema",
+		Object:   "PollOption",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Directives(), nil
+		return obj.VoteCount, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9002,12 +10875,12 @@ func (ec *executionContext) ___Schema_directives(ctx context.Context, field grap
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.Directive)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirectiveᚄ(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Type_kind(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _PollOption_meVoted(ctx context.Context, field graphql.CollectedField, obj *model.PollOption) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -9015,16 +10888,16 @@ func (ec *executionContext) ___Type_kind(ctx context.Context, field graphql.Coll
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "__Type",
+		Object:   "PollOption",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Kind(), nil
+		return obj.MeVoted, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9036,12 +10909,12 @@ func (ec *executionContext) ___Type_kind(ctx context.Context, field graphql.Coll
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalN__TypeKind2string(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Type_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _PollOption_voters(ctx context.Context, field graphql.CollectedField, obj *model.PollOption) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -9049,7 +10922,7 @@ func (ec *executionContext) ___Type_name(ctx context.Context, field graphql.Coll
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "__Type",
+		Object:   "PollOption",
 		Field:    field,
 		Args:     nil,
 		IsMethod: true,
@@ -9058,21 +10931,24 @@ func (ec *executionContext) ___Type_name(ctx context.Context, field graphql.Coll
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name(), nil
+		return ec.resolvers.PollOption().Voters(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.([]*model.Participant)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNParticipant2ᚕᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐParticipantᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Type_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _Post_id(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -9080,30 +10956,33 @@ func (ec *executionContext) ___Type_description(ctx context.Context, field graph
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "__Type",
+		Object:   "Post",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description(), nil
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
 	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	return ec.marshalNID2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Type_fields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _Post_isDeleted(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -9111,23 +10990,50 @@ func (ec *executionContext) ___Type_fields(ctx context.Context, field graphql.Co
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "__Type",
+		Object:   "Post",
 		Field:    field,
 		Args:     nil,
 		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field___Type_fields_args(ctx, rawArgs)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Post().IsDeleted(rctx, obj)
+	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
-	fc.Args = args
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Post_deletedReasonCode(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Post",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Fields(args["includeDeprecated"].(bool)), nil
+		return obj.DeletedReasonCode, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9136,12 +11042,12 @@ func (ec *executionContext) ___Type_fields(ctx context.Context, field graphql.Co
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.Field)
+	res := resTmp.(*model.PostDeletedReason)
 	fc.Result = res
-	return ec.marshalO__Field2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐFieldᚄ(ctx, field.Selections, res)
+	return ec.marshalOPostDeletedReason2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPostDeletedReason(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Type_interfaces(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _Post_content(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -9149,7 +11055,7 @@ func (ec *executionContext) ___Type_interfaces(ctx context.Context, field graphq
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "__Type",
+		Object:   "Post",
 		Field:    field,
 		Args:     nil,
 		IsMethod: true,
@@ -9158,7 +11064,7 @@ func (ec *executionContext) ___Type_interfaces(ctx context.Context, field graphq
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Interfaces(), nil
+		return ec.resolvers.Post().Content(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9167,12 +11073,12 @@ func (ec *executionContext) ___Type_interfaces(ctx context.Context, field graphq
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.Type)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Type_possibleTypes(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _Post_contentHTML(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -9180,7 +11086,7 @@ func (ec *executionContext) ___Type_possibleTypes(ctx context.Context, field gra
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "__Type",
+		Object:   "Post",
 		Field:    field,
 		Args:     nil,
 		IsMethod: true,
@@ -9189,7 +11095,7 @@ func (ec *executionContext) ___Type_possibleTypes(ctx context.Context, field gra
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.PossibleTypes(), nil
+		return ec.resolvers.Post().ContentHTML(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9198,12 +11104,12 @@ func (ec *executionContext) ___Type_possibleTypes(ctx context.Context, field gra
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.Type)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Type_enumValues(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _Post_discussion(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -9211,23 +11117,50 @@ func (ec *executionContext) ___Type_enumValues(ctx context.Context, field graphq
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "__Type",
+		Object:   "Post",
 		Field:    field,
 		Args:     nil,
 		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field___Type_enumValues_args(ctx, rawArgs)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Post().Discussion(rctx, obj)
+	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
-	fc.Args = args
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.Discussion)
+	fc.Result = res
+	return ec.marshalNDiscussion2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussion(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Post_participant(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Post",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.EnumValues(args["includeDeprecated"].(bool)), nil
+		return ec.resolvers.Post().Participant(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9236,12 +11169,12 @@ func (ec *executionContext) ___Type_enumValues(ctx context.Context, field graphq
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.EnumValue)
+	res := resTmp.(*model.Participant)
 	fc.Result = res
-	return ec.marshalO__EnumValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValueᚄ(ctx, field.Selections, res)
+	return ec.marshalOParticipant2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐParticipant(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Type_inputFields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _Post_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -9249,7 +11182,7 @@ func (ec *executionContext) ___Type_inputFields(ctx context.Context, field graph
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "__Type",
+		Object:   "Post",
 		Field:    field,
 		Args:     nil,
 		IsMethod: true,
@@ -9258,21 +11191,24 @@ func (ec *executionContext) ___Type_inputFields(ctx context.Context, field graph
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.InputFields(), nil
+		return ec.resolvers.Post().CreatedAt(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.InputValue)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalO__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Type_ofType(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _Post_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -9280,7 +11216,7 @@ func (ec *executionContext) ___Type_ofType(ctx context.Context, field graphql.Co
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "__Type",
+		Object:   "Post",
 		Field:    field,
 		Args:     nil,
 		IsMethod: true,
@@ -9289,258 +11225,4664 @@ func (ec *executionContext) ___Type_ofType(ctx context.Context, field graphql.Co
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.OfType(), nil
+		return ec.resolvers.Post().UpdatedAt(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-// endregion **************************** field.gotpl *****************************
-
-// region    **************************** input.gotpl *****************************
-
-func (ec *executionContext) unmarshalInputAddDiscussionParticipantInput(ctx context.Context, obj interface{}) (model.AddDiscussionParticipantInput, error) {
-	var it model.AddDiscussionParticipantInput
-	var asMap = obj.(map[string]interface{})
-
-	for k, v := range asMap {
-		switch k {
-		case "gradientColor":
-			var err error
-			it.GradientColor, err = ec.unmarshalOGradientColor2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐGradientColor(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "hasJoined":
-			var err error
-			it.HasJoined, err = ec.unmarshalOBoolean2ᚖbool(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "isAnonymous":
-			var err error
-			it.IsAnonymous, err = ec.unmarshalNBoolean2bool(ctx, v)
-			if err != nil {
-				return it, err
-			}
+func (ec *executionContext) _Post_quotedPost(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
 		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Post",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
 	}
 
-	return it, nil
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Post().QuotedPost(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.Post)
+	fc.Result = res
+	return ec.marshalOPost2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPost(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) unmarshalInputDiscussionCreationSettings(ctx context.Context, obj interface{}) (model.DiscussionCreationSettings, error) {
-	var it model.DiscussionCreationSettings
-	var asMap = obj.(map[string]interface{})
-
-	for k, v := range asMap {
-		switch k {
-		case "discussionJoinability":
-			var err error
-			it.DiscussionJoinability, err = ec.unmarshalNDiscussionJoinabilitySetting2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussionJoinabilitySetting(ctx, v)
-			if err != nil {
-				return it, err
-			}
+func (ec *executionContext) _Post_mentionedEntities(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
 		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Post",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
 	}
 
-	return it, nil
-}
-
-func (ec *executionContext) unmarshalInputDiscussionInput(ctx context.Context, obj interface{}) (model.DiscussionInput, error) {
-	var it model.DiscussionInput
-	var asMap = obj.(map[string]interface{})
-
-	for k, v := range asMap {
-		switch k {
-		case "anonymityType":
-			var err error
-			it.AnonymityType, err = ec.unmarshalOAnonymityType2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐAnonymityType(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "title":
-			var err error
-			it.Title, err = ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "description":
-			var err error
-			it.Description, err = ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "publicAccess":
-			var err error
-			it.PublicAccess, err = ec.unmarshalOBoolean2ᚖbool(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "iconURL":
-			var err error
-			it.IconURL, err = ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "discussionJoinability":
-			var err error
-			it.DiscussionJoinability, err = ec.unmarshalODiscussionJoinabilitySetting2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussionJoinabilitySetting(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "lockStatus":
-			var err error
-			it.LockStatus, err = ec.unmarshalOBoolean2ᚖbool(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Post().MentionedEntities(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
 	}
-
-	return it, nil
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]model.Entity)
+	fc.Result = res
+	return ec.marshalOEntity2ᚕgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐEntityᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) unmarshalInputDiscussionUserSettings(ctx context.Context, obj interface{}) (model.DiscussionUserSettings, error) {
-	var it model.DiscussionUserSettings
-	var asMap = obj.(map[string]interface{})
-
-	for k, v := range asMap {
-		switch k {
-		case "state":
-			var err error
-			it.State, err = ec.unmarshalODiscussionUserAccessState2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussionUserAccessState(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "notifSetting":
-			var err error
-			it.NotifSetting, err = ec.unmarshalODiscussionUserNotificationSetting2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussionUserNotificationSetting(ctx, v)
-			if err != nil {
-				return it, err
-			}
+func (ec *executionContext) _Post_tags(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
 		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Post",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
 	}
 
-	return it, nil
-}
-
-func (ec *executionContext) unmarshalInputPostContentInput(ctx context.Context, obj interface{}) (model.PostContentInput, error) {
-	var it model.PostContentInput
-	var asMap = obj.(map[string]interface{})
-
-	for k, v := range asMap {
-		switch k {
-		case "postText":
-			var err error
-			it.PostText, err = ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "postType":
-			var err error
-			it.PostType, err = ec.unmarshalNPostType2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPostType(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "mentionedEntities":
-			var err error
-			it.MentionedEntities, err = ec.unmarshalOString2ᚕstringᚄ(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "quotedPostID":
-			var err error
-			it.QuotedPostID, err = ec.unmarshalOID2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "mediaID":
-			var err error
-			it.MediaID, err = ec.unmarshalOID2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "preview":
-			var err error
-			it.Preview, err = ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
-			}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Post().Tags(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
 		}
+		return graphql.Null
 	}
-
-	return it, nil
+	res := resTmp.([]string)
+	fc.Result = res
+	return ec.marshalNString2ᚕstringᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) unmarshalInputUpdateParticipantInput(ctx context.Context, obj interface{}) (model.UpdateParticipantInput, error) {
-	var it model.UpdateParticipantInput
-	var asMap = obj.(map[string]interface{})
-
-	for k, v := range asMap {
-		switch k {
-		case "gradientColor":
-			var err error
-			it.GradientColor, err = ec.unmarshalOGradientColor2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐGradientColor(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "isUnsetGradient":
-			var err error
-			it.IsUnsetGradient, err = ec.unmarshalOBoolean2ᚖbool(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "isAnonymous":
-			var err error
-			it.IsAnonymous, err = ec.unmarshalOBoolean2ᚖbool(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "hasJoined":
-			var err error
-			it.HasJoined, err = ec.unmarshalOBoolean2ᚖbool(ctx, v)
-			if err != nil {
-				return it, err
-			}
+func (ec *executionContext) _Post_media(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
 		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Post",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
 	}
 
-	return it, nil
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Post().Media(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.Media)
+	fc.Result = res
+	return ec.marshalOMedia2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐMedia(ctx, field.Selections, res)
 }
 
-// endregion **************************** input.gotpl *****************************
-
-// region    ************************** interface.gotpl ***************************
+func (ec *executionContext) _Post_postType(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Post",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
 
-func (ec *executionContext) _DiscussionSubscriptionEntity(ctx context.Context, sel ast.SelectionSet, obj model.DiscussionSubscriptionEntity) graphql.Marshaler {
-	switch obj := (obj).(type) {
-	case nil:
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.PostType, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
 		return graphql.Null
-	case model.Participant:
-		return ec._Participant(ctx, sel, &obj)
-	case *model.Participant:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._Participant(ctx, sel, obj)
-	case model.Post:
-		return ec._Post(ctx, sel, &obj)
-	case *model.Post:
-		if obj == nil {
-			return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
 		}
-		return ec._Post(ctx, sel, obj)
-	default:
-		panic(fmt.Errorf("unexpected type %T", obj))
+		return graphql.Null
+	}
+	res := resTmp.(model.PostType)
+	fc.Result = res
+	return ec.marshalNPostType2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPostType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Post_isEdited(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Post",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Post().IsEdited(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Post_editHistory(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Post",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Post().EditHistory(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*model.HistoricalString)
+	fc.Result = res
+	return ec.marshalOHistoricalString2ᚕᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐHistoricalStringᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Post_reactions(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Post",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Post().Reactions(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.PostReactionSummary)
+	fc.Result = res
+	return ec.marshalNPostReactionSummary2ᚕᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPostReactionSummaryᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Post_isPinned(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Post",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Post().IsPinned(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Post_poll(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Post",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Post().Poll(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.Poll)
+	fc.Result = res
+	return ec.marshalOPoll2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPoll(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Post_linkPreviews(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Post",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Post().LinkPreviews(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.LinkPreview)
+	fc.Result = res
+	return ec.marshalNLinkPreview2ᚕᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐLinkPreviewᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Post_parentPostID(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Post",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ParentPostID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOID2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Post_replyCount(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Post",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Post().ReplyCount(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Post_repliesConnection(ctx context.Context, field graphql.CollectedField, obj *model.Post) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Post",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Post_repliesConnection_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Post().RepliesConnection(rctx, obj, args["after"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.PostsConnection)
+	fc.Result = res
+	return ec.marshalNPostsConnection2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPostsConnection(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _PostBookmark_post(ctx context.Context, field graphql.CollectedField, obj *model.PostBookmark) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "PostBookmark",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Post, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.Post)
+	fc.Result = res
+	return ec.marshalNPost2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPost(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _PostBookmark_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.PostBookmark) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "PostBookmark",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CreatedAt, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _PostBookmarksConnection_edges(ctx context.Context, field graphql.CollectedField, obj *model.PostBookmarksConnection) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "PostBookmarksConnection",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Edges, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*model.PostBookmarksEdge)
+	fc.Result = res
+	return ec.marshalOPostBookmarksEdge2ᚕᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPostBookmarksEdgeᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _PostBookmarksConnection_pageInfo(ctx context.Context, field graphql.CollectedField, obj *model.PostBookmarksConnection) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "PostBookmarksConnection",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.PageInfo, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.PageInfo)
+	fc.Result = res
+	return ec.marshalNPageInfo2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPageInfo(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _PostBookmarksEdge_cursor(ctx context.Context, field graphql.CollectedField, obj *model.PostBookmarksEdge) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "PostBookmarksEdge",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Cursor, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _PostBookmarksEdge_node(ctx context.Context, field graphql.CollectedField, obj *model.PostBookmarksEdge) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "PostBookmarksEdge",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Node, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.PostBookmark)
+	fc.Result = res
+	return ec.marshalOPostBookmark2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPostBookmark(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _PostReactionSummary_reaction(ctx context.Context, field graphql.CollectedField, obj *model.PostReactionSummary) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "PostReactionSummary",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Reaction, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _PostReactionSummary_count(ctx context.Context, field graphql.CollectedField, obj *model.PostReactionSummary) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "PostReactionSummary",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Count, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _PostReactionSummary_meReacted(ctx context.Context, field graphql.CollectedField, obj *model.PostReactionSummary) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "PostReactionSummary",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.MeReacted, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _PostReport_id(ctx context.Context, field graphql.CollectedField, obj *model.PostReport) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "PostReport",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _PostReport_post(ctx context.Context, field graphql.CollectedField, obj *model.PostReport) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "PostReport",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.PostReport().Post(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.Post)
+	fc.Result = res
+	return ec.marshalOPost2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPost(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _PostReport_reason(ctx context.Context, field graphql.CollectedField, obj *model.PostReport) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "PostReport",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Reason, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.PostReportReason)
+	fc.Result = res
+	return ec.marshalNPostReportReason2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPostReportReason(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _PostReport_note(ctx context.Context, field graphql.CollectedField, obj *model.PostReport) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "PostReport",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Note, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _PostReport_status(ctx context.Context, field graphql.CollectedField, obj *model.PostReport) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "PostReport",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Status, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.PostReportStatus)
+	fc.Result = res
+	return ec.marshalNPostReportStatus2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPostReportStatus(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _PostReport_action(ctx context.Context, field graphql.CollectedField, obj *model.PostReport) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "PostReport",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Action, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.PostReportAction)
+	fc.Result = res
+	return ec.marshalOPostReportAction2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPostReportAction(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _PostReport_resolvedBy(ctx context.Context, field graphql.CollectedField, obj *model.PostReport) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "PostReport",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.PostReport().ResolvedBy(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.UserProfile)
+	fc.Result = res
+	return ec.marshalOUserProfile2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐUserProfile(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _PostReport_resolvedAt(ctx context.Context, field graphql.CollectedField, obj *model.PostReport) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "PostReport",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ResolvedAt, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*time.Time)
+	fc.Result = res
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _PostReport_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.PostReport) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "PostReport",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CreatedAt, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _PostReportConnection_edges(ctx context.Context, field graphql.CollectedField, obj *model.PostReportConnection) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "PostReportConnection",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Edges, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*model.PostReportEdge)
+	fc.Result = res
+	return ec.marshalOPostReportEdge2ᚕᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPostReportEdgeᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _PostReportConnection_pageInfo(ctx context.Context, field graphql.CollectedField, obj *model.PostReportConnection) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "PostReportConnection",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.PageInfo, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.PageInfo)
+	fc.Result = res
+	return ec.marshalNPageInfo2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPageInfo(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _PostReportEdge_cursor(ctx context.Context, field graphql.CollectedField, obj *model.PostReportEdge) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "PostReportEdge",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Cursor, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _PostReportEdge_node(ctx context.Context, field graphql.CollectedField, obj *model.PostReportEdge) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "PostReportEdge",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Node, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.PostReport)
+	fc.Result = res
+	return ec.marshalOPostReport2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPostReport(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _PostsConnection_edges(ctx context.Context, field graphql.CollectedField, obj *model.PostsConnection) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "PostsConnection",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Edges, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*model.PostsEdge)
+	fc.Result = res
+	return ec.marshalOPostsEdge2ᚕᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPostsEdgeᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _PostsConnection_pageInfo(ctx context.Context, field graphql.CollectedField, obj *model.PostsConnection) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "PostsConnection",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.PageInfo, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.PageInfo)
+	fc.Result = res
+	return ec.marshalNPageInfo2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPageInfo(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _PostsEdge_cursor(ctx context.Context, field graphql.CollectedField, obj *model.PostsEdge) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "PostsEdge",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Cursor, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _PostsEdge_node(ctx context.Context, field graphql.CollectedField, obj *model.PostsEdge) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "PostsEdge",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Node, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.Post)
+	fc.Result = res
+	return ec.marshalOPost2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPost(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _PostsEdge_highlight(ctx context.Context, field graphql.CollectedField, obj *model.PostsEdge) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "PostsEdge",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Highlight, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query_discussion(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Query",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_discussion_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Discussion(rctx, args["id"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.Discussion)
+	fc.Result = res
+	return ec.marshalODiscussion2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussion(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query_discussionByLinkSlug(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Query",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_discussionByLinkSlug_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().DiscussionByLinkSlug(rctx, args["slug"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.Discussion)
+	fc.Result = res
+	return ec.marshalODiscussion2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussion(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query_listDiscussions(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Query",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_listDiscussions_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().ListDiscussions(rctx, args["state"].(model.DiscussionUserAccessState))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*model.Discussion)
+	fc.Result = res
+	return ec.marshalODiscussion2ᚕᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussionᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query_user(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Query",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_user_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().User(rctx, args["id"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.User)
+	fc.Result = res
+	return ec.marshalNUser2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐUser(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query_me(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Query",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Me(rctx)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.User)
+	fc.Result = res
+	return ec.marshalNUser2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐUser(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query_searchMyDiscussions(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Query",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_searchMyDiscussions_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().SearchMyDiscussions(rctx, args["query"].(string), args["after"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.PostsConnection)
+	fc.Result = res
+	return ec.marshalNPostsConnection2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPostsConnection(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Query",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query___type_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.introspectType(args["name"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Query",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.introspectSchema()
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Schema)
+	fc.Result = res
+	return ec.marshalO__Schema2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐSchema(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ScheduledPost_id(ctx context.Context, field graphql.CollectedField, obj *model.ScheduledPost) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "ScheduledPost",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ScheduledPost_discussion(ctx context.Context, field graphql.CollectedField, obj *model.ScheduledPost) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "ScheduledPost",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.ScheduledPost().Discussion(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.Discussion)
+	fc.Result = res
+	return ec.marshalNDiscussion2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussion(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ScheduledPost_participant(ctx context.Context, field graphql.CollectedField, obj *model.ScheduledPost) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "ScheduledPost",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.ScheduledPost().Participant(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.Participant)
+	fc.Result = res
+	return ec.marshalOParticipant2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐParticipant(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ScheduledPost_content(ctx context.Context, field graphql.CollectedField, obj *model.ScheduledPost) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "ScheduledPost",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.ScheduledPost().Content(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ScheduledPost_postType(ctx context.Context, field graphql.CollectedField, obj *model.ScheduledPost) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "ScheduledPost",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.ScheduledPost().PostType(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.PostType)
+	fc.Result = res
+	return ec.marshalNPostType2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPostType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ScheduledPost_publishAt(ctx context.Context, field graphql.CollectedField, obj *model.ScheduledPost) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "ScheduledPost",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.PublishAt, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ScheduledPost_status(ctx context.Context, field graphql.CollectedField, obj *model.ScheduledPost) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "ScheduledPost",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Status, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.ScheduledPostStatus)
+	fc.Result = res
+	return ec.marshalNScheduledPostStatus2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐScheduledPostStatus(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ScheduledPost_post(ctx context.Context, field graphql.CollectedField, obj *model.ScheduledPost) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "ScheduledPost",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.ScheduledPost().Post(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.Post)
+	fc.Result = res
+	return ec.marshalOPost2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPost(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ScheduledPost_failureReason(ctx context.Context, field graphql.CollectedField, obj *model.ScheduledPost) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "ScheduledPost",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.FailureReason, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ScheduledPost_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.ScheduledPost) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "ScheduledPost",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CreatedAt, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ScheduledPost_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model.ScheduledPost) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "ScheduledPost",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.UpdatedAt, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Subscription_postAdded(ctx context.Context, field graphql.CollectedField) (ret func() graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = nil
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Subscription",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Subscription_postAdded_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return nil
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Subscription().PostAdded(rctx, args["discussionID"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return nil
+	}
+	if resTmp == nil {
+		return nil
+	}
+	return func() graphql.Marshaler {
+		res, ok := <-resTmp.(<-chan *model.Post)
+		if !ok {
+			return nil
+		}
+		return graphql.WriterFunc(func(w io.Writer) {
+			w.Write([]byte{'{'})
+			graphql.MarshalString(field.Alias).MarshalGQL(w)
+			w.Write([]byte{':'})
+			ec.marshalOPost2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPost(ctx, field.Selections, res).MarshalGQL(w)
+			w.Write([]byte{'}'})
+		})
+	}
+}
+
+func (ec *executionContext) _Subscription_onDiscussionEvent(ctx context.Context, field graphql.CollectedField) (ret func() graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = nil
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Subscription",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Subscription_onDiscussionEvent_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return nil
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Subscription().OnDiscussionEvent(rctx, args["discussionID"].(string), args["afterSequence"].(*int))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return nil
+	}
+	if resTmp == nil {
+		return nil
+	}
+	return func() graphql.Marshaler {
+		res, ok := <-resTmp.(<-chan *model.DiscussionSubscriptionEvent)
+		if !ok {
+			return nil
+		}
+		return graphql.WriterFunc(func(w io.Writer) {
+			w.Write([]byte{'{'})
+			graphql.MarshalString(field.Alias).MarshalGQL(w)
+			w.Write([]byte{':'})
+			ec.marshalODiscussionSubscriptionEvent2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussionSubscriptionEvent(ctx, field.Selections, res).MarshalGQL(w)
+			w.Write([]byte{'}'})
+		})
+	}
+}
+
+func (ec *executionContext) _Tag_id(ctx context.Context, field graphql.CollectedField, obj *model.Tag) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Tag",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Tag_name(ctx context.Context, field graphql.CollectedField, obj *model.Tag) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Tag",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _TagSummary_name(ctx context.Context, field graphql.CollectedField, obj *model.TagSummary) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "TagSummary",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _TagSummary_postCount(ctx context.Context, field graphql.CollectedField, obj *model.TagSummary) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "TagSummary",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.PostCount, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _URL_displayText(ctx context.Context, field graphql.CollectedField, obj *model.URL) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "URL",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DisplayText, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _URL_url(ctx context.Context, field graphql.CollectedField, obj *model.URL) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "URL",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.URL, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _UnknownEntity_id(ctx context.Context, field graphql.CollectedField, obj *model.UnknownEntity) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "UnknownEntity",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _User_id(ctx context.Context, field graphql.CollectedField, obj *model.User) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "User",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _User_participants(ctx context.Context, field graphql.CollectedField, obj *model.User) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "User",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.User().Participants(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*model.Participant)
+	fc.Result = res
+	return ec.marshalOParticipant2ᚕᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐParticipantᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _User_viewers(ctx context.Context, field graphql.CollectedField, obj *model.User) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "User",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.User().Viewers(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*model.Viewer)
+	fc.Result = res
+	return ec.marshalOViewer2ᚕᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐViewerᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _User_profile(ctx context.Context, field graphql.CollectedField, obj *model.User) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "User",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.User().Profile(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.UserProfile)
+	fc.Result = res
+	return ec.marshalNUserProfile2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐUserProfile(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _User_devices(ctx context.Context, field graphql.CollectedField, obj *model.User) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "User",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.User().Devices(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*model.UserDevice)
+	fc.Result = res
+	return ec.marshalOUserDevice2ᚕᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐUserDeviceᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _User_moderatedDiscussions(ctx context.Context, field graphql.CollectedField, obj *model.User) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "User",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.User().ModeratedDiscussions(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*model.Discussion)
+	fc.Result = res
+	return ec.marshalODiscussion2ᚕᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussionᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _User_discussions(ctx context.Context, field graphql.CollectedField, obj *model.User) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "User",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_User_discussions_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.User().Discussions(rctx, obj, args["state"].(model.DiscussionUserAccessState))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*model.Discussion)
+	fc.Result = res
+	return ec.marshalODiscussion2ᚕᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussionᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _User_totalUnreadCount(ctx context.Context, field graphql.CollectedField, obj *model.User) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "User",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.User().TotalUnreadCount(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _User_bookmarks(ctx context.Context, field graphql.CollectedField, obj *model.User) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "User",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_User_bookmarks_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.User().Bookmarks(rctx, obj, args["after"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.PostBookmarksConnection)
+	fc.Result = res
+	return ec.marshalNPostBookmarksConnection2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPostBookmarksConnection(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _User_sentDiscussionAccessRequests(ctx context.Context, field graphql.CollectedField, obj *model.User) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "User",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.User().SentDiscussionAccessRequests(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*model.DiscussionAccessRequest)
+	fc.Result = res
+	return ec.marshalODiscussionAccessRequest2ᚕᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussionAccessRequestᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _UserDevice_id(ctx context.Context, field graphql.CollectedField, obj *model.UserDevice) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "UserDevice",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _UserDevice_user(ctx context.Context, field graphql.CollectedField, obj *model.UserDevice) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "UserDevice",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.User, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.User)
+	fc.Result = res
+	return ec.marshalNUser2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐUser(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _UserDevice_platform(ctx context.Context, field graphql.CollectedField, obj *model.UserDevice) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "UserDevice",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.UserDevice().Platform(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.Platform)
+	fc.Result = res
+	return ec.marshalNPlatform2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPlatform(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _UserDevice_lastSeen(ctx context.Context, field graphql.CollectedField, obj *model.UserDevice) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "UserDevice",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.LastSeen, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _UserProfile_id(ctx context.Context, field graphql.CollectedField, obj *model.UserProfile) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "UserProfile",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _UserProfile_displayName(ctx context.Context, field graphql.CollectedField, obj *model.UserProfile) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "UserProfile",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DisplayName, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _UserProfile_twitterURL(ctx context.Context, field graphql.CollectedField, obj *model.UserProfile) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "UserProfile",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TwitterURL(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.URL)
+	fc.Result = res
+	return ec.marshalNURL2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐURL(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _UserProfile_profileImageURL(ctx context.Context, field graphql.CollectedField, obj *model.UserProfile) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "UserProfile",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.UserProfile().ProfileImageURL(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _UserProfile_authenticatedWithTwitter(ctx context.Context, field graphql.CollectedField, obj *model.UserProfile) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "UserProfile",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.UserProfile().AuthenticatedWithTwitter(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Viewer_id(ctx context.Context, field graphql.CollectedField, obj *model.Viewer) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Viewer",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Viewer_discussion(ctx context.Context, field graphql.CollectedField, obj *model.Viewer) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Viewer",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Viewer().Discussion(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.Discussion)
+	fc.Result = res
+	return ec.marshalODiscussion2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussion(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Viewer_lastViewed(ctx context.Context, field graphql.CollectedField, obj *model.Viewer) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Viewer",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.LastViewed, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*time.Time)
+	fc.Result = res
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Viewer_lastViewedPost(ctx context.Context, field graphql.CollectedField, obj *model.Viewer) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Viewer",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Viewer().LastViewedPost(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.Post)
+	fc.Result = res
+	return ec.marshalOPost2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPost(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ViewersConnection_totalCount(ctx context.Context, field graphql.CollectedField, obj *model.ViewersConnection) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "ViewersConnection",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TotalCount(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ViewersConnection_edges(ctx context.Context, field graphql.CollectedField, obj *model.ViewersConnection) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "ViewersConnection",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.ViewersConnection().Edges(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*model.ViewersEdge)
+	fc.Result = res
+	return ec.marshalOViewersEdge2ᚕᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐViewersEdgeᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ViewersConnection_pageInfo(ctx context.Context, field graphql.CollectedField, obj *model.ViewersConnection) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "ViewersConnection",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.PageInfo(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.PageInfo)
+	fc.Result = res
+	return ec.marshalNPageInfo2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPageInfo(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ViewersEdge_cursor(ctx context.Context, field graphql.CollectedField, obj *model.ViewersEdge) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "ViewersEdge",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Cursor, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ViewersEdge_node(ctx context.Context, field graphql.CollectedField, obj *model.ViewersEdge) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "ViewersEdge",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Node, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.Viewer)
+	fc.Result = res
+	return ec.marshalOViewer2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐViewer(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "__Directive",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Directive_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "__Directive",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalOString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Directive_locations(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "__Directive",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Locations, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]string)
+	fc.Result = res
+	return ec.marshalN__DirectiveLocation2ᚕstringᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Directive_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "__Directive",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Args, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.InputValue)
+	fc.Result = res
+	return ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___EnumValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "__EnumValue",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___EnumValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "__EnumValue",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalOString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___EnumValue_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "__EnumValue",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.IsDeprecated(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___EnumValue_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "__EnumValue",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DeprecationReason(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Field_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "__Field",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Field_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "__Field",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalOString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "__Field",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Args, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.InputValue)
+	fc.Result = res
+	return ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Field_type(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "__Field",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Type, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Field_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "__Field",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.IsDeprecated(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Field_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "__Field",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DeprecationReason(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___InputValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "__InputValue",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___InputValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "__InputValue",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalOString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___InputValue_type(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "__InputValue",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Type, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___InputValue_defaultValue(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "__InputValue",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DefaultValue, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Schema_types(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "__Schema",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Types(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.Type)
+	fc.Result = res
+	return ec.marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Schema_queryType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "__Schema",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.QueryType(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Schema_mutationType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "__Schema",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.MutationType(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Schema_subscriptionType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "__Schema",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.SubscriptionType(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Schema_directives(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "__Schema",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Directives(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.Directive)
+	fc.Result = res
+	return ec.marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirectiveᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Type_kind(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "__Type",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Kind(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalN__TypeKind2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Type_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "__Type",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Type_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "__Type",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalOString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Type_fields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "__Type",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field___Type_fields_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Fields(args["includeDeprecated"].(bool)), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.Field)
+	fc.Result = res
+	return ec.marshalO__Field2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐFieldᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Type_interfaces(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "__Type",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Interfaces(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.Type)
+	fc.Result = res
+	return ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Type_possibleTypes(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "__Type",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.PossibleTypes(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.Type)
+	fc.Result = res
+	return ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Type_enumValues(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "__Type",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field___Type_enumValues_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.EnumValues(args["includeDeprecated"].(bool)), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.EnumValue)
+	fc.Result = res
+	return ec.marshalO__EnumValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValueᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Type_inputFields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "__Type",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.InputFields(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.InputValue)
+	fc.Result = res
+	return ec.marshalO__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Type_ofType(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "__Type",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.OfType(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+// endregion **************************** field.gotpl *****************************
+
+// region    **************************** input.gotpl *****************************
+
+func (ec *executionContext) unmarshalInputAddDiscussionParticipantInput(ctx context.Context, obj interface{}) (model.AddDiscussionParticipantInput, error) {
+	var it model.AddDiscussionParticipantInput
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "gradientColor":
+			var err error
+			it.GradientColor, err = ec.unmarshalOGradientColor2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐGradientColor(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "hasJoined":
+			var err error
+			it.HasJoined, err = ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "isAnonymous":
+			var err error
+			it.IsAnonymous, err = ec.unmarshalNBoolean2bool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputDiscussionCreationSettings(ctx context.Context, obj interface{}) (model.DiscussionCreationSettings, error) {
+	var it model.DiscussionCreationSettings
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "discussionJoinability":
+			var err error
+			it.DiscussionJoinability, err = ec.unmarshalNDiscussionJoinabilitySetting2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussionJoinabilitySetting(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputDiscussionInput(ctx context.Context, obj interface{}) (model.DiscussionInput, error) {
+	var it model.DiscussionInput
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "anonymityType":
+			var err error
+			it.AnonymityType, err = ec.unmarshalOAnonymityType2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐAnonymityType(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "title":
+			var err error
+			it.Title, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "description":
+			var err error
+			it.Description, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "publicAccess":
+			var err error
+			it.PublicAccess, err = ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "iconURL":
+			var err error
+			it.IconURL, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "discussionJoinability":
+			var err error
+			it.DiscussionJoinability, err = ec.unmarshalODiscussionJoinabilitySetting2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussionJoinabilitySetting(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "lockStatus":
+			var err error
+			it.LockStatus, err = ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "everyoneMentionPolicy":
+			var err error
+			it.EveryoneMentionPolicy, err = ec.unmarshalOEveryoneMentionPolicy2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐEveryoneMentionPolicy(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "slowModeSeconds":
+			var err error
+			it.SlowModeSeconds, err = ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputDiscussionUserSettings(ctx context.Context, obj interface{}) (model.DiscussionUserSettings, error) {
+	var it model.DiscussionUserSettings
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "state":
+			var err error
+			it.State, err = ec.unmarshalODiscussionUserAccessState2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussionUserAccessState(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "notifSetting":
+			var err error
+			it.NotifSetting, err = ec.unmarshalODiscussionUserNotificationSetting2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussionUserNotificationSetting(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputPollInput(ctx context.Context, obj interface{}) (model.PollInput, error) {
+	var it model.PollInput
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "options":
+			var err error
+			it.Options, err = ec.unmarshalNString2ᚕstringᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "allowMultipleVotes":
+			var err error
+			it.AllowMultipleVotes, err = ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "isAnonymous":
+			var err error
+			it.IsAnonymous, err = ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "closesAt":
+			var err error
+			it.ClosesAt, err = ec.unmarshalOTime2ᚖtimeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputPostContentInput(ctx context.Context, obj interface{}) (model.PostContentInput, error) {
+	var it model.PostContentInput
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "postText":
+			var err error
+			it.PostText, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "postType":
+			var err error
+			it.PostType, err = ec.unmarshalNPostType2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPostType(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "mentionedEntities":
+			var err error
+			it.MentionedEntities, err = ec.unmarshalOString2ᚕstringᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "quotedPostID":
+			var err error
+			it.QuotedPostID, err = ec.unmarshalOID2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "mediaID":
+			var err error
+			it.MediaID, err = ec.unmarshalOID2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "preview":
+			var err error
+			it.Preview, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "parentPostID":
+			var err error
+			it.ParentPostID, err = ec.unmarshalOID2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "poll":
+			var err error
+			it.Poll, err = ec.unmarshalOPollInput2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPollInput(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputUpdateParticipantInput(ctx context.Context, obj interface{}) (model.UpdateParticipantInput, error) {
+	var it model.UpdateParticipantInput
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "gradientColor":
+			var err error
+			it.GradientColor, err = ec.unmarshalOGradientColor2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐGradientColor(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "isUnsetGradient":
+			var err error
+			it.IsUnsetGradient, err = ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "isAnonymous":
+			var err error
+			it.IsAnonymous, err = ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "hasJoined":
+			var err error
+			it.HasJoined, err = ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+// endregion **************************** input.gotpl *****************************
+
+// region    ************************** interface.gotpl ***************************
+
+func (ec *executionContext) _DiscussionSubscriptionEntity(ctx context.Context, sel ast.SelectionSet, obj model.DiscussionSubscriptionEntity) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case model.Discussion:
+		return ec._Discussion(ctx, sel, &obj)
+	case *model.Discussion:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._Discussion(ctx, sel, obj)
+	case model.DiscussionAccessRequest:
+		return ec._DiscussionAccessRequest(ctx, sel, &obj)
+	case *model.DiscussionAccessRequest:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._DiscussionAccessRequest(ctx, sel, obj)
+	case model.Participant:
+		return ec._Participant(ctx, sel, &obj)
+	case *model.Participant:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._Participant(ctx, sel, obj)
+	case model.Post:
+		return ec._Post(ctx, sel, &obj)
+	case *model.Post:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._Post(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
 	}
 }
 
@@ -9548,56 +15890,1409 @@ func (ec *executionContext) _Entity(ctx context.Context, sel ast.SelectionSet, o
 	switch obj := (obj).(type) {
 	case nil:
 		return graphql.Null
-	case model.Discussion:
-		return ec._Discussion(ctx, sel, &obj)
-	case *model.Discussion:
-		if obj == nil {
-			return graphql.Null
+	case model.Discussion:
+		return ec._Discussion(ctx, sel, &obj)
+	case *model.Discussion:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._Discussion(ctx, sel, obj)
+	case model.UnknownEntity:
+		return ec._UnknownEntity(ctx, sel, &obj)
+	case *model.UnknownEntity:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._UnknownEntity(ctx, sel, obj)
+	case model.Tag:
+		return ec._Tag(ctx, sel, &obj)
+	case *model.Tag:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._Tag(ctx, sel, obj)
+	case model.GroupMention:
+		return ec._GroupMention(ctx, sel, &obj)
+	case *model.GroupMention:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._GroupMention(ctx, sel, obj)
+	case model.Participant:
+		return ec._Participant(ctx, sel, &obj)
+	case *model.Participant:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._Participant(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
+	}
+}
+
+// endregion ************************** interface.gotpl ***************************
+
+// region    **************************** object.gotpl ****************************
+
+var canJoinDiscussionResponseImplementors = []string{"CanJoinDiscussionResponse"}
+
+func (ec *executionContext) _CanJoinDiscussionResponse(ctx context.Context, sel ast.SelectionSet, obj *model.CanJoinDiscussionResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, canJoinDiscussionResponseImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CanJoinDiscussionResponse")
+		case "response":
+			out.Values[i] = ec._CanJoinDiscussionResponse_response(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "reason":
+			out.Values[i] = ec._CanJoinDiscussionResponse_reason(ctx, field, obj)
+		case "reasonCode":
+			out.Values[i] = ec._CanJoinDiscussionResponse_reasonCode(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var discussionImplementors = []string{"Discussion", "Entity", "DiscussionSubscriptionEntity"}
+
+func (ec *executionContext) _Discussion(ctx context.Context, sel ast.SelectionSet, obj *model.Discussion) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, discussionImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Discussion")
+		case "id":
+			out.Values[i] = ec._Discussion_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "moderator":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Discussion_moderator(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "anonymityType":
+			out.Values[i] = ec._Discussion_anonymityType(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "posts":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Discussion_posts(ctx, field, obj)
+				return res
+			})
+		case "postsConnection":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Discussion_postsConnection(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "pinnedPosts":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Discussion_pinnedPosts(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "searchPosts":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Discussion_searchPosts(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "postsByTag":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Discussion_postsByTag(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "topTags":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Discussion_topTags(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "iconURL":
+			out.Values[i] = ec._Discussion_iconURL(ctx, field, obj)
+		case "participants":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Discussion_participants(ctx, field, obj)
+				return res
+			})
+		case "title":
+			out.Values[i] = ec._Discussion_title(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "description":
+			out.Values[i] = ec._Discussion_description(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "titleHistory":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Discussion_titleHistory(ctx, field, obj)
+				return res
+			})
+		case "descriptionHistory":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Discussion_descriptionHistory(ctx, field, obj)
+				return res
+			})
+		case "createdAt":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Discussion_createdAt(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "updatedAt":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Discussion_updatedAt(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "meParticipant":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Discussion_meParticipant(ctx, field, obj)
+				return res
+			})
+		case "meAvailableParticipants":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Discussion_meAvailableParticipants(ctx, field, obj)
+				return res
+			})
+		case "meCanJoinDiscussion":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Discussion_meCanJoinDiscussion(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "meViewer":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Discussion_meViewer(ctx, field, obj)
+				return res
+			})
+		case "meUnreadCount":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Discussion_meUnreadCount(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "meUnreadMentionCount":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Discussion_meUnreadMentionCount(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "meNotificationSettings":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Discussion_meNotificationSettings(ctx, field, obj)
+				return res
+			})
+		case "meDiscussionStatus":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Discussion_meDiscussionStatus(ctx, field, obj)
+				return res
+			})
+		case "meBanReason":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Discussion_meBanReason(ctx, field, obj)
+				return res
+			})
+		case "meModeratorRole":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Discussion_meModeratorRole(ctx, field, obj)
+				return res
+			})
+		case "accessRequests":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Discussion_accessRequests(ctx, field, obj)
+				return res
+			})
+		case "scheduledPosts":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Discussion_scheduledPosts(ctx, field, obj)
+				return res
+			})
+		case "moderationLog":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Discussion_moderationLog(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "reports":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Discussion_reports(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "discussionAccessLink":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Discussion_discussionAccessLink(ctx, field, obj)
+				return res
+			})
+		case "discussionJoinability":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Discussion_discussionJoinability(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "shuffleCount":
+			out.Values[i] = ec._Discussion_shuffleCount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "secondsUntilShuffle":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Discussion_secondsUntilShuffle(ctx, field, obj)
+				return res
+			})
+		case "lockStatus":
+			out.Values[i] = ec._Discussion_lockStatus(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "everyoneMentionPolicy":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Discussion_everyoneMentionPolicy(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "slowModeSeconds":
+			out.Values[i] = ec._Discussion_slowModeSeconds(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "archive":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Discussion_archive(ctx, field, obj)
+				return res
+			})
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var discussionAccessLinkImplementors = []string{"DiscussionAccessLink"}
+
+func (ec *executionContext) _DiscussionAccessLink(ctx context.Context, sel ast.SelectionSet, obj *model.DiscussionAccessLink) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, discussionAccessLinkImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("DiscussionAccessLink")
+		case "discussion":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._DiscussionAccessLink_discussion(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "linkSlug":
+			out.Values[i] = ec._DiscussionAccessLink_linkSlug(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "url":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._DiscussionAccessLink_url(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "createdAt":
+			out.Values[i] = ec._DiscussionAccessLink_createdAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "updatedAt":
+			out.Values[i] = ec._DiscussionAccessLink_updatedAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "isDeleted":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._DiscussionAccessLink_isDeleted(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var discussionAccessRequestImplementors = []string{"DiscussionAccessRequest", "DiscussionSubscriptionEntity"}
+
+func (ec *executionContext) _DiscussionAccessRequest(ctx context.Context, sel ast.SelectionSet, obj *model.DiscussionAccessRequest) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, discussionAccessRequestImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("DiscussionAccessRequest")
+		case "id":
+			out.Values[i] = ec._DiscussionAccessRequest_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "userProfile":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._DiscussionAccessRequest_userProfile(ctx, field, obj)
+				return res
+			})
+		case "discussion":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._DiscussionAccessRequest_discussion(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "createdAt":
+			out.Values[i] = ec._DiscussionAccessRequest_createdAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "updatedAt":
+			out.Values[i] = ec._DiscussionAccessRequest_updatedAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "isDeleted":
+			out.Values[i] = ec._DiscussionAccessRequest_isDeleted(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "status":
+			out.Values[i] = ec._DiscussionAccessRequest_status(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var discussionArchiveImplementors = []string{"DiscussionArchive"}
+
+func (ec *executionContext) _DiscussionArchive(ctx context.Context, sel ast.SelectionSet, obj *model.DiscussionArchive) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, discussionArchiveImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("DiscussionArchive")
+		case "archive":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._DiscussionArchive_archive(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "createdAt":
+			out.Values[i] = ec._DiscussionArchive_createdAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var discussionSubscriptionEventImplementors = []string{"DiscussionSubscriptionEvent"}
+
+func (ec *executionContext) _DiscussionSubscriptionEvent(ctx context.Context, sel ast.SelectionSet, obj *model.DiscussionSubscriptionEvent) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, discussionSubscriptionEventImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("DiscussionSubscriptionEvent")
+		case "sequence":
+			out.Values[i] = ec._DiscussionSubscriptionEvent_sequence(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "eventType":
+			out.Values[i] = ec._DiscussionSubscriptionEvent_eventType(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "entity":
+			out.Values[i] = ec._DiscussionSubscriptionEvent_entity(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var discussionUserAccessImplementors = []string{"DiscussionUserAccess"}
+
+func (ec *executionContext) _DiscussionUserAccess(ctx context.Context, sel ast.SelectionSet, obj *model.DiscussionUserAccess) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, discussionUserAccessImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("DiscussionUserAccess")
+		case "discussion":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._DiscussionUserAccess_discussion(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "user":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._DiscussionUserAccess_user(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "state":
+			out.Values[i] = ec._DiscussionUserAccess_state(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "createdAt":
+			out.Values[i] = ec._DiscussionUserAccess_createdAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "updatedAt":
+			out.Values[i] = ec._DiscussionUserAccess_updatedAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "isDeleted":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._DiscussionUserAccess_isDeleted(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "request":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._DiscussionUserAccess_request(ctx, field, obj)
+				return res
+			})
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var groupMentionImplementors = []string{"GroupMention", "Entity"}
+
+func (ec *executionContext) _GroupMention(ctx context.Context, sel ast.SelectionSet, obj *model.GroupMention) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, groupMentionImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("GroupMention")
+		case "id":
+			out.Values[i] = ec._GroupMention_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "group":
+			out.Values[i] = ec._GroupMention_group(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var historicalStringImplementors = []string{"HistoricalString"}
+
+func (ec *executionContext) _HistoricalString(ctx context.Context, sel ast.SelectionSet, obj *model.HistoricalString) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, historicalStringImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("HistoricalString")
+		case "value":
+			out.Values[i] = ec._HistoricalString_value(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "createdAt":
+			out.Values[i] = ec._HistoricalString_createdAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var linkPreviewImplementors = []string{"LinkPreview"}
+
+func (ec *executionContext) _LinkPreview(ctx context.Context, sel ast.SelectionSet, obj *model.LinkPreview) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, linkPreviewImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("LinkPreview")
+		case "url":
+			out.Values[i] = ec._LinkPreview_url(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "title":
+			out.Values[i] = ec._LinkPreview_title(ctx, field, obj)
+		case "description":
+			out.Values[i] = ec._LinkPreview_description(ctx, field, obj)
+		case "imageURL":
+			out.Values[i] = ec._LinkPreview_imageURL(ctx, field, obj)
+		case "siteName":
+			out.Values[i] = ec._LinkPreview_siteName(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var mediaImplementors = []string{"Media"}
+
+func (ec *executionContext) _Media(ctx context.Context, sel ast.SelectionSet, obj *model.Media) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, mediaImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Media")
+		case "id":
+			out.Values[i] = ec._Media_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "createdAt":
+			out.Values[i] = ec._Media_createdAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "isDeleted":
+			out.Values[i] = ec._Media_isDeleted(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "deletedReasonCode":
+			out.Values[i] = ec._Media_deletedReasonCode(ctx, field, obj)
+		case "mediaType":
+			out.Values[i] = ec._Media_mediaType(ctx, field, obj)
+		case "mediaSize":
+			out.Values[i] = ec._Media_mediaSize(ctx, field, obj)
+		case "assetLocation":
+			out.Values[i] = ec._Media_assetLocation(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var mediaSizeImplementors = []string{"MediaSize"}
+
+func (ec *executionContext) _MediaSize(ctx context.Context, sel ast.SelectionSet, obj *model.MediaSize) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, mediaSizeImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("MediaSize")
+		case "height":
+			out.Values[i] = ec._MediaSize_height(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "width":
+			out.Values[i] = ec._MediaSize_width(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "sizeKb":
+			out.Values[i] = ec._MediaSize_sizeKb(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var moderationLogConnectionImplementors = []string{"ModerationLogConnection"}
+
+func (ec *executionContext) _ModerationLogConnection(ctx context.Context, sel ast.SelectionSet, obj *model.ModerationLogConnection) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, moderationLogConnectionImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ModerationLogConnection")
+		case "edges":
+			out.Values[i] = ec._ModerationLogConnection_edges(ctx, field, obj)
+		case "pageInfo":
+			out.Values[i] = ec._ModerationLogConnection_pageInfo(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var moderationLogEdgeImplementors = []string{"ModerationLogEdge"}
+
+func (ec *executionContext) _ModerationLogEdge(ctx context.Context, sel ast.SelectionSet, obj *model.ModerationLogEdge) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, moderationLogEdgeImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ModerationLogEdge")
+		case "cursor":
+			out.Values[i] = ec._ModerationLogEdge_cursor(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "node":
+			out.Values[i] = ec._ModerationLogEdge_node(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var moderationLogEntryImplementors = []string{"ModerationLogEntry"}
+
+func (ec *executionContext) _ModerationLogEntry(ctx context.Context, sel ast.SelectionSet, obj *model.ModerationLogEntry) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, moderationLogEntryImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ModerationLogEntry")
+		case "id":
+			out.Values[i] = ec._ModerationLogEntry_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "actor":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._ModerationLogEntry_actor(ctx, field, obj)
+				return res
+			})
+		case "action":
+			out.Values[i] = ec._ModerationLogEntry_action(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "targetType":
+			out.Values[i] = ec._ModerationLogEntry_targetType(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "targetID":
+			out.Values[i] = ec._ModerationLogEntry_targetID(ctx, field, obj)
+		case "reason":
+			out.Values[i] = ec._ModerationLogEntry_reason(ctx, field, obj)
+		case "createdAt":
+			out.Values[i] = ec._ModerationLogEntry_createdAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
-		return ec._Discussion(ctx, sel, obj)
-	case model.UnknownEntity:
-		return ec._UnknownEntity(ctx, sel, &obj)
-	case *model.UnknownEntity:
-		if obj == nil {
-			return graphql.Null
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var moderatorImplementors = []string{"Moderator"}
+
+func (ec *executionContext) _Moderator(ctx context.Context, sel ast.SelectionSet, obj *model.Moderator) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, moderatorImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Moderator")
+		case "id":
+			out.Values[i] = ec._Moderator_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "discussion":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Moderator_discussion(ctx, field, obj)
+				return res
+			})
+		case "userProfile":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Moderator_userProfile(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
-		return ec._UnknownEntity(ctx, sel, obj)
-	case model.Participant:
-		return ec._Participant(ctx, sel, &obj)
-	case *model.Participant:
-		if obj == nil {
-			return graphql.Null
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var mutationImplementors = []string{"Mutation"}
+
+func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, mutationImplementors)
+
+	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
+		Object: "Mutation",
+	})
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Mutation")
+		case "addDiscussionParticipant":
+			out.Values[i] = ec._Mutation_addDiscussionParticipant(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "addPost":
+			out.Values[i] = ec._Mutation_addPost(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "createDiscussion":
+			out.Values[i] = ec._Mutation_createDiscussion(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "updateParticipant":
+			out.Values[i] = ec._Mutation_updateParticipant(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "upsertUserDevice":
+			out.Values[i] = ec._Mutation_upsertUserDevice(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "updateDiscussion":
+			out.Values[i] = ec._Mutation_updateDiscussion(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "updateDiscussionUserSettings":
+			out.Values[i] = ec._Mutation_updateDiscussionUserSettings(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "requestAccessToDiscussion":
+			out.Values[i] = ec._Mutation_requestAccessToDiscussion(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "respondToRequestAccess":
+			out.Values[i] = ec._Mutation_respondToRequestAccess(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "deletePost":
+			out.Values[i] = ec._Mutation_deletePost(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "editPost":
+			out.Values[i] = ec._Mutation_editPost(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "schedulePost":
+			out.Values[i] = ec._Mutation_schedulePost(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "cancelScheduledPost":
+			out.Values[i] = ec._Mutation_cancelScheduledPost(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "addReaction":
+			out.Values[i] = ec._Mutation_addReaction(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "removeReaction":
+			out.Values[i] = ec._Mutation_removeReaction(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "votePoll":
+			out.Values[i] = ec._Mutation_votePoll(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "pinPost":
+			out.Values[i] = ec._Mutation_pinPost(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "unpinPost":
+			out.Values[i] = ec._Mutation_unpinPost(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "bookmarkPost":
+			out.Values[i] = ec._Mutation_bookmarkPost(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "unbookmarkPost":
+			out.Values[i] = ec._Mutation_unbookmarkPost(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "reportPost":
+			out.Values[i] = ec._Mutation_reportPost(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "dismissPostReport":
+			out.Values[i] = ec._Mutation_dismissPostReport(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "actionPostReport":
+			out.Values[i] = ec._Mutation_actionPostReport(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "banParticipant":
+			out.Values[i] = ec._Mutation_banParticipant(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "unbanParticipant":
+			out.Values[i] = ec._Mutation_unbanParticipant(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "shuffleDiscussion":
+			out.Values[i] = ec._Mutation_shuffleDiscussion(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "setLastPostViewed":
+			out.Values[i] = ec._Mutation_setLastPostViewed(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "markAllDiscussionsRead":
+			out.Values[i] = ec._Mutation_markAllDiscussionsRead(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "muteParticipants":
+			out.Values[i] = ec._Mutation_muteParticipants(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "unmuteParticipants":
+			out.Values[i] = ec._Mutation_unmuteParticipants(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "grantModeratorRole":
+			out.Values[i] = ec._Mutation_grantModeratorRole(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "revokeModeratorRole":
+			out.Values[i] = ec._Mutation_revokeModeratorRole(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "transferDiscussionOwnership":
+			out.Values[i] = ec._Mutation_transferDiscussionOwnership(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
-		return ec._Participant(ctx, sel, obj)
-	default:
-		panic(fmt.Errorf("unexpected type %T", obj))
 	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
 }
 
-// endregion ************************** interface.gotpl ***************************
-
-// region    **************************** object.gotpl ****************************
-
-var canJoinDiscussionResponseImplementors = []string{"CanJoinDiscussionResponse"}
+var pageInfoImplementors = []string{"PageInfo"}
 
-func (ec *executionContext) _CanJoinDiscussionResponse(ctx context.Context, sel ast.SelectionSet, obj *model.CanJoinDiscussionResponse) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, canJoinDiscussionResponseImplementors)
+func (ec *executionContext) _PageInfo(ctx context.Context, sel ast.SelectionSet, obj *model.PageInfo) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, pageInfoImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("CanJoinDiscussionResponse")
-		case "response":
-			out.Values[i] = ec._CanJoinDiscussionResponse_response(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("PageInfo")
+		case "startCursor":
+			out.Values[i] = ec._PageInfo_startCursor(ctx, field, obj)
+		case "endCursor":
+			out.Values[i] = ec._PageInfo_endCursor(ctx, field, obj)
+		case "hasNextPage":
+			out.Values[i] = ec._PageInfo_hasNextPage(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "hasPreviousPage":
+			out.Values[i] = ec._PageInfo_hasPreviousPage(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "reason":
-			out.Values[i] = ec._CanJoinDiscussionResponse_reason(ctx, field, obj)
-		case "reasonCode":
-			out.Values[i] = ec._CanJoinDiscussionResponse_reasonCode(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -9609,23 +17304,25 @@ func (ec *executionContext) _CanJoinDiscussionResponse(ctx context.Context, sel
 	return out
 }
 
-var discussionImplementors = []string{"Discussion", "Entity"}
+var participantImplementors = []string{"Participant", "Entity", "DiscussionSubscriptionEntity"}
 
-func (ec *executionContext) _Discussion(ctx context.Context, sel ast.SelectionSet, obj *model.Discussion) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, discussionImplementors)
+func (ec *executionContext) _Participant(ctx context.Context, sel ast.SelectionSet, obj *model.Participant) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, participantImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("Discussion")
+			out.Values[i] = graphql.MarshalString("Participant")
 		case "id":
-			out.Values[i] = ec._Discussion_id(ctx, field, obj)
+			out.Values[i] = ec._Participant_id(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				atomic.AddUint32(&invalids, 1)
 			}
-		case "moderator":
+		case "participantID":
+			out.Values[i] = ec._Participant_participantID(ctx, field, obj)
+		case "discussion":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
 				defer func() {
@@ -9633,17 +17330,23 @@ func (ec *executionContext) _Discussion(ctx context.Context, sel ast.SelectionSe
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Discussion_moderator(ctx, field, obj)
+				res = ec._Participant_discussion(ctx, field, obj)
+				return res
+			})
+		case "viewer":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Participant_viewer(ctx, field, obj)
 				if res == graphql.Null {
 					atomic.AddUint32(&invalids, 1)
 				}
 				return res
 			})
-		case "anonymityType":
-			out.Values[i] = ec._Discussion_anonymityType(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
-			}
 		case "posts":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
@@ -9652,10 +17355,15 @@ func (ec *executionContext) _Discussion(ctx context.Context, sel ast.SelectionSe
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Discussion_posts(ctx, field, obj)
+				res = ec._Participant_posts(ctx, field, obj)
 				return res
 			})
-		case "postsConnection":
+		case "isAnonymous":
+			out.Values[i] = ec._Participant_isAnonymous(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "gradientColor":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
 				defer func() {
@@ -9663,15 +17371,10 @@ func (ec *executionContext) _Discussion(ctx context.Context, sel ast.SelectionSe
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Discussion_postsConnection(ctx, field, obj)
-				if res == graphql.Null {
-					atomic.AddUint32(&invalids, 1)
-				}
+				res = ec._Participant_gradientColor(ctx, field, obj)
 				return res
 			})
-		case "iconURL":
-			out.Values[i] = ec._Discussion_iconURL(ctx, field, obj)
-		case "participants":
+		case "inviter":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
 				defer func() {
@@ -9679,20 +17382,18 @@ func (ec *executionContext) _Discussion(ctx context.Context, sel ast.SelectionSe
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Discussion_participants(ctx, field, obj)
+				res = ec._Participant_inviter(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
 				return res
 			})
-		case "title":
-			out.Values[i] = ec._Discussion_title(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
-			}
-		case "description":
-			out.Values[i] = ec._Discussion_description(ctx, field, obj)
+		case "hasJoined":
+			out.Values[i] = ec._Participant_hasJoined(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				atomic.AddUint32(&invalids, 1)
 			}
-		case "titleHistory":
+		case "userProfile":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
 				defer func() {
@@ -9700,10 +17401,15 @@ func (ec *executionContext) _Discussion(ctx context.Context, sel ast.SelectionSe
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Discussion_titleHistory(ctx, field, obj)
+				res = ec._Participant_userProfile(ctx, field, obj)
 				return res
 			})
-		case "descriptionHistory":
+		case "isBanned":
+			out.Values[i] = ec._Participant_isBanned(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "anonDisplayName":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
 				defer func() {
@@ -9711,10 +17417,10 @@ func (ec *executionContext) _Discussion(ctx context.Context, sel ast.SelectionSe
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Discussion_descriptionHistory(ctx, field, obj)
+				res = ec._Participant_anonDisplayName(ctx, field, obj)
 				return res
 			})
-		case "createdAt":
+		case "mutedForSeconds":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
 				defer func() {
@@ -9722,13 +17428,10 @@ func (ec *executionContext) _Discussion(ctx context.Context, sel ast.SelectionSe
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Discussion_createdAt(ctx, field, obj)
-				if res == graphql.Null {
-					atomic.AddUint32(&invalids, 1)
-				}
+				res = ec._Participant_mutedForSeconds(ctx, field, obj)
 				return res
 			})
-		case "updatedAt":
+		case "meSecondsUntilCanPost":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
 				defer func() {
@@ -9736,13 +17439,10 @@ func (ec *executionContext) _Discussion(ctx context.Context, sel ast.SelectionSe
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Discussion_updatedAt(ctx, field, obj)
-				if res == graphql.Null {
-					atomic.AddUint32(&invalids, 1)
-				}
+				res = ec._Participant_meSecondsUntilCanPost(ctx, field, obj)
 				return res
 			})
-		case "meParticipant":
+		case "moderatorRole":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
 				defer func() {
@@ -9750,10 +17450,63 @@ func (ec *executionContext) _Discussion(ctx context.Context, sel ast.SelectionSe
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Discussion_meParticipant(ctx, field, obj)
+				res = ec._Participant_moderatorRole(ctx, field, obj)
 				return res
 			})
-		case "meAvailableParticipants":
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var participantProfileImplementors = []string{"ParticipantProfile"}
+
+func (ec *executionContext) _ParticipantProfile(ctx context.Context, sel ast.SelectionSet, obj *model.ParticipantProfile) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, participantProfileImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ParticipantProfile")
+		case "isAnonymous":
+			out.Values[i] = ec._ParticipantProfile_isAnonymous(ctx, field, obj)
+		case "gradientColor":
+			out.Values[i] = ec._ParticipantProfile_gradientColor(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var participantsConnectionImplementors = []string{"ParticipantsConnection"}
+
+func (ec *executionContext) _ParticipantsConnection(ctx context.Context, sel ast.SelectionSet, obj *model.ParticipantsConnection) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, participantsConnectionImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ParticipantsConnection")
+		case "totalCount":
+			out.Values[i] = ec._ParticipantsConnection_totalCount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "edges":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
 				defer func() {
@@ -9761,10 +17514,78 @@ func (ec *executionContext) _Discussion(ctx context.Context, sel ast.SelectionSe
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Discussion_meAvailableParticipants(ctx, field, obj)
+				res = ec._ParticipantsConnection_edges(ctx, field, obj)
 				return res
 			})
-		case "meCanJoinDiscussion":
+		case "pageInfo":
+			out.Values[i] = ec._ParticipantsConnection_pageInfo(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var participantsEdgeImplementors = []string{"ParticipantsEdge"}
+
+func (ec *executionContext) _ParticipantsEdge(ctx context.Context, sel ast.SelectionSet, obj *model.ParticipantsEdge) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, participantsEdgeImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ParticipantsEdge")
+		case "cursor":
+			out.Values[i] = ec._ParticipantsEdge_cursor(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "node":
+			out.Values[i] = ec._ParticipantsEdge_node(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var pollImplementors = []string{"Poll"}
+
+func (ec *executionContext) _Poll(ctx context.Context, sel ast.SelectionSet, obj *model.Poll) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, pollImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Poll")
+		case "allowMultipleVotes":
+			out.Values[i] = ec._Poll_allowMultipleVotes(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "isAnonymous":
+			out.Values[i] = ec._Poll_isAnonymous(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "closesAt":
+			out.Values[i] = ec._Poll_closesAt(ctx, field, obj)
+		case "isClosed":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
 				defer func() {
@@ -9772,24 +17593,60 @@ func (ec *executionContext) _Discussion(ctx context.Context, sel ast.SelectionSe
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Discussion_meCanJoinDiscussion(ctx, field, obj)
+				res = ec._Poll_isClosed(ctx, field, obj)
 				if res == graphql.Null {
 					atomic.AddUint32(&invalids, 1)
 				}
 				return res
 			})
-		case "meViewer":
-			field := field
-			out.Concurrently(i, func() (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Discussion_meViewer(ctx, field, obj)
-				return res
-			})
-		case "meNotificationSettings":
+		case "options":
+			out.Values[i] = ec._Poll_options(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var pollOptionImplementors = []string{"PollOption"}
+
+func (ec *executionContext) _PollOption(ctx context.Context, sel ast.SelectionSet, obj *model.PollOption) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, pollOptionImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("PollOption")
+		case "id":
+			out.Values[i] = ec._PollOption_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "text":
+			out.Values[i] = ec._PollOption_text(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "voteCount":
+			out.Values[i] = ec._PollOption_voteCount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "meVoted":
+			out.Values[i] = ec._PollOption_meVoted(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "voters":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
 				defer func() {
@@ -9797,10 +17654,40 @@ func (ec *executionContext) _Discussion(ctx context.Context, sel ast.SelectionSe
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Discussion_meNotificationSettings(ctx, field, obj)
+				res = ec._PollOption_voters(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
 				return res
 			})
-		case "meDiscussionStatus":
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var postImplementors = []string{"Post", "DiscussionSubscriptionEntity"}
+
+func (ec *executionContext) _Post(ctx context.Context, sel ast.SelectionSet, obj *model.Post) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, postImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Post")
+		case "id":
+			out.Values[i] = ec._Post_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "isDeleted":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
 				defer func() {
@@ -9808,10 +17695,15 @@ func (ec *executionContext) _Discussion(ctx context.Context, sel ast.SelectionSe
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Discussion_meDiscussionStatus(ctx, field, obj)
+				res = ec._Post_isDeleted(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
 				return res
 			})
-		case "accessRequests":
+		case "deletedReasonCode":
+			out.Values[i] = ec._Post_deletedReasonCode(ctx, field, obj)
+		case "content":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
 				defer func() {
@@ -9819,10 +17711,10 @@ func (ec *executionContext) _Discussion(ctx context.Context, sel ast.SelectionSe
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Discussion_accessRequests(ctx, field, obj)
+				res = ec._Post_content(ctx, field, obj)
 				return res
 			})
-		case "discussionAccessLink":
+		case "contentHTML":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
 				defer func() {
@@ -9830,10 +17722,10 @@ func (ec *executionContext) _Discussion(ctx context.Context, sel ast.SelectionSe
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Discussion_discussionAccessLink(ctx, field, obj)
+				res = ec._Post_contentHTML(ctx, field, obj)
 				return res
 			})
-		case "discussionJoinability":
+		case "discussion":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
 				defer func() {
@@ -9841,34 +17733,13 @@ func (ec *executionContext) _Discussion(ctx context.Context, sel ast.SelectionSe
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Discussion_discussionJoinability(ctx, field, obj)
+				res = ec._Post_discussion(ctx, field, obj)
 				if res == graphql.Null {
 					atomic.AddUint32(&invalids, 1)
 				}
 				return res
 			})
-		case "shuffleCount":
-			out.Values[i] = ec._Discussion_shuffleCount(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
-			}
-		case "secondsUntilShuffle":
-			field := field
-			out.Concurrently(i, func() (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Discussion_secondsUntilShuffle(ctx, field, obj)
-				return res
-			})
-		case "lockStatus":
-			out.Values[i] = ec._Discussion_lockStatus(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
-			}
-		case "archive":
+		case "participant":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
 				defer func() {
@@ -9876,32 +17747,10 @@ func (ec *executionContext) _Discussion(ctx context.Context, sel ast.SelectionSe
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Discussion_archive(ctx, field, obj)
+				res = ec._Post_participant(ctx, field, obj)
 				return res
 			})
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
-		}
-	}
-	out.Dispatch()
-	if invalids > 0 {
-		return graphql.Null
-	}
-	return out
-}
-
-var discussionAccessLinkImplementors = []string{"DiscussionAccessLink"}
-
-func (ec *executionContext) _DiscussionAccessLink(ctx context.Context, sel ast.SelectionSet, obj *model.DiscussionAccessLink) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, discussionAccessLinkImplementors)
-
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("DiscussionAccessLink")
-		case "discussion":
+		case "createdAt":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
 				defer func() {
@@ -9909,18 +17758,13 @@ func (ec *executionContext) _DiscussionAccessLink(ctx context.Context, sel ast.S
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._DiscussionAccessLink_discussion(ctx, field, obj)
+				res = ec._Post_createdAt(ctx, field, obj)
 				if res == graphql.Null {
 					atomic.AddUint32(&invalids, 1)
 				}
 				return res
 			})
-		case "linkSlug":
-			out.Values[i] = ec._DiscussionAccessLink_linkSlug(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
-			}
-		case "url":
+		case "updatedAt":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
 				defer func() {
@@ -9928,23 +17772,13 @@ func (ec *executionContext) _DiscussionAccessLink(ctx context.Context, sel ast.S
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._DiscussionAccessLink_url(ctx, field, obj)
+				res = ec._Post_updatedAt(ctx, field, obj)
 				if res == graphql.Null {
 					atomic.AddUint32(&invalids, 1)
 				}
 				return res
 			})
-		case "createdAt":
-			out.Values[i] = ec._DiscussionAccessLink_createdAt(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
-			}
-		case "updatedAt":
-			out.Values[i] = ec._DiscussionAccessLink_updatedAt(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
-			}
-		case "isDeleted":
+		case "quotedPost":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
 				defer func() {
@@ -9952,40 +17786,10 @@ func (ec *executionContext) _DiscussionAccessLink(ctx context.Context, sel ast.S
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._DiscussionAccessLink_isDeleted(ctx, field, obj)
-				if res == graphql.Null {
-					atomic.AddUint32(&invalids, 1)
-				}
+				res = ec._Post_quotedPost(ctx, field, obj)
 				return res
 			})
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
-		}
-	}
-	out.Dispatch()
-	if invalids > 0 {
-		return graphql.Null
-	}
-	return out
-}
-
-var discussionAccessRequestImplementors = []string{"DiscussionAccessRequest"}
-
-func (ec *executionContext) _DiscussionAccessRequest(ctx context.Context, sel ast.SelectionSet, obj *model.DiscussionAccessRequest) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, discussionAccessRequestImplementors)
-
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("DiscussionAccessRequest")
-		case "id":
-			out.Values[i] = ec._DiscussionAccessRequest_id(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
-			}
-		case "userProfile":
+		case "mentionedEntities":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
 				defer func() {
@@ -9993,10 +17797,10 @@ func (ec *executionContext) _DiscussionAccessRequest(ctx context.Context, sel as
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._DiscussionAccessRequest_userProfile(ctx, field, obj)
+				res = ec._Post_mentionedEntities(ctx, field, obj)
 				return res
 			})
-		case "discussion":
+		case "tags":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
 				defer func() {
@@ -10004,55 +17808,29 @@ func (ec *executionContext) _DiscussionAccessRequest(ctx context.Context, sel as
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._DiscussionAccessRequest_discussion(ctx, field, obj)
+				res = ec._Post_tags(ctx, field, obj)
 				if res == graphql.Null {
 					atomic.AddUint32(&invalids, 1)
 				}
 				return res
 			})
-		case "createdAt":
-			out.Values[i] = ec._DiscussionAccessRequest_createdAt(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
-			}
-		case "updatedAt":
-			out.Values[i] = ec._DiscussionAccessRequest_updatedAt(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
-			}
-		case "isDeleted":
-			out.Values[i] = ec._DiscussionAccessRequest_isDeleted(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
-			}
-		case "status":
-			out.Values[i] = ec._DiscussionAccessRequest_status(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
-		}
-	}
-	out.Dispatch()
-	if invalids > 0 {
-		return graphql.Null
-	}
-	return out
-}
-
-var discussionArchiveImplementors = []string{"DiscussionArchive"}
-
-func (ec *executionContext) _DiscussionArchive(ctx context.Context, sel ast.SelectionSet, obj *model.DiscussionArchive) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, discussionArchiveImplementors)
-
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("DiscussionArchive")
-		case "archive":
+		case "media":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Post_media(ctx, field, obj)
+				return res
+			})
+		case "postType":
+			out.Values[i] = ec._Post_postType(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "isEdited":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
 				defer func() {
@@ -10060,72 +17838,13 @@ func (ec *executionContext) _DiscussionArchive(ctx context.Context, sel ast.Sele
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._DiscussionArchive_archive(ctx, field, obj)
+				res = ec._Post_isEdited(ctx, field, obj)
 				if res == graphql.Null {
 					atomic.AddUint32(&invalids, 1)
 				}
 				return res
 			})
-		case "createdAt":
-			out.Values[i] = ec._DiscussionArchive_createdAt(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
-		}
-	}
-	out.Dispatch()
-	if invalids > 0 {
-		return graphql.Null
-	}
-	return out
-}
-
-var discussionSubscriptionEventImplementors = []string{"DiscussionSubscriptionEvent"}
-
-func (ec *executionContext) _DiscussionSubscriptionEvent(ctx context.Context, sel ast.SelectionSet, obj *model.DiscussionSubscriptionEvent) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, discussionSubscriptionEventImplementors)
-
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("DiscussionSubscriptionEvent")
-		case "eventType":
-			out.Values[i] = ec._DiscussionSubscriptionEvent_eventType(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "entity":
-			out.Values[i] = ec._DiscussionSubscriptionEvent_entity(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
-		}
-	}
-	out.Dispatch()
-	if invalids > 0 {
-		return graphql.Null
-	}
-	return out
-}
-
-var discussionUserAccessImplementors = []string{"DiscussionUserAccess"}
-
-func (ec *executionContext) _DiscussionUserAccess(ctx context.Context, sel ast.SelectionSet, obj *model.DiscussionUserAccess) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, discussionUserAccessImplementors)
-
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("DiscussionUserAccess")
-		case "discussion":
+		case "editHistory":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
 				defer func() {
@@ -10133,13 +17852,24 @@ func (ec *executionContext) _DiscussionUserAccess(ctx context.Context, sel ast.S
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._DiscussionUserAccess_discussion(ctx, field, obj)
+				res = ec._Post_editHistory(ctx, field, obj)
+				return res
+			})
+		case "reactions":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Post_reactions(ctx, field, obj)
 				if res == graphql.Null {
 					atomic.AddUint32(&invalids, 1)
 				}
 				return res
 			})
-		case "user":
+		case "isPinned":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
 				defer func() {
@@ -10147,28 +17877,13 @@ func (ec *executionContext) _DiscussionUserAccess(ctx context.Context, sel ast.S
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._DiscussionUserAccess_user(ctx, field, obj)
+				res = ec._Post_isPinned(ctx, field, obj)
 				if res == graphql.Null {
 					atomic.AddUint32(&invalids, 1)
 				}
 				return res
 			})
-		case "state":
-			out.Values[i] = ec._DiscussionUserAccess_state(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
-			}
-		case "createdAt":
-			out.Values[i] = ec._DiscussionUserAccess_createdAt(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
-			}
-		case "updatedAt":
-			out.Values[i] = ec._DiscussionUserAccess_updatedAt(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
-			}
-		case "isDeleted":
+		case "poll":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
 				defer func() {
@@ -10176,13 +17891,26 @@ func (ec *executionContext) _DiscussionUserAccess(ctx context.Context, sel ast.S
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._DiscussionUserAccess_isDeleted(ctx, field, obj)
+				res = ec._Post_poll(ctx, field, obj)
+				return res
+			})
+		case "linkPreviews":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Post_linkPreviews(ctx, field, obj)
 				if res == graphql.Null {
 					atomic.AddUint32(&invalids, 1)
 				}
 				return res
 			})
-		case "request":
+		case "parentPostID":
+			out.Values[i] = ec._Post_parentPostID(ctx, field, obj)
+		case "replyCount":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
 				defer func() {
@@ -10190,7 +17918,24 @@ func (ec *executionContext) _DiscussionUserAccess(ctx context.Context, sel ast.S
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._DiscussionUserAccess_request(ctx, field, obj)
+				res = ec._Post_replyCount(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "repliesConnection":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Post_repliesConnection(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
 				return res
 			})
 		default:
@@ -10204,106 +17949,24 @@ func (ec *executionContext) _DiscussionUserAccess(ctx context.Context, sel ast.S
 	return out
 }
 
-var historicalStringImplementors = []string{"HistoricalString"}
-
-func (ec *executionContext) _HistoricalString(ctx context.Context, sel ast.SelectionSet, obj *model.HistoricalString) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, historicalStringImplementors)
-
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("HistoricalString")
-		case "value":
-			out.Values[i] = ec._HistoricalString_value(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "createdAt":
-			out.Values[i] = ec._HistoricalString_createdAt(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
-		}
-	}
-	out.Dispatch()
-	if invalids > 0 {
-		return graphql.Null
-	}
-	return out
-}
-
-var mediaImplementors = []string{"Media"}
+var postBookmarkImplementors = []string{"PostBookmark"}
 
-func (ec *executionContext) _Media(ctx context.Context, sel ast.SelectionSet, obj *model.Media) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, mediaImplementors)
+func (ec *executionContext) _PostBookmark(ctx context.Context, sel ast.SelectionSet, obj *model.PostBookmark) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, postBookmarkImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("Media")
-		case "id":
-			out.Values[i] = ec._Media_id(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("PostBookmark")
+		case "post":
+			out.Values[i] = ec._PostBookmark_post(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
 		case "createdAt":
-			out.Values[i] = ec._Media_createdAt(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "isDeleted":
-			out.Values[i] = ec._Media_isDeleted(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "deletedReasonCode":
-			out.Values[i] = ec._Media_deletedReasonCode(ctx, field, obj)
-		case "mediaType":
-			out.Values[i] = ec._Media_mediaType(ctx, field, obj)
-		case "mediaSize":
-			out.Values[i] = ec._Media_mediaSize(ctx, field, obj)
-		case "assetLocation":
-			out.Values[i] = ec._Media_assetLocation(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
-		}
-	}
-	out.Dispatch()
-	if invalids > 0 {
-		return graphql.Null
-	}
-	return out
-}
-
-var mediaSizeImplementors = []string{"MediaSize"}
-
-func (ec *executionContext) _MediaSize(ctx context.Context, sel ast.SelectionSet, obj *model.MediaSize) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, mediaSizeImplementors)
-
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("MediaSize")
-		case "height":
-			out.Values[i] = ec._MediaSize_height(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "width":
-			out.Values[i] = ec._MediaSize_width(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "sizeKb":
-			out.Values[i] = ec._MediaSize_sizeKb(ctx, field, obj)
+			out.Values[i] = ec._PostBookmark_createdAt(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
@@ -10318,47 +17981,24 @@ func (ec *executionContext) _MediaSize(ctx context.Context, sel ast.SelectionSet
 	return out
 }
 
-var moderatorImplementors = []string{"Moderator"}
+var postBookmarksConnectionImplementors = []string{"PostBookmarksConnection"}
 
-func (ec *executionContext) _Moderator(ctx context.Context, sel ast.SelectionSet, obj *model.Moderator) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, moderatorImplementors)
+func (ec *executionContext) _PostBookmarksConnection(ctx context.Context, sel ast.SelectionSet, obj *model.PostBookmarksConnection) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, postBookmarksConnectionImplementors)
 
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("Moderator")
-		case "id":
-			out.Values[i] = ec._Moderator_id(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
-			}
-		case "discussion":
-			field := field
-			out.Concurrently(i, func() (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Moderator_discussion(ctx, field, obj)
-				return res
-			})
-		case "userProfile":
-			field := field
-			out.Concurrently(i, func() (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Moderator_userProfile(ctx, field, obj)
-				if res == graphql.Null {
-					atomic.AddUint32(&invalids, 1)
-				}
-				return res
-			})
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("PostBookmarksConnection")
+		case "edges":
+			out.Values[i] = ec._PostBookmarksConnection_edges(ctx, field, obj)
+		case "pageInfo":
+			out.Values[i] = ec._PostBookmarksConnection_pageInfo(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -10370,96 +18010,24 @@ func (ec *executionContext) _Moderator(ctx context.Context, sel ast.SelectionSet
 	return out
 }
 
-var mutationImplementors = []string{"Mutation"}
-
-func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, mutationImplementors)
+var postBookmarksEdgeImplementors = []string{"PostBookmarksEdge"}
 
-	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
-		Object: "Mutation",
-	})
+func (ec *executionContext) _PostBookmarksEdge(ctx context.Context, sel ast.SelectionSet, obj *model.PostBookmarksEdge) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, postBookmarksEdgeImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("Mutation")
-		case "addDiscussionParticipant":
-			out.Values[i] = ec._Mutation_addDiscussionParticipant(ctx, field)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "addPost":
-			out.Values[i] = ec._Mutation_addPost(ctx, field)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "createDiscussion":
-			out.Values[i] = ec._Mutation_createDiscussion(ctx, field)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "updateParticipant":
-			out.Values[i] = ec._Mutation_updateParticipant(ctx, field)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "upsertUserDevice":
-			out.Values[i] = ec._Mutation_upsertUserDevice(ctx, field)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "updateDiscussion":
-			out.Values[i] = ec._Mutation_updateDiscussion(ctx, field)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "updateDiscussionUserSettings":
-			out.Values[i] = ec._Mutation_updateDiscussionUserSettings(ctx, field)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "requestAccessToDiscussion":
-			out.Values[i] = ec._Mutation_requestAccessToDiscussion(ctx, field)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "respondToRequestAccess":
-			out.Values[i] = ec._Mutation_respondToRequestAccess(ctx, field)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "deletePost":
-			out.Values[i] = ec._Mutation_deletePost(ctx, field)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "banParticipant":
-			out.Values[i] = ec._Mutation_banParticipant(ctx, field)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "shuffleDiscussion":
-			out.Values[i] = ec._Mutation_shuffleDiscussion(ctx, field)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "setLastPostViewed":
-			out.Values[i] = ec._Mutation_setLastPostViewed(ctx, field)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "muteParticipants":
-			out.Values[i] = ec._Mutation_muteParticipants(ctx, field)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "unmuteParticipants":
-			out.Values[i] = ec._Mutation_unmuteParticipants(ctx, field)
+			out.Values[i] = graphql.MarshalString("PostBookmarksEdge")
+		case "cursor":
+			out.Values[i] = ec._PostBookmarksEdge_cursor(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
+		case "node":
+			out.Values[i] = ec._PostBookmarksEdge_node(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -10471,23 +18039,29 @@ func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet)
 	return out
 }
 
-var pageInfoImplementors = []string{"PageInfo"}
+var postReactionSummaryImplementors = []string{"PostReactionSummary"}
 
-func (ec *executionContext) _PageInfo(ctx context.Context, sel ast.SelectionSet, obj *model.PageInfo) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, pageInfoImplementors)
+func (ec *executionContext) _PostReactionSummary(ctx context.Context, sel ast.SelectionSet, obj *model.PostReactionSummary) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, postReactionSummaryImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("PageInfo")
-		case "startCursor":
-			out.Values[i] = ec._PageInfo_startCursor(ctx, field, obj)
-		case "endCursor":
-			out.Values[i] = ec._PageInfo_endCursor(ctx, field, obj)
-		case "hasNextPage":
-			out.Values[i] = ec._PageInfo_hasNextPage(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("PostReactionSummary")
+		case "reaction":
+			out.Values[i] = ec._PostReactionSummary_reaction(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "count":
+			out.Values[i] = ec._PostReactionSummary_count(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "meReacted":
+			out.Values[i] = ec._PostReactionSummary_meReacted(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
@@ -10502,50 +18076,23 @@ func (ec *executionContext) _PageInfo(ctx context.Context, sel ast.SelectionSet,
 	return out
 }
 
-var participantImplementors = []string{"Participant", "Entity", "DiscussionSubscriptionEntity"}
+var postReportImplementors = []string{"PostReport"}
 
-func (ec *executionContext) _Participant(ctx context.Context, sel ast.SelectionSet, obj *model.Participant) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, participantImplementors)
+func (ec *executionContext) _PostReport(ctx context.Context, sel ast.SelectionSet, obj *model.PostReport) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, postReportImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("Participant")
+			out.Values[i] = graphql.MarshalString("PostReport")
 		case "id":
-			out.Values[i] = ec._Participant_id(ctx, field, obj)
+			out.Values[i] = ec._PostReport_id(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				atomic.AddUint32(&invalids, 1)
 			}
-		case "participantID":
-			out.Values[i] = ec._Participant_participantID(ctx, field, obj)
-		case "discussion":
-			field := field
-			out.Concurrently(i, func() (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Participant_discussion(ctx, field, obj)
-				return res
-			})
-		case "viewer":
-			field := field
-			out.Concurrently(i, func() (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Participant_viewer(ctx, field, obj)
-				if res == graphql.Null {
-					atomic.AddUint32(&invalids, 1)
-				}
-				return res
-			})
-		case "posts":
+		case "post":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
 				defer func() {
@@ -10553,45 +18100,24 @@ func (ec *executionContext) _Participant(ctx context.Context, sel ast.SelectionS
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Participant_posts(ctx, field, obj)
+				res = ec._PostReport_post(ctx, field, obj)
 				return res
 			})
-		case "isAnonymous":
-			out.Values[i] = ec._Participant_isAnonymous(ctx, field, obj)
+		case "reason":
+			out.Values[i] = ec._PostReport_reason(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				atomic.AddUint32(&invalids, 1)
 			}
-		case "gradientColor":
-			field := field
-			out.Concurrently(i, func() (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Participant_gradientColor(ctx, field, obj)
-				return res
-			})
-		case "inviter":
-			field := field
-			out.Concurrently(i, func() (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Participant_inviter(ctx, field, obj)
-				if res == graphql.Null {
-					atomic.AddUint32(&invalids, 1)
-				}
-				return res
-			})
-		case "hasJoined":
-			out.Values[i] = ec._Participant_hasJoined(ctx, field, obj)
+		case "note":
+			out.Values[i] = ec._PostReport_note(ctx, field, obj)
+		case "status":
+			out.Values[i] = ec._PostReport_status(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				atomic.AddUint32(&invalids, 1)
 			}
-		case "userProfile":
+		case "action":
+			out.Values[i] = ec._PostReport_action(ctx, field, obj)
+		case "resolvedBy":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
 				defer func() {
@@ -10599,36 +18125,16 @@ func (ec *executionContext) _Participant(ctx context.Context, sel ast.SelectionS
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Participant_userProfile(ctx, field, obj)
+				res = ec._PostReport_resolvedBy(ctx, field, obj)
 				return res
 			})
-		case "isBanned":
-			out.Values[i] = ec._Participant_isBanned(ctx, field, obj)
+		case "resolvedAt":
+			out.Values[i] = ec._PostReport_resolvedAt(ctx, field, obj)
+		case "createdAt":
+			out.Values[i] = ec._PostReport_createdAt(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				atomic.AddUint32(&invalids, 1)
 			}
-		case "anonDisplayName":
-			field := field
-			out.Concurrently(i, func() (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Participant_anonDisplayName(ctx, field, obj)
-				return res
-			})
-		case "mutedForSeconds":
-			field := field
-			out.Concurrently(i, func() (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Participant_mutedForSeconds(ctx, field, obj)
-				return res
-			})
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -10640,21 +18146,24 @@ func (ec *executionContext) _Participant(ctx context.Context, sel ast.SelectionS
 	return out
 }
 
-var participantProfileImplementors = []string{"ParticipantProfile"}
+var postReportConnectionImplementors = []string{"PostReportConnection"}
 
-func (ec *executionContext) _ParticipantProfile(ctx context.Context, sel ast.SelectionSet, obj *model.ParticipantProfile) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, participantProfileImplementors)
+func (ec *executionContext) _PostReportConnection(ctx context.Context, sel ast.SelectionSet, obj *model.PostReportConnection) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, postReportConnectionImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("ParticipantProfile")
-		case "isAnonymous":
-			out.Values[i] = ec._ParticipantProfile_isAnonymous(ctx, field, obj)
-		case "gradientColor":
-			out.Values[i] = ec._ParticipantProfile_gradientColor(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("PostReportConnection")
+		case "edges":
+			out.Values[i] = ec._PostReportConnection_edges(ctx, field, obj)
+		case "pageInfo":
+			out.Values[i] = ec._PostReportConnection_pageInfo(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -10666,37 +18175,52 @@ func (ec *executionContext) _ParticipantProfile(ctx context.Context, sel ast.Sel
 	return out
 }
 
-var participantsConnectionImplementors = []string{"ParticipantsConnection"}
+var postReportEdgeImplementors = []string{"PostReportEdge"}
 
-func (ec *executionContext) _ParticipantsConnection(ctx context.Context, sel ast.SelectionSet, obj *model.ParticipantsConnection) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, participantsConnectionImplementors)
+func (ec *executionContext) _PostReportEdge(ctx context.Context, sel ast.SelectionSet, obj *model.PostReportEdge) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, postReportEdgeImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("ParticipantsConnection")
-		case "totalCount":
-			out.Values[i] = ec._ParticipantsConnection_totalCount(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("PostReportEdge")
+		case "cursor":
+			out.Values[i] = ec._PostReportEdge_cursor(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
+				invalids++
 			}
+		case "node":
+			out.Values[i] = ec._PostReportEdge_node(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var postsConnectionImplementors = []string{"PostsConnection"}
+
+func (ec *executionContext) _PostsConnection(ctx context.Context, sel ast.SelectionSet, obj *model.PostsConnection) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, postsConnectionImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("PostsConnection")
 		case "edges":
-			field := field
-			out.Concurrently(i, func() (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._ParticipantsConnection_edges(ctx, field, obj)
-				return res
-			})
+			out.Values[i] = ec._PostsConnection_edges(ctx, field, obj)
 		case "pageInfo":
-			out.Values[i] = ec._ParticipantsConnection_pageInfo(ctx, field, obj)
+			out.Values[i] = ec._PostsConnection_pageInfo(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
+				invalids++
 			}
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
@@ -10709,24 +18233,26 @@ func (ec *executionContext) _ParticipantsConnection(ctx context.Context, sel ast
 	return out
 }
 
-var participantsEdgeImplementors = []string{"ParticipantsEdge"}
+var postsEdgeImplementors = []string{"PostsEdge"}
 
-func (ec *executionContext) _ParticipantsEdge(ctx context.Context, sel ast.SelectionSet, obj *model.ParticipantsEdge) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, participantsEdgeImplementors)
+func (ec *executionContext) _PostsEdge(ctx context.Context, sel ast.SelectionSet, obj *model.PostsEdge) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, postsEdgeImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("ParticipantsEdge")
+			out.Values[i] = graphql.MarshalString("PostsEdge")
 		case "cursor":
-			out.Values[i] = ec._ParticipantsEdge_cursor(ctx, field, obj)
+			out.Values[i] = ec._PostsEdge_cursor(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
 		case "node":
-			out.Values[i] = ec._ParticipantsEdge_node(ctx, field, obj)
+			out.Values[i] = ec._PostsEdge_node(ctx, field, obj)
+		case "highlight":
+			out.Values[i] = ec._PostsEdge_highlight(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -10738,39 +18264,22 @@ func (ec *executionContext) _ParticipantsEdge(ctx context.Context, sel ast.Selec
 	return out
 }
 
-var postImplementors = []string{"Post", "DiscussionSubscriptionEntity"}
+var queryImplementors = []string{"Query"}
 
-func (ec *executionContext) _Post(ctx context.Context, sel ast.SelectionSet, obj *model.Post) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, postImplementors)
+func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, queryImplementors)
+
+	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
+		Object: "Query",
+	})
 
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("Post")
-		case "id":
-			out.Values[i] = ec._Post_id(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
-			}
-		case "isDeleted":
-			field := field
-			out.Concurrently(i, func() (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Post_isDeleted(ctx, field, obj)
-				if res == graphql.Null {
-					atomic.AddUint32(&invalids, 1)
-				}
-				return res
-			})
-		case "deletedReasonCode":
-			out.Values[i] = ec._Post_deletedReasonCode(ctx, field, obj)
-		case "content":
+			out.Values[i] = graphql.MarshalString("Query")
+		case "discussion":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
 				defer func() {
@@ -10778,10 +18287,10 @@ func (ec *executionContext) _Post(ctx context.Context, sel ast.SelectionSet, obj
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Post_content(ctx, field, obj)
+				res = ec._Query_discussion(ctx, field)
 				return res
 			})
-		case "discussion":
+		case "discussionByLinkSlug":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
 				defer func() {
@@ -10789,13 +18298,10 @@ func (ec *executionContext) _Post(ctx context.Context, sel ast.SelectionSet, obj
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Post_discussion(ctx, field, obj)
-				if res == graphql.Null {
-					atomic.AddUint32(&invalids, 1)
-				}
+				res = ec._Query_discussionByLinkSlug(ctx, field)
 				return res
 			})
-		case "participant":
+		case "listDiscussions":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
 				defer func() {
@@ -10803,10 +18309,10 @@ func (ec *executionContext) _Post(ctx context.Context, sel ast.SelectionSet, obj
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Post_participant(ctx, field, obj)
+				res = ec._Query_listDiscussions(ctx, field)
 				return res
 			})
-		case "createdAt":
+		case "user":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
 				defer func() {
@@ -10814,13 +18320,13 @@ func (ec *executionContext) _Post(ctx context.Context, sel ast.SelectionSet, obj
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Post_createdAt(ctx, field, obj)
+				res = ec._Query_user(ctx, field)
 				if res == graphql.Null {
 					atomic.AddUint32(&invalids, 1)
 				}
 				return res
 			})
-		case "updatedAt":
+		case "me":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
 				defer func() {
@@ -10828,26 +18334,13 @@ func (ec *executionContext) _Post(ctx context.Context, sel ast.SelectionSet, obj
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Post_updatedAt(ctx, field, obj)
+				res = ec._Query_me(ctx, field)
 				if res == graphql.Null {
 					atomic.AddUint32(&invalids, 1)
 				}
 				return res
 			})
-		case "quotedPost":
-			out.Values[i] = ec._Post_quotedPost(ctx, field, obj)
-		case "mentionedEntities":
-			field := field
-			out.Concurrently(i, func() (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Post_mentionedEntities(ctx, field, obj)
-				return res
-			})
-		case "media":
+		case "searchMyDiscussions":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
 				defer func() {
@@ -10855,43 +18348,16 @@ func (ec *executionContext) _Post(ctx context.Context, sel ast.SelectionSet, obj
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Post_media(ctx, field, obj)
+				res = ec._Query_searchMyDiscussions(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
 				return res
 			})
-		case "postType":
-			out.Values[i] = ec._Post_postType(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
-		}
-	}
-	out.Dispatch()
-	if invalids > 0 {
-		return graphql.Null
-	}
-	return out
-}
-
-var postsConnectionImplementors = []string{"PostsConnection"}
-
-func (ec *executionContext) _PostsConnection(ctx context.Context, sel ast.SelectionSet, obj *model.PostsConnection) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, postsConnectionImplementors)
-
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("PostsConnection")
-		case "edges":
-			out.Values[i] = ec._PostsConnection_edges(ctx, field, obj)
-		case "pageInfo":
-			out.Values[i] = ec._PostsConnection_pageInfo(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
+		case "__type":
+			out.Values[i] = ec._Query___type(ctx, field)
+		case "__schema":
+			out.Values[i] = ec._Query___schema(ctx, field)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -10903,50 +18369,22 @@ func (ec *executionContext) _PostsConnection(ctx context.Context, sel ast.Select
 	return out
 }
 
-var postsEdgeImplementors = []string{"PostsEdge"}
+var scheduledPostImplementors = []string{"ScheduledPost"}
 
-func (ec *executionContext) _PostsEdge(ctx context.Context, sel ast.SelectionSet, obj *model.PostsEdge) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, postsEdgeImplementors)
+func (ec *executionContext) _ScheduledPost(ctx context.Context, sel ast.SelectionSet, obj *model.ScheduledPost) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, scheduledPostImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("PostsEdge")
-		case "cursor":
-			out.Values[i] = ec._PostsEdge_cursor(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("ScheduledPost")
+		case "id":
+			out.Values[i] = ec._ScheduledPost_id(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				invalids++
+				atomic.AddUint32(&invalids, 1)
 			}
-		case "node":
-			out.Values[i] = ec._PostsEdge_node(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
-		}
-	}
-	out.Dispatch()
-	if invalids > 0 {
-		return graphql.Null
-	}
-	return out
-}
-
-var queryImplementors = []string{"Query"}
-
-func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, queryImplementors)
-
-	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
-		Object: "Query",
-	})
-
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("Query")
 		case "discussion":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
@@ -10955,10 +18393,13 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_discussion(ctx, field)
+				res = ec._ScheduledPost_discussion(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
 				return res
 			})
-		case "discussionByLinkSlug":
+		case "participant":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
 				defer func() {
@@ -10966,10 +18407,10 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_discussionByLinkSlug(ctx, field)
+				res = ec._ScheduledPost_participant(ctx, field, obj)
 				return res
 			})
-		case "listDiscussions":
+		case "content":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
 				defer func() {
@@ -10977,10 +18418,13 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_listDiscussions(ctx, field)
+				res = ec._ScheduledPost_content(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
 				return res
 			})
-		case "user":
+		case "postType":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
 				defer func() {
@@ -10988,13 +18432,23 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_user(ctx, field)
+				res = ec._ScheduledPost_postType(ctx, field, obj)
 				if res == graphql.Null {
 					atomic.AddUint32(&invalids, 1)
 				}
 				return res
 			})
-		case "me":
+		case "publishAt":
+			out.Values[i] = ec._ScheduledPost_publishAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "status":
+			out.Values[i] = ec._ScheduledPost_status(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "post":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
 				defer func() {
@@ -11002,16 +18456,21 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_me(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&invalids, 1)
-				}
+				res = ec._ScheduledPost_post(ctx, field, obj)
 				return res
 			})
-		case "__type":
-			out.Values[i] = ec._Query___type(ctx, field)
-		case "__schema":
-			out.Values[i] = ec._Query___schema(ctx, field)
+		case "failureReason":
+			out.Values[i] = ec._ScheduledPost_failureReason(ctx, field, obj)
+		case "createdAt":
+			out.Values[i] = ec._ScheduledPost_createdAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "updatedAt":
+			out.Values[i] = ec._ScheduledPost_updatedAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -11034,15 +18493,79 @@ func (ec *executionContext) _Subscription(ctx context.Context, sel ast.Selection
 		ec.Errorf(ctx, "must subscribe to exactly one stream")
 		return nil
 	}
-
-	switch fields[0].Name {
-	case "postAdded":
-		return ec._Subscription_postAdded(ctx, fields[0])
-	case "onDiscussionEvent":
-		return ec._Subscription_onDiscussionEvent(ctx, fields[0])
-	default:
-		panic("unknown field " + strconv.Quote(fields[0].Name))
+
+	switch fields[0].Name {
+	case "postAdded":
+		return ec._Subscription_postAdded(ctx, fields[0])
+	case "onDiscussionEvent":
+		return ec._Subscription_onDiscussionEvent(ctx, fields[0])
+	default:
+		panic("unknown field " + strconv.Quote(fields[0].Name))
+	}
+}
+
+var tagImplementors = []string{"Tag", "Entity"}
+
+func (ec *executionContext) _Tag(ctx context.Context, sel ast.SelectionSet, obj *model.Tag) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, tagImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Tag")
+		case "id":
+			out.Values[i] = ec._Tag_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "name":
+			out.Values[i] = ec._Tag_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var tagSummaryImplementors = []string{"TagSummary"}
+
+func (ec *executionContext) _TagSummary(ctx context.Context, sel ast.SelectionSet, obj *model.TagSummary) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, tagSummaryImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("TagSummary")
+		case "name":
+			out.Values[i] = ec._TagSummary_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "postCount":
+			out.Values[i] = ec._TagSummary_postCount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
 	}
+	return out
 }
 
 var uRLImplementors = []string{"URL"}
@@ -11189,6 +18712,34 @@ func (ec *executionContext) _User(ctx context.Context, sel ast.SelectionSet, obj
 				res = ec._User_discussions(ctx, field, obj)
 				return res
 			})
+		case "totalUnreadCount":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._User_totalUnreadCount(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "bookmarks":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._User_bookmarks(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
 		case "sentDiscussionAccessRequests":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
@@ -11684,36 +19235,254 @@ func (ec *executionContext) ___Type(ctx context.Context, sel ast.SelectionSet, o
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
 	}
-	out.Dispatch()
-	if invalids > 0 {
-		return graphql.Null
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+// endregion **************************** object.gotpl ****************************
+
+// region    ***************************** type.gotpl *****************************
+
+func (ec *executionContext) unmarshalNAddDiscussionParticipantInput2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐAddDiscussionParticipantInput(ctx context.Context, v interface{}) (model.AddDiscussionParticipantInput, error) {
+	return ec.unmarshalInputAddDiscussionParticipantInput(ctx, v)
+}
+
+func (ec *executionContext) unmarshalNAnonymityType2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐAnonymityType(ctx context.Context, v interface{}) (model.AnonymityType, error) {
+	var res model.AnonymityType
+	return res, res.UnmarshalGQL(v)
+}
+
+func (ec *executionContext) marshalNAnonymityType2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐAnonymityType(ctx context.Context, sel ast.SelectionSet, v model.AnonymityType) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) unmarshalNBoolean2bool(ctx context.Context, v interface{}) (bool, error) {
+	return graphql.UnmarshalBoolean(v)
+}
+
+func (ec *executionContext) marshalNBoolean2bool(ctx context.Context, sel ast.SelectionSet, v bool) graphql.Marshaler {
+	res := graphql.MarshalBoolean(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+	}
+	return res
+}
+
+func (ec *executionContext) marshalNCanJoinDiscussionResponse2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐCanJoinDiscussionResponse(ctx context.Context, sel ast.SelectionSet, v model.CanJoinDiscussionResponse) graphql.Marshaler {
+	return ec._CanJoinDiscussionResponse(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNCanJoinDiscussionResponse2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐCanJoinDiscussionResponse(ctx context.Context, sel ast.SelectionSet, v *model.CanJoinDiscussionResponse) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._CanJoinDiscussionResponse(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNDiscussion2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussion(ctx context.Context, sel ast.SelectionSet, v model.Discussion) graphql.Marshaler {
+	return ec._Discussion(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNDiscussion2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussion(ctx context.Context, sel ast.SelectionSet, v *model.Discussion) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._Discussion(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNDiscussionAccessRequest2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussionAccessRequest(ctx context.Context, sel ast.SelectionSet, v model.DiscussionAccessRequest) graphql.Marshaler {
+	return ec._DiscussionAccessRequest(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNDiscussionAccessRequest2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussionAccessRequest(ctx context.Context, sel ast.SelectionSet, v *model.DiscussionAccessRequest) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._DiscussionAccessRequest(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNDiscussionCreationSettings2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussionCreationSettings(ctx context.Context, v interface{}) (model.DiscussionCreationSettings, error) {
+	return ec.unmarshalInputDiscussionCreationSettings(ctx, v)
+}
+
+func (ec *executionContext) unmarshalNDiscussionInput2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussionInput(ctx context.Context, v interface{}) (model.DiscussionInput, error) {
+	return ec.unmarshalInputDiscussionInput(ctx, v)
+}
+
+func (ec *executionContext) unmarshalNDiscussionJoinabilityResponse2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussionJoinabilityResponse(ctx context.Context, v interface{}) (model.DiscussionJoinabilityResponse, error) {
+	var res model.DiscussionJoinabilityResponse
+	return res, res.UnmarshalGQL(v)
+}
+
+func (ec *executionContext) marshalNDiscussionJoinabilityResponse2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussionJoinabilityResponse(ctx context.Context, sel ast.SelectionSet, v model.DiscussionJoinabilityResponse) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) unmarshalNDiscussionJoinabilitySetting2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussionJoinabilitySetting(ctx context.Context, v interface{}) (model.DiscussionJoinabilitySetting, error) {
+	var res model.DiscussionJoinabilitySetting
+	return res, res.UnmarshalGQL(v)
+}
+
+func (ec *executionContext) marshalNDiscussionJoinabilitySetting2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussionJoinabilitySetting(ctx context.Context, sel ast.SelectionSet, v model.DiscussionJoinabilitySetting) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) marshalNDiscussionSubscriptionEntity2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussionSubscriptionEntity(ctx context.Context, sel ast.SelectionSet, v model.DiscussionSubscriptionEntity) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._DiscussionSubscriptionEntity(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNDiscussionSubscriptionEventType2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussionSubscriptionEventType(ctx context.Context, v interface{}) (model.DiscussionSubscriptionEventType, error) {
+	var res model.DiscussionSubscriptionEventType
+	return res, res.UnmarshalGQL(v)
+}
+
+func (ec *executionContext) marshalNDiscussionSubscriptionEventType2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussionSubscriptionEventType(ctx context.Context, sel ast.SelectionSet, v model.DiscussionSubscriptionEventType) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) marshalNDiscussionUserAccess2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussionUserAccess(ctx context.Context, sel ast.SelectionSet, v model.DiscussionUserAccess) graphql.Marshaler {
+	return ec._DiscussionUserAccess(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNDiscussionUserAccess2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussionUserAccess(ctx context.Context, sel ast.SelectionSet, v *model.DiscussionUserAccess) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._DiscussionUserAccess(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNDiscussionUserAccessState2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussionUserAccessState(ctx context.Context, v interface{}) (model.DiscussionUserAccessState, error) {
+	var res model.DiscussionUserAccessState
+	return res, res.UnmarshalGQL(v)
+}
+
+func (ec *executionContext) marshalNDiscussionUserAccessState2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussionUserAccessState(ctx context.Context, sel ast.SelectionSet, v model.DiscussionUserAccessState) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) unmarshalNDiscussionUserSettings2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussionUserSettings(ctx context.Context, v interface{}) (model.DiscussionUserSettings, error) {
+	return ec.unmarshalInputDiscussionUserSettings(ctx, v)
+}
+
+func (ec *executionContext) marshalNEntity2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐEntity(ctx context.Context, sel ast.SelectionSet, v model.Entity) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._Entity(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNEveryoneMentionPolicy2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐEveryoneMentionPolicy(ctx context.Context, v interface{}) (model.EveryoneMentionPolicy, error) {
+	var res model.EveryoneMentionPolicy
+	return res, res.UnmarshalGQL(v)
+}
+
+func (ec *executionContext) marshalNEveryoneMentionPolicy2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐEveryoneMentionPolicy(ctx context.Context, sel ast.SelectionSet, v model.EveryoneMentionPolicy) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) unmarshalNFloat2float64(ctx context.Context, v interface{}) (float64, error) {
+	return graphql.UnmarshalFloat(v)
+}
+
+func (ec *executionContext) marshalNFloat2float64(ctx context.Context, sel ast.SelectionSet, v float64) graphql.Marshaler {
+	res := graphql.MarshalFloat(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+	}
+	return res
+}
+
+func (ec *executionContext) marshalNHistoricalString2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐHistoricalString(ctx context.Context, sel ast.SelectionSet, v model.HistoricalString) graphql.Marshaler {
+	return ec._HistoricalString(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNHistoricalString2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐHistoricalString(ctx context.Context, sel ast.SelectionSet, v *model.HistoricalString) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._HistoricalString(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNID2string(ctx context.Context, v interface{}) (string, error) {
+	return graphql.UnmarshalID(v)
+}
+
+func (ec *executionContext) marshalNID2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
+	res := graphql.MarshalID(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+	}
+	return res
+}
+
+func (ec *executionContext) unmarshalNID2ᚕstringᚄ(ctx context.Context, v interface{}) ([]string, error) {
+	var vSlice []interface{}
+	if v != nil {
+		if tmp1, ok := v.([]interface{}); ok {
+			vSlice = tmp1
+		} else {
+			vSlice = []interface{}{v}
+		}
+	}
+	var err error
+	res := make([]string, len(vSlice))
+	for i := range vSlice {
+		res[i], err = ec.unmarshalNID2string(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
 	}
-	return out
-}
-
-// endregion **************************** object.gotpl ****************************
-
-// region    ***************************** type.gotpl *****************************
-
-func (ec *executionContext) unmarshalNAddDiscussionParticipantInput2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐAddDiscussionParticipantInput(ctx context.Context, v interface{}) (model.AddDiscussionParticipantInput, error) {
-	return ec.unmarshalInputAddDiscussionParticipantInput(ctx, v)
+	return res, nil
 }
 
-func (ec *executionContext) unmarshalNAnonymityType2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐAnonymityType(ctx context.Context, v interface{}) (model.AnonymityType, error) {
-	var res model.AnonymityType
-	return res, res.UnmarshalGQL(v)
-}
+func (ec *executionContext) marshalNID2ᚕstringᚄ(ctx context.Context, sel ast.SelectionSet, v []string) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	for i := range v {
+		ret[i] = ec.marshalNID2string(ctx, sel, v[i])
+	}
 
-func (ec *executionContext) marshalNAnonymityType2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐAnonymityType(ctx context.Context, sel ast.SelectionSet, v model.AnonymityType) graphql.Marshaler {
-	return v
+	return ret
 }
 
-func (ec *executionContext) unmarshalNBoolean2bool(ctx context.Context, v interface{}) (bool, error) {
-	return graphql.UnmarshalBoolean(v)
+func (ec *executionContext) unmarshalNInt2int(ctx context.Context, v interface{}) (int, error) {
+	return graphql.UnmarshalInt(v)
 }
 
-func (ec *executionContext) marshalNBoolean2bool(ctx context.Context, sel ast.SelectionSet, v bool) graphql.Marshaler {
-	res := graphql.MarshalBoolean(v)
+func (ec *executionContext) marshalNInt2int(ctx context.Context, sel ast.SelectionSet, v int) graphql.Marshaler {
+	res := graphql.MarshalInt(v)
 	if res == graphql.Null {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "must not be null")
@@ -11722,247 +19491,361 @@ func (ec *executionContext) marshalNBoolean2bool(ctx context.Context, sel ast.Se
 	return res
 }
 
-func (ec *executionContext) marshalNCanJoinDiscussionResponse2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐCanJoinDiscussionResponse(ctx context.Context, sel ast.SelectionSet, v model.CanJoinDiscussionResponse) graphql.Marshaler {
-	return ec._CanJoinDiscussionResponse(ctx, sel, &v)
+func (ec *executionContext) unmarshalNInviteRequestStatus2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐInviteRequestStatus(ctx context.Context, v interface{}) (model.InviteRequestStatus, error) {
+	var res model.InviteRequestStatus
+	return res, res.UnmarshalGQL(v)
 }
 
-func (ec *executionContext) marshalNCanJoinDiscussionResponse2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐCanJoinDiscussionResponse(ctx context.Context, sel ast.SelectionSet, v *model.CanJoinDiscussionResponse) graphql.Marshaler {
-	if v == nil {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
-	}
-	return ec._CanJoinDiscussionResponse(ctx, sel, v)
+func (ec *executionContext) marshalNInviteRequestStatus2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐInviteRequestStatus(ctx context.Context, sel ast.SelectionSet, v model.InviteRequestStatus) graphql.Marshaler {
+	return v
 }
 
-func (ec *executionContext) marshalNDiscussion2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussion(ctx context.Context, sel ast.SelectionSet, v model.Discussion) graphql.Marshaler {
-	return ec._Discussion(ctx, sel, &v)
+func (ec *executionContext) marshalNLinkPreview2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐLinkPreview(ctx context.Context, sel ast.SelectionSet, v model.LinkPreview) graphql.Marshaler {
+	return ec._LinkPreview(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNDiscussion2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussion(ctx context.Context, sel ast.SelectionSet, v *model.Discussion) graphql.Marshaler {
-	if v == nil {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
+func (ec *executionContext) marshalNLinkPreview2ᚕᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐLinkPreviewᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.LinkPreview) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
 	}
-	return ec._Discussion(ctx, sel, v)
-}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNLinkPreview2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐLinkPreview(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-func (ec *executionContext) marshalNDiscussionAccessRequest2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussionAccessRequest(ctx context.Context, sel ast.SelectionSet, v model.DiscussionAccessRequest) graphql.Marshaler {
-	return ec._DiscussionAccessRequest(ctx, sel, &v)
+	}
+	wg.Wait()
+	return ret
 }
 
-func (ec *executionContext) marshalNDiscussionAccessRequest2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussionAccessRequest(ctx context.Context, sel ast.SelectionSet, v *model.DiscussionAccessRequest) graphql.Marshaler {
+func (ec *executionContext) marshalNLinkPreview2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐLinkPreview(ctx context.Context, sel ast.SelectionSet, v *model.LinkPreview) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "must not be null")
 		}
 		return graphql.Null
 	}
-	return ec._DiscussionAccessRequest(ctx, sel, v)
+	return ec._LinkPreview(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalNDiscussionCreationSettings2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussionCreationSettings(ctx context.Context, v interface{}) (model.DiscussionCreationSettings, error) {
-	return ec.unmarshalInputDiscussionCreationSettings(ctx, v)
+func (ec *executionContext) unmarshalNMentionGroup2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐMentionGroup(ctx context.Context, v interface{}) (model.MentionGroup, error) {
+	var res model.MentionGroup
+	return res, res.UnmarshalGQL(v)
 }
 
-func (ec *executionContext) unmarshalNDiscussionInput2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussionInput(ctx context.Context, v interface{}) (model.DiscussionInput, error) {
-	return ec.unmarshalInputDiscussionInput(ctx, v)
+func (ec *executionContext) marshalNMentionGroup2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐMentionGroup(ctx context.Context, sel ast.SelectionSet, v model.MentionGroup) graphql.Marshaler {
+	return v
 }
 
-func (ec *executionContext) unmarshalNDiscussionJoinabilityResponse2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussionJoinabilityResponse(ctx context.Context, v interface{}) (model.DiscussionJoinabilityResponse, error) {
-	var res model.DiscussionJoinabilityResponse
+func (ec *executionContext) unmarshalNModerationAction2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐModerationAction(ctx context.Context, v interface{}) (model.ModerationAction, error) {
+	var res model.ModerationAction
 	return res, res.UnmarshalGQL(v)
 }
 
-func (ec *executionContext) marshalNDiscussionJoinabilityResponse2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussionJoinabilityResponse(ctx context.Context, sel ast.SelectionSet, v model.DiscussionJoinabilityResponse) graphql.Marshaler {
+func (ec *executionContext) marshalNModerationAction2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐModerationAction(ctx context.Context, sel ast.SelectionSet, v model.ModerationAction) graphql.Marshaler {
 	return v
 }
 
-func (ec *executionContext) unmarshalNDiscussionJoinabilitySetting2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussionJoinabilitySetting(ctx context.Context, v interface{}) (model.DiscussionJoinabilitySetting, error) {
-	var res model.DiscussionJoinabilitySetting
-	return res, res.UnmarshalGQL(v)
+func (ec *executionContext) marshalNModerationLogConnection2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐModerationLogConnection(ctx context.Context, sel ast.SelectionSet, v model.ModerationLogConnection) graphql.Marshaler {
+	return ec._ModerationLogConnection(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNDiscussionJoinabilitySetting2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussionJoinabilitySetting(ctx context.Context, sel ast.SelectionSet, v model.DiscussionJoinabilitySetting) graphql.Marshaler {
-	return v
+func (ec *executionContext) marshalNModerationLogConnection2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐModerationLogConnection(ctx context.Context, sel ast.SelectionSet, v *model.ModerationLogConnection) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._ModerationLogConnection(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNDiscussionSubscriptionEntity2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussionSubscriptionEntity(ctx context.Context, sel ast.SelectionSet, v model.DiscussionSubscriptionEntity) graphql.Marshaler {
+func (ec *executionContext) marshalNModerationLogEdge2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐModerationLogEdge(ctx context.Context, sel ast.SelectionSet, v model.ModerationLogEdge) graphql.Marshaler {
+	return ec._ModerationLogEdge(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNModerationLogEdge2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐModerationLogEdge(ctx context.Context, sel ast.SelectionSet, v *model.ModerationLogEdge) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "must not be null")
 		}
 		return graphql.Null
 	}
-	return ec._DiscussionSubscriptionEntity(ctx, sel, v)
+	return ec._ModerationLogEdge(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalNDiscussionSubscriptionEventType2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussionSubscriptionEventType(ctx context.Context, v interface{}) (model.DiscussionSubscriptionEventType, error) {
-	var res model.DiscussionSubscriptionEventType
+func (ec *executionContext) unmarshalNModerationTargetType2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐModerationTargetType(ctx context.Context, v interface{}) (model.ModerationTargetType, error) {
+	var res model.ModerationTargetType
 	return res, res.UnmarshalGQL(v)
 }
 
-func (ec *executionContext) marshalNDiscussionSubscriptionEventType2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussionSubscriptionEventType(ctx context.Context, sel ast.SelectionSet, v model.DiscussionSubscriptionEventType) graphql.Marshaler {
+func (ec *executionContext) marshalNModerationTargetType2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐModerationTargetType(ctx context.Context, sel ast.SelectionSet, v model.ModerationTargetType) graphql.Marshaler {
 	return v
 }
 
-func (ec *executionContext) marshalNDiscussionUserAccess2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussionUserAccess(ctx context.Context, sel ast.SelectionSet, v model.DiscussionUserAccess) graphql.Marshaler {
-	return ec._DiscussionUserAccess(ctx, sel, &v)
+func (ec *executionContext) marshalNModerator2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐModerator(ctx context.Context, sel ast.SelectionSet, v model.Moderator) graphql.Marshaler {
+	return ec._Moderator(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNDiscussionUserAccess2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussionUserAccess(ctx context.Context, sel ast.SelectionSet, v *model.DiscussionUserAccess) graphql.Marshaler {
+func (ec *executionContext) marshalNModerator2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐModerator(ctx context.Context, sel ast.SelectionSet, v *model.Moderator) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "must not be null")
 		}
 		return graphql.Null
 	}
-	return ec._DiscussionUserAccess(ctx, sel, v)
+	return ec._Moderator(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalNDiscussionUserAccessState2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussionUserAccessState(ctx context.Context, v interface{}) (model.DiscussionUserAccessState, error) {
-	var res model.DiscussionUserAccessState
+func (ec *executionContext) unmarshalNModeratorRole2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐModeratorRole(ctx context.Context, v interface{}) (model.ModeratorRole, error) {
+	var res model.ModeratorRole
 	return res, res.UnmarshalGQL(v)
 }
 
-func (ec *executionContext) marshalNDiscussionUserAccessState2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussionUserAccessState(ctx context.Context, sel ast.SelectionSet, v model.DiscussionUserAccessState) graphql.Marshaler {
+func (ec *executionContext) marshalNModeratorRole2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐModeratorRole(ctx context.Context, sel ast.SelectionSet, v model.ModeratorRole) graphql.Marshaler {
 	return v
 }
 
-func (ec *executionContext) unmarshalNDiscussionUserSettings2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐDiscussionUserSettings(ctx context.Context, v interface{}) (model.DiscussionUserSettings, error) {
-	return ec.unmarshalInputDiscussionUserSettings(ctx, v)
+func (ec *executionContext) marshalNPageInfo2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPageInfo(ctx context.Context, sel ast.SelectionSet, v model.PageInfo) graphql.Marshaler {
+	return ec._PageInfo(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNEntity2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐEntity(ctx context.Context, sel ast.SelectionSet, v model.Entity) graphql.Marshaler {
-	if v == nil {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
-	}
-	return ec._Entity(ctx, sel, v)
+func (ec *executionContext) marshalNParticipant2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐParticipant(ctx context.Context, sel ast.SelectionSet, v model.Participant) graphql.Marshaler {
+	return ec._Participant(ctx, sel, &v)
 }
 
-func (ec *executionContext) unmarshalNFloat2float64(ctx context.Context, v interface{}) (float64, error) {
-	return graphql.UnmarshalFloat(v)
+func (ec *executionContext) marshalNParticipant2ᚕᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐParticipantᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.Participant) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNParticipant2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐParticipant(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+	return ret
 }
 
-func (ec *executionContext) marshalNFloat2float64(ctx context.Context, sel ast.SelectionSet, v float64) graphql.Marshaler {
-	res := graphql.MarshalFloat(v)
-	if res == graphql.Null {
+func (ec *executionContext) marshalNParticipant2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐParticipant(ctx context.Context, sel ast.SelectionSet, v *model.Participant) graphql.Marshaler {
+	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "must not be null")
 		}
+		return graphql.Null
 	}
-	return res
+	return ec._Participant(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNHistoricalString2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐHistoricalString(ctx context.Context, sel ast.SelectionSet, v model.HistoricalString) graphql.Marshaler {
-	return ec._HistoricalString(ctx, sel, &v)
+func (ec *executionContext) marshalNParticipantsEdge2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐParticipantsEdge(ctx context.Context, sel ast.SelectionSet, v model.ParticipantsEdge) graphql.Marshaler {
+	return ec._ParticipantsEdge(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNHistoricalString2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐHistoricalString(ctx context.Context, sel ast.SelectionSet, v *model.HistoricalString) graphql.Marshaler {
+func (ec *executionContext) marshalNParticipantsEdge2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐParticipantsEdge(ctx context.Context, sel ast.SelectionSet, v *model.ParticipantsEdge) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "must not be null")
 		}
 		return graphql.Null
 	}
-	return ec._HistoricalString(ctx, sel, v)
+	return ec._ParticipantsEdge(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalNID2string(ctx context.Context, v interface{}) (string, error) {
-	return graphql.UnmarshalID(v)
+func (ec *executionContext) unmarshalNPlatform2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPlatform(ctx context.Context, v interface{}) (model.Platform, error) {
+	var res model.Platform
+	return res, res.UnmarshalGQL(v)
 }
 
-func (ec *executionContext) marshalNID2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
-	res := graphql.MarshalID(v)
-	if res == graphql.Null {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "must not be null")
-		}
-	}
-	return res
+func (ec *executionContext) marshalNPlatform2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPlatform(ctx context.Context, sel ast.SelectionSet, v model.Platform) graphql.Marshaler {
+	return v
 }
 
-func (ec *executionContext) unmarshalNID2ᚕstringᚄ(ctx context.Context, v interface{}) ([]string, error) {
-	var vSlice []interface{}
-	if v != nil {
-		if tmp1, ok := v.([]interface{}); ok {
-			vSlice = tmp1
+func (ec *executionContext) marshalNPollOption2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPollOption(ctx context.Context, sel ast.SelectionSet, v model.PollOption) graphql.Marshaler {
+	return ec._PollOption(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNPollOption2ᚕᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPollOptionᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.PollOption) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNPollOption2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPollOption(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
 		} else {
-			vSlice = []interface{}{v}
+			go f(i)
 		}
+
 	}
-	var err error
-	res := make([]string, len(vSlice))
-	for i := range vSlice {
-		res[i], err = ec.unmarshalNID2string(ctx, vSlice[i])
-		if err != nil {
-			return nil, err
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalNPollOption2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPollOption(ctx context.Context, sel ast.SelectionSet, v *model.PollOption) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
 		}
+		return graphql.Null
 	}
-	return res, nil
+	return ec._PollOption(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNID2ᚕstringᚄ(ctx context.Context, sel ast.SelectionSet, v []string) graphql.Marshaler {
+func (ec *executionContext) marshalNPost2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPost(ctx context.Context, sel ast.SelectionSet, v model.Post) graphql.Marshaler {
+	return ec._Post(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNPost2ᚕᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPostᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.Post) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
-	for i := range v {
-		ret[i] = ec.marshalNID2string(ctx, sel, v[i])
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
 	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNPost2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPost(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
+	}
+	wg.Wait()
 	return ret
 }
 
-func (ec *executionContext) unmarshalNInt2int(ctx context.Context, v interface{}) (int, error) {
-	return graphql.UnmarshalInt(v)
-}
-
-func (ec *executionContext) marshalNInt2int(ctx context.Context, sel ast.SelectionSet, v int) graphql.Marshaler {
-	res := graphql.MarshalInt(v)
-	if res == graphql.Null {
+func (ec *executionContext) marshalNPost2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPost(ctx context.Context, sel ast.SelectionSet, v *model.Post) graphql.Marshaler {
+	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "must not be null")
 		}
+		return graphql.Null
 	}
-	return res
+	return ec._Post(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalNInviteRequestStatus2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐInviteRequestStatus(ctx context.Context, v interface{}) (model.InviteRequestStatus, error) {
-	var res model.InviteRequestStatus
-	return res, res.UnmarshalGQL(v)
+func (ec *executionContext) marshalNPostBookmarksConnection2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPostBookmarksConnection(ctx context.Context, sel ast.SelectionSet, v model.PostBookmarksConnection) graphql.Marshaler {
+	return ec._PostBookmarksConnection(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNInviteRequestStatus2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐInviteRequestStatus(ctx context.Context, sel ast.SelectionSet, v model.InviteRequestStatus) graphql.Marshaler {
-	return v
+func (ec *executionContext) marshalNPostBookmarksConnection2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPostBookmarksConnection(ctx context.Context, sel ast.SelectionSet, v *model.PostBookmarksConnection) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._PostBookmarksConnection(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNModerator2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐModerator(ctx context.Context, sel ast.SelectionSet, v model.Moderator) graphql.Marshaler {
-	return ec._Moderator(ctx, sel, &v)
+func (ec *executionContext) marshalNPostBookmarksEdge2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPostBookmarksEdge(ctx context.Context, sel ast.SelectionSet, v model.PostBookmarksEdge) graphql.Marshaler {
+	return ec._PostBookmarksEdge(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNModerator2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐModerator(ctx context.Context, sel ast.SelectionSet, v *model.Moderator) graphql.Marshaler {
+func (ec *executionContext) marshalNPostBookmarksEdge2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPostBookmarksEdge(ctx context.Context, sel ast.SelectionSet, v *model.PostBookmarksEdge) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "must not be null")
 		}
 		return graphql.Null
 	}
-	return ec._Moderator(ctx, sel, v)
+	return ec._PostBookmarksEdge(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNPageInfo2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPageInfo(ctx context.Context, sel ast.SelectionSet, v model.PageInfo) graphql.Marshaler {
-	return ec._PageInfo(ctx, sel, &v)
+func (ec *executionContext) unmarshalNPostContentInput2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPostContentInput(ctx context.Context, v interface{}) (model.PostContentInput, error) {
+	return ec.unmarshalInputPostContentInput(ctx, v)
 }
 
-func (ec *executionContext) marshalNParticipant2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐParticipant(ctx context.Context, sel ast.SelectionSet, v model.Participant) graphql.Marshaler {
-	return ec._Participant(ctx, sel, &v)
+func (ec *executionContext) marshalNPostReactionSummary2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPostReactionSummary(ctx context.Context, sel ast.SelectionSet, v model.PostReactionSummary) graphql.Marshaler {
+	return ec._PostReactionSummary(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNParticipant2ᚕᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐParticipantᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.Participant) graphql.Marshaler {
+func (ec *executionContext) marshalNPostReactionSummary2ᚕᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPostReactionSummaryᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.PostReactionSummary) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -11986,7 +19869,7 @@ func (ec *executionContext) marshalNParticipant2ᚕᚖgithubᚗcomᚋdelphisᚑi
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNParticipant2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐParticipant(ctx, sel, v[i])
+			ret[i] = ec.marshalNPostReactionSummary2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPostReactionSummary(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -11999,55 +19882,83 @@ func (ec *executionContext) marshalNParticipant2ᚕᚖgithubᚗcomᚋdelphisᚑi
 	return ret
 }
 
-func (ec *executionContext) marshalNParticipant2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐParticipant(ctx context.Context, sel ast.SelectionSet, v *model.Participant) graphql.Marshaler {
+func (ec *executionContext) marshalNPostReactionSummary2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPostReactionSummary(ctx context.Context, sel ast.SelectionSet, v *model.PostReactionSummary) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "must not be null")
 		}
 		return graphql.Null
 	}
-	return ec._Participant(ctx, sel, v)
+	return ec._PostReactionSummary(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNParticipantsEdge2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐParticipantsEdge(ctx context.Context, sel ast.SelectionSet, v model.ParticipantsEdge) graphql.Marshaler {
-	return ec._ParticipantsEdge(ctx, sel, &v)
+func (ec *executionContext) marshalNPostReport2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPostReport(ctx context.Context, sel ast.SelectionSet, v model.PostReport) graphql.Marshaler {
+	return ec._PostReport(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNParticipantsEdge2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐParticipantsEdge(ctx context.Context, sel ast.SelectionSet, v *model.ParticipantsEdge) graphql.Marshaler {
+func (ec *executionContext) marshalNPostReport2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPostReport(ctx context.Context, sel ast.SelectionSet, v *model.PostReport) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "must not be null")
 		}
 		return graphql.Null
 	}
-	return ec._ParticipantsEdge(ctx, sel, v)
+	return ec._PostReport(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalNPlatform2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPlatform(ctx context.Context, v interface{}) (model.Platform, error) {
-	var res model.Platform
+func (ec *executionContext) unmarshalNPostReportAction2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPostReportAction(ctx context.Context, v interface{}) (model.PostReportAction, error) {
+	var res model.PostReportAction
 	return res, res.UnmarshalGQL(v)
 }
 
-func (ec *executionContext) marshalNPlatform2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPlatform(ctx context.Context, sel ast.SelectionSet, v model.Platform) graphql.Marshaler {
+func (ec *executionContext) marshalNPostReportAction2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPostReportAction(ctx context.Context, sel ast.SelectionSet, v model.PostReportAction) graphql.Marshaler {
 	return v
 }
 
-func (ec *executionContext) marshalNPost2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPost(ctx context.Context, sel ast.SelectionSet, v model.Post) graphql.Marshaler {
-	return ec._Post(ctx, sel, &v)
+func (ec *executionContext) marshalNPostReportConnection2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPostReportConnection(ctx context.Context, sel ast.SelectionSet, v model.PostReportConnection) graphql.Marshaler {
+	return ec._PostReportConnection(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNPost2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPost(ctx context.Context, sel ast.SelectionSet, v *model.Post) graphql.Marshaler {
+func (ec *executionContext) marshalNPostReportConnection2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPostReportConnection(ctx context.Context, sel ast.SelectionSet, v *model.PostReportConnection) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "must not be null")
 		}
 		return graphql.Null
 	}
-	return ec._Post(ctx, sel, v)
+	return ec._PostReportConnection(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalNPostContentInput2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPostContentInput(ctx context.Context, v interface{}) (model.PostContentInput, error) {
-	return ec.unmarshalInputPostContentInput(ctx, v)
+func (ec *executionContext) marshalNPostReportEdge2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPostReportEdge(ctx context.Context, sel ast.SelectionSet, v model.PostReportEdge) graphql.Marshaler {
+	return ec._PostReportEdge(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNPostReportEdge2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPostReportEdge(ctx context.Context, sel ast.SelectionSet, v *model.PostReportEdge) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._PostReportEdge(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNPostReportReason2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPostReportReason(ctx context.Context, v interface{}) (model.PostReportReason, error) {
+	var res model.PostReportReason
+	return res, res.UnmarshalGQL(v)
+}
+
+func (ec *executionContext) marshalNPostReportReason2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPostReportReason(ctx context.Context, sel ast.SelectionSet, v model.PostReportReason) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) unmarshalNPostReportStatus2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPostReportStatus(ctx context.Context, v interface{}) (model.PostReportStatus, error) {
+	var res model.PostReportStatus
+	return res, res.UnmarshalGQL(v)
+}
+
+func (ec *executionContext) marshalNPostReportStatus2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPostReportStatus(ctx context.Context, sel ast.SelectionSet, v model.PostReportStatus) graphql.Marshaler {
+	return v
 }
 
 func (ec *executionContext) unmarshalNPostType2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPostType(ctx context.Context, v interface{}) (model.PostType, error) {
@@ -12087,6 +19998,29 @@ func (ec *executionContext) marshalNPostsEdge2ᚖgithubᚗcomᚋdelphisᚑincᚋ
 	return ec._PostsEdge(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalNScheduledPost2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐScheduledPost(ctx context.Context, sel ast.SelectionSet, v model.ScheduledPost) graphql.Marshaler {
+	return ec._ScheduledPost(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNScheduledPost2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐScheduledPost(ctx context.Context, sel ast.SelectionSet, v *model.ScheduledPost) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._ScheduledPost(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNScheduledPostStatus2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐScheduledPostStatus(ctx context.Context, v interface{}) (model.ScheduledPostStatus, error) {
+	var res model.ScheduledPostStatus
+	return res, res.UnmarshalGQL(v)
+}
+
+func (ec *executionContext) marshalNScheduledPostStatus2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐScheduledPostStatus(ctx context.Context, sel ast.SelectionSet, v model.ScheduledPostStatus) graphql.Marshaler {
+	return v
+}
+
 func (ec *executionContext) unmarshalNString2string(ctx context.Context, v interface{}) (string, error) {
 	return graphql.UnmarshalString(v)
 }
@@ -12101,6 +20035,86 @@ func (ec *executionContext) marshalNString2string(ctx context.Context, sel ast.S
 	return res
 }
 
+func (ec *executionContext) unmarshalNString2ᚕstringᚄ(ctx context.Context, v interface{}) ([]string, error) {
+	var vSlice []interface{}
+	if v != nil {
+		if tmp1, ok := v.([]interface{}); ok {
+			vSlice = tmp1
+		} else {
+			vSlice = []interface{}{v}
+		}
+	}
+	var err error
+	res := make([]string, len(vSlice))
+	for i := range vSlice {
+		res[i], err = ec.unmarshalNString2string(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) marshalNString2ᚕstringᚄ(ctx context.Context, sel ast.SelectionSet, v []string) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	for i := range v {
+		ret[i] = ec.marshalNString2string(ctx, sel, v[i])
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNTagSummary2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐTagSummary(ctx context.Context, sel ast.SelectionSet, v model.TagSummary) graphql.Marshaler {
+	return ec._TagSummary(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNTagSummary2ᚕᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐTagSummaryᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.TagSummary) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNTagSummary2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐTagSummary(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalNTagSummary2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐTagSummary(ctx context.Context, sel ast.SelectionSet, v *model.TagSummary) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._TagSummary(ctx, sel, v)
+}
+
 func (ec *executionContext) unmarshalNTime2timeᚐTime(ctx context.Context, v interface{}) (time.Time, error) {
 	return graphql.UnmarshalTime(v)
 }
@@ -12169,6 +20183,43 @@ func (ec *executionContext) marshalNViewer2githubᚗcomᚋdelphisᚑincᚋdelphi
 	return ec._Viewer(ctx, sel, &v)
 }
 
+func (ec *executionContext) marshalNViewer2ᚕᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐViewerᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.Viewer) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNViewer2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐViewer(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+	return ret
+}
+
 func (ec *executionContext) marshalNViewer2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐViewer(ctx context.Context, sel ast.SelectionSet, v *model.Viewer) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
@@ -12709,8 +20760,32 @@ func (ec *executionContext) marshalOEntity2ᚕgithubᚗcomᚋdelphisᚑincᚋdel
 		}
 
 	}
-	wg.Wait()
-	return ret
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) unmarshalOEveryoneMentionPolicy2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐEveryoneMentionPolicy(ctx context.Context, v interface{}) (model.EveryoneMentionPolicy, error) {
+	var res model.EveryoneMentionPolicy
+	return res, res.UnmarshalGQL(v)
+}
+
+func (ec *executionContext) marshalOEveryoneMentionPolicy2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐEveryoneMentionPolicy(ctx context.Context, sel ast.SelectionSet, v model.EveryoneMentionPolicy) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) unmarshalOEveryoneMentionPolicy2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐEveryoneMentionPolicy(ctx context.Context, v interface{}) (*model.EveryoneMentionPolicy, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalOEveryoneMentionPolicy2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐEveryoneMentionPolicy(ctx, v)
+	return &res, err
+}
+
+func (ec *executionContext) marshalOEveryoneMentionPolicy2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐEveryoneMentionPolicy(ctx context.Context, sel ast.SelectionSet, v *model.EveryoneMentionPolicy) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
 }
 
 func (ec *executionContext) unmarshalOGradientColor2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐGradientColor(ctx context.Context, v interface{}) (model.GradientColor, error) {
@@ -12845,6 +20920,81 @@ func (ec *executionContext) marshalOMediaSize2ᚖgithubᚗcomᚋdelphisᚑincᚋ
 	return ec._MediaSize(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalOModerationLogEdge2ᚕᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐModerationLogEdgeᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.ModerationLogEdge) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNModerationLogEdge2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐModerationLogEdge(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalOModerationLogEntry2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐModerationLogEntry(ctx context.Context, sel ast.SelectionSet, v model.ModerationLogEntry) graphql.Marshaler {
+	return ec._ModerationLogEntry(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalOModerationLogEntry2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐModerationLogEntry(ctx context.Context, sel ast.SelectionSet, v *model.ModerationLogEntry) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._ModerationLogEntry(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalOModeratorRole2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐModeratorRole(ctx context.Context, v interface{}) (model.ModeratorRole, error) {
+	var res model.ModeratorRole
+	return res, res.UnmarshalGQL(v)
+}
+
+func (ec *executionContext) marshalOModeratorRole2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐModeratorRole(ctx context.Context, sel ast.SelectionSet, v model.ModeratorRole) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) unmarshalOModeratorRole2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐModeratorRole(ctx context.Context, v interface{}) (*model.ModeratorRole, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalOModeratorRole2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐModeratorRole(ctx, v)
+	return &res, err
+}
+
+func (ec *executionContext) marshalOModeratorRole2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐModeratorRole(ctx context.Context, sel ast.SelectionSet, v *model.ModeratorRole) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
 func (ec *executionContext) marshalOParticipant2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐParticipant(ctx context.Context, sel ast.SelectionSet, v model.Participant) graphql.Marshaler {
 	return ec._Participant(ctx, sel, &v)
 }
@@ -12936,6 +21086,29 @@ func (ec *executionContext) marshalOParticipantsEdge2ᚕᚖgithubᚗcomᚋdelphi
 	return ret
 }
 
+func (ec *executionContext) marshalOPoll2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPoll(ctx context.Context, sel ast.SelectionSet, v model.Poll) graphql.Marshaler {
+	return ec._Poll(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalOPoll2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPoll(ctx context.Context, sel ast.SelectionSet, v *model.Poll) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._Poll(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalOPollInput2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPollInput(ctx context.Context, v interface{}) (model.PollInput, error) {
+	return ec.unmarshalInputPollInput(ctx, v)
+}
+
+func (ec *executionContext) unmarshalOPollInput2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPollInput(ctx context.Context, v interface{}) (*model.PollInput, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalOPollInput2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPollInput(ctx, v)
+	return &res, err
+}
+
 func (ec *executionContext) marshalOPost2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPost(ctx context.Context, sel ast.SelectionSet, v model.Post) graphql.Marshaler {
 	return ec._Post(ctx, sel, &v)
 }
@@ -12987,6 +21160,57 @@ func (ec *executionContext) marshalOPost2ᚖgithubᚗcomᚋdelphisᚑincᚋdelph
 	return ec._Post(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalOPostBookmark2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPostBookmark(ctx context.Context, sel ast.SelectionSet, v model.PostBookmark) graphql.Marshaler {
+	return ec._PostBookmark(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalOPostBookmark2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPostBookmark(ctx context.Context, sel ast.SelectionSet, v *model.PostBookmark) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._PostBookmark(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalOPostBookmarksEdge2ᚕᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPostBookmarksEdgeᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.PostBookmarksEdge) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNPostBookmarksEdge2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPostBookmarksEdge(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+	return ret
+}
+
 func (ec *executionContext) unmarshalOPostDeletedReason2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPostDeletedReason(ctx context.Context, v interface{}) (model.PostDeletedReason, error) {
 	var res model.PostDeletedReason
 	return res, res.UnmarshalGQL(v)
@@ -13011,6 +21235,105 @@ func (ec *executionContext) marshalOPostDeletedReason2ᚖgithubᚗcomᚋdelphis
 	return v
 }
 
+func (ec *executionContext) marshalOPostReport2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPostReport(ctx context.Context, sel ast.SelectionSet, v model.PostReport) graphql.Marshaler {
+	return ec._PostReport(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalOPostReport2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPostReport(ctx context.Context, sel ast.SelectionSet, v *model.PostReport) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._PostReport(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalOPostReportAction2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPostReportAction(ctx context.Context, v interface{}) (model.PostReportAction, error) {
+	var res model.PostReportAction
+	return res, res.UnmarshalGQL(v)
+}
+
+func (ec *executionContext) marshalOPostReportAction2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPostReportAction(ctx context.Context, sel ast.SelectionSet, v model.PostReportAction) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) unmarshalOPostReportAction2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPostReportAction(ctx context.Context, v interface{}) (*model.PostReportAction, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalOPostReportAction2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPostReportAction(ctx, v)
+	return &res, err
+}
+
+func (ec *executionContext) marshalOPostReportAction2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPostReportAction(ctx context.Context, sel ast.SelectionSet, v *model.PostReportAction) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
+func (ec *executionContext) marshalOPostReportEdge2ᚕᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPostReportEdgeᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.PostReportEdge) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNPostReportEdge2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPostReportEdge(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) unmarshalOPostReportStatus2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPostReportStatus(ctx context.Context, v interface{}) (model.PostReportStatus, error) {
+	var res model.PostReportStatus
+	return res, res.UnmarshalGQL(v)
+}
+
+func (ec *executionContext) marshalOPostReportStatus2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPostReportStatus(ctx context.Context, sel ast.SelectionSet, v model.PostReportStatus) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) unmarshalOPostReportStatus2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPostReportStatus(ctx context.Context, v interface{}) (*model.PostReportStatus, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalOPostReportStatus2githubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPostReportStatus(ctx, v)
+	return &res, err
+}
+
+func (ec *executionContext) marshalOPostReportStatus2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPostReportStatus(ctx context.Context, sel ast.SelectionSet, v *model.PostReportStatus) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
 func (ec *executionContext) marshalOPostsEdge2ᚕᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐPostsEdgeᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.PostsEdge) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -13051,6 +21374,46 @@ func (ec *executionContext) marshalOPostsEdge2ᚕᚖgithubᚗcomᚋdelphisᚑinc
 	return ret
 }
 
+func (ec *executionContext) marshalOScheduledPost2ᚕᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐScheduledPostᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.ScheduledPost) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNScheduledPost2ᚖgithubᚗcomᚋdelphisᚑincᚋdelphisbeᚋgraphᚋmodelᚐScheduledPost(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+	return ret
+}
+
 func (ec *executionContext) unmarshalOString2string(ctx context.Context, v interface{}) (string, error) {
 	return graphql.UnmarshalString(v)
 }
@@ -20,6 +20,7 @@ type InviteType string
 
 type Discussion struct {
 	Entity
+	DiscussionSubscriptionEntity
 	ID                    string                       `json:"id" dynamodbav:"ID" gorm:"type:varchar(36);"`
 	CreatedAt             time.Time                    `json:"createdAt" gorm:"not null;default:CURRENT_TIMESTAMP;"`
 	UpdatedAt             time.Time                    `json:"updatedAt" gorm:"not null;default:CURRENT_TIMESTAMP ONUPDATE CURRENT_TIMESTAMP;"`
@@ -40,6 +41,8 @@ type Discussion struct {
 	LastPostCreatedAt     *time.Time                   `json:"last_post_created_at"`
 	ShuffleCount          int                          `json:"shuffle_count"`
 	LockStatus            bool                         `json:"lock_status"`
+	EveryoneMentionPolicy EveryoneMentionPolicy        `json:"everyone_mention_policy"`
+	SlowModeSeconds       int                          `json:"slow_mode_seconds"`
 }
 
 type DiscussionInput struct {
@@ -52,6 +55,8 @@ type DiscussionInput struct {
 	LastPostID            *string                       `json:"lastPostID"`
 	LastPostCreatedAt     *time.Time                    `json:"lastPostCreatedAt"`
 	LockStatus            *bool                         `json:"lockStatus"`
+	EveryoneMentionPolicy *EveryoneMentionPolicy        `json:"everyoneMentionPolicy"`
+	SlowModeSeconds       *int                          `json:"slowModeSeconds"`
 }
 
 type HistoricalString struct {
@@ -71,6 +76,7 @@ type DiscussionUserAccess struct {
 }
 
 type DiscussionAccessRequest struct {
+	DiscussionSubscriptionEntity
 	ID           string `json:"id"`
 	UserID       string
 	DiscussionID string
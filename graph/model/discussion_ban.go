@@ -0,0 +1,14 @@
+package model
+
+import "time"
+
+// DiscussionBan keeps the reason a participant was banned so the banned user
+// can see it. It is removed when the participant is unbanned.
+type DiscussionBan struct {
+	ParticipantID  string    `json:"participantID"`
+	DiscussionID   string    `json:"discussionID"`
+	UserID         string    `json:"userID"`
+	Reason         *string   `json:"reason"`
+	BannedByUserID string    `json:"bannedByUserID"`
+	CreatedAt      time.Time `json:"createdAt"`
+}
@@ -0,0 +1,15 @@
+package model
+
+import "time"
+
+// DiscussionEvent is the persisted record of a DiscussionSubscriptionEvent.
+// Sequence increases monotonically so clients can resume from the last
+// event they saw.
+type DiscussionEvent struct {
+	Sequence     int                             `json:"sequence"`
+	DiscussionID string                          `json:"discussionID"`
+	EventType    DiscussionSubscriptionEventType `json:"eventType"`
+	EntityType   string                          `json:"entityType"`
+	EntityID     string                          `json:"entityID"`
+	CreatedAt    time.Time                       `json:"createdAt"`
+}
@@ -0,0 +1,14 @@
+package model
+
+import "time"
+
+// LinkPreview is shared between every post linking to the same url, so a
+// popular link is only fetched once per refresh interval.
+type LinkPreview struct {
+	URL         string    `json:"url"`
+	Title       *string   `json:"title"`
+	Description *string   `json:"description"`
+	ImageURL    *string   `json:"imageURL"`
+	SiteName    *string   `json:"siteName"`
+	FetchedAt   time.Time `json:"fetchedAt"`
+}
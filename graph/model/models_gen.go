@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"strconv"
+	"time"
 )
 
 type DiscussionSubscriptionEntity interface {
@@ -33,6 +34,7 @@ type DiscussionCreationSettings struct {
 }
 
 type DiscussionSubscriptionEvent struct {
+	Sequence  int                             `json:"sequence"`
 	EventType DiscussionSubscriptionEventType `json:"eventType"`
 	Entity    DiscussionSubscriptionEntity    `json:"entity"`
 }
@@ -42,6 +44,13 @@ type DiscussionUserSettings struct {
 	NotifSetting *DiscussionUserNotificationSetting `json:"notifSetting"`
 }
 
+type GroupMention struct {
+	ID    string       `json:"id"`
+	Group MentionGroup `json:"group"`
+}
+
+func (GroupMention) IsEntity() {}
+
 type Media struct {
 	ID                string             `json:"id"`
 	CreatedAt         string             `json:"createdAt"`
@@ -63,13 +72,40 @@ type ParticipantProfile struct {
 	GradientColor *GradientColor `json:"gradientColor"`
 }
 
+type PollInput struct {
+	Options            []string   `json:"options"`
+	AllowMultipleVotes *bool      `json:"allowMultipleVotes"`
+	IsAnonymous        *bool      `json:"isAnonymous"`
+	ClosesAt           *time.Time `json:"closesAt"`
+}
+
 type PostContentInput struct {
-	PostText          string   `json:"postText"`
-	PostType          PostType `json:"postType"`
-	MentionedEntities []string `json:"mentionedEntities"`
-	QuotedPostID      *string  `json:"quotedPostID"`
-	MediaID           *string  `json:"mediaID"`
-	Preview           *string  `json:"preview"`
+	PostText          string     `json:"postText"`
+	PostType          PostType   `json:"postType"`
+	MentionedEntities []string   `json:"mentionedEntities"`
+	QuotedPostID      *string    `json:"quotedPostID"`
+	MediaID           *string    `json:"mediaID"`
+	Preview           *string    `json:"preview"`
+	ParentPostID      *string    `json:"parentPostID"`
+	Poll              *PollInput `json:"poll"`
+}
+
+type PostReactionSummary struct {
+	Reaction  string `json:"reaction"`
+	Count     int    `json:"count"`
+	MeReacted bool   `json:"meReacted"`
+}
+
+type Tag struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+func (Tag) IsEntity() {}
+
+type TagSummary struct {
+	Name      string `json:"name"`
+	PostCount int    `json:"postCount"`
 }
 
 type URL struct {
@@ -224,20 +260,58 @@ func (e DiscussionJoinabilitySetting) MarshalGQL(w io.Writer) {
 type DiscussionSubscriptionEventType string
 
 const (
-	DiscussionSubscriptionEventTypePostAdded         DiscussionSubscriptionEventType = "POST_ADDED"
-	DiscussionSubscriptionEventTypePostDeleted       DiscussionSubscriptionEventType = "POST_DELETED"
-	DiscussionSubscriptionEventTypeParticipantBanned DiscussionSubscriptionEventType = "PARTICIPANT_BANNED"
+	DiscussionSubscriptionEventTypePostAdded               DiscussionSubscriptionEventType = "POST_ADDED"
+	DiscussionSubscriptionEventTypePostDeleted             DiscussionSubscriptionEventType = "POST_DELETED"
+	DiscussionSubscriptionEventTypePostEdited              DiscussionSubscriptionEventType = "POST_EDITED"
+	DiscussionSubscriptionEventTypePostReactionAdded       DiscussionSubscriptionEventType = "POST_REACTION_ADDED"
+	DiscussionSubscriptionEventTypePostReactionRemoved     DiscussionSubscriptionEventType = "POST_REACTION_REMOVED"
+	DiscussionSubscriptionEventTypePostPinned              DiscussionSubscriptionEventType = "POST_PINNED"
+	DiscussionSubscriptionEventTypePostUnpinned            DiscussionSubscriptionEventType = "POST_UNPINNED"
+	DiscussionSubscriptionEventTypePostPollVoted           DiscussionSubscriptionEventType = "POST_POLL_VOTED"
+	DiscussionSubscriptionEventTypePostLinkPreviewsUpdated DiscussionSubscriptionEventType = "POST_LINK_PREVIEWS_UPDATED"
+	DiscussionSubscriptionEventTypeParticipantBanned       DiscussionSubscriptionEventType = "PARTICIPANT_BANNED"
+	DiscussionSubscriptionEventTypeParticipantUnbanned     DiscussionSubscriptionEventType = "PARTICIPANT_UNBANNED"
+	DiscussionSubscriptionEventTypeParticipantJoined       DiscussionSubscriptionEventType = "PARTICIPANT_JOINED"
+	DiscussionSubscriptionEventTypeParticipantMuted        DiscussionSubscriptionEventType = "PARTICIPANT_MUTED"
+	DiscussionSubscriptionEventTypeParticipantUnmuted      DiscussionSubscriptionEventType = "PARTICIPANT_UNMUTED"
+	DiscussionSubscriptionEventTypeParticipantRoleUpdated  DiscussionSubscriptionEventType = "PARTICIPANT_ROLE_UPDATED"
+	DiscussionSubscriptionEventTypeDiscussionUpdated       DiscussionSubscriptionEventType = "DISCUSSION_UPDATED"
+	DiscussionSubscriptionEventTypeDiscussionLocked        DiscussionSubscriptionEventType = "DISCUSSION_LOCKED"
+	DiscussionSubscriptionEventTypeDiscussionUnlocked      DiscussionSubscriptionEventType = "DISCUSSION_UNLOCKED"
+	DiscussionSubscriptionEventTypeShuffleScheduled        DiscussionSubscriptionEventType = "SHUFFLE_SCHEDULED"
+	DiscussionSubscriptionEventTypeShuffleCompleted        DiscussionSubscriptionEventType = "SHUFFLE_COMPLETED"
+	DiscussionSubscriptionEventTypeAccessRequestCreated    DiscussionSubscriptionEventType = "ACCESS_REQUEST_CREATED"
+	DiscussionSubscriptionEventTypeAccessRequestUpdated    DiscussionSubscriptionEventType = "ACCESS_REQUEST_UPDATED"
 )
 
 var AllDiscussionSubscriptionEventType = []DiscussionSubscriptionEventType{
 	DiscussionSubscriptionEventTypePostAdded,
 	DiscussionSubscriptionEventTypePostDeleted,
+	DiscussionSubscriptionEventTypePostEdited,
+	DiscussionSubscriptionEventTypePostReactionAdded,
+	DiscussionSubscriptionEventTypePostReactionRemoved,
+	DiscussionSubscriptionEventTypePostPinned,
+	DiscussionSubscriptionEventTypePostUnpinned,
+	DiscussionSubscriptionEventTypePostPollVoted,
+	DiscussionSubscriptionEventTypePostLinkPreviewsUpdated,
 	DiscussionSubscriptionEventTypeParticipantBanned,
+	DiscussionSubscriptionEventTypeParticipantUnbanned,
+	DiscussionSubscriptionEventTypeParticipantJoined,
+	DiscussionSubscriptionEventTypeParticipantMuted,
+	DiscussionSubscriptionEventTypeParticipantUnmuted,
+	DiscussionSubscriptionEventTypeParticipantRoleUpdated,
+	DiscussionSubscriptionEventTypeDiscussionUpdated,
+	DiscussionSubscriptionEventTypeDiscussionLocked,
+	DiscussionSubscriptionEventTypeDiscussionUnlocked,
+	DiscussionSubscriptionEventTypeShuffleScheduled,
+	DiscussionSubscriptionEventTypeShuffleCompleted,
+	DiscussionSubscriptionEventTypeAccessRequestCreated,
+	DiscussionSubscriptionEventTypeAccessRequestUpdated,
 }
 
 func (e DiscussionSubscriptionEventType) IsValid() bool {
 	switch e {
-	case DiscussionSubscriptionEventTypePostAdded, DiscussionSubscriptionEventTypePostDeleted, DiscussionSubscriptionEventTypeParticipantBanned:
+	case DiscussionSubscriptionEventTypePostAdded, DiscussionSubscriptionEventTypePostDeleted, DiscussionSubscriptionEventTypePostEdited, DiscussionSubscriptionEventTypePostReactionAdded, DiscussionSubscriptionEventTypePostReactionRemoved, DiscussionSubscriptionEventTypePostPinned, DiscussionSubscriptionEventTypePostUnpinned, DiscussionSubscriptionEventTypePostPollVoted, DiscussionSubscriptionEventTypePostLinkPreviewsUpdated, DiscussionSubscriptionEventTypeParticipantBanned, DiscussionSubscriptionEventTypeParticipantUnbanned, DiscussionSubscriptionEventTypeParticipantJoined, DiscussionSubscriptionEventTypeParticipantMuted, DiscussionSubscriptionEventTypeParticipantUnmuted, DiscussionSubscriptionEventTypeParticipantRoleUpdated, DiscussionSubscriptionEventTypeDiscussionUpdated, DiscussionSubscriptionEventTypeDiscussionLocked, DiscussionSubscriptionEventTypeDiscussionUnlocked, DiscussionSubscriptionEventTypeShuffleScheduled, DiscussionSubscriptionEventTypeShuffleCompleted, DiscussionSubscriptionEventTypeAccessRequestCreated, DiscussionSubscriptionEventTypeAccessRequestUpdated:
 		return true
 	}
 	return false
@@ -352,6 +426,49 @@ func (e DiscussionUserNotificationSetting) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type EveryoneMentionPolicy string
+
+const (
+	EveryoneMentionPolicyModerators   EveryoneMentionPolicy = "MODERATORS"
+	EveryoneMentionPolicyParticipants EveryoneMentionPolicy = "PARTICIPANTS"
+	EveryoneMentionPolicyNobody       EveryoneMentionPolicy = "NOBODY"
+)
+
+var AllEveryoneMentionPolicy = []EveryoneMentionPolicy{
+	EveryoneMentionPolicyModerators,
+	EveryoneMentionPolicyParticipants,
+	EveryoneMentionPolicyNobody,
+}
+
+func (e EveryoneMentionPolicy) IsValid() bool {
+	switch e {
+	case EveryoneMentionPolicyModerators, EveryoneMentionPolicyParticipants, EveryoneMentionPolicyNobody:
+		return true
+	}
+	return false
+}
+
+func (e EveryoneMentionPolicy) String() string {
+	return string(e)
+}
+
+func (e *EveryoneMentionPolicy) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = EveryoneMentionPolicy(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid EveryoneMentionPolicy", str)
+	}
+	return nil
+}
+
+func (e EveryoneMentionPolicy) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type GradientColor string
 
 const (
@@ -470,6 +587,261 @@ func (e InviteRequestStatus) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type MentionErrorCode string
+
+const (
+	MentionErrorCodeTokenMismatch     MentionErrorCode = "TOKEN_MISMATCH"
+	MentionErrorCodeMalformedEntity   MentionErrorCode = "MALFORMED_ENTITY"
+	MentionErrorCodeUnknownEntity     MentionErrorCode = "UNKNOWN_ENTITY"
+	MentionErrorCodeCrossDiscussion   MentionErrorCode = "CROSS_DISCUSSION"
+	MentionErrorCodeBannedParticipant MentionErrorCode = "BANNED_PARTICIPANT"
+	MentionErrorCodeNotAllowed        MentionErrorCode = "NOT_ALLOWED"
+)
+
+var AllMentionErrorCode = []MentionErrorCode{
+	MentionErrorCodeTokenMismatch,
+	MentionErrorCodeMalformedEntity,
+	MentionErrorCodeUnknownEntity,
+	MentionErrorCodeCrossDiscussion,
+	MentionErrorCodeBannedParticipant,
+	MentionErrorCodeNotAllowed,
+}
+
+func (e MentionErrorCode) IsValid() bool {
+	switch e {
+	case MentionErrorCodeTokenMismatch, MentionErrorCodeMalformedEntity, MentionErrorCodeUnknownEntity, MentionErrorCodeCrossDiscussion, MentionErrorCodeBannedParticipant, MentionErrorCodeNotAllowed:
+		return true
+	}
+	return false
+}
+
+func (e MentionErrorCode) String() string {
+	return string(e)
+}
+
+func (e *MentionErrorCode) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = MentionErrorCode(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid MentionErrorCode", str)
+	}
+	return nil
+}
+
+func (e MentionErrorCode) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type MentionGroup string
+
+const (
+	MentionGroupEveryone  MentionGroup = "EVERYONE"
+	MentionGroupModerator MentionGroup = "MODERATOR"
+)
+
+var AllMentionGroup = []MentionGroup{
+	MentionGroupEveryone,
+	MentionGroupModerator,
+}
+
+func (e MentionGroup) IsValid() bool {
+	switch e {
+	case MentionGroupEveryone, MentionGroupModerator:
+		return true
+	}
+	return false
+}
+
+func (e MentionGroup) String() string {
+	return string(e)
+}
+
+func (e *MentionGroup) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = MentionGroup(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid MentionGroup", str)
+	}
+	return nil
+}
+
+func (e MentionGroup) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type ModerationAction string
+
+const (
+	ModerationActionBanParticipant      ModerationAction = "BAN_PARTICIPANT"
+	ModerationActionUnbanParticipant    ModerationAction = "UNBAN_PARTICIPANT"
+	ModerationActionMuteParticipant     ModerationAction = "MUTE_PARTICIPANT"
+	ModerationActionUnmuteParticipant   ModerationAction = "UNMUTE_PARTICIPANT"
+	ModerationActionDeletePost          ModerationAction = "DELETE_POST"
+	ModerationActionPinPost             ModerationAction = "PIN_POST"
+	ModerationActionUnpinPost           ModerationAction = "UNPIN_POST"
+	ModerationActionLockDiscussion      ModerationAction = "LOCK_DISCUSSION"
+	ModerationActionUnlockDiscussion    ModerationAction = "UNLOCK_DISCUSSION"
+	ModerationActionUpdateDiscussion    ModerationAction = "UPDATE_DISCUSSION"
+	ModerationActionScheduleShuffle     ModerationAction = "SCHEDULE_SHUFFLE"
+	ModerationActionShuffleDiscussion   ModerationAction = "SHUFFLE_DISCUSSION"
+	ModerationActionAcceptAccessRequest ModerationAction = "ACCEPT_ACCESS_REQUEST"
+	ModerationActionRejectAccessRequest ModerationAction = "REJECT_ACCESS_REQUEST"
+	ModerationActionGrantModeratorRole  ModerationAction = "GRANT_MODERATOR_ROLE"
+	ModerationActionRevokeModeratorRole ModerationAction = "REVOKE_MODERATOR_ROLE"
+	ModerationActionTransferOwnership   ModerationAction = "TRANSFER_OWNERSHIP"
+	ModerationActionDismissPostReport   ModerationAction = "DISMISS_POST_REPORT"
+)
+
+var AllModerationAction = []ModerationAction{
+	ModerationActionBanParticipant,
+	ModerationActionUnbanParticipant,
+	ModerationActionMuteParticipant,
+	ModerationActionUnmuteParticipant,
+	ModerationActionDeletePost,
+	ModerationActionPinPost,
+	ModerationActionUnpinPost,
+	ModerationActionLockDiscussion,
+	ModerationActionUnlockDiscussion,
+	ModerationActionUpdateDiscussion,
+	ModerationActionScheduleShuffle,
+	ModerationActionShuffleDiscussion,
+	ModerationActionAcceptAccessRequest,
+	ModerationActionRejectAccessRequest,
+	ModerationActionGrantModeratorRole,
+	ModerationActionRevokeModeratorRole,
+	ModerationActionTransferOwnership,
+	ModerationActionDismissPostReport,
+}
+
+func (e ModerationAction) IsValid() bool {
+	switch e {
+	case ModerationActionBanParticipant, ModerationActionUnbanParticipant, ModerationActionMuteParticipant, ModerationActionUnmuteParticipant, ModerationActionDeletePost, ModerationActionPinPost, ModerationActionUnpinPost, ModerationActionLockDiscussion, ModerationActionUnlockDiscussion, ModerationActionUpdateDiscussion, ModerationActionScheduleShuffle, ModerationActionShuffleDiscussion, ModerationActionAcceptAccessRequest, ModerationActionRejectAccessRequest, ModerationActionGrantModeratorRole, ModerationActionRevokeModeratorRole, ModerationActionTransferOwnership, ModerationActionDismissPostReport:
+		return true
+	}
+	return false
+}
+
+func (e ModerationAction) String() string {
+	return string(e)
+}
+
+func (e *ModerationAction) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ModerationAction(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ModerationAction", str)
+	}
+	return nil
+}
+
+func (e ModerationAction) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type ModerationTargetType string
+
+const (
+	ModerationTargetTypeParticipant   ModerationTargetType = "PARTICIPANT"
+	ModerationTargetTypePost          ModerationTargetType = "POST"
+	ModerationTargetTypeDiscussion    ModerationTargetType = "DISCUSSION"
+	ModerationTargetTypeAccessRequest ModerationTargetType = "ACCESS_REQUEST"
+	ModerationTargetTypeUser          ModerationTargetType = "USER"
+	ModerationTargetTypePostReport    ModerationTargetType = "POST_REPORT"
+)
+
+var AllModerationTargetType = []ModerationTargetType{
+	ModerationTargetTypeParticipant,
+	ModerationTargetTypePost,
+	ModerationTargetTypeDiscussion,
+	ModerationTargetTypeAccessRequest,
+	ModerationTargetTypeUser,
+	ModerationTargetTypePostReport,
+}
+
+func (e ModerationTargetType) IsValid() bool {
+	switch e {
+	case ModerationTargetTypeParticipant, ModerationTargetTypePost, ModerationTargetTypeDiscussion, ModerationTargetTypeAccessRequest, ModerationTargetTypeUser, ModerationTargetTypePostReport:
+		return true
+	}
+	return false
+}
+
+func (e ModerationTargetType) String() string {
+	return string(e)
+}
+
+func (e *ModerationTargetType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ModerationTargetType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ModerationTargetType", str)
+	}
+	return nil
+}
+
+func (e ModerationTargetType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type ModeratorRole string
+
+const (
+	ModeratorRoleOwner     ModeratorRole = "OWNER"
+	ModeratorRoleModerator ModeratorRole = "MODERATOR"
+	ModeratorRoleHelper    ModeratorRole = "HELPER"
+)
+
+var AllModeratorRole = []ModeratorRole{
+	ModeratorRoleOwner,
+	ModeratorRoleModerator,
+	ModeratorRoleHelper,
+}
+
+func (e ModeratorRole) IsValid() bool {
+	switch e {
+	case ModeratorRoleOwner, ModeratorRoleModerator, ModeratorRoleHelper:
+		return true
+	}
+	return false
+}
+
+func (e ModeratorRole) String() string {
+	return string(e)
+}
+
+func (e *ModeratorRole) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ModeratorRole(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ModeratorRole", str)
+	}
+	return nil
+}
+
+func (e ModeratorRole) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type Platform string
 
 const (
@@ -521,17 +893,19 @@ const (
 	PostDeletedReasonUnknown            PostDeletedReason = "UNKNOWN"
 	PostDeletedReasonModeratorRemoved   PostDeletedReason = "MODERATOR_REMOVED"
 	PostDeletedReasonParticipantRemoved PostDeletedReason = "PARTICIPANT_REMOVED"
+	PostDeletedReasonParticipantBanned  PostDeletedReason = "PARTICIPANT_BANNED"
 )
 
 var AllPostDeletedReason = []PostDeletedReason{
 	PostDeletedReasonUnknown,
 	PostDeletedReasonModeratorRemoved,
 	PostDeletedReasonParticipantRemoved,
+	PostDeletedReasonParticipantBanned,
 }
 
 func (e PostDeletedReason) IsValid() bool {
 	switch e {
-	case PostDeletedReasonUnknown, PostDeletedReasonModeratorRemoved, PostDeletedReasonParticipantRemoved:
+	case PostDeletedReasonUnknown, PostDeletedReasonModeratorRemoved, PostDeletedReasonParticipantRemoved, PostDeletedReasonParticipantBanned:
 		return true
 	}
 	return false
@@ -558,6 +932,141 @@ func (e PostDeletedReason) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type PostReportAction string
+
+const (
+	PostReportActionDeletePost PostReportAction = "DELETE_POST"
+	PostReportActionMuteAuthor PostReportAction = "MUTE_AUTHOR"
+	PostReportActionBanAuthor  PostReportAction = "BAN_AUTHOR"
+)
+
+var AllPostReportAction = []PostReportAction{
+	PostReportActionDeletePost,
+	PostReportActionMuteAuthor,
+	PostReportActionBanAuthor,
+}
+
+func (e PostReportAction) IsValid() bool {
+	switch e {
+	case PostReportActionDeletePost, PostReportActionMuteAuthor, PostReportActionBanAuthor:
+		return true
+	}
+	return false
+}
+
+func (e PostReportAction) String() string {
+	return string(e)
+}
+
+func (e *PostReportAction) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = PostReportAction(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid PostReportAction", str)
+	}
+	return nil
+}
+
+func (e PostReportAction) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type PostReportReason string
+
+const (
+	PostReportReasonSpam           PostReportReason = "SPAM"
+	PostReportReasonHarassment     PostReportReason = "HARASSMENT"
+	PostReportReasonHateSpeech     PostReportReason = "HATE_SPEECH"
+	PostReportReasonMisinformation PostReportReason = "MISINFORMATION"
+	PostReportReasonOffTopic       PostReportReason = "OFF_TOPIC"
+	PostReportReasonOther          PostReportReason = "OTHER"
+)
+
+var AllPostReportReason = []PostReportReason{
+	PostReportReasonSpam,
+	PostReportReasonHarassment,
+	PostReportReasonHateSpeech,
+	PostReportReasonMisinformation,
+	PostReportReasonOffTopic,
+	PostReportReasonOther,
+}
+
+func (e PostReportReason) IsValid() bool {
+	switch e {
+	case PostReportReasonSpam, PostReportReasonHarassment, PostReportReasonHateSpeech, PostReportReasonMisinformation, PostReportReasonOffTopic, PostReportReasonOther:
+		return true
+	}
+	return false
+}
+
+func (e PostReportReason) String() string {
+	return string(e)
+}
+
+func (e *PostReportReason) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = PostReportReason(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid PostReportReason", str)
+	}
+	return nil
+}
+
+func (e PostReportReason) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type PostReportStatus string
+
+const (
+	PostReportStatusOpen      PostReportStatus = "OPEN"
+	PostReportStatusDismissed PostReportStatus = "DISMISSED"
+	PostReportStatusActioned  PostReportStatus = "ACTIONED"
+)
+
+var AllPostReportStatus = []PostReportStatus{
+	PostReportStatusOpen,
+	PostReportStatusDismissed,
+	PostReportStatusActioned,
+}
+
+func (e PostReportStatus) IsValid() bool {
+	switch e {
+	case PostReportStatusOpen, PostReportStatusDismissed, PostReportStatusActioned:
+		return true
+	}
+	return false
+}
+
+func (e PostReportStatus) String() string {
+	return string(e)
+}
+
+func (e *PostReportStatus) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = PostReportStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid PostReportStatus", str)
+	}
+	return nil
+}
+
+func (e PostReportStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type PostType string
 
 const (
@@ -565,6 +1074,7 @@ const (
 	PostTypeImportedContent PostType = "IMPORTED_CONTENT"
 	PostTypeAlert           PostType = "ALERT"
 	PostTypeConcierge       PostType = "CONCIERGE"
+	PostTypePoll            PostType = "POLL"
 )
 
 var AllPostType = []PostType{
@@ -572,11 +1082,12 @@ var AllPostType = []PostType{
 	PostTypeImportedContent,
 	PostTypeAlert,
 	PostTypeConcierge,
+	PostTypePoll,
 }
 
 func (e PostType) IsValid() bool {
 	switch e {
-	case PostTypeStandard, PostTypeImportedContent, PostTypeAlert, PostTypeConcierge:
+	case PostTypeStandard, PostTypeImportedContent, PostTypeAlert, PostTypeConcierge, PostTypePoll:
 		return true
 	}
 	return false
@@ -602,3 +1113,50 @@ func (e *PostType) UnmarshalGQL(v interface{}) error {
 func (e PostType) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
+
+type ScheduledPostStatus string
+
+const (
+	ScheduledPostStatusPending    ScheduledPostStatus = "PENDING"
+	ScheduledPostStatusPublishing ScheduledPostStatus = "PUBLISHING"
+	ScheduledPostStatusPublished  ScheduledPostStatus = "PUBLISHED"
+	ScheduledPostStatusCancelled  ScheduledPostStatus = "CANCELLED"
+	ScheduledPostStatusFailed     ScheduledPostStatus = "FAILED"
+)
+
+var AllScheduledPostStatus = []ScheduledPostStatus{
+	ScheduledPostStatusPending,
+	ScheduledPostStatusPublishing,
+	ScheduledPostStatusPublished,
+	ScheduledPostStatusCancelled,
+	ScheduledPostStatusFailed,
+}
+
+func (e ScheduledPostStatus) IsValid() bool {
+	switch e {
+	case ScheduledPostStatusPending, ScheduledPostStatusPublishing, ScheduledPostStatusPublished, ScheduledPostStatusCancelled, ScheduledPostStatusFailed:
+		return true
+	}
+	return false
+}
+
+func (e ScheduledPostStatus) String() string {
+	return string(e)
+}
+
+func (e *ScheduledPostStatus) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ScheduledPostStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ScheduledPostStatus", str)
+	}
+	return nil
+}
+
+func (e ScheduledPostStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
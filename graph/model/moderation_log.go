@@ -0,0 +1,26 @@
+package model
+
+import "time"
+
+// ModerationLogEntry records an action a moderator took in a discussion.
+// Entries are never updated or removed.
+type ModerationLogEntry struct {
+	ID           string               `json:"id"`
+	DiscussionID string               `json:"discussionID"`
+	ActorUserID  string               `json:"actorUserID"`
+	Action       ModerationAction     `json:"action"`
+	TargetType   ModerationTargetType `json:"targetType"`
+	TargetID     *string              `json:"targetID"`
+	Reason       *string              `json:"reason"`
+	CreatedAt    time.Time            `json:"createdAt"`
+}
+
+type ModerationLogEdge struct {
+	Cursor string              `json:"cursor"`
+	Node   *ModerationLogEntry `json:"node"`
+}
+
+type ModerationLogConnection struct {
+	Edges    []*ModerationLogEdge `json:"edges"`
+	PageInfo PageInfo             `json:"pageInfo"`
+}
@@ -11,3 +11,25 @@ type Moderator struct {
 	UserProfile   *UserProfile `json:"userProfile" dynamodbav:"-" gorm:"foreignKey:UserProfileID;"`
 	Discussion    *Discussion  `gorm:"-" dynamodbav:"-"`
 }
+
+// ModeratorRoleGrant gives a user a role below owner in one discussion. The
+// owner is the discussion's Moderator and never has a grant.
+type ModeratorRoleGrant struct {
+	DiscussionID    string        `json:"discussionID"`
+	UserID          string        `json:"userID"`
+	Role            ModeratorRole `json:"role"`
+	GrantedByUserID string        `json:"grantedByUserID"`
+	CreatedAt       time.Time     `json:"createdAt"`
+	UpdatedAt       time.Time     `json:"updatedAt"`
+}
+
+// DiscussionOwnershipTransfer records a change of the user behind a
+// discussion's Moderator.
+type DiscussionOwnershipTransfer struct {
+	ID                  string    `json:"id"`
+	DiscussionID        string    `json:"discussionID"`
+	FromUserID          string    `json:"fromUserID"`
+	ToUserID            string    `json:"toUserID"`
+	TransferredByUserID string    `json:"transferredByUserID"`
+	CreatedAt           time.Time `json:"createdAt"`
+}
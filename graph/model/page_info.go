@@ -6,9 +6,10 @@ import (
 )
 
 type PageInfo struct {
-	StartCursor *string `json:"startCursor"`
-	EndCursor   *string `json:"endCursor"`
-	HasNextPage bool    `json:"hasNextPage"`
+	StartCursor     *string `json:"startCursor"`
+	EndCursor       *string `json:"endCursor"`
+	HasNextPage     bool    `json:"hasNextPage"`
+	HasPreviousPage bool    `json:"hasPreviousPage"`
 }
 
 func EncodeCursor(i int) string {
@@ -0,0 +1,50 @@
+package model
+
+import "time"
+
+// Poll holds the options of a POLL post. The question is the post content.
+type Poll struct {
+	PostID             string        `json:"postID"`
+	AllowMultipleVotes bool          `json:"allowMultipleVotes"`
+	IsAnonymous        bool          `json:"isAnonymous"`
+	ClosesAt           *time.Time    `json:"closesAt"`
+	CreatedAt          time.Time     `json:"createdAt"`
+	Options            []*PollOption `json:"options"`
+}
+
+func (p *Poll) IsClosed(now time.Time) bool {
+	return p.ClosesAt != nil && !p.ClosesAt.After(now)
+}
+
+type PollOption struct {
+	ID       string `json:"id"`
+	PostID   string `json:"postID"`
+	Position int    `json:"position"`
+	Text     string `json:"text"`
+	// Tallied when the poll is loaded
+	VoteCount int  `json:"voteCount"`
+	MeVoted   bool `json:"meVoted"`
+	// Copied from the poll so voters can be hidden without reloading it
+	PollIsAnonymous bool `json:"-"`
+}
+
+// PollVote is attributed to a participant rather than a user so that a vote
+// from an anonymous participant stays anonymous.
+type PollVote struct {
+	PostID        string    `json:"postID"`
+	OptionID      string    `json:"optionID"`
+	ParticipantID string    `json:"participantID"`
+	CreatedAt     time.Time `json:"createdAt"`
+}
+
+type ArchivedPoll struct {
+	AllowMultipleVotes bool                  `json:"allowMultipleVotes"`
+	IsAnonymous        bool                  `json:"isAnonymous"`
+	ClosesAt           *time.Time            `json:"closesAt"`
+	Options            []*ArchivedPollOption `json:"options"`
+}
+
+type ArchivedPollOption struct {
+	Text      string `json:"text"`
+	VoteCount int    `json:"voteCount"`
+}
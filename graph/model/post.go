@@ -1,10 +1,17 @@
 package model
 
-import "time"
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/jinzhu/gorm/dialects/postgres"
+)
 
 const (
 	ParticipantPrefix = "participant"
 	DiscussionPrefix  = "discussion"
+	TagPrefix         = "tag"
+	GroupPrefix       = "group"
 )
 
 type Post struct {
@@ -25,6 +32,50 @@ type Post struct {
 	QuotedPostID *string `json:"quotedPostID" gorm:"type:varchar(36);"`
 	QuotedPost   *Post
 	MediaID      *string
+	// Replies are kept one level deep so this is always a top-level post.
+	ParentPostID *string    `json:"parentPostID" gorm:"type:varchar(36);"`
+	PinnedAt     *time.Time `json:"pinnedAt"`
+	// Prior revisions of the post content, oldest first.
+	EditHistory postgres.Jsonb `json:"editHistory" gorm:"type:jsonb"`
+	// Only loaded for POLL posts that are being archived
+	Poll *Poll `json:"poll" gorm:"-"`
+}
+
+func (p *Post) EditHistoryAsObject() ([]*HistoricalString, error) {
+	resp := make([]*HistoricalString, 0)
+	history := []HistoricalString{}
+	err := json.Unmarshal(p.EditHistory.RawMessage, &history)
+	if err != nil {
+		// Posts that were never edited have no history stored.
+		return resp, nil
+	}
+
+	for idx := range history {
+		resp = append(resp, &history[idx])
+	}
+
+	return resp, nil
+}
+
+func (p *Post) AddContentToEditHistory(content string, createdAt time.Time) error {
+	historyObj, err := p.EditHistoryAsObject()
+	if err != nil {
+		return err
+	}
+
+	historyObj = append(historyObj, &HistoricalString{
+		Value:     content,
+		CreatedAt: createdAt,
+	})
+
+	marshaled, err := json.Marshal(historyObj)
+	if err != nil {
+		return err
+	}
+
+	p.EditHistory = postgres.Jsonb{RawMessage: marshaled}
+
+	return nil
 }
 
 type ArchivedPost struct {
@@ -34,11 +85,16 @@ type ArchivedPost struct {
 	Content           string    `json:"content"`
 	MentionedEntities []string  `json:"mentioned_entities"`
 	MediaID           *string   `json:"mediaID"`
+	IsPinned          bool      `json:"isPinned"`
+	// Final results for POLL posts
+	Poll *ArchivedPoll `json:"poll,omitempty"`
 }
 
 type PostsEdge struct {
 	Cursor string `json:"cursor"`
 	Node   *Post  `json:"node"`
+	// Only set for search results
+	Highlight *string `json:"highlight"`
 }
 
 type PostsConnection struct {
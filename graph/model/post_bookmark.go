@@ -0,0 +1,22 @@
+package model
+
+import "time"
+
+// PostBookmark belongs to a user rather than a participant so that it stays
+// private, and is unaffected by shuffles which only change participant aliases.
+type PostBookmark struct {
+	UserID    string    `json:"userID"`
+	PostID    string    `json:"postID"`
+	CreatedAt time.Time `json:"createdAt"`
+	Post      *Post     `json:"post"`
+}
+
+type PostBookmarksEdge struct {
+	Cursor string        `json:"cursor"`
+	Node   *PostBookmark `json:"node"`
+}
+
+type PostBookmarksConnection struct {
+	Edges    []*PostBookmarksEdge `json:"edges"`
+	PageInfo PageInfo             `json:"pageInfo"`
+}
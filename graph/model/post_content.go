@@ -1,6 +1,9 @@
 package model
 
-import "time"
+import (
+	"strings"
+	"time"
+)
 
 // This is really a placeholder rn. We will want the following:
 // * Edit history and active version
@@ -14,3 +17,19 @@ type PostContent struct {
 	CreatedAt         time.Time `json:"createdAt" gorm:"not null;default:CURRENT_TIMESTAMP;"`
 	UpdatedAt         time.Time `json:"updatedAt" gorm:"not null;default:CURRENT_TIMESTAMP ONUPDATE CURRENT_TIMESTAMP;"`
 }
+
+// Tags returns the distinct tag names mentioned in the content, in the order
+// they first appear.
+func (p *PostContent) Tags() []string {
+	tags := make([]string, 0)
+	seen := map[string]bool{}
+	for _, entityID := range p.MentionedEntities {
+		s := strings.Split(entityID, ":")
+		if len(s) != 2 || s[0] != TagPrefix || seen[s[1]] {
+			continue
+		}
+		seen[s[1]] = true
+		tags = append(tags, s[1])
+	}
+	return tags
+}
@@ -0,0 +1,12 @@
+package model
+
+import "time"
+
+// PostReaction is attributed to a participant rather than a user so that a
+// reaction from an anonymous participant stays anonymous.
+type PostReaction struct {
+	PostID        string    `json:"postID"`
+	ParticipantID string    `json:"participantID"`
+	Reaction      string    `json:"reaction"`
+	CreatedAt     time.Time `json:"createdAt"`
+}
@@ -0,0 +1,29 @@
+package model
+
+import "time"
+
+// PostReport is a participant's report of a post for the moderators to
+// review. Resolving it records who resolved it and, when actioned, how.
+type PostReport struct {
+	ID               string            `json:"id"`
+	DiscussionID     string            `json:"discussionID"`
+	PostID           string            `json:"postID"`
+	ReporterUserID   string            `json:"reporterUserID"`
+	Reason           PostReportReason  `json:"reason"`
+	Note             *string           `json:"note"`
+	Status           PostReportStatus  `json:"status"`
+	Action           *PostReportAction `json:"action"`
+	ResolvedByUserID *string           `json:"resolvedByUserID"`
+	ResolvedAt       *time.Time        `json:"resolvedAt"`
+	CreatedAt        time.Time         `json:"createdAt"`
+}
+
+type PostReportEdge struct {
+	Cursor string      `json:"cursor"`
+	Node   *PostReport `json:"node"`
+}
+
+type PostReportConnection struct {
+	Edges    []*PostReportEdge `json:"edges"`
+	PageInfo PageInfo          `json:"pageInfo"`
+}
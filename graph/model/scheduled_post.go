@@ -0,0 +1,19 @@
+package model
+
+import "time"
+
+// ScheduledPost keeps the post input as it was submitted so it can be
+// published later through CreatePost.
+type ScheduledPost struct {
+	ID            string              `json:"id"`
+	DiscussionID  string              `json:"discussionID"`
+	ParticipantID string              `json:"participantID"`
+	UserID        string              `json:"userID"`
+	PostContent   PostContentInput    `json:"postContent"`
+	PublishAt     time.Time           `json:"publishAt"`
+	Status        ScheduledPostStatus `json:"status"`
+	PostID        *string             `json:"postID"`
+	FailureReason *string             `json:"failureReason"`
+	CreatedAt     time.Time           `json:"createdAt"`
+	UpdatedAt     time.Time           `json:"updatedAt"`
+}
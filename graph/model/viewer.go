@@ -19,6 +19,13 @@ type Viewer struct {
 	User   *User   `json:"user" dynamodbav:"-" gorm:"-"`
 }
 
+// Not exposed directly; backs the unread fields on Discussion and User.
+type DiscussionUnreadCount struct {
+	DiscussionID       string
+	UnreadCount        int
+	UnreadMentionCount int
+}
+
 type ViewersEdge struct {
 	Cursor string  `json:"cursor"`
 	Node   *Viewer `json:"node"`
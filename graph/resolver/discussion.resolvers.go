@@ -5,7 +5,6 @@ package resolver
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"math"
 	"strings"
@@ -43,20 +42,111 @@ func (r *discussionResolver) Posts(ctx context.Context, obj *model.Discussion) (
 	return posts, nil
 }
 
-func (r *discussionResolver) PostsConnection(ctx context.Context, obj *model.Discussion, after *string) (*model.PostsConnection, error) {
-	/* Hardcode the number of posts per page. This can be changed to be settable by the client in the query. */
+func (r *discussionResolver) PostsConnection(ctx context.Context, obj *model.Discussion, after *string, before *string, around *string, first *int, last *int, topLevelOnly *bool) (*model.PostsConnection, error) {
+	/* Defaults to the largest page when the client does not ask for a count. */
 	limit := backend.PostPerPageLimit
+	isTopLevelOnly := topLevelOnly != nil && *topLevelOnly
 
-	/* Sanity check. If no "after" parameter is specified, we set it to a time far into the future, for which
-	   no post can yet have been created (not even cosidering large clocks drift) */
-	if after == nil {
-		futureTime := time.Now().AddDate(1, 0, 0).Format(time.RFC3339Nano)
-		after = &futureTime
-	} else if _, err := time.Parse(time.RFC3339, *after); err != nil {
-		return nil, errors.New("The 'After' parameter is badly formatted: " + *after)
+	cursorCount := 0
+	for _, elem := range []*string{after, before, around} {
+		if elem != nil {
+			cursorCount++
+		}
+	}
+	if cursorCount > 1 {
+		return nil, fmt.Errorf("Only one of 'after', 'before' and 'around' can be set")
 	}
 
-	return r.DAOManager.GetPostsConnectionByDiscussionID(ctx, obj.ID, *after, limit)
+	switch {
+	case around != nil:
+		newerLimit, olderLimit := limit/2, limit/2
+		if last != nil {
+			newerLimit = *last
+		}
+		if first != nil {
+			olderLimit = *first
+		}
+		return r.DAOManager.GetPostsConnectionAroundPost(ctx, obj.ID, *around, newerLimit, olderLimit, isTopLevelOnly)
+	case before != nil || (after == nil && last != nil):
+		if first != nil {
+			return nil, fmt.Errorf("'first' cannot be used with 'before' or 'last'")
+		}
+		if last != nil {
+			limit = *last
+		}
+
+		cursor, err := postsConnectionBeforeCursor(before)
+		if err != nil {
+			return nil, err
+		}
+
+		return r.DAOManager.GetPostsConnectionByDiscussionIDBeforeCursor(ctx, obj.ID, cursor, limit, isTopLevelOnly)
+	default:
+		if last != nil {
+			return nil, fmt.Errorf("'last' cannot be used with 'after'")
+		}
+		if first != nil {
+			limit = *first
+		}
+
+		cursor, err := postsConnectionCursor(after)
+		if err != nil {
+			return nil, err
+		}
+
+		return r.DAOManager.GetPostsConnectionByDiscussionID(ctx, obj.ID, cursor, limit, isTopLevelOnly)
+	}
+}
+
+func (r *discussionResolver) PinnedPosts(ctx context.Context, obj *model.Discussion) ([]*model.Post, error) {
+	authedUser := auth.GetAuthedUser(ctx)
+	if authedUser == nil {
+		return nil, fmt.Errorf("Need auth")
+	}
+
+	return r.DAOManager.GetPinnedPostsByDiscussionID(ctx, obj.ID)
+}
+
+func (r *discussionResolver) SearchPosts(ctx context.Context, obj *model.Discussion, query string, after *string) (*model.PostsConnection, error) {
+	authedUser := auth.GetAuthedUser(ctx)
+	if authedUser == nil {
+		return nil, fmt.Errorf("Need auth")
+	}
+
+	cursor, err := postsConnectionCursor(after)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.DAOManager.SearchPostsByDiscussionID(ctx, obj.ID, query, cursor, backend.PostPerPageLimit)
+}
+
+func (r *discussionResolver) PostsByTag(ctx context.Context, obj *model.Discussion, tag string, after *string) (*model.PostsConnection, error) {
+	authedUser := auth.GetAuthedUser(ctx)
+	if authedUser == nil {
+		return nil, fmt.Errorf("Need auth")
+	}
+
+	cursor, err := postsConnectionCursor(after)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.DAOManager.GetPostsConnectionByTag(ctx, obj.ID, tag, cursor, backend.PostPerPageLimit)
+}
+
+func (r *discussionResolver) TopTags(ctx context.Context, obj *model.Discussion, limit *int) ([]*model.TagSummary, error) {
+	authedUser := auth.GetAuthedUser(ctx)
+	if authedUser == nil {
+		return nil, fmt.Errorf("Need auth")
+	}
+
+	tagLimit := backend.DefaultTopTagsLimit
+	if limit != nil {
+		tagLimit = *limit
+	}
+
+	return r.DAOManager.GetTopTagsByDiscussionID(ctx, obj.ID, tagLimit)
 }
 
 func (r *discussionResolver) Participants(ctx context.Context, obj *model.Discussion) ([]*model.Participant, error) {
@@ -192,6 +282,38 @@ func (r *discussionResolver) MeViewer(ctx context.Context, obj *model.Discussion
 	return r.DAOManager.GetViewerForDiscussion(ctx, obj.ID, authedUser.UserID, true)
 }
 
+func (r *discussionResolver) MeUnreadCount(ctx context.Context, obj *model.Discussion) (int, error) {
+	authedUser := auth.GetAuthedUser(ctx)
+	if authedUser == nil {
+		return 0, fmt.Errorf("Need auth")
+	}
+
+	counts, err := r.resolveMeUnreadCounts(ctx, authedUser.UserID)
+	if err != nil {
+		return 0, err
+	}
+	if count, ok := counts[obj.ID]; ok {
+		return count.UnreadCount, nil
+	}
+	return 0, nil
+}
+
+func (r *discussionResolver) MeUnreadMentionCount(ctx context.Context, obj *model.Discussion) (int, error) {
+	authedUser := auth.GetAuthedUser(ctx)
+	if authedUser == nil {
+		return 0, fmt.Errorf("Need auth")
+	}
+
+	counts, err := r.resolveMeUnreadCounts(ctx, authedUser.UserID)
+	if err != nil {
+		return 0, err
+	}
+	if count, ok := counts[obj.ID]; ok {
+		return count.UnreadMentionCount, nil
+	}
+	return 0, nil
+}
+
 func (r *discussionResolver) MeNotificationSettings(ctx context.Context, obj *model.Discussion) (*model.DiscussionUserNotificationSetting, error) {
 	authedUser := auth.GetAuthedUser(ctx)
 	if authedUser == nil {
@@ -225,6 +347,24 @@ func (r *discussionResolver) MeDiscussionStatus(ctx context.Context, obj *model.
 	return &resp.State, nil
 }
 
+func (r *discussionResolver) MeBanReason(ctx context.Context, obj *model.Discussion) (*string, error) {
+	authedUser := auth.GetAuthedUser(ctx)
+	if authedUser == nil {
+		return nil, nil
+	}
+
+	return r.DAOManager.GetBanReason(ctx, obj.ID, authedUser.UserID)
+}
+
+func (r *discussionResolver) MeModeratorRole(ctx context.Context, obj *model.Discussion) (*model.ModeratorRole, error) {
+	authedUser := auth.GetAuthedUser(ctx)
+	if authedUser == nil {
+		return nil, nil
+	}
+
+	return r.DAOManager.GetModeratorRole(ctx, authedUser.UserID, obj.ID)
+}
+
 func (r *discussionResolver) AccessRequests(ctx context.Context, obj *model.Discussion) ([]*model.DiscussionAccessRequest, error) {
 	authedUser := auth.GetAuthedUser(ctx)
 	if authedUser == nil {
@@ -240,6 +380,66 @@ func (r *discussionResolver) AccessRequests(ctx context.Context, obj *model.Disc
 	return r.DAOManager.GetDiscussionAccessRequestsByDiscussionID(ctx, obj.ID)
 }
 
+func (r *discussionResolver) ScheduledPosts(ctx context.Context, obj *model.Discussion) ([]*model.ScheduledPost, error) {
+	authedUser := auth.GetAuthedUser(ctx)
+	if authedUser == nil {
+		return nil, fmt.Errorf("Need auth")
+	}
+
+	// Only allow the mod to view scheduled posts
+	modCheck, err := r.DAOManager.CheckIfModeratorForDiscussion(ctx, authedUser.UserID, obj.ID)
+	if err != nil || !modCheck {
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	return r.DAOManager.GetPendingScheduledPostsByDiscussionID(ctx, obj.ID)
+}
+
+func (r *discussionResolver) ModerationLog(ctx context.Context, obj *model.Discussion, after *string) (*model.ModerationLogConnection, error) {
+	authedUser := auth.GetAuthedUser(ctx)
+	if authedUser == nil {
+		return nil, fmt.Errorf("Need auth")
+	}
+
+	// Only allow the mod to view the moderation log
+	modCheck, err := r.DAOManager.CheckIfModeratorForDiscussion(ctx, authedUser.UserID, obj.ID)
+	if err != nil || !modCheck {
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	cursor, err := postsConnectionCursor(after)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.DAOManager.GetModerationLogConnectionByDiscussionID(ctx, obj.ID, cursor, backend.PostPerPageLimit)
+}
+
+func (r *discussionResolver) Reports(ctx context.Context, obj *model.Discussion, status *model.PostReportStatus, after *string) (*model.PostReportConnection, error) {
+	authedUser := auth.GetAuthedUser(ctx)
+	if authedUser == nil {
+		return nil, fmt.Errorf("Need auth")
+	}
+
+	/* Helpers and above can review reports */
+	modCheck, err := r.DAOManager.CheckModeratorRole(ctx, authedUser.UserID, obj.ID, model.ModeratorRoleHelper)
+	if err != nil || !modCheck {
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	reportStatus := model.PostReportStatusOpen
+	if status != nil {
+		reportStatus = *status
+	}
+
+	cursor, err := postsConnectionCursor(after)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.DAOManager.GetPostReportsConnectionByDiscussionID(ctx, obj.ID, reportStatus, cursor, backend.PostPerPageLimit)
+}
+
 func (r *discussionResolver) DiscussionAccessLink(ctx context.Context, obj *model.Discussion) (*model.DiscussionAccessLink, error) {
 	authedUser := auth.GetAuthedUser(ctx)
 	if authedUser == nil {
@@ -278,6 +478,14 @@ func (r *discussionResolver) SecondsUntilShuffle(ctx context.Context, obj *model
 	return &seconds, nil
 }
 
+func (r *discussionResolver) EveryoneMentionPolicy(ctx context.Context, obj *model.Discussion) (model.EveryoneMentionPolicy, error) {
+	if string(obj.EveryoneMentionPolicy) == "" {
+		return model.EveryoneMentionPolicyModerators, nil
+	}
+
+	return obj.EveryoneMentionPolicy, nil
+}
+
 func (r *discussionResolver) Archive(ctx context.Context, obj *model.Discussion) (*model.DiscussionArchive, error) {
 	return r.DAOManager.GetDiscussionArchiveByDiscussionID(ctx, obj.ID)
 }
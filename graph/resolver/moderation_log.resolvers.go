@@ -0,0 +1,22 @@
+package resolver
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+
+import (
+	"context"
+
+	"github.com/delphis-inc/delphisbe/graph/generated"
+	"github.com/delphis-inc/delphisbe/graph/model"
+)
+
+func (r *moderationLogEntryResolver) Actor(ctx context.Context, obj *model.ModerationLogEntry) (*model.UserProfile, error) {
+	return r.DAOManager.GetUserProfileByUserID(ctx, obj.ActorUserID)
+}
+
+// ModerationLogEntry returns generated.ModerationLogEntryResolver implementation.
+func (r *Resolver) ModerationLogEntry() generated.ModerationLogEntryResolver {
+	return &moderationLogEntryResolver{r}
+}
+
+type moderationLogEntryResolver struct{ *Resolver }
@@ -10,6 +10,7 @@ import (
 
 	"github.com/delphis-inc/delphisbe/graph/generated"
 	"github.com/delphis-inc/delphisbe/graph/model"
+	"github.com/delphis-inc/delphisbe/internal/auth"
 	"github.com/delphis-inc/delphisbe/internal/util"
 )
 
@@ -166,6 +167,28 @@ func (r *participantResolver) MutedForSeconds(ctx context.Context, obj *model.Pa
 	return &result, nil
 }
 
+func (r *participantResolver) MeSecondsUntilCanPost(ctx context.Context, obj *model.Participant) (*int, error) {
+	authedUser := auth.GetAuthedUser(ctx)
+	if authedUser == nil || obj.UserID == nil || *obj.UserID != authedUser.UserID || obj.DiscussionID == nil {
+		return nil, nil
+	}
+
+	discussion, err := r.resolveDiscussionByID(ctx, *obj.DiscussionID)
+	if err != nil || discussion == nil {
+		return nil, err
+	}
+
+	seconds, err := r.DAOManager.GetSecondsUntilCanPost(ctx, discussion, authedUser.UserID)
+	if err != nil {
+		return nil, err
+	}
+	return &seconds, nil
+}
+
+func (r *participantResolver) ModeratorRole(ctx context.Context, obj *model.Participant) (*model.ModeratorRole, error) {
+	return r.DAOManager.GetParticipantModeratorRole(ctx, obj)
+}
+
 // Participant returns generated.ParticipantResolver implementation.
 func (r *Resolver) Participant() generated.ParticipantResolver { return &participantResolver{r} }
 
@@ -0,0 +1,28 @@
+package resolver
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+
+import (
+	"context"
+
+	"github.com/delphis-inc/delphisbe/graph/generated"
+	"github.com/delphis-inc/delphisbe/graph/model"
+)
+
+func (r *pollResolver) IsClosed(ctx context.Context, obj *model.Poll) (bool, error) {
+	return r.DAOManager.IsPollClosed(obj), nil
+}
+
+func (r *pollOptionResolver) Voters(ctx context.Context, obj *model.PollOption) ([]*model.Participant, error) {
+	return r.DAOManager.GetPollOptionVoters(ctx, *obj)
+}
+
+// Poll returns generated.PollResolver implementation.
+func (r *Resolver) Poll() generated.PollResolver { return &pollResolver{r} }
+
+// PollOption returns generated.PollOptionResolver implementation.
+func (r *Resolver) PollOption() generated.PollOptionResolver { return &pollOptionResolver{r} }
+
+type pollResolver struct{ *Resolver }
+type pollOptionResolver struct{ *Resolver }
@@ -0,0 +1,38 @@
+package resolver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/delphis-inc/delphisbe/graph/model"
+	"github.com/delphis-inc/delphisbe/internal/backend/test_utils"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestPollResolver_IsClosed(t *testing.T) {
+	ctx := context.Background()
+	pollObj := model.Poll{PostID: test_utils.PostID}
+
+	Convey("IsClosed", t, func() {
+		backendObj := &mockBackend{}
+		resolverObj := &pollResolver{&Resolver{DAOManager: backendObj}}
+
+		Convey("when the backend reports the poll closed", func() {
+			backendObj.On("IsPollClosed", &pollObj).Return(true)
+
+			resp, err := resolverObj.IsClosed(ctx, &pollObj)
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldBeTrue)
+		})
+
+		Convey("when the backend reports the poll open", func() {
+			backendObj.On("IsPollClosed", &pollObj).Return(false)
+
+			resp, err := resolverObj.IsClosed(ctx, &pollObj)
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldBeFalse)
+		})
+	})
+}
@@ -9,6 +9,8 @@ import (
 
 	"github.com/delphis-inc/delphisbe/graph/generated"
 	"github.com/delphis-inc/delphisbe/graph/model"
+	"github.com/delphis-inc/delphisbe/internal/backend"
+	"github.com/delphis-inc/delphisbe/internal/markdown"
 	"github.com/sirupsen/logrus"
 )
 
@@ -32,6 +34,16 @@ func (r *postResolver) Content(ctx context.Context, obj *model.Post) (*string, e
 	return &obj.PostContent.Content, nil
 }
 
+func (r *postResolver) ContentHTML(ctx context.Context, obj *model.Post) (*string, error) {
+	content, err := r.Content(ctx, obj)
+	if err != nil || content == nil {
+		return nil, err
+	}
+
+	contentHTML := markdown.ToHTML(*content)
+	return &contentHTML, nil
+}
+
 func (r *postResolver) Discussion(ctx context.Context, obj *model.Post) (*model.Discussion, error) {
 	if obj.Discussion == nil && obj.DiscussionID != nil {
 		res, err := r.DAOManager.GetDiscussionByID(ctx, *obj.DiscussionID)
@@ -65,6 +77,22 @@ func (r *postResolver) UpdatedAt(ctx context.Context, obj *model.Post) (string,
 	return obj.UpdatedAt.Format(time.RFC3339), nil
 }
 
+func (r *postResolver) QuotedPost(ctx context.Context, obj *model.Post) (*model.Post, error) {
+	// Posts deleted by a ban keep their quote so unbanning can restore it
+	if obj.DeletedAt != nil {
+		return nil, nil
+	}
+
+	if obj.QuotedPost == nil && obj.QuotedPostID != nil && obj.DiscussionID != nil {
+		quotedPost, err := r.DAOManager.GetPostByDiscussionPostID(ctx, *obj.DiscussionID, *obj.QuotedPostID)
+		if err != nil {
+			return nil, err
+		}
+		obj.QuotedPost = quotedPost
+	}
+	return obj.QuotedPost, nil
+}
+
 func (r *postResolver) MentionedEntities(ctx context.Context, obj *model.Post) ([]model.Entity, error) {
 	if obj.DeletedAt != nil {
 		return nil, nil
@@ -92,6 +120,14 @@ func (r *postResolver) MentionedEntities(ctx context.Context, obj *model.Post) (
 	return entities, nil
 }
 
+func (r *postResolver) Tags(ctx context.Context, obj *model.Post) ([]string, error) {
+	if obj.DeletedAt != nil || obj.PostContent == nil {
+		return []string{}, nil
+	}
+
+	return obj.PostContent.Tags(), nil
+}
+
 func (r *postResolver) Media(ctx context.Context, obj *model.Post) (*model.Media, error) {
 	if obj.DeletedAt != nil {
 		return nil, nil
@@ -103,6 +139,77 @@ func (r *postResolver) Media(ctx context.Context, obj *model.Post) (*model.Media
 	return nil, nil
 }
 
+func (r *postResolver) IsEdited(ctx context.Context, obj *model.Post) (bool, error) {
+	history, err := r.EditHistory(ctx, obj)
+	if err != nil {
+		return false, err
+	}
+	return len(history) > 0, nil
+}
+
+func (r *postResolver) EditHistory(ctx context.Context, obj *model.Post) ([]*model.HistoricalString, error) {
+	if obj.DeletedAt != nil {
+		return []*model.HistoricalString{}, nil
+	}
+	return obj.EditHistoryAsObject()
+}
+
+func (r *postResolver) Reactions(ctx context.Context, obj *model.Post) ([]*model.PostReactionSummary, error) {
+	if obj.DeletedAt != nil || obj.DiscussionID == nil {
+		return []*model.PostReactionSummary{}, nil
+	}
+
+	var meParticipantID *string
+	meParticipant, err := r.resolveMeParticipant(ctx, *obj.DiscussionID)
+	if err != nil {
+		return nil, err
+	}
+	if meParticipant != nil {
+		meParticipantID = &meParticipant.ID
+	}
+
+	return r.DAOManager.GetPostReactionSummaries(ctx, obj.ID, meParticipantID)
+}
+
+func (r *postResolver) IsPinned(ctx context.Context, obj *model.Post) (bool, error) {
+	return obj.PinnedAt != nil && obj.DeletedAt == nil, nil
+}
+
+func (r *postResolver) Poll(ctx context.Context, obj *model.Post) (*model.Poll, error) {
+	if obj.PostType != model.PostTypePoll || obj.DeletedAt != nil || obj.DiscussionID == nil {
+		return nil, nil
+	}
+
+	// Votes are shared between the viewer's participants
+	meParticipantIDs, err := r.resolveMeParticipantIDs(ctx, *obj.DiscussionID)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.DAOManager.GetPollByPostID(ctx, obj.ID, meParticipantIDs)
+}
+
+func (r *postResolver) LinkPreviews(ctx context.Context, obj *model.Post) ([]*model.LinkPreview, error) {
+	// Deleted content is tombstoned, so its links are too
+	if obj.DeletedAt != nil {
+		return []*model.LinkPreview{}, nil
+	}
+	return r.DAOManager.GetLinkPreviewsByPostID(ctx, obj.ID)
+}
+
+func (r *postResolver) ReplyCount(ctx context.Context, obj *model.Post) (int, error) {
+	return r.DAOManager.GetPostReplyCount(ctx, obj.ID)
+}
+
+func (r *postResolver) RepliesConnection(ctx context.Context, obj *model.Post, after *string) (*model.PostsConnection, error) {
+	cursor, err := postsConnectionCursor(after)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.DAOManager.GetPostRepliesConnection(ctx, obj.ID, cursor, backend.PostPerPageLimit)
+}
+
 // Post returns generated.PostResolver implementation.
 func (r *Resolver) Post() generated.PostResolver { return &postResolver{r} }
 
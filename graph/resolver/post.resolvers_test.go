@@ -0,0 +1,68 @@
+package resolver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/delphis-inc/delphisbe/graph/model"
+	"github.com/delphis-inc/delphisbe/internal/auth"
+	"github.com/delphis-inc/delphisbe/internal/backend"
+	"github.com/delphis-inc/delphisbe/internal/backend/test_utils"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestPostResolver_Poll(t *testing.T) {
+	discussionID := test_utils.DiscussionID
+	authedUser := test_utils.TestDelphisAuthedUser()
+
+	Convey("Poll", t, func() {
+		// A fresh operation cache per case, since meParticipantIDs are memoized
+		ctx := GenerateCachedOperationContext(auth.WithAuthedUser(context.Background(), &authedUser))
+		backendObj := &mockBackend{}
+		resolverObj := &postResolver{&Resolver{DAOManager: backendObj}}
+
+		postObj := test_utils.TestPost()
+		postObj.PostType = model.PostTypePoll
+		nonAnonObj := test_utils.TestParticipant()
+		anonObj := test_utils.TestParticipant()
+		anonObj.ID = "anonParticipantID"
+		anonObj.IsAnonymous = true
+		pollObj := model.Poll{
+			PostID:  postObj.ID,
+			Options: []*model.PollOption{{ID: "option1", PostID: postObj.ID, Text: "yes", VoteCount: 1, MeVoted: true}},
+		}
+
+		Convey("when the post is not a poll", func() {
+			postObj.PostType = model.PostTypeStandard
+
+			resp, err := resolverObj.Poll(ctx, &postObj)
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		Convey("when the viewer has both participants, votes by either count", func() {
+			backendObj.On("GetParticipantsByDiscussionIDUserID", ctx, discussionID, authedUser.UserID).Return(&backend.UserDiscussionParticipants{
+				Anon:    &anonObj,
+				NonAnon: &nonAnonObj,
+			}, nil)
+			backendObj.On("GetPollByPostID", ctx, postObj.ID, []string{nonAnonObj.ID, anonObj.ID}).Return(&pollObj, nil)
+
+			resp, err := resolverObj.Poll(ctx, &postObj)
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldEqual, &pollObj)
+			backendObj.AssertCalled(t, "GetPollByPostID", ctx, postObj.ID, []string{nonAnonObj.ID, anonObj.ID})
+		})
+
+		Convey("when the viewer has no participants", func() {
+			backendObj.On("GetParticipantsByDiscussionIDUserID", ctx, discussionID, authedUser.UserID).Return(&backend.UserDiscussionParticipants{}, nil)
+			backendObj.On("GetPollByPostID", ctx, postObj.ID, []string{}).Return(&pollObj, nil)
+
+			resp, err := resolverObj.Poll(ctx, &postObj)
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldEqual, &pollObj)
+		})
+	})
+}
@@ -0,0 +1,39 @@
+package resolver
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+
+import (
+	"context"
+
+	"github.com/delphis-inc/delphisbe/graph/generated"
+	"github.com/delphis-inc/delphisbe/graph/model"
+	"github.com/delphis-inc/delphisbe/internal/auth"
+)
+
+func (r *postReportResolver) Post(ctx context.Context, obj *model.PostReport) (*model.Post, error) {
+	return r.DAOManager.GetPostByDiscussionPostID(ctx, obj.DiscussionID, obj.PostID)
+}
+
+func (r *postReportResolver) ResolvedBy(ctx context.Context, obj *model.PostReport) (*model.UserProfile, error) {
+	if obj.ResolvedByUserID == nil {
+		return nil, nil
+	}
+
+	// Reporters can see their report but not who resolved it
+	authedUser := auth.GetAuthedUser(ctx)
+	if authedUser == nil {
+		return nil, nil
+	}
+	modCheck, err := r.DAOManager.CheckModeratorRole(ctx, authedUser.UserID, obj.DiscussionID, model.ModeratorRoleHelper)
+	if err != nil || !modCheck {
+		return nil, nil
+	}
+
+	return r.DAOManager.GetUserProfileByUserID(ctx, *obj.ResolvedByUserID)
+}
+
+// PostReport returns generated.PostReportResolver implementation.
+func (r *Resolver) PostReport() generated.PostReportResolver { return &postReportResolver{r} }
+
+type postReportResolver struct{ *Resolver }
@@ -5,6 +5,7 @@ package resolver
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -18,6 +19,10 @@ type resolverKeyType string
 
 const cachedValueKey resolverKeyType = "operationCacheKey"
 
+// How many live discussion events a subscription can hold while its replay
+// is still being sent before the backend drops it as a slow subscriber.
+const discussionEventLiveBufferSize = 64
+
 type Resolver struct {
 	DAOManager backend.DelphisBackend
 }
@@ -62,3 +67,132 @@ func (r *Resolver) resolveDiscussionByID(ctx context.Context, id string) (*model
 
 	return discussionObj, nil
 }
+
+func MeParticipantCacheKey(discussionID string) string {
+	return fmt.Sprintf("me-participant-%s", discussionID)
+}
+
+// resolveMeParticipant memoizes meParticipant for the operation since it is
+// needed for every post in a discussion page.
+func (r *Resolver) resolveMeParticipant(ctx context.Context, discussionID string) (*model.Participant, error) {
+	inMemoryCache := GetOperationCache(ctx)
+	if inMemoryCache != nil {
+		if resp, found := inMemoryCache.Get(MeParticipantCacheKey(discussionID)); found {
+			return resp.(*model.Participant), nil
+		}
+	}
+
+	participant, err := r.Discussion().MeParticipant(ctx, &model.Discussion{ID: discussionID})
+	if err != nil {
+		return nil, err
+	}
+
+	if inMemoryCache != nil {
+		inMemoryCache.Set(MeParticipantCacheKey(discussionID), participant, time.Minute)
+	}
+
+	return participant, nil
+}
+
+func MeParticipantIDsCacheKey(discussionID string) string {
+	return fmt.Sprintf("me-participant-ids-%s", discussionID)
+}
+
+// resolveMeParticipantIDs returns the IDs of both the viewer's anonymous and
+// non-anonymous participants, memoized like resolveMeParticipant.
+func (r *Resolver) resolveMeParticipantIDs(ctx context.Context, discussionID string) ([]string, error) {
+	inMemoryCache := GetOperationCache(ctx)
+	if inMemoryCache != nil {
+		if resp, found := inMemoryCache.Get(MeParticipantIDsCacheKey(discussionID)); found {
+			return resp.([]string), nil
+		}
+	}
+
+	participants, err := r.Discussion().MeAvailableParticipants(ctx, &model.Discussion{ID: discussionID})
+	if err != nil {
+		return nil, err
+	}
+
+	participantIDs := make([]string, 0, len(participants))
+	for _, participant := range participants {
+		participantIDs = append(participantIDs, participant.ID)
+	}
+
+	if inMemoryCache != nil {
+		inMemoryCache.Set(MeParticipantIDsCacheKey(discussionID), participantIDs, time.Minute)
+	}
+
+	return participantIDs, nil
+}
+
+// resolveReactingParticipant checks that the participant belongs to the
+// authed user in an unlocked discussion and is allowed to react.
+func (r *Resolver) resolveReactingParticipant(ctx context.Context, discussionID string, participantID string, userID string) (*model.Participant, error) {
+	discussion, err := r.DAOManager.GetDiscussionByID(ctx, discussionID)
+	if discussion == nil || err != nil || discussion.LockStatus == true {
+		return nil, fmt.Errorf("Discussion with ID %s not found", discussionID)
+	}
+
+	participant, err := r.DAOManager.GetParticipantByID(ctx, participantID)
+	if err != nil {
+		return nil, err
+	} else if participant == nil {
+		return nil, fmt.Errorf("Could not find Participant with ID %s", participantID)
+	} else if participant.IsBanned {
+		return nil, fmt.Errorf("Banned")
+	}
+
+	if participant.UserID == nil || *participant.UserID != userID || participant.DiscussionID == nil || *participant.DiscussionID != discussionID {
+		return nil, fmt.Errorf("Unauthorized")
+	}
+
+	return participant, nil
+}
+
+func MeUnreadCountsCacheKey(userID string) string {
+	return fmt.Sprintf("me-unread-counts-%s", userID)
+}
+
+// resolveMeUnreadCounts loads the unread counts of every discussion in one query
+// and memoizes them so a discussion list does not query once per discussion.
+func (r *Resolver) resolveMeUnreadCounts(ctx context.Context, userID string) (map[string]*model.DiscussionUnreadCount, error) {
+	inMemoryCache := GetOperationCache(ctx)
+	if inMemoryCache != nil {
+		if resp, found := inMemoryCache.Get(MeUnreadCountsCacheKey(userID)); found {
+			return resp.(map[string]*model.DiscussionUnreadCount), nil
+		}
+	}
+
+	counts, err := r.DAOManager.GetUnreadCountsByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if inMemoryCache != nil {
+		inMemoryCache.Set(MeUnreadCountsCacheKey(userID), counts, time.Minute)
+	}
+
+	return counts, nil
+}
+
+// Sanity check. If no "after" parameter is specified, we set it to a time far into the future, for which
+// no post can yet have been created (not even considering large clock drift).
+func postsConnectionCursor(after *string) (string, error) {
+	if after == nil {
+		return time.Now().AddDate(1, 0, 0).Format(time.RFC3339Nano), nil
+	} else if _, err := time.Parse(time.RFC3339, *after); err != nil {
+		return "", errors.New("The 'After' parameter is badly formatted: " + *after)
+	}
+	return *after, nil
+}
+
+// Mirror of postsConnectionCursor. Without a "before" parameter we page forwards from the
+// very first post, matching "last" without a cursor in the Relay spec.
+func postsConnectionBeforeCursor(before *string) (string, error) {
+	if before == nil {
+		return time.Time{}.Format(time.RFC3339Nano), nil
+	} else if _, err := time.Parse(time.RFC3339, *before); err != nil {
+		return "", errors.New("The 'Before' parameter is badly formatted: " + *before)
+	}
+	return *before, nil
+}
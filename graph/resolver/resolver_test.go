@@ -0,0 +1,44 @@
+package resolver
+
+import (
+	"context"
+
+	"github.com/delphis-inc/delphisbe/graph/model"
+	"github.com/delphis-inc/delphisbe/internal/backend"
+	"github.com/stretchr/testify/mock"
+)
+
+// mockBackend stubs only the backend calls a test makes. The mocks package
+// cannot hold a DelphisBackend mock since the backend's own tests import it.
+type mockBackend struct {
+	backend.DelphisBackend
+	mock.Mock
+}
+
+func (m *mockBackend) GetPostingParticipant(ctx context.Context, discussionID string, participantID string, userID string) (*model.Participant, error) {
+	ret := m.Called(ctx, discussionID, participantID, userID)
+	participant, _ := ret.Get(0).(*model.Participant)
+	return participant, ret.Error(1)
+}
+
+func (m *mockBackend) CreatePost(ctx context.Context, discussionID string, userID string, participantID string, input model.PostContentInput) (*model.Post, error) {
+	ret := m.Called(ctx, discussionID, userID, participantID, input)
+	post, _ := ret.Get(0).(*model.Post)
+	return post, ret.Error(1)
+}
+
+func (m *mockBackend) GetParticipantsByDiscussionIDUserID(ctx context.Context, discussionID string, userID string) (*backend.UserDiscussionParticipants, error) {
+	ret := m.Called(ctx, discussionID, userID)
+	participants, _ := ret.Get(0).(*backend.UserDiscussionParticipants)
+	return participants, ret.Error(1)
+}
+
+func (m *mockBackend) GetPollByPostID(ctx context.Context, postID string, participantIDs []string) (*model.Poll, error) {
+	ret := m.Called(ctx, postID, participantIDs)
+	poll, _ := ret.Get(0).(*model.Poll)
+	return poll, ret.Error(1)
+}
+
+func (m *mockBackend) IsPollClosed(poll *model.Poll) bool {
+	return m.Called(poll).Bool(0)
+}
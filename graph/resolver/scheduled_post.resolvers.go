@@ -0,0 +1,39 @@
+package resolver
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+
+import (
+	"context"
+
+	"github.com/delphis-inc/delphisbe/graph/generated"
+	"github.com/delphis-inc/delphisbe/graph/model"
+)
+
+func (r *scheduledPostResolver) Discussion(ctx context.Context, obj *model.ScheduledPost) (*model.Discussion, error) {
+	return r.DAOManager.GetDiscussionByID(ctx, obj.DiscussionID)
+}
+
+func (r *scheduledPostResolver) Participant(ctx context.Context, obj *model.ScheduledPost) (*model.Participant, error) {
+	return r.DAOManager.GetParticipantByID(ctx, obj.ParticipantID)
+}
+
+func (r *scheduledPostResolver) Content(ctx context.Context, obj *model.ScheduledPost) (string, error) {
+	return obj.PostContent.PostText, nil
+}
+
+func (r *scheduledPostResolver) PostType(ctx context.Context, obj *model.ScheduledPost) (model.PostType, error) {
+	return obj.PostContent.PostType, nil
+}
+
+func (r *scheduledPostResolver) Post(ctx context.Context, obj *model.ScheduledPost) (*model.Post, error) {
+	if obj.PostID == nil {
+		return nil, nil
+	}
+	return r.DAOManager.GetPostByDiscussionPostID(ctx, obj.DiscussionID, *obj.PostID)
+}
+
+// ScheduledPost returns generated.ScheduledPostResolver implementation.
+func (r *Resolver) ScheduledPost() generated.ScheduledPostResolver { return &scheduledPostResolver{r} }
+
+type scheduledPostResolver struct{ *Resolver }
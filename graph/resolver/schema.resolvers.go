@@ -11,6 +11,8 @@ import (
 	"github.com/delphis-inc/delphisbe/graph/generated"
 	"github.com/delphis-inc/delphisbe/graph/model"
 	"github.com/delphis-inc/delphisbe/internal/auth"
+	"github.com/delphis-inc/delphisbe/internal/backend"
+	"github.com/delphis-inc/delphisbe/internal/util"
 	"github.com/sirupsen/logrus"
 )
 
@@ -109,31 +111,19 @@ func (r *mutationResolver) AddPost(ctx context.Context, discussionID string, par
 		return nil, fmt.Errorf("Need auth")
 	}
 
-	// Note: This is here mainly to ensure the discussion is not (soft) deleted
-	discussion, err := r.DAOManager.GetDiscussionByID(ctx, discussionID)
-	if discussion == nil || err != nil || discussion.LockStatus == true {
-		return nil, fmt.Errorf("Discussion with ID %s not found", discussionID)
-	}
-
-	//participant, err := r.DAOManager.GetParticipantByDiscussionIDUserID(ctx, discussionID, authedUser.UserID)
-	participant, err := r.DAOManager.GetParticipantByID(ctx, participantID)
+	participant, err := r.DAOManager.GetPostingParticipant(ctx, discussionID, participantID, authedUser.UserID)
 	if err != nil {
 		return nil, err
-	} else if participant == nil {
-		return nil, fmt.Errorf("Could not find Participant with ID %s", participantID)
-	} else if participant.IsBanned {
-		return nil, fmt.Errorf("Banned")
-	} else if participant.MutedUntil != nil && participant.MutedUntil.After(time.Now()) {
-		return nil, fmt.Errorf("This participant is muted")
-	}
-
-	// Verify that the posting participant belongs to the logged-in user
-	if *participant.UserID != authedUser.UserID || *participant.DiscussionID != discussionID {
-		return nil, fmt.Errorf("Unauthorized")
 	}
 
 	createdPost, err := r.DAOManager.CreatePost(ctx, discussionID, authedUser.UserID, participant.ID, postContent)
 	if err != nil {
+		// Clients need to know which mention was rejected or how long slow
+		// mode has left
+		switch err.(type) {
+		case *backend.MentionError, *backend.SlowModeError:
+			return nil, err
+		}
 		return nil, fmt.Errorf("Failed to create post")
 	}
 
@@ -249,7 +239,7 @@ func (r *mutationResolver) UpdateDiscussion(ctx context.Context, discussionID st
 		return nil, fmt.Errorf("Discussion with ID %s not found", discussionID)
 	}
 
-	return r.DAOManager.UpdateDiscussion(ctx, discussionID, input)
+	return r.DAOManager.UpdateDiscussion(ctx, discussionID, authedUser.UserID, input)
 }
 
 func (r *mutationResolver) UpdateDiscussionUserSettings(ctx context.Context, discussionID string, settings model.DiscussionUserSettings) (*model.DiscussionUserAccess, error) {
@@ -324,7 +314,7 @@ func (r *mutationResolver) RespondToRequestAccess(ctx context.Context, requestID
 		nonAnonParticipantID = participantResponse.NonAnon.ID
 	}
 
-	return r.DAOManager.RespondToRequestAccess(ctx, requestID, response, nonAnonParticipantID)
+	return r.DAOManager.RespondToRequestAccess(ctx, requestID, response, nonAnonParticipantID, authedUser.UserID)
 }
 
 func (r *mutationResolver) DeletePost(ctx context.Context, discussionID string, postID string) (*model.Post, error) {
@@ -353,19 +343,213 @@ func (r *mutationResolver) DeletePost(ctx context.Context, discussionID string,
 	return deletedPost, nil
 }
 
-func (r *mutationResolver) BanParticipant(ctx context.Context, discussionID string, participantID string) (*model.Participant, error) {
+func (r *mutationResolver) EditPost(ctx context.Context, discussionID string, postID string, postContent model.PostContentInput) (*model.Post, error) {
+	authedUser := auth.GetAuthedUser(ctx)
+	if authedUser == nil {
+		return nil, fmt.Errorf("Need auth")
+	}
+
+	editedPost, err := r.DAOManager.EditPost(ctx, discussionID, postID, authedUser.UserID, postContent)
+	if err != nil {
+		return nil, err
+	}
+
+	return editedPost, nil
+}
+
+func (r *mutationResolver) SchedulePost(ctx context.Context, discussionID string, participantID string, postContent model.PostContentInput, publishAt time.Time) (*model.ScheduledPost, error) {
+	authedUser := auth.GetAuthedUser(ctx)
+	if authedUser == nil {
+		return nil, fmt.Errorf("Need auth")
+	}
+
+	// Only allow the mod to schedule posts
+	modCheck, err := r.DAOManager.CheckIfModeratorForDiscussion(ctx, authedUser.UserID, discussionID)
+	if err != nil || !modCheck {
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	return r.DAOManager.SchedulePost(ctx, authedUser.UserID, discussionID, participantID, postContent, publishAt)
+}
+
+func (r *mutationResolver) CancelScheduledPost(ctx context.Context, scheduledPostID string) (*model.ScheduledPost, error) {
+	authedUser := auth.GetAuthedUser(ctx)
+	if authedUser == nil {
+		return nil, fmt.Errorf("Need auth")
+	}
+
+	scheduledPost, err := r.DAOManager.GetScheduledPostByID(ctx, scheduledPostID)
+	if err != nil || scheduledPost == nil {
+		return nil, fmt.Errorf("Scheduled post with ID %s not found", scheduledPostID)
+	}
+
+	// Only allow the mod to cancel scheduled posts
+	modCheck, err := r.DAOManager.CheckIfModeratorForDiscussion(ctx, authedUser.UserID, scheduledPost.DiscussionID)
+	if err != nil || !modCheck {
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	return r.DAOManager.CancelScheduledPost(ctx, scheduledPostID)
+}
+
+func (r *mutationResolver) AddReaction(ctx context.Context, discussionID string, participantID string, postID string, reaction string) (*model.Post, error) {
+	authedUser := auth.GetAuthedUser(ctx)
+	if authedUser == nil {
+		return nil, fmt.Errorf("Need auth")
+	}
+
+	participant, err := r.resolveReactingParticipant(ctx, discussionID, participantID, authedUser.UserID)
+	if err != nil {
+		return nil, err
+	} else if participant.MutedUntil != nil && participant.MutedUntil.After(time.Now()) {
+		return nil, fmt.Errorf("This participant is muted")
+	}
+
+	return r.DAOManager.AddPostReaction(ctx, discussionID, participant.ID, postID, reaction)
+}
+
+func (r *mutationResolver) RemoveReaction(ctx context.Context, discussionID string, participantID string, postID string, reaction string) (*model.Post, error) {
+	authedUser := auth.GetAuthedUser(ctx)
+	if authedUser == nil {
+		return nil, fmt.Errorf("Need auth")
+	}
+
+	participant, err := r.resolveReactingParticipant(ctx, discussionID, participantID, authedUser.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.DAOManager.RemovePostReaction(ctx, discussionID, participant.ID, postID, reaction)
+}
+
+func (r *mutationResolver) VotePoll(ctx context.Context, discussionID string, participantID string, postID string, optionIDs []string) (*model.Post, error) {
+	authedUser := auth.GetAuthedUser(ctx)
+	if authedUser == nil {
+		return nil, fmt.Errorf("Need auth")
+	}
+
+	participant, err := r.resolveReactingParticipant(ctx, discussionID, participantID, authedUser.UserID)
+	if err != nil {
+		return nil, err
+	} else if participant.MutedUntil != nil && participant.MutedUntil.After(time.Now()) {
+		return nil, fmt.Errorf("This participant is muted")
+	}
+
+	return r.DAOManager.VotePoll(ctx, discussionID, participant.ID, postID, optionIDs)
+}
+
+func (r *mutationResolver) PinPost(ctx context.Context, discussionID string, postID string) (*model.Post, error) {
+	authedUser := auth.GetAuthedUser(ctx)
+	if authedUser == nil {
+		return nil, fmt.Errorf("Need auth")
+	}
+
+	// Only allow the mod to change pins
+	modCheck, err := r.DAOManager.CheckIfModeratorForDiscussion(ctx, authedUser.UserID, discussionID)
+	if err != nil || !modCheck {
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	// Note: This is here mainly to ensure the discussion is not (soft) deleted
+	discussion, err := r.DAOManager.GetDiscussionByID(ctx, discussionID)
+	if discussion == nil || err != nil || discussion.LockStatus == true {
+		return nil, fmt.Errorf("Discussion with ID %s not found", discussionID)
+	}
+
+	return r.DAOManager.PinPost(ctx, discussionID, postID, authedUser.UserID)
+}
+
+func (r *mutationResolver) UnpinPost(ctx context.Context, discussionID string, postID string) (*model.Post, error) {
 	authedUser := auth.GetAuthedUser(ctx)
 	if authedUser == nil {
 		return nil, fmt.Errorf("Need auth")
 	}
 
+	// Only allow the mod to change pins
+	modCheck, err := r.DAOManager.CheckIfModeratorForDiscussion(ctx, authedUser.UserID, discussionID)
+	if err != nil || !modCheck {
+		return nil, fmt.Errorf("unauthorized")
+	}
+
 	// Note: This is here mainly to ensure the discussion is not (soft) deleted
 	discussion, err := r.DAOManager.GetDiscussionByID(ctx, discussionID)
 	if discussion == nil || err != nil || discussion.LockStatus == true {
 		return nil, fmt.Errorf("Discussion with ID %s not found", discussionID)
 	}
 
-	bannedParticipant, err := r.DAOManager.BanParticipant(ctx, discussionID, participantID, authedUser.UserID)
+	return r.DAOManager.UnpinPost(ctx, discussionID, postID, authedUser.UserID)
+}
+
+func (r *mutationResolver) BookmarkPost(ctx context.Context, discussionID string, postID string) (*model.Post, error) {
+	authedUser := auth.GetAuthedUser(ctx)
+	if authedUser == nil {
+		return nil, fmt.Errorf("Need auth")
+	}
+
+	return r.DAOManager.BookmarkPost(ctx, authedUser.UserID, discussionID, postID)
+}
+
+func (r *mutationResolver) UnbookmarkPost(ctx context.Context, discussionID string, postID string) (*model.Post, error) {
+	authedUser := auth.GetAuthedUser(ctx)
+	if authedUser == nil {
+		return nil, fmt.Errorf("Need auth")
+	}
+
+	return r.DAOManager.UnbookmarkPost(ctx, authedUser.UserID, discussionID, postID)
+}
+
+func (r *mutationResolver) ReportPost(ctx context.Context, discussionID string, postID string, reason model.PostReportReason, note *string) (*model.PostReport, error) {
+	authedUser := auth.GetAuthedUser(ctx)
+	if authedUser == nil {
+		return nil, fmt.Errorf("Need auth")
+	}
+
+	return r.DAOManager.ReportPost(ctx, discussionID, postID, authedUser.UserID, reason, note)
+}
+
+func (r *mutationResolver) DismissPostReport(ctx context.Context, discussionID string, reportID string) (*model.PostReport, error) {
+	authedUser := auth.GetAuthedUser(ctx)
+	if authedUser == nil {
+		return nil, fmt.Errorf("Need auth")
+	}
+
+	// Note: This is here mainly to ensure the discussion is not (soft) deleted
+	discussion, err := r.DAOManager.GetDiscussionByID(ctx, discussionID)
+	if discussion == nil || err != nil {
+		return nil, fmt.Errorf("Discussion with ID %s not found", discussionID)
+	}
+
+	return r.DAOManager.DismissPostReport(ctx, discussionID, reportID, authedUser.UserID)
+}
+
+func (r *mutationResolver) ActionPostReport(ctx context.Context, discussionID string, reportID string, action model.PostReportAction, mutedForSeconds *int) (*model.PostReport, error) {
+	authedUser := auth.GetAuthedUser(ctx)
+	if authedUser == nil {
+		return nil, fmt.Errorf("Need auth")
+	}
+
+	// Note: This is here mainly to ensure the discussion is not (soft) deleted
+	discussion, err := r.DAOManager.GetDiscussionByID(ctx, discussionID)
+	if discussion == nil || err != nil {
+		return nil, fmt.Errorf("Discussion with ID %s not found", discussionID)
+	}
+
+	return r.DAOManager.ActionPostReport(ctx, discussionID, reportID, authedUser.UserID, action, mutedForSeconds)
+}
+
+func (r *mutationResolver) BanParticipant(ctx context.Context, discussionID string, participantID string, reason *string, keepPosts *bool) (*model.Participant, error) {
+	authedUser := auth.GetAuthedUser(ctx)
+	if authedUser == nil {
+		return nil, fmt.Errorf("Need auth")
+	}
+
+	// Note: This is here mainly to ensure the discussion is not (soft) deleted
+	discussion, err := r.DAOManager.GetDiscussionByID(ctx, discussionID)
+	if discussion == nil || err != nil || discussion.LockStatus == true {
+		return nil, fmt.Errorf("Discussion with ID %s not found", discussionID)
+	}
+
+	bannedParticipant, err := r.DAOManager.BanParticipant(ctx, discussionID, participantID, authedUser.UserID, reason, keepPosts != nil && *keepPosts)
 	if err != nil {
 		return nil, fmt.Errorf("Failed to ban participant")
 	}
@@ -379,6 +563,21 @@ func (r *mutationResolver) BanParticipant(ctx context.Context, discussionID stri
 	return bannedParticipant, nil
 }
 
+func (r *mutationResolver) UnbanParticipant(ctx context.Context, discussionID string, participantID string, restorePosts *bool) (*model.Participant, error) {
+	authedUser := auth.GetAuthedUser(ctx)
+	if authedUser == nil {
+		return nil, fmt.Errorf("Need auth")
+	}
+
+	// Note: This is here mainly to ensure the discussion is not (soft) deleted
+	discussion, err := r.DAOManager.GetDiscussionByID(ctx, discussionID)
+	if discussion == nil || err != nil || discussion.LockStatus == true {
+		return nil, fmt.Errorf("Discussion with ID %s not found", discussionID)
+	}
+
+	return r.DAOManager.UnbanParticipant(ctx, discussionID, participantID, authedUser.UserID, restorePosts != nil && *restorePosts)
+}
+
 func (r *mutationResolver) ShuffleDiscussion(ctx context.Context, discussionID string, inFutureSeconds *int) (*model.Discussion, error) {
 	authedUser := auth.GetAuthedUser(ctx)
 	if authedUser == nil {
@@ -408,7 +607,7 @@ func (r *mutationResolver) ShuffleDiscussion(ctx context.Context, discussionID s
 
 	shuffleTimeAsTime := time.Now().Add(time.Duration(seconds) * time.Second)
 
-	_, err = r.DAOManager.PutDiscussionShuffleTime(ctx, discussionID, &shuffleTimeAsTime)
+	_, err = r.DAOManager.PutDiscussionShuffleTime(ctx, discussionID, &shuffleTimeAsTime, authedUser.UserID)
 	if err != nil {
 		return nil, err
 	}
@@ -440,6 +639,15 @@ func (r *mutationResolver) SetLastPostViewed(ctx context.Context, viewerID strin
 	return r.DAOManager.SetViewerLastPostViewed(ctx, viewerID, postID)
 }
 
+func (r *mutationResolver) MarkAllDiscussionsRead(ctx context.Context) ([]*model.Viewer, error) {
+	authedUser := auth.GetAuthedUser(ctx)
+	if authedUser == nil {
+		return nil, fmt.Errorf("Need auth")
+	}
+
+	return r.DAOManager.MarkAllDiscussionsRead(ctx, authedUser.UserID)
+}
+
 func (r *mutationResolver) MuteParticipants(ctx context.Context, discussionID string, participantIDs []string, mutedForSeconds int) ([]*model.Participant, error) {
 	authedUser := auth.GetAuthedUser(ctx)
 	if authedUser == nil {
@@ -456,13 +664,13 @@ func (r *mutationResolver) MuteParticipants(ctx context.Context, discussionID st
 		return nil, fmt.Errorf("mutedForSeconds value is invalid")
 	}
 
-	/* Only moderators can use this mutation */
-	modCheck, err := r.DAOManager.CheckIfModerator(ctx, authedUser.UserID)
+	/* Helpers and above can use this mutation */
+	modCheck, err := r.DAOManager.CheckModeratorRole(ctx, authedUser.UserID, discussionID, model.ModeratorRoleHelper)
 	if err != nil || !modCheck {
 		return nil, fmt.Errorf("unauthorized")
 	}
 
-	return r.DAOManager.MuteParticipants(ctx, discussionID, participantIDs, mutedForSeconds)
+	return r.DAOManager.MuteParticipants(ctx, discussionID, participantIDs, authedUser.UserID, mutedForSeconds)
 }
 
 func (r *mutationResolver) UnmuteParticipants(ctx context.Context, discussionID string, participantIDs []string) ([]*model.Participant, error) {
@@ -477,8 +685,8 @@ func (r *mutationResolver) UnmuteParticipants(ctx context.Context, discussionID
 		return nil, fmt.Errorf("Discussion with ID %s not found", discussionID)
 	}
 
-	/* Only moderators can use this mutation */
-	modCheck, err := r.DAOManager.CheckIfModerator(ctx, authedUser.UserID)
+	/* Helpers and above can use this mutation */
+	modCheck, err := r.DAOManager.CheckModeratorRole(ctx, authedUser.UserID, discussionID, model.ModeratorRoleHelper)
 	if err != nil || !modCheck {
 		return nil, fmt.Errorf("unauthorized")
 	}
@@ -486,6 +694,51 @@ func (r *mutationResolver) UnmuteParticipants(ctx context.Context, discussionID
 	return r.DAOManager.UnmuteParticipants(ctx, discussionID, participantIDs)
 }
 
+func (r *mutationResolver) GrantModeratorRole(ctx context.Context, discussionID string, participantID string, role model.ModeratorRole) (*model.Participant, error) {
+	authedUser := auth.GetAuthedUser(ctx)
+	if authedUser == nil {
+		return nil, fmt.Errorf("Need auth")
+	}
+
+	// Note: This is here mainly to ensure the discussion is not (soft) deleted
+	discussion, err := r.DAOManager.GetDiscussionByID(ctx, discussionID)
+	if discussion == nil || err != nil {
+		return nil, fmt.Errorf("Discussion with ID %s not found", discussionID)
+	}
+
+	return r.DAOManager.GrantModeratorRole(ctx, discussionID, participantID, role, authedUser.UserID)
+}
+
+func (r *mutationResolver) RevokeModeratorRole(ctx context.Context, discussionID string, participantID string) (*model.Participant, error) {
+	authedUser := auth.GetAuthedUser(ctx)
+	if authedUser == nil {
+		return nil, fmt.Errorf("Need auth")
+	}
+
+	// Note: This is here mainly to ensure the discussion is not (soft) deleted
+	discussion, err := r.DAOManager.GetDiscussionByID(ctx, discussionID)
+	if discussion == nil || err != nil {
+		return nil, fmt.Errorf("Discussion with ID %s not found", discussionID)
+	}
+
+	return r.DAOManager.RevokeModeratorRole(ctx, discussionID, participantID, authedUser.UserID)
+}
+
+func (r *mutationResolver) TransferDiscussionOwnership(ctx context.Context, discussionID string, toUserID string) (*model.Discussion, error) {
+	authedUser := auth.GetAuthedUser(ctx)
+	if authedUser == nil {
+		return nil, fmt.Errorf("Need auth")
+	}
+
+	// Note: This is here mainly to ensure the discussion is not (soft) deleted
+	discussion, err := r.DAOManager.GetDiscussionByID(ctx, discussionID)
+	if discussion == nil || err != nil {
+		return nil, fmt.Errorf("Discussion with ID %s not found", discussionID)
+	}
+
+	return r.DAOManager.TransferDiscussionOwnership(ctx, discussionID, toUserID, authedUser.UserID)
+}
+
 func (r *queryResolver) Discussion(ctx context.Context, id string) (*model.Discussion, error) {
 	return r.resolveDiscussionByID(ctx, id)
 }
@@ -549,6 +802,20 @@ func (r *queryResolver) Me(ctx context.Context) (*model.User, error) {
 	return authedUser.User, nil
 }
 
+func (r *queryResolver) SearchMyDiscussions(ctx context.Context, query string, after *string) (*model.PostsConnection, error) {
+	authedUser := auth.GetAuthedUser(ctx)
+	if authedUser == nil {
+		return nil, fmt.Errorf("Need auth")
+	}
+
+	cursor, err := postsConnectionCursor(after)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.DAOManager.SearchPostsByUserAccess(ctx, authedUser.UserID, query, cursor, backend.PostPerPageLimit)
+}
+
 func (r *subscriptionResolver) PostAdded(ctx context.Context, discussionID string) (<-chan *model.Post, error) {
 	authedUser := auth.GetAuthedUser(ctx)
 	if authedUser == nil {
@@ -556,16 +823,20 @@ func (r *subscriptionResolver) PostAdded(ctx context.Context, discussionID strin
 	}
 	events := make(chan *model.Post, 1)
 
+	// Each connection gets its own subscription so a user can listen from
+	// several devices at once.
+	subscriptionID := util.UUIDv4()
+
 	go func() {
 		<-ctx.Done()
-		err := r.DAOManager.UnSubscribeFromDiscussion(ctx, authedUser.UserID, discussionID)
+		err := r.DAOManager.UnSubscribeFromDiscussion(ctx, subscriptionID, discussionID)
 		if err != nil {
 			logrus.WithError(err).Errorf("Failed to unsubscribe from discussion")
 		}
 		close(events)
 	}()
 
-	err := r.DAOManager.SubscribeToDiscussion(ctx, authedUser.UserID, events, discussionID)
+	err := r.DAOManager.SubscribeToDiscussion(ctx, subscriptionID, events, discussionID)
 	if err != nil {
 		close(events)
 		return nil, err
@@ -574,28 +845,80 @@ func (r *subscriptionResolver) PostAdded(ctx context.Context, discussionID strin
 	return events, nil
 }
 
-func (r *subscriptionResolver) OnDiscussionEvent(ctx context.Context, discussionID string) (<-chan *model.DiscussionSubscriptionEvent, error) {
+func (r *subscriptionResolver) OnDiscussionEvent(ctx context.Context, discussionID string, afterSequence *int) (<-chan *model.DiscussionSubscriptionEvent, error) {
 	authedUser := auth.GetAuthedUser(ctx)
 	if authedUser == nil {
 		return nil, fmt.Errorf("Need auth")
 	}
 	events := make(chan *model.DiscussionSubscriptionEvent, 1)
 
-	go func() {
-		<-ctx.Done()
-		err := r.DAOManager.UnSubscribeFromDiscussionEvent(ctx, authedUser.UserID, discussionID)
-		if err != nil {
-			logrus.WithError(err).Errorf("Failed to unsubscribe from discussion")
-		}
-		close(events)
-	}()
+	// Each connection gets its own subscription so a user can listen from
+	// several devices at once.
+	subscriptionID := util.UUIDv4()
 
-	err := r.DAOManager.SubscribeToDiscussionEvent(ctx, authedUser.UserID, events, discussionID)
+	// Subscribe before reading the replay so nothing published in between is
+	// missed. Live events buffer here until the replay has been sent. The
+	// backend closes live if it fills up and drops the subscription.
+	live := make(chan *model.DiscussionSubscriptionEvent, discussionEventLiveBufferSize)
+	err := r.DAOManager.SubscribeToDiscussionEvent(ctx, subscriptionID, live, discussionID)
 	if err != nil {
 		close(events)
 		return nil, err
 	}
 
+	var replay []*model.DiscussionSubscriptionEvent
+	if afterSequence != nil {
+		replay, err = r.DAOManager.GetDiscussionEventsAfterSequence(ctx, discussionID, *afterSequence)
+		if err != nil {
+			if err := r.DAOManager.UnSubscribeFromDiscussionEvent(ctx, subscriptionID, discussionID); err != nil {
+				logrus.WithError(err).Errorf("Failed to unsubscribe from discussion")
+			}
+			close(events)
+			return nil, err
+		}
+	}
+
+	go func() {
+		defer func() {
+			err := r.DAOManager.UnSubscribeFromDiscussionEvent(ctx, subscriptionID, discussionID)
+			if err != nil {
+				logrus.WithError(err).Errorf("Failed to unsubscribe from discussion")
+			}
+			close(events)
+		}()
+
+		// Sequences can commit out of order, so live events are only skipped
+		// if they were actually sent as part of the replay.
+		replayed := make(map[int]bool, len(replay))
+		for _, event := range replay {
+			select {
+			case events <- event:
+				replayed[event.Sequence] = true
+			case <-ctx.Done():
+				return
+			}
+		}
+
+		for {
+			select {
+			case event, ok := <-live:
+				if !ok {
+					return
+				}
+				if replayed[event.Sequence] {
+					continue
+				}
+				select {
+				case events <- event:
+				case <-ctx.Done():
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
 	return events, nil
 }
 
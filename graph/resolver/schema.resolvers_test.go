@@ -0,0 +1,76 @@
+package resolver
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/delphis-inc/delphisbe/graph/model"
+	"github.com/delphis-inc/delphisbe/internal/auth"
+	"github.com/delphis-inc/delphisbe/internal/backend"
+	"github.com/delphis-inc/delphisbe/internal/backend/test_utils"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestMutationResolver_AddPost(t *testing.T) {
+	discussionID := test_utils.DiscussionID
+	participantObj := test_utils.TestParticipant()
+	postObj := test_utils.TestPost()
+	authedUser := test_utils.TestDelphisAuthedUser()
+	ctx := auth.WithAuthedUser(context.Background(), &authedUser)
+	input := model.PostContentInput{PostText: "hello"}
+
+	Convey("AddPost", t, func() {
+		backendObj := &mockBackend{}
+		resolverObj := &mutationResolver{&Resolver{DAOManager: backendObj}}
+
+		Convey("when there is no authed user", func() {
+			resp, err := resolverObj.AddPost(context.Background(), discussionID, participantObj.ID, input)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		backendObj.On("GetPostingParticipant", ctx, discussionID, participantObj.ID, authedUser.UserID).Return(&participantObj, nil)
+
+		Convey("when slow mode rejects the post in the post transaction", func() {
+			slowModeErr := &backend.SlowModeError{SecondsRemaining: 12}
+			backendObj.On("CreatePost", ctx, discussionID, authedUser.UserID, participantObj.ID, input).Return(nil, slowModeErr)
+
+			resp, err := resolverObj.AddPost(ctx, discussionID, participantObj.ID, input)
+
+			So(err, ShouldEqual, slowModeErr)
+			So(err.(*backend.SlowModeError).Extensions()["secondsRemaining"], ShouldEqual, 12)
+			So(resp, ShouldBeNil)
+		})
+
+		Convey("when a mention is rejected", func() {
+			mentionErr := &backend.MentionError{Code: model.MentionErrorCodeUnknownEntity, Message: "sth"}
+			backendObj.On("CreatePost", ctx, discussionID, authedUser.UserID, participantObj.ID, input).Return(nil, mentionErr)
+
+			resp, err := resolverObj.AddPost(ctx, discussionID, participantObj.ID, input)
+
+			So(err, ShouldEqual, mentionErr)
+			So(resp, ShouldBeNil)
+		})
+
+		Convey("when creating the post fails otherwise", func() {
+			backendObj.On("CreatePost", ctx, discussionID, authedUser.UserID, participantObj.ID, input).Return(nil, fmt.Errorf("sth"))
+
+			resp, err := resolverObj.AddPost(ctx, discussionID, participantObj.ID, input)
+
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldEqual, "Failed to create post")
+			So(resp, ShouldBeNil)
+		})
+
+		Convey("when the post is created", func() {
+			backendObj.On("CreatePost", ctx, discussionID, authedUser.UserID, participantObj.ID, input).Return(&postObj, nil)
+
+			resp, err := resolverObj.AddPost(ctx, discussionID, participantObj.ID, input)
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldEqual, &postObj)
+		})
+	})
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/delphis-inc/delphisbe/graph/generated"
 	"github.com/delphis-inc/delphisbe/graph/model"
 	"github.com/delphis-inc/delphisbe/internal/auth"
+	"github.com/delphis-inc/delphisbe/internal/backend"
 	"github.com/sirupsen/logrus"
 )
 
@@ -109,6 +110,47 @@ func (r *userResolver) Discussions(ctx context.Context, obj *model.User, state m
 	return r.DAOManager.GetDiscussionAccessesByUserID(ctx, authedUser.UserID, state)
 }
 
+func (r *userResolver) TotalUnreadCount(ctx context.Context, obj *model.User) (int, error) {
+	authedUser := auth.GetAuthedUser(ctx)
+	if authedUser == nil {
+		return 0, fmt.Errorf("Need auth")
+	}
+
+	if authedUser.UserID != obj.ID {
+		return 0, fmt.Errorf("unauthorized")
+	}
+
+	counts, err := r.resolveMeUnreadCounts(ctx, authedUser.UserID)
+	if err != nil {
+		return 0, err
+	}
+
+	total := 0
+	for _, count := range counts {
+		total += count.UnreadCount
+	}
+	return total, nil
+}
+
+func (r *userResolver) Bookmarks(ctx context.Context, obj *model.User, after *string) (*model.PostBookmarksConnection, error) {
+	authedUser := auth.GetAuthedUser(ctx)
+	if authedUser == nil {
+		return nil, fmt.Errorf("Need auth")
+	}
+
+	// Bookmarks are private to the user who made them
+	if authedUser.UserID != obj.ID {
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	cursor, err := postsConnectionCursor(after)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.DAOManager.GetPostBookmarksConnectionByUserID(ctx, obj.ID, cursor, backend.PostPerPageLimit)
+}
+
 func (r *userResolver) SentDiscussionAccessRequests(ctx context.Context, obj *model.User) ([]*model.DiscussionAccessRequest, error) {
 	authedUser := auth.GetAuthedUser(ctx)
 	if authedUser == nil {
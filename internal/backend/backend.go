@@ -8,7 +8,9 @@ import (
 	"time"
 
 	"github.com/delphis-inc/delphisbe/internal/mediadb"
+	"github.com/delphis-inc/delphisbe/internal/pubsub"
 	"github.com/delphis-inc/delphisbe/internal/twitter"
+	"github.com/delphis-inc/delphisbe/internal/unfurl"
 	"github.com/sirupsen/logrus"
 
 	"github.com/aws/aws-sdk-go/aws/session"
@@ -22,7 +24,7 @@ import (
 
 type DelphisBackend interface {
 	CreateNewDiscussion(ctx context.Context, creatingUser *model.User, anonymityType model.AnonymityType, title string, description string, publicAccess bool, discussionSettings model.DiscussionCreationSettings) (*model.Discussion, error)
-	UpdateDiscussion(ctx context.Context, id string, input model.DiscussionInput) (*model.Discussion, error)
+	UpdateDiscussion(ctx context.Context, id string, requestingUserID string, input model.DiscussionInput) (*model.Discussion, error)
 	GetDiscussionArchiveByDiscussionID(ctx context.Context, discussionID string) (*model.DiscussionArchive, error)
 	CreateDiscussionArchive(ctx context.Context, discussionID string, shuffleCount int) (*model.DiscussionArchive, error)
 	GetDiscussionByID(ctx context.Context, id string) (*model.Discussion, error)
@@ -30,10 +32,11 @@ type DelphisBackend interface {
 	GetDiscussionByLinkSlug(ctx context.Context, slug string) (*model.Discussion, error)
 	GetDiscussionByModeratorID(ctx context.Context, moderatorID string) (*model.Discussion, error)
 	GetDiscussionJoinabilityForUser(ctx context.Context, userObj *model.User, discussionObj *model.Discussion, meParticipant *model.Participant) (*model.CanJoinDiscussionResponse, error)
-	SubscribeToDiscussion(ctx context.Context, subscriberUserID string, postChannel chan *model.Post, discussionID string) error
-	UnSubscribeFromDiscussion(ctx context.Context, subscriberUserID string, discussionID string) error
-	SubscribeToDiscussionEvent(ctx context.Context, subscriberUserID string, eventChannel chan *model.DiscussionSubscriptionEvent, discussionID string) error
-	UnSubscribeFromDiscussionEvent(ctx context.Context, subscriberUserID string, discussionID string) error
+	SubscribeToDiscussion(ctx context.Context, subscriptionID string, postChannel chan *model.Post, discussionID string) error
+	UnSubscribeFromDiscussion(ctx context.Context, subscriptionID string, discussionID string) error
+	SubscribeToDiscussionEvent(ctx context.Context, subscriptionID string, eventChannel chan *model.DiscussionSubscriptionEvent, discussionID string) error
+	UnSubscribeFromDiscussionEvent(ctx context.Context, subscriptionID string, discussionID string) error
+	GetDiscussionEventsAfterSequence(ctx context.Context, discussionID string, afterSequence int) ([]*model.DiscussionSubscriptionEvent, error)
 	ListDiscussions(ctx context.Context) (*model.DiscussionsConnection, error)
 	ListDiscussionsByUserID(ctx context.Context, userID string, state model.DiscussionUserAccessState) (*model.DiscussionsConnection, error)
 	GetModeratorByID(ctx context.Context, id string) (*model.Moderator, error)
@@ -42,6 +45,13 @@ type DelphisBackend interface {
 	GetModeratedDiscussionsByUserID(ctx context.Context, userID string) ([]*model.Discussion, error)
 	CheckIfModerator(ctx context.Context, userID string) (bool, error)
 	CheckIfModeratorForDiscussion(ctx context.Context, userID string, discussionID string) (bool, error)
+	GetModeratorRole(ctx context.Context, userID string, discussionID string) (*model.ModeratorRole, error)
+	CheckModeratorRole(ctx context.Context, userID string, discussionID string, minRole model.ModeratorRole) (bool, error)
+	GetParticipantModeratorRole(ctx context.Context, participant *model.Participant) (*model.ModeratorRole, error)
+	GrantModeratorRole(ctx context.Context, discussionID string, participantID string, role model.ModeratorRole, requestingUserID string) (*model.Participant, error)
+	RevokeModeratorRole(ctx context.Context, discussionID string, participantID string, requestingUserID string) (*model.Participant, error)
+	TransferDiscussionOwnership(ctx context.Context, discussionID string, toUserID string, requestingUserID string) (*model.Discussion, error)
+	GetModerationLogConnectionByDiscussionID(ctx context.Context, discussionID string, cursor string, limit int) (*model.ModerationLogConnection, error)
 	CreateParticipantForDiscussion(ctx context.Context, discussionID string, userID string, discussionParticipantInput model.AddDiscussionParticipantInput) (*model.Participant, error)
 	GetParticipantsByDiscussionIDUserID(ctx context.Context, discussionID string, userID string) (*UserDiscussionParticipants, error)
 	GetParticipantsByDiscussionID(ctx context.Context, id string) ([]model.Participant, error)
@@ -49,22 +59,58 @@ type DelphisBackend interface {
 	GetParticipantsByIDs(ctx context.Context, ids []string) (map[string]*model.Participant, error)
 	GetModeratorParticipantsByDiscussionID(ctx context.Context, discussionID string) (*UserDiscussionParticipants, error)
 	GetTotalParticipantCountByDiscussionID(ctx context.Context, discussionID string) int
-	BanParticipant(ctx context.Context, discussionID string, participantID string, requestingUserID string) (*model.Participant, error)
+	BanParticipant(ctx context.Context, discussionID string, participantID string, requestingUserID string, reason *string, keepPosts bool) (*model.Participant, error)
+	UnbanParticipant(ctx context.Context, discussionID string, participantID string, requestingUserID string, restorePosts bool) (*model.Participant, error)
+	GetBanReason(ctx context.Context, discussionID string, userID string) (*string, error)
+	ReportPost(ctx context.Context, discussionID string, postID string, userID string, reason model.PostReportReason, note *string) (*model.PostReport, error)
+	GetPostReportsConnectionByDiscussionID(ctx context.Context, discussionID string, status model.PostReportStatus, cursor string, limit int) (*model.PostReportConnection, error)
+	DismissPostReport(ctx context.Context, discussionID string, reportID string, requestingUserID string) (*model.PostReport, error)
+	ActionPostReport(ctx context.Context, discussionID string, reportID string, requestingUserID string, action model.PostReportAction, mutedForSeconds *int) (*model.PostReport, error)
 	UpdateParticipant(ctx context.Context, participants UserDiscussionParticipants, currentParticipantID string, input model.UpdateParticipantInput) (*model.Participant, error)
-	MuteParticipants(ctx context.Context, discussionID string, participantIDs []string, muteForSeconds int) ([]*model.Participant, error)
+	MuteParticipants(ctx context.Context, discussionID string, participantIDs []string, requestingUserID string, muteForSeconds int) ([]*model.Participant, error)
 	UnmuteParticipants(ctx context.Context, discussionID string, participantIDs []string) ([]*model.Participant, error)
 	CreatePost(ctx context.Context, discussionID string, userID string, participantID string, input model.PostContentInput) (*model.Post, error)
 	CreateWelcomeAlertPost(ctx context.Context, discussionID string, participantID string, userObj *model.User, isAnonymous bool) (*model.Post, error)
 	CreateShuffleAlertPost(ctx context.Context, discussionID string) (*model.Post, error)
+	CreateOwnershipTransferAlertPost(ctx context.Context, discussionID string, toUser *model.User) (*model.Post, error)
 	NotifySubscribersOfCreatedPost(ctx context.Context, post *model.Post, discussionID string) error
 	NotifySubscribersOfDeletedPost(ctx context.Context, post *model.Post, discussionID string) error
 	NotifySubscribersOfBannedParticipant(ctx context.Context, participant *model.Participant, discussionID string) error
 	GetPostByDiscussionPostID(ctx context.Context, discussionID, postID string) (*model.Post, error)
-	GetPostsConnectionByDiscussionID(ctx context.Context, discussionID string, cursor string, limit int) (*model.PostsConnection, error)
+	GetPostsConnectionByDiscussionID(ctx context.Context, discussionID string, cursor string, limit int, topLevelOnly bool) (*model.PostsConnection, error)
+	GetPostsConnectionByDiscussionIDBeforeCursor(ctx context.Context, discussionID string, cursor string, limit int, topLevelOnly bool) (*model.PostsConnection, error)
+	GetPostsConnectionAroundPost(ctx context.Context, discussionID string, postID string, newerLimit int, olderLimit int, topLevelOnly bool) (*model.PostsConnection, error)
+	GetPostRepliesConnection(ctx context.Context, postID string, cursor string, limit int) (*model.PostsConnection, error)
+	GetPostReplyCount(ctx context.Context, postID string) (int, error)
 	GetPostsByDiscussionID(ctx context.Context, discussionID string) ([]*model.Post, error)
 	GetLastPostByDiscussionID(ctx context.Context, discussionID string) (*model.Post, error)
 	GetPostContentByID(ctx context.Context, id string) (*model.PostContent, error)
 	DeletePostByID(ctx context.Context, discussionID string, postID string, requestingUserID string) (*model.Post, error)
+	EditPost(ctx context.Context, discussionID string, postID string, requestingUserID string, input model.PostContentInput) (*model.Post, error)
+	AddPostReaction(ctx context.Context, discussionID string, participantID string, postID string, reaction string) (*model.Post, error)
+	RemovePostReaction(ctx context.Context, discussionID string, participantID string, postID string, reaction string) (*model.Post, error)
+	GetPostReactionSummaries(ctx context.Context, postID string, participantID *string) ([]*model.PostReactionSummary, error)
+	PinPost(ctx context.Context, discussionID string, postID string, requestingUserID string) (*model.Post, error)
+	UnpinPost(ctx context.Context, discussionID string, postID string, requestingUserID string) (*model.Post, error)
+	GetPinnedPostsByDiscussionID(ctx context.Context, discussionID string) ([]*model.Post, error)
+	SearchPostsByDiscussionID(ctx context.Context, discussionID string, query string, cursor string, limit int) (*model.PostsConnection, error)
+	SearchPostsByUserAccess(ctx context.Context, userID string, query string, cursor string, limit int) (*model.PostsConnection, error)
+	BookmarkPost(ctx context.Context, userID string, discussionID string, postID string) (*model.Post, error)
+	UnbookmarkPost(ctx context.Context, userID string, discussionID string, postID string) (*model.Post, error)
+	GetPostBookmarksConnectionByUserID(ctx context.Context, userID string, cursor string, limit int) (*model.PostBookmarksConnection, error)
+	VotePoll(ctx context.Context, discussionID string, participantID string, postID string, optionIDs []string) (*model.Post, error)
+	GetPollByPostID(ctx context.Context, postID string, participantIDs []string) (*model.Poll, error)
+	IsPollClosed(poll *model.Poll) bool
+	GetPollOptionVoters(ctx context.Context, option model.PollOption) ([]*model.Participant, error)
+	GetLinkPreviewsByPostID(ctx context.Context, postID string) ([]*model.LinkPreview, error)
+	GetPostsConnectionByTag(ctx context.Context, discussionID string, tag string, cursor string, limit int) (*model.PostsConnection, error)
+	GetTopTagsByDiscussionID(ctx context.Context, discussionID string, limit int) ([]*model.TagSummary, error)
+	GetPostingParticipant(ctx context.Context, discussionID string, participantID string, userID string) (*model.Participant, error)
+	GetSecondsUntilCanPost(ctx context.Context, discussion *model.Discussion, userID string) (int, error)
+	SchedulePost(ctx context.Context, userID string, discussionID string, participantID string, input model.PostContentInput, publishAt time.Time) (*model.ScheduledPost, error)
+	CancelScheduledPost(ctx context.Context, scheduledPostID string) (*model.ScheduledPost, error)
+	GetScheduledPostByID(ctx context.Context, id string) (*model.ScheduledPost, error)
+	GetPendingScheduledPostsByDiscussionID(ctx context.Context, discussionID string) ([]*model.ScheduledPost, error)
 	GetUserProfileByID(ctx context.Context, id string) (*model.UserProfile, error)
 	GetUserProfileByUserID(ctx context.Context, userID string) (*model.UserProfile, error)
 	CreateUser(ctx context.Context) (*model.User, error)
@@ -78,6 +124,8 @@ type DelphisBackend interface {
 	GetViewersByIDs(ctx context.Context, viewerIDs []string) (map[string]*model.Viewer, error)
 	CreateViewerForDiscussion(ctx context.Context, discussionID string, userID string) (*model.Viewer, error)
 	GetViewerForDiscussion(ctx context.Context, discussionID, userID string, createIfNotFound bool) (*model.Viewer, error)
+	GetUnreadCountsByUserID(ctx context.Context, userID string) (map[string]*model.DiscussionUnreadCount, error)
+	MarkAllDiscussionsRead(ctx context.Context, userID string) ([]*model.Viewer, error)
 	SetViewerLastPostViewed(ctx context.Context, viewerID, postID string) (*model.Viewer, error)
 	GetSocialInfosByUserProfileID(ctx context.Context, userProfileID string) ([]model.SocialInfo, error)
 	UpsertSocialInfo(ctx context.Context, socialInfo model.SocialInfo) (*model.SocialInfo, error)
@@ -85,7 +133,7 @@ type DelphisBackend interface {
 	NewAccessToken(ctx context.Context, userID string) (*auth.DelphisAccessToken, error)
 	ValidateAccessToken(ctx context.Context, token string) (*auth.DelphisAuthedUser, error)
 	ValidateRefreshToken(ctx context.Context, token string) (*auth.DelphisRefreshTokenUser, error)
-	SendNotificationsToSubscribers(ctx context.Context, userID string, discussion *model.Discussion, post *model.Post, contentPreview *string) (*SendNotificationResponse, error)
+	SendNotificationsToSubscribers(ctx context.Context, userID string, discussion *model.Discussion, post *model.Post, repliedToPostID *string, contentPreview *string) (*SendNotificationResponse, error)
 	GetMediaRecord(ctx context.Context, mediaID string) (*model.Media, error)
 	UploadMedia(ctx context.Context, media multipart.File) (string, string, error)
 	GetDiscussionAccessesByUserID(ctx context.Context, userID string, state model.DiscussionUserAccessState) ([]*model.Discussion, error)
@@ -101,13 +149,14 @@ type DelphisBackend interface {
 	GetTwitterClientWithAccessTokens(ctx context.Context, accessToken string, accessTokenSecret string) (twitter.TwitterClient, error)
 	DoesTwitterUserFollowUser(ctx context.Context, twitterClient twitter.TwitterClient, firstUser model.SocialInfo, secondUser model.SocialInfo) (bool, error)
 	RequestAccessToDiscussion(ctx context.Context, userID, discussionID string) (*model.DiscussionAccessRequest, error)
-	RespondToRequestAccess(ctx context.Context, requestID string, response model.InviteRequestStatus, invitingParticipantID string) (*model.DiscussionAccessRequest, error)
+	RespondToRequestAccess(ctx context.Context, requestID string, response model.InviteRequestStatus, invitingParticipantID string, requestingUserID string) (*model.DiscussionAccessRequest, error)
 	GetAccessLinkBySlug(ctx context.Context, slug string) (*model.DiscussionAccessLink, error)
 	GetAccessLinkByDiscussionID(ctx context.Context, discussionID string) (*model.DiscussionAccessLink, error)
 	PutAccessLinkForDiscussion(ctx context.Context, discussionID string) (*model.DiscussionAccessLink, error)
 	GetNextDiscussionShuffleTime(ctx context.Context, discussionID string) (*model.DiscussionShuffleTime, error)
-	PutDiscussionShuffleTime(ctx context.Context, discussionID string, shuffleTime *time.Time) (*model.DiscussionShuffleTime, error)
+	PutDiscussionShuffleTime(ctx context.Context, discussionID string, shuffleTime *time.Time, requestingUserID string) (*model.DiscussionShuffleTime, error)
 	ShuffleDiscussionsIfNecessary()
+	PublishScheduledPostsIfNecessary()
 	IncrementDiscussionShuffleCount(ctx context.Context, tx *sql.Tx, id string) (*int, error)
 	GetDiscussionIDsToBeShuffledBeforeTime(ctx context.Context, tx *sql.Tx, epoc time.Time) ([]string, error)
 }
@@ -121,11 +170,13 @@ type delphisBackend struct {
 	timeProvider    util.TimeProvider
 	mediadb         mediadb.MediaDB
 	twitterBackend  twitter.TwitterBackend
+	pubsub          pubsub.PubSub
+	unfurler        unfurl.Fetcher
 }
 
 func NewDelphisBackend(conf config.Config, awsSession *session.Session) DelphisBackend {
 	chathamCache := cache.NewInMemoryCache()
-	return &delphisBackend{
+	backendObj := &delphisBackend{
 		db:              datastore.NewDatastore(conf, awsSession),
 		auth:            auth.NewDelphisAuth(&conf.Auth),
 		cache:           chathamCache,
@@ -134,7 +185,16 @@ func NewDelphisBackend(conf config.Config, awsSession *session.Session) DelphisB
 		timeProvider:    &util.RealTime{},
 		mediadb:         mediadb.NewMediaDB(conf, awsSession),
 		twitterBackend:  &twitter.TwitterBackendImpl{},
+		pubsub:          pubsub.NewPubSub(conf),
+		unfurler:        unfurl.NewHTTPFetcher(unfurl.DefaultTimeout, unfurl.DefaultMaxBytes),
 	}
+
+	// Deliver discussion events published by any instance to our own subscribers
+	if err := backendObj.pubsub.Subscribe(context.Background(), discussionEventsChannel, backendObj.handleDiscussionEventMessage); err != nil {
+		logrus.WithError(err).Error("failed to subscribe to discussion events")
+	}
+
+	return backendObj
 }
 
 func (d *delphisBackend) rollbackTx(ctx context.Context, tx *sql.Tx) error {
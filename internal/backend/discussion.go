@@ -68,6 +68,7 @@ func (d *delphisBackend) CreateNewDiscussion(ctx context.Context, creatingUser *
 		ModeratorID:           &moderatorObj.ID,
 		DiscussionJoinability: discussionSettings.DiscussionJoinability,
 		LockStatus:            false,
+		EveryoneMentionPolicy: model.EveryoneMentionPolicyModerators,
 	}
 
 	_, err = d.db.UpsertDiscussion(ctx, discussionObj)
@@ -253,7 +254,11 @@ func (d *delphisBackend) GetDiscussionByLinkSlug(ctx context.Context, slug strin
 	return d.db.GetDiscussionByLinkSlug(ctx, slug)
 }
 
-func (d *delphisBackend) UpdateDiscussion(ctx context.Context, id string, input model.DiscussionInput) (*model.Discussion, error) {
+func (d *delphisBackend) UpdateDiscussion(ctx context.Context, id string, requestingUserID string, input model.DiscussionInput) (*model.Discussion, error) {
+	if err := validateSlowModeSeconds(input.SlowModeSeconds); err != nil {
+		return nil, err
+	}
+
 	discObj, err := d.db.GetDiscussionByID(ctx, id)
 	if err != nil {
 		logrus.WithError(err).Error("failed to get discussion by ID")
@@ -265,6 +270,7 @@ func (d *delphisBackend) UpdateDiscussion(ctx context.Context, id string, input
 		return nil, nil
 	}
 
+	previous := *discObj
 	updateDiscussionObj(discObj, input)
 
 	if input.LockStatus != nil && *input.LockStatus == true {
@@ -275,7 +281,20 @@ func (d *delphisBackend) UpdateDiscussion(ctx context.Context, id string, input
 
 	}
 
-	return d.db.UpsertDiscussion(ctx, *discObj)
+	updatedDiscussion, err := d.db.UpsertDiscussion(ctx, *discObj)
+	if err != nil {
+		return nil, err
+	}
+
+	if updatedDiscussion != nil {
+		for _, eventType := range discussionUpdateEventTypes(&previous, updatedDiscussion) {
+			d.emitDiscussionEvent(ctx, id, eventType, updatedDiscussion)
+			if action, ok := discussionUpdateModerationActions[eventType]; ok {
+				d.recordModerationAction(ctx, id, requestingUserID, action, model.ModerationTargetTypeDiscussion, id, nil)
+			}
+		}
+	}
+	return updatedDiscussion, nil
 }
 
 // May need to add paging
@@ -291,6 +310,11 @@ func (d *delphisBackend) CreateDiscussionArchive(ctx context.Context, discussion
 		return nil, err
 	}
 
+	if err := d.loadPollsForArchive(ctx, posts); err != nil {
+		logrus.WithError(err).Error("failed to load polls for archive")
+		return nil, err
+	}
+
 	archivedPosts, err := anonymizePostsForArchive(ctx, posts, shuffleCount)
 	if err != nil {
 		logrus.WithError(err).Error("failed to anonymize posts for archive")
@@ -368,7 +392,7 @@ func (d *delphisBackend) ListDiscussionsByUserID(ctx context.Context, userID str
 	return d.db.ListDiscussionsByUserID(ctx, userID, state)
 }
 
-func (d *delphisBackend) SubscribeToDiscussion(ctx context.Context, subscriberUserID string, postChannel chan *model.Post, discussionID string) error {
+func (d *delphisBackend) SubscribeToDiscussion(ctx context.Context, subscriptionID string, postChannel chan *model.Post, discussionID string) error {
 	cacheKey := fmt.Sprintf(discussionSubscriberKey, discussionID)
 	d.discussionMutex.Lock()
 	defer d.discussionMutex.Unlock()
@@ -381,12 +405,12 @@ func (d *delphisBackend) SubscribeToDiscussion(ctx context.Context, subscriberUs
 	if currentSubs, ok = currentSubsIface.(map[string]chan *model.Post); !ok {
 		currentSubs = map[string]chan *model.Post{}
 	}
-	currentSubs[subscriberUserID] = postChannel
+	currentSubs[subscriptionID] = postChannel
 	d.cache.Set(cacheKey, currentSubs, time.Hour)
 	return nil
 }
 
-func (d *delphisBackend) UnSubscribeFromDiscussion(ctx context.Context, subscriberUserID string, discussionID string) error {
+func (d *delphisBackend) UnSubscribeFromDiscussion(ctx context.Context, subscriptionID string, discussionID string) error {
 	cacheKey := fmt.Sprintf(discussionSubscriberKey, discussionID)
 	d.discussionMutex.Lock()
 	defer d.discussionMutex.Unlock()
@@ -399,12 +423,12 @@ func (d *delphisBackend) UnSubscribeFromDiscussion(ctx context.Context, subscrib
 	if currentSubs, ok = currentSubsIface.(map[string]chan *model.Post); !ok {
 		currentSubs = map[string]chan *model.Post{}
 	}
-	delete(currentSubs, subscriberUserID)
+	delete(currentSubs, subscriptionID)
 	d.cache.Set(cacheKey, currentSubs, time.Hour)
 	return nil
 }
 
-func (d *delphisBackend) SubscribeToDiscussionEvent(ctx context.Context, subscriberUserID string, eventChannel chan *model.DiscussionSubscriptionEvent, discussionID string) error {
+func (d *delphisBackend) SubscribeToDiscussionEvent(ctx context.Context, subscriptionID string, eventChannel chan *model.DiscussionSubscriptionEvent, discussionID string) error {
 	cacheKey := fmt.Sprintf(discussionEventSubscriberKey, discussionID)
 	d.discussionMutex.Lock()
 	defer d.discussionMutex.Unlock()
@@ -417,12 +441,12 @@ func (d *delphisBackend) SubscribeToDiscussionEvent(ctx context.Context, subscri
 	if currentSubs, ok = currentSubsIface.(map[string]chan *model.DiscussionSubscriptionEvent); !ok {
 		currentSubs = map[string]chan *model.DiscussionSubscriptionEvent{}
 	}
-	currentSubs[subscriberUserID] = eventChannel
+	currentSubs[subscriptionID] = eventChannel
 	d.cache.Set(cacheKey, currentSubs, time.Hour)
 	return nil
 }
 
-func (d *delphisBackend) UnSubscribeFromDiscussionEvent(ctx context.Context, subscriberUserID string, discussionID string) error {
+func (d *delphisBackend) UnSubscribeFromDiscussionEvent(ctx context.Context, subscriptionID string, discussionID string) error {
 	cacheKey := fmt.Sprintf(discussionEventSubscriberKey, discussionID)
 	d.discussionMutex.Lock()
 	defer d.discussionMutex.Unlock()
@@ -435,7 +459,7 @@ func (d *delphisBackend) UnSubscribeFromDiscussionEvent(ctx context.Context, sub
 	if currentSubs, ok = currentSubsIface.(map[string]chan *model.DiscussionSubscriptionEvent); !ok {
 		currentSubs = map[string]chan *model.DiscussionSubscriptionEvent{}
 	}
-	delete(currentSubs, subscriberUserID)
+	delete(currentSubs, subscriptionID)
 	d.cache.Set(cacheKey, currentSubs, time.Hour)
 	return nil
 }
@@ -499,6 +523,10 @@ func anonymizePostsForArchive(ctx context.Context, posts []*model.Post, shuffleC
 					entities = append(entities, util.GenerateFullDisplayName(hashAsInt64))
 				} else if entity.Type == model.DiscussionPrefix {
 					entities = append(entities, "redacted_discussion")
+				} else if entity.Type == model.TagPrefix {
+					entities = append(entities, "#"+entity.ID)
+				} else if entity.Type == model.GroupPrefix {
+					entities = append(entities, "@"+entity.ID)
 				}
 			}
 		}
@@ -510,6 +538,8 @@ func anonymizePostsForArchive(ctx context.Context, posts []*model.Post, shuffleC
 			Content:           post.PostContent.Content,
 			MentionedEntities: entities,
 			MediaID:           post.MediaID,
+			IsPinned:          post.PinnedAt != nil,
+			Poll:              archivePoll(post.Poll),
 		}
 
 		archivedPosts = append(archivedPosts, &archivePost)
@@ -518,6 +548,27 @@ func anonymizePostsForArchive(ctx context.Context, posts []*model.Post, shuffleC
 	return archivedPosts, nil
 }
 
+func archivePoll(poll *model.Poll) *model.ArchivedPoll {
+	if poll == nil {
+		return nil
+	}
+
+	archivedPoll := model.ArchivedPoll{
+		AllowMultipleVotes: poll.AllowMultipleVotes,
+		IsAnonymous:        poll.IsAnonymous,
+		ClosesAt:           poll.ClosesAt,
+		Options:            make([]*model.ArchivedPollOption, 0),
+	}
+	for _, option := range poll.Options {
+		archivedPoll.Options = append(archivedPoll.Options, &model.ArchivedPollOption{
+			Text:      option.Text,
+			VoteCount: option.VoteCount,
+		})
+	}
+
+	return &archivedPoll
+}
+
 func updateDiscussionObj(disc *model.Discussion, input model.DiscussionInput) {
 	if input.AnonymityType != nil {
 		disc.AnonymityType = *input.AnonymityType
@@ -545,6 +596,44 @@ func updateDiscussionObj(disc *model.Discussion, input model.DiscussionInput) {
 	if input.LockStatus != nil {
 		disc.LockStatus = *input.LockStatus
 	}
+	if input.EveryoneMentionPolicy != nil {
+		disc.EveryoneMentionPolicy = *input.EveryoneMentionPolicy
+	}
+	if input.SlowModeSeconds != nil {
+		disc.SlowModeSeconds = *input.SlowModeSeconds
+	}
+}
+
+// discussionUpdateEventTypes skips fields such as the last post, which
+// change with every post and already have their own event.
+// Only moderators can change what these events report, unlike the last post
+// bookkeeping that also goes through UpdateDiscussion.
+var discussionUpdateModerationActions = map[model.DiscussionSubscriptionEventType]model.ModerationAction{
+	model.DiscussionSubscriptionEventTypeDiscussionUpdated:  model.ModerationActionUpdateDiscussion,
+	model.DiscussionSubscriptionEventTypeDiscussionLocked:   model.ModerationActionLockDiscussion,
+	model.DiscussionSubscriptionEventTypeDiscussionUnlocked: model.ModerationActionUnlockDiscussion,
+}
+
+func discussionUpdateEventTypes(previous, updated *model.Discussion) []model.DiscussionSubscriptionEventType {
+	iconURLChanged := (previous.IconURL == nil) != (updated.IconURL == nil) ||
+		(previous.IconURL != nil && *previous.IconURL != *updated.IconURL)
+
+	var eventTypes []model.DiscussionSubscriptionEventType
+	if previous.Title != updated.Title ||
+		previous.Description != updated.Description ||
+		previous.AnonymityType != updated.AnonymityType ||
+		previous.DiscussionJoinability != updated.DiscussionJoinability ||
+		previous.EveryoneMentionPolicy != updated.EveryoneMentionPolicy ||
+		previous.SlowModeSeconds != updated.SlowModeSeconds ||
+		iconURLChanged {
+		eventTypes = append(eventTypes, model.DiscussionSubscriptionEventTypeDiscussionUpdated)
+	}
+	if !previous.LockStatus && updated.LockStatus {
+		eventTypes = append(eventTypes, model.DiscussionSubscriptionEventTypeDiscussionLocked)
+	} else if previous.LockStatus && !updated.LockStatus {
+		eventTypes = append(eventTypes, model.DiscussionSubscriptionEventTypeDiscussionUnlocked)
+	}
+	return eventTypes
 }
 
 func dedupeDiscussions(discussions []*model.Discussion) []*model.Discussion {
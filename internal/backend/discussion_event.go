@@ -0,0 +1,272 @@
+package backend
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/delphis-inc/delphisbe/graph/model"
+	"github.com/sirupsen/logrus"
+)
+
+// All discussion events are published on a single channel so that each
+// instance only needs one listener regardless of how many discussions its
+// clients are subscribed to.
+const discussionEventsChannel = "discussion_events"
+
+const (
+	postEntityType          = "post"
+	participantEntityType   = "participant"
+	discussionEntityType    = "discussion"
+	accessRequestEntityType = "access_request"
+)
+
+// A client further behind than this should refetch the discussion rather
+// than replay its events.
+const maxDiscussionEventReplay = 500
+
+// Sequences come from a bigserial, so an event can commit after one with a
+// higher sequence. Replays start this many sequences early so an event that
+// committed late is not missed by a client that already saw a later one.
+const discussionEventReplayLookback = 20
+
+// Events are persisted before they are published and only a reference to the
+// entity travels over the pubsub layer. Each instance resolves it from the
+// datastore before handing it to its local subscribers.
+func (d *delphisBackend) notifySubscribersOfEvent(ctx context.Context, event *model.DiscussionSubscriptionEvent, discussionID string) error {
+	record := model.DiscussionEvent{
+		DiscussionID: discussionID,
+		EventType:    event.EventType,
+	}
+	switch entity := event.Entity.(type) {
+	case *model.Post:
+		record.EntityType, record.EntityID = postEntityType, entity.ID
+	case *model.Participant:
+		record.EntityType, record.EntityID = participantEntityType, entity.ID
+	case *model.Discussion:
+		record.EntityType, record.EntityID = discussionEntityType, entity.ID
+	case *model.DiscussionAccessRequest:
+		record.EntityType, record.EntityID = accessRequestEntityType, entity.ID
+	default:
+		return fmt.Errorf("unsupported discussion event entity: %T", event.Entity)
+	}
+
+	persisted, err := d.db.PutDiscussionEvent(ctx, record)
+	if err != nil {
+		logrus.WithError(err).Error("failed to persist discussion event")
+		return err
+	}
+	event.Sequence = persisted.Sequence
+
+	payload, err := json.Marshal(persisted)
+	if err != nil {
+		logrus.WithError(err).Error("failed to marshal discussion event")
+		return err
+	}
+
+	if err := d.pubsub.Publish(ctx, discussionEventsChannel, payload); err != nil {
+		logrus.WithError(err).Error("failed to publish discussion event")
+		return err
+	}
+	return nil
+}
+
+// emitDiscussionEvent is for mutations that should succeed even when their
+// subscribers cannot be told about it.
+func (d *delphisBackend) emitDiscussionEvent(ctx context.Context, discussionID string, eventType model.DiscussionSubscriptionEventType, entity model.DiscussionSubscriptionEntity) {
+	event := &model.DiscussionSubscriptionEvent{
+		EventType: eventType,
+		Entity:    entity,
+	}
+	if err := d.notifySubscribersOfEvent(ctx, event, discussionID); err != nil {
+		logrus.WithError(err).Warnf("failed to notify subscribers of %s", eventType)
+	}
+}
+
+// handleDiscussionEventMessage receives events published by any instance and
+// delivers them to the subscribers connected to this one.
+func (d *delphisBackend) handleDiscussionEventMessage(ctx context.Context, payload []byte) {
+	record := model.DiscussionEvent{}
+	if err := json.Unmarshal(payload, &record); err != nil {
+		logrus.WithError(err).Error("failed to unmarshal discussion event")
+		return
+	}
+
+	if !d.hasLocalSubscribers(record.DiscussionID) {
+		return
+	}
+
+	event, err := d.resolveDiscussionEvent(ctx, &record)
+	if err != nil || event == nil {
+		return
+	}
+	d.deliverEventToLocalSubscribers(event, record.DiscussionID)
+
+	if post, ok := event.Entity.(*model.Post); ok && event.EventType == model.DiscussionSubscriptionEventTypePostAdded {
+		d.deliverPostToLocalSubscribers(post, record.DiscussionID)
+	}
+}
+
+// GetDiscussionEventsAfterSequence returns the persisted events for a
+// discussion from discussionEventReplayLookback sequences before the passed
+// one, oldest first. Events whose entity no longer exists or cannot be
+// resolved are skipped.
+func (d *delphisBackend) GetDiscussionEventsAfterSequence(ctx context.Context, discussionID string, afterSequence int) ([]*model.DiscussionSubscriptionEvent, error) {
+	fromSequence := afterSequence - discussionEventReplayLookback
+	if fromSequence < 0 {
+		fromSequence = 0
+	}
+	maxRecords := maxDiscussionEventReplay + afterSequence - fromSequence
+
+	records, err := d.db.GetDiscussionEventsAfterSequence(ctx, discussionID, fromSequence, maxRecords+1)
+	if err != nil {
+		logrus.WithError(err).Error("failed to get discussion events")
+		return nil, err
+	}
+	if len(records) > maxRecords {
+		return nil, fmt.Errorf("more than %d events to replay, refetch the discussion instead", maxDiscussionEventReplay)
+	}
+
+	events := make([]*model.DiscussionSubscriptionEvent, 0, len(records))
+	for _, record := range records {
+		event, err := d.resolveDiscussionEvent(ctx, record)
+		if err != nil {
+			// One bad event should not stop the client catching up on the rest
+			logrus.WithError(err).Warnf("skipping discussion event %d on replay", record.Sequence)
+			continue
+		}
+		if event != nil {
+			events = append(events, event)
+		}
+	}
+	return events, nil
+}
+
+func (d *delphisBackend) resolveDiscussionEvent(ctx context.Context, record *model.DiscussionEvent) (*model.DiscussionSubscriptionEvent, error) {
+	entity, err := d.resolveDiscussionEventEntity(ctx, record.EntityType, record.EntityID)
+	if err != nil {
+		logrus.WithError(err).Errorf("failed to resolve %s entity for discussion event", record.EntityType)
+		return nil, err
+	}
+	if entity == nil {
+		logrus.Debugf("%s %s for discussion event %d no longer exists", record.EntityType, record.EntityID, record.Sequence)
+		return nil, nil
+	}
+	return &model.DiscussionSubscriptionEvent{
+		Sequence:  record.Sequence,
+		EventType: record.EventType,
+		Entity:    entity,
+	}, nil
+}
+
+func (d *delphisBackend) resolveDiscussionEventEntity(ctx context.Context, entityType, entityID string) (model.DiscussionSubscriptionEntity, error) {
+	switch entityType {
+	case postEntityType:
+		post, err := d.db.GetPostByID(ctx, entityID)
+		if err != nil || post == nil {
+			return nil, err
+		}
+		if post.DeletedAt != nil {
+			// Match what DeletePostByID hands back so deleted content never leaks
+			post.PostContent = nil
+			post.PostContentID = nil
+			post.QuotedPostID = nil
+			post.MediaID = nil
+		}
+		return post, nil
+	case participantEntityType:
+		participant, err := d.db.GetParticipantByID(ctx, entityID)
+		if err != nil || participant == nil {
+			return nil, err
+		}
+		return participant, nil
+	case discussionEntityType:
+		discussion, err := d.db.GetDiscussionByID(ctx, entityID)
+		if err != nil || discussion == nil {
+			return nil, err
+		}
+		return discussion, nil
+	case accessRequestEntityType:
+		request, err := d.db.GetDiscussionRequestAccessByID(ctx, entityID)
+		if err != nil || request == nil {
+			return nil, err
+		}
+		return request, nil
+	default:
+		return nil, fmt.Errorf("unsupported entity type: %s", entityType)
+	}
+}
+
+func (d *delphisBackend) hasLocalSubscribers(discussionID string) bool {
+	d.discussionMutex.Lock()
+	defer d.discussionMutex.Unlock()
+	if subs, found := d.cache.Get(fmt.Sprintf(discussionEventSubscriberKey, discussionID)); found {
+		if eventSubs, ok := subs.(map[string]chan *model.DiscussionSubscriptionEvent); ok && len(eventSubs) > 0 {
+			return true
+		}
+	}
+	if subs, found := d.cache.Get(fmt.Sprintf(discussionSubscriberKey, discussionID)); found {
+		if postSubs, ok := subs.(map[string]chan *model.Post); ok && len(postSubs) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func (d *delphisBackend) deliverEventToLocalSubscribers(event *model.DiscussionSubscriptionEvent, discussionID string) {
+	cacheKey := fmt.Sprintf(discussionEventSubscriberKey, discussionID)
+	d.discussionMutex.Lock()
+	defer d.discussionMutex.Unlock()
+	currentSubsIface, found := d.cache.Get(cacheKey)
+	if !found {
+		currentSubsIface = map[string]chan *model.DiscussionSubscriptionEvent{}
+	}
+	var currentSubs map[string]chan *model.DiscussionSubscriptionEvent
+	var ok bool
+	if currentSubs, ok = currentSubsIface.(map[string]chan *model.DiscussionSubscriptionEvent); !ok {
+		currentSubs = map[string]chan *model.DiscussionSubscriptionEvent{}
+	}
+	for subscriptionID, channel := range currentSubs {
+		if channel != nil {
+			select {
+			case channel <- event:
+				logrus.Debugf("Sent message to channel for subscription ID: %s", subscriptionID)
+			default:
+				// Closing the channel ends the subscription so the client
+				// resubscribes and replays what it missed
+				logrus.Debugf("No message was sent. Removing the subscription")
+				delete(currentSubs, subscriptionID)
+				close(channel)
+			}
+		}
+	}
+	d.cache.Set(cacheKey, currentSubs, time.Hour)
+}
+
+func (d *delphisBackend) deliverPostToLocalSubscribers(post *model.Post, discussionID string) {
+	cacheKey := fmt.Sprintf(discussionSubscriberKey, discussionID)
+	d.discussionMutex.Lock()
+	defer d.discussionMutex.Unlock()
+	currentSubsIface, found := d.cache.Get(cacheKey)
+	if !found {
+		return
+	}
+	var currentSubs map[string]chan *model.Post
+	var ok bool
+	if currentSubs, ok = currentSubsIface.(map[string]chan *model.Post); !ok {
+		return
+	}
+	for subscriptionID, channel := range currentSubs {
+		if channel != nil {
+			select {
+			case channel <- post:
+				logrus.Debugf("Sent post to channel for subscription ID: %s", subscriptionID)
+			default:
+				logrus.Debugf("No post was sent. Removing the subscription")
+				delete(currentSubs, subscriptionID)
+			}
+		}
+	}
+	d.cache.Set(cacheKey, currentSubs, time.Hour)
+}
@@ -0,0 +1,345 @@
+package backend
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/delphis-inc/delphisbe/graph/model"
+	"github.com/delphis-inc/delphisbe/internal/auth"
+	"github.com/delphis-inc/delphisbe/internal/backend/test_utils"
+	"github.com/delphis-inc/delphisbe/internal/cache"
+	"github.com/delphis-inc/delphisbe/internal/config"
+	"github.com/delphis-inc/delphisbe/internal/pubsub"
+	"github.com/delphis-inc/delphisbe/internal/util"
+	"github.com/delphis-inc/delphisbe/mocks"
+	. "github.com/smartystreets/goconvey/convey"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestDelphisBackend_NotifySubscribersOfEvent(t *testing.T) {
+	ctx := context.Background()
+
+	discussionID := test_utils.DiscussionID
+	subscriptionID := "subscriptionID"
+
+	postObj := test_utils.TestPost()
+	participantObj := test_utils.TestParticipant()
+
+	Convey("NotifySubscribersOfEvent", t, func() {
+		now := time.Now()
+		cacheObj := cache.NewInMemoryCache()
+		authObj := auth.NewDelphisAuth(nil)
+		mockDB := &mocks.Datastore{}
+		backendObj := &delphisBackend{
+			db:              mockDB,
+			auth:            authObj,
+			cache:           cacheObj,
+			pubsub:          pubsub.NewInMemoryPubSub(),
+			discussionMutex: sync.Mutex{},
+			config:          config.Config{},
+			timeProvider:    &util.FrozenTime{NowTime: now},
+		}
+		So(backendObj.pubsub.Subscribe(ctx, discussionEventsChannel, backendObj.handleDiscussionEventMessage), ShouldBeNil)
+
+		Convey("when the event cannot be persisted", func() {
+			eventChannel := make(chan *model.DiscussionSubscriptionEvent, 1)
+			So(backendObj.SubscribeToDiscussionEvent(ctx, subscriptionID, eventChannel, discussionID), ShouldBeNil)
+
+			mockDB.On("PutDiscussionEvent", ctx, mock.Anything).Return(nil, fmt.Errorf("error"))
+
+			err := backendObj.NotifySubscribersOfCreatedPost(ctx, &postObj, discussionID)
+
+			So(err, ShouldNotBeNil)
+			So(eventChannel, ShouldBeEmpty)
+		})
+
+		// Hand out sequences the way the discussion_events table would
+		sequence := 0
+		mockDB.On("PutDiscussionEvent", ctx, mock.Anything).Return(func(ctx context.Context, event model.DiscussionEvent) *model.DiscussionEvent {
+			sequence++
+			event.Sequence = sequence
+			event.CreatedAt = now
+			return &event
+		}, nil)
+
+		Convey("when nobody is subscribed to the discussion", func() {
+			err := backendObj.NotifySubscribersOfCreatedPost(ctx, &postObj, discussionID)
+
+			So(err, ShouldBeNil)
+			mockDB.AssertCalled(t, "PutDiscussionEvent", ctx, model.DiscussionEvent{
+				DiscussionID: discussionID,
+				EventType:    model.DiscussionSubscriptionEventTypePostAdded,
+				EntityType:   postEntityType,
+				EntityID:     postObj.ID,
+			})
+			mockDB.AssertNotCalled(t, "GetPostByID", ctx, postObj.ID)
+		})
+
+		Convey("when a post is created", func() {
+			eventChannel := make(chan *model.DiscussionSubscriptionEvent, 1)
+			postChannel := make(chan *model.Post, 1)
+			So(backendObj.SubscribeToDiscussionEvent(ctx, subscriptionID, eventChannel, discussionID), ShouldBeNil)
+			So(backendObj.SubscribeToDiscussion(ctx, subscriptionID, postChannel, discussionID), ShouldBeNil)
+
+			Convey("and the post cannot be resolved", func() {
+				mockDB.On("GetPostByID", ctx, postObj.ID).Return(nil, fmt.Errorf("error"))
+
+				err := backendObj.NotifySubscribersOfCreatedPost(ctx, &postObj, discussionID)
+
+				So(err, ShouldBeNil)
+				So(eventChannel, ShouldBeEmpty)
+				So(postChannel, ShouldBeEmpty)
+			})
+
+			Convey("and the post is resolved", func() {
+				mockDB.On("GetPostByID", ctx, postObj.ID).Return(&postObj, nil)
+
+				err := backendObj.NotifySubscribersOfCreatedPost(ctx, &postObj, discussionID)
+
+				So(err, ShouldBeNil)
+				event := <-eventChannel
+				So(event.Sequence, ShouldEqual, 1)
+				So(event.EventType, ShouldEqual, model.DiscussionSubscriptionEventTypePostAdded)
+				So(event.Entity, ShouldResemble, &postObj)
+				So(<-postChannel, ShouldResemble, &postObj)
+			})
+
+			Convey("and several events are sent", func() {
+				mockDB.On("GetPostByID", ctx, postObj.ID).Return(&postObj, nil)
+				eventChannel = make(chan *model.DiscussionSubscriptionEvent, 2)
+				So(backendObj.SubscribeToDiscussionEvent(ctx, subscriptionID, eventChannel, discussionID), ShouldBeNil)
+
+				So(backendObj.NotifySubscribersOfCreatedPost(ctx, &postObj, discussionID), ShouldBeNil)
+				<-postChannel
+				So(backendObj.NotifySubscribersOfDeletedPost(ctx, &postObj, discussionID), ShouldBeNil)
+
+				So((<-eventChannel).Sequence, ShouldEqual, 1)
+				So((<-eventChannel).Sequence, ShouldEqual, 2)
+			})
+		})
+
+		Convey("when the same user is subscribed from several connections", func() {
+			firstChannel := make(chan *model.DiscussionSubscriptionEvent, 1)
+			secondChannel := make(chan *model.DiscussionSubscriptionEvent, 1)
+			So(backendObj.SubscribeToDiscussionEvent(ctx, "subscription1", firstChannel, discussionID), ShouldBeNil)
+			So(backendObj.SubscribeToDiscussionEvent(ctx, "subscription2", secondChannel, discussionID), ShouldBeNil)
+
+			mockDB.On("GetPostByID", ctx, postObj.ID).Return(&postObj, nil)
+
+			Convey("every connection receives the event", func() {
+				err := backendObj.NotifySubscribersOfCreatedPost(ctx, &postObj, discussionID)
+
+				So(err, ShouldBeNil)
+				So((<-firstChannel).Entity, ShouldResemble, &postObj)
+				So((<-secondChannel).Entity, ShouldResemble, &postObj)
+			})
+
+			Convey("a connection that falls behind is dropped and its channel closed", func() {
+				firstChannel <- &model.DiscussionSubscriptionEvent{}
+
+				err := backendObj.NotifySubscribersOfCreatedPost(ctx, &postObj, discussionID)
+
+				So(err, ShouldBeNil)
+				So((<-secondChannel).Entity, ShouldResemble, &postObj)
+				<-firstChannel
+				_, open := <-firstChannel
+				So(open, ShouldBeFalse)
+
+				So(backendObj.NotifySubscribersOfDeletedPost(ctx, &postObj, discussionID), ShouldBeNil)
+				So((<-secondChannel).Sequence, ShouldEqual, 2)
+			})
+
+			Convey("unsubscribing one connection leaves the other working", func() {
+				So(backendObj.UnSubscribeFromDiscussionEvent(ctx, "subscription1", discussionID), ShouldBeNil)
+
+				err := backendObj.NotifySubscribersOfCreatedPost(ctx, &postObj, discussionID)
+
+				So(err, ShouldBeNil)
+				So(firstChannel, ShouldBeEmpty)
+				So((<-secondChannel).Entity, ShouldResemble, &postObj)
+			})
+		})
+
+		Convey("when a post is deleted", func() {
+			eventChannel := make(chan *model.DiscussionSubscriptionEvent, 1)
+			So(backendObj.SubscribeToDiscussionEvent(ctx, subscriptionID, eventChannel, discussionID), ShouldBeNil)
+
+			deletedPost := test_utils.TestPost()
+			deletedPost.DeletedAt = &now
+			mockDB.On("GetPostByID", ctx, postObj.ID).Return(&deletedPost, nil)
+
+			err := backendObj.NotifySubscribersOfDeletedPost(ctx, &postObj, discussionID)
+
+			So(err, ShouldBeNil)
+			event := <-eventChannel
+			So(event.EventType, ShouldEqual, model.DiscussionSubscriptionEventTypePostDeleted)
+			So(event.Entity.(*model.Post).PostContent, ShouldBeNil)
+			So(event.Entity.(*model.Post).PostContentID, ShouldBeNil)
+		})
+
+		Convey("when a discussion is updated", func() {
+			eventChannel := make(chan *model.DiscussionSubscriptionEvent, 1)
+			So(backendObj.SubscribeToDiscussionEvent(ctx, subscriptionID, eventChannel, discussionID), ShouldBeNil)
+
+			discussionObj := test_utils.TestDiscussion()
+			mockDB.On("GetDiscussionByID", ctx, discussionObj.ID).Return(&discussionObj, nil)
+
+			backendObj.emitDiscussionEvent(ctx, discussionID, model.DiscussionSubscriptionEventTypeDiscussionUpdated, &discussionObj)
+
+			event := <-eventChannel
+			So(event.EventType, ShouldEqual, model.DiscussionSubscriptionEventTypeDiscussionUpdated)
+			So(event.Entity, ShouldResemble, &discussionObj)
+		})
+
+		Convey("when an access request is created", func() {
+			eventChannel := make(chan *model.DiscussionSubscriptionEvent, 1)
+			So(backendObj.SubscribeToDiscussionEvent(ctx, subscriptionID, eventChannel, discussionID), ShouldBeNil)
+
+			requestObj := test_utils.TestDiscussionAccessRequest(model.InviteRequestStatusPending)
+			mockDB.On("GetDiscussionRequestAccessByID", ctx, requestObj.ID).Return(&requestObj, nil)
+
+			backendObj.emitDiscussionEvent(ctx, discussionID, model.DiscussionSubscriptionEventTypeAccessRequestCreated, &requestObj)
+
+			event := <-eventChannel
+			So(event.EventType, ShouldEqual, model.DiscussionSubscriptionEventTypeAccessRequestCreated)
+			So(event.Entity, ShouldResemble, &requestObj)
+		})
+
+		Convey("when a participant is banned", func() {
+			eventChannel := make(chan *model.DiscussionSubscriptionEvent, 1)
+			So(backendObj.SubscribeToDiscussionEvent(ctx, subscriptionID, eventChannel, discussionID), ShouldBeNil)
+
+			mockDB.On("GetParticipantByID", ctx, participantObj.ID).Return(&participantObj, nil)
+
+			err := backendObj.NotifySubscribersOfBannedParticipant(ctx, &participantObj, discussionID)
+
+			So(err, ShouldBeNil)
+			event := <-eventChannel
+			So(event.EventType, ShouldEqual, model.DiscussionSubscriptionEventTypeParticipantBanned)
+			So(event.Entity, ShouldResemble, &participantObj)
+		})
+	})
+}
+
+func TestDelphisBackend_GetDiscussionEventsAfterSequence(t *testing.T) {
+	ctx := context.Background()
+
+	discussionID := test_utils.DiscussionID
+	afterSequence := 30
+	fromSequence := afterSequence - discussionEventReplayLookback
+	maxRecords := maxDiscussionEventReplay + discussionEventReplayLookback
+
+	postObj := test_utils.TestPost()
+	participantObj := test_utils.TestParticipant()
+
+	postEvent := test_utils.TestDiscussionEvent()
+	postEvent.Sequence = 31
+	participantEvent := test_utils.TestDiscussionEvent()
+	participantEvent.Sequence = 32
+	participantEvent.EventType = model.DiscussionSubscriptionEventTypeParticipantBanned
+	participantEvent.EntityType = participantEntityType
+	participantEvent.EntityID = participantObj.ID
+
+	Convey("GetDiscussionEventsAfterSequence", t, func() {
+		now := time.Now()
+		cacheObj := cache.NewInMemoryCache()
+		authObj := auth.NewDelphisAuth(nil)
+		mockDB := &mocks.Datastore{}
+		backendObj := &delphisBackend{
+			db:              mockDB,
+			auth:            authObj,
+			cache:           cacheObj,
+			pubsub:          pubsub.NewInMemoryPubSub(),
+			discussionMutex: sync.Mutex{},
+			config:          config.Config{},
+			timeProvider:    &util.FrozenTime{NowTime: now},
+		}
+
+		Convey("when the query errors out", func() {
+			mockDB.On("GetDiscussionEventsAfterSequence", ctx, discussionID, fromSequence, maxRecords+1).Return(nil, fmt.Errorf("error"))
+
+			resp, err := backendObj.GetDiscussionEventsAfterSequence(ctx, discussionID, afterSequence)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		Convey("when there are too many events to replay", func() {
+			records := make([]*model.DiscussionEvent, maxRecords+1)
+			mockDB.On("GetDiscussionEventsAfterSequence", ctx, discussionID, fromSequence, maxRecords+1).Return(records, nil)
+
+			resp, err := backendObj.GetDiscussionEventsAfterSequence(ctx, discussionID, afterSequence)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		Convey("when an entity fails to resolve", func() {
+			mockDB.On("GetDiscussionEventsAfterSequence", ctx, discussionID, fromSequence, maxRecords+1).Return([]*model.DiscussionEvent{&postEvent, &participantEvent}, nil)
+			mockDB.On("GetPostByID", ctx, postObj.ID).Return(nil, fmt.Errorf("error"))
+			mockDB.On("GetParticipantByID", ctx, participantObj.ID).Return(&participantObj, nil)
+
+			resp, err := backendObj.GetDiscussionEventsAfterSequence(ctx, discussionID, afterSequence)
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldResemble, []*model.DiscussionSubscriptionEvent{
+				{
+					Sequence:  participantEvent.Sequence,
+					EventType: model.DiscussionSubscriptionEventTypeParticipantBanned,
+					Entity:    &participantObj,
+				},
+			})
+		})
+
+		Convey("when the client is near the start of the discussion", func() {
+			mockDB.On("GetDiscussionEventsAfterSequence", ctx, discussionID, 0, maxDiscussionEventReplay+5+1).Return([]*model.DiscussionEvent{}, nil)
+
+			resp, err := backendObj.GetDiscussionEventsAfterSequence(ctx, discussionID, 5)
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldResemble, []*model.DiscussionSubscriptionEvent{})
+		})
+
+		Convey("when an entity no longer exists", func() {
+			mockDB.On("GetDiscussionEventsAfterSequence", ctx, discussionID, fromSequence, maxRecords+1).Return([]*model.DiscussionEvent{&postEvent, &participantEvent}, nil)
+			mockDB.On("GetPostByID", ctx, postObj.ID).Return(nil, nil)
+			mockDB.On("GetParticipantByID", ctx, participantObj.ID).Return(&participantObj, nil)
+
+			resp, err := backendObj.GetDiscussionEventsAfterSequence(ctx, discussionID, afterSequence)
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldResemble, []*model.DiscussionSubscriptionEvent{
+				{
+					Sequence:  participantEvent.Sequence,
+					EventType: model.DiscussionSubscriptionEventTypeParticipantBanned,
+					Entity:    &participantObj,
+				},
+			})
+		})
+
+		Convey("when every event resolves", func() {
+			mockDB.On("GetDiscussionEventsAfterSequence", ctx, discussionID, fromSequence, maxRecords+1).Return([]*model.DiscussionEvent{&postEvent, &participantEvent}, nil)
+			mockDB.On("GetPostByID", ctx, postObj.ID).Return(&postObj, nil)
+			mockDB.On("GetParticipantByID", ctx, participantObj.ID).Return(&participantObj, nil)
+
+			resp, err := backendObj.GetDiscussionEventsAfterSequence(ctx, discussionID, afterSequence)
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldResemble, []*model.DiscussionSubscriptionEvent{
+				{
+					Sequence:  postEvent.Sequence,
+					EventType: model.DiscussionSubscriptionEventTypePostAdded,
+					Entity:    &postObj,
+				},
+				{
+					Sequence:  participantEvent.Sequence,
+					EventType: model.DiscussionSubscriptionEventTypeParticipantBanned,
+					Entity:    &participantObj,
+				},
+			})
+		})
+	})
+}
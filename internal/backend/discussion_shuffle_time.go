@@ -49,7 +49,7 @@ func (d *delphisBackend) GetDiscussionIDsToBeShuffledBeforeTime(ctx context.Cont
 	return resp, nil
 }
 
-func (d *delphisBackend) PutDiscussionShuffleTime(ctx context.Context, discussionID string, shuffleTime *time.Time) (*model.DiscussionShuffleTime, error) {
+func (d *delphisBackend) PutDiscussionShuffleTime(ctx context.Context, discussionID string, shuffleTime *time.Time, requestingUserID string) (*model.DiscussionShuffleTime, error) {
 	tx, err := d.db.BeginTx(ctx)
 	if err != nil {
 		logrus.WithError(err).Error("failed to begin tx")
@@ -71,6 +71,11 @@ func (d *delphisBackend) PutDiscussionShuffleTime(ctx context.Context, discussio
 		return nil, err
 	}
 
+	d.emitDiscussionEvent(ctx, discussionID, model.DiscussionSubscriptionEventTypeShuffleScheduled, &model.Discussion{ID: discussionID})
+	if shuffleTime != nil {
+		d.recordModerationAction(ctx, discussionID, requestingUserID, model.ModerationActionScheduleShuffle, model.ModerationTargetTypeDiscussion, discussionID, nil)
+	}
+
 	return dst, nil
 }
 
@@ -90,6 +95,7 @@ func (d *delphisBackend) ShuffleDiscussionsIfNecessary() {
 		return
 	}
 
+	shuffledDiscussionIDs := make([]string, 0, len(discussionIDsToShuffle))
 	for _, discussionID := range discussionIDsToShuffle {
 		_, err := d.IncrementDiscussionShuffleCount(ctx, tx, discussionID)
 		if err != nil {
@@ -108,6 +114,7 @@ func (d *delphisBackend) ShuffleDiscussionsIfNecessary() {
 				}
 				return
 			}
+			shuffledDiscussionIDs = append(shuffledDiscussionIDs, discussionID)
 		}
 	}
 
@@ -116,4 +123,9 @@ func (d *delphisBackend) ShuffleDiscussionsIfNecessary() {
 		logrus.WithError(txErr).Errorf("failed committing transaction")
 		return
 	}
+
+	for _, discussionID := range shuffledDiscussionIDs {
+		d.emitDiscussionEvent(ctx, discussionID, model.DiscussionSubscriptionEventTypeShuffleCompleted, &model.Discussion{ID: discussionID})
+		d.recordModerationAction(ctx, discussionID, model.ConciergeUser, model.ModerationActionShuffleDiscussion, model.ModerationTargetTypeDiscussion, discussionID, nil)
+	}
 }
@@ -15,6 +15,7 @@ import (
 	"github.com/delphis-inc/delphisbe/internal/backend/test_utils"
 	"github.com/delphis-inc/delphisbe/internal/cache"
 	"github.com/delphis-inc/delphisbe/internal/config"
+	"github.com/delphis-inc/delphisbe/internal/pubsub"
 	"github.com/delphis-inc/delphisbe/internal/util"
 	"github.com/delphis-inc/delphisbe/mocks"
 	. "github.com/smartystreets/goconvey/convey"
@@ -93,6 +94,13 @@ func TestDelphisBackend_PutDiscussionShuffleTime(t *testing.T) {
 	ctx := context.Background()
 	discussionObj := test_utils.TestDiscussion()
 	discussionShuffleTime := test_utils.TestDiscussionShuffleTime()
+	eventObj := test_utils.TestDiscussionEvent()
+	scheduledEvent := model.DiscussionEvent{
+		DiscussionID: discussionObj.ID,
+		EventType:    model.DiscussionSubscriptionEventTypeShuffleScheduled,
+		EntityType:   discussionEntityType,
+		EntityID:     discussionObj.ID,
+	}
 
 	Convey("PutDiscussionShuffleTime", t, func() {
 		now := time.Now()
@@ -103,6 +111,7 @@ func TestDelphisBackend_PutDiscussionShuffleTime(t *testing.T) {
 			db:              mockDB,
 			auth:            authObj,
 			cache:           cacheObj,
+			pubsub:          pubsub.NewInMemoryPubSub(),
 			discussionMutex: sync.Mutex{},
 			config:          config.Config{},
 			timeProvider:    &util.FrozenTime{NowTime: now},
@@ -111,7 +120,7 @@ func TestDelphisBackend_PutDiscussionShuffleTime(t *testing.T) {
 		Convey("when begin transaction fails", func() {
 			mockDB.On("BeginTx", ctx).Return(nil, fmt.Errorf("sth"))
 
-			resp, err := backendObj.PutDiscussionShuffleTime(ctx, discussionObj.ID, &now)
+			resp, err := backendObj.PutDiscussionShuffleTime(ctx, discussionObj.ID, &now, test_utils.UserID)
 
 			So(err, ShouldNotBeNil)
 			So(resp, ShouldBeNil)
@@ -125,7 +134,7 @@ func TestDelphisBackend_PutDiscussionShuffleTime(t *testing.T) {
 					mockDB.On("PutNextShuffleTimeForDiscussionID", ctx, &tx, discussionObj.ID, &now).Return(nil, fmt.Errorf("sth"))
 					mockDB.On("RollbackTx", ctx, &tx).Return(fmt.Errorf("sth"))
 
-					resp, err := backendObj.PutDiscussionShuffleTime(ctx, discussionObj.ID, &now)
+					resp, err := backendObj.PutDiscussionShuffleTime(ctx, discussionObj.ID, &now, test_utils.UserID)
 
 					So(err, ShouldNotBeNil)
 					So(resp, ShouldBeNil)
@@ -134,7 +143,7 @@ func TestDelphisBackend_PutDiscussionShuffleTime(t *testing.T) {
 					mockDB.On("PutNextShuffleTimeForDiscussionID", ctx, &tx, discussionObj.ID, &now).Return(nil, fmt.Errorf("sth"))
 					mockDB.On("RollbackTx", ctx, &tx).Return(nil)
 
-					resp, err := backendObj.PutDiscussionShuffleTime(ctx, discussionObj.ID, &now)
+					resp, err := backendObj.PutDiscussionShuffleTime(ctx, discussionObj.ID, &now, test_utils.UserID)
 
 					So(err, ShouldNotBeNil)
 					So(resp, ShouldBeNil)
@@ -145,7 +154,7 @@ func TestDelphisBackend_PutDiscussionShuffleTime(t *testing.T) {
 					mockDB.On("PutNextShuffleTimeForDiscussionID", ctx, &tx, discussionObj.ID, &now).Return(&discussionShuffleTime, nil)
 					mockDB.On("CommitTx", ctx, &tx).Return(fmt.Errorf("sth"))
 
-					resp, err := backendObj.PutDiscussionShuffleTime(ctx, discussionObj.ID, &now)
+					resp, err := backendObj.PutDiscussionShuffleTime(ctx, discussionObj.ID, &now, test_utils.UserID)
 
 					So(err, ShouldNotBeNil)
 					So(resp, ShouldBeNil)
@@ -153,11 +162,16 @@ func TestDelphisBackend_PutDiscussionShuffleTime(t *testing.T) {
 				Convey("and commit succeeds", func() {
 					mockDB.On("PutNextShuffleTimeForDiscussionID", ctx, &tx, discussionObj.ID, &now).Return(&discussionShuffleTime, nil)
 					mockDB.On("CommitTx", ctx, &tx).Return(nil)
+					mockDB.On("PutDiscussionEvent", ctx, scheduledEvent).Return(&eventObj, nil)
+					mockDB.On("PutModerationLogEntry", ctx, mock.Anything).Return(&model.ModerationLogEntry{}, nil)
 
-					resp, err := backendObj.PutDiscussionShuffleTime(ctx, discussionObj.ID, &now)
+					resp, err := backendObj.PutDiscussionShuffleTime(ctx, discussionObj.ID, &now, test_utils.UserID)
 
 					So(err, ShouldBeNil)
 					So(resp, ShouldResemble, &discussionShuffleTime)
+					mockDB.AssertCalled(t, "PutModerationLogEntry", ctx, mock.MatchedBy(func(entry model.ModerationLogEntry) bool {
+						return entry.Action == model.ModerationActionScheduleShuffle && entry.ActorUserID == test_utils.UserID
+					}))
 				})
 			})
 		})
@@ -172,6 +186,7 @@ func TestDelphisBackend_ShuffleDiscussionsIfNecessary(t *testing.T) {
 
 	parObj := test_utils.TestParticipant()
 	postObj := test_utils.TestPost()
+	eventObj := test_utils.TestDiscussionEvent()
 	discussionObj := test_utils.TestDiscussion()
 	// discussionShuffleTime := test_utils.TestDiscussionShuffleTime()
 
@@ -184,6 +199,7 @@ func TestDelphisBackend_ShuffleDiscussionsIfNecessary(t *testing.T) {
 			db:              mockDB,
 			auth:            authObj,
 			cache:           cacheObj,
+			pubsub:          pubsub.NewInMemoryPubSub(),
 			discussionMutex: sync.Mutex{},
 			config:          config.Config{},
 			timeProvider:    &util.FrozenTime{NowTime: now},
@@ -249,8 +265,20 @@ func TestDelphisBackend_ShuffleDiscussionsIfNecessary(t *testing.T) {
 
 					mockDB.On("PutNextShuffleTimeForDiscussionID", ctx, &tx, discussionObj.ID, nilTime).Return(nil, nil)
 					mockDB.On("CommitTx", ctx, &tx).Return(nil)
+					mockDB.On("PutDiscussionEvent", ctx, mock.Anything).Return(&eventObj, nil)
+					mockDB.On("PutModerationLogEntry", ctx, mock.Anything).Return(&model.ModerationLogEntry{}, nil)
 
 					backendObj.ShuffleDiscussionsIfNecessary()
+
+					mockDB.AssertCalled(t, "PutDiscussionEvent", ctx, model.DiscussionEvent{
+						DiscussionID: discussionObj.ID,
+						EventType:    model.DiscussionSubscriptionEventTypeShuffleCompleted,
+						EntityType:   discussionEntityType,
+						EntityID:     discussionObj.ID,
+					})
+					mockDB.AssertCalled(t, "PutModerationLogEntry", ctx, mock.MatchedBy(func(entry model.ModerationLogEntry) bool {
+						return entry.Action == model.ModerationActionShuffleDiscussion && entry.ActorUserID == model.ConciergeUser
+					}))
 				})
 			})
 		})
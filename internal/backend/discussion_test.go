@@ -18,6 +18,7 @@ import (
 	"github.com/delphis-inc/delphisbe/internal/auth"
 	"github.com/delphis-inc/delphisbe/internal/cache"
 	"github.com/delphis-inc/delphisbe/internal/config"
+	"github.com/delphis-inc/delphisbe/internal/pubsub"
 	"github.com/delphis-inc/delphisbe/internal/util"
 	"github.com/delphis-inc/delphisbe/mocks"
 	. "github.com/smartystreets/goconvey/convey"
@@ -45,6 +46,7 @@ func TestDelphisBackend_CreateNewDiscussion(t *testing.T) {
 	viewerObj := test_utils.TestViewer()
 
 	parObj := test_utils.TestParticipant()
+	eventObj := test_utils.TestDiscussionEvent()
 
 	tx := sql.Tx{}
 
@@ -57,6 +59,7 @@ func TestDelphisBackend_CreateNewDiscussion(t *testing.T) {
 			db:              mockDB,
 			auth:            authObj,
 			cache:           cacheObj,
+			pubsub:          pubsub.NewInMemoryPubSub(),
 			discussionMutex: sync.Mutex{},
 			config:          config.Config{},
 			timeProvider:    &util.FrozenTime{NowTime: now},
@@ -162,6 +165,7 @@ func TestDelphisBackend_CreateNewDiscussion(t *testing.T) {
 			mockDB.On("GetDUAForEverythingNotifications", ctx, discussionID, mock.Anything).Return(nil)
 			mockDB.On("DuaIterCollect", ctx, mock.Anything).Return(nil, nil)
 			mockDB.On("GetUserDevicesByUserID", ctx, mock.Anything).Return(nil, nil)
+			mockDB.On("PutDiscussionEvent", ctx, mock.Anything).Return(&eventObj, nil)
 
 			mockDB.On("BeginTx", ctx).Return(tx, nil)
 			mockDB.On("PutAccessLinkForDiscussion", ctx, mock.Anything, mock.Anything).Return(nil, expectedError)
@@ -215,6 +219,7 @@ func TestDelphisBackend_CreateNewDiscussion(t *testing.T) {
 			mockDB.On("GetDUAForEverythingNotifications", ctx, discussionID, mock.Anything).Return(nil)
 			mockDB.On("DuaIterCollect", ctx, mock.Anything).Return(nil, nil)
 			mockDB.On("GetUserDevicesByUserID", ctx, mock.Anything).Return(nil, nil)
+			mockDB.On("PutDiscussionEvent", ctx, mock.Anything).Return(&eventObj, nil)
 
 			mockDB.On("BeginTx", ctx).Return(tx, nil)
 			mockDB.On("PutAccessLinkForDiscussion", ctx, mock.Anything, mock.Anything).Return(
@@ -717,9 +722,19 @@ func TestDelphisBackend_UpdateDiscussion(t *testing.T) {
 	postObj := test_utils.TestPost()
 	contentObj := test_utils.TestPostContent()
 	archiveObj := test_utils.TestDiscussionArchive()
+	eventObj := test_utils.TestDiscussionEvent()
 
 	postObj.PostContent = &contentObj
 
+	updatedEvent := model.DiscussionEvent{
+		DiscussionID: discussionID,
+		EventType:    model.DiscussionSubscriptionEventTypeDiscussionUpdated,
+		EntityType:   discussionEntityType,
+		EntityID:     discObj.ID,
+	}
+	lockedEvent := updatedEvent
+	lockedEvent.EventType = model.DiscussionSubscriptionEventTypeDiscussionLocked
+
 	tx := sql.Tx{}
 
 	Convey("UpdateDiscussion", t, func() {
@@ -731,6 +746,7 @@ func TestDelphisBackend_UpdateDiscussion(t *testing.T) {
 			db:              mockDB,
 			auth:            authObj,
 			cache:           cacheObj,
+			pubsub:          pubsub.NewInMemoryPubSub(),
 			discussionMutex: sync.Mutex{},
 			config:          config.Config{},
 			timeProvider:    &util.FrozenTime{NowTime: now},
@@ -740,7 +756,7 @@ func TestDelphisBackend_UpdateDiscussion(t *testing.T) {
 			expectedError := fmt.Errorf("Some Error")
 			mockDB.On("GetDiscussionByID", ctx, discussionID).Return(nil, expectedError)
 
-			resp, err := backendObj.UpdateDiscussion(ctx, discussionID, discInput)
+			resp, err := backendObj.UpdateDiscussion(ctx, discussionID, test_utils.UserID, discInput)
 
 			So(err, ShouldEqual, expectedError)
 			So(resp, ShouldBeNil)
@@ -751,7 +767,7 @@ func TestDelphisBackend_UpdateDiscussion(t *testing.T) {
 			mockDB.On("GetDiscussionByID", ctx, discussionID).Return(&discObj, nil)
 			mockDB.On("UpsertDiscussion", ctx, mock.Anything).Return(nil, expectedError)
 
-			resp, err := backendObj.UpdateDiscussion(ctx, discussionID, discInput)
+			resp, err := backendObj.UpdateDiscussion(ctx, discussionID, test_utils.UserID, discInput)
 
 			So(err, ShouldEqual, expectedError)
 			So(resp, ShouldBeNil)
@@ -760,8 +776,9 @@ func TestDelphisBackend_UpdateDiscussion(t *testing.T) {
 		Convey("when the discussion is updated successfully", func() {
 			mockDB.On("GetDiscussionByID", ctx, discussionID).Return(&discObj, nil)
 			mockDB.On("UpsertDiscussion", ctx, mock.Anything).Return(&discObj, nil)
+			mockDB.On("PutDiscussionEvent", ctx, updatedEvent).Return(&eventObj, nil)
 
-			resp, err := backendObj.UpdateDiscussion(ctx, discussionID, discInput)
+			resp, err := backendObj.UpdateDiscussion(ctx, discussionID, test_utils.UserID, discInput)
 
 			So(err, ShouldBeNil)
 			So(resp, ShouldNotBeNil)
@@ -785,7 +802,9 @@ func TestDelphisBackend_UpdateDiscussion(t *testing.T) {
 			updateInput := model.DiscussionInput{
 				Title: &newTitle,
 			}
-			resp, err := backendObj.UpdateDiscussion(ctx, discussionID, updateInput)
+			mockDB.On("PutDiscussionEvent", ctx, updatedEvent).Return(&eventObj, nil)
+			mockDB.On("PutModerationLogEntry", ctx, mock.Anything).Return(&model.ModerationLogEntry{}, nil)
+			resp, err := backendObj.UpdateDiscussion(ctx, discussionID, test_utils.UserID, updateInput)
 
 			So(err, ShouldBeNil)
 			So(resp, ShouldNotBeNil)
@@ -809,7 +828,9 @@ func TestDelphisBackend_UpdateDiscussion(t *testing.T) {
 			updateInput := model.DiscussionInput{
 				Description: &newDescription,
 			}
-			resp, err := backendObj.UpdateDiscussion(ctx, discussionID, updateInput)
+			mockDB.On("PutDiscussionEvent", ctx, updatedEvent).Return(&eventObj, nil)
+			mockDB.On("PutModerationLogEntry", ctx, mock.Anything).Return(&model.ModerationLogEntry{}, nil)
+			resp, err := backendObj.UpdateDiscussion(ctx, discussionID, test_utils.UserID, updateInput)
 
 			So(err, ShouldBeNil)
 			So(resp, ShouldNotBeNil)
@@ -831,11 +852,28 @@ func TestDelphisBackend_UpdateDiscussion(t *testing.T) {
 			updateInput := model.DiscussionInput{
 				LockStatus: &trueVal,
 			}
-			resp, err := backendObj.UpdateDiscussion(ctx, discussionID, updateInput)
+			mockDB.On("PutDiscussionEvent", ctx, lockedEvent).Return(&eventObj, nil)
+			mockDB.On("PutModerationLogEntry", ctx, mock.Anything).Return(&model.ModerationLogEntry{}, nil)
+			resp, err := backendObj.UpdateDiscussion(ctx, discussionID, test_utils.UserID, updateInput)
 
 			So(err, ShouldBeNil)
 			So(resp, ShouldNotBeNil)
 		})
+
+		Convey("when a moderator updates the discussion, the action is logged", func() {
+			mockDB.On("GetDiscussionByID", ctx, discussionID).Return(&discObj, nil)
+			mockDB.On("UpsertDiscussion", ctx, mock.Anything).Return(&discObj, nil)
+			mockDB.On("PutDiscussionEvent", ctx, updatedEvent).Return(&eventObj, nil)
+			mockDB.On("PutModerationLogEntry", ctx, mock.MatchedBy(func(entry model.ModerationLogEntry) bool {
+				return entry.Action == model.ModerationActionUpdateDiscussion && entry.ActorUserID == test_utils.UserID
+			})).Return(&model.ModerationLogEntry{}, nil)
+
+			resp, err := backendObj.UpdateDiscussion(ctx, discussionID, test_utils.UserID, discInput)
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldNotBeNil)
+			mockDB.AssertCalled(t, "PutModerationLogEntry", ctx, mock.Anything)
+		})
 	})
 }
 
@@ -1308,6 +1346,51 @@ func TestDelphisBackend_anonymizePostsForArchive(t *testing.T) {
 			So(resp, ShouldResemble, []*model.ArchivedPost{&testResult})
 		})
 
+		Convey("when the post is pinned", func() {
+			tempPost := postObj
+			tempPost.PinnedAt = &now
+			tempPosts := []*model.Post{&tempPost}
+
+			// Expected result
+			testResult := expectedResult
+			testResult.IsPinned = true
+
+			resp, err := anonymizePostsForArchive(ctx, tempPosts, shuffleCount)
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldResemble, []*model.ArchivedPost{&testResult})
+		})
+
+		Convey("when the post is a poll", func() {
+			tempPost := postObj
+			tempPost.PostType = model.PostTypePoll
+			tempPost.Poll = &model.Poll{
+				PostID:      tempPost.ID,
+				IsAnonymous: true,
+				Options: []*model.PollOption{
+					{ID: "option1", Text: "yes", VoteCount: 2, MeVoted: true},
+					{ID: "option2", Text: "no", VoteCount: 1},
+				},
+			}
+			tempPosts := []*model.Post{&tempPost}
+
+			// Expected result
+			testResult := expectedResult
+			testResult.PostType = model.PostTypePoll
+			testResult.Poll = &model.ArchivedPoll{
+				IsAnonymous: true,
+				Options: []*model.ArchivedPollOption{
+					{Text: "yes", VoteCount: 2},
+					{Text: "no", VoteCount: 1},
+				},
+			}
+
+			resp, err := anonymizePostsForArchive(ctx, tempPosts, shuffleCount)
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldResemble, []*model.ArchivedPost{&testResult})
+		})
+
 	})
 }
 
@@ -1328,6 +1411,54 @@ func TestDelphisBackend_UpdateDiscussionObj(t *testing.T) {
 	})
 }
 
+func TestDelphisBackend_DiscussionUpdateEventTypes(t *testing.T) {
+	iconURL := "http://test.com"
+	newIconURL := "http://test.com/new"
+	postID := test_utils.PostID
+
+	Convey("DiscussionUpdateEventTypes", t, func() {
+		previous := test_utils.TestDiscussion()
+		previous.IconURL = &iconURL
+		updated := previous
+
+		Convey("when nothing changes", func() {
+			So(discussionUpdateEventTypes(&previous, &updated), ShouldBeEmpty)
+		})
+
+		Convey("when only the last post changes", func() {
+			updated.LastPostID = &postID
+
+			So(discussionUpdateEventTypes(&previous, &updated), ShouldBeEmpty)
+		})
+
+		Convey("when the icon changes", func() {
+			updated.IconURL = &newIconURL
+
+			So(discussionUpdateEventTypes(&previous, &updated), ShouldResemble, []model.DiscussionSubscriptionEventType{
+				model.DiscussionSubscriptionEventTypeDiscussionUpdated,
+			})
+		})
+
+		Convey("when the title changes and the discussion is locked", func() {
+			updated.Title = "new title"
+			updated.LockStatus = true
+
+			So(discussionUpdateEventTypes(&previous, &updated), ShouldResemble, []model.DiscussionSubscriptionEventType{
+				model.DiscussionSubscriptionEventTypeDiscussionUpdated,
+				model.DiscussionSubscriptionEventTypeDiscussionLocked,
+			})
+		})
+
+		Convey("when the discussion is unlocked", func() {
+			previous.LockStatus = true
+
+			So(discussionUpdateEventTypes(&previous, &updated), ShouldResemble, []model.DiscussionSubscriptionEventType{
+				model.DiscussionSubscriptionEventTypeDiscussionUnlocked,
+			})
+		})
+	})
+}
+
 func TestDelphisBackend_DedupeDiscussions(t *testing.T) {
 	disc1 := test_utils.TestDiscussion()
 	disc2 := test_utils.TestDiscussion()
@@ -0,0 +1,96 @@
+package backend
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/delphis-inc/delphisbe/graph/model"
+	"github.com/delphis-inc/delphisbe/internal/util"
+	"github.com/sirupsen/logrus"
+)
+
+// parseMentionGroup maps the id of a group entity, such as the "everyone" in
+// "group:everyone", to its group.
+func parseMentionGroup(id string) (model.MentionGroup, error) {
+	group := model.MentionGroup(strings.ToUpper(id))
+	if id != strings.ToLower(id) || !group.IsValid() {
+		return "", fmt.Errorf("Unknown mention group %s", id)
+	}
+	return group, nil
+}
+
+func mentionsGroup(entities []string, group model.MentionGroup) bool {
+	for _, entityID := range entities {
+		entity, err := util.ReturnParsedEntityID(entityID)
+		if err != nil || entity.Type != model.GroupPrefix {
+			continue
+		}
+		if mentioned, err := parseMentionGroup(entity.ID); err == nil && mentioned == group {
+			return true
+		}
+	}
+	return false
+}
+
+// checkGroupMentionPermission enforces the discussion's EveryoneMentionPolicy.
+// Anyone may mention @moderator since that is how moderators are asked for help.
+func (d *delphisBackend) checkGroupMentionPermission(ctx context.Context, discussionID string, userID string, entities []string) error {
+	if !mentionsGroup(entities, model.MentionGroupEveryone) {
+		return nil
+	}
+
+	discussion, err := d.GetDiscussionByID(ctx, discussionID)
+	if err != nil || discussion == nil {
+		return fmt.Errorf("Discussion not found")
+	}
+
+	switch discussion.EveryoneMentionPolicy {
+	case model.EveryoneMentionPolicyParticipants:
+		return nil
+	case model.EveryoneMentionPolicyNobody:
+		return newMentionError(model.MentionErrorCodeNotAllowed, "group:everyone", "@everyone is disabled in this discussion")
+	default:
+		isModerator, err := d.CheckIfModeratorForDiscussion(ctx, userID, discussionID)
+		if err != nil {
+			logrus.WithError(err).Error("failed to check if moderator for discussion")
+			return err
+		}
+		if !isModerator {
+			return newMentionError(model.MentionErrorCodeNotAllowed, "group:everyone", "Only moderators can mention @everyone in this discussion")
+		}
+		return nil
+	}
+}
+
+// getModeratorUserIDs returns the owner along with every user granted a role,
+// who are notified by an @moderator mention.
+func (d *delphisBackend) getModeratorUserIDs(ctx context.Context, discussionID string) ([]string, error) {
+	participants, err := d.db.GetModeratorParticipantsByDiscussionID(ctx, discussionID)
+	if err != nil {
+		logrus.WithError(err).Error("failed to get moderator participants")
+		return nil, err
+	}
+
+	grants, err := d.db.GetModeratorRolesByDiscussionID(ctx, discussionID)
+	if err != nil {
+		logrus.WithError(err).Error("failed to get moderator roles")
+		return nil, err
+	}
+
+	seen := map[string]bool{}
+	userIDs := make([]string, 0)
+	for _, participant := range participants {
+		if participant.UserID != nil && !seen[*participant.UserID] {
+			seen[*participant.UserID] = true
+			userIDs = append(userIDs, *participant.UserID)
+		}
+	}
+	for _, grant := range grants {
+		if !seen[grant.UserID] {
+			seen[grant.UserID] = true
+			userIDs = append(userIDs, grant.UserID)
+		}
+	}
+	return userIDs, nil
+}
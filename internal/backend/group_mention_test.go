@@ -0,0 +1,189 @@
+package backend
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/delphis-inc/delphisbe/graph/model"
+	"github.com/delphis-inc/delphisbe/internal/auth"
+	"github.com/delphis-inc/delphisbe/internal/backend/test_utils"
+	"github.com/delphis-inc/delphisbe/internal/cache"
+	"github.com/delphis-inc/delphisbe/internal/config"
+	"github.com/delphis-inc/delphisbe/internal/util"
+	"github.com/delphis-inc/delphisbe/mocks"
+	. "github.com/smartystreets/goconvey/convey"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestDelphisBackend_checkGroupMentionPermission(t *testing.T) {
+	ctx := context.Background()
+	discussionID := test_utils.DiscussionID
+	userID := test_utils.UserID
+	everyone := []string{"group:everyone"}
+
+	Convey("checkGroupMentionPermission", t, func() {
+		now := time.Now()
+		mockDB := &mocks.Datastore{}
+		backendObj := &delphisBackend{
+			db:              mockDB,
+			auth:            auth.NewDelphisAuth(nil),
+			cache:           cache.NewInMemoryCache(),
+			discussionMutex: sync.Mutex{},
+			config:          config.Config{},
+			timeProvider:    &util.FrozenTime{NowTime: now},
+		}
+
+		discObj := test_utils.TestDiscussion()
+		modObj := test_utils.TestModerator()
+
+		Convey("when @everyone is not mentioned", func() {
+			err := backendObj.checkGroupMentionPermission(ctx, discussionID, userID, []string{"group:moderator"})
+
+			So(err, ShouldBeNil)
+			mockDB.AssertNotCalled(t, "GetDiscussionByID", ctx, discussionID)
+		})
+
+		Convey("when the discussion is not found", func() {
+			mockDB.On("GetDiscussionByID", ctx, discussionID).Return(nil, nil)
+
+			err := backendObj.checkGroupMentionPermission(ctx, discussionID, userID, everyone)
+
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("when the policy allows participants", func() {
+			discObj.EveryoneMentionPolicy = model.EveryoneMentionPolicyParticipants
+			mockDB.On("GetDiscussionByID", ctx, discussionID).Return(&discObj, nil)
+
+			err := backendObj.checkGroupMentionPermission(ctx, discussionID, userID, everyone)
+
+			So(err, ShouldBeNil)
+		})
+
+		Convey("when the policy allows nobody", func() {
+			discObj.EveryoneMentionPolicy = model.EveryoneMentionPolicyNobody
+			mockDB.On("GetDiscussionByID", ctx, discussionID).Return(&discObj, nil)
+
+			err := backendObj.checkGroupMentionPermission(ctx, discussionID, userID, everyone)
+
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("when the policy allows moderators", func() {
+			discObj.EveryoneMentionPolicy = model.EveryoneMentionPolicyModerators
+			mockDB.On("GetDiscussionByID", ctx, discussionID).Return(&discObj, nil)
+
+			Convey("when the moderator check errors out", func() {
+				mockDB.On("GetModeratorByUserIDAndDiscussionID", ctx, userID, discussionID).Return(nil, fmt.Errorf("sth"))
+
+				err := backendObj.checkGroupMentionPermission(ctx, discussionID, userID, everyone)
+
+				So(err, ShouldNotBeNil)
+			})
+
+			Convey("when the user is not a moderator", func() {
+				mockDB.On("GetModeratorByUserIDAndDiscussionID", ctx, userID, discussionID).Return(nil, nil)
+				mockDB.On("GetModeratorRoleByUserIDAndDiscussionID", ctx, userID, discussionID).Return(nil, nil)
+
+				err := backendObj.checkGroupMentionPermission(ctx, discussionID, userID, everyone)
+
+				So(err, ShouldNotBeNil)
+			})
+
+			Convey("when the user is a moderator", func() {
+				mockDB.On("GetModeratorByUserIDAndDiscussionID", ctx, userID, discussionID).Return(&modObj, nil)
+
+				err := backendObj.checkGroupMentionPermission(ctx, discussionID, userID, everyone)
+
+				So(err, ShouldBeNil)
+			})
+		})
+	})
+}
+
+func TestDelphisBackend_getMentionedUsersToNotify(t *testing.T) {
+	ctx := context.Background()
+	discussionID := test_utils.DiscussionID
+	userID := test_utils.UserID
+	moderatorUserID := "moderatorUserID"
+
+	Convey("getMentionedUsersToNotify", t, func() {
+		now := time.Now()
+		mockDB := &mocks.Datastore{}
+		backendObj := &delphisBackend{
+			db:              mockDB,
+			auth:            auth.NewDelphisAuth(nil),
+			cache:           cache.NewInMemoryCache(),
+			discussionMutex: sync.Mutex{},
+			config:          config.Config{},
+			timeProvider:    &util.FrozenTime{NowTime: now},
+		}
+
+		duaObj := test_utils.TestDiscussionUserAccess()
+		modParticipant := test_utils.TestParticipant()
+		modParticipant.UserID = &moderatorUserID
+
+		Convey("when @everyone is mentioned", func() {
+			mockDB.On("GetDUAForEveryoneMentionNotifications", ctx, discussionID, userID).Return(nil)
+
+			Convey("when collecting the users errors out", func() {
+				mockDB.On("DuaIterCollect", ctx, mock.Anything).Return(nil, fmt.Errorf("sth"))
+
+				resp, err := backendObj.getMentionedUsersToNotify(ctx, userID, discussionID, []string{"group:everyone"})
+
+				So(err, ShouldNotBeNil)
+				So(resp, ShouldBeNil)
+			})
+
+			Convey("when every subscribed user is returned", func() {
+				mockDB.On("DuaIterCollect", ctx, mock.Anything).Return([]*model.DiscussionUserAccess{&duaObj}, nil)
+
+				resp, err := backendObj.getMentionedUsersToNotify(ctx, userID, discussionID, []string{"participant:abc", "group:everyone"})
+
+				So(err, ShouldBeNil)
+				So(resp, ShouldResemble, []*model.DiscussionUserAccess{&duaObj})
+				mockDB.AssertNotCalled(t, "GetParticipantsByIDs", ctx, mock.Anything)
+			})
+		})
+
+		Convey("when @moderator is mentioned", func() {
+			Convey("when getting moderator participants errors out", func() {
+				mockDB.On("GetModeratorParticipantsByDiscussionID", ctx, discussionID).Return(nil, fmt.Errorf("sth"))
+
+				resp, err := backendObj.getMentionedUsersToNotify(ctx, userID, discussionID, []string{"group:moderator"})
+
+				So(err, ShouldNotBeNil)
+				So(resp, ShouldBeNil)
+			})
+
+			Convey("when getting moderator roles errors out", func() {
+				mockDB.On("GetModeratorParticipantsByDiscussionID", ctx, discussionID).Return([]model.Participant{modParticipant}, nil)
+				mockDB.On("GetModeratorRolesByDiscussionID", ctx, discussionID).Return(nil, fmt.Errorf("sth"))
+
+				resp, err := backendObj.getMentionedUsersToNotify(ctx, userID, discussionID, []string{"group:moderator"})
+
+				So(err, ShouldNotBeNil)
+				So(resp, ShouldBeNil)
+			})
+
+			Convey("when the owner's and role holders' users are notified", func() {
+				mockDB.On("GetModeratorParticipantsByDiscussionID", ctx, discussionID).Return([]model.Participant{modParticipant, modParticipant}, nil)
+				mockDB.On("GetModeratorRolesByDiscussionID", ctx, discussionID).Return([]*model.ModeratorRoleGrant{
+					{DiscussionID: discussionID, UserID: "helperUserID", Role: model.ModeratorRoleHelper},
+					{DiscussionID: discussionID, UserID: moderatorUserID, Role: model.ModeratorRoleModerator},
+				}, nil)
+				mockDB.On("GetParticipantsByIDs", ctx, []string(nil)).Return(nil, nil)
+				mockDB.On("GetDUAForMentionNotifications", ctx, discussionID, userID, []string{moderatorUserID, "helperUserID"}).Return(nil)
+				mockDB.On("DuaIterCollect", ctx, mock.Anything).Return([]*model.DiscussionUserAccess{&duaObj}, nil)
+
+				resp, err := backendObj.getMentionedUsersToNotify(ctx, userID, discussionID, []string{"group:moderator"})
+
+				So(err, ShouldBeNil)
+				So(resp, ShouldResemble, []*model.DiscussionUserAccess{&duaObj})
+			})
+		})
+	})
+}
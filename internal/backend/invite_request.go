@@ -74,10 +74,12 @@ func (d *delphisBackend) RequestAccessToDiscussion(ctx context.Context, userID,
 		return nil, err
 	}
 
+	d.emitDiscussionEvent(ctx, discussionID, model.DiscussionSubscriptionEventTypeAccessRequestCreated, requestObj)
+
 	return requestObj, nil
 }
 
-func (d *delphisBackend) RespondToRequestAccess(ctx context.Context, requestID string, response model.InviteRequestStatus, invitingParticipantID string) (*model.DiscussionAccessRequest, error) {
+func (d *delphisBackend) RespondToRequestAccess(ctx context.Context, requestID string, response model.InviteRequestStatus, invitingParticipantID string, requestingUserID string) (*model.DiscussionAccessRequest, error) {
 	request := model.DiscussionAccessRequest{
 		ID:     requestID,
 		Status: response,
@@ -130,5 +132,13 @@ func (d *delphisBackend) RespondToRequestAccess(ctx context.Context, requestID s
 		return nil, err
 	}
 
+	d.emitDiscussionEvent(ctx, requestObj.DiscussionID, model.DiscussionSubscriptionEventTypeAccessRequestUpdated, requestObj)
+
+	if response == model.InviteRequestStatusAccepted {
+		d.recordModerationAction(ctx, requestObj.DiscussionID, requestingUserID, model.ModerationActionAcceptAccessRequest, model.ModerationTargetTypeAccessRequest, requestObj.ID, nil)
+	} else if response == model.InviteRequestStatusRejected {
+		d.recordModerationAction(ctx, requestObj.DiscussionID, requestingUserID, model.ModerationActionRejectAccessRequest, model.ModerationTargetTypeAccessRequest, requestObj.ID, nil)
+	}
+
 	return requestObj, nil
 }
@@ -16,6 +16,7 @@ import (
 	"github.com/delphis-inc/delphisbe/internal/auth"
 	"github.com/delphis-inc/delphisbe/internal/cache"
 	"github.com/delphis-inc/delphisbe/internal/config"
+	"github.com/delphis-inc/delphisbe/internal/pubsub"
 	"github.com/delphis-inc/delphisbe/internal/util"
 	"github.com/delphis-inc/delphisbe/mocks"
 	. "github.com/smartystreets/goconvey/convey"
@@ -166,6 +167,13 @@ func TestDelphisBackend_RequestAccessToDiscussion(t *testing.T) {
 	discussionID := test_utils.DiscussionID
 
 	requestObj := test_utils.TestDiscussionAccessRequest(model.InviteRequestStatusAccepted)
+	eventObj := test_utils.TestDiscussionEvent()
+	createdEvent := model.DiscussionEvent{
+		DiscussionID: discussionID,
+		EventType:    model.DiscussionSubscriptionEventTypeAccessRequestCreated,
+		EntityType:   accessRequestEntityType,
+		EntityID:     requestObj.ID,
+	}
 
 	tx := sql.Tx{}
 
@@ -177,6 +185,7 @@ func TestDelphisBackend_RequestAccessToDiscussion(t *testing.T) {
 			db:              mockDB,
 			auth:            authObj,
 			cache:           cacheObj,
+			pubsub:          pubsub.NewInMemoryPubSub(),
 			discussionMutex: sync.Mutex{},
 			config:          config.Config{},
 			timeProvider:    &util.FrozenTime{NowTime: now},
@@ -247,6 +256,7 @@ func TestDelphisBackend_RequestAccessToDiscussion(t *testing.T) {
 			mockDB.On("BeginTx", ctx).Return(&tx, nil)
 			mockDB.On("PutDiscussionAccessRequestRecord", ctx, mock.Anything, mock.Anything).Return(&requestObj, nil)
 			mockDB.On("CommitTx", ctx, mock.Anything).Return(nil)
+			mockDB.On("PutDiscussionEvent", ctx, createdEvent).Return(&eventObj, nil)
 
 			resp, err := backendObj.RequestAccessToDiscussion(ctx, userID, discussionID)
 
@@ -268,6 +278,13 @@ func TestDelphisBackend_RespondToRequestAccess(t *testing.T) {
 	duaObj := test_utils.TestDiscussionUserAccess()
 
 	duaObj.RequestID = &requestObj.ID
+	eventObj := test_utils.TestDiscussionEvent()
+	updatedEvent := model.DiscussionEvent{
+		DiscussionID: requestObj.DiscussionID,
+		EventType:    model.DiscussionSubscriptionEventTypeAccessRequestUpdated,
+		EntityType:   accessRequestEntityType,
+		EntityID:     requestObj.ID,
+	}
 
 	tx := sql.Tx{}
 
@@ -279,6 +296,7 @@ func TestDelphisBackend_RespondToRequestAccess(t *testing.T) {
 			db:              mockDB,
 			auth:            authObj,
 			cache:           cacheObj,
+			pubsub:          pubsub.NewInMemoryPubSub(),
 			discussionMutex: sync.Mutex{},
 			config:          config.Config{},
 			timeProvider:    &util.FrozenTime{NowTime: now},
@@ -288,7 +306,7 @@ func TestDelphisBackend_RespondToRequestAccess(t *testing.T) {
 			expectedError := fmt.Errorf("Some Error")
 			mockDB.On("BeginTx", ctx).Return(nil, expectedError)
 
-			resp, err := backendObj.RespondToRequestAccess(ctx, requestID, response, participantID)
+			resp, err := backendObj.RespondToRequestAccess(ctx, requestID, response, participantID, test_utils.UserID)
 
 			So(err, ShouldEqual, expectedError)
 			So(resp, ShouldBeNil)
@@ -300,7 +318,7 @@ func TestDelphisBackend_RespondToRequestAccess(t *testing.T) {
 			mockDB.On("UpdateDiscussionAccessRequestRecord", ctx, mock.Anything, mock.Anything).Return(nil, expectedError)
 			mockDB.On("RollbackTx", ctx, mock.Anything).Return(expectedError)
 
-			resp, err := backendObj.RespondToRequestAccess(ctx, requestID, response, participantID)
+			resp, err := backendObj.RespondToRequestAccess(ctx, requestID, response, participantID, test_utils.UserID)
 
 			So(err, ShouldNotBeNil)
 			So(resp, ShouldBeNil)
@@ -312,7 +330,7 @@ func TestDelphisBackend_RespondToRequestAccess(t *testing.T) {
 			mockDB.On("UpdateDiscussionAccessRequestRecord", ctx, mock.Anything, mock.Anything).Return(nil, expectedError)
 			mockDB.On("RollbackTx", ctx, mock.Anything).Return(nil)
 
-			resp, err := backendObj.RespondToRequestAccess(ctx, requestID, response, participantID)
+			resp, err := backendObj.RespondToRequestAccess(ctx, requestID, response, participantID, test_utils.UserID)
 
 			So(err, ShouldEqual, expectedError)
 			So(resp, ShouldBeNil)
@@ -325,7 +343,7 @@ func TestDelphisBackend_RespondToRequestAccess(t *testing.T) {
 			mockDB.On("UpsertDiscussionUserAccess", ctx, mock.Anything, duaObj).Return(nil, expectedError)
 			mockDB.On("RollbackTx", ctx, mock.Anything).Return(expectedError)
 
-			resp, err := backendObj.RespondToRequestAccess(ctx, requestID, response, participantID)
+			resp, err := backendObj.RespondToRequestAccess(ctx, requestID, response, participantID, test_utils.UserID)
 
 			So(err, ShouldNotBeNil)
 			So(resp, ShouldBeNil)
@@ -338,7 +356,7 @@ func TestDelphisBackend_RespondToRequestAccess(t *testing.T) {
 			mockDB.On("UpsertDiscussionUserAccess", ctx, mock.Anything, duaObj).Return(nil, expectedError)
 			mockDB.On("RollbackTx", ctx, mock.Anything).Return(nil)
 
-			resp, err := backendObj.RespondToRequestAccess(ctx, requestID, response, participantID)
+			resp, err := backendObj.RespondToRequestAccess(ctx, requestID, response, participantID, test_utils.UserID)
 
 			So(err, ShouldEqual, expectedError)
 			So(resp, ShouldBeNil)
@@ -352,7 +370,7 @@ func TestDelphisBackend_RespondToRequestAccess(t *testing.T) {
 
 			mockDB.On("CommitTx", ctx, mock.Anything).Return(expectedError)
 
-			resp, err := backendObj.RespondToRequestAccess(ctx, requestID, response, participantID)
+			resp, err := backendObj.RespondToRequestAccess(ctx, requestID, response, participantID, test_utils.UserID)
 
 			So(err, ShouldEqual, expectedError)
 			So(resp, ShouldBeNil)
@@ -364,11 +382,16 @@ func TestDelphisBackend_RespondToRequestAccess(t *testing.T) {
 			mockDB.On("UpsertDiscussionUserAccess", ctx, mock.Anything, duaObj).Return(&duaObj, nil)
 
 			mockDB.On("CommitTx", ctx, mock.Anything).Return(nil)
+			mockDB.On("PutDiscussionEvent", ctx, updatedEvent).Return(&eventObj, nil)
+			mockDB.On("PutModerationLogEntry", ctx, mock.Anything).Return(&model.ModerationLogEntry{}, nil)
 
-			resp, err := backendObj.RespondToRequestAccess(ctx, requestID, response, participantID)
+			resp, err := backendObj.RespondToRequestAccess(ctx, requestID, response, participantID, test_utils.UserID)
 
 			So(err, ShouldBeNil)
 			So(resp, ShouldNotBeNil)
+			mockDB.AssertCalled(t, "PutModerationLogEntry", ctx, mock.MatchedBy(func(entry model.ModerationLogEntry) bool {
+				return entry.Action == model.ModerationActionAcceptAccessRequest && entry.ActorUserID == test_utils.UserID
+			}))
 		})
 	})
 }
@@ -0,0 +1,130 @@
+package backend
+
+import (
+	"context"
+	"time"
+
+	"github.com/delphis-inc/delphisbe/graph/model"
+	"github.com/delphis-inc/delphisbe/internal/unfurl"
+	"github.com/sirupsen/logrus"
+	"go.uber.org/multierr"
+)
+
+const (
+	// Previews older than this are fetched again the next time they are linked.
+	linkPreviewRefreshInterval = 24 * time.Hour
+	// Upper bound on unfurling every link in a post, on top of the per-fetch timeout.
+	unfurlPostLinksTimeout = 15 * time.Second
+)
+
+func (d *delphisBackend) GetLinkPreviewsByPostID(ctx context.Context, postID string) ([]*model.LinkPreview, error) {
+	return d.db.GetLinkPreviewsByPostID(ctx, postID)
+}
+
+// unfurlPostLinksInBackground fetches previews without holding up the post
+// mutation. Subscribers are told once the previews are stored.
+func (d *delphisBackend) unfurlPostLinksInBackground(discussionID string, post *model.Post, urls []string) {
+	if d.unfurler == nil {
+		return
+	}
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), unfurlPostLinksTimeout)
+		defer cancel()
+
+		if err := d.unfurlPostLinks(ctx, discussionID, post, urls); err != nil {
+			logrus.WithError(err).Warn("failed to unfurl post links")
+		}
+	}()
+}
+
+// unfurlPostLinks replaces the post's previews with those for urls. A fresh
+// cached preview is reused, and a stale one is kept if refetching it fails.
+// Links that never produced any metadata are left out.
+func (d *delphisBackend) unfurlPostLinks(ctx context.Context, discussionID string, post *model.Post, urls []string) error {
+	now := d.timeProvider.Now()
+	fetched := make([]model.LinkPreview, 0)
+	previewURLs := make([]string, 0)
+	for _, url := range urls {
+		cached, err := d.db.GetLinkPreviewByURL(ctx, url)
+		if err != nil {
+			logrus.WithError(err).Error("failed to get link preview")
+			return err
+		}
+		if cached != nil && now.Sub(cached.FetchedAt) < linkPreviewRefreshInterval {
+			previewURLs = append(previewURLs, url)
+			continue
+		}
+
+		page, err := d.unfurler.Fetch(ctx, url)
+		if err != nil || page.IsEmpty() {
+			if err != nil {
+				logrus.WithError(err).Debugf("failed to fetch link preview")
+			}
+			if cached != nil {
+				previewURLs = append(previewURLs, url)
+			}
+			continue
+		}
+
+		fetched = append(fetched, newLinkPreview(url, page))
+		previewURLs = append(previewURLs, url)
+	}
+
+	tx, err := d.db.BeginTx(ctx)
+	if err != nil {
+		logrus.WithError(err).Error("failed to begin tx")
+		return err
+	}
+
+	for _, preview := range fetched {
+		if err := d.db.UpsertLinkPreview(ctx, tx, preview); err != nil {
+			logrus.WithError(err).Error("failed to upsert link preview")
+
+			// Rollback on errors
+			if txErr := d.db.RollbackTx(ctx, tx); txErr != nil {
+				logrus.WithError(txErr).Error("failed to rollback tx")
+				return multierr.Append(err, txErr)
+			}
+			return err
+		}
+	}
+
+	if err := d.db.ReplacePostLinkPreviews(ctx, tx, post.ID, previewURLs); err != nil {
+		logrus.WithError(err).Error("failed to replace post link previews")
+
+		// Rollback on errors
+		if txErr := d.db.RollbackTx(ctx, tx); txErr != nil {
+			logrus.WithError(txErr).Error("failed to rollback tx")
+			return multierr.Append(err, txErr)
+		}
+		return err
+	}
+
+	if err := d.db.CommitTx(ctx, tx); err != nil {
+		logrus.WithError(err).Error("failed to commit link preview tx")
+		return err
+	}
+
+	// Clients refetch the post's previews
+	d.emitDiscussionEvent(ctx, discussionID, model.DiscussionSubscriptionEventTypePostLinkPreviewsUpdated, post)
+
+	return nil
+}
+
+func newLinkPreview(url string, page *unfurl.Preview) model.LinkPreview {
+	return model.LinkPreview{
+		URL:         url,
+		Title:       optionalString(page.Title),
+		Description: optionalString(page.Description),
+		ImageURL:    optionalString(page.ImageURL),
+		SiteName:    optionalString(page.SiteName),
+	}
+}
+
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
@@ -0,0 +1,177 @@
+package backend
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/delphis-inc/delphisbe/graph/model"
+	"github.com/delphis-inc/delphisbe/internal/auth"
+	"github.com/delphis-inc/delphisbe/internal/backend/test_utils"
+	"github.com/delphis-inc/delphisbe/internal/cache"
+	"github.com/delphis-inc/delphisbe/internal/config"
+	"github.com/delphis-inc/delphisbe/internal/pubsub"
+	"github.com/delphis-inc/delphisbe/internal/unfurl"
+	"github.com/delphis-inc/delphisbe/internal/util"
+	"github.com/delphis-inc/delphisbe/mocks"
+	. "github.com/smartystreets/goconvey/convey"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestDelphisBackend_GetLinkPreviewsByPostID(t *testing.T) {
+	ctx := context.Background()
+	postID := test_utils.PostID
+	title := "title"
+	previewObj := model.LinkPreview{URL: "https://example.com", Title: &title}
+
+	Convey("GetLinkPreviewsByPostID", t, func() {
+		now := time.Now()
+		mockDB := &mocks.Datastore{}
+		backendObj := &delphisBackend{
+			db:              mockDB,
+			auth:            auth.NewDelphisAuth(nil),
+			cache:           cache.NewInMemoryCache(),
+			pubsub:          pubsub.NewInMemoryPubSub(),
+			discussionMutex: sync.Mutex{},
+			config:          config.Config{},
+			timeProvider:    &util.FrozenTime{NowTime: now},
+		}
+
+		Convey("when the query errors out", func() {
+			mockDB.On("GetLinkPreviewsByPostID", ctx, postID).Return(nil, fmt.Errorf("sth"))
+
+			resp, err := backendObj.GetLinkPreviewsByPostID(ctx, postID)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		Convey("when the query returns successfully", func() {
+			mockDB.On("GetLinkPreviewsByPostID", ctx, postID).Return([]*model.LinkPreview{&previewObj}, nil)
+
+			resp, err := backendObj.GetLinkPreviewsByPostID(ctx, postID)
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldResemble, []*model.LinkPreview{&previewObj})
+		})
+	})
+}
+
+func TestDelphisBackend_unfurlPostLinks(t *testing.T) {
+	ctx := context.Background()
+	discussionID := test_utils.DiscussionID
+	eventObj := test_utils.TestDiscussionEvent()
+	freshURL := "https://fresh.com"
+	staleURL := "https://stale.com"
+	newURL := "https://new.com"
+
+	Convey("unfurlPostLinks", t, func() {
+		now := time.Now()
+		mockDB := &mocks.Datastore{}
+		mockFetcher := &mocks.Fetcher{}
+		backendObj := &delphisBackend{
+			db:              mockDB,
+			auth:            auth.NewDelphisAuth(nil),
+			cache:           cache.NewInMemoryCache(),
+			pubsub:          pubsub.NewInMemoryPubSub(),
+			discussionMutex: sync.Mutex{},
+			config:          config.Config{},
+			timeProvider:    &util.FrozenTime{NowTime: now},
+			unfurler:        mockFetcher,
+		}
+
+		tx := sql.Tx{}
+		postObj := test_utils.TestPost()
+		freshPreview := model.LinkPreview{URL: freshURL, FetchedAt: now.Add(-time.Hour)}
+		stalePreview := model.LinkPreview{URL: staleURL, FetchedAt: now.Add(-48 * time.Hour)}
+		newPage := unfurl.Preview{URL: newURL, Title: "New", ImageURL: "https://new.com/card.png"}
+		newPreview := newLinkPreview(newURL, &newPage)
+
+		Convey("when GetLinkPreviewByURL errors out", func() {
+			mockDB.On("GetLinkPreviewByURL", ctx, newURL).Return(nil, fmt.Errorf("sth"))
+
+			err := backendObj.unfurlPostLinks(ctx, discussionID, &postObj, []string{newURL})
+
+			So(err, ShouldNotBeNil)
+			mockFetcher.AssertNotCalled(t, "Fetch", mock.Anything, mock.Anything)
+		})
+
+		Convey("when fresh previews are reused and failed fetches are dropped", func() {
+			mockDB.On("GetLinkPreviewByURL", ctx, freshURL).Return(&freshPreview, nil)
+			mockDB.On("GetLinkPreviewByURL", ctx, staleURL).Return(&stalePreview, nil)
+			mockDB.On("GetLinkPreviewByURL", ctx, newURL).Return(nil, nil)
+			mockFetcher.On("Fetch", ctx, staleURL).Return(nil, fmt.Errorf("timeout"))
+			mockFetcher.On("Fetch", ctx, newURL).Return(&unfurl.Preview{URL: newURL}, nil)
+			mockDB.On("BeginTx", ctx).Return(&tx, nil)
+			mockDB.On("ReplacePostLinkPreviews", ctx, &tx, postObj.ID, []string{freshURL, staleURL}).Return(nil)
+			mockDB.On("CommitTx", ctx, &tx).Return(nil)
+			mockDB.On("PutDiscussionEvent", ctx, mock.Anything).Return(&eventObj, nil)
+
+			err := backendObj.unfurlPostLinks(ctx, discussionID, &postObj, []string{freshURL, staleURL, newURL})
+
+			So(err, ShouldBeNil)
+			mockFetcher.AssertNotCalled(t, "Fetch", ctx, freshURL)
+			mockDB.AssertNotCalled(t, "UpsertLinkPreview", mock.Anything, mock.Anything, mock.Anything)
+		})
+
+		mockDB.On("GetLinkPreviewByURL", ctx, newURL).Return(nil, nil)
+		mockFetcher.On("Fetch", ctx, newURL).Return(&newPage, nil)
+
+		Convey("when BeginTx errors out", func() {
+			mockDB.On("BeginTx", ctx).Return(nil, fmt.Errorf("sth"))
+
+			err := backendObj.unfurlPostLinks(ctx, discussionID, &postObj, []string{newURL})
+
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("when UpsertLinkPreview errors out", func() {
+			mockDB.On("BeginTx", ctx).Return(&tx, nil)
+			mockDB.On("UpsertLinkPreview", ctx, &tx, newPreview).Return(fmt.Errorf("sth"))
+			mockDB.On("RollbackTx", ctx, &tx).Return(nil)
+
+			err := backendObj.unfurlPostLinks(ctx, discussionID, &postObj, []string{newURL})
+
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("when ReplacePostLinkPreviews errors out and rollback fails", func() {
+			mockDB.On("BeginTx", ctx).Return(&tx, nil)
+			mockDB.On("UpsertLinkPreview", ctx, &tx, newPreview).Return(nil)
+			mockDB.On("ReplacePostLinkPreviews", ctx, &tx, postObj.ID, []string{newURL}).Return(fmt.Errorf("sth"))
+			mockDB.On("RollbackTx", ctx, &tx).Return(fmt.Errorf("sth"))
+
+			err := backendObj.unfurlPostLinks(ctx, discussionID, &postObj, []string{newURL})
+
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("when CommitTx errors out", func() {
+			mockDB.On("BeginTx", ctx).Return(&tx, nil)
+			mockDB.On("UpsertLinkPreview", ctx, &tx, newPreview).Return(nil)
+			mockDB.On("ReplacePostLinkPreviews", ctx, &tx, postObj.ID, []string{newURL}).Return(nil)
+			mockDB.On("CommitTx", ctx, &tx).Return(fmt.Errorf("sth"))
+
+			err := backendObj.unfurlPostLinks(ctx, discussionID, &postObj, []string{newURL})
+
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("when the previews are stored", func() {
+			mockDB.On("BeginTx", ctx).Return(&tx, nil)
+			mockDB.On("UpsertLinkPreview", ctx, &tx, newPreview).Return(nil)
+			mockDB.On("ReplacePostLinkPreviews", ctx, &tx, postObj.ID, []string{newURL}).Return(nil)
+			mockDB.On("CommitTx", ctx, &tx).Return(nil)
+			mockDB.On("PutDiscussionEvent", ctx, mock.Anything).Return(&eventObj, nil)
+
+			err := backendObj.unfurlPostLinks(ctx, discussionID, &postObj, []string{newURL})
+
+			So(err, ShouldBeNil)
+			So(*newPreview.Title, ShouldEqual, "New")
+			So(newPreview.Description, ShouldBeNil)
+		})
+	})
+}
@@ -0,0 +1,163 @@
+package backend
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/delphis-inc/delphisbe/graph/model"
+	"github.com/sirupsen/logrus"
+)
+
+var mentionTokenRegex = regexp.MustCompile(`\<(\d+)\>`)
+
+// MentionError rejects a post because of one of its mentions. The code and
+// offending entity are exposed to clients as GraphQL error extensions.
+type MentionError struct {
+	Code    model.MentionErrorCode
+	Entity  string
+	Message string
+}
+
+func (e *MentionError) Error() string {
+	return e.Message
+}
+
+func (e *MentionError) Extensions() map[string]interface{} {
+	extensions := map[string]interface{}{"code": e.Code}
+	if e.Entity != "" {
+		extensions["entity"] = e.Entity
+	}
+	return extensions
+}
+
+func newMentionError(code model.MentionErrorCode, entity string, format string, args ...interface{}) *MentionError {
+	return &MentionError{
+		Code:    code,
+		Entity:  entity,
+		Message: fmt.Sprintf(format, args...),
+	}
+}
+
+// normalizeMentions checks that every <n> token refers to an entity and every
+// entity is referred to, then rewrites both into their stored form: tokens
+// without leading zeros and entities as "type:id" with tags normalized.
+// Entities keep their positions since the text refers to them by index.
+func normalizeMentions(text string, entities []string) (string, []string, error) {
+	if entities == nil {
+		return text, nil, nil
+	}
+
+	referenced := make([]bool, len(entities))
+	tokens := mentionTokenRegex.FindAllStringSubmatch(text, -1)
+	if len(tokens) != len(entities) {
+		return "", nil, newMentionError(model.MentionErrorCodeTokenMismatch, "", "tokens did not match entities")
+	}
+	for _, token := range tokens {
+		index, err := strconv.Atoi(token[1])
+		if err != nil || index >= len(entities) || referenced[index] {
+			return "", nil, newMentionError(model.MentionErrorCodeTokenMismatch, "", "token %s did not match an entity", token[0])
+		}
+		referenced[index] = true
+	}
+	text = mentionTokenRegex.ReplaceAllStringFunc(text, func(token string) string {
+		index, _ := strconv.Atoi(mentionTokenRegex.FindStringSubmatch(token)[1])
+		return fmt.Sprintf("<%d>", index)
+	})
+
+	normalized := make([]string, 0, len(entities))
+	for _, entityID := range entities {
+		entity, err := normalizeMentionEntity(entityID)
+		if err != nil {
+			return "", nil, err
+		}
+		normalized = append(normalized, entity)
+	}
+
+	return text, normalized, nil
+}
+
+func normalizeMentionEntity(entityID string) (string, error) {
+	parts := strings.Split(entityID, ":")
+	if len(parts) != 2 {
+		return "", newMentionError(model.MentionErrorCodeMalformedEntity, entityID, "Mentioned entity %q is not of the form type:id", entityID)
+	}
+	entityType, id := strings.ToLower(strings.TrimSpace(parts[0])), strings.TrimSpace(parts[1])
+	if id == "" {
+		return "", newMentionError(model.MentionErrorCodeMalformedEntity, entityID, "Mentioned entity %q has no id", entityID)
+	}
+
+	switch entityType {
+	case model.ParticipantPrefix, model.DiscussionPrefix:
+	case model.TagPrefix:
+		tag, err := normalizeTag(id)
+		if err != nil {
+			return "", newMentionError(model.MentionErrorCodeMalformedEntity, entityID, "%s", err.Error())
+		}
+		id = tag
+	case model.GroupPrefix:
+		id = strings.ToLower(id)
+		if _, err := parseMentionGroup(id); err != nil {
+			return "", newMentionError(model.MentionErrorCodeUnknownEntity, entityID, "%s", err.Error())
+		}
+	default:
+		return "", newMentionError(model.MentionErrorCodeMalformedEntity, entityID, "Mentioned entity %q has an unsupported type", entityID)
+	}
+
+	return strings.Join([]string{entityType, id}, ":"), nil
+}
+
+// resolveMentionedEntities checks normalized entities against the database.
+// Participants must belong to the discussion being posted in and not be
+// banned, while discussions may be any that still exist.
+func (d *delphisBackend) resolveMentionedEntities(ctx context.Context, discussionID string, userID string, entities []string) error {
+	var participantIDs []string
+	var discussionIDs []string
+	for _, entityID := range entities {
+		parts := strings.Split(entityID, ":")
+		if parts[0] == model.ParticipantPrefix {
+			participantIDs = append(participantIDs, parts[1])
+		} else if parts[0] == model.DiscussionPrefix {
+			discussionIDs = append(discussionIDs, parts[1])
+		}
+	}
+
+	if len(participantIDs) > 0 {
+		participants, err := d.GetParticipantsByIDs(ctx, participantIDs)
+		if err != nil {
+			logrus.WithError(err).Error("failed to get mentioned participants")
+			return err
+		}
+		for _, id := range participantIDs {
+			entityID := strings.Join([]string{model.ParticipantPrefix, id}, ":")
+			participant := participants[id]
+			if participant == nil || participant.DeletedAt != nil {
+				return newMentionError(model.MentionErrorCodeUnknownEntity, entityID, "Mentioned participant %s not found", id)
+			}
+			if participant.DiscussionID == nil || *participant.DiscussionID != discussionID {
+				return newMentionError(model.MentionErrorCodeCrossDiscussion, entityID, "Mentioned participant %s is not in this discussion", id)
+			}
+			if participant.IsBanned {
+				return newMentionError(model.MentionErrorCodeBannedParticipant, entityID, "Mentioned participant %s is banned", id)
+			}
+		}
+	}
+
+	if len(discussionIDs) > 0 {
+		discussions, err := d.GetDiscussionsByIDs(ctx, discussionIDs)
+		if err != nil {
+			logrus.WithError(err).Error("failed to get mentioned discussions")
+			return err
+		}
+		for _, id := range discussionIDs {
+			if discussion := discussions[id]; discussion == nil || discussion.DeletedAt != nil {
+				entityID := strings.Join([]string{model.DiscussionPrefix, id}, ":")
+				return newMentionError(model.MentionErrorCodeUnknownEntity, entityID, "Mentioned discussion %s not found", id)
+			}
+		}
+	}
+
+	return d.checkGroupMentionPermission(ctx, discussionID, userID, entities)
+}
@@ -0,0 +1,225 @@
+package backend
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/delphis-inc/delphisbe/graph/model"
+	"github.com/delphis-inc/delphisbe/internal/auth"
+	"github.com/delphis-inc/delphisbe/internal/backend/test_utils"
+	"github.com/delphis-inc/delphisbe/internal/cache"
+	"github.com/delphis-inc/delphisbe/internal/config"
+	"github.com/delphis-inc/delphisbe/internal/util"
+	"github.com/delphis-inc/delphisbe/mocks"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestDelphisBackend_normalizeMentions(t *testing.T) {
+	mentionErrorCode := func(err error) model.MentionErrorCode {
+		mentionErr, ok := err.(*MentionError)
+		So(ok, ShouldBeTrue)
+		return mentionErr.Code
+	}
+
+	Convey("normalizeMentions", t, func() {
+		Convey("when there are no entities", func() {
+			text, entities, err := normalizeMentions("hello <0>", nil)
+
+			So(err, ShouldBeNil)
+			So(text, ShouldEqual, "hello <0>")
+			So(entities, ShouldBeNil)
+		})
+
+		Convey("when the token count does not match", func() {
+			_, _, err := normalizeMentions("hello <0>", []string{"participant:a", "participant:b"})
+
+			So(mentionErrorCode(err), ShouldEqual, model.MentionErrorCodeTokenMismatch)
+		})
+
+		Convey("when a token is out of range", func() {
+			_, _, err := normalizeMentions("hello <1>", []string{"participant:a"})
+
+			So(mentionErrorCode(err), ShouldEqual, model.MentionErrorCodeTokenMismatch)
+		})
+
+		Convey("when a token is repeated and an entity is left out", func() {
+			_, _, err := normalizeMentions("<0> <0>", []string{"participant:a", "participant:b"})
+
+			So(mentionErrorCode(err), ShouldEqual, model.MentionErrorCodeTokenMismatch)
+		})
+
+		Convey("when an entity has more than one colon", func() {
+			_, _, err := normalizeMentions("<0>", []string{"participant:a:b"})
+
+			So(mentionErrorCode(err), ShouldEqual, model.MentionErrorCodeMalformedEntity)
+			So(err.(*MentionError).Entity, ShouldEqual, "participant:a:b")
+		})
+
+		Convey("when an entity has no colon", func() {
+			_, _, err := normalizeMentions("<0>", []string{"participant"})
+
+			So(mentionErrorCode(err), ShouldEqual, model.MentionErrorCodeMalformedEntity)
+		})
+
+		Convey("when an entity has no id", func() {
+			_, _, err := normalizeMentions("<0>", []string{"participant: "})
+
+			So(mentionErrorCode(err), ShouldEqual, model.MentionErrorCodeMalformedEntity)
+		})
+
+		Convey("when an entity type is unsupported", func() {
+			_, _, err := normalizeMentions("<0>", []string{"user:a"})
+
+			So(mentionErrorCode(err), ShouldEqual, model.MentionErrorCodeMalformedEntity)
+		})
+
+		Convey("when a tag is too long", func() {
+			_, _, err := normalizeMentions("<0>", []string{"tag:abcdefghijklmnopqrstuvwxyz0123456789"})
+
+			So(mentionErrorCode(err), ShouldEqual, model.MentionErrorCodeMalformedEntity)
+		})
+
+		Convey("when a tag has unsupported characters", func() {
+			_, _, err := normalizeMentions("<0>", []string{"tag:go-lang"})
+
+			So(mentionErrorCode(err), ShouldEqual, model.MentionErrorCodeMalformedEntity)
+		})
+
+		Convey("when a group is unknown", func() {
+			_, _, err := normalizeMentions("<0>", []string{"group:admins"})
+
+			So(mentionErrorCode(err), ShouldEqual, model.MentionErrorCodeUnknownEntity)
+		})
+
+		Convey("when tokens and entities are normalized in place", func() {
+			text, entities, err := normalizeMentions("<01> and <0> on <2> for <3>",
+				[]string{" Participant:abc", "tag:#GoLang", "group:Everyone", "discussion:def"})
+
+			So(err, ShouldBeNil)
+			So(text, ShouldEqual, "<1> and <0> on <2> for <3>")
+			So(entities, ShouldResemble, []string{"participant:abc", "tag:golang", "group:everyone", "discussion:def"})
+		})
+	})
+}
+
+func TestDelphisBackend_MentionError(t *testing.T) {
+	Convey("MentionError", t, func() {
+		Convey("when the error names an entity", func() {
+			err := newMentionError(model.MentionErrorCodeBannedParticipant, "participant:abc", "Mentioned participant %s is banned", "abc")
+
+			So(err.Error(), ShouldEqual, "Mentioned participant abc is banned")
+			So(err.Extensions(), ShouldResemble, map[string]interface{}{
+				"code":   model.MentionErrorCodeBannedParticipant,
+				"entity": "participant:abc",
+			})
+		})
+
+		Convey("when the error is about the whole post", func() {
+			err := newMentionError(model.MentionErrorCodeTokenMismatch, "", "tokens did not match entities")
+
+			So(err.Extensions(), ShouldResemble, map[string]interface{}{"code": model.MentionErrorCodeTokenMismatch})
+		})
+	})
+}
+
+func TestDelphisBackend_resolveMentionedEntities(t *testing.T) {
+	ctx := context.Background()
+	discussionID := test_utils.DiscussionID
+	userID := test_utils.UserID
+	participantID := test_utils.ParticipantID
+	mentionedDiscussionID := "mentionedDiscussionID"
+	otherDiscussionID := "otherDiscussionID"
+	entities := []string{"participant:" + participantID, "discussion:" + mentionedDiscussionID, "tag:golang"}
+
+	Convey("resolveMentionedEntities", t, func() {
+		now := time.Now()
+		mockDB := &mocks.Datastore{}
+		backendObj := &delphisBackend{
+			db:              mockDB,
+			auth:            auth.NewDelphisAuth(nil),
+			cache:           cache.NewInMemoryCache(),
+			discussionMutex: sync.Mutex{},
+			config:          config.Config{},
+			timeProvider:    &util.FrozenTime{NowTime: now},
+		}
+
+		participantObj := test_utils.TestParticipant()
+		discObj := test_utils.TestDiscussion()
+		discObj.ID = mentionedDiscussionID
+
+		Convey("when there is nothing to look up", func() {
+			err := backendObj.resolveMentionedEntities(ctx, discussionID, userID, []string{"tag:golang", "group:moderator"})
+
+			So(err, ShouldBeNil)
+			mockDB.AssertNotCalled(t, "GetParticipantsByIDs", ctx, []string(nil))
+		})
+
+		Convey("when getting participants errors out", func() {
+			mockDB.On("GetParticipantsByIDs", ctx, []string{participantID}).Return(nil, fmt.Errorf("sth"))
+
+			err := backendObj.resolveMentionedEntities(ctx, discussionID, userID, entities)
+
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("when a participant does not exist", func() {
+			mockDB.On("GetParticipantsByIDs", ctx, []string{participantID}).Return(map[string]*model.Participant{}, nil)
+
+			err := backendObj.resolveMentionedEntities(ctx, discussionID, userID, entities)
+
+			So(err.(*MentionError).Code, ShouldEqual, model.MentionErrorCodeUnknownEntity)
+			So(err.(*MentionError).Entity, ShouldEqual, "participant:"+participantID)
+		})
+
+		Convey("when a participant is from another discussion", func() {
+			participantObj.DiscussionID = &otherDiscussionID
+			mockDB.On("GetParticipantsByIDs", ctx, []string{participantID}).Return(map[string]*model.Participant{participantID: &participantObj}, nil)
+
+			err := backendObj.resolveMentionedEntities(ctx, discussionID, userID, entities)
+
+			So(err.(*MentionError).Code, ShouldEqual, model.MentionErrorCodeCrossDiscussion)
+		})
+
+		Convey("when a participant is banned", func() {
+			participantObj.IsBanned = true
+			mockDB.On("GetParticipantsByIDs", ctx, []string{participantID}).Return(map[string]*model.Participant{participantID: &participantObj}, nil)
+
+			err := backendObj.resolveMentionedEntities(ctx, discussionID, userID, entities)
+
+			So(err.(*MentionError).Code, ShouldEqual, model.MentionErrorCodeBannedParticipant)
+		})
+
+		Convey("when participants are valid", func() {
+			mockDB.On("GetParticipantsByIDs", ctx, []string{participantID}).Return(map[string]*model.Participant{participantID: &participantObj}, nil)
+
+			Convey("when getting discussions errors out", func() {
+				mockDB.On("GetDiscussionsByIDs", ctx, []string{mentionedDiscussionID}).Return(nil, fmt.Errorf("sth"))
+
+				err := backendObj.resolveMentionedEntities(ctx, discussionID, userID, entities)
+
+				So(err, ShouldNotBeNil)
+			})
+
+			Convey("when a discussion has been deleted", func() {
+				discObj.DeletedAt = &now
+				mockDB.On("GetDiscussionsByIDs", ctx, []string{mentionedDiscussionID}).Return(map[string]*model.Discussion{mentionedDiscussionID: &discObj}, nil)
+
+				err := backendObj.resolveMentionedEntities(ctx, discussionID, userID, entities)
+
+				So(err.(*MentionError).Code, ShouldEqual, model.MentionErrorCodeUnknownEntity)
+				So(err.(*MentionError).Entity, ShouldEqual, "discussion:"+mentionedDiscussionID)
+			})
+
+			Convey("when every entity resolves", func() {
+				mockDB.On("GetDiscussionsByIDs", ctx, []string{mentionedDiscussionID}).Return(map[string]*model.Discussion{mentionedDiscussionID: &discObj}, nil)
+
+				err := backendObj.resolveMentionedEntities(ctx, discussionID, userID, entities)
+
+				So(err, ShouldBeNil)
+			})
+		})
+	})
+}
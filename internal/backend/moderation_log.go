@@ -0,0 +1,44 @@
+package backend
+
+import (
+	"context"
+	"errors"
+
+	"github.com/delphis-inc/delphisbe/graph/model"
+	"github.com/delphis-inc/delphisbe/internal/util"
+	"github.com/sirupsen/logrus"
+)
+
+// recordModerationAction is called once the action has taken effect, so a
+// failure to record it is only logged. Callers always pass the actor; system
+// actions such as background shuffles are recorded against the concierge user.
+func (d *delphisBackend) recordModerationAction(ctx context.Context, discussionID string, actorUserID string, action model.ModerationAction, targetType model.ModerationTargetType, targetID string, reason *string) {
+	if actorUserID == "" {
+		logrus.Errorf("skipping moderation log entry %s for discussion %s without an actor", action, discussionID)
+		return
+	}
+
+	entry := model.ModerationLogEntry{
+		ID:           util.UUIDv4(),
+		DiscussionID: discussionID,
+		ActorUserID:  actorUserID,
+		Action:       action,
+		TargetType:   targetType,
+		Reason:       reason,
+	}
+	if targetID != "" {
+		entry.TargetID = &targetID
+	}
+
+	if _, err := d.db.PutModerationLogEntry(ctx, entry); err != nil {
+		logrus.WithError(err).Error("failed to put moderation log entry")
+	}
+}
+
+func (d *delphisBackend) GetModerationLogConnectionByDiscussionID(ctx context.Context, discussionID string, cursor string, limit int) (*model.ModerationLogConnection, error) {
+	if limit < 2 || limit > PostPerPageLimit {
+		return nil, errors.New("Values of 'limit' is illegal")
+	}
+
+	return d.db.GetModerationLogConnectionByDiscussionID(ctx, discussionID, cursor, limit)
+}
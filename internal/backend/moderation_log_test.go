@@ -0,0 +1,120 @@
+package backend
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/delphis-inc/delphisbe/graph/model"
+	"github.com/delphis-inc/delphisbe/internal/auth"
+	"github.com/delphis-inc/delphisbe/internal/backend/test_utils"
+	"github.com/delphis-inc/delphisbe/internal/cache"
+	"github.com/delphis-inc/delphisbe/internal/config"
+	"github.com/delphis-inc/delphisbe/internal/util"
+	"github.com/delphis-inc/delphisbe/mocks"
+	. "github.com/smartystreets/goconvey/convey"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestDelphisBackend_recordModerationAction(t *testing.T) {
+	ctx := context.Background()
+	discussionID := test_utils.DiscussionID
+	participantID := test_utils.ParticipantID
+	actorUserID := "actorUserID"
+
+	Convey("recordModerationAction", t, func() {
+		mockDB := &mocks.Datastore{}
+		backendObj := &delphisBackend{
+			db:              mockDB,
+			auth:            auth.NewDelphisAuth(nil),
+			cache:           cache.NewInMemoryCache(),
+			discussionMutex: sync.Mutex{},
+			config:          config.Config{},
+			timeProvider:    &util.FrozenTime{NowTime: time.Now()},
+		}
+
+		Convey("when the actor is given", func() {
+			mockDB.On("PutModerationLogEntry", ctx, mock.MatchedBy(func(entry model.ModerationLogEntry) bool {
+				return entry.ID != "" && entry.DiscussionID == discussionID && entry.ActorUserID == actorUserID &&
+					entry.Action == model.ModerationActionBanParticipant && entry.TargetType == model.ModerationTargetTypeParticipant &&
+					*entry.TargetID == participantID && entry.Reason == nil
+			})).Return(&model.ModerationLogEntry{}, nil)
+
+			backendObj.recordModerationAction(ctx, discussionID, actorUserID, model.ModerationActionBanParticipant, model.ModerationTargetTypeParticipant, participantID, nil)
+
+			mockDB.AssertNumberOfCalls(t, "PutModerationLogEntry", 1)
+		})
+
+		Convey("when there is no actor", func() {
+			backendObj.recordModerationAction(ctx, discussionID, "", model.ModerationActionScheduleShuffle, model.ModerationTargetTypeDiscussion, discussionID, nil)
+
+			mockDB.AssertNotCalled(t, "PutModerationLogEntry", ctx, mock.Anything)
+		})
+
+		Convey("when there is no target", func() {
+			mockDB.On("PutModerationLogEntry", ctx, mock.MatchedBy(func(entry model.ModerationLogEntry) bool {
+				return entry.TargetID == nil
+			})).Return(&model.ModerationLogEntry{}, nil)
+
+			backendObj.recordModerationAction(ctx, discussionID, actorUserID, model.ModerationActionUpdateDiscussion, model.ModerationTargetTypeDiscussion, "", nil)
+
+			mockDB.AssertNumberOfCalls(t, "PutModerationLogEntry", 1)
+		})
+
+		Convey("when putting the entry errors out", func() {
+			mockDB.On("PutModerationLogEntry", ctx, mock.Anything).Return(nil, fmt.Errorf("sth"))
+
+			So(func() {
+				backendObj.recordModerationAction(ctx, discussionID, actorUserID, model.ModerationActionDeletePost, model.ModerationTargetTypePost, test_utils.PostID, nil)
+			}, ShouldNotPanic)
+		})
+	})
+}
+
+func TestDelphisBackend_GetModerationLogConnectionByDiscussionID(t *testing.T) {
+	ctx := context.Background()
+	discussionID := test_utils.DiscussionID
+	cursor := "cursor"
+
+	Convey("GetModerationLogConnectionByDiscussionID", t, func() {
+		mockDB := &mocks.Datastore{}
+		backendObj := &delphisBackend{
+			db:              mockDB,
+			auth:            auth.NewDelphisAuth(nil),
+			cache:           cache.NewInMemoryCache(),
+			discussionMutex: sync.Mutex{},
+			config:          config.Config{},
+			timeProvider:    &util.FrozenTime{NowTime: time.Now()},
+		}
+
+		Convey("when the limit is illegal", func() {
+			resp, err := backendObj.GetModerationLogConnectionByDiscussionID(ctx, discussionID, cursor, PostPerPageLimit+1)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		Convey("when the query errors out", func() {
+			mockDB.On("GetModerationLogConnectionByDiscussionID", ctx, discussionID, cursor, 10).Return(nil, fmt.Errorf("sth"))
+
+			resp, err := backendObj.GetModerationLogConnectionByDiscussionID(ctx, discussionID, cursor, 10)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		Convey("when the entries are returned", func() {
+			connObj := model.ModerationLogConnection{
+				Edges: []*model.ModerationLogEdge{{Cursor: cursor, Node: &model.ModerationLogEntry{ID: "entry1"}}},
+			}
+			mockDB.On("GetModerationLogConnectionByDiscussionID", ctx, discussionID, cursor, 10).Return(&connObj, nil)
+
+			resp, err := backendObj.GetModerationLogConnectionByDiscussionID(ctx, discussionID, cursor, 10)
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldResemble, &connObj)
+		})
+	})
+}
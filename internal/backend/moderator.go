@@ -39,12 +39,8 @@ func (d *delphisBackend) CheckIfModerator(ctx context.Context, userID string) (b
 	return mod != nil, nil
 }
 
+// CheckIfModeratorForDiscussion is true for owners and moderators. Helpers
+// are left out since they can only mute.
 func (d *delphisBackend) CheckIfModeratorForDiscussion(ctx context.Context, userID string, discussionID string) (bool, error) {
-	mod, err := d.GetModeratorByUserIDAndDiscussionID(ctx, userID, discussionID)
-	if err != nil {
-		logrus.WithError(err).Error("failed to get moderator by userID")
-		return false, err
-	}
-
-	return mod != nil, nil
+	return d.CheckModeratorRole(ctx, userID, discussionID, model.ModeratorRoleModerator)
 }
@@ -0,0 +1,193 @@
+package backend
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/delphis-inc/delphisbe/graph/model"
+	"github.com/delphis-inc/delphisbe/internal/auth"
+	"github.com/sirupsen/logrus"
+)
+
+var moderatorRoleRanks = map[model.ModeratorRole]int{
+	model.ModeratorRoleHelper:    1,
+	model.ModeratorRoleModerator: 2,
+	model.ModeratorRoleOwner:     3,
+}
+
+// moderatorRoleRank is 0 for users without a role.
+func moderatorRoleRank(role *model.ModeratorRole) int {
+	if role == nil {
+		return 0
+	}
+	return moderatorRoleRanks[*role]
+}
+
+// GetModeratorRole returns the user's role in the discussion or nil if they
+// have none. The owner is the discussion's moderator, everyone else needs a grant.
+func (d *delphisBackend) GetModeratorRole(ctx context.Context, userID string, discussionID string) (*model.ModeratorRole, error) {
+	mod, err := d.GetModeratorByUserIDAndDiscussionID(ctx, userID, discussionID)
+	if err != nil {
+		logrus.WithError(err).Error("failed to get moderator by userID")
+		return nil, err
+	}
+	if mod != nil {
+		owner := model.ModeratorRoleOwner
+		return &owner, nil
+	}
+
+	grant, err := d.db.GetModeratorRoleByUserIDAndDiscussionID(ctx, userID, discussionID)
+	if err != nil {
+		logrus.WithError(err).Error("failed to get moderator role")
+		return nil, err
+	}
+	if grant == nil {
+		return nil, nil
+	}
+	return &grant.Role, nil
+}
+
+// CheckModeratorRole reports whether the user holds minRole or a higher role.
+func (d *delphisBackend) CheckModeratorRole(ctx context.Context, userID string, discussionID string, minRole model.ModeratorRole) (bool, error) {
+	role, err := d.GetModeratorRole(ctx, userID, discussionID)
+	if err != nil {
+		return false, err
+	}
+
+	return moderatorRoleRank(role) >= moderatorRoleRanks[minRole], nil
+}
+
+// outranks reports whether a user holding role may act on the target user,
+// which needs a strictly higher role so moderators cannot ban each other.
+func (d *delphisBackend) outranks(ctx context.Context, discussionID string, role *model.ModeratorRole, targetUserID string) (bool, error) {
+	targetRole, err := d.GetModeratorRole(ctx, targetUserID, discussionID)
+	if err != nil {
+		return false, err
+	}
+
+	return moderatorRoleRank(role) > moderatorRoleRank(targetRole), nil
+}
+
+// getRoleTarget returns the participant whose user's role is being changed.
+func (d *delphisBackend) getRoleTarget(ctx context.Context, discussionID string, participantID string, requestingUserID string) (*model.Participant, error) {
+	participantObj, err := d.GetParticipantByID(ctx, participantID)
+	if err != nil || participantObj == nil || participantObj.UserID == nil {
+		return nil, fmt.Errorf("Failed to retrieve participant")
+	}
+
+	if participantObj.DiscussionID == nil || *participantObj.DiscussionID != discussionID {
+		return nil, fmt.Errorf("Participant is not part of this discussion")
+	}
+
+	if *participantObj.UserID == requestingUserID {
+		return nil, fmt.Errorf("Cannot change your own role")
+	}
+
+	if *participantObj.UserID == model.ConciergeUser {
+		return nil, fmt.Errorf("Cannot change the role of the concierge")
+	}
+
+	return participantObj, nil
+}
+
+// GrantModeratorRole gives the user behind the participant a role in the
+// discussion, replacing any role they had. Requesting users can only grant
+// roles below their own, to users holding a role below their own.
+func (d *delphisBackend) GrantModeratorRole(ctx context.Context, discussionID string, participantID string, role model.ModeratorRole, requestingUserID string) (*model.Participant, error) {
+	if !role.IsValid() || role == model.ModeratorRoleOwner {
+		return nil, fmt.Errorf("Role %s cannot be granted", role)
+	}
+
+	participantObj, err := d.getRoleTarget(ctx, discussionID, participantID, requestingUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if participantObj.IsBanned {
+		return nil, fmt.Errorf("Cannot grant a role to a banned participant")
+	}
+
+	requesterRole, err := d.GetModeratorRole(ctx, requestingUserID, discussionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if moderatorRoleRank(requesterRole) <= moderatorRoleRanks[role] {
+		return nil, fmt.Errorf("Not allowed to grant role %s", role)
+	}
+
+	canChange, err := d.outranks(ctx, discussionID, requesterRole, *participantObj.UserID)
+	if err != nil {
+		return nil, err
+	} else if !canChange {
+		return nil, fmt.Errorf("Not allowed to change the role of this participant")
+	}
+
+	if _, err := d.db.UpsertModeratorRole(ctx, model.ModeratorRoleGrant{
+		DiscussionID:    discussionID,
+		UserID:          *participantObj.UserID,
+		Role:            role,
+		GrantedByUserID: requestingUserID,
+	}); err != nil {
+		logrus.WithError(err).Error("failed to upsert moderator role")
+		return nil, err
+	}
+
+	d.emitDiscussionEvent(ctx, discussionID, model.DiscussionSubscriptionEventTypeParticipantRoleUpdated, participantObj)
+	d.recordModerationAction(ctx, discussionID, requestingUserID, model.ModerationActionGrantModeratorRole, model.ModerationTargetTypeParticipant, participantID, nil)
+
+	return participantObj, nil
+}
+
+// RevokeModeratorRole takes away the role of the user behind the participant.
+// Revoking from a user without a role is a no-op.
+func (d *delphisBackend) RevokeModeratorRole(ctx context.Context, discussionID string, participantID string, requestingUserID string) (*model.Participant, error) {
+	participantObj, err := d.getRoleTarget(ctx, discussionID, participantID, requestingUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	targetRole, err := d.GetModeratorRole(ctx, *participantObj.UserID, discussionID)
+	if err != nil {
+		return nil, err
+	}
+	if targetRole == nil {
+		return participantObj, nil
+	}
+
+	requesterRole, err := d.GetModeratorRole(ctx, requestingUserID, discussionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if moderatorRoleRank(requesterRole) <= moderatorRoleRank(targetRole) {
+		return nil, fmt.Errorf("Not allowed to change the role of this participant")
+	}
+
+	if err := d.db.DeleteModeratorRole(ctx, *participantObj.UserID, discussionID); err != nil {
+		logrus.WithError(err).Error("failed to delete moderator role")
+		return nil, err
+	}
+
+	d.emitDiscussionEvent(ctx, discussionID, model.DiscussionSubscriptionEventTypeParticipantRoleUpdated, participantObj)
+	d.recordModerationAction(ctx, discussionID, requestingUserID, model.ModerationActionRevokeModeratorRole, model.ModerationTargetTypeParticipant, participantID, nil)
+
+	return participantObj, nil
+}
+
+// GetParticipantModeratorRole hides the role of anonymous participants from
+// everyone but their own user, so a role cannot unmask them.
+func (d *delphisBackend) GetParticipantModeratorRole(ctx context.Context, participant *model.Participant) (*model.ModeratorRole, error) {
+	if participant.UserID == nil || participant.DiscussionID == nil {
+		return nil, nil
+	}
+
+	if participant.IsAnonymous {
+		authedUser := auth.GetAuthedUser(ctx)
+		if authedUser == nil || authedUser.UserID != *participant.UserID {
+			return nil, nil
+		}
+	}
+
+	return d.GetModeratorRole(ctx, *participant.UserID, *participant.DiscussionID)
+}
@@ -0,0 +1,363 @@
+package backend
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/delphis-inc/delphisbe/graph/model"
+	"github.com/delphis-inc/delphisbe/internal/auth"
+	"github.com/delphis-inc/delphisbe/internal/backend/test_utils"
+	"github.com/delphis-inc/delphisbe/internal/cache"
+	"github.com/delphis-inc/delphisbe/internal/config"
+	"github.com/delphis-inc/delphisbe/internal/pubsub"
+	"github.com/delphis-inc/delphisbe/internal/util"
+	"github.com/delphis-inc/delphisbe/mocks"
+	. "github.com/smartystreets/goconvey/convey"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestDelphisBackend_GetModeratorRole(t *testing.T) {
+	ctx := context.Background()
+	userID := test_utils.UserID
+	discussionID := test_utils.DiscussionID
+
+	Convey("GetModeratorRole", t, func() {
+		mockDB := &mocks.Datastore{}
+		backendObj := &delphisBackend{
+			db:              mockDB,
+			auth:            auth.NewDelphisAuth(nil),
+			cache:           cache.NewInMemoryCache(),
+			discussionMutex: sync.Mutex{},
+			config:          config.Config{},
+			timeProvider:    &util.FrozenTime{NowTime: time.Now()},
+		}
+
+		modObj := test_utils.TestModerator()
+
+		Convey("when the user is the discussion's moderator", func() {
+			mockDB.On("GetModeratorByUserIDAndDiscussionID", ctx, userID, discussionID).Return(&modObj, nil)
+
+			resp, err := backendObj.GetModeratorRole(ctx, userID, discussionID)
+
+			So(err, ShouldBeNil)
+			So(*resp, ShouldEqual, model.ModeratorRoleOwner)
+			mockDB.AssertNotCalled(t, "GetModeratorRoleByUserIDAndDiscussionID", ctx, userID, discussionID)
+		})
+
+		mockDB.On("GetModeratorByUserIDAndDiscussionID", ctx, userID, discussionID).Return(nil, nil)
+
+		Convey("when getting the role grant errors out", func() {
+			mockDB.On("GetModeratorRoleByUserIDAndDiscussionID", ctx, userID, discussionID).Return(nil, fmt.Errorf("sth"))
+
+			resp, err := backendObj.GetModeratorRole(ctx, userID, discussionID)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		Convey("when the user has no role", func() {
+			mockDB.On("GetModeratorRoleByUserIDAndDiscussionID", ctx, userID, discussionID).Return(nil, nil)
+
+			resp, err := backendObj.GetModeratorRole(ctx, userID, discussionID)
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		Convey("when the user was granted a role", func() {
+			mockDB.On("GetModeratorRoleByUserIDAndDiscussionID", ctx, userID, discussionID).Return(&model.ModeratorRoleGrant{Role: model.ModeratorRoleHelper}, nil)
+
+			resp, err := backendObj.GetModeratorRole(ctx, userID, discussionID)
+
+			So(err, ShouldBeNil)
+			So(*resp, ShouldEqual, model.ModeratorRoleHelper)
+		})
+	})
+}
+
+func TestDelphisBackend_GrantModeratorRole(t *testing.T) {
+	ctx := context.Background()
+	discussionID := test_utils.DiscussionID
+	participantID := test_utils.ParticipantID
+	participantUserID := test_utils.UserID
+	requestingUserID := "requestingUserID"
+
+	Convey("GrantModeratorRole", t, func() {
+		mockDB := &mocks.Datastore{}
+		backendObj := &delphisBackend{
+			db:              mockDB,
+			auth:            auth.NewDelphisAuth(nil),
+			cache:           cache.NewInMemoryCache(),
+			pubsub:          pubsub.NewInMemoryPubSub(),
+			discussionMutex: sync.Mutex{},
+			config:          config.Config{},
+			timeProvider:    &util.FrozenTime{NowTime: time.Now()},
+		}
+
+		participantObj := test_utils.TestParticipant()
+		modObj := test_utils.TestModerator()
+		eventObj := test_utils.TestDiscussionEvent()
+
+		Convey("when the role is owner", func() {
+			resp, err := backendObj.GrantModeratorRole(ctx, discussionID, participantID, model.ModeratorRoleOwner, requestingUserID)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		Convey("when the participant is not found", func() {
+			mockDB.On("GetParticipantByID", ctx, participantID).Return(nil, fmt.Errorf("sth"))
+
+			resp, err := backendObj.GrantModeratorRole(ctx, discussionID, participantID, model.ModeratorRoleHelper, requestingUserID)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		Convey("when the participant is from another discussion", func() {
+			otherDiscussionID := "otherDiscussionID"
+			participantObj.DiscussionID = &otherDiscussionID
+			mockDB.On("GetParticipantByID", ctx, participantID).Return(&participantObj, nil)
+
+			resp, err := backendObj.GrantModeratorRole(ctx, discussionID, participantID, model.ModeratorRoleHelper, requestingUserID)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		Convey("when the participant is the requesting user", func() {
+			mockDB.On("GetParticipantByID", ctx, participantID).Return(&participantObj, nil)
+
+			resp, err := backendObj.GrantModeratorRole(ctx, discussionID, participantID, model.ModeratorRoleHelper, participantUserID)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		Convey("when the participant is banned", func() {
+			participantObj.IsBanned = true
+			mockDB.On("GetParticipantByID", ctx, participantID).Return(&participantObj, nil)
+
+			resp, err := backendObj.GrantModeratorRole(ctx, discussionID, participantID, model.ModeratorRoleHelper, requestingUserID)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		mockDB.On("GetParticipantByID", ctx, participantID).Return(&participantObj, nil)
+		mockDB.On("GetModeratorByUserIDAndDiscussionID", ctx, participantUserID, discussionID).Return(nil, nil)
+
+		Convey("when a moderator grants the moderator role", func() {
+			mockDB.On("GetModeratorByUserIDAndDiscussionID", ctx, requestingUserID, discussionID).Return(nil, nil)
+			mockDB.On("GetModeratorRoleByUserIDAndDiscussionID", ctx, requestingUserID, discussionID).Return(&model.ModeratorRoleGrant{Role: model.ModeratorRoleModerator}, nil)
+
+			resp, err := backendObj.GrantModeratorRole(ctx, discussionID, participantID, model.ModeratorRoleModerator, requestingUserID)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+			mockDB.AssertNotCalled(t, "UpsertModeratorRole", ctx, mock.Anything)
+		})
+
+		Convey("when a moderator demotes another moderator", func() {
+			mockDB.On("GetModeratorByUserIDAndDiscussionID", ctx, requestingUserID, discussionID).Return(nil, nil)
+			mockDB.On("GetModeratorRoleByUserIDAndDiscussionID", ctx, mock.Anything, discussionID).Return(&model.ModeratorRoleGrant{Role: model.ModeratorRoleModerator}, nil)
+
+			resp, err := backendObj.GrantModeratorRole(ctx, discussionID, participantID, model.ModeratorRoleHelper, requestingUserID)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+			mockDB.AssertNotCalled(t, "UpsertModeratorRole", ctx, mock.Anything)
+		})
+
+		Convey("when the owner grants a role", func() {
+			grantObj := model.ModeratorRoleGrant{
+				DiscussionID:    discussionID,
+				UserID:          participantUserID,
+				Role:            model.ModeratorRoleModerator,
+				GrantedByUserID: requestingUserID,
+			}
+			mockDB.On("GetModeratorByUserIDAndDiscussionID", ctx, requestingUserID, discussionID).Return(&modObj, nil)
+			mockDB.On("GetModeratorRoleByUserIDAndDiscussionID", ctx, participantUserID, discussionID).Return(&model.ModeratorRoleGrant{Role: model.ModeratorRoleHelper}, nil)
+
+			Convey("when the upsert errors out", func() {
+				mockDB.On("UpsertModeratorRole", ctx, grantObj).Return(nil, fmt.Errorf("sth"))
+
+				resp, err := backendObj.GrantModeratorRole(ctx, discussionID, participantID, model.ModeratorRoleModerator, requestingUserID)
+
+				So(err, ShouldNotBeNil)
+				So(resp, ShouldBeNil)
+			})
+
+			Convey("when the role is granted", func() {
+				mockDB.On("UpsertModeratorRole", ctx, grantObj).Return(&grantObj, nil)
+				mockDB.On("PutDiscussionEvent", ctx, model.DiscussionEvent{
+					DiscussionID: discussionID,
+					EventType:    model.DiscussionSubscriptionEventTypeParticipantRoleUpdated,
+					EntityType:   participantEntityType,
+					EntityID:     participantID,
+				}).Return(&eventObj, nil)
+				mockDB.On("PutModerationLogEntry", ctx, mock.MatchedBy(func(entry model.ModerationLogEntry) bool {
+					return entry.Action == model.ModerationActionGrantModeratorRole && entry.ActorUserID == requestingUserID && *entry.TargetID == participantID
+				})).Return(&model.ModerationLogEntry{}, nil)
+
+				resp, err := backendObj.GrantModeratorRole(ctx, discussionID, participantID, model.ModeratorRoleModerator, requestingUserID)
+
+				So(err, ShouldBeNil)
+				So(resp, ShouldResemble, &participantObj)
+				mockDB.AssertCalled(t, "PutModerationLogEntry", ctx, mock.Anything)
+			})
+		})
+	})
+}
+
+func TestDelphisBackend_RevokeModeratorRole(t *testing.T) {
+	ctx := context.Background()
+	discussionID := test_utils.DiscussionID
+	participantID := test_utils.ParticipantID
+	participantUserID := test_utils.UserID
+	requestingUserID := "requestingUserID"
+
+	Convey("RevokeModeratorRole", t, func() {
+		mockDB := &mocks.Datastore{}
+		backendObj := &delphisBackend{
+			db:              mockDB,
+			auth:            auth.NewDelphisAuth(nil),
+			cache:           cache.NewInMemoryCache(),
+			pubsub:          pubsub.NewInMemoryPubSub(),
+			discussionMutex: sync.Mutex{},
+			config:          config.Config{},
+			timeProvider:    &util.FrozenTime{NowTime: time.Now()},
+		}
+
+		participantObj := test_utils.TestParticipant()
+		modObj := test_utils.TestModerator()
+		eventObj := test_utils.TestDiscussionEvent()
+
+		mockDB.On("GetParticipantByID", ctx, participantID).Return(&participantObj, nil)
+
+		Convey("when the participant is the owner", func() {
+			mockDB.On("GetModeratorByUserIDAndDiscussionID", ctx, participantUserID, discussionID).Return(&modObj, nil)
+			mockDB.On("GetModeratorByUserIDAndDiscussionID", ctx, requestingUserID, discussionID).Return(nil, nil)
+			mockDB.On("GetModeratorRoleByUserIDAndDiscussionID", ctx, requestingUserID, discussionID).Return(&model.ModeratorRoleGrant{Role: model.ModeratorRoleModerator}, nil)
+
+			resp, err := backendObj.RevokeModeratorRole(ctx, discussionID, participantID, requestingUserID)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		mockDB.On("GetModeratorByUserIDAndDiscussionID", ctx, participantUserID, discussionID).Return(nil, nil)
+
+		Convey("when the participant has no role", func() {
+			mockDB.On("GetModeratorRoleByUserIDAndDiscussionID", ctx, participantUserID, discussionID).Return(nil, nil)
+
+			resp, err := backendObj.RevokeModeratorRole(ctx, discussionID, participantID, requestingUserID)
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldResemble, &participantObj)
+			mockDB.AssertNotCalled(t, "DeleteModeratorRole", ctx, participantUserID, discussionID)
+		})
+
+		mockDB.On("GetModeratorRoleByUserIDAndDiscussionID", ctx, participantUserID, discussionID).Return(&model.ModeratorRoleGrant{Role: model.ModeratorRoleHelper}, nil)
+
+		Convey("when a helper revokes another helper", func() {
+			mockDB.On("GetModeratorByUserIDAndDiscussionID", ctx, requestingUserID, discussionID).Return(nil, nil)
+			mockDB.On("GetModeratorRoleByUserIDAndDiscussionID", ctx, requestingUserID, discussionID).Return(&model.ModeratorRoleGrant{Role: model.ModeratorRoleHelper}, nil)
+
+			resp, err := backendObj.RevokeModeratorRole(ctx, discussionID, participantID, requestingUserID)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		Convey("when a moderator revokes a helper", func() {
+			mockDB.On("GetModeratorByUserIDAndDiscussionID", ctx, requestingUserID, discussionID).Return(nil, nil)
+			mockDB.On("GetModeratorRoleByUserIDAndDiscussionID", ctx, requestingUserID, discussionID).Return(&model.ModeratorRoleGrant{Role: model.ModeratorRoleModerator}, nil)
+
+			Convey("when the delete errors out", func() {
+				mockDB.On("DeleteModeratorRole", ctx, participantUserID, discussionID).Return(fmt.Errorf("sth"))
+
+				resp, err := backendObj.RevokeModeratorRole(ctx, discussionID, participantID, requestingUserID)
+
+				So(err, ShouldNotBeNil)
+				So(resp, ShouldBeNil)
+			})
+
+			Convey("when the role is revoked", func() {
+				mockDB.On("DeleteModeratorRole", ctx, participantUserID, discussionID).Return(nil)
+				mockDB.On("PutDiscussionEvent", ctx, model.DiscussionEvent{
+					DiscussionID: discussionID,
+					EventType:    model.DiscussionSubscriptionEventTypeParticipantRoleUpdated,
+					EntityType:   participantEntityType,
+					EntityID:     participantID,
+				}).Return(&eventObj, nil)
+				mockDB.On("PutModerationLogEntry", ctx, mock.MatchedBy(func(entry model.ModerationLogEntry) bool {
+					return entry.Action == model.ModerationActionRevokeModeratorRole && entry.ActorUserID == requestingUserID && *entry.TargetID == participantID
+				})).Return(&model.ModerationLogEntry{}, nil)
+
+				resp, err := backendObj.RevokeModeratorRole(ctx, discussionID, participantID, requestingUserID)
+
+				So(err, ShouldBeNil)
+				So(resp, ShouldResemble, &participantObj)
+				mockDB.AssertCalled(t, "PutModerationLogEntry", ctx, mock.Anything)
+			})
+		})
+	})
+}
+
+func TestDelphisBackend_GetParticipantModeratorRole(t *testing.T) {
+	ctx := context.Background()
+	discussionID := test_utils.DiscussionID
+	participantUserID := test_utils.UserID
+
+	Convey("GetParticipantModeratorRole", t, func() {
+		mockDB := &mocks.Datastore{}
+		backendObj := &delphisBackend{
+			db:              mockDB,
+			auth:            auth.NewDelphisAuth(nil),
+			cache:           cache.NewInMemoryCache(),
+			discussionMutex: sync.Mutex{},
+			config:          config.Config{},
+			timeProvider:    &util.FrozenTime{NowTime: time.Now()},
+		}
+
+		participantObj := test_utils.TestParticipant()
+		modObj := test_utils.TestModerator()
+		mockDB.On("GetModeratorByUserIDAndDiscussionID", ctx, participantUserID, discussionID).Return(&modObj, nil)
+
+		Convey("when the participant is not anonymous", func() {
+			resp, err := backendObj.GetParticipantModeratorRole(ctx, &participantObj)
+
+			So(err, ShouldBeNil)
+			So(*resp, ShouldEqual, model.ModeratorRoleOwner)
+		})
+
+		Convey("when the participant is anonymous", func() {
+			participantObj.IsAnonymous = true
+
+			Convey("when the viewer is someone else", func() {
+				authedUser := test_utils.TestDelphisAuthedUser()
+				authedCtx := auth.WithAuthedUser(ctx, &authedUser)
+
+				resp, err := backendObj.GetParticipantModeratorRole(authedCtx, &participantObj)
+
+				So(err, ShouldBeNil)
+				So(resp, ShouldBeNil)
+			})
+
+			Convey("when the viewer is the participant's user", func() {
+				authedUser := auth.DelphisAuthedUser{UserID: participantUserID}
+				authedCtx := auth.WithAuthedUser(ctx, &authedUser)
+				mockDB.On("GetModeratorByUserIDAndDiscussionID", authedCtx, participantUserID, discussionID).Return(&modObj, nil)
+
+				resp, err := backendObj.GetParticipantModeratorRole(authedCtx, &participantObj)
+
+				So(err, ShouldBeNil)
+				So(*resp, ShouldEqual, model.ModeratorRoleOwner)
+			})
+		})
+	})
+}
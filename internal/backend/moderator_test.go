@@ -7,6 +7,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/delphis-inc/delphisbe/graph/model"
 	"github.com/delphis-inc/delphisbe/internal/auth"
 	"github.com/delphis-inc/delphisbe/internal/backend/test_utils"
 	"github.com/delphis-inc/delphisbe/internal/cache"
@@ -268,13 +269,35 @@ func TestDelphisBackend_CheckIfModeratorForDiscussion(t *testing.T) {
 			So(resp, ShouldEqual, false)
 		})
 
-		Convey("when GetModeratorByUserIDAndDiscussionID does not return a row; the user is not a mod", func() {
+		Convey("when GetModeratorByUserIDAndDiscussionID does not return a row", func() {
 			mockDB.On("GetModeratorByUserIDAndDiscussionID", ctx, userID, discussionID).Return(nil, nil)
 
-			resp, err := backendObj.CheckIfModeratorForDiscussion(ctx, userID, discussionID)
+			Convey("when the user has no role; the user is not a mod", func() {
+				mockDB.On("GetModeratorRoleByUserIDAndDiscussionID", ctx, userID, discussionID).Return(nil, nil)
 
-			So(err, ShouldBeNil)
-			So(resp, ShouldEqual, false)
+				resp, err := backendObj.CheckIfModeratorForDiscussion(ctx, userID, discussionID)
+
+				So(err, ShouldBeNil)
+				So(resp, ShouldEqual, false)
+			})
+
+			Convey("when the user is a helper", func() {
+				mockDB.On("GetModeratorRoleByUserIDAndDiscussionID", ctx, userID, discussionID).Return(&model.ModeratorRoleGrant{Role: model.ModeratorRoleHelper}, nil)
+
+				resp, err := backendObj.CheckIfModeratorForDiscussion(ctx, userID, discussionID)
+
+				So(err, ShouldBeNil)
+				So(resp, ShouldEqual, false)
+			})
+
+			Convey("when the user was granted the moderator role", func() {
+				mockDB.On("GetModeratorRoleByUserIDAndDiscussionID", ctx, userID, discussionID).Return(&model.ModeratorRoleGrant{Role: model.ModeratorRoleModerator}, nil)
+
+				resp, err := backendObj.CheckIfModeratorForDiscussion(ctx, userID, discussionID)
+
+				So(err, ShouldBeNil)
+				So(resp, ShouldEqual, true)
+			})
 		})
 
 		Convey("when query succeeds", func() {
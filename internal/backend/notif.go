@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"sort"
+	"strings"
 
 	"github.com/delphis-inc/delphisbe/internal/util"
 
@@ -25,7 +26,9 @@ type SendNotificationResponse struct {
 	NumDevicesToNotify int
 }
 
-func (d *delphisBackend) SendNotificationsToSubscribers(ctx context.Context, userID string, discussion *model.Discussion, post *model.Post, contentPreview *string) (*SendNotificationResponse, error) {
+// repliedToPostID is the post the author replied to, which for a reply to a
+// reply is not the post's ParentPostID since threads are a single level deep.
+func (d *delphisBackend) SendNotificationsToSubscribers(ctx context.Context, userID string, discussion *model.Discussion, post *model.Post, repliedToPostID *string, contentPreview *string) (*SendNotificationResponse, error) {
 	if discussion == nil || post == nil {
 		return nil, fmt.Errorf("Cannot send notification to missing Post or Discussion")
 	}
@@ -50,7 +53,7 @@ func (d *delphisBackend) SendNotificationsToSubscribers(ctx context.Context, use
 
 	// Get Users that were mentioned
 	if post.PostContent.MentionedEntities != nil {
-		mentionedUsers, err := d.getMentionedUsersToNotify(ctx, "", discussion.ID, post.PostContent.MentionedEntities)
+		mentionedUsers, err := d.getMentionedUsersToNotify(ctx, userID, discussion.ID, post.PostContent.MentionedEntities)
 		if err != nil {
 			logrus.WithError(err).Error("failed to get mentioned users")
 			return nil, err
@@ -59,6 +62,17 @@ func (d *delphisBackend) SendNotificationsToSubscribers(ctx context.Context, use
 		usersToNotify = append(usersToNotify, mentionedUsers...)
 	}
 
+	// Get the author of the post being replied to
+	if repliedToPostID != nil {
+		replyUsers, err := d.getReplyUsersToNotify(ctx, userID, discussion.ID, *repliedToPostID)
+		if err != nil {
+			logrus.WithError(err).Error("failed to get replied to users")
+			return nil, err
+		}
+
+		usersToNotify = append(usersToNotify, replyUsers...)
+	}
+
 	notifChan := make(chan *SingleNotificationSendStatus, 1)
 	go func() {
 		// Track the users we have sent notifications to
@@ -120,6 +134,29 @@ func (d *delphisBackend) SendNotificationsToSubscribers(ctx context.Context, use
 	}, nil
 }
 
+// sendPushNotificationToUser sends the notification to the user's most
+// recently seen device, if it has a push token.
+func (d *delphisBackend) sendPushNotificationToUser(ctx context.Context, userID string, body notif.PushNotificationBody) error {
+	userDevices, err := d.GetUserDevicesByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if len(userDevices) == 0 {
+		return nil
+	}
+	sort.Slice(userDevices, func(lhs, rhs int) bool {
+		return userDevices[lhs].LastSeen.After(userDevices[rhs].LastSeen)
+	})
+
+	toSendTo := userDevices[0]
+	if toSendTo.Token == nil || len(*toSendTo.Token) == 0 {
+		return nil
+	}
+
+	_, err = notif.SendPushNotification(ctx, d.config.AblyConfig, &toSendTo, body)
+	return err
+}
+
 func sendMessageNonBlocking(notifChan chan *SingleNotificationSendStatus, status *SingleNotificationSendStatus) bool {
 	select {
 	case notifChan <- status:
@@ -135,8 +172,10 @@ func sendMessageNonBlocking(notifChan chan *SingleNotificationSendStatus, status
 func (d *delphisBackend) getMentionedUsersToNotify(ctx context.Context, userID string, discussionID string, mentionedEntities []string) ([]*model.DiscussionUserAccess, error) {
 	var participantIDs []string
 	var userIDs []string
+	mentionsEveryone := false
+	mentionsModerator := false
 
-	// Parse mentioned entities for participants
+	// Parse mentioned entities for participants and groups
 	for _, entity := range mentionedEntities {
 		parsedEntity, err := util.ReturnParsedEntityID(entity)
 		if err != nil {
@@ -145,7 +184,34 @@ func (d *delphisBackend) getMentionedUsersToNotify(ctx context.Context, userID s
 		}
 		if parsedEntity.Type == model.ParticipantPrefix {
 			participantIDs = append(participantIDs, parsedEntity.ID)
+		} else if parsedEntity.Type == model.GroupPrefix {
+			group, err := parseMentionGroup(parsedEntity.ID)
+			if err != nil {
+				logrus.WithError(err).Error("failed to parse mention group")
+				continue
+			}
+			mentionsEveryone = mentionsEveryone || group == model.MentionGroupEveryone
+			mentionsModerator = mentionsModerator || group == model.MentionGroupModerator
+		}
+	}
+
+	// Everyone who wants mentions is a superset of any individual mention
+	if mentionsEveryone {
+		iter := d.db.GetDUAForEveryoneMentionNotifications(ctx, discussionID, userID)
+		notifyUsers, err := d.db.DuaIterCollect(ctx, iter)
+		if err != nil {
+			logrus.WithError(err).Error("failed to get users for @everyone")
+			return nil, err
+		}
+		return notifyUsers, nil
+	}
+
+	if mentionsModerator {
+		moderatorUserIDs, err := d.getModeratorUserIDs(ctx, discussionID)
+		if err != nil {
+			return nil, err
 		}
+		userIDs = append(userIDs, moderatorUserIDs...)
 	}
 
 	// Get participants by IDs so we can have the userIDa
@@ -169,3 +235,18 @@ func (d *delphisBackend) getMentionedUsersToNotify(ctx context.Context, userID s
 
 	return notifyUsers, nil
 }
+
+// A reply notifies the parent post's author the same way a mention of them would.
+func (d *delphisBackend) getReplyUsersToNotify(ctx context.Context, userID string, discussionID string, parentPostID string) ([]*model.DiscussionUserAccess, error) {
+	parent, err := d.db.GetPostByID(ctx, parentPostID)
+	if err != nil {
+		logrus.WithError(err).Error("failed to get parent post")
+		return nil, err
+	}
+	if parent == nil || parent.DeletedAt != nil || parent.ParticipantID == nil {
+		return nil, nil
+	}
+
+	parentAuthor := strings.Join([]string{model.ParticipantPrefix, *parent.ParticipantID}, ":")
+	return d.getMentionedUsersToNotify(ctx, userID, discussionID, []string{parentAuthor})
+}
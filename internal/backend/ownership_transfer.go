@@ -0,0 +1,164 @@
+package backend
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/delphis-inc/delphisbe/graph/model"
+	"github.com/delphis-inc/delphisbe/internal/util"
+	"github.com/sirupsen/logrus"
+	"go.uber.org/multierr"
+)
+
+// TransferDiscussionOwnership hands the discussion's Moderator to another user
+// with active access. Only the owner may transfer, unless the owner has lost
+// access, in which case a moderator may take the discussion over. The previous
+// owner stays on as a moderator.
+func (d *delphisBackend) TransferDiscussionOwnership(ctx context.Context, discussionID string, toUserID string, requestingUserID string) (*model.Discussion, error) {
+	discussionObj, err := d.GetDiscussionByID(ctx, discussionID)
+	if err != nil || discussionObj == nil || discussionObj.ModeratorID == nil {
+		return nil, fmt.Errorf("Failed to retrieve discussion")
+	}
+
+	fromUserID, err := d.getOwnerUserID(ctx, *discussionObj.ModeratorID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := d.checkCanTransferOwnership(ctx, discussionID, fromUserID, requestingUserID); err != nil {
+		return nil, err
+	}
+
+	if toUserID == model.ConciergeUser {
+		return nil, fmt.Errorf("Cannot transfer ownership to the concierge")
+	}
+
+	if fromUserID == toUserID {
+		return nil, fmt.Errorf("User already owns this discussion")
+	}
+
+	access, err := d.db.GetDiscussionUserAccess(ctx, discussionID, toUserID)
+	if err != nil {
+		logrus.WithError(err).Error("failed to get discussion user access")
+		return nil, err
+	}
+	if access == nil || access.DeletedAt != nil || access.State != model.DiscussionUserAccessStateActive {
+		return nil, fmt.Errorf("The new owner needs active access to the discussion")
+	}
+
+	toUser, err := d.GetUserByID(ctx, toUserID)
+	if err != nil || toUser == nil || toUser.UserProfile == nil {
+		return nil, fmt.Errorf("Failed to retrieve user")
+	}
+
+	// The owner posts as a non-anonymous participant, as the creator does
+	participants, err := d.GetParticipantsByDiscussionIDUserID(ctx, discussionID, toUserID)
+	if err != nil {
+		logrus.WithError(err).Error("failed to get participants of the new owner")
+		return nil, err
+	}
+	if participants.NonAnon == nil {
+		trueObj := true
+		if _, err := d.CreateParticipantForDiscussion(ctx, discussionID, toUserID, model.AddDiscussionParticipantInput{HasJoined: &trueObj}); err != nil {
+			logrus.WithError(err).Error("failed to create participant for the new owner")
+			return nil, err
+		}
+	}
+
+	tx, err := d.db.BeginTx(ctx)
+	if err != nil {
+		logrus.WithError(err).Error("failed to begin tx")
+		return nil, err
+	}
+
+	moderatorObj, err := d.db.UpdateModeratorUserProfileID(ctx, tx, *discussionObj.ModeratorID, toUser.UserProfile.ID)
+	if err != nil {
+		logrus.WithError(err).Error("failed to update moderator")
+		if txErr := d.rollbackTx(ctx, tx); txErr != nil {
+			return nil, multierr.Append(err, txErr)
+		}
+		return nil, err
+	}
+
+	if _, err := d.db.PutDiscussionOwnershipTransfer(ctx, tx, model.DiscussionOwnershipTransfer{
+		ID:                  util.UUIDv4(),
+		DiscussionID:        discussionID,
+		FromUserID:          fromUserID,
+		ToUserID:            toUserID,
+		TransferredByUserID: requestingUserID,
+	}); err != nil {
+		logrus.WithError(err).Error("failed to put ownership transfer")
+		if txErr := d.rollbackTx(ctx, tx); txErr != nil {
+			return nil, multierr.Append(err, txErr)
+		}
+		return nil, err
+	}
+
+	// The owner never holds a grant, and the previous owner keeps moderating
+	if err := d.db.TransferModeratorRoles(ctx, tx, discussionID, fromUserID, toUserID, requestingUserID); err != nil {
+		logrus.WithError(err).Error("failed to transfer moderator roles")
+		if txErr := d.rollbackTx(ctx, tx); txErr != nil {
+			return nil, multierr.Append(err, txErr)
+		}
+		return nil, err
+	}
+
+	if err := d.db.CommitTx(ctx, tx); err != nil {
+		logrus.WithError(err).Error("failed to commit tx")
+		return nil, err
+	}
+
+	discussionObj.Moderator = moderatorObj
+
+	if _, err := d.CreateOwnershipTransferAlertPost(ctx, discussionID, toUser); err != nil {
+		logrus.WithError(err).Error("failed to create ownership transfer alert post")
+	}
+
+	d.emitDiscussionEvent(ctx, discussionID, model.DiscussionSubscriptionEventTypeDiscussionUpdated, discussionObj)
+	d.recordModerationAction(ctx, discussionID, requestingUserID, model.ModerationActionTransferOwnership, model.ModerationTargetTypeUser, toUserID, nil)
+
+	return discussionObj, nil
+}
+
+// checkCanTransferOwnership lets moderators take over only once the owner's
+// access is gone, so a co-moderator cannot promote themselves past the owner.
+func (d *delphisBackend) checkCanTransferOwnership(ctx context.Context, discussionID string, ownerUserID string, requestingUserID string) error {
+	isOwner, err := d.CheckModeratorRole(ctx, requestingUserID, discussionID, model.ModeratorRoleOwner)
+	if err != nil {
+		return err
+	} else if isOwner {
+		return nil
+	}
+
+	isModerator, err := d.CheckIfModeratorForDiscussion(ctx, requestingUserID, discussionID)
+	if err != nil {
+		return err
+	} else if !isModerator {
+		return fmt.Errorf("Only the owner may transfer ownership")
+	}
+
+	ownerAccess, err := d.db.GetDiscussionUserAccess(ctx, discussionID, ownerUserID)
+	if err != nil {
+		logrus.WithError(err).Error("failed to get discussion user access of the owner")
+		return err
+	}
+	if ownerAccess != nil && ownerAccess.DeletedAt == nil && ownerAccess.State == model.DiscussionUserAccessStateActive {
+		return fmt.Errorf("Only the owner may transfer ownership")
+	}
+
+	return nil
+}
+
+func (d *delphisBackend) getOwnerUserID(ctx context.Context, moderatorID string) (string, error) {
+	moderatorObj, err := d.GetModeratorByID(ctx, moderatorID)
+	if err != nil || moderatorObj == nil || moderatorObj.UserProfileID == nil {
+		return "", fmt.Errorf("Failed to retrieve discussion")
+	}
+
+	userProfile, err := d.GetUserProfileByID(ctx, *moderatorObj.UserProfileID)
+	if err != nil || userProfile == nil || userProfile.UserID == nil {
+		return "", fmt.Errorf("Failed to retrieve discussion")
+	}
+
+	return *userProfile.UserID, nil
+}
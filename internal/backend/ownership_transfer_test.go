@@ -0,0 +1,237 @@
+package backend
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/delphis-inc/delphisbe/graph/model"
+	"github.com/delphis-inc/delphisbe/internal/auth"
+	"github.com/delphis-inc/delphisbe/internal/backend/test_utils"
+	"github.com/delphis-inc/delphisbe/internal/cache"
+	"github.com/delphis-inc/delphisbe/internal/config"
+	"github.com/delphis-inc/delphisbe/internal/pubsub"
+	"github.com/delphis-inc/delphisbe/internal/util"
+	"github.com/delphis-inc/delphisbe/mocks"
+	. "github.com/smartystreets/goconvey/convey"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestDelphisBackend_TransferDiscussionOwnership(t *testing.T) {
+	ctx := context.Background()
+	discussionID := test_utils.DiscussionID
+	ownerUserID := test_utils.UserID
+	toUserID := "toUserID"
+	helperUserID := "helperUserID"
+	toProfileID := "toProfileID"
+
+	Convey("TransferDiscussionOwnership", t, func() {
+		mockDB := &mocks.Datastore{}
+		backendObj := &delphisBackend{
+			db:              mockDB,
+			auth:            auth.NewDelphisAuth(nil),
+			cache:           cache.NewInMemoryCache(),
+			pubsub:          pubsub.NewInMemoryPubSub(),
+			discussionMutex: sync.Mutex{},
+			config:          config.Config{},
+			timeProvider:    &util.FrozenTime{NowTime: time.Now()},
+		}
+
+		discObj := test_utils.TestDiscussion()
+		modObj := test_utils.TestModerator()
+		profileObj := test_utils.TestUserProfile()
+		duaObj := test_utils.TestDiscussionUserAccess()
+		eventObj := test_utils.TestDiscussionEvent()
+		parObj := test_utils.TestParticipant()
+		duaObj.UserID = toUserID
+		toUserObj := model.User{
+			ID:          toUserID,
+			UserProfile: &model.UserProfile{ID: toProfileID, DisplayName: "newOwner"},
+		}
+		newModObj := test_utils.TestModerator()
+		newModObj.UserProfileID = &toProfileID
+
+		tx := sql.Tx{}
+
+		Convey("when the discussion is not found", func() {
+			mockDB.On("GetDiscussionByID", ctx, discussionID).Return(nil, fmt.Errorf("sth"))
+
+			resp, err := backendObj.TransferDiscussionOwnership(ctx, discussionID, toUserID, ownerUserID)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		mockDB.On("GetDiscussionByID", ctx, discussionID).Return(&discObj, nil)
+
+		mockDB.On("GetModeratorByID", ctx, *discObj.ModeratorID).Return(&modObj, nil)
+		mockDB.On("GetUserProfileByID", ctx, *modObj.UserProfileID).Return(&profileObj, nil)
+
+		Convey("when the requester is not a moderator", func() {
+			mockDB.On("GetModeratorByUserIDAndDiscussionID", ctx, helperUserID, discussionID).Return(nil, nil)
+			mockDB.On("GetModeratorRoleByUserIDAndDiscussionID", ctx, helperUserID, discussionID).Return(&model.ModeratorRoleGrant{Role: model.ModeratorRoleHelper}, nil)
+
+			resp, err := backendObj.TransferDiscussionOwnership(ctx, discussionID, toUserID, helperUserID)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		mockDB.On("GetModeratorByUserIDAndDiscussionID", ctx, toUserID, discussionID).Return(nil, nil)
+		mockDB.On("GetModeratorRoleByUserIDAndDiscussionID", ctx, toUserID, discussionID).Return(&model.ModeratorRoleGrant{Role: model.ModeratorRoleModerator}, nil)
+
+		Convey("when a moderator transfers to themselves while the owner has access", func() {
+			ownerDuaObj := test_utils.TestDiscussionUserAccess()
+			mockDB.On("GetDiscussionUserAccess", ctx, discussionID, ownerUserID).Return(&ownerDuaObj, nil)
+
+			resp, err := backendObj.TransferDiscussionOwnership(ctx, discussionID, toUserID, toUserID)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+			mockDB.AssertNotCalled(t, "BeginTx", ctx)
+		})
+
+		Convey("when a moderator takes over a discussion the owner has left", func() {
+			ownerDuaObj := test_utils.TestDiscussionUserAccess()
+			ownerDuaObj.State = model.DiscussionUserAccessStateArchived
+			mockDB.On("GetDiscussionUserAccess", ctx, discussionID, ownerUserID).Return(&ownerDuaObj, nil)
+			mockDB.On("GetDiscussionUserAccess", ctx, discussionID, toUserID).Return(&duaObj, nil)
+			mockDB.On("GetUserByID", ctx, toUserID).Return(&toUserObj, nil)
+			mockDB.On("GetParticipantsByDiscussionIDUserID", ctx, discussionID, toUserID).Return([]model.Participant{parObj}, nil)
+			mockDB.On("BeginTx", ctx).Return(&tx, nil)
+			mockDB.On("UpdateModeratorUserProfileID", ctx, &tx, *discObj.ModeratorID, toProfileID).Return(&newModObj, nil)
+			mockDB.On("PutDiscussionOwnershipTransfer", ctx, &tx, mock.Anything).Return(&model.DiscussionOwnershipTransfer{}, nil)
+			mockDB.On("TransferModeratorRoles", ctx, &tx, discussionID, ownerUserID, toUserID, toUserID).Return(nil)
+			mockDB.On("CommitTx", ctx, &tx).Return(nil)
+			mockDB.On("GetParticipantsByDiscussionIDUserID", ctx, discussionID, model.ConciergeUser).Return(nil, fmt.Errorf("sth"))
+			mockDB.On("PutDiscussionEvent", ctx, mock.Anything).Return(&eventObj, nil)
+			mockDB.On("PutModerationLogEntry", ctx, mock.Anything).Return(&model.ModerationLogEntry{}, nil)
+
+			resp, err := backendObj.TransferDiscussionOwnership(ctx, discussionID, toUserID, toUserID)
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldNotBeNil)
+			mockDB.AssertCalled(t, "CommitTx", ctx, &tx)
+		})
+
+		mockDB.On("GetModeratorByUserIDAndDiscussionID", ctx, ownerUserID, discussionID).Return(&modObj, nil)
+
+		Convey("when the target is the concierge", func() {
+			resp, err := backendObj.TransferDiscussionOwnership(ctx, discussionID, model.ConciergeUser, ownerUserID)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		Convey("when the target already owns the discussion", func() {
+			resp, err := backendObj.TransferDiscussionOwnership(ctx, discussionID, ownerUserID, ownerUserID)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		Convey("when the target has no access", func() {
+			mockDB.On("GetDiscussionUserAccess", ctx, discussionID, toUserID).Return(nil, nil)
+
+			resp, err := backendObj.TransferDiscussionOwnership(ctx, discussionID, toUserID, ownerUserID)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		Convey("when the target's access is not active", func() {
+			duaObj.State = model.DiscussionUserAccessStateArchived
+			mockDB.On("GetDiscussionUserAccess", ctx, discussionID, toUserID).Return(&duaObj, nil)
+
+			resp, err := backendObj.TransferDiscussionOwnership(ctx, discussionID, toUserID, ownerUserID)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		mockDB.On("GetDiscussionUserAccess", ctx, discussionID, toUserID).Return(&duaObj, nil)
+		mockDB.On("GetUserByID", ctx, toUserID).Return(&toUserObj, nil)
+
+		Convey("when creating the new owner's participant fails", func() {
+			mockDB.On("GetParticipantsByDiscussionIDUserID", ctx, discussionID, toUserID).Return([]model.Participant{}, nil)
+			mockDB.On("GetTotalParticipantCountByDiscussionID", ctx, discussionID).Return(1)
+			mockDB.On("GetViewerForDiscussion", ctx, discussionID, toUserID).Return(nil, nil)
+			mockDB.On("UpsertViewer", ctx, mock.Anything).Return(nil, fmt.Errorf("sth"))
+
+			resp, err := backendObj.TransferDiscussionOwnership(ctx, discussionID, toUserID, ownerUserID)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+			mockDB.AssertNotCalled(t, "BeginTx", ctx)
+		})
+
+		mockDB.On("GetParticipantsByDiscussionIDUserID", ctx, discussionID, toUserID).Return([]model.Participant{parObj}, nil)
+
+		Convey("when updating the moderator fails", func() {
+			mockDB.On("BeginTx", ctx).Return(&tx, nil)
+			mockDB.On("UpdateModeratorUserProfileID", ctx, &tx, *discObj.ModeratorID, toProfileID).Return(nil, fmt.Errorf("sth"))
+			mockDB.On("RollbackTx", ctx, &tx).Return(nil)
+
+			resp, err := backendObj.TransferDiscussionOwnership(ctx, discussionID, toUserID, ownerUserID)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+			mockDB.AssertCalled(t, "RollbackTx", ctx, &tx)
+		})
+
+		Convey("when recording the transfer fails", func() {
+			mockDB.On("BeginTx", ctx).Return(&tx, nil)
+			mockDB.On("UpdateModeratorUserProfileID", ctx, &tx, *discObj.ModeratorID, toProfileID).Return(&newModObj, nil)
+			mockDB.On("PutDiscussionOwnershipTransfer", ctx, &tx, mock.Anything).Return(nil, fmt.Errorf("sth"))
+			mockDB.On("RollbackTx", ctx, &tx).Return(nil)
+
+			resp, err := backendObj.TransferDiscussionOwnership(ctx, discussionID, toUserID, ownerUserID)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+			mockDB.AssertCalled(t, "RollbackTx", ctx, &tx)
+		})
+
+		Convey("when transferring the moderator roles fails", func() {
+			mockDB.On("BeginTx", ctx).Return(&tx, nil)
+			mockDB.On("UpdateModeratorUserProfileID", ctx, &tx, *discObj.ModeratorID, toProfileID).Return(&newModObj, nil)
+			mockDB.On("PutDiscussionOwnershipTransfer", ctx, &tx, mock.Anything).Return(&model.DiscussionOwnershipTransfer{}, nil)
+			mockDB.On("TransferModeratorRoles", ctx, &tx, discussionID, ownerUserID, toUserID, ownerUserID).Return(fmt.Errorf("sth"))
+			mockDB.On("RollbackTx", ctx, &tx).Return(nil)
+
+			resp, err := backendObj.TransferDiscussionOwnership(ctx, discussionID, toUserID, ownerUserID)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+			mockDB.AssertCalled(t, "RollbackTx", ctx, &tx)
+			mockDB.AssertNotCalled(t, "CommitTx", ctx, &tx)
+		})
+
+		Convey("when the transfer succeeds", func() {
+			mockDB.On("BeginTx", ctx).Return(&tx, nil)
+			mockDB.On("UpdateModeratorUserProfileID", ctx, &tx, *discObj.ModeratorID, toProfileID).Return(&newModObj, nil)
+			mockDB.On("PutDiscussionOwnershipTransfer", ctx, &tx, mock.MatchedBy(func(transfer model.DiscussionOwnershipTransfer) bool {
+				return transfer.FromUserID == ownerUserID && transfer.ToUserID == toUserID && transfer.TransferredByUserID == ownerUserID
+			})).Return(&model.DiscussionOwnershipTransfer{}, nil)
+			mockDB.On("TransferModeratorRoles", ctx, &tx, discussionID, ownerUserID, toUserID, ownerUserID).Return(nil)
+			mockDB.On("CommitTx", ctx, &tx).Return(nil)
+			mockDB.On("GetParticipantsByDiscussionIDUserID", ctx, discussionID, model.ConciergeUser).Return(nil, fmt.Errorf("sth"))
+			mockDB.On("PutDiscussionEvent", ctx, mock.Anything).Return(&eventObj, nil)
+			mockDB.On("PutModerationLogEntry", ctx, mock.MatchedBy(func(entry model.ModerationLogEntry) bool {
+				return entry.Action == model.ModerationActionTransferOwnership && *entry.TargetID == toUserID
+			})).Return(&model.ModerationLogEntry{}, nil)
+
+			resp, err := backendObj.TransferDiscussionOwnership(ctx, discussionID, toUserID, ownerUserID)
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldNotBeNil)
+			So(resp.Moderator, ShouldResemble, &newModObj)
+			mockDB.AssertCalled(t, "TransferModeratorRoles", ctx, &tx, discussionID, ownerUserID, toUserID, ownerUserID)
+			mockDB.AssertCalled(t, "PutDiscussionEvent", ctx, mock.Anything)
+			mockDB.AssertCalled(t, "PutModerationLogEntry", ctx, mock.Anything)
+		})
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"math/rand"
+	"strings"
 	"time"
 
 	"github.com/sirupsen/logrus"
@@ -13,6 +14,9 @@ import (
 	"github.com/delphis-inc/delphisbe/internal/util"
 )
 
+// Shown to the banned user, so kept to a short explanation
+const maxBanReasonLength = 500
+
 type UserDiscussionParticipants struct {
 	Anon    *model.Participant
 	NonAnon *model.Participant
@@ -67,6 +71,10 @@ func (d *delphisBackend) CreateParticipantForDiscussion(ctx context.Context, dis
 		return nil, err
 	}
 
+	if participantObj.HasJoined {
+		d.emitDiscussionEvent(ctx, discussionID, model.DiscussionSubscriptionEventTypeParticipantJoined, &participantObj)
+	}
+
 	if _, err := d.CreateWelcomeAlertPost(ctx, discussionID, participantObj.ID, userObj, discussionParticipantInput.IsAnonymous); err != nil {
 		// Don't return err. Just log
 		logrus.WithError(err).Error("failed to create alert post")
@@ -75,24 +83,26 @@ func (d *delphisBackend) CreateParticipantForDiscussion(ctx context.Context, dis
 	return &participantObj, nil
 }
 
-func (d *delphisBackend) BanParticipant(ctx context.Context, discussionID string, participantID string, requestingUserID string) (*model.Participant, error) {
-	discussionObj, err := d.GetDiscussionByID(ctx, discussionID)
-	if err != nil || discussionObj == nil || discussionObj.ModeratorID == nil {
-		return nil, fmt.Errorf("Failed to retrieve discussion")
+// BanParticipant removes the participant's user from the discussion. Their
+// posts are deleted unless keepPosts is set, in a way UnbanParticipant can undo.
+func (d *delphisBackend) BanParticipant(ctx context.Context, discussionID string, participantID string, requestingUserID string, reason *string, keepPosts bool) (*model.Participant, error) {
+	reason, err := normalizeBanReason(reason)
+	if err != nil {
+		return nil, err
 	}
 
-	moderatorObj, err := d.GetModeratorByID(ctx, *discussionObj.ModeratorID)
-	if err != nil || moderatorObj == nil || moderatorObj.UserProfileID == nil {
+	discussionObj, err := d.GetDiscussionByID(ctx, discussionID)
+	if err != nil || discussionObj == nil {
 		return nil, fmt.Errorf("Failed to retrieve discussion")
 	}
 
-	userProfile, err := d.GetUserProfileByID(ctx, *moderatorObj.UserProfileID)
-	if err != nil || userProfile == nil || userProfile.UserID == nil {
+	requesterRole, err := d.GetModeratorRole(ctx, requestingUserID, discussionID)
+	if err != nil {
 		return nil, fmt.Errorf("Failed to retrieve discussion")
 	}
 
-	if *userProfile.UserID != requestingUserID {
-		return nil, fmt.Errorf("Only the moderator may ban users")
+	if moderatorRoleRank(requesterRole) < moderatorRoleRanks[model.ModeratorRoleModerator] {
+		return nil, fmt.Errorf("Only moderators may ban users")
 	}
 
 	participantObj, err := d.GetParticipantByID(ctx, participantID)
@@ -116,6 +126,13 @@ func (d *delphisBackend) BanParticipant(ctx context.Context, discussionID string
 		return participantObj, nil
 	}
 
+	canBan, err := d.outranks(ctx, discussionID, requesterRole, *participantObj.UserID)
+	if err != nil {
+		return nil, err
+	} else if !canBan {
+		return nil, fmt.Errorf("Cannot ban a participant with an equal or higher role")
+	}
+
 	participantObj.IsBanned = true
 	updatedParticipant, err := d.db.UpsertParticipant(ctx, *participantObj)
 	if err != nil {
@@ -132,16 +149,160 @@ func (d *delphisBackend) BanParticipant(ctx context.Context, discussionID string
 		return nil, err
 	}
 
-	_, err = d.db.DeleteAllParticipantPosts(ctx, discussionID, participantID, model.PostDeletedReasonParticipantRemoved)
+	// A banned user keeps no role they were granted
+	if err := d.db.DeleteModeratorRole(ctx, *participantObj.UserID, discussionID); err != nil {
+		logrus.WithError(err).Error("failed to delete moderator role of banned user")
+		return nil, err
+	}
+
+	if _, err := d.db.PutDiscussionBan(ctx, model.DiscussionBan{
+		ParticipantID:  participantID,
+		DiscussionID:   discussionID,
+		UserID:         *participantObj.UserID,
+		Reason:         reason,
+		BannedByUserID: requestingUserID,
+	}); err != nil {
+		logrus.WithError(err).Error("failed to put discussion ban")
+		return nil, err
+	}
+
+	if !keepPosts {
+		_, err = d.db.DeleteAllParticipantPosts(ctx, discussionID, participantID, model.PostDeletedReasonParticipantBanned)
+
+		if err != nil {
+			logrus.WithError(err).Error("Failed to delete participant posts.")
+			// Do not return error here.
+		}
+	}
+
+	d.recordModerationAction(ctx, discussionID, requestingUserID, model.ModerationActionBanParticipant, model.ModerationTargetTypeParticipant, participantID, reason)
+
+	return updatedParticipant, nil
+}
+
+func normalizeBanReason(reason *string) (*string, error) {
+	if reason == nil {
+		return nil, nil
+	}
+
+	text := strings.TrimSpace(*reason)
+	if text == "" {
+		return nil, nil
+	}
+	if len(text) > maxBanReasonLength {
+		return nil, fmt.Errorf("Ban reasons must be at most %d characters", maxBanReasonLength)
+	}
+	return &text, nil
+}
+
+// UnbanParticipant gives the participant's user back active access unless
+// their other participant in the discussion is still banned. Posts are only
+// restored if they were deleted by a ban, so posts the author deleted
+// themselves stay deleted, and restored posts keep their pins.
+func (d *delphisBackend) UnbanParticipant(ctx context.Context, discussionID string, participantID string, requestingUserID string, restorePosts bool) (*model.Participant, error) {
+	discussionObj, err := d.GetDiscussionByID(ctx, discussionID)
+	if err != nil || discussionObj == nil {
+		return nil, fmt.Errorf("Failed to retrieve discussion")
+	}
+
+	isModerator, err := d.CheckIfModeratorForDiscussion(ctx, requestingUserID, discussionID)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to retrieve discussion")
+	} else if !isModerator {
+		return nil, fmt.Errorf("Only moderators may unban users")
+	}
+
+	participantObj, err := d.GetParticipantByID(ctx, participantID)
+	if err != nil || participantObj == nil {
+		return nil, fmt.Errorf("Failed to retreive participant")
+	}
+
+	if participantObj.DiscussionID == nil || *participantObj.DiscussionID != discussionID {
+		return nil, fmt.Errorf("Participant is not part of this discussion")
+	}
+
+	if !participantObj.IsBanned {
+		return participantObj, nil
+	}
+
+	participantObj.IsBanned = false
+	updatedParticipant, err := d.db.UpsertParticipant(ctx, *participantObj)
+	if err != nil {
+		logrus.WithError(err).Error("Failed to update participant")
+		return nil, err
+	}
 
+	// Bans are per participant, so the user stays banned from the discussion
+	// while their other participant is
+	otherBanned, err := d.hasOtherBannedParticipant(ctx, discussionID, *participantObj.UserID, participantID)
 	if err != nil {
-		logrus.WithError(err).Error("Failed to delete participant posts.")
-		// Do not return error here.
+		return nil, err
+	}
+	if !otherBanned {
+		active := model.DiscussionUserAccessStateActive
+		settings := model.DiscussionUserSettings{
+			State: &active,
+		}
+		if _, err := d.UpsertUserDiscussionAccess(ctx, *participantObj.UserID, discussionID, settings); err != nil {
+			logrus.WithError(err).Error("failed to upsert unbanned user discussion access")
+			return nil, err
+		}
 	}
 
+	if err := d.db.DeleteDiscussionBan(ctx, participantID); err != nil {
+		logrus.WithError(err).Error("failed to delete discussion ban")
+		return nil, err
+	}
+
+	var restoredPostIDs []string
+	if restorePosts {
+		restoredPostIDs, err = d.db.RestoreParticipantPosts(ctx, discussionID, participantID, model.PostDeletedReasonParticipantBanned)
+		if err != nil {
+			logrus.WithError(err).Error("failed to restore participant posts")
+			return nil, err
+		}
+	}
+
+	d.emitDiscussionEvent(ctx, discussionID, model.DiscussionSubscriptionEventTypeParticipantUnbanned, updatedParticipant)
+	// Live clients dropped the posts on the ban, so they are added back
+	for _, postID := range restoredPostIDs {
+		d.emitDiscussionEvent(ctx, discussionID, model.DiscussionSubscriptionEventTypePostAdded, &model.Post{ID: postID})
+	}
+	d.recordModerationAction(ctx, discussionID, requestingUserID, model.ModerationActionUnbanParticipant, model.ModerationTargetTypeParticipant, participantID, nil)
+
 	return updatedParticipant, nil
 }
 
+func (d *delphisBackend) hasOtherBannedParticipant(ctx context.Context, discussionID string, userID string, participantID string) (bool, error) {
+	participants, err := d.db.GetParticipantsByDiscussionIDUserID(ctx, discussionID, userID)
+	if err != nil {
+		logrus.WithError(err).Error("failed to get user's participants")
+		return false, err
+	}
+
+	for _, participant := range participants {
+		if participant.ID != participantID && participant.IsBanned {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// GetBanReason returns why the user was banned from the discussion, or nil
+// when they are not banned or no reason was given.
+func (d *delphisBackend) GetBanReason(ctx context.Context, discussionID string, userID string) (*string, error) {
+	ban, err := d.db.GetDiscussionBanByDiscussionIDUserID(ctx, discussionID, userID)
+	if err != nil {
+		logrus.WithError(err).Error("failed to get discussion ban")
+		return nil, err
+	}
+	if ban == nil {
+		return nil, nil
+	}
+
+	return ban.Reason, nil
+}
+
 func (d *delphisBackend) GetParticipantsByDiscussionID(ctx context.Context, id string) ([]model.Participant, error) {
 	return d.db.GetParticipantsByDiscussionID(ctx, id)
 }
@@ -198,12 +359,7 @@ func (d *delphisBackend) GetParticipantsByIDs(ctx context.Context, ids []string)
 	return d.db.GetParticipantsByIDs(ctx, ids)
 }
 
-func (d *delphisBackend) MuteParticipants(ctx context.Context, discussionID string, participantIDs []string, muteForSeconds int) ([]*model.Participant, error) {
-	authedUser := auth.GetAuthedUser(ctx)
-	if authedUser == nil {
-		return nil, fmt.Errorf("Need auth")
-	}
-
+func (d *delphisBackend) MuteParticipants(ctx context.Context, discussionID string, participantIDs []string, requestingUserID string, muteForSeconds int) ([]*model.Participant, error) {
 	/* Get discussion participants */
 	participants, err := d.GetParticipantsByDiscussionID(ctx, discussionID)
 	if err != nil {
@@ -214,18 +370,29 @@ func (d *delphisBackend) MuteParticipants(ctx context.Context, discussionID stri
 		return []*model.Participant{}, nil
 	}
 
+	requesterRole, err := d.GetModeratorRole(ctx, requestingUserID, discussionID)
+	if err != nil {
+		return nil, err
+	}
+
 	/* Check participants validity and retrieve the ones we need to modify */
 	var participantsToEdit []*model.Participant
 	for _, participantID := range participantIDs {
 		found := false
 		for _, participant := range participants {
 			if participant.ID == participantID {
-				if *participant.UserID == authedUser.UserID {
+				if *participant.UserID == requestingUserID {
 					return nil, fmt.Errorf("You cannot mute yourself")
 				}
 				if *participant.UserID == model.ConciergeUser {
 					return nil, fmt.Errorf("You cannot mute the concierge")
 				}
+				canEdit, err := d.outranks(ctx, discussionID, requesterRole, *participant.UserID)
+				if err != nil {
+					return nil, err
+				} else if !canEdit {
+					return nil, fmt.Errorf("You cannot mute a participant with an equal or higher role")
+				}
 				found = true
 				p := participant
 				participantsToEdit = append(participantsToEdit, &p)
@@ -237,7 +404,16 @@ func (d *delphisBackend) MuteParticipants(ctx context.Context, discussionID stri
 		}
 	}
 	newTime := time.Now().Add(time.Duration(muteForSeconds) * time.Second)
-	return d.db.SetParticipantsMutedUntil(ctx, participantsToEdit, &newTime)
+	mutedParticipants, err := d.db.SetParticipantsMutedUntil(ctx, participantsToEdit, &newTime)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, participant := range mutedParticipants {
+		d.emitDiscussionEvent(ctx, discussionID, model.DiscussionSubscriptionEventTypeParticipantMuted, participant)
+		d.recordModerationAction(ctx, discussionID, requestingUserID, model.ModerationActionMuteParticipant, model.ModerationTargetTypeParticipant, participant.ID, nil)
+	}
+	return mutedParticipants, nil
 }
 
 func (d *delphisBackend) UnmuteParticipants(ctx context.Context, discussionID string, participantIDs []string) ([]*model.Participant, error) {
@@ -256,6 +432,11 @@ func (d *delphisBackend) UnmuteParticipants(ctx context.Context, discussionID st
 		return []*model.Participant{}, nil
 	}
 
+	requesterRole, err := d.GetModeratorRole(ctx, authedUser.UserID, discussionID)
+	if err != nil {
+		return nil, err
+	}
+
 	/* Check participants validity and retrieve the ones we need to modify */
 	var participantsToEdit []*model.Participant
 	for _, participantID := range participantIDs {
@@ -268,6 +449,12 @@ func (d *delphisBackend) UnmuteParticipants(ctx context.Context, discussionID st
 				if *participant.UserID == model.ConciergeUser {
 					return nil, fmt.Errorf("You cannot unmute the concierge")
 				}
+				canEdit, err := d.outranks(ctx, discussionID, requesterRole, *participant.UserID)
+				if err != nil {
+					return nil, err
+				} else if !canEdit {
+					return nil, fmt.Errorf("You cannot unmute a participant with an equal or higher role")
+				}
 				found = true
 				p := participant
 				participantsToEdit = append(participantsToEdit, &p)
@@ -278,7 +465,16 @@ func (d *delphisBackend) UnmuteParticipants(ctx context.Context, discussionID st
 			return nil, fmt.Errorf("Participant with ID (%s) is not associated with discussionID (%s)", participantID, discussionID)
 		}
 	}
-	return d.db.SetParticipantsMutedUntil(ctx, participantsToEdit, nil)
+	unmutedParticipants, err := d.db.SetParticipantsMutedUntil(ctx, participantsToEdit, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, participant := range unmutedParticipants {
+		d.emitDiscussionEvent(ctx, discussionID, model.DiscussionSubscriptionEventTypeParticipantUnmuted, participant)
+		d.recordModerationAction(ctx, discussionID, authedUser.UserID, model.ModerationActionUnmuteParticipant, model.ModerationTargetTypeParticipant, participant.ID, nil)
+	}
+	return unmutedParticipants, nil
 }
 
 func (d *delphisBackend) GetTotalParticipantCountByDiscussionID(ctx context.Context, discussionID string) int {
@@ -321,12 +517,22 @@ func (d *delphisBackend) UpdateParticipant(ctx context.Context, participants Use
 	if input.GradientColor != nil || (input.IsUnsetGradient != nil && *input.IsUnsetGradient) {
 		currentParticipantObj.GradientColor = input.GradientColor
 	}
+	joined := false
 	if input.HasJoined != nil {
 		// Cannot unjoin a conversation.
 		if !currentParticipantObj.HasJoined {
 			currentParticipantObj.HasJoined = *input.HasJoined
+			joined = currentParticipantObj.HasJoined
 		}
 	}
 
-	return d.db.UpsertParticipant(ctx, *currentParticipantObj)
+	participantObj, err := d.db.UpsertParticipant(ctx, *currentParticipantObj)
+	if err != nil {
+		return nil, err
+	}
+
+	if joined && participantObj != nil && participantObj.DiscussionID != nil {
+		d.emitDiscussionEvent(ctx, *participantObj.DiscussionID, model.DiscussionSubscriptionEventTypeParticipantJoined, participantObj)
+	}
+	return participantObj, nil
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -13,6 +14,7 @@ import (
 	"github.com/delphis-inc/delphisbe/internal/backend/test_utils"
 	"github.com/delphis-inc/delphisbe/internal/cache"
 	"github.com/delphis-inc/delphisbe/internal/config"
+	"github.com/delphis-inc/delphisbe/internal/pubsub"
 	"github.com/delphis-inc/delphisbe/internal/util"
 	"github.com/delphis-inc/delphisbe/mocks"
 	. "github.com/smartystreets/goconvey/convey"
@@ -33,6 +35,7 @@ func TestDelphisBackend_CreateParticipantForDiscussion(t *testing.T) {
 	discObj := test_utils.TestDiscussion()
 	viewerObj := test_utils.TestViewer()
 	parObj := test_utils.TestParticipant()
+	eventObj := test_utils.TestDiscussionEvent()
 
 	userObj.UserProfile = &profile
 	modObj.UserProfile = &profile
@@ -48,6 +51,7 @@ func TestDelphisBackend_CreateParticipantForDiscussion(t *testing.T) {
 			db:              mockDB,
 			auth:            authObj,
 			cache:           cacheObj,
+			pubsub:          pubsub.NewInMemoryPubSub(),
 			discussionMutex: sync.Mutex{},
 			config:          config.Config{},
 			timeProvider:    &util.FrozenTime{NowTime: now},
@@ -111,6 +115,7 @@ func TestDelphisBackend_CreateParticipantForDiscussion(t *testing.T) {
 			mockDB.On("GetDiscussionByID", ctx, discussionID).Return(&discObj, nil)
 			mockDB.On("GetParticipantsByDiscussionIDUserID", ctx, mock.Anything, mock.Anything).Return([]model.Participant{parObj}, nil)
 			mockDB.On("BeginTx", ctx).Return(nil, expectedError)
+			mockDB.On("PutDiscussionEvent", ctx, mock.Anything).Return(&eventObj, nil)
 
 			resp, err := backendObj.CreateParticipantForDiscussion(ctx, discussionID, userID, inputObj)
 
@@ -138,6 +143,7 @@ func TestDelphisBackend_CreateParticipantForDiscussion(t *testing.T) {
 			mockDB.On("GetDUAForEverythingNotifications", ctx, discussionID, mock.Anything).Return(nil)
 			mockDB.On("DuaIterCollect", ctx, mock.Anything).Return(nil, nil)
 			mockDB.On("GetUserDevicesByUserID", ctx, mock.Anything).Return(nil, nil)
+			mockDB.On("PutDiscussionEvent", ctx, mock.Anything).Return(&eventObj, nil)
 
 			resp, err := backendObj.CreateParticipantForDiscussion(ctx, discussionID, userID, inputObj)
 
@@ -373,7 +379,15 @@ func TestDelphisBackend_MuteParticipants(t *testing.T) {
 	parListObj := []*model.Participant{&parObj}
 	discussionID := "discussionID"
 	authedUser := test_utils.TestDelphisAuthedUser()
+	modObj := test_utils.TestModerator()
 	seconds := 5
+	eventObj := test_utils.TestDiscussionEvent()
+	participantEvent := model.DiscussionEvent{
+		DiscussionID: *parObj.DiscussionID,
+		EventType:    model.DiscussionSubscriptionEventTypeParticipantMuted,
+		EntityType:   participantEntityType,
+		EntityID:     parObj.ID,
+	}
 
 	Convey("MuteParticipants", t, func() {
 		cacheObj := cache.NewInMemoryCache()
@@ -383,36 +397,58 @@ func TestDelphisBackend_MuteParticipants(t *testing.T) {
 			db:              mockDB,
 			auth:            authObj,
 			cache:           cacheObj,
+			pubsub:          pubsub.NewInMemoryPubSub(),
 			discussionMutex: sync.Mutex{},
 			config:          config.Config{},
 			timeProvider:    &util.FrozenTime{NowTime: now},
 		}
 
-		Convey("when user is not authed", func() {
-			resp, err := backendObj.MuteParticipants(ctx, discussionID, parIDListObj, seconds)
+		Convey("when the participant query errors out", func() {
+			expectedError := fmt.Errorf("Some Error")
+			mockDB.On("GetParticipantsByDiscussionID", ctx, discussionID).Return(nil, expectedError)
 
-			So(err, ShouldNotEqual, nil)
+			resp, err := backendObj.MuteParticipants(ctx, discussionID, parIDListObj, authedUser.UserID, seconds)
+
+			So(err, ShouldEqual, expectedError)
 			So(resp, ShouldBeNil)
 		})
-		ctx = auth.WithAuthedUser(ctx, &authedUser)
 
-		Convey("when the participant query errors out", func() {
+		Convey("when the requesting user's role query errors out", func() {
 			expectedError := fmt.Errorf("Some Error")
-			mockDB.On("GetParticipantsByDiscussionID", ctx, discussionID).Return(nil, expectedError)
+			participants := []model.Participant{parObj}
+			mockDB.On("GetParticipantsByDiscussionID", ctx, discussionID).Return(participants, nil)
+			mockDB.On("GetModeratorByUserIDAndDiscussionID", ctx, authedUser.UserID, discussionID).Return(nil, expectedError)
 
-			resp, err := backendObj.MuteParticipants(ctx, discussionID, parIDListObj, seconds)
+			resp, err := backendObj.MuteParticipants(ctx, discussionID, parIDListObj, authedUser.UserID, seconds)
 
 			So(err, ShouldEqual, expectedError)
 			So(resp, ShouldBeNil)
 		})
 
+		Convey("when the participant has the same role as the requesting user", func() {
+			participants := []model.Participant{parObj}
+			helperGrant := model.ModeratorRoleGrant{Role: model.ModeratorRoleHelper}
+			mockDB.On("GetParticipantsByDiscussionID", ctx, discussionID).Return(participants, nil)
+			mockDB.On("GetModeratorByUserIDAndDiscussionID", ctx, mock.Anything, discussionID).Return(nil, nil)
+			mockDB.On("GetModeratorRoleByUserIDAndDiscussionID", ctx, mock.Anything, discussionID).Return(&helperGrant, nil)
+
+			resp, err := backendObj.MuteParticipants(ctx, discussionID, parIDListObj, authedUser.UserID, seconds)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		mockDB.On("GetModeratorByUserIDAndDiscussionID", ctx, authedUser.UserID, discussionID).Return(&modObj, nil)
+		mockDB.On("GetModeratorByUserIDAndDiscussionID", ctx, *parObj.UserID, discussionID).Return(nil, nil)
+		mockDB.On("GetModeratorRoleByUserIDAndDiscussionID", ctx, *parObj.UserID, discussionID).Return(nil, nil)
+
 		Convey("when the muted query errors out", func() {
 			expectedError := fmt.Errorf("Some Error")
 			participants := []model.Participant{parObj}
 			mockDB.On("GetParticipantsByDiscussionID", ctx, discussionID).Return(participants, nil)
 			mockDB.On("SetParticipantsMutedUntil", ctx, parListObj, mock.AnythingOfType("*time.Time")).Return(nil, expectedError)
 
-			resp, err := backendObj.MuteParticipants(ctx, discussionID, parIDListObj, seconds)
+			resp, err := backendObj.MuteParticipants(ctx, discussionID, parIDListObj, authedUser.UserID, seconds)
 
 			So(err, ShouldEqual, expectedError)
 			So(resp, ShouldBeNil)
@@ -425,7 +461,7 @@ func TestDelphisBackend_MuteParticipants(t *testing.T) {
 			mockDB.On("GetParticipantsByDiscussionID", ctx, discussionID).Return(participants, nil)
 			mockDB.On("SetParticipantsMutedUntil", ctx, parListObj, mock.AnythingOfType("*time.Time")).Return(parListObj, nil)
 
-			resp, err := backendObj.MuteParticipants(ctx, discussionID, parIDListObj, seconds)
+			resp, err := backendObj.MuteParticipants(ctx, discussionID, parIDListObj, authedUser.UserID, seconds)
 
 			So(err, ShouldNotBeNil)
 			So(resp, ShouldBeNil)
@@ -440,7 +476,7 @@ func TestDelphisBackend_MuteParticipants(t *testing.T) {
 			mockDB.On("GetParticipantsByDiscussionID", ctx, discussionID).Return(participants, nil)
 			mockDB.On("SetParticipantsMutedUntil", ctx, otherList, mock.AnythingOfType("*time.Time")).Return(otherList, nil)
 
-			resp, err := backendObj.MuteParticipants(ctx, discussionID, parIDListObj, seconds)
+			resp, err := backendObj.MuteParticipants(ctx, discussionID, parIDListObj, authedUser.UserID, seconds)
 
 			So(err, ShouldNotBeNil)
 			So(resp, ShouldBeNil)
@@ -450,8 +486,12 @@ func TestDelphisBackend_MuteParticipants(t *testing.T) {
 			participants := []model.Participant{parObj}
 			mockDB.On("GetParticipantsByDiscussionID", ctx, discussionID).Return(participants, nil)
 			mockDB.On("SetParticipantsMutedUntil", ctx, parListObj, mock.AnythingOfType("*time.Time")).Return(parListObj, nil)
+			mockDB.On("PutDiscussionEvent", ctx, participantEvent).Return(&eventObj, nil)
+			mockDB.On("PutModerationLogEntry", ctx, mock.MatchedBy(func(entry model.ModerationLogEntry) bool {
+				return entry.Action == model.ModerationActionMuteParticipant && entry.ActorUserID == authedUser.UserID
+			})).Return(&model.ModerationLogEntry{}, nil)
 
-			resp, err := backendObj.MuteParticipants(ctx, discussionID, parIDListObj, seconds)
+			resp, err := backendObj.MuteParticipants(ctx, discussionID, parIDListObj, authedUser.UserID, seconds)
 
 			So(err, ShouldBeNil)
 			So(resp, ShouldResemble, parListObj)
@@ -468,6 +508,14 @@ func TestDelphisBackend_UnmuteParticipants(t *testing.T) {
 	parListObj := []*model.Participant{&parObj}
 	discussionID := "discussionID"
 	authedUser := test_utils.TestDelphisAuthedUser()
+	modObj := test_utils.TestModerator()
+	eventObj := test_utils.TestDiscussionEvent()
+	participantEvent := model.DiscussionEvent{
+		DiscussionID: *parObj.DiscussionID,
+		EventType:    model.DiscussionSubscriptionEventTypeParticipantUnmuted,
+		EntityType:   participantEntityType,
+		EntityID:     parObj.ID,
+	}
 
 	Convey("UnmuteParticipants", t, func() {
 		cacheObj := cache.NewInMemoryCache()
@@ -477,6 +525,7 @@ func TestDelphisBackend_UnmuteParticipants(t *testing.T) {
 			db:              mockDB,
 			auth:            authObj,
 			cache:           cacheObj,
+			pubsub:          pubsub.NewInMemoryPubSub(),
 			discussionMutex: sync.Mutex{},
 			config:          config.Config{},
 			timeProvider:    &util.FrozenTime{NowTime: now},
@@ -500,6 +549,35 @@ func TestDelphisBackend_UnmuteParticipants(t *testing.T) {
 			So(resp, ShouldBeNil)
 		})
 
+		Convey("when the requesting user's role query errors out", func() {
+			expectedError := fmt.Errorf("Some Error")
+			participants := []model.Participant{parObj}
+			mockDB.On("GetParticipantsByDiscussionID", ctx, discussionID).Return(participants, nil)
+			mockDB.On("GetModeratorByUserIDAndDiscussionID", ctx, authedUser.UserID, discussionID).Return(nil, expectedError)
+
+			resp, err := backendObj.UnmuteParticipants(ctx, discussionID, parIDListObj)
+
+			So(err, ShouldEqual, expectedError)
+			So(resp, ShouldBeNil)
+		})
+
+		Convey("when the participant has the same role as the requesting user", func() {
+			participants := []model.Participant{parObj}
+			helperGrant := model.ModeratorRoleGrant{Role: model.ModeratorRoleHelper}
+			mockDB.On("GetParticipantsByDiscussionID", ctx, discussionID).Return(participants, nil)
+			mockDB.On("GetModeratorByUserIDAndDiscussionID", ctx, mock.Anything, discussionID).Return(nil, nil)
+			mockDB.On("GetModeratorRoleByUserIDAndDiscussionID", ctx, mock.Anything, discussionID).Return(&helperGrant, nil)
+
+			resp, err := backendObj.UnmuteParticipants(ctx, discussionID, parIDListObj)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		mockDB.On("GetModeratorByUserIDAndDiscussionID", ctx, authedUser.UserID, discussionID).Return(&modObj, nil)
+		mockDB.On("GetModeratorByUserIDAndDiscussionID", ctx, *parObj.UserID, discussionID).Return(nil, nil)
+		mockDB.On("GetModeratorRoleByUserIDAndDiscussionID", ctx, *parObj.UserID, discussionID).Return(nil, nil)
+
 		Convey("when the muted query errors out", func() {
 			expectedError := fmt.Errorf("Some Error")
 			participants := []model.Participant{parObj}
@@ -544,6 +622,10 @@ func TestDelphisBackend_UnmuteParticipants(t *testing.T) {
 			participants := []model.Participant{parObj}
 			mockDB.On("GetParticipantsByDiscussionID", ctx, discussionID).Return(participants, nil)
 			mockDB.On("SetParticipantsMutedUntil", ctx, parListObj, (*time.Time)(nil)).Return(parListObj, nil)
+			mockDB.On("PutDiscussionEvent", ctx, participantEvent).Return(&eventObj, nil)
+			mockDB.On("PutModerationLogEntry", ctx, mock.MatchedBy(func(entry model.ModerationLogEntry) bool {
+				return entry.Action == model.ModerationActionUnmuteParticipant
+			})).Return(&model.ModerationLogEntry{}, nil)
 
 			resp, err := backendObj.UnmuteParticipants(ctx, discussionID, parIDListObj)
 
@@ -566,6 +648,7 @@ func TestDelphisBackend_BanParticipant(t *testing.T) {
 	moderatorObj := test_utils.TestModerator()
 	userProfileObj := test_utils.TestUserProfile()
 	requestingUserID := *userProfileObj.UserID
+	coModUserID := "coModUserID"
 
 	anonParObj.IsAnonymous = true
 
@@ -582,11 +665,21 @@ func TestDelphisBackend_BanParticipant(t *testing.T) {
 			timeProvider:    &util.FrozenTime{NowTime: now},
 		}
 
+		Convey("when the reason is too long", func() {
+			reason := strings.Repeat("a", maxBanReasonLength+1)
+
+			resp, err := backendObj.BanParticipant(ctx, discussionID, participantID, requestingUserID, &reason, false)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+			mockDB.AssertNotCalled(t, "GetDiscussionByID", ctx, discussionID)
+		})
+
 		Convey("when discussion is not found", func() {
 			Convey("when an error is returned", func() {
 				mockDB.On("GetDiscussionByID", ctx, discussionID).Return(nil, fmt.Errorf("sth"))
 
-				resp, err := backendObj.BanParticipant(ctx, discussionID, participantID, requestingUserID)
+				resp, err := backendObj.BanParticipant(ctx, discussionID, participantID, requestingUserID, nil, false)
 
 				So(err, ShouldNotBeNil)
 				So(resp, ShouldBeNil)
@@ -594,7 +687,7 @@ func TestDelphisBackend_BanParticipant(t *testing.T) {
 			Convey("when nil is returned", func() {
 				mockDB.On("GetDiscussionByID", ctx, discussionID).Return(nil, nil)
 
-				resp, err := backendObj.BanParticipant(ctx, discussionID, participantID, requestingUserID)
+				resp, err := backendObj.BanParticipant(ctx, discussionID, participantID, requestingUserID, nil, false)
 
 				So(err, ShouldNotBeNil)
 				So(resp, ShouldBeNil)
@@ -603,62 +696,54 @@ func TestDelphisBackend_BanParticipant(t *testing.T) {
 
 		mockDB.On("GetDiscussionByID", ctx, discussionID).Return(&discussionObj, nil)
 
-		Convey("when the moderator is not found", func() {
-			Convey("when an error is returned", func() {
-				mockDB.On("GetModeratorByID", ctx, *discussionObj.ModeratorID).Return(nil, fmt.Errorf("sth"))
-
-				resp, err := backendObj.BanParticipant(ctx, discussionID, participantID, requestingUserID)
-
-				So(err, ShouldNotBeNil)
-				So(resp, ShouldBeNil)
-			})
-
-			Convey("when the object is nil", func() {
-				mockDB.On("GetModeratorByID", ctx, *discussionObj.ModeratorID).Return(nil, nil)
+		Convey("when the requesting user's role query errors out", func() {
+			mockDB.On("GetModeratorByUserIDAndDiscussionID", ctx, requestingUserID, discussionID).Return(nil, fmt.Errorf("sth"))
 
-				resp, err := backendObj.BanParticipant(ctx, discussionID, participantID, requestingUserID)
+			resp, err := backendObj.BanParticipant(ctx, discussionID, participantID, requestingUserID, nil, false)
 
-				So(err, ShouldNotBeNil)
-				So(resp, ShouldBeNil)
-			})
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
 		})
 
-		mockDB.On("GetModeratorByID", ctx, *discussionObj.ModeratorID).Return(&moderatorObj, nil)
-
-		Convey("when the user profile is not found", func() {
-			Convey("when an error is returned", func() {
-				mockDB.On("GetUserProfileByID", ctx, *moderatorObj.UserProfileID).Return(nil, fmt.Errorf("sth"))
+		Convey("when the requesting user is a helper", func() {
+			mockDB.On("GetModeratorByUserIDAndDiscussionID", ctx, coModUserID, discussionID).Return(nil, nil)
+			mockDB.On("GetModeratorRoleByUserIDAndDiscussionID", ctx, coModUserID, discussionID).Return(&model.ModeratorRoleGrant{Role: model.ModeratorRoleHelper}, nil)
 
-				resp, err := backendObj.BanParticipant(ctx, discussionID, participantID, requestingUserID)
+			resp, err := backendObj.BanParticipant(ctx, discussionID, participantID, coModUserID, nil, false)
 
-				So(err, ShouldNotBeNil)
-				So(resp, ShouldBeNil)
-			})
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
 
-			Convey("when the object is nil", func() {
-				mockDB.On("GetUserProfileByID", ctx, *moderatorObj.UserProfileID).Return(nil, nil)
+		Convey("when the requesting user is not a moderator", func() {
+			mockDB.On("GetModeratorByUserIDAndDiscussionID", ctx, "baduserid", discussionID).Return(nil, nil)
+			mockDB.On("GetModeratorRoleByUserIDAndDiscussionID", ctx, "baduserid", discussionID).Return(nil, nil)
 
-				resp, err := backendObj.BanParticipant(ctx, discussionID, participantID, requestingUserID)
+			resp, err := backendObj.BanParticipant(ctx, discussionID, participantID, "baduserid", nil, false)
 
-				So(err, ShouldNotBeNil)
-				So(resp, ShouldBeNil)
-			})
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
 		})
 
-		mockDB.On("GetUserProfileByID", ctx, *moderatorObj.UserProfileID).Return(&userProfileObj, nil)
+		Convey("when the requesting user and the participant are both moderators", func() {
+			modGrant := model.ModeratorRoleGrant{Role: model.ModeratorRoleModerator}
+			mockDB.On("GetModeratorByUserIDAndDiscussionID", ctx, mock.Anything, discussionID).Return(nil, nil)
+			mockDB.On("GetModeratorRoleByUserIDAndDiscussionID", ctx, mock.Anything, discussionID).Return(&modGrant, nil)
+			mockDB.On("GetParticipantByID", ctx, participantID).Return(&anonParObj, nil)
 
-		Convey("when the requesting user is not the moderator", func() {
-			resp, err := backendObj.BanParticipant(ctx, discussionID, participantID, "baduserid")
+			resp, err := backendObj.BanParticipant(ctx, discussionID, participantID, coModUserID, nil, false)
 
 			So(err, ShouldNotBeNil)
 			So(resp, ShouldBeNil)
 		})
 
+		mockDB.On("GetModeratorByUserIDAndDiscussionID", ctx, requestingUserID, discussionID).Return(&moderatorObj, nil)
+
 		Convey("when the participant is not found", func() {
 			Convey("when an error is returned", func() {
 				mockDB.On("GetParticipantByID", ctx, participantID).Return(nil, fmt.Errorf("sth"))
 
-				resp, err := backendObj.BanParticipant(ctx, discussionID, participantID, requestingUserID)
+				resp, err := backendObj.BanParticipant(ctx, discussionID, participantID, requestingUserID, nil, false)
 
 				So(err, ShouldNotBeNil)
 				So(resp, ShouldBeNil)
@@ -666,7 +751,7 @@ func TestDelphisBackend_BanParticipant(t *testing.T) {
 			Convey("when response is nil", func() {
 				mockDB.On("GetParticipantByID", ctx, participantID).Return(nil, nil)
 
-				resp, err := backendObj.BanParticipant(ctx, discussionID, participantID, requestingUserID)
+				resp, err := backendObj.BanParticipant(ctx, discussionID, participantID, requestingUserID, nil, false)
 
 				So(err, ShouldNotBeNil)
 				So(resp, ShouldBeNil)
@@ -679,7 +764,7 @@ func TestDelphisBackend_BanParticipant(t *testing.T) {
 			badDiscussion := "baddiscussion"
 			anonParObj.DiscussionID = &badDiscussion
 
-			resp, err := backendObj.BanParticipant(ctx, discussionID, participantID, requestingUserID)
+			resp, err := backendObj.BanParticipant(ctx, discussionID, participantID, requestingUserID, nil, false)
 
 			So(err, ShouldNotBeNil)
 			So(resp, ShouldBeNil)
@@ -690,7 +775,7 @@ func TestDelphisBackend_BanParticipant(t *testing.T) {
 		Convey("when moderator attempts to ban themselves", func() {
 			anonParObj.UserID = userProfileObj.UserID
 
-			resp, err := backendObj.BanParticipant(ctx, discussionID, participantID, requestingUserID)
+			resp, err := backendObj.BanParticipant(ctx, discussionID, participantID, requestingUserID, nil, false)
 
 			So(err, ShouldNotBeNil)
 			So(resp, ShouldBeNil)
@@ -701,7 +786,7 @@ func TestDelphisBackend_BanParticipant(t *testing.T) {
 		Convey("when participant is already banned", func() {
 			anonParObj.IsBanned = true
 
-			resp, err := backendObj.BanParticipant(ctx, discussionID, participantID, requestingUserID)
+			resp, err := backendObj.BanParticipant(ctx, discussionID, participantID, requestingUserID, nil, false)
 
 			So(err, ShouldBeNil)
 			So(resp, ShouldNotBeNil)
@@ -716,7 +801,7 @@ func TestDelphisBackend_BanParticipant(t *testing.T) {
 			expected := anonParObj
 			expected.IsBanned = true
 			mockDB.On("UpsertParticipant", ctx, expected).Return(nil, expectedError)
-			resp, err := backendObj.BanParticipant(ctx, discussionID, participantID, requestingUserID)
+			resp, err := backendObj.BanParticipant(ctx, discussionID, participantID, requestingUserID, nil, false)
 
 			So(err, ShouldNotBeNil)
 			So(err, ShouldNotEqual, expectedError)
@@ -725,12 +810,24 @@ func TestDelphisBackend_BanParticipant(t *testing.T) {
 
 		anonParObj.UserID = &participantUserID
 
+		Convey("when the participant's role query errors out", func() {
+			mockDB.On("GetModeratorByUserIDAndDiscussionID", ctx, participantUserID, discussionID).Return(nil, fmt.Errorf("sth"))
+
+			resp, err := backendObj.BanParticipant(ctx, discussionID, participantID, requestingUserID, nil, false)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		mockDB.On("GetModeratorByUserIDAndDiscussionID", ctx, participantUserID, discussionID).Return(nil, nil)
+		mockDB.On("GetModeratorRoleByUserIDAndDiscussionID", ctx, participantUserID, discussionID).Return(nil, nil)
+
 		Convey("when upsert fails", func() {
 			expected := anonParObj
 			expected.IsBanned = true
 			mockDB.On("UpsertParticipant", ctx, expected).Return(nil, fmt.Errorf("sth"))
 
-			resp, err := backendObj.BanParticipant(ctx, discussionID, participantID, requestingUserID)
+			resp, err := backendObj.BanParticipant(ctx, discussionID, participantID, requestingUserID, nil, false)
 
 			So(err, ShouldNotBeNil)
 			So(resp, ShouldBeNil)
@@ -744,7 +841,7 @@ func TestDelphisBackend_BanParticipant(t *testing.T) {
 			Convey("when upsert discussion access fails", func() {
 				mockDB.On("BeginTx", ctx).Return(nil, fmt.Errorf("sth"))
 
-				resp, err := backendObj.BanParticipant(ctx, discussionID, participantID, requestingUserID)
+				resp, err := backendObj.BanParticipant(ctx, discussionID, participantID, requestingUserID, nil, false)
 
 				So(err, ShouldNotBeNil)
 				So(resp, ShouldBeNil)
@@ -760,28 +857,336 @@ func TestDelphisBackend_BanParticipant(t *testing.T) {
 				mockDB.On("UpsertDiscussionUserAccess", ctx, &tx, mock.Anything).Return(&discussionUserAccess, nil)
 				mockDB.On("CommitTx", ctx, &tx).Return(nil)
 
+				Convey("when deleting the participant's role fails", func() {
+					mockDB.On("DeleteModeratorRole", ctx, participantUserID, discussionID).Return(fmt.Errorf("sth"))
+
+					resp, err := backendObj.BanParticipant(ctx, discussionID, participantID, requestingUserID, nil, false)
+
+					So(err, ShouldNotBeNil)
+					So(resp, ShouldBeNil)
+				})
+
+				mockDB.On("DeleteModeratorRole", ctx, participantUserID, discussionID).Return(nil)
+
+				Convey("when putting the ban fails", func() {
+					mockDB.On("PutDiscussionBan", ctx, mock.Anything).Return(nil, fmt.Errorf("sth"))
+
+					resp, err := backendObj.BanParticipant(ctx, discussionID, participantID, requestingUserID, nil, false)
+
+					So(err, ShouldNotBeNil)
+					So(resp, ShouldBeNil)
+				})
+
+				Convey("when the ban has a reason", func() {
+					reason := "  Spamming links  "
+					mockDB.On("PutDiscussionBan", ctx, mock.MatchedBy(func(ban model.DiscussionBan) bool {
+						return ban.Reason != nil && *ban.Reason == "Spamming links" && ban.UserID == participantUserID && ban.BannedByUserID == requestingUserID
+					})).Return(&model.DiscussionBan{}, nil)
+					mockDB.On("DeleteAllParticipantPosts", ctx, discussionID, participantID, model.PostDeletedReasonParticipantBanned).Return(1, nil)
+					mockDB.On("PutModerationLogEntry", ctx, mock.MatchedBy(func(entry model.ModerationLogEntry) bool {
+						return entry.Reason != nil && *entry.Reason == "Spamming links"
+					})).Return(&model.ModerationLogEntry{}, nil)
+
+					resp, err := backendObj.BanParticipant(ctx, discussionID, participantID, requestingUserID, &reason, false)
+
+					So(err, ShouldBeNil)
+					So(resp, ShouldEqual, &expected)
+					mockDB.AssertCalled(t, "PutModerationLogEntry", ctx, mock.Anything)
+				})
+
+				mockDB.On("PutDiscussionBan", ctx, mock.MatchedBy(func(ban model.DiscussionBan) bool {
+					return ban.Reason == nil && ban.ParticipantID == participantID
+				})).Return(&model.DiscussionBan{}, nil)
+				mockDB.On("PutModerationLogEntry", ctx, mock.MatchedBy(func(entry model.ModerationLogEntry) bool {
+					return entry.Action == model.ModerationActionBanParticipant
+				})).Return(&model.ModerationLogEntry{}, nil)
+
+				Convey("when posts are kept", func() {
+					resp, err := backendObj.BanParticipant(ctx, discussionID, participantID, requestingUserID, nil, true)
+
+					So(err, ShouldBeNil)
+					So(resp, ShouldEqual, &expected)
+					mockDB.AssertNotCalled(t, "DeleteAllParticipantPosts", ctx, discussionID, participantID, mock.Anything)
+				})
+
 				Convey("when delete posts fails", func() {
-					mockDB.On("DeleteAllParticipantPosts", ctx, discussionID, participantID, model.PostDeletedReasonParticipantRemoved).Return(0, fmt.Errorf("sth"))
+					mockDB.On("DeleteAllParticipantPosts", ctx, discussionID, participantID, model.PostDeletedReasonParticipantBanned).Return(0, fmt.Errorf("sth"))
 
-					resp, err := backendObj.BanParticipant(ctx, discussionID, participantID, requestingUserID)
+					resp, err := backendObj.BanParticipant(ctx, discussionID, participantID, requestingUserID, nil, false)
 
 					So(err, ShouldBeNil)
 					So(resp, ShouldEqual, &expected)
 				})
 
 				Convey("when delete posts succeeds", func() {
-					mockDB.On("DeleteAllParticipantPosts", ctx, discussionID, participantID, model.PostDeletedReasonParticipantRemoved).Return(1, nil)
+					mockDB.On("DeleteAllParticipantPosts", ctx, discussionID, participantID, model.PostDeletedReasonParticipantBanned).Return(1, nil)
 
-					resp, err := backendObj.BanParticipant(ctx, discussionID, participantID, requestingUserID)
+					resp, err := backendObj.BanParticipant(ctx, discussionID, participantID, requestingUserID, nil, false)
 
 					So(err, ShouldBeNil)
 					So(resp, ShouldEqual, &expected)
+					mockDB.AssertCalled(t, "PutModerationLogEntry", ctx, mock.Anything)
 				})
 			})
 		})
 	})
 }
 
+func TestDelphisBackend_UnbanParticipant(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	participantID := test_utils.ParticipantID
+	parObj := test_utils.TestParticipant()
+	participantUserID := "participant_user_id"
+	parObj.UserID = &participantUserID
+	discussionObj := test_utils.TestDiscussion()
+	discussionID := test_utils.DiscussionID
+	moderatorObj := test_utils.TestModerator()
+	userProfileObj := test_utils.TestUserProfile()
+	requestingUserID := *userProfileObj.UserID
+	eventObj := test_utils.TestDiscussionEvent()
+
+	Convey("UnbanParticipant", t, func() {
+		cacheObj := cache.NewInMemoryCache()
+		authObj := auth.NewDelphisAuth(nil)
+		mockDB := &mocks.Datastore{}
+		backendObj := &delphisBackend{
+			db:              mockDB,
+			auth:            authObj,
+			cache:           cacheObj,
+			pubsub:          pubsub.NewInMemoryPubSub(),
+			discussionMutex: sync.Mutex{},
+			config:          config.Config{},
+			timeProvider:    &util.FrozenTime{NowTime: now},
+		}
+
+		Convey("when discussion is not found", func() {
+			mockDB.On("GetDiscussionByID", ctx, discussionID).Return(nil, nil)
+
+			resp, err := backendObj.UnbanParticipant(ctx, discussionID, participantID, requestingUserID, false)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		mockDB.On("GetDiscussionByID", ctx, discussionID).Return(&discussionObj, nil)
+
+		Convey("when the requesting user is not a moderator", func() {
+			mockDB.On("GetModeratorByUserIDAndDiscussionID", ctx, "baduserid", discussionID).Return(nil, nil)
+			mockDB.On("GetModeratorRoleByUserIDAndDiscussionID", ctx, "baduserid", discussionID).Return(nil, nil)
+
+			resp, err := backendObj.UnbanParticipant(ctx, discussionID, participantID, "baduserid", false)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		mockDB.On("GetModeratorByUserIDAndDiscussionID", ctx, requestingUserID, discussionID).Return(&moderatorObj, nil)
+
+		Convey("when the participant is not found", func() {
+			mockDB.On("GetParticipantByID", ctx, participantID).Return(nil, fmt.Errorf("sth"))
+
+			resp, err := backendObj.UnbanParticipant(ctx, discussionID, participantID, requestingUserID, false)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		mockDB.On("GetParticipantByID", ctx, participantID).Return(&parObj, nil)
+
+		Convey("when participant is not part of the discussion", func() {
+			badDiscussion := "baddiscussion"
+			parObj.DiscussionID = &badDiscussion
+
+			resp, err := backendObj.UnbanParticipant(ctx, discussionID, participantID, requestingUserID, false)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		parObj.DiscussionID = &discussionID
+
+		Convey("when participant is not banned", func() {
+			parObj.IsBanned = false
+
+			resp, err := backendObj.UnbanParticipant(ctx, discussionID, participantID, requestingUserID, false)
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldNotBeNil)
+			mockDB.AssertNotCalled(t, "UpsertParticipant", ctx, mock.Anything)
+		})
+
+		parObj.IsBanned = true
+		expected := parObj
+		expected.IsBanned = false
+
+		Convey("when upsert fails", func() {
+			mockDB.On("UpsertParticipant", ctx, expected).Return(nil, fmt.Errorf("sth"))
+
+			resp, err := backendObj.UnbanParticipant(ctx, discussionID, participantID, requestingUserID, false)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		mockDB.On("UpsertParticipant", ctx, expected).Return(&expected, nil)
+
+		Convey("when getting the user's participants fails", func() {
+			mockDB.On("GetParticipantsByDiscussionIDUserID", ctx, discussionID, participantUserID).Return(nil, fmt.Errorf("sth"))
+
+			resp, err := backendObj.UnbanParticipant(ctx, discussionID, participantID, requestingUserID, false)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		Convey("when the user's other participant is still banned", func() {
+			otherParticipant := test_utils.TestParticipant()
+			otherParticipant.ID = "other_participant_id"
+			otherParticipant.IsBanned = true
+			mockDB.On("GetParticipantsByDiscussionIDUserID", ctx, discussionID, participantUserID).Return([]model.Participant{expected, otherParticipant}, nil)
+			mockDB.On("DeleteDiscussionBan", ctx, participantID).Return(nil)
+			mockDB.On("PutDiscussionEvent", ctx, mock.Anything).Return(&eventObj, nil)
+			mockDB.On("PutModerationLogEntry", ctx, mock.Anything).Return(&model.ModerationLogEntry{}, nil)
+
+			resp, err := backendObj.UnbanParticipant(ctx, discussionID, participantID, requestingUserID, false)
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldResemble, &expected)
+			mockDB.AssertNotCalled(t, "BeginTx", ctx)
+			mockDB.AssertNotCalled(t, "UpsertDiscussionUserAccess", ctx, mock.Anything, mock.Anything)
+		})
+
+		mockDB.On("GetParticipantsByDiscussionIDUserID", ctx, discussionID, participantUserID).Return([]model.Participant{expected}, nil)
+
+		Convey("when upsert discussion access fails", func() {
+			mockDB.On("BeginTx", ctx).Return(nil, fmt.Errorf("sth"))
+
+			resp, err := backendObj.UnbanParticipant(ctx, discussionID, participantID, requestingUserID, false)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		discussionUserAccess := test_utils.TestDiscussionUserAccess()
+		tx := sql.Tx{}
+		mockDB.On("BeginTx", ctx).Return(&tx, nil)
+		mockDB.On("GetDiscussionUserAccess", ctx, discussionID, participantUserID).Return(&discussionUserAccess, nil)
+		mockDB.On("UpsertDiscussionUserAccess", ctx, &tx, mock.MatchedBy(func(dua model.DiscussionUserAccess) bool {
+			return dua.State == model.DiscussionUserAccessStateActive
+		})).Return(&discussionUserAccess, nil)
+		mockDB.On("CommitTx", ctx, &tx).Return(nil)
+
+		Convey("when deleting the ban fails", func() {
+			mockDB.On("DeleteDiscussionBan", ctx, participantID).Return(fmt.Errorf("sth"))
+
+			resp, err := backendObj.UnbanParticipant(ctx, discussionID, participantID, requestingUserID, false)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		mockDB.On("DeleteDiscussionBan", ctx, participantID).Return(nil)
+
+		Convey("when restoring posts fails", func() {
+			mockDB.On("RestoreParticipantPosts", ctx, discussionID, participantID, model.PostDeletedReasonParticipantBanned).Return(nil, fmt.Errorf("sth"))
+
+			resp, err := backendObj.UnbanParticipant(ctx, discussionID, participantID, requestingUserID, true)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		mockDB.On("PutDiscussionEvent", ctx, model.DiscussionEvent{
+			DiscussionID: discussionID,
+			EventType:    model.DiscussionSubscriptionEventTypeParticipantUnbanned,
+			EntityType:   participantEntityType,
+			EntityID:     participantID,
+		}).Return(&eventObj, nil)
+		mockDB.On("PutModerationLogEntry", ctx, mock.MatchedBy(func(entry model.ModerationLogEntry) bool {
+			return entry.Action == model.ModerationActionUnbanParticipant && entry.ActorUserID == requestingUserID
+		})).Return(&model.ModerationLogEntry{}, nil)
+
+		Convey("when posts are restored", func() {
+			mockDB.On("RestoreParticipantPosts", ctx, discussionID, participantID, model.PostDeletedReasonParticipantBanned).Return([]string{"post1", "post2"}, nil)
+			mockDB.On("PutDiscussionEvent", ctx, mock.MatchedBy(func(event model.DiscussionEvent) bool {
+				return event.EventType == model.DiscussionSubscriptionEventTypePostAdded && event.EntityType == postEntityType
+			})).Return(&eventObj, nil)
+
+			resp, err := backendObj.UnbanParticipant(ctx, discussionID, participantID, requestingUserID, true)
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldResemble, &expected)
+			mockDB.AssertCalled(t, "PutModerationLogEntry", ctx, mock.Anything)
+			for _, postID := range []string{"post1", "post2"} {
+				mockDB.AssertCalled(t, "PutDiscussionEvent", ctx, model.DiscussionEvent{
+					DiscussionID: discussionID,
+					EventType:    model.DiscussionSubscriptionEventTypePostAdded,
+					EntityType:   postEntityType,
+					EntityID:     postID,
+				})
+			}
+		})
+
+		Convey("when posts are not restored", func() {
+			resp, err := backendObj.UnbanParticipant(ctx, discussionID, participantID, requestingUserID, false)
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldResemble, &expected)
+			mockDB.AssertNotCalled(t, "RestoreParticipantPosts", ctx, discussionID, participantID, mock.Anything)
+		})
+	})
+}
+
+func TestDelphisBackend_GetBanReason(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	discussionID := test_utils.DiscussionID
+	userID := "userID"
+	reason := "Spamming links"
+
+	Convey("GetBanReason", t, func() {
+		mockDB := &mocks.Datastore{}
+		backendObj := &delphisBackend{
+			db:              mockDB,
+			auth:            auth.NewDelphisAuth(nil),
+			cache:           cache.NewInMemoryCache(),
+			discussionMutex: sync.Mutex{},
+			config:          config.Config{},
+			timeProvider:    &util.FrozenTime{NowTime: now},
+		}
+
+		Convey("when the query errors out", func() {
+			mockDB.On("GetDiscussionBanByDiscussionIDUserID", ctx, discussionID, userID).Return(nil, fmt.Errorf("sth"))
+
+			resp, err := backendObj.GetBanReason(ctx, discussionID, userID)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		Convey("when the user is not banned", func() {
+			mockDB.On("GetDiscussionBanByDiscussionIDUserID", ctx, discussionID, userID).Return(nil, nil)
+
+			resp, err := backendObj.GetBanReason(ctx, discussionID, userID)
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		Convey("when the user is banned", func() {
+			mockDB.On("GetDiscussionBanByDiscussionIDUserID", ctx, discussionID, userID).Return(&model.DiscussionBan{Reason: &reason}, nil)
+
+			resp, err := backendObj.GetBanReason(ctx, discussionID, userID)
+
+			So(err, ShouldBeNil)
+			So(*resp, ShouldEqual, reason)
+		})
+	})
+}
+
 func TestDelphisBackend_UpdateParticipant(t *testing.T) {
 	ctx := context.Background()
 	now := time.Now()
@@ -792,6 +1197,13 @@ func TestDelphisBackend_UpdateParticipant(t *testing.T) {
 	updateObj := test_utils.TestUpdateParticipantInput()
 
 	anonParObj.IsAnonymous = true
+	eventObj := test_utils.TestDiscussionEvent()
+	participantEvent := model.DiscussionEvent{
+		DiscussionID: *anonParObj.DiscussionID,
+		EventType:    model.DiscussionSubscriptionEventTypeParticipantJoined,
+		EntityType:   participantEntityType,
+		EntityID:     anonParObj.ID,
+	}
 	participants := UserDiscussionParticipants{
 		Anon: &anonParObj,
 	}
@@ -804,6 +1216,7 @@ func TestDelphisBackend_UpdateParticipant(t *testing.T) {
 			db:              mockDB,
 			auth:            authObj,
 			cache:           cacheObj,
+			pubsub:          pubsub.NewInMemoryPubSub(),
 			discussionMutex: sync.Mutex{},
 			config:          config.Config{},
 			timeProvider:    &util.FrozenTime{NowTime: now},
@@ -820,6 +1233,7 @@ func TestDelphisBackend_UpdateParticipant(t *testing.T) {
 		Convey("when the user can create an non-anonymous participant", func() {
 			mockDB.On("GetTotalParticipantCountByDiscussionID", ctx, mock.Anything).Return(10)
 			mockDB.On("UpsertParticipant", ctx, mock.Anything).Return(&anonParObj, nil)
+			mockDB.On("PutDiscussionEvent", ctx, participantEvent).Return(&eventObj, nil)
 
 			resp, err := backendObj.UpdateParticipant(ctx, participants, participantID, updateObj)
 
@@ -834,6 +1248,7 @@ func TestDelphisBackend_UpdateParticipant(t *testing.T) {
 
 			mockDB.On("GetTotalParticipantCountByDiscussionID", ctx, mock.Anything).Return(10)
 			mockDB.On("UpsertParticipant", ctx, mock.Anything).Return(&anonParObj, nil)
+			mockDB.On("PutDiscussionEvent", ctx, participantEvent).Return(&eventObj, nil)
 
 			resp, err := backendObj.UpdateParticipant(ctx, testParticipants, participantID, updateObj)
 
@@ -847,6 +1262,7 @@ func TestDelphisBackend_UpdateParticipant(t *testing.T) {
 			testParticipants.Anon.HasJoined = false
 
 			mockDB.On("UpsertParticipant", ctx, mock.Anything).Return(&anonParObj, nil)
+			mockDB.On("PutDiscussionEvent", ctx, participantEvent).Return(&eventObj, nil)
 
 			resp, err := backendObj.UpdateParticipant(ctx, testParticipants, participantID, updateObj)
 
@@ -0,0 +1,70 @@
+package backend
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/delphis-inc/delphisbe/graph/model"
+	"github.com/sirupsen/logrus"
+)
+
+// Pins are stored on the post itself rather than against participants, so
+// shuffling a discussion leaves them in place.
+func (d *delphisBackend) PinPost(ctx context.Context, discussionID string, postID string, requestingUserID string) (*model.Post, error) {
+	post, err := d.getPinnablePost(ctx, discussionID, postID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Re-pinning moves the post back to the top of the pinned list
+	now := d.timeProvider.Now()
+	if err := d.db.UpdatePostPinnedAt(ctx, post.ID, &now); err != nil {
+		logrus.WithError(err).Error("failed to pin post")
+		return nil, err
+	}
+	post.PinnedAt = &now
+
+	d.emitDiscussionEvent(ctx, discussionID, model.DiscussionSubscriptionEventTypePostPinned, post)
+	d.recordModerationAction(ctx, discussionID, requestingUserID, model.ModerationActionPinPost, model.ModerationTargetTypePost, post.ID, nil)
+
+	return post, nil
+}
+
+func (d *delphisBackend) UnpinPost(ctx context.Context, discussionID string, postID string, requestingUserID string) (*model.Post, error) {
+	post, err := d.getPinnablePost(ctx, discussionID, postID)
+	if err != nil {
+		return nil, err
+	}
+
+	if post.PinnedAt == nil {
+		return post, nil
+	}
+
+	if err := d.db.UpdatePostPinnedAt(ctx, post.ID, nil); err != nil {
+		logrus.WithError(err).Error("failed to unpin post")
+		return nil, err
+	}
+	post.PinnedAt = nil
+
+	d.emitDiscussionEvent(ctx, discussionID, model.DiscussionSubscriptionEventTypePostUnpinned, post)
+	d.recordModerationAction(ctx, discussionID, requestingUserID, model.ModerationActionUnpinPost, model.ModerationTargetTypePost, post.ID, nil)
+
+	return post, nil
+}
+
+func (d *delphisBackend) GetPinnedPostsByDiscussionID(ctx context.Context, discussionID string) ([]*model.Post, error) {
+	iter := d.db.GetPinnedPostsByDiscussionIDIter(ctx, discussionID)
+	return d.db.PostIterCollect(ctx, iter)
+}
+
+func (d *delphisBackend) getPinnablePost(ctx context.Context, discussionID string, postID string) (*model.Post, error) {
+	post, err := d.GetPostByDiscussionPostID(ctx, discussionID, postID)
+	if err != nil || post == nil {
+		return nil, fmt.Errorf("Post not found")
+	}
+	if post.DeletedAt != nil {
+		return nil, fmt.Errorf("Post has been deleted")
+	}
+
+	return post, nil
+}
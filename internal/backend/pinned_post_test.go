@@ -0,0 +1,221 @@
+package backend
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/delphis-inc/delphisbe/graph/model"
+	"github.com/delphis-inc/delphisbe/internal/auth"
+	"github.com/delphis-inc/delphisbe/internal/backend/test_utils"
+	"github.com/delphis-inc/delphisbe/internal/cache"
+	"github.com/delphis-inc/delphisbe/internal/config"
+	"github.com/delphis-inc/delphisbe/internal/pubsub"
+	"github.com/delphis-inc/delphisbe/internal/util"
+	"github.com/delphis-inc/delphisbe/mocks"
+	. "github.com/smartystreets/goconvey/convey"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestDelphisBackend_PinPost(t *testing.T) {
+	ctx := context.Background()
+
+	discussionID := test_utils.DiscussionID
+	eventObj := test_utils.TestDiscussionEvent()
+
+	Convey("PinPost", t, func() {
+		now := time.Now()
+		cacheObj := cache.NewInMemoryCache()
+		authObj := auth.NewDelphisAuth(nil)
+		mockDB := &mocks.Datastore{}
+		backendObj := &delphisBackend{
+			db:              mockDB,
+			auth:            authObj,
+			cache:           cacheObj,
+			pubsub:          pubsub.NewInMemoryPubSub(),
+			discussionMutex: sync.Mutex{},
+			config:          config.Config{},
+			timeProvider:    &util.FrozenTime{NowTime: now},
+		}
+
+		postObj := test_utils.TestPost()
+
+		Convey("when the post is not found", func() {
+			mockDB.On("GetPostByID", ctx, postObj.ID).Return(nil, fmt.Errorf("sth"))
+
+			resp, err := backendObj.PinPost(ctx, discussionID, postObj.ID, test_utils.UserID)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		Convey("when the post belongs to another discussion", func() {
+			mockDB.On("GetPostByID", ctx, postObj.ID).Return(&postObj, nil)
+
+			resp, err := backendObj.PinPost(ctx, "other_discussion_id", postObj.ID, test_utils.UserID)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		Convey("when the post is deleted", func() {
+			postObj.DeletedAt = &now
+			mockDB.On("GetPostByID", ctx, postObj.ID).Return(&postObj, nil)
+
+			resp, err := backendObj.PinPost(ctx, discussionID, postObj.ID, test_utils.UserID)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		mockDB.On("GetPostByID", ctx, postObj.ID).Return(&postObj, nil)
+
+		Convey("when UpdatePostPinnedAt errors out", func() {
+			mockDB.On("UpdatePostPinnedAt", ctx, postObj.ID, &now).Return(fmt.Errorf("sth"))
+
+			resp, err := backendObj.PinPost(ctx, discussionID, postObj.ID, test_utils.UserID)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		Convey("when the post is pinned", func() {
+			mockDB.On("UpdatePostPinnedAt", ctx, postObj.ID, &now).Return(nil)
+			mockDB.On("PutDiscussionEvent", ctx, mock.Anything).Return(&eventObj, nil)
+			mockDB.On("PutModerationLogEntry", ctx, mock.Anything).Return(&model.ModerationLogEntry{}, nil)
+
+			resp, err := backendObj.PinPost(ctx, discussionID, postObj.ID, test_utils.UserID)
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldNotBeNil)
+			So(*resp.PinnedAt, ShouldEqual, now)
+			mockDB.AssertCalled(t, "PutDiscussionEvent", ctx, mock.MatchedBy(func(event model.DiscussionEvent) bool {
+				return event.EventType == model.DiscussionSubscriptionEventTypePostPinned && event.EntityID == postObj.ID
+			}))
+			mockDB.AssertCalled(t, "PutModerationLogEntry", ctx, mock.MatchedBy(func(entry model.ModerationLogEntry) bool {
+				return entry.Action == model.ModerationActionPinPost && entry.ActorUserID == test_utils.UserID
+			}))
+		})
+	})
+}
+
+func TestDelphisBackend_UnpinPost(t *testing.T) {
+	ctx := context.Background()
+
+	discussionID := test_utils.DiscussionID
+	eventObj := test_utils.TestDiscussionEvent()
+
+	Convey("UnpinPost", t, func() {
+		now := time.Now()
+		cacheObj := cache.NewInMemoryCache()
+		authObj := auth.NewDelphisAuth(nil)
+		mockDB := &mocks.Datastore{}
+		backendObj := &delphisBackend{
+			db:              mockDB,
+			auth:            authObj,
+			cache:           cacheObj,
+			pubsub:          pubsub.NewInMemoryPubSub(),
+			discussionMutex: sync.Mutex{},
+			config:          config.Config{},
+			timeProvider:    &util.FrozenTime{NowTime: now},
+		}
+
+		postObj := test_utils.TestPost()
+
+		Convey("when the post is not found", func() {
+			mockDB.On("GetPostByID", ctx, postObj.ID).Return(nil, nil)
+
+			resp, err := backendObj.UnpinPost(ctx, discussionID, postObj.ID, test_utils.UserID)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		Convey("when the post is not pinned", func() {
+			mockDB.On("GetPostByID", ctx, postObj.ID).Return(&postObj, nil)
+
+			resp, err := backendObj.UnpinPost(ctx, discussionID, postObj.ID, test_utils.UserID)
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldResemble, &postObj)
+			mockDB.AssertNotCalled(t, "UpdatePostPinnedAt", ctx, postObj.ID, mock.Anything)
+		})
+
+		postObj.PinnedAt = &now
+		mockDB.On("GetPostByID", ctx, postObj.ID).Return(&postObj, nil)
+
+		Convey("when UpdatePostPinnedAt errors out", func() {
+			mockDB.On("UpdatePostPinnedAt", ctx, postObj.ID, (*time.Time)(nil)).Return(fmt.Errorf("sth"))
+
+			resp, err := backendObj.UnpinPost(ctx, discussionID, postObj.ID, test_utils.UserID)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		Convey("when the post is unpinned", func() {
+			mockDB.On("UpdatePostPinnedAt", ctx, postObj.ID, (*time.Time)(nil)).Return(nil)
+			mockDB.On("PutDiscussionEvent", ctx, mock.Anything).Return(&eventObj, nil)
+			mockDB.On("PutModerationLogEntry", ctx, mock.Anything).Return(&model.ModerationLogEntry{}, nil)
+
+			resp, err := backendObj.UnpinPost(ctx, discussionID, postObj.ID, test_utils.UserID)
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldNotBeNil)
+			So(resp.PinnedAt, ShouldBeNil)
+			mockDB.AssertCalled(t, "PutDiscussionEvent", ctx, mock.MatchedBy(func(event model.DiscussionEvent) bool {
+				return event.EventType == model.DiscussionSubscriptionEventTypePostUnpinned && event.EntityID == postObj.ID
+			}))
+			mockDB.AssertCalled(t, "PutModerationLogEntry", ctx, mock.MatchedBy(func(entry model.ModerationLogEntry) bool {
+				return entry.Action == model.ModerationActionUnpinPost && entry.ActorUserID == test_utils.UserID
+			}))
+		})
+	})
+}
+
+func TestDelphisBackend_GetPinnedPostsByDiscussionID(t *testing.T) {
+	ctx := context.Background()
+
+	discussionID := test_utils.DiscussionID
+
+	Convey("GetPinnedPostsByDiscussionID", t, func() {
+		now := time.Now()
+		cacheObj := cache.NewInMemoryCache()
+		authObj := auth.NewDelphisAuth(nil)
+		mockDB := &mocks.Datastore{}
+		backendObj := &delphisBackend{
+			db:              mockDB,
+			auth:            authObj,
+			cache:           cacheObj,
+			pubsub:          pubsub.NewInMemoryPubSub(),
+			discussionMutex: sync.Mutex{},
+			config:          config.Config{},
+			timeProvider:    &util.FrozenTime{NowTime: now},
+		}
+
+		postObj := test_utils.TestPost()
+		postObj.PinnedAt = &now
+
+		Convey("when the iterator errors out", func() {
+			mockDB.On("GetPinnedPostsByDiscussionIDIter", ctx, discussionID).Return(&mockPostIter{})
+			mockDB.On("PostIterCollect", ctx, mock.Anything).Return(nil, fmt.Errorf("sth"))
+
+			resp, err := backendObj.GetPinnedPostsByDiscussionID(ctx, discussionID)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		Convey("when pinned posts are returned", func() {
+			mockDB.On("GetPinnedPostsByDiscussionIDIter", ctx, discussionID).Return(&mockPostIter{})
+			mockDB.On("PostIterCollect", ctx, mock.Anything).Return([]*model.Post{&postObj}, nil)
+
+			resp, err := backendObj.GetPinnedPostsByDiscussionID(ctx, discussionID)
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldResemble, []*model.Post{&postObj})
+		})
+	})
+}
@@ -0,0 +1,248 @@
+package backend
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/delphis-inc/delphisbe/graph/model"
+	"github.com/delphis-inc/delphisbe/internal/util"
+	"github.com/sirupsen/logrus"
+	"go.uber.org/multierr"
+)
+
+const (
+	minPollOptions = 2
+	maxPollOptions = 10
+	// Matches the text column of poll_options.
+	maxPollOptionLength = 140
+)
+
+// Votes are attributed to the participant so that a vote from an anonymous
+// participant stays anonymous. Voting again replaces the previous votes.
+func (d *delphisBackend) VotePoll(ctx context.Context, discussionID string, participantID string, postID string, optionIDs []string) (*model.Post, error) {
+	post, err := d.GetPostByDiscussionPostID(ctx, discussionID, postID)
+	if err != nil || post == nil {
+		return nil, fmt.Errorf("Post not found")
+	}
+	if post.DeletedAt != nil {
+		return nil, fmt.Errorf("Post has been deleted")
+	}
+	if post.PostType != model.PostTypePoll {
+		return nil, fmt.Errorf("Post is not a poll")
+	}
+
+	poll, err := d.db.GetPollByPostID(ctx, post.ID, []string{participantID})
+	if err != nil {
+		logrus.WithError(err).Error("failed to get poll")
+		return nil, err
+	}
+	if poll == nil {
+		return nil, fmt.Errorf("Post is not a poll")
+	}
+	if poll.IsClosed(d.timeProvider.Now()) {
+		return nil, fmt.Errorf("Poll is closed")
+	}
+
+	optionIDs, err = validatePollVote(poll, optionIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	userParticipantIDs, err := d.getUserParticipantIDs(ctx, discussionID, participantID)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := d.db.BeginTx(ctx)
+	if err != nil {
+		logrus.WithError(err).Error("failed to begin tx")
+		return nil, err
+	}
+
+	if err := d.db.ReplacePollVotes(ctx, tx, post.ID, participantID, userParticipantIDs, optionIDs); err != nil {
+		logrus.WithError(err).Error("failed to replace poll votes")
+
+		// Rollback on errors
+		if txErr := d.db.RollbackTx(ctx, tx); txErr != nil {
+			logrus.WithError(txErr).Error("failed to rollback tx")
+			return nil, multierr.Append(err, txErr)
+		}
+		return nil, err
+	}
+
+	if err := d.db.CommitTx(ctx, tx); err != nil {
+		logrus.WithError(err).Error("failed to commit poll vote tx")
+		return nil, err
+	}
+
+	// Clients refetch the poll to update the tallies
+	d.emitDiscussionEvent(ctx, discussionID, model.DiscussionSubscriptionEventTypePostPollVoted, post)
+
+	return post, nil
+}
+
+// getUserParticipantIDs returns the IDs of every participant the voting
+// participant's user has in the discussion. Users have both an anonymous and a
+// non-anonymous participant, and may only vote once between them.
+func (d *delphisBackend) getUserParticipantIDs(ctx context.Context, discussionID string, participantID string) ([]string, error) {
+	participant, err := d.db.GetParticipantByID(ctx, participantID)
+	if err != nil {
+		logrus.WithError(err).Error("failed to get voting participant")
+		return nil, err
+	}
+	if participant == nil || participant.UserID == nil {
+		return nil, fmt.Errorf("Participant not found")
+	}
+
+	participants, err := d.db.GetParticipantsByDiscussionIDUserID(ctx, discussionID, *participant.UserID)
+	if err != nil {
+		logrus.WithError(err).Error("failed to get user's participants")
+		return nil, err
+	}
+
+	participantIDs := []string{participantID}
+	for _, elem := range participants {
+		if elem.ID != participantID {
+			participantIDs = append(participantIDs, elem.ID)
+		}
+	}
+	return participantIDs, nil
+}
+
+func (d *delphisBackend) GetPollByPostID(ctx context.Context, postID string, participantIDs []string) (*model.Poll, error) {
+	return d.db.GetPollByPostID(ctx, postID, participantIDs)
+}
+
+func (d *delphisBackend) IsPollClosed(poll *model.Poll) bool {
+	return poll.IsClosed(d.timeProvider.Now())
+}
+
+// Voters are never revealed for anonymous polls.
+func (d *delphisBackend) GetPollOptionVoters(ctx context.Context, option model.PollOption) ([]*model.Participant, error) {
+	if option.PollIsAnonymous {
+		return []*model.Participant{}, nil
+	}
+
+	participantIDs, err := d.db.GetPollVoterIDsByOptionID(ctx, option.ID)
+	if err != nil {
+		logrus.WithError(err).Error("failed to get poll voters")
+		return nil, err
+	}
+
+	participantMap, err := d.db.GetParticipantsByIDs(ctx, participantIDs)
+	if err != nil {
+		logrus.WithError(err).Error("failed to get poll voter participants")
+		return nil, err
+	}
+
+	voters := make([]*model.Participant, 0)
+	for _, participantID := range participantIDs {
+		if participant, ok := participantMap[participantID]; ok && participant != nil {
+			voters = append(voters, participant)
+		}
+	}
+
+	return voters, nil
+}
+
+// Loads the results of POLL posts so they are captured by the archive.
+func (d *delphisBackend) loadPollsForArchive(ctx context.Context, posts []*model.Post) error {
+	for _, post := range posts {
+		if post.PostType != model.PostTypePoll || post.DeletedAt != nil {
+			continue
+		}
+
+		poll, err := d.db.GetPollByPostID(ctx, post.ID, nil)
+		if err != nil {
+			logrus.WithError(err).Error("failed to get poll for archive")
+			return err
+		}
+		post.Poll = poll
+	}
+
+	return nil
+}
+
+func validatePollInput(input model.PostContentInput, now time.Time) error {
+	if input.PostType != model.PostTypePoll {
+		if input.Poll != nil {
+			return fmt.Errorf("Only POLL posts may have a poll")
+		}
+		return nil
+	}
+
+	if input.Poll == nil {
+		return fmt.Errorf("POLL posts must have a poll")
+	}
+	if len(input.Poll.Options) < minPollOptions || len(input.Poll.Options) > maxPollOptions {
+		return fmt.Errorf("Polls must have between %d and %d options", minPollOptions, maxPollOptions)
+	}
+	for _, option := range input.Poll.Options {
+		text := strings.TrimSpace(option)
+		if text == "" {
+			return fmt.Errorf("Poll options must not be empty")
+		}
+		if len(text) > maxPollOptionLength {
+			return fmt.Errorf("Poll options must be at most %d characters", maxPollOptionLength)
+		}
+	}
+	if input.Poll.ClosesAt != nil && !input.Poll.ClosesAt.After(now) {
+		return fmt.Errorf("Poll must close in the future")
+	}
+
+	return nil
+}
+
+func newPoll(postID string, input model.PollInput) model.Poll {
+	poll := model.Poll{
+		PostID:   postID,
+		ClosesAt: input.ClosesAt,
+		Options:  make([]*model.PollOption, 0),
+	}
+	if input.AllowMultipleVotes != nil {
+		poll.AllowMultipleVotes = *input.AllowMultipleVotes
+	}
+	if input.IsAnonymous != nil {
+		poll.IsAnonymous = *input.IsAnonymous
+	}
+
+	for i, option := range input.Options {
+		poll.Options = append(poll.Options, &model.PollOption{
+			ID:       util.UUIDv4(),
+			PostID:   postID,
+			Position: i,
+			Text:     strings.TrimSpace(option),
+		})
+	}
+
+	return poll
+}
+
+// Returns the option IDs without duplicates once they are known to belong to the poll.
+func validatePollVote(poll *model.Poll, optionIDs []string) ([]string, error) {
+	pollOptions := map[string]bool{}
+	for _, option := range poll.Options {
+		pollOptions[option.ID] = true
+	}
+
+	seen := map[string]bool{}
+	deduped := make([]string, 0)
+	for _, optionID := range optionIDs {
+		if !pollOptions[optionID] {
+			return nil, fmt.Errorf("Option with ID %s not found", optionID)
+		}
+		if seen[optionID] {
+			continue
+		}
+		seen[optionID] = true
+		deduped = append(deduped, optionID)
+	}
+
+	if !poll.AllowMultipleVotes && len(deduped) > 1 {
+		return nil, fmt.Errorf("Poll only allows a single vote")
+	}
+
+	return deduped, nil
+}
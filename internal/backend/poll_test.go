@@ -0,0 +1,336 @@
+package backend
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/delphis-inc/delphisbe/graph/model"
+	"github.com/delphis-inc/delphisbe/internal/auth"
+	"github.com/delphis-inc/delphisbe/internal/backend/test_utils"
+	"github.com/delphis-inc/delphisbe/internal/cache"
+	"github.com/delphis-inc/delphisbe/internal/config"
+	"github.com/delphis-inc/delphisbe/internal/pubsub"
+	"github.com/delphis-inc/delphisbe/internal/util"
+	"github.com/delphis-inc/delphisbe/mocks"
+	. "github.com/smartystreets/goconvey/convey"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestDelphisBackend_VotePoll(t *testing.T) {
+	ctx := context.Background()
+
+	discussionID := test_utils.DiscussionID
+	participantID := test_utils.ParticipantID
+	eventObj := test_utils.TestDiscussionEvent()
+
+	Convey("VotePoll", t, func() {
+		now := time.Now()
+		cacheObj := cache.NewInMemoryCache()
+		authObj := auth.NewDelphisAuth(nil)
+		mockDB := &mocks.Datastore{}
+		backendObj := &delphisBackend{
+			db:              mockDB,
+			auth:            authObj,
+			cache:           cacheObj,
+			pubsub:          pubsub.NewInMemoryPubSub(),
+			discussionMutex: sync.Mutex{},
+			config:          config.Config{},
+			timeProvider:    &util.FrozenTime{NowTime: now},
+		}
+
+		tx := sql.Tx{}
+		postObj := test_utils.TestPost()
+		postObj.PostType = model.PostTypePoll
+		participantObj := test_utils.TestParticipant()
+		anonParticipantObj := test_utils.TestParticipant()
+		anonParticipantObj.ID = "anonParticipantID"
+		anonParticipantObj.IsAnonymous = true
+		anonParticipantID := anonParticipantObj.ID
+		pollObj := model.Poll{
+			PostID: postObj.ID,
+			Options: []*model.PollOption{
+				{ID: "option1", PostID: postObj.ID, Position: 0, Text: "yes"},
+				{ID: "option2", PostID: postObj.ID, Position: 1, Text: "no"},
+			},
+		}
+
+		Convey("when the post is not found", func() {
+			mockDB.On("GetPostByID", ctx, postObj.ID).Return(nil, fmt.Errorf("sth"))
+
+			resp, err := backendObj.VotePoll(ctx, discussionID, participantID, postObj.ID, []string{"option1"})
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		Convey("when the post is not a poll", func() {
+			postObj.PostType = model.PostTypeStandard
+			mockDB.On("GetPostByID", ctx, postObj.ID).Return(&postObj, nil)
+
+			resp, err := backendObj.VotePoll(ctx, discussionID, participantID, postObj.ID, []string{"option1"})
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		mockDB.On("GetPostByID", ctx, postObj.ID).Return(&postObj, nil)
+
+		Convey("when GetPollByPostID errors out", func() {
+			mockDB.On("GetPollByPostID", ctx, postObj.ID, []string{participantID}).Return(nil, fmt.Errorf("sth"))
+
+			resp, err := backendObj.VotePoll(ctx, discussionID, participantID, postObj.ID, []string{"option1"})
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		Convey("when the poll is closed", func() {
+			pollObj.ClosesAt = &now
+			mockDB.On("GetPollByPostID", ctx, postObj.ID, []string{participantID}).Return(&pollObj, nil)
+
+			resp, err := backendObj.VotePoll(ctx, discussionID, participantID, postObj.ID, []string{"option1"})
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		Convey("when the option does not belong to the poll", func() {
+			mockDB.On("GetPollByPostID", ctx, postObj.ID, []string{participantID}).Return(&pollObj, nil)
+
+			resp, err := backendObj.VotePoll(ctx, discussionID, participantID, postObj.ID, []string{"option3"})
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		Convey("when several options are voted for on a single choice poll", func() {
+			mockDB.On("GetPollByPostID", ctx, postObj.ID, []string{participantID}).Return(&pollObj, nil)
+
+			resp, err := backendObj.VotePoll(ctx, discussionID, participantID, postObj.ID, []string{"option1", "option2"})
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		Convey("when GetParticipantByID errors out", func() {
+			mockDB.On("GetPollByPostID", ctx, postObj.ID, []string{participantID}).Return(&pollObj, nil)
+			mockDB.On("GetParticipantByID", ctx, participantID).Return(nil, fmt.Errorf("sth"))
+
+			resp, err := backendObj.VotePoll(ctx, discussionID, participantID, postObj.ID, []string{"option1"})
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+			mockDB.AssertNotCalled(t, "BeginTx", ctx)
+		})
+
+		Convey("when GetParticipantsByDiscussionIDUserID errors out", func() {
+			mockDB.On("GetPollByPostID", ctx, postObj.ID, []string{participantID}).Return(&pollObj, nil)
+			mockDB.On("GetParticipantByID", ctx, participantID).Return(&participantObj, nil)
+			mockDB.On("GetParticipantsByDiscussionIDUserID", ctx, discussionID, *participantObj.UserID).Return(nil, fmt.Errorf("sth"))
+
+			resp, err := backendObj.VotePoll(ctx, discussionID, participantID, postObj.ID, []string{"option1"})
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+			mockDB.AssertNotCalled(t, "BeginTx", ctx)
+		})
+
+		mockDB.On("GetParticipantByID", ctx, participantID).Return(&participantObj, nil)
+		mockDB.On("GetParticipantByID", ctx, anonParticipantID).Return(&anonParticipantObj, nil)
+		mockDB.On("GetParticipantsByDiscussionIDUserID", ctx, discussionID, *participantObj.UserID).Return([]model.Participant{participantObj, anonParticipantObj}, nil)
+
+		Convey("when ReplacePollVotes errors out", func() {
+			mockDB.On("GetPollByPostID", ctx, postObj.ID, []string{participantID}).Return(&pollObj, nil)
+			mockDB.On("BeginTx", ctx).Return(&tx, nil)
+			mockDB.On("ReplacePollVotes", ctx, &tx, postObj.ID, participantID, []string{participantID, anonParticipantID}, []string{"option1"}).Return(fmt.Errorf("sth"))
+			mockDB.On("RollbackTx", ctx, &tx).Return(nil)
+
+			resp, err := backendObj.VotePoll(ctx, discussionID, participantID, postObj.ID, []string{"option1"})
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		Convey("when multiple votes are allowed and options are repeated", func() {
+			pollObj.AllowMultipleVotes = true
+			mockDB.On("GetPollByPostID", ctx, postObj.ID, []string{participantID}).Return(&pollObj, nil)
+			mockDB.On("BeginTx", ctx).Return(&tx, nil)
+			mockDB.On("ReplacePollVotes", ctx, &tx, postObj.ID, participantID, []string{participantID, anonParticipantID}, []string{"option1", "option2"}).Return(nil)
+			mockDB.On("CommitTx", ctx, &tx).Return(nil)
+			mockDB.On("PutDiscussionEvent", ctx, mock.Anything).Return(&eventObj, nil)
+
+			resp, err := backendObj.VotePoll(ctx, discussionID, participantID, postObj.ID, []string{"option1", "option2", "option1"})
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldResemble, &postObj)
+			mockDB.AssertCalled(t, "PutDiscussionEvent", ctx, mock.MatchedBy(func(event model.DiscussionEvent) bool {
+				return event.EventType == model.DiscussionSubscriptionEventTypePostPollVoted
+			}))
+		})
+
+		Convey("when the vote is retracted", func() {
+			mockDB.On("GetPollByPostID", ctx, postObj.ID, []string{participantID}).Return(&pollObj, nil)
+			mockDB.On("BeginTx", ctx).Return(&tx, nil)
+			mockDB.On("ReplacePollVotes", ctx, &tx, postObj.ID, participantID, []string{participantID, anonParticipantID}, []string{}).Return(nil)
+			mockDB.On("CommitTx", ctx, &tx).Return(nil)
+			mockDB.On("PutDiscussionEvent", ctx, mock.Anything).Return(&eventObj, nil)
+
+			resp, err := backendObj.VotePoll(ctx, discussionID, participantID, postObj.ID, []string{})
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldResemble, &postObj)
+		})
+
+		Convey("when the user votes with both of their participants", func() {
+			mockDB.On("GetPollByPostID", ctx, postObj.ID, []string{participantID}).Return(&pollObj, nil)
+			mockDB.On("GetPollByPostID", ctx, postObj.ID, []string{anonParticipantID}).Return(&pollObj, nil)
+			mockDB.On("BeginTx", ctx).Return(&tx, nil)
+			mockDB.On("ReplacePollVotes", ctx, &tx, postObj.ID, participantID, []string{participantID, anonParticipantID}, []string{"option1"}).Return(nil)
+			mockDB.On("ReplacePollVotes", ctx, &tx, postObj.ID, anonParticipantID, []string{anonParticipantID, participantID}, []string{"option2"}).Return(nil)
+			mockDB.On("CommitTx", ctx, &tx).Return(nil)
+			mockDB.On("PutDiscussionEvent", ctx, mock.Anything).Return(&eventObj, nil)
+
+			_, err := backendObj.VotePoll(ctx, discussionID, participantID, postObj.ID, []string{"option1"})
+			So(err, ShouldBeNil)
+
+			resp, err := backendObj.VotePoll(ctx, discussionID, anonParticipantID, postObj.ID, []string{"option2"})
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldResemble, &postObj)
+			// The anonymous vote clears the non-anonymous one but is still attributed to the anonymous participant
+			mockDB.AssertCalled(t, "ReplacePollVotes", ctx, &tx, postObj.ID, anonParticipantID, []string{anonParticipantID, participantID}, []string{"option2"})
+		})
+	})
+}
+
+func TestDelphisBackend_IsPollClosed(t *testing.T) {
+	Convey("IsPollClosed", t, func() {
+		now := time.Now()
+		backendObj := &delphisBackend{
+			timeProvider: &util.FrozenTime{NowTime: now},
+		}
+		pollObj := model.Poll{PostID: test_utils.PostID}
+
+		Convey("when the poll never closes", func() {
+			So(backendObj.IsPollClosed(&pollObj), ShouldBeFalse)
+		})
+
+		Convey("when the poll closes after the current time", func() {
+			closesAt := now.Add(time.Second)
+			pollObj.ClosesAt = &closesAt
+
+			So(backendObj.IsPollClosed(&pollObj), ShouldBeFalse)
+		})
+
+		Convey("when the poll closes at the current time", func() {
+			pollObj.ClosesAt = &now
+
+			So(backendObj.IsPollClosed(&pollObj), ShouldBeTrue)
+		})
+	})
+}
+
+func TestDelphisBackend_GetPollOptionVoters(t *testing.T) {
+	ctx := context.Background()
+
+	Convey("GetPollOptionVoters", t, func() {
+		now := time.Now()
+		cacheObj := cache.NewInMemoryCache()
+		authObj := auth.NewDelphisAuth(nil)
+		mockDB := &mocks.Datastore{}
+		backendObj := &delphisBackend{
+			db:              mockDB,
+			auth:            authObj,
+			cache:           cacheObj,
+			pubsub:          pubsub.NewInMemoryPubSub(),
+			discussionMutex: sync.Mutex{},
+			config:          config.Config{},
+			timeProvider:    &util.FrozenTime{NowTime: now},
+		}
+
+		participantObj := test_utils.TestParticipant()
+		optionObj := model.PollOption{ID: "option1", PostID: test_utils.PostID, Text: "yes"}
+
+		Convey("when the poll is anonymous", func() {
+			optionObj.PollIsAnonymous = true
+
+			resp, err := backendObj.GetPollOptionVoters(ctx, optionObj)
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldResemble, []*model.Participant{})
+			mockDB.AssertNotCalled(t, "GetPollVoterIDsByOptionID", ctx, mock.Anything)
+		})
+
+		Convey("when GetPollVoterIDsByOptionID errors out", func() {
+			mockDB.On("GetPollVoterIDsByOptionID", ctx, optionObj.ID).Return(nil, fmt.Errorf("sth"))
+
+			resp, err := backendObj.GetPollOptionVoters(ctx, optionObj)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		Convey("when the voters are returned", func() {
+			mockDB.On("GetPollVoterIDsByOptionID", ctx, optionObj.ID).Return([]string{participantObj.ID}, nil)
+			mockDB.On("GetParticipantsByIDs", ctx, []string{participantObj.ID}).Return(map[string]*model.Participant{participantObj.ID: &participantObj}, nil)
+
+			resp, err := backendObj.GetPollOptionVoters(ctx, optionObj)
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldResemble, []*model.Participant{&participantObj})
+		})
+	})
+}
+
+func TestDelphisBackend_validatePollInput(t *testing.T) {
+	now := time.Now()
+	past := now.Add(-time.Hour)
+
+	Convey("validatePollInput", t, func() {
+		input := model.PostContentInput{
+			PostText: "Lunch?",
+			PostType: model.PostTypePoll,
+			Poll: &model.PollInput{
+				Options: []string{"yes", "no"},
+			},
+		}
+
+		Convey("when a standard post has a poll", func() {
+			input.PostType = model.PostTypeStandard
+
+			So(validatePollInput(input, now), ShouldNotBeNil)
+		})
+
+		Convey("when a poll post has no poll", func() {
+			input.Poll = nil
+
+			So(validatePollInput(input, now), ShouldNotBeNil)
+		})
+
+		Convey("when there are too few options", func() {
+			input.Poll.Options = []string{"yes"}
+
+			So(validatePollInput(input, now), ShouldNotBeNil)
+		})
+
+		Convey("when an option is blank", func() {
+			input.Poll.Options = []string{"yes", "  "}
+
+			So(validatePollInput(input, now), ShouldNotBeNil)
+		})
+
+		Convey("when the poll closes in the past", func() {
+			input.Poll.ClosesAt = &past
+
+			So(validatePollInput(input, now), ShouldNotBeNil)
+		})
+
+		Convey("when the poll is valid", func() {
+			So(validatePollInput(input, now), ShouldBeNil)
+		})
+	})
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"regexp"
 	"strconv"
 	"strings"
 	"time"
@@ -12,6 +11,7 @@ import (
 	"go.uber.org/multierr"
 
 	"github.com/delphis-inc/delphisbe/graph/model"
+	"github.com/delphis-inc/delphisbe/internal/unfurl"
 	"github.com/delphis-inc/delphisbe/internal/util"
 	"github.com/lib/pq"
 	"github.com/sirupsen/logrus"
@@ -29,10 +29,42 @@ func (d *delphisBackend) CreatePost(ctx context.Context, discussionID string, us
 		return nil, err
 	}
 
+	if err := validatePollInput(input, d.timeProvider.Now()); err != nil {
+		logrus.WithError(err).Error("failed to validate poll")
+		return nil, err
+	}
+
+	parentPostID, err := d.resolveParentPostID(ctx, discussionID, input.ParentPostID)
+	if err != nil {
+		logrus.WithError(err).Error("failed to resolve parent post")
+		return nil, err
+	}
+
+	postText, mentionedEntities, err := normalizeMentions(input.PostText, input.MentionedEntities)
+	if err != nil {
+		logrus.WithError(err).Error("failed to normalize mentions")
+		return nil, err
+	}
+
+	if err := d.resolveMentionedEntities(ctx, discussionID, userID, mentionedEntities); err != nil {
+		logrus.WithError(err).Error("failed to resolve mentioned entities")
+		return nil, err
+	}
+
+	discussion, err := d.GetDiscussionByID(ctx, discussionID)
+	if err != nil || discussion == nil {
+		return nil, fmt.Errorf("Discussion with ID %s not found", discussionID)
+	}
+	enforceSlowMode, err := d.slowModeAppliesToUser(ctx, discussion, userID)
+	if err != nil {
+		logrus.WithError(err).Error("failed to check slow mode")
+		return nil, err
+	}
+
 	postContent := model.PostContent{
 		ID:                util.UUIDv4(),
-		Content:           input.PostText,
-		MentionedEntities: input.MentionedEntities,
+		Content:           postText,
+		MentionedEntities: mentionedEntities,
 	}
 
 	post := model.Post{
@@ -46,6 +78,7 @@ func (d *delphisBackend) CreatePost(ctx context.Context, discussionID string, us
 		PostContent:   &postContent,
 		QuotedPostID:  input.QuotedPostID,
 		MediaID:       input.MediaID,
+		ParentPostID:  parentPostID,
 	}
 
 	retryAttempts := 0
@@ -56,6 +89,17 @@ func (d *delphisBackend) CreatePost(ctx context.Context, discussionID string, us
 			logrus.WithError(err).Error("failed to begin tx")
 			return nil, err
 		}
+
+		if enforceSlowMode {
+			if err := d.checkSlowModeInTx(ctx, tx, discussion, userID); err != nil {
+				if txErr := d.db.RollbackTx(ctx, tx); txErr != nil {
+					logrus.WithError(txErr).Error("failed to rollback tx")
+					return nil, multierr.Append(err, txErr)
+				}
+				return nil, err
+			}
+		}
+
 		// Put post contents
 		if err := d.db.PutPostContent(ctx, tx, postContent); err != nil {
 			logrus.WithError(err).Error("failed to PutPostContent")
@@ -75,6 +119,11 @@ func (d *delphisBackend) CreatePost(ctx context.Context, discussionID string, us
 			if isPqError && retryAttempts < PutPostMaxRetry && pqError.Code == "23505" {
 				retryAttempts++
 				logrus.WithError(err).Error("failed to PutPost, retrying with attempt #" + strconv.Itoa(retryAttempts))
+				// The retry starts a new transaction, so end this one and release its locks
+				if txErr := d.db.RollbackTx(ctx, tx); txErr != nil {
+					logrus.WithError(txErr).Error("failed to rollback tx")
+					return nil, multierr.Append(err, txErr)
+				}
 				// Note for the future: should we backoff a little?
 				continue
 			} else {
@@ -88,6 +137,20 @@ func (d *delphisBackend) CreatePost(ctx context.Context, discussionID string, us
 			}
 		}
 
+		// Put poll
+		if input.Poll != nil {
+			if err := d.db.PutPoll(ctx, tx, newPoll(post.ID, *input.Poll)); err != nil {
+				logrus.WithError(err).Error("failed to PutPoll")
+
+				// Rollback on errors
+				if txErr := d.db.RollbackTx(ctx, tx); txErr != nil {
+					logrus.WithError(txErr).Error("failed to rollback tx")
+					return nil, multierr.Append(err, txErr)
+				}
+				return nil, err
+			}
+		}
+
 		// Put Activity
 		if err := d.db.PutActivity(ctx, tx, postObj); err != nil {
 			logrus.WithError(err).Error("failed to PutActivity")
@@ -107,12 +170,12 @@ func (d *delphisBackend) CreatePost(ctx context.Context, discussionID string, us
 			LastPostCreatedAt: &post.CreatedAt,
 		}
 
-		discussion, err := d.UpdateDiscussion(ctx, discussionID, discInput)
+		discussion, err := d.UpdateDiscussion(ctx, discussionID, model.ConciergeUser, discInput)
 		// If we reach this point then the transaction is succesfully committed and we should not retry
 		if err != nil {
 			logrus.WithError(err).Debugf("Skipping notification to subscribers because of an error")
 		} else {
-			_, err := d.SendNotificationsToSubscribers(ctx, userID, discussion, &post, input.Preview)
+			_, err := d.SendNotificationsToSubscribers(ctx, userID, discussion, &post, input.ParentPostID, input.Preview)
 			if err != nil {
 				logrus.WithError(err).Warn("Failed to send push notifications on createPost")
 			}
@@ -123,11 +186,53 @@ func (d *delphisBackend) CreatePost(ctx context.Context, discussionID string, us
 			logrus.Warnf("Failed to notify subscribers of created post")
 		}
 
+		if urls := unfurl.ExtractURLs(input.PostText); len(urls) > 0 {
+			d.unfurlPostLinksInBackground(discussionID, postObj, urls)
+		}
+
 		return postObj, nil
 	}
 	return nil, errors.New("Unknown error, this code should not be reachable")
 }
 
+// GetPostingParticipant returns the participant if it belongs to the user and
+// may currently post in the discussion. Scheduled posts are checked again
+// with this when they are published.
+func (d *delphisBackend) GetPostingParticipant(ctx context.Context, discussionID string, participantID string, userID string) (*model.Participant, error) {
+	// Note: This is here mainly to ensure the discussion is not (soft) deleted
+	discussion, err := d.GetDiscussionByID(ctx, discussionID)
+	if discussion == nil || err != nil || discussion.LockStatus == true {
+		return nil, fmt.Errorf("Discussion with ID %s not found", discussionID)
+	}
+
+	participant, err := d.GetParticipantByID(ctx, participantID)
+	if err != nil {
+		return nil, err
+	} else if participant == nil {
+		return nil, fmt.Errorf("Could not find Participant with ID %s", participantID)
+	} else if participant.IsBanned {
+		return nil, fmt.Errorf("Banned")
+	} else if participant.MutedUntil != nil && participant.MutedUntil.After(time.Now()) {
+		return nil, fmt.Errorf("This participant is muted")
+	}
+
+	// Verify that the posting participant belongs to the user
+	if participant.UserID == nil || *participant.UserID != userID || participant.DiscussionID == nil || *participant.DiscussionID != discussionID {
+		return nil, fmt.Errorf("Unauthorized")
+	}
+
+	secondsUntilCanPost, err := d.GetSecondsUntilCanPost(ctx, discussion, userID)
+	if err != nil {
+		logrus.WithError(err).Error("failed to check slow mode")
+		return nil, err
+	}
+	if secondsUntilCanPost > 0 {
+		return nil, &SlowModeError{SecondsRemaining: secondsUntilCanPost}
+	}
+
+	return participant, nil
+}
+
 func (d *delphisBackend) CreateWelcomeAlertPost(ctx context.Context, discussionID string, participantID string, userObj *model.User, isAnonymous bool) (*model.Post, error) {
 	// Do not create an alert post when the concierge joins
 	if userObj.ID == model.ConciergeUser {
@@ -193,32 +298,25 @@ func (d *delphisBackend) CreateShuffleAlertPost(ctx context.Context, discussionI
 	return d.CreatePost(ctx, discussionID, model.ConciergeUser, resp.NonAnon.ID, input)
 }
 
-func (d *delphisBackend) notifySubscribersOfEvent(ctx context.Context, event *model.DiscussionSubscriptionEvent, discussionID string) error {
-	cacheKey := fmt.Sprintf(discussionEventSubscriberKey, discussionID)
-	d.discussionMutex.Lock()
-	defer d.discussionMutex.Unlock()
-	currentSubsIface, found := d.cache.Get(cacheKey)
-	if !found {
-		currentSubsIface = map[string]chan *model.DiscussionSubscriptionEvent{}
-	}
-	var currentSubs map[string]chan *model.DiscussionSubscriptionEvent
-	var ok bool
-	if currentSubs, ok = currentSubsIface.(map[string]chan *model.DiscussionSubscriptionEvent); !ok {
-		currentSubs = map[string]chan *model.DiscussionSubscriptionEvent{}
-	}
-	for userID, channel := range currentSubs {
-		if channel != nil {
-			select {
-			case channel <- event:
-				logrus.Debugf("Sent message to channel for user ID: %s", userID)
-			default:
-				logrus.Debugf("No message was sent. Unsubscribing the user")
-				delete(currentSubs, userID)
-			}
-		}
+func (d *delphisBackend) CreateOwnershipTransferAlertPost(ctx context.Context, discussionID string, toUser *model.User) (*model.Post, error) {
+	postStr := fmt.Sprintf("%v is now the owner of this discussion", toUser.UserProfile.DisplayName)
+
+	// Get concierge participant
+	resp, err := d.GetParticipantsByDiscussionIDUserID(ctx, discussionID, model.ConciergeUser)
+	if err != nil {
+		logrus.WithError(err).Error("failed to fetch concierge participant")
+		return nil, err
 	}
-	d.cache.Set(cacheKey, currentSubs, time.Hour)
-	return nil
+	if resp.NonAnon == nil {
+		return nil, fmt.Errorf("discussion is missing a concierge participant")
+	}
+
+	input := model.PostContentInput{
+		PostText: postStr,
+		PostType: model.PostTypeAlert,
+	}
+
+	return d.CreatePost(ctx, discussionID, model.ConciergeUser, resp.NonAnon.ID, input)
 }
 
 func (d *delphisBackend) NotifySubscribersOfCreatedPost(ctx context.Context, post *model.Post, discussionID string) error {
@@ -261,12 +359,12 @@ func (d *delphisBackend) GetLastPostByDiscussionID(ctx context.Context, discussi
 	return d.db.GetLastPostByDiscussionID(ctx, discussionID)
 }
 
-func (d *delphisBackend) GetPostsConnectionByDiscussionID(ctx context.Context, discussionID string, cursor string, limit int) (*model.PostsConnection, error) {
+func (d *delphisBackend) GetPostsConnectionByDiscussionID(ctx context.Context, discussionID string, cursor string, limit int, topLevelOnly bool) (*model.PostsConnection, error) {
 	if limit < 2 || limit > PostPerPageLimit {
 		return nil, errors.New("Values of 'limit' is illegal")
 	}
 
-	connection, err := d.db.GetPostsConnectionByDiscussionID(ctx, discussionID, cursor, limit)
+	connection, err := d.db.GetPostsConnectionByDiscussionID(ctx, discussionID, cursor, limit, topLevelOnly)
 	if err != nil {
 		return nil, err
 	}
@@ -274,6 +372,44 @@ func (d *delphisBackend) GetPostsConnectionByDiscussionID(ctx context.Context, d
 	return connection, err
 }
 
+func (d *delphisBackend) GetPostsConnectionByDiscussionIDBeforeCursor(ctx context.Context, discussionID string, cursor string, limit int, topLevelOnly bool) (*model.PostsConnection, error) {
+	if limit < 2 || limit > PostPerPageLimit {
+		return nil, errors.New("Values of 'limit' is illegal")
+	}
+
+	return d.db.GetPostsConnectionByDiscussionIDBeforeCursor(ctx, discussionID, cursor, limit, topLevelOnly)
+}
+
+// Used to open a discussion at a given post, e.g. the viewer's last viewed post,
+// so it can be scrolled in both directions.
+func (d *delphisBackend) GetPostsConnectionAroundPost(ctx context.Context, discussionID string, postID string, newerLimit int, olderLimit int, topLevelOnly bool) (*model.PostsConnection, error) {
+	if newerLimit < 1 || olderLimit < 1 || newerLimit+olderLimit > PostPerPageLimit {
+		return nil, errors.New("Values of 'limit' is illegal")
+	}
+
+	anchorPost, err := d.GetPostByDiscussionPostID(ctx, discussionID, postID)
+	if err != nil {
+		return nil, err
+	}
+	if anchorPost == nil {
+		return nil, fmt.Errorf("Post with ID %s not found", postID)
+	}
+
+	return d.db.GetPostsConnectionAroundPost(ctx, *anchorPost, newerLimit, olderLimit, topLevelOnly)
+}
+
+func (d *delphisBackend) GetPostRepliesConnection(ctx context.Context, postID string, cursor string, limit int) (*model.PostsConnection, error) {
+	if limit < 2 || limit > PostPerPageLimit {
+		return nil, errors.New("Values of 'limit' is illegal")
+	}
+
+	return d.db.GetPostRepliesConnection(ctx, postID, cursor, limit)
+}
+
+func (d *delphisBackend) GetPostReplyCount(ctx context.Context, postID string) (int, error) {
+	return d.db.GetPostReplyCount(ctx, postID)
+}
+
 func (d *delphisBackend) GetMentionedEntities(ctx context.Context, entityIDs []string) (map[string]model.Entity, error) {
 	entities := map[string]model.Entity{}
 	var participantIDs []string
@@ -290,6 +426,16 @@ func (d *delphisBackend) GetMentionedEntities(ctx context.Context, entityIDs []s
 			participantIDs = append(participantIDs, entity.ID)
 		} else if entity.Type == model.DiscussionPrefix {
 			discussionIDs = append(discussionIDs, entity.ID)
+		} else if entity.Type == model.TagPrefix {
+			// Tags are not stored anywhere else so there is nothing to look up
+			entities[entityID] = &model.Tag{ID: entity.ID, Name: entity.ID}
+		} else if entity.Type == model.GroupPrefix {
+			group, err := parseMentionGroup(entity.ID)
+			if err != nil {
+				logrus.WithError(err).Debugf("MentionedEntity using an unsupported group: %v\n", entityID)
+				continue
+			}
+			entities[entityID] = &model.GroupMention{ID: entity.ID, Group: group}
 		} else {
 			// TODO: Log to cloudwatch
 			logrus.Debugf("MentionedEntity using an unsupported type: %v\n", entityID)
@@ -298,7 +444,10 @@ func (d *delphisBackend) GetMentionedEntities(ctx context.Context, entityIDs []s
 	}
 
 	if len(participantIDs) == 0 && len(discussionIDs) == 0 {
-		return nil, nil
+		if len(entities) == 0 {
+			return nil, nil
+		}
+		return entities, nil
 	}
 
 	participants, err := d.GetParticipantsByIDs(ctx, participantIDs)
@@ -354,16 +503,6 @@ func (d *delphisBackend) DeletePostByID(ctx context.Context, discussionID string
 		return nil, fmt.Errorf("Discussion not found")
 	}
 
-	moderatorObj, err := d.GetModeratorByID(ctx, *disc.ModeratorID)
-	if err != nil || moderatorObj == nil || moderatorObj.UserProfileID == nil {
-		return nil, fmt.Errorf("Failed to retrieve discussion")
-	}
-
-	userProfile, err := d.GetUserProfileByID(ctx, *moderatorObj.UserProfileID)
-	if err != nil || userProfile == nil || userProfile.UserID == nil {
-		return nil, fmt.Errorf("Failed to retrieve discussion")
-	}
-
 	post, err := d.GetPostByID(ctx, postID)
 	if err != nil || post == nil || post.ParticipantID == nil {
 		return nil, fmt.Errorf("Post not found")
@@ -374,12 +513,17 @@ func (d *delphisBackend) DeletePostByID(ctx context.Context, discussionID string
 		return nil, fmt.Errorf("Participant not found")
 	}
 
-	isModerator := *userProfile.UserID == requestingUserID
 	isParticipant := participant.UserID != nil && *participant.UserID == requestingUserID
 
-	// Only the moderator or author can delete a post
-	if !isModerator && !isParticipant {
-		return nil, fmt.Errorf("Only moderator or author can delete a post")
+	// Only moderators or the author can delete a post
+	if !isParticipant {
+		isModerator, err := d.CheckIfModeratorForDiscussion(ctx, requestingUserID, discussionID)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to retrieve discussion")
+		}
+		if !isModerator {
+			return nil, fmt.Errorf("Only moderator or author can delete a post")
+		}
 	}
 
 	if post.DeletedAt != nil {
@@ -392,7 +536,154 @@ func (d *delphisBackend) DeletePostByID(ctx context.Context, discussionID string
 		deletedReasonCode = model.PostDeletedReasonParticipantRemoved
 	}
 
-	return d.db.DeletePostByID(ctx, postID, deletedReasonCode)
+	deletedPost, err := d.db.DeletePostByID(ctx, postID, deletedReasonCode)
+	if err != nil {
+		return nil, err
+	}
+
+	if !isParticipant {
+		d.recordModerationAction(ctx, discussionID, requestingUserID, model.ModerationActionDeletePost, model.ModerationTargetTypePost, postID, nil)
+	}
+
+	return deletedPost, nil
+}
+
+// EditPost replaces the content of a post and keeps the content it replaces
+// in the post's edit history.
+func (d *delphisBackend) EditPost(ctx context.Context, discussionID string, postID string, requestingUserID string, input model.PostContentInput) (*model.Post, error) {
+	if err := validatePostParams(ctx, input); err != nil {
+		logrus.WithError(err).Error("failed to validate post params")
+		return nil, err
+	}
+
+	disc, err := d.GetDiscussionByID(ctx, discussionID)
+	if err != nil || disc == nil {
+		return nil, fmt.Errorf("Discussion not found")
+	}
+	if disc.LockStatus {
+		return nil, fmt.Errorf("Discussion is locked")
+	}
+
+	post, err := d.GetPostByDiscussionPostID(ctx, discussionID, postID)
+	if err != nil || post == nil || post.ParticipantID == nil || post.PostContentID == nil || post.PostContent == nil {
+		return nil, fmt.Errorf("Post not found")
+	}
+	if post.DeletedAt != nil {
+		return nil, fmt.Errorf("Cannot edit a deleted post")
+	}
+
+	participant, err := d.GetParticipantByID(ctx, *post.ParticipantID)
+	if err != nil || participant == nil {
+		return nil, fmt.Errorf("Participant not found")
+	}
+
+	// Only the author can edit a post
+	if participant.UserID == nil || *participant.UserID != requestingUserID {
+		return nil, fmt.Errorf("Only the author can edit a post")
+	}
+
+	// The replaced content is recorded with the time it was written. Every edit
+	// stores a new content row, so unlike the post's updated_at, which pins and
+	// restores also bump, the row's created_at only changes with the content.
+	previousContent, err := d.db.GetPostContentByID(ctx, *post.PostContentID)
+	if err != nil || previousContent == nil {
+		logrus.WithError(err).Error("failed to get post content by ID")
+		return nil, fmt.Errorf("Post content not found")
+	}
+	if err := post.AddContentToEditHistory(post.PostContent.Content, previousContent.CreatedAt); err != nil {
+		logrus.WithError(err).Error("failed to add content to edit history")
+		return nil, err
+	}
+
+	postText, mentionedEntities, err := normalizeMentions(input.PostText, input.MentionedEntities)
+	if err != nil {
+		logrus.WithError(err).Error("failed to normalize mentions")
+		return nil, err
+	}
+
+	if err := d.resolveMentionedEntities(ctx, discussionID, requestingUserID, mentionedEntities); err != nil {
+		logrus.WithError(err).Error("failed to resolve mentioned entities")
+		return nil, err
+	}
+
+	previousURLs := unfurl.ExtractURLs(post.PostContent.Content)
+	postContent := model.PostContent{
+		ID:                util.UUIDv4(),
+		Content:           postText,
+		MentionedEntities: mentionedEntities,
+	}
+	post.PostContentID = &postContent.ID
+	post.PostContent = &postContent
+
+	// Begin tx
+	tx, err := d.db.BeginTx(ctx)
+	if err != nil {
+		logrus.WithError(err).Error("failed to begin tx")
+		return nil, err
+	}
+
+	if err := d.db.PutPostContent(ctx, tx, postContent); err != nil {
+		logrus.WithError(err).Error("failed to PutPostContent")
+
+		// Rollback on errors
+		if txErr := d.db.RollbackTx(ctx, tx); txErr != nil {
+			logrus.WithError(txErr).Error("failed to rollback tx")
+			return nil, multierr.Append(err, txErr)
+		}
+		return nil, err
+	}
+
+	postObj, err := d.db.EditPost(ctx, tx, *post)
+	if err != nil {
+		logrus.WithError(err).Error("failed to EditPost")
+
+		// Rollback on errors
+		if txErr := d.db.RollbackTx(ctx, tx); txErr != nil {
+			logrus.WithError(txErr).Error("failed to rollback tx")
+			return nil, multierr.Append(err, txErr)
+		}
+		return nil, err
+	}
+
+	// Record any new mentions
+	if err := d.db.PutActivity(ctx, tx, postObj); err != nil {
+		logrus.WithError(err).Error("failed to PutActivity")
+	}
+
+	if err := d.db.CommitTx(ctx, tx); err != nil {
+		logrus.WithError(err).Error("failed to commit edit post tx")
+		return nil, err
+	}
+
+	d.emitDiscussionEvent(ctx, discussionID, model.DiscussionSubscriptionEventTypePostEdited, postObj)
+
+	// Previews are replaced even when every link was removed by the edit
+	if urls := unfurl.ExtractURLs(input.PostText); len(urls) > 0 || len(previousURLs) > 0 {
+		d.unfurlPostLinksInBackground(discussionID, postObj, urls)
+	}
+
+	return postObj, nil
+}
+
+// Threads are a single level deep, so replying to a reply attaches the new
+// post to the top-level post of that thread.
+func (d *delphisBackend) resolveParentPostID(ctx context.Context, discussionID string, parentPostID *string) (*string, error) {
+	if parentPostID == nil {
+		return nil, nil
+	}
+
+	parent, err := d.GetPostByDiscussionPostID(ctx, discussionID, *parentPostID)
+	if err != nil {
+		return nil, err
+	}
+	if parent == nil || parent.DeletedAt != nil {
+		return nil, fmt.Errorf("Parent post not found")
+	}
+
+	if parent.ParentPostID != nil {
+		return parent.ParentPostID, nil
+	}
+	return &parent.ID, nil
 }
 
 func validatePostParams(ctx context.Context, input model.PostContentInput) error {
@@ -402,11 +693,8 @@ func validatePostParams(ctx context.Context, input model.PostContentInput) error
 	}
 
 	// Validate mentionedEntities
-	if input.MentionedEntities != nil {
-		tokens := regexp.MustCompile(`\<(\d+)\>`).FindAllStringSubmatch(input.PostText, -1)
-		if len(tokens) != len(input.MentionedEntities) {
-			return errors.New("tokens did not match entities")
-		}
+	if _, _, err := normalizeMentions(input.PostText, input.MentionedEntities); err != nil {
+		return err
 	}
 
 	return nil
@@ -0,0 +1,83 @@
+package backend
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/delphis-inc/delphisbe/graph/model"
+	"github.com/sirupsen/logrus"
+)
+
+// Bookmarks are keyed on the user rather than a participant, so they stay
+// private to the user and survive discussion shuffles.
+func (d *delphisBackend) BookmarkPost(ctx context.Context, userID string, discussionID string, postID string) (*model.Post, error) {
+	post, err := d.getBookmarkablePost(ctx, userID, discussionID, postID)
+	if err != nil {
+		return nil, err
+	}
+	if post.DeletedAt != nil {
+		return nil, fmt.Errorf("Post has been deleted")
+	}
+
+	if err := d.db.PutPostBookmark(ctx, model.PostBookmark{UserID: userID, PostID: post.ID}); err != nil {
+		logrus.WithError(err).Error("failed to bookmark post")
+		return nil, err
+	}
+
+	return post, nil
+}
+
+// Deleted posts can still be unbookmarked so tombstones can be cleared.
+func (d *delphisBackend) UnbookmarkPost(ctx context.Context, userID string, discussionID string, postID string) (*model.Post, error) {
+	post, err := d.getBookmarkablePost(ctx, userID, discussionID, postID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := d.db.DeletePostBookmark(ctx, model.PostBookmark{UserID: userID, PostID: post.ID}); err != nil {
+		logrus.WithError(err).Error("failed to unbookmark post")
+		return nil, err
+	}
+
+	return post, nil
+}
+
+func (d *delphisBackend) GetPostBookmarksConnectionByUserID(ctx context.Context, userID string, cursor string, limit int) (*model.PostBookmarksConnection, error) {
+	if limit < 2 || limit > PostPerPageLimit {
+		return nil, errors.New("Values of 'limit' is illegal")
+	}
+
+	connection, err := d.db.GetPostBookmarksConnectionByUserID(ctx, userID, cursor, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	// Deleted posts are returned as tombstones without their content
+	for _, edge := range connection.Edges {
+		post := edge.Node.Post
+		if post != nil && post.DeletedAt != nil && post.PostContent != nil {
+			post.PostContent = &model.PostContent{ID: post.PostContent.ID}
+		}
+	}
+
+	return connection, nil
+}
+
+func (d *delphisBackend) getBookmarkablePost(ctx context.Context, userID string, discussionID string, postID string) (*model.Post, error) {
+	dua, err := d.db.GetDiscussionUserAccess(ctx, discussionID, userID)
+	if err != nil {
+		logrus.WithError(err).Error("failed to get discussion user access")
+		return nil, err
+	}
+	if dua == nil || dua.State != model.DiscussionUserAccessStateActive {
+		return nil, fmt.Errorf("Unauthorized")
+	}
+
+	post, err := d.GetPostByDiscussionPostID(ctx, discussionID, postID)
+	if err != nil || post == nil {
+		return nil, fmt.Errorf("Post not found")
+	}
+
+	return post, nil
+}
@@ -0,0 +1,240 @@
+package backend
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/delphis-inc/delphisbe/graph/model"
+	"github.com/delphis-inc/delphisbe/internal/auth"
+	"github.com/delphis-inc/delphisbe/internal/backend/test_utils"
+	"github.com/delphis-inc/delphisbe/internal/cache"
+	"github.com/delphis-inc/delphisbe/internal/config"
+	"github.com/delphis-inc/delphisbe/internal/pubsub"
+	"github.com/delphis-inc/delphisbe/internal/util"
+	"github.com/delphis-inc/delphisbe/mocks"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestDelphisBackend_BookmarkPost(t *testing.T) {
+	ctx := context.Background()
+
+	userID := test_utils.UserID
+	discussionID := test_utils.DiscussionID
+
+	Convey("BookmarkPost", t, func() {
+		now := time.Now()
+		cacheObj := cache.NewInMemoryCache()
+		authObj := auth.NewDelphisAuth(nil)
+		mockDB := &mocks.Datastore{}
+		backendObj := &delphisBackend{
+			db:              mockDB,
+			auth:            authObj,
+			cache:           cacheObj,
+			pubsub:          pubsub.NewInMemoryPubSub(),
+			discussionMutex: sync.Mutex{},
+			config:          config.Config{},
+			timeProvider:    &util.FrozenTime{NowTime: now},
+		}
+
+		postObj := test_utils.TestPost()
+		duaObj := test_utils.TestDiscussionUserAccess()
+		bookmarkObj := model.PostBookmark{UserID: userID, PostID: postObj.ID}
+
+		Convey("when GetDiscussionUserAccess errors out", func() {
+			mockDB.On("GetDiscussionUserAccess", ctx, discussionID, userID).Return(nil, fmt.Errorf("sth"))
+
+			resp, err := backendObj.BookmarkPost(ctx, userID, discussionID, postObj.ID)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		Convey("when the user does not have access to the discussion", func() {
+			duaObj.State = model.DiscussionUserAccessStateArchived
+			mockDB.On("GetDiscussionUserAccess", ctx, discussionID, userID).Return(&duaObj, nil)
+
+			resp, err := backendObj.BookmarkPost(ctx, userID, discussionID, postObj.ID)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		mockDB.On("GetDiscussionUserAccess", ctx, discussionID, userID).Return(&duaObj, nil)
+
+		Convey("when the post belongs to another discussion", func() {
+			otherDiscussionID := "other_discussion_id"
+			postObj.DiscussionID = &otherDiscussionID
+			mockDB.On("GetPostByID", ctx, postObj.ID).Return(&postObj, nil)
+
+			resp, err := backendObj.BookmarkPost(ctx, userID, discussionID, postObj.ID)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		Convey("when the post is deleted", func() {
+			postObj.DeletedAt = &now
+			mockDB.On("GetPostByID", ctx, postObj.ID).Return(&postObj, nil)
+
+			resp, err := backendObj.BookmarkPost(ctx, userID, discussionID, postObj.ID)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		mockDB.On("GetPostByID", ctx, postObj.ID).Return(&postObj, nil)
+
+		Convey("when PutPostBookmark errors out", func() {
+			mockDB.On("PutPostBookmark", ctx, bookmarkObj).Return(fmt.Errorf("sth"))
+
+			resp, err := backendObj.BookmarkPost(ctx, userID, discussionID, postObj.ID)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		Convey("when the post is bookmarked", func() {
+			mockDB.On("PutPostBookmark", ctx, bookmarkObj).Return(nil)
+
+			resp, err := backendObj.BookmarkPost(ctx, userID, discussionID, postObj.ID)
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldResemble, &postObj)
+		})
+	})
+}
+
+func TestDelphisBackend_UnbookmarkPost(t *testing.T) {
+	ctx := context.Background()
+
+	userID := test_utils.UserID
+	discussionID := test_utils.DiscussionID
+
+	Convey("UnbookmarkPost", t, func() {
+		now := time.Now()
+		cacheObj := cache.NewInMemoryCache()
+		authObj := auth.NewDelphisAuth(nil)
+		mockDB := &mocks.Datastore{}
+		backendObj := &delphisBackend{
+			db:              mockDB,
+			auth:            authObj,
+			cache:           cacheObj,
+			pubsub:          pubsub.NewInMemoryPubSub(),
+			discussionMutex: sync.Mutex{},
+			config:          config.Config{},
+			timeProvider:    &util.FrozenTime{NowTime: now},
+		}
+
+		postObj := test_utils.TestPost()
+		duaObj := test_utils.TestDiscussionUserAccess()
+		bookmarkObj := model.PostBookmark{UserID: userID, PostID: postObj.ID}
+
+		mockDB.On("GetDiscussionUserAccess", ctx, discussionID, userID).Return(&duaObj, nil)
+
+		Convey("when the post is not found", func() {
+			mockDB.On("GetPostByID", ctx, postObj.ID).Return(nil, nil)
+
+			resp, err := backendObj.UnbookmarkPost(ctx, userID, discussionID, postObj.ID)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		Convey("when DeletePostBookmark errors out", func() {
+			mockDB.On("GetPostByID", ctx, postObj.ID).Return(&postObj, nil)
+			mockDB.On("DeletePostBookmark", ctx, bookmarkObj).Return(fmt.Errorf("sth"))
+
+			resp, err := backendObj.UnbookmarkPost(ctx, userID, discussionID, postObj.ID)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		Convey("when the post is deleted it can still be unbookmarked", func() {
+			postObj.DeletedAt = &now
+			mockDB.On("GetPostByID", ctx, postObj.ID).Return(&postObj, nil)
+			mockDB.On("DeletePostBookmark", ctx, bookmarkObj).Return(nil)
+
+			resp, err := backendObj.UnbookmarkPost(ctx, userID, discussionID, postObj.ID)
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldResemble, &postObj)
+		})
+	})
+}
+
+func TestDelphisBackend_GetPostBookmarksConnectionByUserID(t *testing.T) {
+	ctx := context.Background()
+
+	userID := test_utils.UserID
+	cursor := "cursor"
+
+	Convey("GetPostBookmarksConnectionByUserID", t, func() {
+		now := time.Now()
+		cacheObj := cache.NewInMemoryCache()
+		authObj := auth.NewDelphisAuth(nil)
+		mockDB := &mocks.Datastore{}
+		backendObj := &delphisBackend{
+			db:              mockDB,
+			auth:            authObj,
+			cache:           cacheObj,
+			pubsub:          pubsub.NewInMemoryPubSub(),
+			discussionMutex: sync.Mutex{},
+			config:          config.Config{},
+			timeProvider:    &util.FrozenTime{NowTime: now},
+		}
+
+		postObj := test_utils.TestPost()
+		postContentObj := test_utils.TestPostContent()
+		postObj.PostContent = &postContentObj
+
+		Convey("when the limit is illegal", func() {
+			resp, err := backendObj.GetPostBookmarksConnectionByUserID(ctx, userID, cursor, PostPerPageLimit+1)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		Convey("when GetPostBookmarksConnectionByUserID errors out", func() {
+			mockDB.On("GetPostBookmarksConnectionByUserID", ctx, userID, cursor, 10).Return(nil, fmt.Errorf("sth"))
+
+			resp, err := backendObj.GetPostBookmarksConnectionByUserID(ctx, userID, cursor, 10)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		Convey("when a bookmarked post has been deleted", func() {
+			postObj.DeletedAt = &now
+			connObj := model.PostBookmarksConnection{
+				Edges: []*model.PostBookmarksEdge{
+					{Cursor: cursor, Node: &model.PostBookmark{UserID: userID, PostID: postObj.ID, CreatedAt: now, Post: &postObj}},
+				},
+			}
+			mockDB.On("GetPostBookmarksConnectionByUserID", ctx, userID, cursor, 10).Return(&connObj, nil)
+
+			resp, err := backendObj.GetPostBookmarksConnectionByUserID(ctx, userID, cursor, 10)
+
+			So(err, ShouldBeNil)
+			So(resp.Edges[0].Node.Post.PostContent, ShouldResemble, &model.PostContent{ID: postContentObj.ID})
+		})
+
+		Convey("when the bookmarks are returned", func() {
+			connObj := model.PostBookmarksConnection{
+				Edges: []*model.PostBookmarksEdge{
+					{Cursor: cursor, Node: &model.PostBookmark{UserID: userID, PostID: postObj.ID, CreatedAt: now, Post: &postObj}},
+				},
+			}
+			mockDB.On("GetPostBookmarksConnectionByUserID", ctx, userID, cursor, 10).Return(&connObj, nil)
+
+			resp, err := backendObj.GetPostBookmarksConnectionByUserID(ctx, userID, cursor, 10)
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldResemble, &connObj)
+			So(resp.Edges[0].Node.Post.PostContent, ShouldResemble, &postContentObj)
+		})
+	})
+}
@@ -0,0 +1,94 @@
+package backend
+
+import (
+	"context"
+	"fmt"
+	"unicode"
+
+	"github.com/delphis-inc/delphisbe/graph/model"
+	"github.com/sirupsen/logrus"
+)
+
+// Long enough for multi-codepoint emoji such as flags and skin tone or ZWJ
+// sequences. Matches the reaction column.
+const maxReactionLength = 32
+
+// Joins emoji into a single glyph, e.g. family and profession emoji.
+const zeroWidthJoiner = '\u200d'
+
+func (d *delphisBackend) AddPostReaction(ctx context.Context, discussionID string, participantID string, postID string, reaction string) (*model.Post, error) {
+	post, err := d.getReactablePost(ctx, discussionID, postID, reaction)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := d.db.PutPostReaction(ctx, model.PostReaction{
+		PostID:        post.ID,
+		ParticipantID: participantID,
+		Reaction:      reaction,
+	}); err != nil {
+		logrus.WithError(err).Error("failed to put post reaction")
+		return nil, err
+	}
+
+	d.emitDiscussionEvent(ctx, discussionID, model.DiscussionSubscriptionEventTypePostReactionAdded, post)
+
+	return post, nil
+}
+
+func (d *delphisBackend) RemovePostReaction(ctx context.Context, discussionID string, participantID string, postID string, reaction string) (*model.Post, error) {
+	post, err := d.getReactablePost(ctx, discussionID, postID, reaction)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := d.db.DeletePostReaction(ctx, model.PostReaction{
+		PostID:        post.ID,
+		ParticipantID: participantID,
+		Reaction:      reaction,
+	}); err != nil {
+		logrus.WithError(err).Error("failed to delete post reaction")
+		return nil, err
+	}
+
+	d.emitDiscussionEvent(ctx, discussionID, model.DiscussionSubscriptionEventTypePostReactionRemoved, post)
+
+	return post, nil
+}
+
+func (d *delphisBackend) GetPostReactionSummaries(ctx context.Context, postID string, participantID *string) ([]*model.PostReactionSummary, error) {
+	return d.db.GetPostReactionSummaries(ctx, postID, participantID)
+}
+
+func (d *delphisBackend) getReactablePost(ctx context.Context, discussionID string, postID string, reaction string) (*model.Post, error) {
+	if err := validateReaction(reaction); err != nil {
+		return nil, err
+	}
+
+	post, err := d.GetPostByDiscussionPostID(ctx, discussionID, postID)
+	if err != nil || post == nil {
+		return nil, fmt.Errorf("Post not found")
+	}
+	if post.DeletedAt != nil {
+		return nil, fmt.Errorf("Cannot react to a deleted post")
+	}
+
+	return post, nil
+}
+
+// Reactions are meant to be emoji so anything containing letters or
+// whitespace is rejected rather than stored as free text.
+func validateReaction(reaction string) error {
+	if reaction == "" {
+		return fmt.Errorf("Reaction must not be empty")
+	}
+	if len(reaction) > maxReactionLength {
+		return fmt.Errorf("Reaction must be at most %d bytes", maxReactionLength)
+	}
+	for _, r := range reaction {
+		if unicode.IsLetter(r) || unicode.IsSpace(r) || (!unicode.IsPrint(r) && r != zeroWidthJoiner) {
+			return fmt.Errorf("Reaction must be an emoji")
+		}
+	}
+	return nil
+}
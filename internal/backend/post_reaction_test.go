@@ -0,0 +1,186 @@
+package backend
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/delphis-inc/delphisbe/graph/model"
+	"github.com/delphis-inc/delphisbe/internal/auth"
+	"github.com/delphis-inc/delphisbe/internal/backend/test_utils"
+	"github.com/delphis-inc/delphisbe/internal/cache"
+	"github.com/delphis-inc/delphisbe/internal/config"
+	"github.com/delphis-inc/delphisbe/internal/pubsub"
+	"github.com/delphis-inc/delphisbe/internal/util"
+	"github.com/delphis-inc/delphisbe/mocks"
+	. "github.com/smartystreets/goconvey/convey"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestDelphisBackend_AddPostReaction(t *testing.T) {
+	ctx := context.Background()
+
+	discussionID := test_utils.DiscussionID
+	participantID := test_utils.ParticipantID
+	reaction := "👍"
+
+	eventObj := test_utils.TestDiscussionEvent()
+	reactionObj := model.PostReaction{
+		PostID:        test_utils.PostID,
+		ParticipantID: participantID,
+		Reaction:      reaction,
+	}
+
+	Convey("AddPostReaction", t, func() {
+		now := time.Now()
+		cacheObj := cache.NewInMemoryCache()
+		authObj := auth.NewDelphisAuth(nil)
+		mockDB := &mocks.Datastore{}
+		backendObj := &delphisBackend{
+			db:              mockDB,
+			auth:            authObj,
+			cache:           cacheObj,
+			pubsub:          pubsub.NewInMemoryPubSub(),
+			discussionMutex: sync.Mutex{},
+			config:          config.Config{},
+			timeProvider:    &util.FrozenTime{NowTime: now},
+		}
+
+		postObj := test_utils.TestPost()
+
+		Convey("when the reaction is invalid", func() {
+			resp, err := backendObj.AddPostReaction(ctx, discussionID, participantID, postObj.ID, "nice")
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		Convey("when the post is not found", func() {
+			mockDB.On("GetPostByID", ctx, postObj.ID).Return(nil, fmt.Errorf("sth"))
+
+			resp, err := backendObj.AddPostReaction(ctx, discussionID, participantID, postObj.ID, reaction)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		Convey("when the post is deleted", func() {
+			postObj.DeletedAt = &now
+			mockDB.On("GetPostByID", ctx, postObj.ID).Return(&postObj, nil)
+
+			resp, err := backendObj.AddPostReaction(ctx, discussionID, participantID, postObj.ID, reaction)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		mockDB.On("GetPostByID", ctx, postObj.ID).Return(&postObj, nil)
+
+		Convey("when PutPostReaction errors out", func() {
+			mockDB.On("PutPostReaction", ctx, reactionObj).Return(fmt.Errorf("sth"))
+
+			resp, err := backendObj.AddPostReaction(ctx, discussionID, participantID, postObj.ID, reaction)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		Convey("when the reaction is added", func() {
+			mockDB.On("PutPostReaction", ctx, reactionObj).Return(nil)
+			mockDB.On("PutDiscussionEvent", ctx, mock.Anything).Return(&eventObj, nil)
+
+			resp, err := backendObj.AddPostReaction(ctx, discussionID, participantID, postObj.ID, reaction)
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldResemble, &postObj)
+			mockDB.AssertCalled(t, "PutDiscussionEvent", ctx, mock.MatchedBy(func(event model.DiscussionEvent) bool {
+				return event.EventType == model.DiscussionSubscriptionEventTypePostReactionAdded && event.EntityID == postObj.ID
+			}))
+		})
+	})
+}
+
+func TestDelphisBackend_RemovePostReaction(t *testing.T) {
+	ctx := context.Background()
+
+	discussionID := test_utils.DiscussionID
+	participantID := test_utils.ParticipantID
+	reaction := "👍"
+
+	eventObj := test_utils.TestDiscussionEvent()
+	reactionObj := model.PostReaction{
+		PostID:        test_utils.PostID,
+		ParticipantID: participantID,
+		Reaction:      reaction,
+	}
+
+	Convey("RemovePostReaction", t, func() {
+		now := time.Now()
+		cacheObj := cache.NewInMemoryCache()
+		authObj := auth.NewDelphisAuth(nil)
+		mockDB := &mocks.Datastore{}
+		backendObj := &delphisBackend{
+			db:              mockDB,
+			auth:            authObj,
+			cache:           cacheObj,
+			pubsub:          pubsub.NewInMemoryPubSub(),
+			discussionMutex: sync.Mutex{},
+			config:          config.Config{},
+			timeProvider:    &util.FrozenTime{NowTime: now},
+		}
+
+		postObj := test_utils.TestPost()
+
+		Convey("when the post is in another discussion", func() {
+			mockDB.On("GetPostByID", ctx, postObj.ID).Return(&postObj, nil)
+
+			resp, err := backendObj.RemovePostReaction(ctx, "other_discussion_id", participantID, postObj.ID, reaction)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		mockDB.On("GetPostByID", ctx, postObj.ID).Return(&postObj, nil)
+
+		Convey("when DeletePostReaction errors out", func() {
+			mockDB.On("DeletePostReaction", ctx, reactionObj).Return(fmt.Errorf("sth"))
+
+			resp, err := backendObj.RemovePostReaction(ctx, discussionID, participantID, postObj.ID, reaction)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		Convey("when the reaction is removed", func() {
+			mockDB.On("DeletePostReaction", ctx, reactionObj).Return(nil)
+			mockDB.On("PutDiscussionEvent", ctx, mock.Anything).Return(&eventObj, nil)
+
+			resp, err := backendObj.RemovePostReaction(ctx, discussionID, participantID, postObj.ID, reaction)
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldResemble, &postObj)
+			mockDB.AssertCalled(t, "PutDiscussionEvent", ctx, mock.MatchedBy(func(event model.DiscussionEvent) bool {
+				return event.EventType == model.DiscussionSubscriptionEventTypePostReactionRemoved && event.EntityID == postObj.ID
+			}))
+		})
+	})
+}
+
+func TestDelphisBackend_ValidateReaction(t *testing.T) {
+	Convey("validateReaction", t, func() {
+		Convey("accepts emoji", func() {
+			for _, reaction := range []string{"👍", "❤️", "👍🏽", "🇺🇸", "👨‍👩‍👧"} {
+				So(validateReaction(reaction), ShouldBeNil)
+			}
+		})
+
+		Convey("rejects empty, text and oversized reactions", func() {
+			for _, reaction := range []string{"", "nice", "👍 ", strings.Repeat("👍", 9)} {
+				So(validateReaction(reaction), ShouldNotBeNil)
+			}
+		})
+	})
+}
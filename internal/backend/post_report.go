@@ -0,0 +1,223 @@
+package backend
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/delphis-inc/delphisbe/graph/model"
+	"github.com/delphis-inc/delphisbe/internal/notif"
+	"github.com/delphis-inc/delphisbe/internal/util"
+	"github.com/sirupsen/logrus"
+)
+
+const maxPostReportNoteLength = 1000
+
+// ReportPost files a report on the post for the discussion's moderators.
+// Users can report a post once, so reporting it again returns the earlier
+// report without notifying anyone.
+func (d *delphisBackend) ReportPost(ctx context.Context, discussionID string, postID string, userID string, reason model.PostReportReason, note *string) (*model.PostReport, error) {
+	note, err := normalizePostReportNote(note)
+	if err != nil {
+		return nil, err
+	}
+
+	discussionObj, err := d.GetDiscussionByID(ctx, discussionID)
+	if err != nil || discussionObj == nil {
+		return nil, fmt.Errorf("Discussion not found")
+	}
+
+	post, err := d.GetPostByDiscussionPostID(ctx, discussionID, postID)
+	if err != nil || post == nil || post.DeletedAt != nil {
+		return nil, fmt.Errorf("Post not found")
+	}
+
+	participants, err := d.GetParticipantsByDiscussionIDUserID(ctx, discussionID, userID)
+	if err != nil {
+		logrus.WithError(err).Error("failed to get participants for reporter")
+		return nil, err
+	}
+	if participants.Anon == nil && participants.NonAnon == nil {
+		return nil, fmt.Errorf("Only participants can report posts")
+	}
+	if (participants.Anon != nil && participants.Anon.IsBanned) || (participants.NonAnon != nil && participants.NonAnon.IsBanned) {
+		return nil, fmt.Errorf("Banned participants cannot report posts")
+	}
+
+	existing, err := d.db.GetPostReportByPostIDReporterUserID(ctx, postID, userID)
+	if err != nil {
+		logrus.WithError(err).Error("failed to get existing post report")
+		return nil, err
+	}
+	if existing != nil {
+		return existing, nil
+	}
+
+	report, err := d.db.PutPostReport(ctx, model.PostReport{
+		ID:             util.UUIDv4(),
+		DiscussionID:   discussionID,
+		PostID:         postID,
+		ReporterUserID: userID,
+		Reason:         reason,
+		Note:           note,
+		Status:         model.PostReportStatusOpen,
+	})
+	if err != nil {
+		logrus.WithError(err).Error("failed to put post report")
+		return nil, err
+	}
+
+	d.notifyModeratorsOfPostReport(ctx, discussionObj, report)
+
+	return report, nil
+}
+
+func normalizePostReportNote(note *string) (*string, error) {
+	if note == nil {
+		return nil, nil
+	}
+
+	text := strings.TrimSpace(*note)
+	if text == "" {
+		return nil, nil
+	}
+	if len(text) > maxPostReportNoteLength {
+		return nil, fmt.Errorf("Report notes must be at most %d characters", maxPostReportNoteLength)
+	}
+	return &text, nil
+}
+
+// notifyModeratorsOfPostReport is best effort since the report is already
+// filed, so failures are only logged.
+func (d *delphisBackend) notifyModeratorsOfPostReport(ctx context.Context, discussion *model.Discussion, report *model.PostReport) {
+	moderatorUserIDs, err := d.getModeratorUserIDs(ctx, discussion.ID)
+	if err != nil {
+		logrus.WithError(err).Warn("failed to get moderators to notify of post report")
+		return
+	}
+
+	notificationBody, err := notif.BuildPostReportNotification(ctx, *discussion, report.Reason)
+	if err != nil {
+		logrus.WithError(err).Warn("failed to build post report notification")
+		return
+	}
+
+	for _, userID := range moderatorUserIDs {
+		if userID == report.ReporterUserID {
+			continue
+		}
+		if err := d.sendPushNotificationToUser(ctx, userID, *notificationBody); err != nil {
+			logrus.WithError(err).Warnf("failed to notify moderator %s of post report", userID)
+		}
+	}
+}
+
+func (d *delphisBackend) GetPostReportsConnectionByDiscussionID(ctx context.Context, discussionID string, status model.PostReportStatus, cursor string, limit int) (*model.PostReportConnection, error) {
+	if limit < 2 || limit > PostPerPageLimit {
+		return nil, errors.New("Values of 'limit' is illegal")
+	}
+
+	return d.db.GetPostReportsConnectionByDiscussionID(ctx, discussionID, status, cursor, limit)
+}
+
+// DismissPostReport closes the report, and any other open report on the same
+// post, without acting on the post.
+func (d *delphisBackend) DismissPostReport(ctx context.Context, discussionID string, reportID string, requestingUserID string) (*model.PostReport, error) {
+	report, err := d.getOpenPostReport(ctx, discussionID, reportID, requestingUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	resolved, err := d.resolvePostReports(ctx, report, model.PostReportStatusDismissed, nil, requestingUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	d.recordModerationAction(ctx, discussionID, requestingUserID, model.ModerationActionDismissPostReport, model.ModerationTargetTypePostReport, reportID, nil)
+
+	return resolved, nil
+}
+
+// ActionPostReport acts on the reported post through the same backend calls
+// the moderation mutations use, so their permission checks and moderation
+// log entries apply. The report is only resolved once the action succeeds.
+func (d *delphisBackend) ActionPostReport(ctx context.Context, discussionID string, reportID string, requestingUserID string, action model.PostReportAction, mutedForSeconds *int) (*model.PostReport, error) {
+	report, err := d.getOpenPostReport(ctx, discussionID, reportID, requestingUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	post, err := d.GetPostByID(ctx, report.PostID)
+	if err != nil || post == nil || post.ParticipantID == nil {
+		return nil, fmt.Errorf("Post not found")
+	}
+
+	switch action {
+	case model.PostReportActionDeletePost:
+		deletedPost, err := d.DeletePostByID(ctx, discussionID, report.PostID, requestingUserID)
+		if err != nil {
+			return nil, err
+		}
+		if err := d.NotifySubscribersOfDeletedPost(ctx, deletedPost, discussionID); err != nil {
+			logrus.WithError(err).Warn("failed to notify subscribers of deleted post")
+		}
+	case model.PostReportActionMuteAuthor:
+		if mutedForSeconds == nil || *mutedForSeconds < 0 || *mutedForSeconds > 86400 {
+			return nil, fmt.Errorf("mutedForSeconds value is invalid")
+		}
+		if _, err := d.MuteParticipants(ctx, discussionID, []string{*post.ParticipantID}, requestingUserID, *mutedForSeconds); err != nil {
+			return nil, err
+		}
+	case model.PostReportActionBanAuthor:
+		bannedParticipant, err := d.BanParticipant(ctx, discussionID, *post.ParticipantID, requestingUserID, nil, false)
+		if err != nil {
+			return nil, err
+		}
+		if err := d.NotifySubscribersOfBannedParticipant(ctx, bannedParticipant, discussionID); err != nil {
+			logrus.WithError(err).Warn("failed to notify subscribers of banned participant")
+		}
+	default:
+		return nil, fmt.Errorf("Unknown report action %s", action)
+	}
+
+	return d.resolvePostReports(ctx, report, model.PostReportStatusActioned, &action, requestingUserID)
+}
+
+// getOpenPostReport returns the report if it is still open and the requesting
+// user may review reports in the discussion. Helpers can review reports.
+func (d *delphisBackend) getOpenPostReport(ctx context.Context, discussionID string, reportID string, requestingUserID string) (*model.PostReport, error) {
+	isModerator, err := d.CheckModeratorRole(ctx, requestingUserID, discussionID, model.ModeratorRoleHelper)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to retrieve discussion")
+	} else if !isModerator {
+		return nil, fmt.Errorf("Only moderators may review reports")
+	}
+
+	report, err := d.db.GetPostReportByID(ctx, reportID)
+	if err != nil || report == nil || report.DiscussionID != discussionID {
+		return nil, fmt.Errorf("Report not found")
+	}
+	if report.Status != model.PostReportStatusOpen {
+		return nil, fmt.Errorf("Report has already been resolved")
+	}
+
+	return report, nil
+}
+
+func (d *delphisBackend) resolvePostReports(ctx context.Context, report *model.PostReport, status model.PostReportStatus, action *model.PostReportAction, requestingUserID string) (*model.PostReport, error) {
+	resolved, err := d.db.ResolvePostReports(ctx, report.PostID, status, action, requestingUserID)
+	if err != nil {
+		logrus.WithError(err).Error("failed to resolve post reports")
+		return nil, err
+	}
+
+	for _, elem := range resolved {
+		if elem.ID == report.ID {
+			return elem, nil
+		}
+	}
+
+	// Another moderator resolved the report first
+	return nil, fmt.Errorf("Report has already been resolved")
+}
@@ -0,0 +1,429 @@
+package backend
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/delphis-inc/delphisbe/graph/model"
+	"github.com/delphis-inc/delphisbe/internal/auth"
+	"github.com/delphis-inc/delphisbe/internal/backend/test_utils"
+	"github.com/delphis-inc/delphisbe/internal/cache"
+	"github.com/delphis-inc/delphisbe/internal/config"
+	"github.com/delphis-inc/delphisbe/internal/pubsub"
+	"github.com/delphis-inc/delphisbe/internal/util"
+	"github.com/delphis-inc/delphisbe/mocks"
+	. "github.com/smartystreets/goconvey/convey"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestDelphisBackend_ReportPost(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	discussionObj := test_utils.TestDiscussion()
+	discussionID := test_utils.DiscussionID
+	postID := test_utils.PostID
+	reporterUserID := "reporterUserID"
+	moderatorUserID := "moderatorUserID"
+	reason := model.PostReportReasonSpam
+
+	Convey("ReportPost", t, func() {
+		mockDB := &mocks.Datastore{}
+		backendObj := &delphisBackend{
+			db:              mockDB,
+			auth:            auth.NewDelphisAuth(nil),
+			cache:           cache.NewInMemoryCache(),
+			discussionMutex: sync.Mutex{},
+			config:          config.Config{},
+			timeProvider:    &util.FrozenTime{NowTime: now},
+		}
+
+		postObj := test_utils.TestPost()
+		reporterObj := test_utils.TestParticipant()
+		reporterObj.UserID = &reporterUserID
+
+		Convey("when the note is too long", func() {
+			note := strings.Repeat("a", maxPostReportNoteLength+1)
+
+			resp, err := backendObj.ReportPost(ctx, discussionID, postID, reporterUserID, reason, &note)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		Convey("when the discussion is not found", func() {
+			mockDB.On("GetDiscussionByID", ctx, discussionID).Return(nil, nil)
+
+			resp, err := backendObj.ReportPost(ctx, discussionID, postID, reporterUserID, reason, nil)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		mockDB.On("GetDiscussionByID", ctx, discussionID).Return(&discussionObj, nil)
+
+		Convey("when the post is not found", func() {
+			mockDB.On("GetPostByID", ctx, postID).Return(nil, nil)
+
+			resp, err := backendObj.ReportPost(ctx, discussionID, postID, reporterUserID, reason, nil)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		Convey("when the post is deleted", func() {
+			postObj.DeletedAt = &now
+			mockDB.On("GetPostByID", ctx, postID).Return(&postObj, nil)
+
+			resp, err := backendObj.ReportPost(ctx, discussionID, postID, reporterUserID, reason, nil)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		mockDB.On("GetPostByID", ctx, postID).Return(&postObj, nil)
+
+		Convey("when the reporter is not a participant", func() {
+			mockDB.On("GetParticipantsByDiscussionIDUserID", ctx, discussionID, reporterUserID).Return([]model.Participant{}, nil)
+
+			resp, err := backendObj.ReportPost(ctx, discussionID, postID, reporterUserID, reason, nil)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		Convey("when the reporter is banned", func() {
+			reporterObj.IsBanned = true
+			mockDB.On("GetParticipantsByDiscussionIDUserID", ctx, discussionID, reporterUserID).Return([]model.Participant{reporterObj}, nil)
+
+			resp, err := backendObj.ReportPost(ctx, discussionID, postID, reporterUserID, reason, nil)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		mockDB.On("GetParticipantsByDiscussionIDUserID", ctx, discussionID, reporterUserID).Return([]model.Participant{reporterObj}, nil)
+
+		Convey("when the reporter already reported the post", func() {
+			existing := model.PostReport{ID: "report1", PostID: postID, ReporterUserID: reporterUserID}
+			mockDB.On("GetPostReportByPostIDReporterUserID", ctx, postID, reporterUserID).Return(&existing, nil)
+
+			resp, err := backendObj.ReportPost(ctx, discussionID, postID, reporterUserID, reason, nil)
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldEqual, &existing)
+			mockDB.AssertNotCalled(t, "PutPostReport", ctx, mock.Anything)
+		})
+
+		mockDB.On("GetPostReportByPostIDReporterUserID", ctx, postID, reporterUserID).Return(nil, nil)
+
+		Convey("when putting the report fails", func() {
+			mockDB.On("PutPostReport", ctx, mock.Anything).Return(nil, fmt.Errorf("sth"))
+
+			resp, err := backendObj.ReportPost(ctx, discussionID, postID, reporterUserID, reason, nil)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		Convey("when the report is filed", func() {
+			note := "  Posted the same link ten times  "
+			report := model.PostReport{ID: "report1", DiscussionID: discussionID, PostID: postID, ReporterUserID: reporterUserID, Reason: reason}
+			token := "token"
+			mockDB.On("PutPostReport", ctx, mock.MatchedBy(func(input model.PostReport) bool {
+				return input.ID != "" && input.DiscussionID == discussionID && input.PostID == postID && input.ReporterUserID == reporterUserID &&
+					input.Reason == reason && *input.Note == "Posted the same link ten times" && input.Status == model.PostReportStatusOpen
+			})).Return(&report, nil)
+			mockDB.On("GetModeratorParticipantsByDiscussionID", ctx, discussionID).Return([]model.Participant{{UserID: &moderatorUserID}}, nil)
+			mockDB.On("GetModeratorRolesByDiscussionID", ctx, discussionID).Return([]*model.ModeratorRoleGrant{{UserID: reporterUserID}}, nil)
+			mockDB.On("GetUserDevicesByUserID", ctx, moderatorUserID).Return([]model.UserDevice{{Platform: "ios", Token: &token}}, nil)
+
+			resp, err := backendObj.ReportPost(ctx, discussionID, postID, reporterUserID, reason, &note)
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldEqual, &report)
+			mockDB.AssertCalled(t, "GetUserDevicesByUserID", ctx, moderatorUserID)
+			mockDB.AssertNotCalled(t, "GetUserDevicesByUserID", ctx, reporterUserID)
+		})
+
+		Convey("when notifying the moderators fails", func() {
+			report := model.PostReport{ID: "report1", ReporterUserID: reporterUserID}
+			mockDB.On("PutPostReport", ctx, mock.Anything).Return(&report, nil)
+			mockDB.On("GetModeratorParticipantsByDiscussionID", ctx, discussionID).Return(nil, fmt.Errorf("sth"))
+
+			resp, err := backendObj.ReportPost(ctx, discussionID, postID, reporterUserID, reason, nil)
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldEqual, &report)
+		})
+	})
+}
+
+func TestDelphisBackend_GetPostReportsConnectionByDiscussionID(t *testing.T) {
+	ctx := context.Background()
+	discussionID := test_utils.DiscussionID
+	cursor := "cursor"
+
+	Convey("GetPostReportsConnectionByDiscussionID", t, func() {
+		mockDB := &mocks.Datastore{}
+		backendObj := &delphisBackend{
+			db:              mockDB,
+			auth:            auth.NewDelphisAuth(nil),
+			cache:           cache.NewInMemoryCache(),
+			discussionMutex: sync.Mutex{},
+			config:          config.Config{},
+			timeProvider:    &util.FrozenTime{NowTime: time.Now()},
+		}
+
+		Convey("when the limit is illegal", func() {
+			resp, err := backendObj.GetPostReportsConnectionByDiscussionID(ctx, discussionID, model.PostReportStatusOpen, cursor, PostPerPageLimit+1)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		Convey("when the limit is legal", func() {
+			connection := model.PostReportConnection{}
+			mockDB.On("GetPostReportsConnectionByDiscussionID", ctx, discussionID, model.PostReportStatusOpen, cursor, 10).Return(&connection, nil)
+
+			resp, err := backendObj.GetPostReportsConnectionByDiscussionID(ctx, discussionID, model.PostReportStatusOpen, cursor, 10)
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldEqual, &connection)
+		})
+	})
+}
+
+func TestDelphisBackend_DismissPostReport(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	discussionID := test_utils.DiscussionID
+	postID := test_utils.PostID
+	reportID := "reportID"
+	moderatorObj := test_utils.TestModerator()
+	requestingUserID := "requestingUserID"
+
+	Convey("DismissPostReport", t, func() {
+		mockDB := &mocks.Datastore{}
+		backendObj := &delphisBackend{
+			db:              mockDB,
+			auth:            auth.NewDelphisAuth(nil),
+			cache:           cache.NewInMemoryCache(),
+			discussionMutex: sync.Mutex{},
+			config:          config.Config{},
+			timeProvider:    &util.FrozenTime{NowTime: now},
+		}
+
+		reportObj := model.PostReport{ID: reportID, DiscussionID: discussionID, PostID: postID, Status: model.PostReportStatusOpen}
+
+		Convey("when the requesting user is not a moderator", func() {
+			mockDB.On("GetModeratorByUserIDAndDiscussionID", ctx, requestingUserID, discussionID).Return(nil, nil)
+			mockDB.On("GetModeratorRoleByUserIDAndDiscussionID", ctx, requestingUserID, discussionID).Return(nil, nil)
+
+			resp, err := backendObj.DismissPostReport(ctx, discussionID, reportID, requestingUserID)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		Convey("when the requesting user is a helper", func() {
+			mockDB.On("GetModeratorByUserIDAndDiscussionID", ctx, requestingUserID, discussionID).Return(nil, nil)
+			mockDB.On("GetModeratorRoleByUserIDAndDiscussionID", ctx, requestingUserID, discussionID).Return(&model.ModeratorRoleGrant{Role: model.ModeratorRoleHelper}, nil)
+			mockDB.On("GetPostReportByID", ctx, reportID).Return(&reportObj, nil)
+			dismissed := reportObj
+			dismissed.Status = model.PostReportStatusDismissed
+			mockDB.On("ResolvePostReports", ctx, postID, model.PostReportStatusDismissed, (*model.PostReportAction)(nil), requestingUserID).Return([]*model.PostReport{&dismissed}, nil)
+			mockDB.On("PutModerationLogEntry", ctx, mock.MatchedBy(func(entry model.ModerationLogEntry) bool {
+				return entry.Action == model.ModerationActionDismissPostReport && entry.TargetType == model.ModerationTargetTypePostReport && *entry.TargetID == reportID
+			})).Return(&model.ModerationLogEntry{}, nil)
+
+			resp, err := backendObj.DismissPostReport(ctx, discussionID, reportID, requestingUserID)
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldEqual, &dismissed)
+			mockDB.AssertCalled(t, "PutModerationLogEntry", ctx, mock.Anything)
+		})
+
+		mockDB.On("GetModeratorByUserIDAndDiscussionID", ctx, requestingUserID, discussionID).Return(&moderatorObj, nil)
+
+		Convey("when the report is not found", func() {
+			mockDB.On("GetPostReportByID", ctx, reportID).Return(nil, nil)
+
+			resp, err := backendObj.DismissPostReport(ctx, discussionID, reportID, requestingUserID)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		Convey("when the report is in another discussion", func() {
+			reportObj.DiscussionID = "otherDiscussionID"
+			mockDB.On("GetPostReportByID", ctx, reportID).Return(&reportObj, nil)
+
+			resp, err := backendObj.DismissPostReport(ctx, discussionID, reportID, requestingUserID)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		Convey("when the report is already resolved", func() {
+			reportObj.Status = model.PostReportStatusActioned
+			mockDB.On("GetPostReportByID", ctx, reportID).Return(&reportObj, nil)
+
+			resp, err := backendObj.DismissPostReport(ctx, discussionID, reportID, requestingUserID)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		mockDB.On("GetPostReportByID", ctx, reportID).Return(&reportObj, nil)
+
+		Convey("when resolving the reports fails", func() {
+			mockDB.On("ResolvePostReports", ctx, postID, model.PostReportStatusDismissed, (*model.PostReportAction)(nil), requestingUserID).Return(nil, fmt.Errorf("sth"))
+
+			resp, err := backendObj.DismissPostReport(ctx, discussionID, reportID, requestingUserID)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		Convey("when another moderator resolved the report first", func() {
+			mockDB.On("ResolvePostReports", ctx, postID, model.PostReportStatusDismissed, (*model.PostReportAction)(nil), requestingUserID).Return([]*model.PostReport{}, nil)
+
+			resp, err := backendObj.DismissPostReport(ctx, discussionID, reportID, requestingUserID)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+			mockDB.AssertNotCalled(t, "PutModerationLogEntry", ctx, mock.Anything)
+		})
+	})
+}
+
+func TestDelphisBackend_ActionPostReport(t *testing.T) {
+	now := time.Now()
+	authedUser := test_utils.TestDelphisAuthedUser()
+	// No authed user on the context, so every action has to use the
+	// requesting user it is given
+	ctx := context.Background()
+
+	discussionObj := test_utils.TestDiscussion()
+	discussionID := test_utils.DiscussionID
+	postID := test_utils.PostID
+	participantID := test_utils.ParticipantID
+	reportID := "reportID"
+	moderatorObj := test_utils.TestModerator()
+	requestingUserID := authedUser.UserID
+	authorUserID := "authorUserID"
+	eventObj := test_utils.TestDiscussionEvent()
+
+	Convey("ActionPostReport", t, func() {
+		mockDB := &mocks.Datastore{}
+		backendObj := &delphisBackend{
+			db:              mockDB,
+			auth:            auth.NewDelphisAuth(nil),
+			cache:           cache.NewInMemoryCache(),
+			pubsub:          pubsub.NewInMemoryPubSub(),
+			discussionMutex: sync.Mutex{},
+			config:          config.Config{},
+			timeProvider:    &util.FrozenTime{NowTime: now},
+		}
+
+		reportObj := model.PostReport{ID: reportID, DiscussionID: discussionID, PostID: postID, Status: model.PostReportStatusOpen}
+		postObj := test_utils.TestPost()
+		authorObj := test_utils.TestParticipant()
+		authorObj.UserID = &authorUserID
+
+		Convey("when the requesting user is not a moderator", func() {
+			mockDB.On("GetModeratorByUserIDAndDiscussionID", ctx, requestingUserID, discussionID).Return(nil, nil)
+			mockDB.On("GetModeratorRoleByUserIDAndDiscussionID", ctx, requestingUserID, discussionID).Return(nil, nil)
+
+			resp, err := backendObj.ActionPostReport(ctx, discussionID, reportID, requestingUserID, model.PostReportActionDeletePost, nil)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		mockDB.On("GetModeratorByUserIDAndDiscussionID", ctx, requestingUserID, discussionID).Return(&moderatorObj, nil)
+		mockDB.On("GetPostReportByID", ctx, reportID).Return(&reportObj, nil)
+
+		Convey("when the post is not found", func() {
+			mockDB.On("GetPostByID", ctx, postID).Return(nil, nil)
+
+			resp, err := backendObj.ActionPostReport(ctx, discussionID, reportID, requestingUserID, model.PostReportActionDeletePost, nil)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		mockDB.On("GetPostByID", ctx, postID).Return(&postObj, nil)
+		mockDB.On("GetDiscussionByID", ctx, discussionID).Return(&discussionObj, nil)
+
+		Convey("when the action fails", func() {
+			mockDB.On("GetParticipantByID", ctx, participantID).Return(nil, fmt.Errorf("sth"))
+
+			resp, err := backendObj.ActionPostReport(ctx, discussionID, reportID, requestingUserID, model.PostReportActionBanAuthor, nil)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+			mockDB.AssertNotCalled(t, "ResolvePostReports", ctx, postID, mock.Anything, mock.Anything, mock.Anything)
+		})
+
+		Convey("when muting without a duration", func() {
+			resp, err := backendObj.ActionPostReport(ctx, discussionID, reportID, requestingUserID, model.PostReportActionMuteAuthor, nil)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+			mockDB.AssertNotCalled(t, "SetParticipantsMutedUntil", ctx, mock.Anything, mock.Anything)
+		})
+
+		Convey("when the post is deleted", func() {
+			action := model.PostReportActionDeletePost
+			actioned := reportObj
+			actioned.Status = model.PostReportStatusActioned
+			actioned.Action = &action
+			mockDB.On("GetParticipantByID", ctx, participantID).Return(&authorObj, nil)
+			mockDB.On("DeletePostByID", ctx, postID, model.PostDeletedReasonModeratorRemoved).Return(&postObj, nil)
+			mockDB.On("PutModerationLogEntry", ctx, mock.MatchedBy(func(entry model.ModerationLogEntry) bool {
+				return entry.Action == model.ModerationActionDeletePost
+			})).Return(&model.ModerationLogEntry{}, nil)
+			mockDB.On("PutDiscussionEvent", ctx, mock.MatchedBy(func(event model.DiscussionEvent) bool {
+				return event.EventType == model.DiscussionSubscriptionEventTypePostDeleted
+			})).Return(&eventObj, nil)
+			mockDB.On("ResolvePostReports", ctx, postID, model.PostReportStatusActioned, &action, requestingUserID).Return([]*model.PostReport{&actioned}, nil)
+
+			resp, err := backendObj.ActionPostReport(ctx, discussionID, reportID, requestingUserID, action, nil)
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldEqual, &actioned)
+			mockDB.AssertCalled(t, "DeletePostByID", ctx, postID, model.PostDeletedReasonModeratorRemoved)
+		})
+
+		Convey("when the author is muted", func() {
+			action := model.PostReportActionMuteAuthor
+			seconds := 60
+			actioned := reportObj
+			actioned.Status = model.PostReportStatusActioned
+			actioned.Action = &action
+			participants := []model.Participant{authorObj}
+			mockDB.On("GetModeratorByUserIDAndDiscussionID", ctx, authorUserID, discussionID).Return(nil, nil)
+			mockDB.On("GetModeratorRoleByUserIDAndDiscussionID", ctx, authorUserID, discussionID).Return(nil, nil)
+			mockDB.On("GetParticipantsByDiscussionID", ctx, discussionID).Return(participants, nil)
+			mockDB.On("SetParticipantsMutedUntil", ctx, mock.Anything, mock.AnythingOfType("*time.Time")).Return([]*model.Participant{&authorObj}, nil)
+			mockDB.On("PutDiscussionEvent", ctx, mock.Anything).Return(&eventObj, nil)
+			mockDB.On("PutModerationLogEntry", ctx, mock.MatchedBy(func(entry model.ModerationLogEntry) bool {
+				return entry.Action == model.ModerationActionMuteParticipant && entry.ActorUserID == requestingUserID
+			})).Return(&model.ModerationLogEntry{}, nil)
+			mockDB.On("ResolvePostReports", ctx, postID, model.PostReportStatusActioned, &action, requestingUserID).Return([]*model.PostReport{&actioned}, nil)
+
+			resp, err := backendObj.ActionPostReport(ctx, discussionID, reportID, requestingUserID, action, &seconds)
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldEqual, &actioned)
+			mockDB.AssertCalled(t, "SetParticipantsMutedUntil", ctx, mock.Anything, mock.AnythingOfType("*time.Time"))
+		})
+	})
+}
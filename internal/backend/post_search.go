@@ -0,0 +1,43 @@
+package backend
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/delphis-inc/delphisbe/graph/model"
+)
+
+// Keeps tsquery parsing cheap; longer input is almost certainly not a search.
+const maxSearchQueryLength = 256
+
+func (d *delphisBackend) SearchPostsByDiscussionID(ctx context.Context, discussionID string, query string, cursor string, limit int) (*model.PostsConnection, error) {
+	query, err := normalizeSearchQuery(query)
+	if err != nil {
+		return nil, err
+	}
+
+	return d.db.SearchPostsByDiscussionID(ctx, discussionID, query, cursor, limit)
+}
+
+// Only discussions the user has ACTIVE access to are searched.
+func (d *delphisBackend) SearchPostsByUserAccess(ctx context.Context, userID string, query string, cursor string, limit int) (*model.PostsConnection, error) {
+	query, err := normalizeSearchQuery(query)
+	if err != nil {
+		return nil, err
+	}
+
+	return d.db.SearchPostsByUserAccess(ctx, userID, query, cursor, limit)
+}
+
+func normalizeSearchQuery(query string) (string, error) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return "", fmt.Errorf("Search query cannot be empty")
+	}
+	if len(query) > maxSearchQueryLength {
+		return "", fmt.Errorf("Search query cannot be longer than %d characters", maxSearchQueryLength)
+	}
+
+	return query, nil
+}
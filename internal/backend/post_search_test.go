@@ -0,0 +1,118 @@
+package backend
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/delphis-inc/delphisbe/graph/model"
+	"github.com/delphis-inc/delphisbe/internal/auth"
+	"github.com/delphis-inc/delphisbe/internal/backend/test_utils"
+	"github.com/delphis-inc/delphisbe/internal/cache"
+	"github.com/delphis-inc/delphisbe/internal/config"
+	"github.com/delphis-inc/delphisbe/internal/pubsub"
+	"github.com/delphis-inc/delphisbe/internal/util"
+	"github.com/delphis-inc/delphisbe/mocks"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestDelphisBackend_SearchPostsByDiscussionID(t *testing.T) {
+	ctx := context.Background()
+
+	discussionID := test_utils.DiscussionID
+	cursor := "2020-06-01T00:00:00Z"
+	limit := 10
+	connObj := &model.PostsConnection{}
+
+	Convey("SearchPostsByDiscussionID", t, func() {
+		now := time.Now()
+		cacheObj := cache.NewInMemoryCache()
+		authObj := auth.NewDelphisAuth(nil)
+		mockDB := &mocks.Datastore{}
+		backendObj := &delphisBackend{
+			db:              mockDB,
+			auth:            authObj,
+			cache:           cacheObj,
+			pubsub:          pubsub.NewInMemoryPubSub(),
+			discussionMutex: sync.Mutex{},
+			config:          config.Config{},
+			timeProvider:    &util.FrozenTime{NowTime: now},
+		}
+
+		Convey("when the query is blank", func() {
+			resp, err := backendObj.SearchPostsByDiscussionID(ctx, discussionID, "   ", cursor, limit)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		Convey("when the query is too long", func() {
+			resp, err := backendObj.SearchPostsByDiscussionID(ctx, discussionID, strings.Repeat("a", maxSearchQueryLength+1), cursor, limit)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		Convey("when the search errors out", func() {
+			mockDB.On("SearchPostsByDiscussionID", ctx, discussionID, "agenda", cursor, limit).Return(nil, fmt.Errorf("sth"))
+
+			resp, err := backendObj.SearchPostsByDiscussionID(ctx, discussionID, "agenda", cursor, limit)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		Convey("when the search succeeds with a padded query", func() {
+			mockDB.On("SearchPostsByDiscussionID", ctx, discussionID, "agenda", cursor, limit).Return(connObj, nil)
+
+			resp, err := backendObj.SearchPostsByDiscussionID(ctx, discussionID, " agenda\n", cursor, limit)
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldEqual, connObj)
+		})
+	})
+}
+
+func TestDelphisBackend_SearchPostsByUserAccess(t *testing.T) {
+	ctx := context.Background()
+
+	userID := test_utils.UserID
+	cursor := "2020-06-01T00:00:00Z"
+	limit := 10
+	connObj := &model.PostsConnection{}
+
+	Convey("SearchPostsByUserAccess", t, func() {
+		now := time.Now()
+		cacheObj := cache.NewInMemoryCache()
+		authObj := auth.NewDelphisAuth(nil)
+		mockDB := &mocks.Datastore{}
+		backendObj := &delphisBackend{
+			db:              mockDB,
+			auth:            authObj,
+			cache:           cacheObj,
+			pubsub:          pubsub.NewInMemoryPubSub(),
+			discussionMutex: sync.Mutex{},
+			config:          config.Config{},
+			timeProvider:    &util.FrozenTime{NowTime: now},
+		}
+
+		Convey("when the query is blank", func() {
+			resp, err := backendObj.SearchPostsByUserAccess(ctx, userID, "", cursor, limit)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		Convey("when the search succeeds", func() {
+			mockDB.On("SearchPostsByUserAccess", ctx, userID, "agenda", cursor, limit).Return(connObj, nil)
+
+			resp, err := backendObj.SearchPostsByUserAccess(ctx, userID, "agenda", cursor, limit)
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldEqual, connObj)
+		})
+	})
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/delphis-inc/delphisbe/internal/auth"
 	"github.com/delphis-inc/delphisbe/internal/cache"
 	"github.com/delphis-inc/delphisbe/internal/config"
+	"github.com/delphis-inc/delphisbe/internal/pubsub"
 	"github.com/delphis-inc/delphisbe/internal/util"
 	"github.com/delphis-inc/delphisbe/mocks"
 	. "github.com/smartystreets/goconvey/convey"
@@ -83,48 +84,6 @@ func TestDelphisBackend_DeletePostByID(t *testing.T) {
 
 		mockDB.On("GetDiscussionByID", ctx, discussionID).Return(&discObj, nil)
 
-		Convey("when the moderator is not found", func() {
-			Convey("because it returns nil", func() {
-				mockDB.On("GetModeratorByID", ctx, *discObj.ModeratorID).Return(nil, nil)
-
-				resp, err := backendObj.DeletePostByID(ctx, discussionID, postObj.ID, userObj.ID)
-
-				So(err, ShouldNotBeNil)
-				So(resp, ShouldBeNil)
-			})
-			Convey("because it returns an error", func() {
-				mockDB.On("GetModeratorByID", ctx, *discObj.ModeratorID).Return(nil, fmt.Errorf("sth"))
-
-				resp, err := backendObj.DeletePostByID(ctx, discussionID, postObj.ID, userObj.ID)
-
-				So(err, ShouldNotBeNil)
-				So(resp, ShouldBeNil)
-			})
-		})
-
-		mockDB.On("GetModeratorByID", ctx, *discObj.ModeratorID).Return(&moderatorObj, nil)
-
-		Convey("when user profile is not found", func() {
-			Convey("because it returns nil", func() {
-				mockDB.On("GetUserProfileByID", ctx, userProfileObj.ID).Return(nil, nil)
-
-				resp, err := backendObj.DeletePostByID(ctx, discussionID, postObj.ID, userObj.ID)
-
-				So(err, ShouldNotBeNil)
-				So(resp, ShouldBeNil)
-			})
-			Convey("because it returns an error", func() {
-				mockDB.On("GetUserProfileByID", ctx, userProfileObj.ID).Return(nil, fmt.Errorf("sth"))
-
-				resp, err := backendObj.DeletePostByID(ctx, discussionID, postObj.ID, userObj.ID)
-
-				So(err, ShouldNotBeNil)
-				So(resp, ShouldBeNil)
-			})
-		})
-
-		mockDB.On("GetUserProfileByID", ctx, userProfileObj.ID).Return(&userProfileObj, nil)
-
 		Convey("when the post is not found", func() {
 			Convey("because it returns nil", func() {
 				mockDB.On("GetPostByID", ctx, postObj.ID).Return(nil, nil)
@@ -168,13 +127,37 @@ func TestDelphisBackend_DeletePostByID(t *testing.T) {
 
 		mockDB.On("GetParticipantByID", ctx, participantID).Return(&participantObj, nil)
 
+		Convey("when the moderator check errors out", func() {
+			mockDB.On("GetModeratorByUserIDAndDiscussionID", ctx, "baduserid", discussionID).Return(nil, fmt.Errorf("sth"))
+
+			resp, err := backendObj.DeletePostByID(ctx, discussionID, postObj.ID, "baduserid")
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
 		Convey("when userID is not moderator or participant", func() {
+			mockDB.On("GetModeratorByUserIDAndDiscussionID", ctx, "baduserid", discussionID).Return(nil, nil)
+			mockDB.On("GetModeratorRoleByUserIDAndDiscussionID", ctx, "baduserid", discussionID).Return(nil, nil)
+
 			resp, err := backendObj.DeletePostByID(ctx, discussionID, postObj.ID, "baduserid")
 
 			So(err, ShouldNotBeNil)
 			So(resp, ShouldBeNil)
 		})
 
+		Convey("when userID is a helper", func() {
+			mockDB.On("GetModeratorByUserIDAndDiscussionID", ctx, "helperuserid", discussionID).Return(nil, nil)
+			mockDB.On("GetModeratorRoleByUserIDAndDiscussionID", ctx, "helperuserid", discussionID).Return(&model.ModeratorRoleGrant{Role: model.ModeratorRoleHelper}, nil)
+
+			resp, err := backendObj.DeletePostByID(ctx, discussionID, postObj.ID, "helperuserid")
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		mockDB.On("GetModeratorByUserIDAndDiscussionID", ctx, *userProfileObj.UserID, discussionID).Return(&moderatorObj, nil)
+
 		Convey("when post is already deleted", func() {
 			postObj.DeletedAt = &now
 
@@ -188,6 +171,9 @@ func TestDelphisBackend_DeletePostByID(t *testing.T) {
 
 		Convey("when user is moderator", func() {
 			mockDB.On("DeletePostByID", ctx, postObj.ID, model.PostDeletedReasonModeratorRemoved).Return(&postObj, nil)
+			mockDB.On("PutModerationLogEntry", ctx, mock.MatchedBy(func(entry model.ModerationLogEntry) bool {
+				return entry.Action == model.ModerationActionDeletePost
+			})).Return(&model.ModerationLogEntry{}, nil)
 
 			resp, err := backendObj.DeletePostByID(ctx, discussionID, postObj.ID, *userProfileObj.UserID)
 
@@ -195,6 +181,18 @@ func TestDelphisBackend_DeletePostByID(t *testing.T) {
 			So(resp, ShouldNotBeNil)
 		})
 
+		Convey("when user was granted the moderator role", func() {
+			mockDB.On("GetModeratorByUserIDAndDiscussionID", ctx, "comoduserid", discussionID).Return(nil, nil)
+			mockDB.On("GetModeratorRoleByUserIDAndDiscussionID", ctx, "comoduserid", discussionID).Return(&model.ModeratorRoleGrant{Role: model.ModeratorRoleModerator}, nil)
+			mockDB.On("DeletePostByID", ctx, postObj.ID, model.PostDeletedReasonModeratorRemoved).Return(&postObj, nil)
+			mockDB.On("PutModerationLogEntry", ctx, mock.Anything).Return(&model.ModerationLogEntry{}, nil)
+
+			resp, err := backendObj.DeletePostByID(ctx, discussionID, postObj.ID, "comoduserid")
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldNotBeNil)
+		})
+
 		Convey("when user is participant", func() {
 			mockDB.On("DeletePostByID", ctx, postObj.ID, model.PostDeletedReasonParticipantRemoved).Return(&postObj, nil)
 
@@ -202,6 +200,218 @@ func TestDelphisBackend_DeletePostByID(t *testing.T) {
 
 			So(err, ShouldBeNil)
 			So(resp, ShouldNotBeNil)
+			mockDB.AssertNotCalled(t, "PutModerationLogEntry", ctx, mock.Anything)
+		})
+	})
+}
+
+func TestDelphisBackend_EditPost(t *testing.T) {
+	ctx := context.Background()
+
+	discussionID := test_utils.DiscussionID
+	participantID := test_utils.ParticipantID
+	userID := test_utils.UserID
+
+	eventObj := test_utils.TestDiscussionEvent()
+	participantObj := test_utils.TestParticipant()
+	postInputObj := test_utils.TestPostContentInput()
+	postInputObj.PostText = "hello edited world"
+
+	tx := sql.Tx{}
+
+	Convey("EditPost", t, func() {
+		now := time.Now()
+		cacheObj := cache.NewInMemoryCache()
+		authObj := auth.NewDelphisAuth(nil)
+		mockDB := &mocks.Datastore{}
+		backendObj := &delphisBackend{
+			db:              mockDB,
+			auth:            authObj,
+			cache:           cacheObj,
+			pubsub:          pubsub.NewInMemoryPubSub(),
+			discussionMutex: sync.Mutex{},
+			config:          config.Config{},
+			timeProvider:    &util.FrozenTime{NowTime: now},
+		}
+
+		discObj := test_utils.TestDiscussion()
+		postContentObj := test_utils.TestPostContent()
+		postContentObj.CreatedAt = now.Add(-time.Hour)
+		postObj := test_utils.TestPost()
+		postObj.PostContent = &postContentObj
+		// Pinning the post after it was written bumped updated_at
+		postObj.UpdatedAt = now
+
+		Convey("when the post type is not passed in", func() {
+			tempPostInputObj := postInputObj
+			tempPostInputObj.PostType = ""
+			resp, err := backendObj.EditPost(ctx, discussionID, postObj.ID, userID, tempPostInputObj)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		Convey("when the discussion is not found", func() {
+			mockDB.On("GetDiscussionByID", ctx, discussionID).Return(nil, nil)
+
+			resp, err := backendObj.EditPost(ctx, discussionID, postObj.ID, userID, postInputObj)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		Convey("when the discussion is locked", func() {
+			discObj.LockStatus = true
+			mockDB.On("GetDiscussionByID", ctx, discussionID).Return(&discObj, nil)
+
+			resp, err := backendObj.EditPost(ctx, discussionID, postObj.ID, userID, postInputObj)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		mockDB.On("GetDiscussionByID", ctx, discussionID).Return(&discObj, nil)
+
+		Convey("when the post is not found", func() {
+			Convey("because it returns an error", func() {
+				mockDB.On("GetPostByID", ctx, postObj.ID).Return(nil, fmt.Errorf("sth"))
+
+				resp, err := backendObj.EditPost(ctx, discussionID, postObj.ID, userID, postInputObj)
+
+				So(err, ShouldNotBeNil)
+				So(resp, ShouldBeNil)
+			})
+
+			Convey("because it belongs to another discussion", func() {
+				otherDiscussionID := "other_discussion_id"
+				postObj.DiscussionID = &otherDiscussionID
+				mockDB.On("GetPostByID", ctx, postObj.ID).Return(&postObj, nil)
+
+				resp, err := backendObj.EditPost(ctx, discussionID, postObj.ID, userID, postInputObj)
+
+				So(err, ShouldNotBeNil)
+				So(resp, ShouldBeNil)
+			})
+		})
+
+		Convey("when the post is deleted", func() {
+			postObj.DeletedAt = &now
+			mockDB.On("GetPostByID", ctx, postObj.ID).Return(&postObj, nil)
+
+			resp, err := backendObj.EditPost(ctx, discussionID, postObj.ID, userID, postInputObj)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		mockDB.On("GetPostByID", ctx, postObj.ID).Return(&postObj, nil)
+
+		Convey("when the participant is not found", func() {
+			mockDB.On("GetParticipantByID", ctx, participantID).Return(nil, fmt.Errorf("sth"))
+
+			resp, err := backendObj.EditPost(ctx, discussionID, postObj.ID, userID, postInputObj)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		mockDB.On("GetParticipantByID", ctx, participantID).Return(&participantObj, nil)
+
+		Convey("when the user is not the author", func() {
+			resp, err := backendObj.EditPost(ctx, discussionID, postObj.ID, "baduserid", postInputObj)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		Convey("when GetPostContentByID errors out", func() {
+			mockDB.On("GetPostContentByID", ctx, *postObj.PostContentID).Return(nil, fmt.Errorf("sth"))
+
+			resp, err := backendObj.EditPost(ctx, discussionID, postObj.ID, userID, postInputObj)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+			mockDB.AssertNotCalled(t, "BeginTx", ctx)
+		})
+
+		mockDB.On("GetPostContentByID", ctx, *postObj.PostContentID).Return(&postContentObj, nil)
+
+		Convey("when BeginTx errors out", func() {
+			expectedError := fmt.Errorf("Some Error")
+			mockDB.On("BeginTx", ctx).Return(nil, expectedError)
+
+			resp, err := backendObj.EditPost(ctx, discussionID, postObj.ID, userID, postInputObj)
+
+			So(err, ShouldEqual, expectedError)
+			So(resp, ShouldBeNil)
+		})
+
+		Convey("when PutPostContent errors out", func() {
+			expectedError := fmt.Errorf("Some Error")
+			mockDB.On("BeginTx", ctx).Return(&tx, nil)
+			mockDB.On("PutPostContent", ctx, mock.Anything, mock.Anything).Return(expectedError)
+			mockDB.On("RollbackTx", ctx, mock.Anything).Return(nil)
+
+			resp, err := backendObj.EditPost(ctx, discussionID, postObj.ID, userID, postInputObj)
+
+			So(err, ShouldEqual, expectedError)
+			So(resp, ShouldBeNil)
+		})
+
+		Convey("when EditPost errors out and Rollback fails", func() {
+			expectedError := fmt.Errorf("Some Error")
+			mockDB.On("BeginTx", ctx).Return(&tx, nil)
+			mockDB.On("PutPostContent", ctx, mock.Anything, mock.Anything).Return(nil)
+			mockDB.On("EditPost", ctx, mock.Anything, mock.Anything).Return(nil, expectedError)
+			mockDB.On("RollbackTx", ctx, mock.Anything).Return(expectedError)
+
+			resp, err := backendObj.EditPost(ctx, discussionID, postObj.ID, userID, postInputObj)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		Convey("when CommitTx errors out", func() {
+			expectedError := fmt.Errorf("Some Error")
+			mockDB.On("BeginTx", ctx).Return(&tx, nil)
+			mockDB.On("PutPostContent", ctx, mock.Anything, mock.Anything).Return(nil)
+			mockDB.On("EditPost", ctx, mock.Anything, mock.Anything).Return(&postObj, nil)
+			mockDB.On("PutActivity", ctx, mock.Anything, mock.Anything).Return(nil)
+			mockDB.On("CommitTx", ctx, mock.Anything).Return(expectedError)
+
+			resp, err := backendObj.EditPost(ctx, discussionID, postObj.ID, userID, postInputObj)
+
+			So(err, ShouldEqual, expectedError)
+			So(resp, ShouldBeNil)
+		})
+
+		Convey("when the post is edited successfully", func() {
+			var edited model.Post
+			mockDB.On("BeginTx", ctx).Return(&tx, nil)
+			mockDB.On("PutPostContent", ctx, mock.Anything, mock.Anything).Return(nil)
+			mockDB.On("EditPost", ctx, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+				edited = args.Get(2).(model.Post)
+			}).Return(&postObj, nil)
+			mockDB.On("PutActivity", ctx, mock.Anything, mock.Anything).Return(nil)
+			mockDB.On("CommitTx", ctx, mock.Anything).Return(nil)
+			mockDB.On("PutDiscussionEvent", ctx, mock.Anything).Return(&eventObj, nil)
+
+			resp, err := backendObj.EditPost(ctx, discussionID, postObj.ID, userID, postInputObj)
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldNotBeNil)
+			So(edited.PostContent.Content, ShouldEqual, postInputObj.PostText)
+			So(*edited.PostContentID, ShouldEqual, edited.PostContent.ID)
+
+			history, err := edited.EditHistoryAsObject()
+			So(err, ShouldBeNil)
+			So(len(history), ShouldEqual, 1)
+			So(history[0].Value, ShouldEqual, postContentObj.Content)
+			So(history[0].CreatedAt.Equal(postContentObj.CreatedAt), ShouldBeTrue)
+
+			mockDB.AssertCalled(t, "PutDiscussionEvent", ctx, mock.MatchedBy(func(event model.DiscussionEvent) bool {
+				return event.EventType == model.DiscussionSubscriptionEventTypePostEdited && event.EntityID == postObj.ID
+			}))
 		})
 	})
 }
@@ -214,6 +424,7 @@ func TestDelphisBackend_CreatePost(t *testing.T) {
 	participantID := test_utils.ParticipantID
 
 	postObj := test_utils.TestPost()
+	eventObj := test_utils.TestDiscussionEvent()
 	postInputObj := test_utils.TestPostContentInput()
 	userObj := test_utils.TestUser()
 	profile := test_utils.TestUserProfile()
@@ -232,6 +443,7 @@ func TestDelphisBackend_CreatePost(t *testing.T) {
 			db:              mockDB,
 			auth:            authObj,
 			cache:           cacheObj,
+			pubsub:          pubsub.NewInMemoryPubSub(),
 			discussionMutex: sync.Mutex{},
 			config:          config.Config{},
 			timeProvider:    &util.FrozenTime{NowTime: now},
@@ -246,8 +458,24 @@ func TestDelphisBackend_CreatePost(t *testing.T) {
 			So(resp, ShouldBeNil)
 		})
 
+		Convey("when the poll closes before the backend's current time", func() {
+			// Still in the future by the wall clock, so only the time provider rejects it
+			closesAt := now.Add(time.Hour)
+			backendObj.timeProvider = &util.FrozenTime{NowTime: now.Add(2 * time.Hour)}
+			pollPostInputObj := postInputObj
+			pollPostInputObj.PostType = model.PostTypePoll
+			pollPostInputObj.Poll = &model.PollInput{Options: []string{"yes", "no"}, ClosesAt: &closesAt}
+
+			resp, err := backendObj.CreatePost(ctx, discussionID, userID, participantID, pollPostInputObj)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+			mockDB.AssertNotCalled(t, "BeginTx", ctx)
+		})
+
 		Convey("when BeginTx errors out", func() {
 			expectedError := fmt.Errorf("Some Error")
+			mockDB.On("GetDiscussionByID", ctx, discussionID).Return(&discObj, nil)
 			mockDB.On("BeginTx", ctx).Return(nil, expectedError)
 
 			resp, err := backendObj.CreatePost(ctx, discussionID, userID, participantID, postInputObj)
@@ -258,6 +486,7 @@ func TestDelphisBackend_CreatePost(t *testing.T) {
 
 		Convey("when PutPostContent errors out and Rollback fails", func() {
 			expectedError := fmt.Errorf("Some Error")
+			mockDB.On("GetDiscussionByID", ctx, discussionID).Return(&discObj, nil)
 			mockDB.On("BeginTx", ctx).Return(&tx, nil)
 			mockDB.On("PutPostContent", ctx, mock.Anything, mock.Anything).Return(expectedError)
 			mockDB.On("RollbackTx", ctx, mock.Anything).Return(expectedError)
@@ -270,6 +499,7 @@ func TestDelphisBackend_CreatePost(t *testing.T) {
 
 		Convey("when PutPostContent errors out", func() {
 			expectedError := fmt.Errorf("Some Error")
+			mockDB.On("GetDiscussionByID", ctx, discussionID).Return(&discObj, nil)
 			mockDB.On("BeginTx", ctx).Return(&tx, nil)
 			mockDB.On("PutPostContent", ctx, mock.Anything, mock.Anything).Return(expectedError)
 			mockDB.On("RollbackTx", ctx, mock.Anything).Return(nil)
@@ -282,6 +512,7 @@ func TestDelphisBackend_CreatePost(t *testing.T) {
 
 		Convey("when PutPost errors out and Rollback fails", func() {
 			expectedError := fmt.Errorf("Some Error")
+			mockDB.On("GetDiscussionByID", ctx, discussionID).Return(&discObj, nil)
 			mockDB.On("BeginTx", ctx).Return(&tx, nil)
 			mockDB.On("PutPostContent", ctx, mock.Anything, mock.Anything).Return(nil)
 			mockDB.On("PutPost", ctx, mock.Anything, mock.Anything).Return(nil, expectedError)
@@ -295,6 +526,7 @@ func TestDelphisBackend_CreatePost(t *testing.T) {
 
 		Convey("when PutPost errors out", func() {
 			expectedError := fmt.Errorf("Some Error")
+			mockDB.On("GetDiscussionByID", ctx, discussionID).Return(&discObj, nil)
 			mockDB.On("BeginTx", ctx).Return(&tx, nil)
 			mockDB.On("PutPostContent", ctx, mock.Anything, mock.Anything).Return(nil)
 			mockDB.On("PutPost", ctx, mock.Anything, mock.Anything).Return(nil, expectedError)
@@ -308,6 +540,7 @@ func TestDelphisBackend_CreatePost(t *testing.T) {
 
 		Convey("when PutActivity errors out and we fail to commit", func() {
 			expectedError := fmt.Errorf("Some Error")
+			mockDB.On("GetDiscussionByID", ctx, discussionID).Return(&discObj, nil)
 			mockDB.On("BeginTx", ctx).Return(&tx, nil)
 			mockDB.On("PutPostContent", ctx, mock.Anything, mock.Anything).Return(nil)
 			mockDB.On("PutPost", ctx, mock.Anything, mock.Anything).Return(&postObj, nil)
@@ -320,14 +553,16 @@ func TestDelphisBackend_CreatePost(t *testing.T) {
 			So(resp, ShouldBeNil)
 		})
 
-		Convey("when GetDiscussionByID errors out", func() {
+		Convey("when GetDiscussionByID errors out after the post is committed", func() {
 			expectedError := fmt.Errorf("Some Error")
+			mockDB.On("GetDiscussionByID", ctx, discussionID).Return(&discObj, nil).Once()
 			mockDB.On("BeginTx", ctx).Return(&tx, nil)
 			mockDB.On("PutPostContent", ctx, mock.Anything, mock.Anything).Return(nil)
 			mockDB.On("PutPost", ctx, mock.Anything, mock.Anything).Return(&postObj, nil)
 			mockDB.On("PutActivity", ctx, mock.Anything, mock.Anything).Return(nil)
 			mockDB.On("CommitTx", ctx, mock.Anything).Return(nil)
 			mockDB.On("GetDiscussionByID", ctx, discussionID).Return(nil, expectedError)
+			mockDB.On("PutDiscussionEvent", ctx, mock.Anything).Return(&eventObj, nil)
 
 			resp, err := backendObj.CreatePost(ctx, discussionID, userID, participantID, postInputObj)
 
@@ -337,16 +572,17 @@ func TestDelphisBackend_CreatePost(t *testing.T) {
 
 		Convey("when GetUserDevicesByUserID errors out", func() {
 			expectedError := fmt.Errorf("Some Error")
+			mockDB.On("GetDiscussionByID", ctx, discussionID).Return(&discObj, nil)
 			mockDB.On("BeginTx", ctx).Return(&tx, nil)
 			mockDB.On("PutPostContent", ctx, mock.Anything, mock.Anything).Return(nil)
 			mockDB.On("PutPost", ctx, mock.Anything, mock.Anything).Return(&postObj, nil)
 			mockDB.On("PutActivity", ctx, mock.Anything, mock.Anything).Return(nil)
 			mockDB.On("CommitTx", ctx, mock.Anything).Return(nil)
-			mockDB.On("GetDiscussionByID", ctx, discussionID).Return(&discObj, nil)
 			mockDB.On("UpsertDiscussion", ctx, mock.Anything).Return(&discObj, nil)
 			mockDB.On("GetDUAForEverythingNotifications", ctx, discussionID, userID).Return(nil)
 			mockDB.On("DuaIterCollect", ctx, mock.Anything).Return(nil, nil)
 			mockDB.On("GetUserDevicesByUserID", ctx, mock.Anything).Return(nil, expectedError)
+			mockDB.On("PutDiscussionEvent", ctx, mock.Anything).Return(&eventObj, nil)
 
 			resp, err := backendObj.CreatePost(ctx, discussionID, userID, participantID, postInputObj)
 
@@ -355,22 +591,82 @@ func TestDelphisBackend_CreatePost(t *testing.T) {
 		})
 
 		Convey("when post succeeds", func() {
+			mockDB.On("GetDiscussionByID", ctx, discussionID).Return(&discObj, nil)
 			mockDB.On("BeginTx", ctx).Return(&tx, nil)
 			mockDB.On("PutPostContent", ctx, mock.Anything, mock.Anything).Return(nil)
 			mockDB.On("PutPost", ctx, mock.Anything, mock.Anything).Return(&postObj, nil)
 			mockDB.On("PutActivity", ctx, mock.Anything, mock.Anything).Return(nil)
 			mockDB.On("CommitTx", ctx, mock.Anything).Return(nil)
-			mockDB.On("GetDiscussionByID", ctx, discussionID).Return(&discObj, nil)
 			mockDB.On("UpsertDiscussion", ctx, mock.Anything).Return(&discObj, nil)
 			mockDB.On("GetDUAForEverythingNotifications", ctx, discussionID, userID).Return(nil)
 			mockDB.On("DuaIterCollect", ctx, mock.Anything).Return(nil, nil)
 			mockDB.On("GetUserDevicesByUserID", ctx, mock.Anything).Return(nil, nil)
+			mockDB.On("PutDiscussionEvent", ctx, mock.Anything).Return(&eventObj, nil)
 
 			resp, err := backendObj.CreatePost(ctx, discussionID, userID, participantID, postInputObj)
 
 			So(err, ShouldBeNil)
 			So(resp, ShouldNotBeNil)
 		})
+
+		Convey("when the parent post is not found", func() {
+			parentPostID := "parent_post_id"
+			replyInputObj := postInputObj
+			replyInputObj.ParentPostID = &parentPostID
+			mockDB.On("GetPostByID", ctx, parentPostID).Return(nil, nil)
+
+			resp, err := backendObj.CreatePost(ctx, discussionID, userID, participantID, replyInputObj)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		Convey("when replying to a reply", func() {
+			rootPostID := "root_post_id"
+			rootParticipantID := "root_participant_id"
+			rootAuthorID := "root_author_id"
+			rootPost := test_utils.TestPost()
+			rootPost.ID = rootPostID
+			rootPost.ParticipantID = &rootParticipantID
+			replyParticipantID := "reply_participant_id"
+			replyAuthorID := "reply_author_id"
+			replyPost := test_utils.TestPost()
+			replyPost.ID = "reply_post_id"
+			replyPost.ParentPostID = &rootPostID
+			replyPost.ParticipantID = &replyParticipantID
+			replyAuthor := test_utils.TestParticipant()
+			replyAuthor.UserID = &replyAuthorID
+
+			replyInputObj := postInputObj
+			replyInputObj.ParentPostID = &replyPost.ID
+
+			var putPost model.Post
+			mockDB.On("GetPostByID", ctx, replyPost.ID).Return(&replyPost, nil)
+			mockDB.On("GetPostByID", ctx, rootPostID).Return(&rootPost, nil)
+			mockDB.On("GetDiscussionByID", ctx, discussionID).Return(&discObj, nil)
+			mockDB.On("BeginTx", ctx).Return(&tx, nil)
+			mockDB.On("PutPostContent", ctx, mock.Anything, mock.Anything).Return(nil)
+			mockDB.On("PutPost", ctx, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+				putPost = args.Get(2).(model.Post)
+			}).Return(&postObj, nil)
+			mockDB.On("PutActivity", ctx, mock.Anything, mock.Anything).Return(nil)
+			mockDB.On("CommitTx", ctx, mock.Anything).Return(nil)
+			mockDB.On("UpsertDiscussion", ctx, mock.Anything).Return(&discObj, nil)
+			mockDB.On("GetDUAForEverythingNotifications", ctx, discussionID, userID).Return(nil)
+			mockDB.On("GetParticipantsByIDs", ctx, []string{replyParticipantID}).Return(map[string]*model.Participant{replyParticipantID: &replyAuthor}, nil)
+			mockDB.On("GetDUAForMentionNotifications", ctx, discussionID, userID, []string{replyAuthorID}).Return(nil)
+			mockDB.On("DuaIterCollect", ctx, mock.Anything).Return(nil, nil)
+			mockDB.On("PutDiscussionEvent", ctx, mock.Anything).Return(&eventObj, nil)
+
+			resp, err := backendObj.CreatePost(ctx, discussionID, userID, participantID, replyInputObj)
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldNotBeNil)
+			So(*putPost.ParentPostID, ShouldEqual, rootPostID)
+			// The author of the reply being replied to is notified, not the thread starter
+			mockDB.AssertCalled(t, "GetDUAForMentionNotifications", ctx, discussionID, userID, []string{replyAuthorID})
+			mockDB.AssertNotCalled(t, "GetDUAForMentionNotifications", ctx, discussionID, userID, []string{rootAuthorID})
+		})
 	})
 }
 
@@ -381,6 +677,7 @@ func TestDelphisBackend_CreateAlertPost(t *testing.T) {
 	participantID := test_utils.ParticipantID
 
 	postObj := test_utils.TestPost()
+	eventObj := test_utils.TestDiscussionEvent()
 	userObj := test_utils.TestUser()
 	modObj := test_utils.TestModerator()
 	profile := test_utils.TestUserProfile()
@@ -401,6 +698,7 @@ func TestDelphisBackend_CreateAlertPost(t *testing.T) {
 			db:              mockDB,
 			auth:            authObj,
 			cache:           cacheObj,
+			pubsub:          pubsub.NewInMemoryPubSub(),
 			discussionMutex: sync.Mutex{},
 			config:          config.Config{},
 			timeProvider:    &util.FrozenTime{NowTime: now},
@@ -461,6 +759,7 @@ func TestDelphisBackend_CreateAlertPost(t *testing.T) {
 			mockDB.On("GetDUAForEverythingNotifications", ctx, discussionID, mock.Anything).Return(nil)
 			mockDB.On("DuaIterCollect", ctx, mock.Anything).Return(nil, nil)
 			mockDB.On("GetUserDevicesByUserID", ctx, mock.Anything).Return(nil, nil)
+			mockDB.On("PutDiscussionEvent", ctx, mock.Anything).Return(&eventObj, nil)
 
 			resp, err := backendObj.CreateWelcomeAlertPost(ctx, discussionID, participantID, &userObj, false)
 
@@ -476,6 +775,7 @@ func TestDelphisBackend_CreateShuffleAlertPost(t *testing.T) {
 	discussionID := test_utils.DiscussionID
 
 	postObj := test_utils.TestPost()
+	eventObj := test_utils.TestDiscussionEvent()
 	userObj := test_utils.TestUser()
 	modObj := test_utils.TestModerator()
 	profile := test_utils.TestUserProfile()
@@ -496,6 +796,7 @@ func TestDelphisBackend_CreateShuffleAlertPost(t *testing.T) {
 			db:              mockDB,
 			auth:            authObj,
 			cache:           cacheObj,
+			pubsub:          pubsub.NewInMemoryPubSub(),
 			discussionMutex: sync.Mutex{},
 			config:          config.Config{},
 			timeProvider:    &util.FrozenTime{NowTime: now},
@@ -534,6 +835,7 @@ func TestDelphisBackend_CreateShuffleAlertPost(t *testing.T) {
 			mockDB.On("GetDUAForEverythingNotifications", ctx, discussionID, mock.Anything).Return(nil)
 			mockDB.On("DuaIterCollect", ctx, mock.Anything).Return(nil, nil)
 			mockDB.On("GetUserDevicesByUserID", ctx, mock.Anything).Return(nil, nil)
+			mockDB.On("PutDiscussionEvent", ctx, mock.Anything).Return(&eventObj, nil)
 
 			resp, err := backendObj.CreateShuffleAlertPost(ctx, discussionID)
 
@@ -543,6 +845,82 @@ func TestDelphisBackend_CreateShuffleAlertPost(t *testing.T) {
 	})
 }
 
+func TestDelphisBackend_CreateOwnershipTransferAlertPost(t *testing.T) {
+	ctx := context.Background()
+
+	discussionID := test_utils.DiscussionID
+
+	postObj := test_utils.TestPost()
+	eventObj := test_utils.TestDiscussionEvent()
+	userObj := test_utils.TestUser()
+	modObj := test_utils.TestModerator()
+	profile := test_utils.TestUserProfile()
+	discObj := test_utils.TestDiscussion()
+	parObj := test_utils.TestParticipant()
+
+	userObj.UserProfile = &profile
+	modObj.UserProfile = &profile
+
+	tx := sql.Tx{}
+
+	Convey("CreateOwnershipTransferAlertPost", t, func() {
+		now := time.Now()
+		cacheObj := cache.NewInMemoryCache()
+		authObj := auth.NewDelphisAuth(nil)
+		mockDB := &mocks.Datastore{}
+		backendObj := &delphisBackend{
+			db:              mockDB,
+			auth:            authObj,
+			cache:           cacheObj,
+			pubsub:          pubsub.NewInMemoryPubSub(),
+			discussionMutex: sync.Mutex{},
+			config:          config.Config{},
+			timeProvider:    &util.FrozenTime{NowTime: now},
+		}
+
+		Convey("when GetParticipantsByDiscussionIDUserID errors out", func() {
+			expectedError := fmt.Errorf("Some error")
+			mockDB.On("GetParticipantsByDiscussionIDUserID", ctx, discussionID, mock.Anything).Return(nil, expectedError)
+
+			resp, err := backendObj.CreateOwnershipTransferAlertPost(ctx, discussionID, &userObj)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		Convey("when GetParticipantsByDiscussionIDUserID returns nil", func() {
+			mockDB.On("GetParticipantsByDiscussionIDUserID", ctx, discussionID, mock.Anything).Return(nil, nil)
+
+			resp, err := backendObj.CreateOwnershipTransferAlertPost(ctx, discussionID, &userObj)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		Convey("when the alert post is created successfully", func() {
+			mockDB.On("GetParticipantsByDiscussionIDUserID", ctx, discussionID, mock.Anything).Return([]model.Participant{parObj}, nil)
+
+			// Create post functions
+			mockDB.On("BeginTx", ctx).Return(&tx, nil)
+			mockDB.On("PutPostContent", ctx, mock.Anything, mock.Anything).Return(nil)
+			mockDB.On("PutPost", ctx, mock.Anything, mock.Anything).Return(&postObj, nil)
+			mockDB.On("PutActivity", ctx, mock.Anything, mock.Anything).Return(nil)
+			mockDB.On("CommitTx", ctx, mock.Anything).Return(nil)
+			mockDB.On("GetDiscussionByID", ctx, discussionID).Return(&discObj, nil)
+			mockDB.On("UpsertDiscussion", ctx, mock.Anything).Return(&discObj, nil)
+			mockDB.On("GetDUAForEverythingNotifications", ctx, discussionID, mock.Anything).Return(nil)
+			mockDB.On("DuaIterCollect", ctx, mock.Anything).Return(nil, nil)
+			mockDB.On("GetUserDevicesByUserID", ctx, mock.Anything).Return(nil, nil)
+			mockDB.On("PutDiscussionEvent", ctx, mock.Anything).Return(&eventObj, nil)
+
+			resp, err := backendObj.CreateOwnershipTransferAlertPost(ctx, discussionID, &userObj)
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldNotBeNil)
+		})
+	})
+}
+
 func TestDelphisBackend_GetPostsByDiscussionID(t *testing.T) {
 	ctx := context.Background()
 	discussionID := test_utils.DiscussionID
@@ -693,7 +1071,7 @@ func TestDelphisBackend_GetPostsConnectionByDiscussionID(t *testing.T) {
 		}
 
 		Convey("when limit is less than 2", func() {
-			resp, err := backendObj.GetPostsConnectionByDiscussionID(ctx, discussionID, cursor, 0)
+			resp, err := backendObj.GetPostsConnectionByDiscussionID(ctx, discussionID, cursor, 0, false)
 
 			So(err, ShouldNotBeNil)
 			So(resp, ShouldBeNil)
@@ -701,18 +1079,18 @@ func TestDelphisBackend_GetPostsConnectionByDiscussionID(t *testing.T) {
 
 		Convey("when GetPostsConnectionByDiscussionID errors out", func() {
 			expectedError := fmt.Errorf("Some Error")
-			mockDB.On("GetPostsConnectionByDiscussionID", ctx, discussionID, cursor, limit).Return(nil, expectedError)
+			mockDB.On("GetPostsConnectionByDiscussionID", ctx, discussionID, cursor, limit, false).Return(nil, expectedError)
 
-			resp, err := backendObj.GetPostsConnectionByDiscussionID(ctx, discussionID, cursor, limit)
+			resp, err := backendObj.GetPostsConnectionByDiscussionID(ctx, discussionID, cursor, limit, false)
 
 			So(err, ShouldEqual, expectedError)
 			So(resp, ShouldBeNil)
 		})
 
 		Convey("when the cursor can not be parsed", func() {
-			mockDB.On("GetPostsConnectionByDiscussionID", ctx, discussionID, mock.Anything, limit).Return(&postConnObj, nil)
+			mockDB.On("GetPostsConnectionByDiscussionID", ctx, discussionID, mock.Anything, limit, false).Return(&postConnObj, nil)
 
-			resp, err := backendObj.GetPostsConnectionByDiscussionID(ctx, discussionID, "bad cursor", limit)
+			resp, err := backendObj.GetPostsConnectionByDiscussionID(ctx, discussionID, "bad cursor", limit, false)
 
 			So(err, ShouldBeNil)
 			So(resp, ShouldNotBeNil)
@@ -720,6 +1098,173 @@ func TestDelphisBackend_GetPostsConnectionByDiscussionID(t *testing.T) {
 	})
 }
 
+func TestDelphisBackend_GetPostsConnectionByDiscussionIDBeforeCursor(t *testing.T) {
+	ctx := context.Background()
+	discussionID := test_utils.DiscussionID
+	limit := test_utils.Limit
+	cursor := time.Now().Add(-10 * time.Minute).Format(time.RFC3339Nano)
+
+	postConnObj := test_utils.TestPostsConnection(cursor)
+
+	Convey("GetPostsConnectionByDiscussionIDBeforeCursor", t, func() {
+		now := time.Now()
+		cacheObj := cache.NewInMemoryCache()
+		authObj := auth.NewDelphisAuth(nil)
+		mockDB := &mocks.Datastore{}
+		backendObj := &delphisBackend{
+			db:              mockDB,
+			auth:            authObj,
+			cache:           cacheObj,
+			discussionMutex: sync.Mutex{},
+			config:          config.Config{},
+			timeProvider:    &util.FrozenTime{NowTime: now},
+		}
+
+		Convey("when limit is more than the page limit", func() {
+			resp, err := backendObj.GetPostsConnectionByDiscussionIDBeforeCursor(ctx, discussionID, cursor, PostPerPageLimit+1, false)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		Convey("when GetPostsConnectionByDiscussionIDBeforeCursor errors out", func() {
+			expectedError := fmt.Errorf("Some Error")
+			mockDB.On("GetPostsConnectionByDiscussionIDBeforeCursor", ctx, discussionID, cursor, limit, true).Return(nil, expectedError)
+
+			resp, err := backendObj.GetPostsConnectionByDiscussionIDBeforeCursor(ctx, discussionID, cursor, limit, true)
+
+			So(err, ShouldEqual, expectedError)
+			So(resp, ShouldBeNil)
+		})
+
+		Convey("when the connection is returned", func() {
+			mockDB.On("GetPostsConnectionByDiscussionIDBeforeCursor", ctx, discussionID, cursor, limit, false).Return(&postConnObj, nil)
+
+			resp, err := backendObj.GetPostsConnectionByDiscussionIDBeforeCursor(ctx, discussionID, cursor, limit, false)
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldResemble, &postConnObj)
+		})
+	})
+}
+
+func TestDelphisBackend_GetPostsConnectionAroundPost(t *testing.T) {
+	ctx := context.Background()
+	discussionID := test_utils.DiscussionID
+	cursor := time.Now().Format(time.RFC3339Nano)
+
+	postConnObj := test_utils.TestPostsConnection(cursor)
+	postObject := test_utils.TestPost()
+
+	Convey("GetPostsConnectionAroundPost", t, func() {
+		now := time.Now()
+		cacheObj := cache.NewInMemoryCache()
+		authObj := auth.NewDelphisAuth(nil)
+		mockDB := &mocks.Datastore{}
+		backendObj := &delphisBackend{
+			db:              mockDB,
+			auth:            authObj,
+			cache:           cacheObj,
+			discussionMutex: sync.Mutex{},
+			config:          config.Config{},
+			timeProvider:    &util.FrozenTime{NowTime: now},
+		}
+
+		Convey("when a limit is less than 1", func() {
+			resp, err := backendObj.GetPostsConnectionAroundPost(ctx, discussionID, postObject.ID, 0, 10, false)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		Convey("when the limits add up to more than the page limit", func() {
+			resp, err := backendObj.GetPostsConnectionAroundPost(ctx, discussionID, postObject.ID, PostPerPageLimit, 1, false)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		Convey("when GetPostByID errors out", func() {
+			expectedError := fmt.Errorf("Some Error")
+			mockDB.On("GetPostByID", ctx, postObject.ID).Return(nil, expectedError)
+
+			resp, err := backendObj.GetPostsConnectionAroundPost(ctx, discussionID, postObject.ID, 10, 10, false)
+
+			So(err, ShouldEqual, expectedError)
+			So(resp, ShouldBeNil)
+		})
+
+		Convey("when the anchor post is in another discussion", func() {
+			mockDB.On("GetPostByID", ctx, postObject.ID).Return(&postObject, nil)
+
+			resp, err := backendObj.GetPostsConnectionAroundPost(ctx, "other_discussion_id", postObject.ID, 10, 10, false)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		Convey("when the connection is returned", func() {
+			mockDB.On("GetPostByID", ctx, postObject.ID).Return(&postObject, nil)
+			mockDB.On("GetPostsConnectionAroundPost", ctx, postObject, 10, 15, false).Return(&postConnObj, nil)
+
+			resp, err := backendObj.GetPostsConnectionAroundPost(ctx, discussionID, postObject.ID, 10, 15, false)
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldResemble, &postConnObj)
+		})
+	})
+}
+
+func TestDelphisBackend_GetPostRepliesConnection(t *testing.T) {
+	ctx := context.Background()
+	postID := test_utils.PostID
+	limit := test_utils.Limit
+	cursor := time.Now().Add(10 * time.Minute).Format(time.RFC3339Nano)
+
+	postConnObj := test_utils.TestPostsConnection(cursor)
+
+	Convey("GetPostRepliesConnection", t, func() {
+		now := time.Now()
+		cacheObj := cache.NewInMemoryCache()
+		authObj := auth.NewDelphisAuth(nil)
+		mockDB := &mocks.Datastore{}
+		backendObj := &delphisBackend{
+			db:              mockDB,
+			auth:            authObj,
+			cache:           cacheObj,
+			discussionMutex: sync.Mutex{},
+			config:          config.Config{},
+			timeProvider:    &util.FrozenTime{NowTime: now},
+		}
+
+		Convey("when limit is less than 2", func() {
+			resp, err := backendObj.GetPostRepliesConnection(ctx, postID, cursor, 0)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		Convey("when GetPostRepliesConnection errors out", func() {
+			expectedError := fmt.Errorf("Some Error")
+			mockDB.On("GetPostRepliesConnection", ctx, postID, cursor, limit).Return(nil, expectedError)
+
+			resp, err := backendObj.GetPostRepliesConnection(ctx, postID, cursor, limit)
+
+			So(err, ShouldEqual, expectedError)
+			So(resp, ShouldBeNil)
+		})
+
+		Convey("when GetPostRepliesConnection succeeds", func() {
+			mockDB.On("GetPostRepliesConnection", ctx, postID, cursor, limit).Return(&postConnObj, nil)
+
+			resp, err := backendObj.GetPostRepliesConnection(ctx, postID, cursor, limit)
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldResemble, &postConnObj)
+		})
+	})
+}
+
 func TestDelphisBackend_GetMentionedEntities(t *testing.T) {
 	ctx := context.Background()
 	discussionID := test_utils.DiscussionID
@@ -799,5 +1344,15 @@ func TestDelphisBackend_GetMentionedEntities(t *testing.T) {
 			So(err, ShouldBeNil)
 			So(resp, ShouldResemble, entityMap)
 		})
+
+		Convey("when only tags are mentioned", func() {
+			resp, err := backendObj.GetMentionedEntities(ctx, []string{"tag:golang"})
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldResemble, map[string]model.Entity{
+				"tag:golang": &model.Tag{ID: "golang", Name: "golang"},
+			})
+			mockDB.AssertNotCalled(t, "GetParticipantsByIDs", mock.Anything, mock.Anything)
+		})
 	})
 }
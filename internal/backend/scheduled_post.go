@@ -0,0 +1,144 @@
+package backend
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/delphis-inc/delphisbe/graph/model"
+	"github.com/delphis-inc/delphisbe/internal/util"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// How far ahead a post may be scheduled.
+	maxSchedulePostAhead = 90 * 24 * time.Hour
+	// Matches the failure_reason column.
+	maxScheduledPostFailureReasonLength = 256
+)
+
+func (d *delphisBackend) SchedulePost(ctx context.Context, userID string, discussionID string, participantID string, input model.PostContentInput, publishAt time.Time) (*model.ScheduledPost, error) {
+	now := d.timeProvider.Now()
+	if !publishAt.After(now) {
+		return nil, fmt.Errorf("publishAt must be in the future")
+	}
+	if publishAt.Sub(now) > maxSchedulePostAhead {
+		return nil, fmt.Errorf("Posts can be scheduled at most %d days ahead", int(maxSchedulePostAhead.Hours()/24))
+	}
+
+	// Validate now so a bad post is not only caught at publish time
+	if err := validatePostParams(ctx, input); err != nil {
+		logrus.WithError(err).Error("failed to validate post params")
+		return nil, err
+	}
+	if err := validatePollInput(input, publishAt); err != nil {
+		logrus.WithError(err).Error("failed to validate poll")
+		return nil, err
+	}
+
+	postText, mentionedEntities, err := normalizeMentions(input.PostText, input.MentionedEntities)
+	if err != nil {
+		logrus.WithError(err).Error("failed to normalize mentions")
+		return nil, err
+	}
+	input.PostText, input.MentionedEntities = postText, mentionedEntities
+	if err := d.resolveMentionedEntities(ctx, discussionID, userID, input.MentionedEntities); err != nil {
+		logrus.WithError(err).Error("failed to resolve mentioned entities")
+		return nil, err
+	}
+
+	participant, err := d.GetPostingParticipant(ctx, discussionID, participantID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return d.db.PutScheduledPost(ctx, model.ScheduledPost{
+		ID:            util.UUIDv4(),
+		DiscussionID:  discussionID,
+		ParticipantID: participant.ID,
+		UserID:        userID,
+		PostContent:   input,
+		PublishAt:     publishAt,
+	})
+}
+
+func (d *delphisBackend) CancelScheduledPost(ctx context.Context, scheduledPostID string) (*model.ScheduledPost, error) {
+	scheduledPost, err := d.db.CancelScheduledPost(ctx, scheduledPostID)
+	if err != nil {
+		logrus.WithError(err).Error("failed to cancel scheduled post")
+		return nil, err
+	}
+	if scheduledPost == nil {
+		return nil, fmt.Errorf("Scheduled post is no longer pending")
+	}
+
+	return scheduledPost, nil
+}
+
+func (d *delphisBackend) GetScheduledPostByID(ctx context.Context, id string) (*model.ScheduledPost, error) {
+	return d.db.GetScheduledPostByID(ctx, id)
+}
+
+func (d *delphisBackend) GetPendingScheduledPostsByDiscussionID(ctx context.Context, discussionID string) ([]*model.ScheduledPost, error) {
+	return d.db.GetPendingScheduledPostsByDiscussionID(ctx, discussionID)
+}
+
+// Runs from the same cron as shuffling. A scheduled post that cannot be
+// published, e.g. because its author was banned, is marked FAILED rather than
+// retried.
+//
+// Each due post is claimed, and later marked, in its own statement so one
+// failure cannot roll back the others. A post is claimed before it is
+// published, so a crash in between leaves it PUBLISHING instead of publishing
+// it again on the next run.
+func (d *delphisBackend) PublishScheduledPostsIfNecessary() {
+	ctx := context.Background()
+	now := d.timeProvider.Now()
+
+	for {
+		scheduledPost, err := d.db.ClaimScheduledPostDueBeforeTime(ctx, now)
+		if err != nil {
+			logrus.WithError(err).Error("failed to claim scheduled post to publish")
+			return
+		}
+		if scheduledPost == nil {
+			return
+		}
+
+		status := model.ScheduledPostStatusPublished
+		var postID, failureReason *string
+
+		post, err := d.publishScheduledPost(ctx, *scheduledPost)
+		if err != nil {
+			logrus.WithError(err).Warnf("failed to publish scheduled post %s", scheduledPost.ID)
+			status = model.ScheduledPostStatusFailed
+			reason := err.Error()
+			if len(reason) > maxScheduledPostFailureReasonLength {
+				reason = reason[:maxScheduledPostFailureReasonLength]
+			}
+			failureReason = &reason
+		} else {
+			postID = &post.ID
+		}
+
+		if _, err := d.db.UpdateScheduledPostStatus(ctx, scheduledPost.ID, model.ScheduledPostStatusPublishing, status, postID, failureReason); err != nil {
+			// The post stays PUBLISHING and is not retried. Move on to the others
+			logrus.WithError(err).Errorf("failed to update status of scheduled post %s", scheduledPost.ID)
+		}
+	}
+}
+
+// The author must still be a moderator and able to post when it is published.
+func (d *delphisBackend) publishScheduledPost(ctx context.Context, scheduledPost model.ScheduledPost) (*model.Post, error) {
+	modCheck, err := d.CheckIfModeratorForDiscussion(ctx, scheduledPost.UserID, scheduledPost.DiscussionID)
+	if err != nil || !modCheck {
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	participant, err := d.GetPostingParticipant(ctx, scheduledPost.DiscussionID, scheduledPost.ParticipantID, scheduledPost.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	return d.CreatePost(ctx, scheduledPost.DiscussionID, scheduledPost.UserID, participant.ID, scheduledPost.PostContent)
+}
@@ -0,0 +1,367 @@
+package backend
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/delphis-inc/delphisbe/graph/model"
+	"github.com/delphis-inc/delphisbe/internal/auth"
+	"github.com/delphis-inc/delphisbe/internal/backend/test_utils"
+	"github.com/delphis-inc/delphisbe/internal/cache"
+	"github.com/delphis-inc/delphisbe/internal/config"
+	"github.com/delphis-inc/delphisbe/internal/pubsub"
+	"github.com/delphis-inc/delphisbe/internal/util"
+	"github.com/delphis-inc/delphisbe/mocks"
+	. "github.com/smartystreets/goconvey/convey"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestDelphisBackend_GetPostingParticipant(t *testing.T) {
+	ctx := context.Background()
+
+	userID := test_utils.UserID
+	discussionID := test_utils.DiscussionID
+	participantID := test_utils.ParticipantID
+
+	Convey("GetPostingParticipant", t, func() {
+		now := time.Now()
+		cacheObj := cache.NewInMemoryCache()
+		authObj := auth.NewDelphisAuth(nil)
+		mockDB := &mocks.Datastore{}
+		backendObj := &delphisBackend{
+			db:              mockDB,
+			auth:            authObj,
+			cache:           cacheObj,
+			pubsub:          pubsub.NewInMemoryPubSub(),
+			discussionMutex: sync.Mutex{},
+			config:          config.Config{},
+			timeProvider:    &util.FrozenTime{NowTime: now},
+		}
+
+		discObj := test_utils.TestDiscussion()
+		participantObj := test_utils.TestParticipant()
+
+		Convey("when the discussion is locked", func() {
+			discObj.LockStatus = true
+			mockDB.On("GetDiscussionByID", ctx, discussionID).Return(&discObj, nil)
+
+			resp, err := backendObj.GetPostingParticipant(ctx, discussionID, participantID, userID)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		mockDB.On("GetDiscussionByID", ctx, discussionID).Return(&discObj, nil)
+
+		Convey("when the participant is not found", func() {
+			mockDB.On("GetParticipantByID", ctx, participantID).Return(nil, nil)
+
+			resp, err := backendObj.GetPostingParticipant(ctx, discussionID, participantID, userID)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		Convey("when the participant is banned", func() {
+			participantObj.IsBanned = true
+			mockDB.On("GetParticipantByID", ctx, participantID).Return(&participantObj, nil)
+
+			resp, err := backendObj.GetPostingParticipant(ctx, discussionID, participantID, userID)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		Convey("when the participant is muted", func() {
+			mutedUntil := time.Now().Add(time.Hour)
+			participantObj.MutedUntil = &mutedUntil
+			mockDB.On("GetParticipantByID", ctx, participantID).Return(&participantObj, nil)
+
+			resp, err := backendObj.GetPostingParticipant(ctx, discussionID, participantID, userID)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		Convey("when the participant belongs to another user", func() {
+			mockDB.On("GetParticipantByID", ctx, participantID).Return(&participantObj, nil)
+
+			resp, err := backendObj.GetPostingParticipant(ctx, discussionID, participantID, "otherUserID")
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		Convey("when the participant can post", func() {
+			mockDB.On("GetParticipantByID", ctx, participantID).Return(&participantObj, nil)
+
+			resp, err := backendObj.GetPostingParticipant(ctx, discussionID, participantID, userID)
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldResemble, &participantObj)
+		})
+
+		Convey("when the discussion is in slow mode", func() {
+			discObj.SlowModeSeconds = 60
+			mockDB.On("GetParticipantByID", ctx, participantID).Return(&participantObj, nil)
+			mockDB.On("GetModeratorByUserIDAndDiscussionID", ctx, userID, discussionID).Return(nil, nil)
+			mockDB.On("GetModeratorRoleByUserIDAndDiscussionID", ctx, userID, discussionID).Return(nil, nil)
+
+			Convey("when the user has to wait", func() {
+				lastPost := now.Add(-15 * time.Second)
+				mockDB.On("GetLastPostCreatedAtByDiscussionUserID", ctx, discussionID, userID).Return(&lastPost, nil)
+
+				resp, err := backendObj.GetPostingParticipant(ctx, discussionID, participantID, userID)
+
+				So(err, ShouldResemble, &SlowModeError{SecondsRemaining: 45})
+				So(err.(*SlowModeError).Extensions()["secondsRemaining"], ShouldEqual, 45)
+				So(resp, ShouldBeNil)
+			})
+
+			Convey("when the user can post again", func() {
+				lastPost := now.Add(-time.Minute)
+				mockDB.On("GetLastPostCreatedAtByDiscussionUserID", ctx, discussionID, userID).Return(&lastPost, nil)
+
+				resp, err := backendObj.GetPostingParticipant(ctx, discussionID, participantID, userID)
+
+				So(err, ShouldBeNil)
+				So(resp, ShouldResemble, &participantObj)
+			})
+		})
+	})
+}
+
+func TestDelphisBackend_SchedulePost(t *testing.T) {
+	ctx := context.Background()
+
+	userID := test_utils.UserID
+	discussionID := test_utils.DiscussionID
+	participantID := test_utils.ParticipantID
+
+	Convey("SchedulePost", t, func() {
+		now := time.Now()
+		cacheObj := cache.NewInMemoryCache()
+		authObj := auth.NewDelphisAuth(nil)
+		mockDB := &mocks.Datastore{}
+		backendObj := &delphisBackend{
+			db:              mockDB,
+			auth:            authObj,
+			cache:           cacheObj,
+			pubsub:          pubsub.NewInMemoryPubSub(),
+			discussionMutex: sync.Mutex{},
+			config:          config.Config{},
+			timeProvider:    &util.FrozenTime{NowTime: now},
+		}
+
+		discObj := test_utils.TestDiscussion()
+		participantObj := test_utils.TestParticipant()
+		inputObj := test_utils.TestPostContentInput()
+		publishAt := now.Add(time.Hour)
+
+		Convey("when publishAt is in the past", func() {
+			resp, err := backendObj.SchedulePost(ctx, userID, discussionID, participantID, inputObj, now.Add(-time.Hour))
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		Convey("when publishAt is too far ahead", func() {
+			resp, err := backendObj.SchedulePost(ctx, userID, discussionID, participantID, inputObj, now.Add(maxSchedulePostAhead+time.Hour))
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		Convey("when the post params are invalid", func() {
+			inputObj.PostType = ""
+
+			resp, err := backendObj.SchedulePost(ctx, userID, discussionID, participantID, inputObj, publishAt)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		Convey("when the participant cannot post", func() {
+			participantObj.IsBanned = true
+			mockDB.On("GetDiscussionByID", ctx, discussionID).Return(&discObj, nil)
+			mockDB.On("GetParticipantByID", ctx, participantID).Return(&participantObj, nil)
+
+			resp, err := backendObj.SchedulePost(ctx, userID, discussionID, participantID, inputObj, publishAt)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		Convey("when the post is scheduled", func() {
+			mockDB.On("GetDiscussionByID", ctx, discussionID).Return(&discObj, nil)
+			mockDB.On("GetParticipantByID", ctx, participantID).Return(&participantObj, nil)
+			mockDB.On("PutScheduledPost", ctx, mock.Anything).Return(&model.ScheduledPost{ID: "scheduledPostID"}, nil)
+
+			resp, err := backendObj.SchedulePost(ctx, userID, discussionID, participantID, inputObj, publishAt)
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldResemble, &model.ScheduledPost{ID: "scheduledPostID"})
+			mockDB.AssertCalled(t, "PutScheduledPost", ctx, mock.MatchedBy(func(scheduledPost model.ScheduledPost) bool {
+				return scheduledPost.DiscussionID == discussionID && scheduledPost.ParticipantID == participantID &&
+					scheduledPost.UserID == userID && scheduledPost.PublishAt == publishAt && reflect.DeepEqual(scheduledPost.PostContent, inputObj)
+			}))
+		})
+	})
+}
+
+func TestDelphisBackend_CancelScheduledPost(t *testing.T) {
+	ctx := context.Background()
+
+	scheduledPostID := "scheduledPostID"
+
+	Convey("CancelScheduledPost", t, func() {
+		now := time.Now()
+		cacheObj := cache.NewInMemoryCache()
+		authObj := auth.NewDelphisAuth(nil)
+		mockDB := &mocks.Datastore{}
+		backendObj := &delphisBackend{
+			db:              mockDB,
+			auth:            authObj,
+			cache:           cacheObj,
+			pubsub:          pubsub.NewInMemoryPubSub(),
+			discussionMutex: sync.Mutex{},
+			config:          config.Config{},
+			timeProvider:    &util.FrozenTime{NowTime: now},
+		}
+
+		Convey("when CancelScheduledPost errors out", func() {
+			mockDB.On("CancelScheduledPost", ctx, scheduledPostID).Return(nil, fmt.Errorf("sth"))
+
+			resp, err := backendObj.CancelScheduledPost(ctx, scheduledPostID)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		Convey("when the scheduled post is no longer pending", func() {
+			mockDB.On("CancelScheduledPost", ctx, scheduledPostID).Return(nil, nil)
+
+			resp, err := backendObj.CancelScheduledPost(ctx, scheduledPostID)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		Convey("when the scheduled post is cancelled", func() {
+			scheduledPostObj := model.ScheduledPost{ID: scheduledPostID, Status: model.ScheduledPostStatusCancelled}
+			mockDB.On("CancelScheduledPost", ctx, scheduledPostID).Return(&scheduledPostObj, nil)
+
+			resp, err := backendObj.CancelScheduledPost(ctx, scheduledPostID)
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldResemble, &scheduledPostObj)
+		})
+	})
+}
+
+func TestDelphisBackend_PublishScheduledPostsIfNecessary(t *testing.T) {
+	ctx := context.Background()
+
+	userID := test_utils.UserID
+	discussionID := test_utils.DiscussionID
+	participantID := test_utils.ParticipantID
+
+	Convey("PublishScheduledPostsIfNecessary", t, func() {
+		now := time.Now()
+		cacheObj := cache.NewInMemoryCache()
+		authObj := auth.NewDelphisAuth(nil)
+		mockDB := &mocks.Datastore{}
+		backendObj := &delphisBackend{
+			db:              mockDB,
+			auth:            authObj,
+			cache:           cacheObj,
+			pubsub:          pubsub.NewInMemoryPubSub(),
+			discussionMutex: sync.Mutex{},
+			config:          config.Config{},
+			timeProvider:    &util.FrozenTime{NowTime: now},
+		}
+
+		modObj := test_utils.TestModerator()
+		discObj := test_utils.TestDiscussion()
+		participantObj := test_utils.TestParticipant()
+		scheduledPostObj := model.ScheduledPost{
+			ID:            "scheduledPostID",
+			DiscussionID:  discussionID,
+			ParticipantID: participantID,
+			UserID:        userID,
+			PostContent:   test_utils.TestPostContentInput(),
+			PublishAt:     now,
+			Status:        model.ScheduledPostStatusPending,
+		}
+
+		Convey("when ClaimScheduledPostDueBeforeTime errors out", func() {
+			mockDB.On("ClaimScheduledPostDueBeforeTime", ctx, now).Return(nil, fmt.Errorf("sth"))
+
+			backendObj.PublishScheduledPostsIfNecessary()
+
+			mockDB.AssertNotCalled(t, "UpdateScheduledPostStatus", ctx, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		})
+
+		Convey("when no scheduled post is due", func() {
+			mockDB.On("ClaimScheduledPostDueBeforeTime", ctx, now).Return(nil, nil)
+
+			backendObj.PublishScheduledPostsIfNecessary()
+
+			mockDB.AssertNumberOfCalls(t, "ClaimScheduledPostDueBeforeTime", 1)
+			mockDB.AssertNotCalled(t, "UpdateScheduledPostStatus", ctx, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		})
+
+		Convey("when the author is no longer a moderator", func() {
+			mockDB.On("ClaimScheduledPostDueBeforeTime", ctx, now).Return(&scheduledPostObj, nil).Once()
+			mockDB.On("ClaimScheduledPostDueBeforeTime", ctx, now).Return(nil, nil)
+			mockDB.On("GetModeratorByUserIDAndDiscussionID", ctx, userID, discussionID).Return(nil, nil)
+			mockDB.On("GetModeratorRoleByUserIDAndDiscussionID", ctx, userID, discussionID).Return(nil, nil)
+			mockDB.On("UpdateScheduledPostStatus", ctx, scheduledPostObj.ID, model.ScheduledPostStatusPublishing, model.ScheduledPostStatusFailed, (*string)(nil), mock.Anything).Return(nil, nil)
+
+			backendObj.PublishScheduledPostsIfNecessary()
+
+			mockDB.AssertCalled(t, "UpdateScheduledPostStatus", ctx, scheduledPostObj.ID, model.ScheduledPostStatusPublishing, model.ScheduledPostStatusFailed, (*string)(nil), mock.MatchedBy(func(reason *string) bool {
+				return reason != nil && *reason == "unauthorized"
+			}))
+			mockDB.AssertNotCalled(t, "PutPost", ctx, mock.Anything, mock.Anything)
+		})
+
+		Convey("when the author was banned since scheduling", func() {
+			participantObj.IsBanned = true
+			mockDB.On("ClaimScheduledPostDueBeforeTime", ctx, now).Return(&scheduledPostObj, nil).Once()
+			mockDB.On("ClaimScheduledPostDueBeforeTime", ctx, now).Return(nil, nil)
+			mockDB.On("GetModeratorByUserIDAndDiscussionID", ctx, userID, discussionID).Return(&modObj, nil)
+			mockDB.On("GetDiscussionByID", ctx, discussionID).Return(&discObj, nil)
+			mockDB.On("GetParticipantByID", ctx, participantID).Return(&participantObj, nil)
+			mockDB.On("UpdateScheduledPostStatus", ctx, scheduledPostObj.ID, model.ScheduledPostStatusPublishing, model.ScheduledPostStatusFailed, (*string)(nil), mock.Anything).Return(nil, nil)
+
+			backendObj.PublishScheduledPostsIfNecessary()
+
+			mockDB.AssertCalled(t, "UpdateScheduledPostStatus", ctx, scheduledPostObj.ID, model.ScheduledPostStatusPublishing, model.ScheduledPostStatusFailed, (*string)(nil), mock.MatchedBy(func(reason *string) bool {
+				return reason != nil && *reason == "Banned"
+			}))
+			mockDB.AssertNotCalled(t, "PutPost", ctx, mock.Anything, mock.Anything)
+		})
+
+		Convey("when updating the status of one scheduled post errors out", func() {
+			otherScheduledPostObj := scheduledPostObj
+			otherScheduledPostObj.ID = "otherScheduledPostID"
+			mockDB.On("ClaimScheduledPostDueBeforeTime", ctx, now).Return(&scheduledPostObj, nil).Once()
+			mockDB.On("ClaimScheduledPostDueBeforeTime", ctx, now).Return(&otherScheduledPostObj, nil).Once()
+			mockDB.On("ClaimScheduledPostDueBeforeTime", ctx, now).Return(nil, nil)
+			mockDB.On("GetModeratorByUserIDAndDiscussionID", ctx, userID, discussionID).Return(nil, nil)
+			mockDB.On("GetModeratorRoleByUserIDAndDiscussionID", ctx, userID, discussionID).Return(nil, nil)
+			mockDB.On("UpdateScheduledPostStatus", ctx, scheduledPostObj.ID, model.ScheduledPostStatusPublishing, model.ScheduledPostStatusFailed, (*string)(nil), mock.Anything).Return(nil, fmt.Errorf("sth"))
+			mockDB.On("UpdateScheduledPostStatus", ctx, otherScheduledPostObj.ID, model.ScheduledPostStatusPublishing, model.ScheduledPostStatusFailed, (*string)(nil), mock.Anything).Return(nil, nil)
+
+			backendObj.PublishScheduledPostsIfNecessary()
+
+			mockDB.AssertNumberOfCalls(t, "ClaimScheduledPostDueBeforeTime", 3)
+			mockDB.AssertCalled(t, "UpdateScheduledPostStatus", ctx, otherScheduledPostObj.ID, model.ScheduledPostStatusPublishing, model.ScheduledPostStatusFailed, (*string)(nil), mock.Anything)
+		})
+	})
+}
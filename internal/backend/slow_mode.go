@@ -0,0 +1,96 @@
+package backend
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/delphis-inc/delphisbe/graph/model"
+	"github.com/sirupsen/logrus"
+)
+
+const maxSlowModeSeconds = 6 * 60 * 60
+
+// SlowModeError rejects a post made before the discussion's slow mode
+// interval has passed. Clients get the wait as GraphQL error extensions.
+type SlowModeError struct {
+	SecondsRemaining int
+}
+
+func (e *SlowModeError) Error() string {
+	return fmt.Sprintf("Slow mode is on, you can post again in %d seconds", e.SecondsRemaining)
+}
+
+func (e *SlowModeError) Extensions() map[string]interface{} {
+	return map[string]interface{}{
+		"code":             "SLOW_MODE",
+		"secondsRemaining": e.SecondsRemaining,
+	}
+}
+
+func validateSlowModeSeconds(seconds *int) error {
+	if seconds != nil && (*seconds < 0 || *seconds > maxSlowModeSeconds) {
+		return fmt.Errorf("Slow mode must be between 0 and %d seconds", maxSlowModeSeconds)
+	}
+	return nil
+}
+
+// GetSecondsUntilCanPost returns how long the user has to wait before posting
+// again under the discussion's slow mode. The wait is per user rather than per
+// participant, so it is shared by the user's anonymous and non-anonymous
+// participants. Moderators and the concierge never wait.
+func (d *delphisBackend) GetSecondsUntilCanPost(ctx context.Context, discussion *model.Discussion, userID string) (int, error) {
+	applies, err := d.slowModeAppliesToUser(ctx, discussion, userID)
+	if err != nil || !applies {
+		return 0, err
+	}
+
+	lastPostCreatedAt, err := d.db.GetLastPostCreatedAtByDiscussionUserID(ctx, discussion.ID, userID)
+	if err != nil {
+		logrus.WithError(err).Error("failed to get last post created at")
+		return 0, err
+	}
+	return d.secondsUntilCanPost(discussion, lastPostCreatedAt), nil
+}
+
+// checkSlowModeInTx repeats the slow mode check inside the transaction that
+// inserts the post. The user's posting stays locked until tx ends so two
+// concurrent posts cannot both pass the check.
+func (d *delphisBackend) checkSlowModeInTx(ctx context.Context, tx *sql.Tx, discussion *model.Discussion, userID string) error {
+	lastPostCreatedAt, err := d.db.LockLastPostCreatedAtByDiscussionUserID(ctx, tx, discussion.ID, userID)
+	if err != nil {
+		logrus.WithError(err).Error("failed to lock last post created at")
+		return err
+	}
+	if remaining := d.secondsUntilCanPost(discussion, lastPostCreatedAt); remaining > 0 {
+		return &SlowModeError{SecondsRemaining: remaining}
+	}
+	return nil
+}
+
+func (d *delphisBackend) slowModeAppliesToUser(ctx context.Context, discussion *model.Discussion, userID string) (bool, error) {
+	if discussion.SlowModeSeconds <= 0 || userID == model.ConciergeUser {
+		return false, nil
+	}
+
+	isModerator, err := d.CheckIfModeratorForDiscussion(ctx, userID, discussion.ID)
+	if err != nil {
+		return false, err
+	}
+	return !isModerator, nil
+}
+
+func (d *delphisBackend) secondsUntilCanPost(discussion *model.Discussion, lastPostCreatedAt *time.Time) int {
+	if lastPostCreatedAt == nil {
+		return 0
+	}
+
+	elapsed := d.timeProvider.Now().Sub(*lastPostCreatedAt).Seconds()
+	remaining := int(math.Ceil(float64(discussion.SlowModeSeconds) - elapsed))
+	if remaining < 0 {
+		return 0
+	}
+	return remaining
+}
@@ -0,0 +1,235 @@
+package backend
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/delphis-inc/delphisbe/graph/model"
+	"github.com/delphis-inc/delphisbe/internal/auth"
+	"github.com/delphis-inc/delphisbe/internal/backend/test_utils"
+	"github.com/delphis-inc/delphisbe/internal/cache"
+	"github.com/delphis-inc/delphisbe/internal/config"
+	"github.com/delphis-inc/delphisbe/internal/pubsub"
+	"github.com/delphis-inc/delphisbe/internal/util"
+	"github.com/delphis-inc/delphisbe/mocks"
+	. "github.com/smartystreets/goconvey/convey"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestDelphisBackend_GetSecondsUntilCanPost(t *testing.T) {
+	ctx := context.Background()
+	discussionID := test_utils.DiscussionID
+	userID := test_utils.UserID
+
+	Convey("GetSecondsUntilCanPost", t, func() {
+		now := time.Now()
+		mockDB := &mocks.Datastore{}
+		backendObj := &delphisBackend{
+			db:              mockDB,
+			auth:            auth.NewDelphisAuth(nil),
+			cache:           cache.NewInMemoryCache(),
+			discussionMutex: sync.Mutex{},
+			config:          config.Config{},
+			timeProvider:    &util.FrozenTime{NowTime: now},
+		}
+
+		discObj := test_utils.TestDiscussion()
+		discObj.SlowModeSeconds = 60
+		modObj := test_utils.TestModerator()
+
+		Convey("when slow mode is off", func() {
+			discObj.SlowModeSeconds = 0
+
+			resp, err := backendObj.GetSecondsUntilCanPost(ctx, &discObj, userID)
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldEqual, 0)
+			mockDB.AssertNotCalled(t, "GetLastPostCreatedAtByDiscussionUserID", ctx, discussionID, userID)
+		})
+
+		Convey("when the concierge posts", func() {
+			resp, err := backendObj.GetSecondsUntilCanPost(ctx, &discObj, model.ConciergeUser)
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldEqual, 0)
+			mockDB.AssertNotCalled(t, "GetModeratorByUserIDAndDiscussionID", ctx, model.ConciergeUser, discussionID)
+		})
+
+		Convey("when the moderator check errors out", func() {
+			mockDB.On("GetModeratorByUserIDAndDiscussionID", ctx, userID, discussionID).Return(nil, fmt.Errorf("sth"))
+
+			resp, err := backendObj.GetSecondsUntilCanPost(ctx, &discObj, userID)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldEqual, 0)
+		})
+
+		Convey("when the user is a moderator", func() {
+			mockDB.On("GetModeratorByUserIDAndDiscussionID", ctx, userID, discussionID).Return(&modObj, nil)
+
+			resp, err := backendObj.GetSecondsUntilCanPost(ctx, &discObj, userID)
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldEqual, 0)
+			mockDB.AssertNotCalled(t, "GetLastPostCreatedAtByDiscussionUserID", ctx, discussionID, userID)
+		})
+
+		Convey("when the user is not a moderator", func() {
+			mockDB.On("GetModeratorByUserIDAndDiscussionID", ctx, userID, discussionID).Return(nil, nil)
+			mockDB.On("GetModeratorRoleByUserIDAndDiscussionID", ctx, userID, discussionID).Return(nil, nil)
+
+			Convey("when getting the last post errors out", func() {
+				mockDB.On("GetLastPostCreatedAtByDiscussionUserID", ctx, discussionID, userID).Return(nil, fmt.Errorf("sth"))
+
+				resp, err := backendObj.GetSecondsUntilCanPost(ctx, &discObj, userID)
+
+				So(err, ShouldNotBeNil)
+				So(resp, ShouldEqual, 0)
+			})
+
+			Convey("when the user has not posted", func() {
+				mockDB.On("GetLastPostCreatedAtByDiscussionUserID", ctx, discussionID, userID).Return(nil, nil)
+
+				resp, err := backendObj.GetSecondsUntilCanPost(ctx, &discObj, userID)
+
+				So(err, ShouldBeNil)
+				So(resp, ShouldEqual, 0)
+			})
+
+			Convey("when the user posted within the interval", func() {
+				lastPost := now.Add(-20*time.Second - 500*time.Millisecond)
+				mockDB.On("GetLastPostCreatedAtByDiscussionUserID", ctx, discussionID, userID).Return(&lastPost, nil)
+
+				resp, err := backendObj.GetSecondsUntilCanPost(ctx, &discObj, userID)
+
+				So(err, ShouldBeNil)
+				So(resp, ShouldEqual, 40)
+			})
+
+			Convey("when the interval has passed", func() {
+				lastPost := now.Add(-2 * time.Minute)
+				mockDB.On("GetLastPostCreatedAtByDiscussionUserID", ctx, discussionID, userID).Return(&lastPost, nil)
+
+				resp, err := backendObj.GetSecondsUntilCanPost(ctx, &discObj, userID)
+
+				So(err, ShouldBeNil)
+				So(resp, ShouldEqual, 0)
+			})
+		})
+	})
+}
+
+func TestDelphisBackend_UpdateDiscussion_SlowMode(t *testing.T) {
+	ctx := context.Background()
+	discussionID := test_utils.DiscussionID
+
+	Convey("UpdateDiscussion with slow mode", t, func() {
+		mockDB := &mocks.Datastore{}
+		backendObj := &delphisBackend{
+			db:              mockDB,
+			auth:            auth.NewDelphisAuth(nil),
+			cache:           cache.NewInMemoryCache(),
+			discussionMutex: sync.Mutex{},
+			config:          config.Config{},
+			timeProvider:    &util.FrozenTime{NowTime: time.Now()},
+		}
+
+		Convey("when the interval is negative", func() {
+			seconds := -1
+
+			resp, err := backendObj.UpdateDiscussion(ctx, discussionID, test_utils.UserID, model.DiscussionInput{SlowModeSeconds: &seconds})
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		Convey("when the interval is too long", func() {
+			seconds := maxSlowModeSeconds + 1
+
+			resp, err := backendObj.UpdateDiscussion(ctx, discussionID, test_utils.UserID, model.DiscussionInput{SlowModeSeconds: &seconds})
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+	})
+}
+
+func TestDelphisBackend_CreatePost_SlowMode(t *testing.T) {
+	ctx := context.Background()
+	discussionID := test_utils.DiscussionID
+	userID := test_utils.UserID
+	participantID := test_utils.ParticipantID
+
+	Convey("CreatePost with slow mode", t, func() {
+		now := time.Now()
+		mockDB := &mocks.Datastore{}
+		backendObj := &delphisBackend{
+			db:              mockDB,
+			auth:            auth.NewDelphisAuth(nil),
+			cache:           cache.NewInMemoryCache(),
+			pubsub:          pubsub.NewInMemoryPubSub(),
+			discussionMutex: sync.Mutex{},
+			config:          config.Config{},
+			timeProvider:    &util.FrozenTime{NowTime: now},
+		}
+
+		tx := sql.Tx{}
+		postObj := test_utils.TestPost()
+		eventObj := test_utils.TestDiscussionEvent()
+		postInputObj := test_utils.TestPostContentInput()
+		discObj := test_utils.TestDiscussion()
+		discObj.SlowModeSeconds = 60
+
+		mockDB.On("GetDiscussionByID", ctx, discussionID).Return(&discObj, nil)
+		mockDB.On("GetModeratorByUserIDAndDiscussionID", ctx, userID, discussionID).Return(nil, nil)
+		mockDB.On("GetModeratorRoleByUserIDAndDiscussionID", ctx, userID, discussionID).Return(nil, nil)
+		mockDB.On("BeginTx", ctx).Return(&tx, nil)
+
+		Convey("when another post got in first", func() {
+			lastPost := now.Add(-15 * time.Second)
+			mockDB.On("LockLastPostCreatedAtByDiscussionUserID", ctx, &tx, discussionID, userID).Return(&lastPost, nil)
+			mockDB.On("RollbackTx", ctx, &tx).Return(nil)
+
+			resp, err := backendObj.CreatePost(ctx, discussionID, userID, participantID, postInputObj)
+
+			So(err, ShouldResemble, &SlowModeError{SecondsRemaining: 45})
+			So(resp, ShouldBeNil)
+			mockDB.AssertCalled(t, "RollbackTx", ctx, &tx)
+			mockDB.AssertNotCalled(t, "PutPost", ctx, mock.Anything, mock.Anything)
+		})
+
+		Convey("when locking errors out", func() {
+			mockDB.On("LockLastPostCreatedAtByDiscussionUserID", ctx, &tx, discussionID, userID).Return(nil, fmt.Errorf("sth"))
+			mockDB.On("RollbackTx", ctx, &tx).Return(nil)
+
+			resp, err := backendObj.CreatePost(ctx, discussionID, userID, participantID, postInputObj)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+			mockDB.AssertNotCalled(t, "PutPost", ctx, mock.Anything, mock.Anything)
+		})
+
+		Convey("when the interval has passed", func() {
+			lastPost := now.Add(-2 * time.Minute)
+			mockDB.On("LockLastPostCreatedAtByDiscussionUserID", ctx, &tx, discussionID, userID).Return(&lastPost, nil)
+			mockDB.On("PutPostContent", ctx, &tx, mock.Anything).Return(nil)
+			mockDB.On("PutPost", ctx, &tx, mock.Anything).Return(&postObj, nil)
+			mockDB.On("PutActivity", ctx, &tx, mock.Anything).Return(nil)
+			mockDB.On("CommitTx", ctx, &tx).Return(nil)
+			mockDB.On("UpsertDiscussion", ctx, mock.Anything).Return(&discObj, nil)
+			mockDB.On("GetDUAForEverythingNotifications", ctx, discussionID, userID).Return(nil)
+			mockDB.On("DuaIterCollect", ctx, mock.Anything).Return(nil, nil)
+			mockDB.On("PutDiscussionEvent", ctx, mock.Anything).Return(&eventObj, nil)
+
+			resp, err := backendObj.CreatePost(ctx, discussionID, userID, participantID, postInputObj)
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldNotBeNil)
+			mockDB.AssertCalled(t, "LockLastPostCreatedAtByDiscussionUserID", ctx, &tx, discussionID, userID)
+		})
+	})
+}
@@ -0,0 +1,59 @@
+package backend
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/delphis-inc/delphisbe/graph/model"
+)
+
+const (
+	// Keeps "tag:<name>" within the mentioned_entities and activity columns.
+	maxTagLength        = 32
+	DefaultTopTagsLimit = 10
+	maxTopTagsLimit     = 50
+)
+
+var tagRegex = regexp.MustCompile(`^[a-z0-9_]+$`)
+
+func (d *delphisBackend) GetPostsConnectionByTag(ctx context.Context, discussionID string, tag string, cursor string, limit int) (*model.PostsConnection, error) {
+	if limit < 2 || limit > PostPerPageLimit {
+		return nil, errors.New("Values of 'limit' is illegal")
+	}
+
+	tag, err := normalizeTag(tag)
+	if err != nil {
+		return nil, err
+	}
+
+	return d.db.GetPostsConnectionByTag(ctx, discussionID, tag, cursor, limit)
+}
+
+// Tags are ranked by how many posts use them, ties broken alphabetically.
+func (d *delphisBackend) GetTopTagsByDiscussionID(ctx context.Context, discussionID string, limit int) ([]*model.TagSummary, error) {
+	if limit < 1 || limit > maxTopTagsLimit {
+		return nil, fmt.Errorf("Limit must be between 1 and %d", maxTopTagsLimit)
+	}
+
+	return d.db.GetTopTagsByDiscussionID(ctx, discussionID, limit)
+}
+
+// normalizeTag accepts a tag with or without the leading '#' and returns the
+// lowercased name it is stored under.
+func normalizeTag(tag string) (string, error) {
+	tag = strings.ToLower(strings.TrimPrefix(strings.TrimSpace(tag), "#"))
+	if tag == "" {
+		return "", fmt.Errorf("Tag cannot be empty")
+	}
+	if len(tag) > maxTagLength {
+		return "", fmt.Errorf("Tag cannot be longer than %d characters", maxTagLength)
+	}
+	if !tagRegex.MatchString(tag) {
+		return "", fmt.Errorf("Tag can only contain letters, numbers and underscores")
+	}
+
+	return tag, nil
+}
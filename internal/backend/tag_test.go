@@ -0,0 +1,119 @@
+package backend
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/delphis-inc/delphisbe/graph/model"
+	"github.com/delphis-inc/delphisbe/internal/auth"
+	"github.com/delphis-inc/delphisbe/internal/backend/test_utils"
+	"github.com/delphis-inc/delphisbe/internal/cache"
+	"github.com/delphis-inc/delphisbe/internal/config"
+	"github.com/delphis-inc/delphisbe/internal/util"
+	"github.com/delphis-inc/delphisbe/mocks"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestDelphisBackend_GetPostsConnectionByTag(t *testing.T) {
+	ctx := context.Background()
+	discussionID := test_utils.DiscussionID
+	cursor := "cursor"
+	limit := 10
+
+	Convey("GetPostsConnectionByTag", t, func() {
+		now := time.Now()
+		mockDB := &mocks.Datastore{}
+		backendObj := &delphisBackend{
+			db:              mockDB,
+			auth:            auth.NewDelphisAuth(nil),
+			cache:           cache.NewInMemoryCache(),
+			discussionMutex: sync.Mutex{},
+			config:          config.Config{},
+			timeProvider:    &util.FrozenTime{NowTime: now},
+		}
+
+		postObj := test_utils.TestPost()
+		connObj := model.PostsConnection{
+			Edges: []*model.PostsEdge{{Cursor: cursor, Node: &postObj}},
+		}
+
+		Convey("when the limit is out of range", func() {
+			resp, err := backendObj.GetPostsConnectionByTag(ctx, discussionID, "golang", cursor, PostPerPageLimit+1)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		Convey("when the tag is invalid", func() {
+			resp, err := backendObj.GetPostsConnectionByTag(ctx, discussionID, "#not a tag", cursor, limit)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		Convey("when the query errors out", func() {
+			mockDB.On("GetPostsConnectionByTag", ctx, discussionID, "golang", cursor, limit).Return(nil, fmt.Errorf("sth"))
+
+			resp, err := backendObj.GetPostsConnectionByTag(ctx, discussionID, "golang", cursor, limit)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		Convey("when the tag is normalized before querying", func() {
+			mockDB.On("GetPostsConnectionByTag", ctx, discussionID, "golang", cursor, limit).Return(&connObj, nil)
+
+			resp, err := backendObj.GetPostsConnectionByTag(ctx, discussionID, " #GoLang ", cursor, limit)
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldResemble, &connObj)
+		})
+	})
+}
+
+func TestDelphisBackend_GetTopTagsByDiscussionID(t *testing.T) {
+	ctx := context.Background()
+	discussionID := test_utils.DiscussionID
+	tags := []*model.TagSummary{{Name: "golang", PostCount: 2}}
+
+	Convey("GetTopTagsByDiscussionID", t, func() {
+		now := time.Now()
+		mockDB := &mocks.Datastore{}
+		backendObj := &delphisBackend{
+			db:              mockDB,
+			auth:            auth.NewDelphisAuth(nil),
+			cache:           cache.NewInMemoryCache(),
+			discussionMutex: sync.Mutex{},
+			config:          config.Config{},
+			timeProvider:    &util.FrozenTime{NowTime: now},
+		}
+
+		Convey("when the limit is out of range", func() {
+			resp, err := backendObj.GetTopTagsByDiscussionID(ctx, discussionID, maxTopTagsLimit+1)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		Convey("when the query errors out", func() {
+			mockDB.On("GetTopTagsByDiscussionID", ctx, discussionID, DefaultTopTagsLimit).Return(nil, fmt.Errorf("sth"))
+
+			resp, err := backendObj.GetTopTagsByDiscussionID(ctx, discussionID, DefaultTopTagsLimit)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		Convey("when the query returns successfully", func() {
+			mockDB.On("GetTopTagsByDiscussionID", ctx, discussionID, DefaultTopTagsLimit).Return(tags, nil)
+
+			resp, err := backendObj.GetTopTagsByDiscussionID(ctx, discussionID, DefaultTopTagsLimit)
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldResemble, tags)
+		})
+	})
+}
@@ -87,6 +87,17 @@ func TestDiscussionShuffleTime() model.DiscussionShuffleTime {
 	}
 }
 
+func TestDiscussionEvent() model.DiscussionEvent {
+	return model.DiscussionEvent{
+		Sequence:     1,
+		DiscussionID: DiscussionID,
+		EventType:    model.DiscussionSubscriptionEventTypePostAdded,
+		EntityType:   "post",
+		EntityID:     PostID,
+		CreatedAt:    Now,
+	}
+}
+
 func TestDiscussionCreationSettings() model.DiscussionCreationSettings {
 	return model.DiscussionCreationSettings{
 		DiscussionJoinability: model.DiscussionJoinabilitySettingAllowTwitterFriends,
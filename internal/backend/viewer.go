@@ -57,3 +57,14 @@ func (d *delphisBackend) GetViewerByID(ctx context.Context, viewerID string) (*m
 
 	return viewers[viewerID], nil
 }
+
+// Keyed by discussion ID. Discussions without a viewer for the user are absent.
+func (d *delphisBackend) GetUnreadCountsByUserID(ctx context.Context, userID string) (map[string]*model.DiscussionUnreadCount, error) {
+	return d.db.GetUnreadCountsByUserID(ctx, userID)
+}
+
+func (d *delphisBackend) MarkAllDiscussionsRead(ctx context.Context, userID string) ([]*model.Viewer, error) {
+	now := d.timeProvider.Now()
+
+	return d.db.MarkAllViewersRead(ctx, userID, now)
+}
@@ -213,3 +213,41 @@ func Test_GetViewerByID(t *testing.T) {
 		})
 	})
 }
+
+func TestDelphisBackend_MarkAllDiscussionsRead(t *testing.T) {
+	ctx := context.Background()
+	viewerObj := test_utils.TestViewer()
+	userID := test_utils.UserID
+
+	Convey("MarkAllDiscussionsRead", t, func() {
+		now := time.Now()
+		cacheObj := cache.NewInMemoryCache()
+		authObj := auth.NewDelphisAuth(nil)
+		mockDB := &mocks.Datastore{}
+		backendObj := &delphisBackend{
+			db:              mockDB,
+			auth:            authObj,
+			cache:           cacheObj,
+			discussionMutex: sync.Mutex{},
+			config:          config.Config{},
+			timeProvider:    &util.FrozenTime{NowTime: now},
+		}
+		Convey("when db returns an error", func() {
+			mockDB.On("MarkAllViewersRead", ctx, userID, now).Return(nil, fmt.Errorf("sth"))
+
+			resp, err := backendObj.MarkAllDiscussionsRead(ctx, userID)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		Convey("when db returns the updated viewers", func() {
+			mockDB.On("MarkAllViewersRead", ctx, userID, now).Return([]*model.Viewer{&viewerObj}, nil)
+
+			resp, err := backendObj.MarkAllDiscussionsRead(ctx, userID)
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldResemble, []*model.Viewer{&viewerObj})
+		})
+	})
+}
@@ -17,6 +17,7 @@ type Config struct {
 	S3BucketConfig  S3BucketConfig  `json:"s3_bucket" mapstructure:"s3_bucket"`
 	SQSConfig       SQSConfig       `json:"sqs" mapstructure:"sqs"`
 	AppleAuthConfig AppleAuthConfig `json:"apple_auth_config" mapstructure:"apple_auth_config"`
+	PubSubConfig    PubSubConfig    `json:"pubsub" mapstructure:"pubsub"`
 }
 
 func (c *Config) ReadEnvAndUpdate() {
@@ -107,6 +108,10 @@ type SQSConfig struct {
 	Enabled    bool   `json:"enabled" mapstructure:"enabled"`
 }
 
+type PubSubConfig struct {
+	Type string `json:"type" mapstructure:"type"`
+}
+
 type TableConfig struct {
 	TableName string `json:"table_name" mapstructure:"table_name"`
 }
@@ -32,6 +32,23 @@ type Datastore interface {
 	GetModeratorByDiscussionID(ctx context.Context, discussionID string) (*model.Moderator, error)
 	GetModeratorByUserIDAndDiscussionID(ctx context.Context, userID, discussionID string) (*model.Moderator, error)
 	GetModeratedDiscussionsByUserID(ctx context.Context, userID string) DiscussionIter
+	GetModeratorRoleByUserIDAndDiscussionID(ctx context.Context, userID, discussionID string) (*model.ModeratorRoleGrant, error)
+	GetModeratorRolesByDiscussionID(ctx context.Context, discussionID string) ([]*model.ModeratorRoleGrant, error)
+	UpsertModeratorRole(ctx context.Context, grant model.ModeratorRoleGrant) (*model.ModeratorRoleGrant, error)
+	DeleteModeratorRole(ctx context.Context, userID, discussionID string) error
+	UpdateModeratorUserProfileID(ctx context.Context, tx *sql.Tx, moderatorID string, userProfileID string) (*model.Moderator, error)
+	PutDiscussionOwnershipTransfer(ctx context.Context, tx *sql.Tx, transfer model.DiscussionOwnershipTransfer) (*model.DiscussionOwnershipTransfer, error)
+	TransferModeratorRoles(ctx context.Context, tx *sql.Tx, discussionID, fromUserID, toUserID, grantedByUserID string) error
+	PutModerationLogEntry(ctx context.Context, entry model.ModerationLogEntry) (*model.ModerationLogEntry, error)
+	GetModerationLogConnectionByDiscussionID(ctx context.Context, discussionID string, cursor string, limit int) (*model.ModerationLogConnection, error)
+	PutDiscussionBan(ctx context.Context, ban model.DiscussionBan) (*model.DiscussionBan, error)
+	GetDiscussionBanByDiscussionIDUserID(ctx context.Context, discussionID string, userID string) (*model.DiscussionBan, error)
+	DeleteDiscussionBan(ctx context.Context, participantID string) error
+	PutPostReport(ctx context.Context, report model.PostReport) (*model.PostReport, error)
+	GetPostReportByID(ctx context.Context, id string) (*model.PostReport, error)
+	GetPostReportByPostIDReporterUserID(ctx context.Context, postID string, reporterUserID string) (*model.PostReport, error)
+	GetPostReportsConnectionByDiscussionID(ctx context.Context, discussionID string, status model.PostReportStatus, cursor string, limit int) (*model.PostReportConnection, error)
+	ResolvePostReports(ctx context.Context, postID string, status model.PostReportStatus, action *model.PostReportAction, resolvedByUserID string) ([]*model.PostReport, error)
 	ListDiscussions(ctx context.Context) (*model.DiscussionsConnection, error)
 	ListDiscussionsByUserID(ctx context.Context, userID string, state model.DiscussionUserAccessState) (*model.DiscussionsConnection, error)
 	UpsertDiscussion(ctx context.Context, discussion model.Discussion) (*model.Discussion, error)
@@ -45,14 +62,51 @@ type Datastore interface {
 	UpsertParticipant(ctx context.Context, participant model.Participant) (*model.Participant, error)
 	SetParticipantsMutedUntil(ctx context.Context, participants []*model.Participant, mutedUntil *time.Time) ([]*model.Participant, error)
 	GetPostsByDiscussionIDIter(ctx context.Context, discussionID string) PostIter
-	GetPostsByDiscussionIDFromCursorIter(ctx context.Context, discussionID string, cursor string, limit int) PostIter
-	GetPostsConnectionByDiscussionID(ctx context.Context, discussionID string, cursor string, limit int) (*model.PostsConnection, error)
+	GetPostsByDiscussionIDFromCursorIter(ctx context.Context, discussionID string, cursor string, limit int, topLevelOnly bool) PostIter
+	GetPostsConnectionByDiscussionID(ctx context.Context, discussionID string, cursor string, limit int, topLevelOnly bool) (*model.PostsConnection, error)
+	GetPostsByDiscussionIDBeforeCursorIter(ctx context.Context, discussionID string, cursor string, limit int, topLevelOnly bool) PostIter
+	GetPostsConnectionByDiscussionIDBeforeCursor(ctx context.Context, discussionID string, cursor string, limit int, topLevelOnly bool) (*model.PostsConnection, error)
+	GetPostsConnectionAroundPost(ctx context.Context, anchorPost model.Post, newerLimit int, olderLimit int, topLevelOnly bool) (*model.PostsConnection, error)
+	GetPostRepliesFromCursorIter(ctx context.Context, parentPostID string, cursor string, limit int) PostIter
+	GetPostRepliesConnection(ctx context.Context, parentPostID string, cursor string, limit int) (*model.PostsConnection, error)
+	GetPostReplyCount(ctx context.Context, parentPostID string) (int, error)
+	UpdatePostPinnedAt(ctx context.Context, postID string, pinnedAt *time.Time) error
+	GetPinnedPostsByDiscussionIDIter(ctx context.Context, discussionID string) PostIter
+	SearchPostsByDiscussionID(ctx context.Context, discussionID string, query string, cursor string, limit int) (*model.PostsConnection, error)
+	SearchPostsByUserAccess(ctx context.Context, userID string, query string, cursor string, limit int) (*model.PostsConnection, error)
 	GetLastPostByDiscussionID(ctx context.Context, discussionID string) (*model.Post, error)
 	GetPostContentByID(ctx context.Context, id string) (*model.PostContent, error)
 	PutPost(ctx context.Context, tx *sql2.Tx, post model.Post) (*model.Post, error)
 	PutPostContent(ctx context.Context, tx *sql2.Tx, postContent model.PostContent) error
+	EditPost(ctx context.Context, tx *sql2.Tx, post model.Post) (*model.Post, error)
+	PutPostReaction(ctx context.Context, reaction model.PostReaction) error
+	DeletePostReaction(ctx context.Context, reaction model.PostReaction) error
+	GetPostReactionSummaries(ctx context.Context, postID string, participantID *string) ([]*model.PostReactionSummary, error)
+	PutPostBookmark(ctx context.Context, bookmark model.PostBookmark) error
+	DeletePostBookmark(ctx context.Context, bookmark model.PostBookmark) error
+	GetPostBookmarksConnectionByUserID(ctx context.Context, userID string, cursor string, limit int) (*model.PostBookmarksConnection, error)
+	PutPoll(ctx context.Context, tx *sql.Tx, poll model.Poll) error
+	GetPollByPostID(ctx context.Context, postID string, participantIDs []string) (*model.Poll, error)
+	ReplacePollVotes(ctx context.Context, tx *sql.Tx, postID string, participantID string, userParticipantIDs []string, optionIDs []string) error
+	GetPollVoterIDsByOptionID(ctx context.Context, optionID string) ([]string, error)
+	GetLinkPreviewByURL(ctx context.Context, url string) (*model.LinkPreview, error)
+	UpsertLinkPreview(ctx context.Context, tx *sql.Tx, preview model.LinkPreview) error
+	ReplacePostLinkPreviews(ctx context.Context, tx *sql.Tx, postID string, urls []string) error
+	GetLinkPreviewsByPostID(ctx context.Context, postID string) ([]*model.LinkPreview, error)
+	GetPostsByTagFromCursorIter(ctx context.Context, discussionID string, tag string, cursor string, limit int) PostIter
+	GetPostsConnectionByTag(ctx context.Context, discussionID string, tag string, cursor string, limit int) (*model.PostsConnection, error)
+	GetTopTagsByDiscussionID(ctx context.Context, discussionID string, limit int) ([]*model.TagSummary, error)
+	GetLastPostCreatedAtByDiscussionUserID(ctx context.Context, discussionID string, userID string) (*time.Time, error)
+	LockLastPostCreatedAtByDiscussionUserID(ctx context.Context, tx *sql.Tx, discussionID string, userID string) (*time.Time, error)
+	PutScheduledPost(ctx context.Context, scheduledPost model.ScheduledPost) (*model.ScheduledPost, error)
+	GetScheduledPostByID(ctx context.Context, id string) (*model.ScheduledPost, error)
+	GetPendingScheduledPostsByDiscussionID(ctx context.Context, discussionID string) ([]*model.ScheduledPost, error)
+	ClaimScheduledPostDueBeforeTime(ctx context.Context, epoc time.Time) (*model.ScheduledPost, error)
+	UpdateScheduledPostStatus(ctx context.Context, id string, fromStatus model.ScheduledPostStatus, status model.ScheduledPostStatus, postID *string, failureReason *string) (*model.ScheduledPost, error)
+	CancelScheduledPost(ctx context.Context, id string) (*model.ScheduledPost, error)
 	DeletePostByID(ctx context.Context, postID string, deletedReasonCode model.PostDeletedReason) (*model.Post, error)
 	DeleteAllParticipantPosts(ctx context.Context, discussionID string, participantID string, deletedReasonCode model.PostDeletedReason) (int, error)
+	RestoreParticipantPosts(ctx context.Context, discussionID string, participantID string, deletedReasonCode model.PostDeletedReason) ([]string, error)
 	GetUserProfileByID(ctx context.Context, id string) (*model.UserProfile, error)
 	GetUserProfileByUserID(ctx context.Context, userID string) (*model.UserProfile, error)
 	GetSocialInfosByUserProfileID(ctx context.Context, userProfileID string) ([]model.SocialInfo, error)
@@ -66,6 +120,8 @@ type Datastore interface {
 	UpsertViewer(ctx context.Context, viewer model.Viewer) (*model.Viewer, error)
 	GetViewerForDiscussion(ctx context.Context, discussionID, userID string) (*model.Viewer, error)
 	SetViewerLastPostViewed(ctx context.Context, viewerID, postID string, viewedTime time.Time) (*model.Viewer, error)
+	GetUnreadCountsByUserID(ctx context.Context, userID string) (map[string]*model.DiscussionUnreadCount, error)
+	MarkAllViewersRead(ctx context.Context, userID string, viewedTime time.Time) ([]*model.Viewer, error)
 	GetPostByID(ctx context.Context, postID string) (*model.Post, error)
 	PutActivity(ctx context.Context, tx *sql2.Tx, post *model.Post) error
 	PutMediaRecord(ctx context.Context, tx *sql2.Tx, media model.Media) error
@@ -85,6 +141,7 @@ type Datastore interface {
 	GetDiscussionUserAccess(ctx context.Context, discussionID, userID string) (*model.DiscussionUserAccess, error)
 	GetDUAForEverythingNotifications(ctx context.Context, discussionID, userID string) DiscussionUserAccessIter
 	GetDUAForMentionNotifications(ctx context.Context, discussionID string, userID string, mentionedUserIDs []string) DiscussionUserAccessIter
+	GetDUAForEveryoneMentionNotifications(ctx context.Context, discussionID string, userID string) DiscussionUserAccessIter
 	UpsertDiscussionUserAccess(ctx context.Context, tx *sql2.Tx, dua model.DiscussionUserAccess) (*model.DiscussionUserAccess, error)
 	DeleteDiscussionUserAccess(ctx context.Context, tx *sql2.Tx, discussionID, userID string) (*model.DiscussionUserAccess, error)
 	GetDiscussionRequestAccessByID(ctx context.Context, id string) (*model.DiscussionAccessRequest, error)
@@ -98,6 +155,8 @@ type Datastore interface {
 	PutAccessLinkForDiscussion(ctx context.Context, tx *sql.Tx, input model.DiscussionAccessLink) (*model.DiscussionAccessLink, error)
 	GetDiscussionArchiveByDiscussionID(ctx context.Context, discussionID string) (*model.DiscussionArchive, error)
 	UpsertDiscussionArchive(ctx context.Context, tx *sql.Tx, discArchive model.DiscussionArchive) (*model.DiscussionArchive, error)
+	PutDiscussionEvent(ctx context.Context, event model.DiscussionEvent) (*model.DiscussionEvent, error)
+	GetDiscussionEventsAfterSequence(ctx context.Context, discussionID string, afterSequence int, limit int) ([]*model.DiscussionEvent, error)
 
 	// TXN
 	BeginTx(ctx context.Context) (*sql2.Tx, error)
@@ -385,6 +444,282 @@ func (d *delphisDB) initializeStatements(ctx context.Context) (err error) {
 		return errors.Wrap(err, "failed to prepare updateViewerLastViewed")
 	}
 
+	// Discussion Events
+	if d.prepStmts.putDiscussionEventStmt, err = d.pg.PrepareContext(ctx, putDiscussionEventString); err != nil {
+		logrus.WithError(err).Error("failed to prepare putDiscussionEventStmt")
+		return errors.Wrap(err, "failed to prepare putDiscussionEventStmt")
+	}
+	if d.prepStmts.getDiscussionEventsAfterSequenceStmt, err = d.pg.PrepareContext(ctx, getDiscussionEventsAfterSequenceString); err != nil {
+		logrus.WithError(err).Error("failed to prepare getDiscussionEventsAfterSequenceStmt")
+		return errors.Wrap(err, "failed to prepare getDiscussionEventsAfterSequenceStmt")
+	}
+
+	// Post Edits
+	if d.prepStmts.editPostStmt, err = d.pg.PrepareContext(ctx, editPostString); err != nil {
+		logrus.WithError(err).Error("failed to prepare editPostStmt")
+		return errors.Wrap(err, "failed to prepare editPostStmt")
+	}
+
+	// Post Reactions
+	if d.prepStmts.putPostReactionStmt, err = d.pg.PrepareContext(ctx, putPostReactionString); err != nil {
+		logrus.WithError(err).Error("failed to prepare putPostReactionStmt")
+		return errors.Wrap(err, "failed to prepare putPostReactionStmt")
+	}
+	if d.prepStmts.deletePostReactionStmt, err = d.pg.PrepareContext(ctx, deletePostReactionString); err != nil {
+		logrus.WithError(err).Error("failed to prepare deletePostReactionStmt")
+		return errors.Wrap(err, "failed to prepare deletePostReactionStmt")
+	}
+	if d.prepStmts.getPostReactionSummariesStmt, err = d.pg.PrepareContext(ctx, getPostReactionSummariesString); err != nil {
+		logrus.WithError(err).Error("failed to prepare getPostReactionSummariesStmt")
+		return errors.Wrap(err, "failed to prepare getPostReactionSummariesStmt")
+	}
+
+	// Post Replies
+	if d.prepStmts.getPostRepliesFromCursorStmt, err = d.pg.PrepareContext(ctx, getPostRepliesFromCursorString); err != nil {
+		logrus.WithError(err).Error("failed to prepare getPostRepliesFromCursorStmt")
+		return errors.Wrap(err, "failed to prepare getPostRepliesFromCursorStmt")
+	}
+	if d.prepStmts.getPostReplyCountStmt, err = d.pg.PrepareContext(ctx, getPostReplyCountString); err != nil {
+		logrus.WithError(err).Error("failed to prepare getPostReplyCountStmt")
+		return errors.Wrap(err, "failed to prepare getPostReplyCountStmt")
+	}
+
+	// Pinned Posts
+	if d.prepStmts.updatePostPinnedAtStmt, err = d.pg.PrepareContext(ctx, updatePostPinnedAtString); err != nil {
+		logrus.WithError(err).Error("failed to prepare updatePostPinnedAtStmt")
+		return errors.Wrap(err, "failed to prepare updatePostPinnedAtStmt")
+	}
+	if d.prepStmts.getPinnedPostsByDiscussionIDStmt, err = d.pg.PrepareContext(ctx, getPinnedPostsByDiscussionIDString); err != nil {
+		logrus.WithError(err).Error("failed to prepare getPinnedPostsByDiscussionIDStmt")
+		return errors.Wrap(err, "failed to prepare getPinnedPostsByDiscussionIDStmt")
+	}
+
+	// Post Search
+	if d.prepStmts.searchPostsByDiscussionIDStmt, err = d.pg.PrepareContext(ctx, searchPostsByDiscussionIDString); err != nil {
+		logrus.WithError(err).Error("failed to prepare searchPostsByDiscussionIDStmt")
+		return errors.Wrap(err, "failed to prepare searchPostsByDiscussionIDStmt")
+	}
+	if d.prepStmts.searchPostsByUserAccessStmt, err = d.pg.PrepareContext(ctx, searchPostsByUserAccessString); err != nil {
+		logrus.WithError(err).Error("failed to prepare searchPostsByUserAccessStmt")
+		return errors.Wrap(err, "failed to prepare searchPostsByUserAccessStmt")
+	}
+
+	// Post Pagination
+	if d.prepStmts.getPostsByDiscussionIDBeforeCursorStmt, err = d.pg.PrepareContext(ctx, getPostsByDiscussionIDBeforeCursorString); err != nil {
+		logrus.WithError(err).Error("failed to prepare getPostsByDiscussionIDBeforeCursorStmt")
+		return errors.Wrap(err, "failed to prepare getPostsByDiscussionIDBeforeCursorStmt")
+	}
+	if d.prepStmts.hasNewerPostsByDiscussionIDStmt, err = d.pg.PrepareContext(ctx, hasNewerPostsByDiscussionIDString); err != nil {
+		logrus.WithError(err).Error("failed to prepare hasNewerPostsByDiscussionIDStmt")
+		return errors.Wrap(err, "failed to prepare hasNewerPostsByDiscussionIDStmt")
+	}
+	if d.prepStmts.hasOlderPostsByDiscussionIDStmt, err = d.pg.PrepareContext(ctx, hasOlderPostsByDiscussionIDString); err != nil {
+		logrus.WithError(err).Error("failed to prepare hasOlderPostsByDiscussionIDStmt")
+		return errors.Wrap(err, "failed to prepare hasOlderPostsByDiscussionIDStmt")
+	}
+
+	// Unread Counts
+	if d.prepStmts.getUnreadCountsByUserIDStmt, err = d.pg.PrepareContext(ctx, getUnreadCountsByUserIDString); err != nil {
+		logrus.WithError(err).Error("failed to prepare getUnreadCountsByUserIDStmt")
+		return errors.Wrap(err, "failed to prepare getUnreadCountsByUserIDStmt")
+	}
+	if d.prepStmts.markAllViewersReadStmt, err = d.pg.PrepareContext(ctx, markAllViewersReadString); err != nil {
+		logrus.WithError(err).Error("failed to prepare markAllViewersReadStmt")
+		return errors.Wrap(err, "failed to prepare markAllViewersReadStmt")
+	}
+
+	// Post Bookmarks
+	if d.prepStmts.putPostBookmarkStmt, err = d.pg.PrepareContext(ctx, putPostBookmarkString); err != nil {
+		logrus.WithError(err).Error("failed to prepare putPostBookmarkStmt")
+		return errors.Wrap(err, "failed to prepare putPostBookmarkStmt")
+	}
+	if d.prepStmts.deletePostBookmarkStmt, err = d.pg.PrepareContext(ctx, deletePostBookmarkString); err != nil {
+		logrus.WithError(err).Error("failed to prepare deletePostBookmarkStmt")
+		return errors.Wrap(err, "failed to prepare deletePostBookmarkStmt")
+	}
+	if d.prepStmts.getPostBookmarksByUserIDFromCursorStmt, err = d.pg.PrepareContext(ctx, getPostBookmarksByUserIDFromCursorString); err != nil {
+		logrus.WithError(err).Error("failed to prepare getPostBookmarksByUserIDFromCursorStmt")
+		return errors.Wrap(err, "failed to prepare getPostBookmarksByUserIDFromCursorStmt")
+	}
+
+	// Polls
+	if d.prepStmts.putPollStmt, err = d.pg.PrepareContext(ctx, putPollString); err != nil {
+		logrus.WithError(err).Error("failed to prepare putPollStmt")
+		return errors.Wrap(err, "failed to prepare putPollStmt")
+	}
+	if d.prepStmts.putPollOptionStmt, err = d.pg.PrepareContext(ctx, putPollOptionString); err != nil {
+		logrus.WithError(err).Error("failed to prepare putPollOptionStmt")
+		return errors.Wrap(err, "failed to prepare putPollOptionStmt")
+	}
+	if d.prepStmts.getPollByPostIDStmt, err = d.pg.PrepareContext(ctx, getPollByPostIDString); err != nil {
+		logrus.WithError(err).Error("failed to prepare getPollByPostIDStmt")
+		return errors.Wrap(err, "failed to prepare getPollByPostIDStmt")
+	}
+	if d.prepStmts.getPollOptionTalliesByPostIDStmt, err = d.pg.PrepareContext(ctx, getPollOptionTalliesByPostIDString); err != nil {
+		logrus.WithError(err).Error("failed to prepare getPollOptionTalliesByPostIDStmt")
+		return errors.Wrap(err, "failed to prepare getPollOptionTalliesByPostIDStmt")
+	}
+	if d.prepStmts.deletePollVotesByParticipantIDStmt, err = d.pg.PrepareContext(ctx, deletePollVotesByParticipantIDString); err != nil {
+		logrus.WithError(err).Error("failed to prepare deletePollVotesByParticipantIDStmt")
+		return errors.Wrap(err, "failed to prepare deletePollVotesByParticipantIDStmt")
+	}
+	if d.prepStmts.putPollVoteStmt, err = d.pg.PrepareContext(ctx, putPollVoteString); err != nil {
+		logrus.WithError(err).Error("failed to prepare putPollVoteStmt")
+		return errors.Wrap(err, "failed to prepare putPollVoteStmt")
+	}
+	if d.prepStmts.getPollVoterIDsByOptionIDStmt, err = d.pg.PrepareContext(ctx, getPollVoterIDsByOptionIDString); err != nil {
+		logrus.WithError(err).Error("failed to prepare getPollVoterIDsByOptionIDStmt")
+		return errors.Wrap(err, "failed to prepare getPollVoterIDsByOptionIDStmt")
+	}
+
+	// ScheduledPosts
+	if d.prepStmts.putScheduledPostStmt, err = d.pg.PrepareContext(ctx, putScheduledPostString); err != nil {
+		logrus.WithError(err).Error("failed to prepare putScheduledPostStmt")
+		return errors.Wrap(err, "failed to prepare putScheduledPostStmt")
+	}
+	if d.prepStmts.getScheduledPostByIDStmt, err = d.pg.PrepareContext(ctx, getScheduledPostByIDString); err != nil {
+		logrus.WithError(err).Error("failed to prepare getScheduledPostByIDStmt")
+		return errors.Wrap(err, "failed to prepare getScheduledPostByIDStmt")
+	}
+	if d.prepStmts.getPendingScheduledPostsByDiscussionIDStmt, err = d.pg.PrepareContext(ctx, getPendingScheduledPostsByDiscussionIDString); err != nil {
+		logrus.WithError(err).Error("failed to prepare getPendingScheduledPostsByDiscussionIDStmt")
+		return errors.Wrap(err, "failed to prepare getPendingScheduledPostsByDiscussionIDStmt")
+	}
+	if d.prepStmts.claimScheduledPostDueBeforeTimeStmt, err = d.pg.PrepareContext(ctx, claimScheduledPostDueBeforeTimeString); err != nil {
+		logrus.WithError(err).Error("failed to prepare claimScheduledPostDueBeforeTimeStmt")
+		return errors.Wrap(err, "failed to prepare claimScheduledPostDueBeforeTimeStmt")
+	}
+	if d.prepStmts.updateScheduledPostStatusStmt, err = d.pg.PrepareContext(ctx, updateScheduledPostStatusString); err != nil {
+		logrus.WithError(err).Error("failed to prepare updateScheduledPostStatusStmt")
+		return errors.Wrap(err, "failed to prepare updateScheduledPostStatusStmt")
+	}
+
+	// LinkPreviews
+	if d.prepStmts.getLinkPreviewByURLStmt, err = d.pg.PrepareContext(ctx, getLinkPreviewByURLString); err != nil {
+		logrus.WithError(err).Error("failed to prepare getLinkPreviewByURLStmt")
+		return errors.Wrap(err, "failed to prepare getLinkPreviewByURLStmt")
+	}
+	if d.prepStmts.upsertLinkPreviewStmt, err = d.pg.PrepareContext(ctx, upsertLinkPreviewString); err != nil {
+		logrus.WithError(err).Error("failed to prepare upsertLinkPreviewStmt")
+		return errors.Wrap(err, "failed to prepare upsertLinkPreviewStmt")
+	}
+	if d.prepStmts.deletePostLinkPreviewsByPostIDStmt, err = d.pg.PrepareContext(ctx, deletePostLinkPreviewsByPostIDString); err != nil {
+		logrus.WithError(err).Error("failed to prepare deletePostLinkPreviewsByPostIDStmt")
+		return errors.Wrap(err, "failed to prepare deletePostLinkPreviewsByPostIDStmt")
+	}
+	if d.prepStmts.putPostLinkPreviewStmt, err = d.pg.PrepareContext(ctx, putPostLinkPreviewString); err != nil {
+		logrus.WithError(err).Error("failed to prepare putPostLinkPreviewStmt")
+		return errors.Wrap(err, "failed to prepare putPostLinkPreviewStmt")
+	}
+	if d.prepStmts.getLinkPreviewsByPostIDStmt, err = d.pg.PrepareContext(ctx, getLinkPreviewsByPostIDString); err != nil {
+		logrus.WithError(err).Error("failed to prepare getLinkPreviewsByPostIDStmt")
+		return errors.Wrap(err, "failed to prepare getLinkPreviewsByPostIDStmt")
+	}
+
+	// Tags
+	if d.prepStmts.getPostsByTagFromCursorStmt, err = d.pg.PrepareContext(ctx, getPostsByTagFromCursorString); err != nil {
+		logrus.WithError(err).Error("failed to prepare getPostsByTagFromCursorStmt")
+		return errors.Wrap(err, "failed to prepare getPostsByTagFromCursorStmt")
+	}
+	if d.prepStmts.getTopTagsByDiscussionIDStmt, err = d.pg.PrepareContext(ctx, getTopTagsByDiscussionIDString); err != nil {
+		logrus.WithError(err).Error("failed to prepare getTopTagsByDiscussionIDStmt")
+		return errors.Wrap(err, "failed to prepare getTopTagsByDiscussionIDStmt")
+	}
+
+	// GroupMentions
+	if d.prepStmts.getDUAForEveryoneMentionNotificationsStmt, err = d.pg.PrepareContext(ctx, getDUAForEveryoneMentionNotificationsString); err != nil {
+		logrus.WithError(err).Error("failed to prepare getDUAForEveryoneMentionNotificationsStmt")
+		return errors.Wrap(err, "failed to prepare getDUAForEveryoneMentionNotificationsStmt")
+	}
+
+	// SlowMode
+	if d.prepStmts.getLastPostCreatedAtByDiscussionUserIDStmt, err = d.pg.PrepareContext(ctx, getLastPostCreatedAtByDiscussionUserIDString); err != nil {
+		logrus.WithError(err).Error("failed to prepare getLastPostCreatedAtByDiscussionUserIDStmt")
+		return errors.Wrap(err, "failed to prepare getLastPostCreatedAtByDiscussionUserIDStmt")
+	}
+	if d.prepStmts.lockPostingByDiscussionUserIDStmt, err = d.pg.PrepareContext(ctx, lockPostingByDiscussionUserIDString); err != nil {
+		logrus.WithError(err).Error("failed to prepare lockPostingByDiscussionUserIDStmt")
+		return errors.Wrap(err, "failed to prepare lockPostingByDiscussionUserIDStmt")
+	}
+
+	// ModeratorRoles
+	if d.prepStmts.getModeratorRoleByUserIDAndDiscussionIDStmt, err = d.pg.PrepareContext(ctx, getModeratorRoleByUserIDAndDiscussionIDString); err != nil {
+		logrus.WithError(err).Error("failed to prepare getModeratorRoleByUserIDAndDiscussionIDStmt")
+		return errors.Wrap(err, "failed to prepare getModeratorRoleByUserIDAndDiscussionIDStmt")
+	}
+	if d.prepStmts.getModeratorRolesByDiscussionIDStmt, err = d.pg.PrepareContext(ctx, getModeratorRolesByDiscussionIDString); err != nil {
+		logrus.WithError(err).Error("failed to prepare getModeratorRolesByDiscussionIDStmt")
+		return errors.Wrap(err, "failed to prepare getModeratorRolesByDiscussionIDStmt")
+	}
+	if d.prepStmts.upsertModeratorRoleStmt, err = d.pg.PrepareContext(ctx, upsertModeratorRoleString); err != nil {
+		logrus.WithError(err).Error("failed to prepare upsertModeratorRoleStmt")
+		return errors.Wrap(err, "failed to prepare upsertModeratorRoleStmt")
+	}
+	if d.prepStmts.deleteModeratorRoleStmt, err = d.pg.PrepareContext(ctx, deleteModeratorRoleString); err != nil {
+		logrus.WithError(err).Error("failed to prepare deleteModeratorRoleStmt")
+		return errors.Wrap(err, "failed to prepare deleteModeratorRoleStmt")
+	}
+
+	// OwnershipTransfers
+	if d.prepStmts.updateModeratorUserProfileIDStmt, err = d.pg.PrepareContext(ctx, updateModeratorUserProfileIDString); err != nil {
+		logrus.WithError(err).Error("failed to prepare updateModeratorUserProfileIDStmt")
+		return errors.Wrap(err, "failed to prepare updateModeratorUserProfileIDStmt")
+	}
+	if d.prepStmts.putDiscussionOwnershipTransferStmt, err = d.pg.PrepareContext(ctx, putDiscussionOwnershipTransferString); err != nil {
+		logrus.WithError(err).Error("failed to prepare putDiscussionOwnershipTransferStmt")
+		return errors.Wrap(err, "failed to prepare putDiscussionOwnershipTransferStmt")
+	}
+
+	// ModerationLog
+	if d.prepStmts.putModerationLogEntryStmt, err = d.pg.PrepareContext(ctx, putModerationLogEntryString); err != nil {
+		logrus.WithError(err).Error("failed to prepare putModerationLogEntryStmt")
+		return errors.Wrap(err, "failed to prepare putModerationLogEntryStmt")
+	}
+	if d.prepStmts.getModerationLogEntriesByDiscussionIDFromCursorStmt, err = d.pg.PrepareContext(ctx, getModerationLogEntriesByDiscussionIDFromCursorString); err != nil {
+		logrus.WithError(err).Error("failed to prepare getModerationLogEntriesByDiscussionIDFromCursorStmt")
+		return errors.Wrap(err, "failed to prepare getModerationLogEntriesByDiscussionIDFromCursorStmt")
+	}
+
+	// DiscussionBans
+	if d.prepStmts.putDiscussionBanStmt, err = d.pg.PrepareContext(ctx, putDiscussionBanString); err != nil {
+		logrus.WithError(err).Error("failed to prepare putDiscussionBanStmt")
+		return errors.Wrap(err, "failed to prepare putDiscussionBanStmt")
+	}
+	if d.prepStmts.getDiscussionBanByDiscussionIDUserIDStmt, err = d.pg.PrepareContext(ctx, getDiscussionBanByDiscussionIDUserIDString); err != nil {
+		logrus.WithError(err).Error("failed to prepare getDiscussionBanByDiscussionIDUserIDStmt")
+		return errors.Wrap(err, "failed to prepare getDiscussionBanByDiscussionIDUserIDStmt")
+	}
+	if d.prepStmts.deleteDiscussionBanStmt, err = d.pg.PrepareContext(ctx, deleteDiscussionBanString); err != nil {
+		logrus.WithError(err).Error("failed to prepare deleteDiscussionBanStmt")
+		return errors.Wrap(err, "failed to prepare deleteDiscussionBanStmt")
+	}
+	if d.prepStmts.restoreParticipantPostsStmt, err = d.pg.PrepareContext(ctx, restoreParticipantPostsString); err != nil {
+		logrus.WithError(err).Error("failed to prepare restoreParticipantPostsStmt")
+		return errors.Wrap(err, "failed to prepare restoreParticipantPostsStmt")
+	}
+
+	// PostReports
+	if d.prepStmts.putPostReportStmt, err = d.pg.PrepareContext(ctx, putPostReportString); err != nil {
+		logrus.WithError(err).Error("failed to prepare putPostReportStmt")
+		return errors.Wrap(err, "failed to prepare putPostReportStmt")
+	}
+	if d.prepStmts.getPostReportByIDStmt, err = d.pg.PrepareContext(ctx, getPostReportByIDString); err != nil {
+		logrus.WithError(err).Error("failed to prepare getPostReportByIDStmt")
+		return errors.Wrap(err, "failed to prepare getPostReportByIDStmt")
+	}
+	if d.prepStmts.getPostReportByPostIDReporterUserIDStmt, err = d.pg.PrepareContext(ctx, getPostReportByPostIDReporterUserIDString); err != nil {
+		logrus.WithError(err).Error("failed to prepare getPostReportByPostIDReporterUserIDStmt")
+		return errors.Wrap(err, "failed to prepare getPostReportByPostIDReporterUserIDStmt")
+	}
+	if d.prepStmts.getPostReportsByDiscussionIDStatusFromCursorStmt, err = d.pg.PrepareContext(ctx, getPostReportsByDiscussionIDStatusFromCursorString); err != nil {
+		logrus.WithError(err).Error("failed to prepare getPostReportsByDiscussionIDStatusFromCursorStmt")
+		return errors.Wrap(err, "failed to prepare getPostReportsByDiscussionIDStatusFromCursorStmt")
+	}
+	if d.prepStmts.resolvePostReportsByPostIDStmt, err = d.pg.PrepareContext(ctx, resolvePostReportsByPostIDString); err != nil {
+		logrus.WithError(err).Error("failed to prepare resolvePostReportsByPostIDStmt")
+		return errors.Wrap(err, "failed to prepare resolvePostReportsByPostIDStmt")
+	}
+
 	d.ready = true
 	return
 }
@@ -75,6 +75,8 @@ func (d *delphisDB) GetDiscussionByLinkSlug(ctx context.Context, slug string) (*
 		&discussion.LastPostCreatedAt,
 		&discussion.ShuffleCount,
 		&discussion.LockStatus,
+		&discussion.EveryoneMentionPolicy,
+		&discussion.SlowModeSeconds,
 	); err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil
@@ -203,6 +205,8 @@ func (d *delphisDB) UpsertDiscussion(ctx context.Context, discussion model.Discu
 			"LastPostID":            discussion.LastPostID,
 			"LastPostCreatedAt":     discussion.LastPostCreatedAt,
 			"LockStatus":            discussion.LockStatus,
+			"EveryoneMentionPolicy": discussion.EveryoneMentionPolicy,
+			"SlowModeSeconds":       discussion.SlowModeSeconds,
 		}).First(&found).Error; err != nil {
 			logrus.WithError(err).Errorf("UpsertDiscussion::Failed updating disucssion object")
 			return nil, err
@@ -272,6 +276,8 @@ func (iter *discussionIter) Next(discussion *model.Discussion) bool {
 		&discussion.LastPostCreatedAt,
 		&discussion.ShuffleCount,
 		&discussion.LockStatus,
+		&discussion.EveryoneMentionPolicy,
+		&discussion.SlowModeSeconds,
 	); iter.err != nil {
 		logrus.WithError(iter.err).Error("iterator failed to scan row")
 		return false
@@ -114,6 +114,31 @@ func (d *delphisDB) GetDUAForMentionNotifications(ctx context.Context, discussio
 	}
 }
 
+// GetDUAForEveryoneMentionNotifications returns everyone in the discussion
+// who is notified of mentions, for expanding an @everyone mention.
+func (d *delphisDB) GetDUAForEveryoneMentionNotifications(ctx context.Context, discussionID string, userID string) DiscussionUserAccessIter {
+	logrus.Debug("GetDUAForEveryoneMentionNotifications::SQL Query")
+	if err := d.initializeStatements(ctx); err != nil {
+		logrus.WithError(err).Error("GetDUAForEveryoneMentionNotifications::failed to initialize statements")
+		return &duaIter{err: err}
+	}
+
+	rows, err := d.prepStmts.getDUAForEveryoneMentionNotificationsStmt.QueryContext(
+		ctx,
+		discussionID,
+		userID,
+	)
+	if err != nil {
+		logrus.WithError(err).Error("failed to query getDUAForEveryoneMentionNotificationsString")
+		return &duaIter{err: err}
+	}
+
+	return &duaIter{
+		ctx:  ctx,
+		rows: rows,
+	}
+}
+
 func (d *delphisDB) UpsertDiscussionUserAccess(ctx context.Context, tx *sql.Tx, dua model.DiscussionUserAccess) (*model.DiscussionUserAccess, error) {
 	logrus.Debug("UpsertDiscussionUserAccess::SQL Create")
 	if err := d.initializeStatements(ctx); err != nil {
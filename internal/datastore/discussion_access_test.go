@@ -82,12 +82,12 @@ func TestDelphisDB_GetDiscussionsForUserAccess(t *testing.T) {
 			rs := sqlmock.NewRows([]string{"id", "created_at", "updated_at", "deleted_at", "title",
 				"anonymity_type", "moderator_id", "icon_url", "description", "title_history",
 				"description_history", "discussion_joinability", "last_post_id", "last_post_created_at",
-				"shuffle_count", "lock_status"}).
+				"shuffle_count", "lock_status", "everyone_mention_policy", "slow_mode_seconds"}).
 				AddRow(discObj.ID, discObj.CreatedAt, discObj.UpdatedAt, discObj.DeletedAt,
 					discObj.Title, discObj.AnonymityType, discObj.ModeratorID, discObj.IconURL,
 					discObj.Description, discObj.TitleHistory, discObj.DescriptionHistory,
 					discObj.DiscussionJoinability, discObj.LastPostID, discObj.LastPostCreatedAt,
-					discObj.ShuffleCount, discObj.LockStatus)
+					discObj.ShuffleCount, discObj.LockStatus, discObj.EveryoneMentionPolicy, discObj.SlowModeSeconds)
 
 			mock.ExpectQuery(getDiscussionsByUserAccessString).WithArgs(userID, state).WillReturnRows(rs)
 
@@ -304,6 +304,70 @@ func TestDelphisDB_GetDUAForMentionNotifications(t *testing.T) {
 	})
 }
 
+func TestDelphisDB_GetDUAForEveryoneMentionNotifications(t *testing.T) {
+	ctx := context.Background()
+	discussionID := test_utils.DiscussionID
+	duaObj := test_utils.TestDiscussionUserAccess()
+
+	postingUser := "postingUser"
+
+	emptyDuaObj := model.DiscussionUserAccess{}
+
+	Convey("GetDUAForEveryoneMentionNotifications", t, func() {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+
+		assert.Nil(t, err, "Failed setting up sqlmock db")
+
+		gormDB, _ := gorm.Open("postgres", db)
+		mockDatastore := &delphisDB{
+			dbConfig:  config.TablesConfig{},
+			sql:       gormDB,
+			pg:        db,
+			prepStmts: &dbPrepStmts{},
+			dynamo:    nil,
+			encoder:   nil,
+		}
+		defer db.Close()
+
+		Convey("when preparing statements returns an error", func() {
+			mockPreparedStatementsWithError(mock)
+
+			iter := mockDatastore.GetDUAForEveryoneMentionNotifications(ctx, discussionID, postingUser)
+
+			So(iter.Next(&emptyDuaObj), ShouldBeFalse)
+			So(iter.Close(), ShouldNotBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when query execution returns an error", func() {
+			mockPreparedStatements(mock)
+			mock.ExpectQuery(getDUAForEveryoneMentionNotificationsString).WithArgs(discussionID, postingUser).WillReturnError(fmt.Errorf("error"))
+
+			iter := mockDatastore.GetDUAForEveryoneMentionNotifications(ctx, discussionID, postingUser)
+
+			So(iter.Next(&emptyDuaObj), ShouldBeFalse)
+			So(iter.Close(), ShouldNotBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when query execution succeeds and returns user access", func() {
+			mockPreparedStatements(mock)
+			rs := sqlmock.NewRows([]string{"discussion_id", "user_id", "state", "request_id",
+				"notif_setting", "created_at", "updated_at", "deleted_at"}).
+				AddRow(duaObj.DiscussionID, duaObj.UserID, duaObj.State, duaObj.RequestID,
+					duaObj.NotifSetting, duaObj.CreatedAt, duaObj.UpdatedAt, duaObj.DeletedAt)
+
+			mock.ExpectQuery(getDUAForEveryoneMentionNotificationsString).WithArgs(discussionID, postingUser).WillReturnRows(rs)
+
+			iter := mockDatastore.GetDUAForEveryoneMentionNotifications(ctx, discussionID, postingUser)
+
+			So(iter.Next(&emptyDuaObj), ShouldBeTrue)
+			So(iter.Close(), ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+	})
+}
+
 func TestDelphisDB_UpsertDiscussionUserAccess(t *testing.T) {
 	ctx := context.Background()
 	userID := "userID"
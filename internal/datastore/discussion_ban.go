@@ -0,0 +1,88 @@
+package datastore
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/delphis-inc/delphisbe/graph/model"
+	"github.com/sirupsen/logrus"
+)
+
+func (d *delphisDB) PutDiscussionBan(ctx context.Context, ban model.DiscussionBan) (*model.DiscussionBan, error) {
+	logrus.Debug("PutDiscussionBan::SQL Insert")
+	if err := d.initializeStatements(ctx); err != nil {
+		logrus.WithError(err).Error("PutDiscussionBan::failed to initialize statements")
+		return nil, err
+	}
+
+	if err := d.prepStmts.putDiscussionBanStmt.QueryRowContext(
+		ctx,
+		ban.ParticipantID,
+		ban.DiscussionID,
+		ban.UserID,
+		ban.Reason,
+		ban.BannedByUserID,
+	).Scan(
+		&ban.ParticipantID,
+		&ban.DiscussionID,
+		&ban.UserID,
+		&ban.Reason,
+		&ban.BannedByUserID,
+		&ban.CreatedAt,
+	); err != nil {
+		logrus.WithError(err).Error("failed to execute putDiscussionBanStmt")
+		return nil, err
+	}
+
+	return &ban, nil
+}
+
+// GetDiscussionBanByDiscussionIDUserID returns the user's latest ban in the
+// discussion, if any of their participants is banned.
+func (d *delphisDB) GetDiscussionBanByDiscussionIDUserID(ctx context.Context, discussionID string, userID string) (*model.DiscussionBan, error) {
+	logrus.Debug("GetDiscussionBanByDiscussionIDUserID::SQL Query")
+	if err := d.initializeStatements(ctx); err != nil {
+		logrus.WithError(err).Error("GetDiscussionBanByDiscussionIDUserID::failed to initialize statements")
+		return nil, err
+	}
+
+	ban := model.DiscussionBan{}
+	if err := d.prepStmts.getDiscussionBanByDiscussionIDUserIDStmt.QueryRowContext(
+		ctx,
+		discussionID,
+		userID,
+	).Scan(
+		&ban.ParticipantID,
+		&ban.DiscussionID,
+		&ban.UserID,
+		&ban.Reason,
+		&ban.BannedByUserID,
+		&ban.CreatedAt,
+	); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		logrus.WithError(err).Error("failed to execute getDiscussionBanByDiscussionIDUserIDStmt")
+		return nil, err
+	}
+
+	return &ban, nil
+}
+
+func (d *delphisDB) DeleteDiscussionBan(ctx context.Context, participantID string) error {
+	logrus.Debug("DeleteDiscussionBan::SQL Delete")
+	if err := d.initializeStatements(ctx); err != nil {
+		logrus.WithError(err).Error("DeleteDiscussionBan::failed to initialize statements")
+		return err
+	}
+
+	if _, err := d.prepStmts.deleteDiscussionBanStmt.ExecContext(
+		ctx,
+		participantID,
+	); err != nil {
+		logrus.WithError(err).Error("failed to execute deleteDiscussionBanStmt")
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,215 @@
+package datastore
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/delphis-inc/delphisbe/graph/model"
+	"github.com/delphis-inc/delphisbe/internal/config"
+	"github.com/jinzhu/gorm"
+	. "github.com/smartystreets/goconvey/convey"
+	"github.com/stretchr/testify/assert"
+)
+
+var discussionBanColumns = []string{"participant_id", "discussion_id", "user_id", "reason", "banned_by_user_id", "created_at"}
+
+func TestDelphisDB_PutDiscussionBan(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	reason := "Spamming links"
+	banObj := model.DiscussionBan{
+		ParticipantID:  "participant1",
+		DiscussionID:   "discussion1",
+		UserID:         "user1",
+		Reason:         &reason,
+		BannedByUserID: "user2",
+		CreatedAt:      now,
+	}
+
+	Convey("PutDiscussionBan", t, func() {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+
+		assert.Nil(t, err, "Failed setting up sqlmock db")
+
+		gormDB, _ := gorm.Open("postgres", db)
+		mockDatastore := &delphisDB{
+			dbConfig:  config.TablesConfig{},
+			sql:       gormDB,
+			pg:        db,
+			prepStmts: &dbPrepStmts{},
+			dynamo:    nil,
+			encoder:   nil,
+		}
+		defer db.Close()
+
+		Convey("when preparing statements returns an error", func() {
+			mockPreparedStatementsWithError(mock)
+
+			resp, err := mockDatastore.PutDiscussionBan(ctx, banObj)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when query execution returns an error", func() {
+			mockPreparedStatements(mock)
+			mock.ExpectQuery(putDiscussionBanString).WithArgs(banObj.ParticipantID, banObj.DiscussionID, banObj.UserID,
+				banObj.Reason, banObj.BannedByUserID).WillReturnError(fmt.Errorf("error"))
+
+			resp, err := mockDatastore.PutDiscussionBan(ctx, banObj)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when query execution succeeds", func() {
+			mockPreparedStatements(mock)
+			rs := sqlmock.NewRows(discussionBanColumns).
+				AddRow(banObj.ParticipantID, banObj.DiscussionID, banObj.UserID, banObj.Reason, banObj.BannedByUserID, banObj.CreatedAt)
+			mock.ExpectQuery(putDiscussionBanString).WithArgs(banObj.ParticipantID, banObj.DiscussionID, banObj.UserID,
+				banObj.Reason, banObj.BannedByUserID).WillReturnRows(rs)
+
+			resp, err := mockDatastore.PutDiscussionBan(ctx, banObj)
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldResemble, &banObj)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+	})
+}
+
+func TestDelphisDB_GetDiscussionBanByDiscussionIDUserID(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	reason := "Spamming links"
+	banObj := model.DiscussionBan{
+		ParticipantID:  "participant1",
+		DiscussionID:   "discussion1",
+		UserID:         "user1",
+		Reason:         &reason,
+		BannedByUserID: "user2",
+		CreatedAt:      now,
+	}
+
+	Convey("GetDiscussionBanByDiscussionIDUserID", t, func() {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+
+		assert.Nil(t, err, "Failed setting up sqlmock db")
+
+		gormDB, _ := gorm.Open("postgres", db)
+		mockDatastore := &delphisDB{
+			dbConfig:  config.TablesConfig{},
+			sql:       gormDB,
+			pg:        db,
+			prepStmts: &dbPrepStmts{},
+			dynamo:    nil,
+			encoder:   nil,
+		}
+		defer db.Close()
+
+		Convey("when preparing statements returns an error", func() {
+			mockPreparedStatementsWithError(mock)
+
+			resp, err := mockDatastore.GetDiscussionBanByDiscussionIDUserID(ctx, banObj.DiscussionID, banObj.UserID)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when query execution returns an error", func() {
+			mockPreparedStatements(mock)
+			mock.ExpectQuery(getDiscussionBanByDiscussionIDUserIDString).WithArgs(banObj.DiscussionID, banObj.UserID).
+				WillReturnError(fmt.Errorf("error"))
+
+			resp, err := mockDatastore.GetDiscussionBanByDiscussionIDUserID(ctx, banObj.DiscussionID, banObj.UserID)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when the user is not banned", func() {
+			mockPreparedStatements(mock)
+			mock.ExpectQuery(getDiscussionBanByDiscussionIDUserIDString).WithArgs(banObj.DiscussionID, banObj.UserID).
+				WillReturnError(sql.ErrNoRows)
+
+			resp, err := mockDatastore.GetDiscussionBanByDiscussionIDUserID(ctx, banObj.DiscussionID, banObj.UserID)
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when query execution succeeds", func() {
+			mockPreparedStatements(mock)
+			rs := sqlmock.NewRows(discussionBanColumns).
+				AddRow(banObj.ParticipantID, banObj.DiscussionID, banObj.UserID, banObj.Reason, banObj.BannedByUserID, banObj.CreatedAt)
+			mock.ExpectQuery(getDiscussionBanByDiscussionIDUserIDString).WithArgs(banObj.DiscussionID, banObj.UserID).
+				WillReturnRows(rs)
+
+			resp, err := mockDatastore.GetDiscussionBanByDiscussionIDUserID(ctx, banObj.DiscussionID, banObj.UserID)
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldResemble, &banObj)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+	})
+}
+
+func TestDelphisDB_DeleteDiscussionBan(t *testing.T) {
+	ctx := context.Background()
+	participantID := "participant1"
+
+	Convey("DeleteDiscussionBan", t, func() {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+
+		assert.Nil(t, err, "Failed setting up sqlmock db")
+
+		gormDB, _ := gorm.Open("postgres", db)
+		mockDatastore := &delphisDB{
+			dbConfig:  config.TablesConfig{},
+			sql:       gormDB,
+			pg:        db,
+			prepStmts: &dbPrepStmts{},
+			dynamo:    nil,
+			encoder:   nil,
+		}
+		defer db.Close()
+
+		Convey("when preparing statements returns an error", func() {
+			mockPreparedStatementsWithError(mock)
+
+			err := mockDatastore.DeleteDiscussionBan(ctx, participantID)
+
+			So(err, ShouldNotBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when query execution returns an error", func() {
+			mockPreparedStatements(mock)
+			mock.ExpectExec(deleteDiscussionBanString).WithArgs(participantID).WillReturnError(fmt.Errorf("error"))
+
+			err := mockDatastore.DeleteDiscussionBan(ctx, participantID)
+
+			So(err, ShouldNotBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when query execution succeeds", func() {
+			mockPreparedStatements(mock)
+			mock.ExpectExec(deleteDiscussionBanString).WithArgs(participantID).WillReturnResult(sqlmock.NewResult(0, 1))
+
+			err := mockDatastore.DeleteDiscussionBan(ctx, participantID)
+
+			So(err, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+	})
+}
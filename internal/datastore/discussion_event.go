@@ -0,0 +1,79 @@
+package datastore
+
+import (
+	"context"
+
+	"github.com/delphis-inc/delphisbe/graph/model"
+	"github.com/sirupsen/logrus"
+)
+
+func (d *delphisDB) PutDiscussionEvent(ctx context.Context, event model.DiscussionEvent) (*model.DiscussionEvent, error) {
+	logrus.Debug("PutDiscussionEvent::SQL Insert")
+	if err := d.initializeStatements(ctx); err != nil {
+		logrus.WithError(err).Error("PutDiscussionEvent::failed to initialize statements")
+		return nil, err
+	}
+
+	if err := d.prepStmts.putDiscussionEventStmt.QueryRowContext(
+		ctx,
+		event.DiscussionID,
+		event.EventType,
+		event.EntityType,
+		event.EntityID,
+	).Scan(
+		&event.Sequence,
+		&event.DiscussionID,
+		&event.EventType,
+		&event.EntityType,
+		&event.EntityID,
+		&event.CreatedAt,
+	); err != nil {
+		logrus.WithError(err).Error("failed to execute putDiscussionEventStmt")
+		return nil, err
+	}
+
+	return &event, nil
+}
+
+func (d *delphisDB) GetDiscussionEventsAfterSequence(ctx context.Context, discussionID string, afterSequence int, limit int) ([]*model.DiscussionEvent, error) {
+	logrus.Debug("GetDiscussionEventsAfterSequence::SQL Query")
+	if err := d.initializeStatements(ctx); err != nil {
+		logrus.WithError(err).Error("GetDiscussionEventsAfterSequence::failed to initialize statements")
+		return nil, err
+	}
+
+	rows, err := d.prepStmts.getDiscussionEventsAfterSequenceStmt.QueryContext(
+		ctx,
+		discussionID,
+		afterSequence,
+		limit,
+	)
+	if err != nil {
+		logrus.WithError(err).Error("failed to query getDiscussionEventsAfterSequenceStmt")
+		return nil, err
+	}
+	defer rows.Close()
+
+	events := make([]*model.DiscussionEvent, 0)
+	for rows.Next() {
+		event := model.DiscussionEvent{}
+		if err := rows.Scan(
+			&event.Sequence,
+			&event.DiscussionID,
+			&event.EventType,
+			&event.EntityType,
+			&event.EntityID,
+			&event.CreatedAt,
+		); err != nil {
+			logrus.WithError(err).Error("failed to scan discussion event")
+			return nil, err
+		}
+		events = append(events, &event)
+	}
+	if err := rows.Err(); err != nil {
+		logrus.WithError(err).Error("failed iterating discussion events")
+		return nil, err
+	}
+
+	return events, nil
+}
@@ -0,0 +1,168 @@
+package datastore
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/delphis-inc/delphisbe/graph/model"
+	"github.com/delphis-inc/delphisbe/internal/config"
+	"github.com/jinzhu/gorm"
+	. "github.com/smartystreets/goconvey/convey"
+	"github.com/stretchr/testify/assert"
+)
+
+var discussionEventColumns = []string{"sequence", "discussion_id", "event_type", "entity_type", "entity_id", "created_at"}
+
+func TestDelphisDB_PutDiscussionEvent(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	eventObj := model.DiscussionEvent{
+		DiscussionID: "discussion1",
+		EventType:    model.DiscussionSubscriptionEventTypePostAdded,
+		EntityType:   "post",
+		EntityID:     "post1",
+	}
+
+	Convey("PutDiscussionEvent", t, func() {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+
+		assert.Nil(t, err, "Failed setting up sqlmock db")
+
+		gormDB, _ := gorm.Open("postgres", db)
+		mockDatastore := &delphisDB{
+			dbConfig:  config.TablesConfig{},
+			sql:       gormDB,
+			pg:        db,
+			prepStmts: &dbPrepStmts{},
+			dynamo:    nil,
+			encoder:   nil,
+		}
+		defer db.Close()
+
+		Convey("when preparing statements returns an error", func() {
+			mockPreparedStatementsWithError(mock)
+
+			resp, err := mockDatastore.PutDiscussionEvent(ctx, eventObj)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when query execution returns an error", func() {
+			mockPreparedStatements(mock)
+			mock.ExpectQuery(putDiscussionEventString).WithArgs(eventObj.DiscussionID, eventObj.EventType,
+				eventObj.EntityType, eventObj.EntityID).WillReturnError(fmt.Errorf("error"))
+
+			resp, err := mockDatastore.PutDiscussionEvent(ctx, eventObj)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when query execution succeeds", func() {
+			expected := eventObj
+			expected.Sequence = 42
+			expected.CreatedAt = now
+
+			mockPreparedStatements(mock)
+			rs := sqlmock.NewRows(discussionEventColumns).
+				AddRow(expected.Sequence, expected.DiscussionID, expected.EventType, expected.EntityType, expected.EntityID, expected.CreatedAt)
+			mock.ExpectQuery(putDiscussionEventString).WithArgs(eventObj.DiscussionID, eventObj.EventType,
+				eventObj.EntityType, eventObj.EntityID).WillReturnRows(rs)
+
+			resp, err := mockDatastore.PutDiscussionEvent(ctx, eventObj)
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldResemble, &expected)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+	})
+}
+
+func TestDelphisDB_GetDiscussionEventsAfterSequence(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	discussionID := "discussion1"
+	afterSequence := 10
+	limit := 100
+	eventObj := model.DiscussionEvent{
+		Sequence:     11,
+		DiscussionID: discussionID,
+		EventType:    model.DiscussionSubscriptionEventTypePostDeleted,
+		EntityType:   "post",
+		EntityID:     "post1",
+		CreatedAt:    now,
+	}
+
+	Convey("GetDiscussionEventsAfterSequence", t, func() {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+
+		assert.Nil(t, err, "Failed setting up sqlmock db")
+
+		gormDB, _ := gorm.Open("postgres", db)
+		mockDatastore := &delphisDB{
+			dbConfig:  config.TablesConfig{},
+			sql:       gormDB,
+			pg:        db,
+			prepStmts: &dbPrepStmts{},
+			dynamo:    nil,
+			encoder:   nil,
+		}
+		defer db.Close()
+
+		Convey("when preparing statements returns an error", func() {
+			mockPreparedStatementsWithError(mock)
+
+			resp, err := mockDatastore.GetDiscussionEventsAfterSequence(ctx, discussionID, afterSequence, limit)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when query execution returns an error", func() {
+			mockPreparedStatements(mock)
+			mock.ExpectQuery(getDiscussionEventsAfterSequenceString).WithArgs(discussionID, afterSequence, limit).
+				WillReturnError(fmt.Errorf("error"))
+
+			resp, err := mockDatastore.GetDiscussionEventsAfterSequence(ctx, discussionID, afterSequence, limit)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when scanning a row returns an error", func() {
+			mockPreparedStatements(mock)
+			rs := sqlmock.NewRows(discussionEventColumns).
+				AddRow("not a number", eventObj.DiscussionID, eventObj.EventType, eventObj.EntityType, eventObj.EntityID, eventObj.CreatedAt)
+			mock.ExpectQuery(getDiscussionEventsAfterSequenceString).WithArgs(discussionID, afterSequence, limit).
+				WillReturnRows(rs)
+
+			resp, err := mockDatastore.GetDiscussionEventsAfterSequence(ctx, discussionID, afterSequence, limit)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when query execution succeeds", func() {
+			mockPreparedStatements(mock)
+			rs := sqlmock.NewRows(discussionEventColumns).
+				AddRow(eventObj.Sequence, eventObj.DiscussionID, eventObj.EventType, eventObj.EntityType, eventObj.EntityID, eventObj.CreatedAt)
+			mock.ExpectQuery(getDiscussionEventsAfterSequenceString).WithArgs(discussionID, afterSequence, limit).
+				WillReturnRows(rs)
+
+			resp, err := mockDatastore.GetDiscussionEventsAfterSequence(ctx, discussionID, afterSequence, limit)
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldResemble, []*model.DiscussionEvent{&eventObj})
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+	})
+}
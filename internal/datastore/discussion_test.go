@@ -338,7 +338,7 @@ func TestDelphisDB_GetDiscussionByLinkSlug(t *testing.T) {
 			rs := sqlmock.NewRows([]string{"id", "created_at", "updated_at", "deleted_at", "title",
 				"anonymity_type", "moderator_id", "icon_url", "description",
 				"title_history", "description_history", "discussion_joinability", "last_post_id", "last_post_created_at",
-				"shuffle_count", "lock_status"})
+				"shuffle_count", "lock_status", "everyone_mention_policy", "slow_mode_seconds"})
 
 			mock.ExpectQuery(getDiscussionByLinkSlugString).WithArgs(slug).WillReturnRows(rs)
 
@@ -354,12 +354,12 @@ func TestDelphisDB_GetDiscussionByLinkSlug(t *testing.T) {
 			rs := sqlmock.NewRows([]string{"id", "created_at", "updated_at", "deleted_at", "title",
 				"anonymity_type", "moderator_id", "icon_url", "description",
 				"title_history", "description_history", "discussion_joinability", "last_post_id", "last_post_created_at",
-				"shuffle_count", "lock_status"}).
+				"shuffle_count", "lock_status", "everyone_mention_policy", "slow_mode_seconds"}).
 				AddRow(discObj.ID, discObj.CreatedAt, discObj.UpdatedAt, discObj.DeletedAt,
 					discObj.Title, discObj.AnonymityType, discObj.ModeratorID,
 					discObj.IconURL, discObj.Description, discObj.TitleHistory,
 					discObj.DescriptionHistory, discObj.DiscussionJoinability, discObj.LastPostID, discObj.LastPostCreatedAt,
-					discObj.ShuffleCount, discObj.LockStatus)
+					discObj.ShuffleCount, discObj.LockStatus, discObj.EveryoneMentionPolicy, discObj.SlowModeSeconds)
 
 			mock.ExpectQuery(getDiscussionByLinkSlugString).WithArgs(slug).WillReturnRows(rs)
 
@@ -520,17 +520,17 @@ func TestDelphisDB_ListDiscussionsByUserID(t *testing.T) {
 			rs := sqlmock.NewRows([]string{"id", "created_at", "updated_at", "deleted_at", "title",
 				"anonymity_type", "moderator_id", "icon_url", "description",
 				"title_history", "description_history", "discussion_joinability", "last_post_id", "last_post_created_at",
-				"shuffle_count", "lock_status"}).
+				"shuffle_count", "lock_status", "everyone_mention_policy", "slow_mode_seconds"}).
 				AddRow(discObj.ID, discObj.CreatedAt, discObj.UpdatedAt, discObj.DeletedAt,
 					discObj.Title, discObj.AnonymityType, discObj.ModeratorID,
 					discObj.IconURL, discObj.Description, discObj.TitleHistory,
 					discObj.DescriptionHistory, discObj.DiscussionJoinability, discObj.LastPostID, discObj.LastPostCreatedAt,
-					discObj.ShuffleCount, discObj.LockStatus).
+					discObj.ShuffleCount, discObj.LockStatus, discObj.EveryoneMentionPolicy, discObj.SlowModeSeconds).
 				AddRow(discObj.ID, discObj.CreatedAt, discObj.UpdatedAt, discObj.DeletedAt,
 					discObj.Title, discObj.AnonymityType, discObj.ModeratorID,
 					discObj.IconURL, discObj.Description, discObj.TitleHistory,
 					discObj.DescriptionHistory, discObj.DiscussionJoinability, discObj.LastPostID, discObj.LastPostCreatedAt,
-					discObj.ShuffleCount, discObj.LockStatus)
+					discObj.ShuffleCount, discObj.LockStatus, discObj.EveryoneMentionPolicy, discObj.SlowModeSeconds)
 
 			mock.ExpectQuery(getDiscussionsByUserAccessString).WithArgs(userID, state).WillReturnRows(rs)
 
@@ -594,14 +594,14 @@ func TestDelphisDB_UpsertDiscussion(t *testing.T) {
 		defer db.Close()
 
 		expectedFindQueryStr := `SELECT * FROM "discussions" WHERE "discussions"."deleted_at" IS NULL AND (("discussions"."id" = $1)) ORDER BY "discussions"."id" ASC LIMIT 1`
-		createQueryStr := `INSERT INTO "discussions" ("id","created_at","updated_at","deleted_at","title","description","title_history","description_history","anonymity_type","moderator_id","icon_url","discussion_joinability","last_post_id","last_post_created_at","shuffle_count","lock_status") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15,$16) RETURNING "discussions"."id"`
+		createQueryStr := `INSERT INTO "discussions" ("id","created_at","updated_at","deleted_at","title","description","title_history","description_history","anonymity_type","moderator_id","icon_url","discussion_joinability","last_post_id","last_post_created_at","shuffle_count","lock_status","everyone_mention_policy","slow_mode_seconds") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15,$16,$17,$18) RETURNING "discussions"."id"`
 
 		expectedNewObjectRow := sqlmock.NewRows([]string{"id", "created_at", "updated_at", "deleted_at", "title", "description", "title_history",
 			"description_history", "anonymity_type", "moderator_id", "icon_url", "discussion_joinability"}).
 			AddRow(discObj.ID, discObj.CreatedAt, discObj.UpdatedAt, discObj.DeletedAt, discObj.Title, discObj.Description, discObj.TitleHistory, discObj.DescriptionHistory, discObj.AnonymityType,
 				discObj.ModeratorID, discObj.IconURL, discObj.DiscussionJoinability)
 
-		expectedUpdateStr := `UPDATE "discussions" SET "anonymity_type" = $1, "description" = $2, "description_history" = $3, "discussion_joinability" = $4, "icon_url" = $5, "last_post_created_at" = $6, "last_post_id" = $7, "lock_status" = $8, "title" = $9, "title_history" = $10, "updated_at" = $11 WHERE "discussions"."deleted_at" IS NULL AND "discussions"."id" = $12`
+		expectedUpdateStr := `UPDATE "discussions" SET "anonymity_type" = $1, "description" = $2, "description_history" = $3, "discussion_joinability" = $4, "everyone_mention_policy" = $5, "icon_url" = $6, "last_post_created_at" = $7, "last_post_id" = $8, "lock_status" = $9, "slow_mode_seconds" = $10, "title" = $11, "title_history" = $12, "updated_at" = $13 WHERE "discussions"."deleted_at" IS NULL AND "discussions"."id" = $14`
 		expectedPostUpdateSelectStr := `SELECT * FROM "discussions" WHERE "discussions"."deleted_at" IS NULL AND "discussions"."id" = $1 ORDER BY "discussions"."id" ASC LIMIT 1`
 		expectedPostUpdateModSelectStr := `SELECT * FROM "moderators"  WHERE "moderators"."deleted_at" IS NULL AND (("id" IN ($1))) ORDER BY "moderators"."id" ASC`
 
@@ -627,7 +627,7 @@ func TestDelphisDB_UpsertDiscussion(t *testing.T) {
 					discObj.ID, discObj.CreatedAt, discObj.UpdatedAt, discObj.DeletedAt, discObj.Title,
 					discObj.Description, discObj.TitleHistory, discObj.DescriptionHistory, discObj.AnonymityType,
 					discObj.ModeratorID, discObj.IconURL, discObj.DiscussionJoinability, discObj.LastPostID,
-					discObj.LastPostCreatedAt, discObj.ShuffleCount, discObj.LockStatus,
+					discObj.LastPostCreatedAt, discObj.ShuffleCount, discObj.LockStatus, discObj.EveryoneMentionPolicy, discObj.SlowModeSeconds,
 				).WillReturnError(expectedError)
 
 				resp, err := mockDatastore.UpsertDiscussion(ctx, discObj)
@@ -644,7 +644,7 @@ func TestDelphisDB_UpsertDiscussion(t *testing.T) {
 					discObj.ID, discObj.CreatedAt, discObj.UpdatedAt, discObj.DeletedAt, discObj.Title, discObj.Description,
 					discObj.TitleHistory, discObj.DescriptionHistory, discObj.AnonymityType,
 					discObj.ModeratorID, discObj.IconURL, discObj.DiscussionJoinability, discObj.LastPostID,
-					discObj.LastPostCreatedAt, discObj.ShuffleCount, discObj.LockStatus,
+					discObj.LastPostCreatedAt, discObj.ShuffleCount, discObj.LockStatus, discObj.EveryoneMentionPolicy, discObj.SlowModeSeconds,
 				).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(discObj.ID))
 				mock.ExpectCommit()
 				mock.ExpectQuery(expectedFindQueryStr).WithArgs(discObj.ID).WillReturnRows(expectedNewObjectRow)
@@ -665,8 +665,8 @@ func TestDelphisDB_UpsertDiscussion(t *testing.T) {
 				mock.ExpectBegin()
 				mock.ExpectExec(expectedUpdateStr).WithArgs(
 					discObj.AnonymityType, discObj.Description, discObj.DescriptionHistory,
-					discObj.DiscussionJoinability, discObj.IconURL, discObj.LastPostCreatedAt,
-					discObj.LastPostID, discObj.LockStatus, discObj.Title,
+					discObj.DiscussionJoinability, discObj.EveryoneMentionPolicy, discObj.IconURL, discObj.LastPostCreatedAt,
+					discObj.LastPostID, discObj.LockStatus, discObj.SlowModeSeconds, discObj.Title,
 					discObj.TitleHistory, sqlmock.AnyArg(), discObj.ID,
 				).WillReturnError(expectedError)
 
@@ -683,8 +683,8 @@ func TestDelphisDB_UpsertDiscussion(t *testing.T) {
 				mock.ExpectBegin()
 				mock.ExpectExec(expectedUpdateStr).WithArgs(
 					discObj.AnonymityType, discObj.Description, discObj.DescriptionHistory,
-					discObj.DiscussionJoinability, discObj.IconURL, discObj.LastPostCreatedAt,
-					discObj.LastPostID, discObj.LockStatus, discObj.Title,
+					discObj.DiscussionJoinability, discObj.EveryoneMentionPolicy, discObj.IconURL, discObj.LastPostCreatedAt,
+					discObj.LastPostID, discObj.LockStatus, discObj.SlowModeSeconds, discObj.Title,
 					discObj.TitleHistory, sqlmock.AnyArg(), discObj.ID,
 				).WillReturnResult(sqlmock.NewResult(0, 1))
 				mock.ExpectCommit()
@@ -711,8 +711,8 @@ func TestDelphisDB_UpsertDiscussion(t *testing.T) {
 				mock.ExpectBegin()
 				mock.ExpectExec(expectedUpdateStr).WithArgs(
 					discObj.AnonymityType, discObj.Description, discObj.DescriptionHistory,
-					discObj.DiscussionJoinability, discObj.IconURL, discObj.LastPostCreatedAt,
-					discObj.LastPostID, discObj.LockStatus, discObj.Title,
+					discObj.DiscussionJoinability, discObj.EveryoneMentionPolicy, discObj.IconURL, discObj.LastPostCreatedAt,
+					discObj.LastPostID, discObj.LockStatus, discObj.SlowModeSeconds, discObj.Title,
 					discObj.TitleHistory, sqlmock.AnyArg(), discObj.ID,
 				).WillReturnResult(sqlmock.NewResult(0, 1))
 				mock.ExpectCommit()
@@ -864,17 +864,17 @@ func TestDelphisDB_DiscussionIterCollect(t *testing.T) {
 			rs := sqlmock.NewRows([]string{"id", "created_at", "updated_at", "deleted_at", "title",
 				"anonymity_type", "moderator_id", "icon_url", "description", "title_history",
 				"description_history", "discussion_joinability", "last_post_id", "last_post_created_at",
-				"shuffle_count", "lock_status"}).
+				"shuffle_count", "lock_status", "everyone_mention_policy", "slow_mode_seconds"}).
 				AddRow(discObj.ID, discObj.CreatedAt, discObj.UpdatedAt, discObj.DeletedAt,
 					discObj.Title, discObj.AnonymityType, discObj.ModeratorID, discObj.IconURL,
 					discObj.Description, discObj.TitleHistory, discObj.DescriptionHistory,
 					discObj.DiscussionJoinability, discObj.LastPostID, discObj.LastPostCreatedAt,
-					discObj.ShuffleCount, discObj.LockStatus).
+					discObj.ShuffleCount, discObj.LockStatus, discObj.EveryoneMentionPolicy, discObj.SlowModeSeconds).
 				AddRow(discObj.ID, discObj.CreatedAt, discObj.UpdatedAt, discObj.DeletedAt,
 					discObj.Title, discObj.AnonymityType, discObj.ModeratorID, discObj.IconURL,
 					discObj.Description, discObj.TitleHistory, discObj.DescriptionHistory,
 					discObj.DiscussionJoinability, discObj.LastPostID, discObj.LastPostCreatedAt,
-					discObj.ShuffleCount, discObj.LockStatus)
+					discObj.ShuffleCount, discObj.LockStatus, discObj.EveryoneMentionPolicy, discObj.SlowModeSeconds)
 
 			// Convert mocked rows to sql.Rows
 			mock.ExpectQuery("SELECT").WillReturnRows(rs)
@@ -987,15 +987,15 @@ func TestDiscussionIter_Next(t *testing.T) {
 			rs := sqlmock.NewRows([]string{"id", "created_at", "updated_at", "deleted_at", "title",
 				"anonymity_type", "moderator_id", "icon_url", "description",
 				"title_history", "description_history", "discussion_joinability", "last_post_id",
-				"last_post_created_at", "shuffle_count", "lock_status"}).
+				"last_post_created_at", "shuffle_count", "lock_status", "everyone_mention_policy", "slow_mode_seconds"}).
 				AddRow(discObj.ID, discObj.CreatedAt, discObj.UpdatedAt, discObj.DeletedAt, discObj.Title,
 					discObj.AnonymityType, discObj.ModeratorID, discObj.IconURL, discObj.Description,
 					discObj.TitleHistory, discObj.DescriptionHistory, discObj.DiscussionJoinability,
-					discObj.LastPostID, discObj.LastPostCreatedAt, discObj.ShuffleCount, discObj.LockStatus).
+					discObj.LastPostID, discObj.LastPostCreatedAt, discObj.ShuffleCount, discObj.LockStatus, discObj.EveryoneMentionPolicy, discObj.SlowModeSeconds).
 				AddRow(discObj.ID, discObj.CreatedAt, discObj.UpdatedAt, discObj.DeletedAt, discObj.Title,
 					discObj.AnonymityType, discObj.ModeratorID, discObj.IconURL, discObj.Description,
 					discObj.TitleHistory, discObj.DescriptionHistory, discObj.DiscussionJoinability,
-					discObj.LastPostID, discObj.LastPostCreatedAt, discObj.ShuffleCount, discObj.LockStatus)
+					discObj.LastPostID, discObj.LastPostCreatedAt, discObj.ShuffleCount, discObj.LockStatus, discObj.EveryoneMentionPolicy, discObj.SlowModeSeconds)
 
 			// Convert mocked rows to sql.Rows
 			mock.ExpectQuery("SELECT").WillReturnRows(rs)
@@ -0,0 +1,135 @@
+package datastore
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/delphis-inc/delphisbe/graph/model"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+func (d *delphisDB) GetLinkPreviewByURL(ctx context.Context, url string) (*model.LinkPreview, error) {
+	logrus.Debug("GetLinkPreviewByURL::SQL Query")
+	if err := d.initializeStatements(ctx); err != nil {
+		logrus.WithError(err).Error("GetLinkPreviewByURL::failed to initialize statements")
+		return nil, err
+	}
+
+	preview := model.LinkPreview{}
+	if err := d.prepStmts.getLinkPreviewByURLStmt.QueryRowContext(
+		ctx,
+		url,
+	).Scan(
+		&preview.URL,
+		&preview.Title,
+		&preview.Description,
+		&preview.ImageURL,
+		&preview.SiteName,
+		&preview.FetchedAt,
+	); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		logrus.WithError(err).Error("failed to execute getLinkPreviewByURLStmt")
+		return nil, err
+	}
+
+	return &preview, nil
+}
+
+func (d *delphisDB) UpsertLinkPreview(ctx context.Context, tx *sql.Tx, preview model.LinkPreview) error {
+	logrus.Debug("UpsertLinkPreview::SQL Upsert")
+	if err := d.initializeStatements(ctx); err != nil {
+		logrus.WithError(err).Error("UpsertLinkPreview::failed to initialize statements")
+		return err
+	}
+
+	if _, err := tx.StmtContext(ctx, d.prepStmts.upsertLinkPreviewStmt).ExecContext(
+		ctx,
+		preview.URL,
+		preview.Title,
+		preview.Description,
+		preview.ImageURL,
+		preview.SiteName,
+	); err != nil {
+		logrus.WithError(err).Error("failed to execute upsertLinkPreviewStmt")
+		return errors.Wrap(err, "failed to upsert link preview")
+	}
+
+	return nil
+}
+
+// ReplacePostLinkPreviews points the post at the previews for urls, in order.
+// The previews themselves must already exist.
+func (d *delphisDB) ReplacePostLinkPreviews(ctx context.Context, tx *sql.Tx, postID string, urls []string) error {
+	logrus.Debug("ReplacePostLinkPreviews::SQL Delete/Create")
+	if err := d.initializeStatements(ctx); err != nil {
+		logrus.WithError(err).Error("ReplacePostLinkPreviews::failed to initialize statements")
+		return err
+	}
+
+	if _, err := tx.StmtContext(ctx, d.prepStmts.deletePostLinkPreviewsByPostIDStmt).ExecContext(
+		ctx,
+		postID,
+	); err != nil {
+		logrus.WithError(err).Error("failed to execute deletePostLinkPreviewsByPostIDStmt")
+		return errors.Wrap(err, "failed to delete post link previews")
+	}
+
+	putPostLinkPreviewStmt := tx.StmtContext(ctx, d.prepStmts.putPostLinkPreviewStmt)
+	for i, url := range urls {
+		if _, err := putPostLinkPreviewStmt.ExecContext(
+			ctx,
+			postID,
+			url,
+			i,
+		); err != nil {
+			logrus.WithError(err).Error("failed to execute putPostLinkPreviewStmt")
+			return errors.Wrap(err, "failed to put post link preview")
+		}
+	}
+
+	return nil
+}
+
+func (d *delphisDB) GetLinkPreviewsByPostID(ctx context.Context, postID string) ([]*model.LinkPreview, error) {
+	logrus.Debug("GetLinkPreviewsByPostID::SQL Query")
+	if err := d.initializeStatements(ctx); err != nil {
+		logrus.WithError(err).Error("GetLinkPreviewsByPostID::failed to initialize statements")
+		return nil, err
+	}
+
+	rows, err := d.prepStmts.getLinkPreviewsByPostIDStmt.QueryContext(
+		ctx,
+		postID,
+	)
+	if err != nil {
+		logrus.WithError(err).Error("failed to query getLinkPreviewsByPostIDStmt")
+		return nil, err
+	}
+	defer rows.Close()
+
+	previews := make([]*model.LinkPreview, 0)
+	for rows.Next() {
+		preview := model.LinkPreview{}
+		if err := rows.Scan(
+			&preview.URL,
+			&preview.Title,
+			&preview.Description,
+			&preview.ImageURL,
+			&preview.SiteName,
+			&preview.FetchedAt,
+		); err != nil {
+			logrus.WithError(err).Error("failed to scan link preview")
+			return nil, err
+		}
+		previews = append(previews, &preview)
+	}
+	if err := rows.Err(); err != nil {
+		logrus.WithError(err).Error("failed iterating link previews")
+		return nil, err
+	}
+
+	return previews, nil
+}
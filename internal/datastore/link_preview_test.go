@@ -0,0 +1,310 @@
+package datastore
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/delphis-inc/delphisbe/graph/model"
+	"github.com/delphis-inc/delphisbe/internal/config"
+	"github.com/jinzhu/gorm"
+	. "github.com/smartystreets/goconvey/convey"
+	"github.com/stretchr/testify/assert"
+)
+
+var linkPreviewColumns = []string{"url", "title", "description", "image_url", "site_name", "fetched_at"}
+
+func TestDelphisDB_GetLinkPreviewByURL(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	title := "title"
+	previewObj := model.LinkPreview{
+		URL:       "https://example.com",
+		Title:     &title,
+		FetchedAt: now,
+	}
+
+	Convey("GetLinkPreviewByURL", t, func() {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+
+		assert.Nil(t, err, "Failed setting up sqlmock db")
+
+		gormDB, _ := gorm.Open("postgres", db)
+		mockDatastore := &delphisDB{
+			dbConfig:  config.TablesConfig{},
+			sql:       gormDB,
+			pg:        db,
+			prepStmts: &dbPrepStmts{},
+			dynamo:    nil,
+			encoder:   nil,
+		}
+		defer db.Close()
+
+		Convey("when preparing statements returns an error", func() {
+			mockPreparedStatementsWithError(mock)
+
+			resp, err := mockDatastore.GetLinkPreviewByURL(ctx, previewObj.URL)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when query execution returns an error", func() {
+			mockPreparedStatements(mock)
+			mock.ExpectQuery(getLinkPreviewByURLString).WithArgs(previewObj.URL).WillReturnError(fmt.Errorf("error"))
+
+			resp, err := mockDatastore.GetLinkPreviewByURL(ctx, previewObj.URL)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when the url has not been fetched", func() {
+			mockPreparedStatements(mock)
+			mock.ExpectQuery(getLinkPreviewByURLString).WithArgs(previewObj.URL).WillReturnError(sql.ErrNoRows)
+
+			resp, err := mockDatastore.GetLinkPreviewByURL(ctx, previewObj.URL)
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when the preview is found", func() {
+			rs := sqlmock.NewRows(linkPreviewColumns).
+				AddRow(previewObj.URL, previewObj.Title, nil, nil, nil, previewObj.FetchedAt)
+
+			mockPreparedStatements(mock)
+			mock.ExpectQuery(getLinkPreviewByURLString).WithArgs(previewObj.URL).WillReturnRows(rs)
+
+			resp, err := mockDatastore.GetLinkPreviewByURL(ctx, previewObj.URL)
+
+			So(err, ShouldBeNil)
+			So(*resp, ShouldResemble, previewObj)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+	})
+}
+
+func TestDelphisDB_UpsertLinkPreview(t *testing.T) {
+	ctx := context.Background()
+	title := "title"
+	previewObj := model.LinkPreview{
+		URL:   "https://example.com",
+		Title: &title,
+	}
+
+	Convey("UpsertLinkPreview", t, func() {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+
+		assert.Nil(t, err, "Failed setting up sqlmock db")
+
+		gormDB, _ := gorm.Open("postgres", db)
+		mockDatastore := &delphisDB{
+			dbConfig:  config.TablesConfig{},
+			sql:       gormDB,
+			pg:        db,
+			prepStmts: &dbPrepStmts{},
+			dynamo:    nil,
+			encoder:   nil,
+		}
+		defer db.Close()
+
+		Convey("when preparing statements returns an error", func() {
+			mock.ExpectBegin()
+			mockPreparedStatementsWithError(mock)
+
+			tx, err := mockDatastore.BeginTx(ctx)
+			err = mockDatastore.UpsertLinkPreview(ctx, tx, previewObj)
+
+			So(err, ShouldNotBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when execution returns an error", func() {
+			mock.ExpectBegin()
+			mockPreparedStatements(mock)
+			mock.ExpectPrepare(upsertLinkPreviewString)
+			mock.ExpectExec(upsertLinkPreviewString).WithArgs(previewObj.URL, previewObj.Title, nil, nil, nil).WillReturnError(fmt.Errorf("error"))
+
+			tx, err := mockDatastore.BeginTx(ctx)
+			err = mockDatastore.UpsertLinkPreview(ctx, tx, previewObj)
+
+			So(err, ShouldNotBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when the preview is stored", func() {
+			mock.ExpectBegin()
+			mockPreparedStatements(mock)
+			mock.ExpectPrepare(upsertLinkPreviewString)
+			mock.ExpectExec(upsertLinkPreviewString).WithArgs(previewObj.URL, previewObj.Title, nil, nil, nil).WillReturnResult(sqlmock.NewResult(0, 1))
+
+			tx, err := mockDatastore.BeginTx(ctx)
+			err = mockDatastore.UpsertLinkPreview(ctx, tx, previewObj)
+
+			So(err, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+	})
+}
+
+func TestDelphisDB_ReplacePostLinkPreviews(t *testing.T) {
+	ctx := context.Background()
+	postID := "post1"
+	urls := []string{"https://a.com", "https://b.com"}
+
+	Convey("ReplacePostLinkPreviews", t, func() {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+
+		assert.Nil(t, err, "Failed setting up sqlmock db")
+
+		gormDB, _ := gorm.Open("postgres", db)
+		mockDatastore := &delphisDB{
+			dbConfig:  config.TablesConfig{},
+			sql:       gormDB,
+			pg:        db,
+			prepStmts: &dbPrepStmts{},
+			dynamo:    nil,
+			encoder:   nil,
+		}
+		defer db.Close()
+
+		Convey("when preparing statements returns an error", func() {
+			mock.ExpectBegin()
+			mockPreparedStatementsWithError(mock)
+
+			tx, err := mockDatastore.BeginTx(ctx)
+			err = mockDatastore.ReplacePostLinkPreviews(ctx, tx, postID, urls)
+
+			So(err, ShouldNotBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when deleting existing previews returns an error", func() {
+			mock.ExpectBegin()
+			mockPreparedStatements(mock)
+			mock.ExpectPrepare(deletePostLinkPreviewsByPostIDString)
+			mock.ExpectExec(deletePostLinkPreviewsByPostIDString).WithArgs(postID).WillReturnError(fmt.Errorf("error"))
+
+			tx, err := mockDatastore.BeginTx(ctx)
+			err = mockDatastore.ReplacePostLinkPreviews(ctx, tx, postID, urls)
+
+			So(err, ShouldNotBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when putting a preview returns an error", func() {
+			mock.ExpectBegin()
+			mockPreparedStatements(mock)
+			mock.ExpectPrepare(deletePostLinkPreviewsByPostIDString)
+			mock.ExpectExec(deletePostLinkPreviewsByPostIDString).WithArgs(postID).WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectPrepare(putPostLinkPreviewString)
+			mock.ExpectExec(putPostLinkPreviewString).WithArgs(postID, urls[0], 0).WillReturnError(fmt.Errorf("error"))
+
+			tx, err := mockDatastore.BeginTx(ctx)
+			err = mockDatastore.ReplacePostLinkPreviews(ctx, tx, postID, urls)
+
+			So(err, ShouldNotBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when the previews are replaced", func() {
+			mock.ExpectBegin()
+			mockPreparedStatements(mock)
+			mock.ExpectPrepare(deletePostLinkPreviewsByPostIDString)
+			mock.ExpectExec(deletePostLinkPreviewsByPostIDString).WithArgs(postID).WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectPrepare(putPostLinkPreviewString)
+			mock.ExpectExec(putPostLinkPreviewString).WithArgs(postID, urls[0], 0).WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectExec(putPostLinkPreviewString).WithArgs(postID, urls[1], 1).WillReturnResult(sqlmock.NewResult(0, 1))
+
+			tx, err := mockDatastore.BeginTx(ctx)
+			err = mockDatastore.ReplacePostLinkPreviews(ctx, tx, postID, urls)
+
+			So(err, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+	})
+}
+
+func TestDelphisDB_GetLinkPreviewsByPostID(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	postID := "post1"
+	title := "title"
+	previewObj := model.LinkPreview{
+		URL:       "https://example.com",
+		Title:     &title,
+		FetchedAt: now,
+	}
+
+	Convey("GetLinkPreviewsByPostID", t, func() {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+
+		assert.Nil(t, err, "Failed setting up sqlmock db")
+
+		gormDB, _ := gorm.Open("postgres", db)
+		mockDatastore := &delphisDB{
+			dbConfig:  config.TablesConfig{},
+			sql:       gormDB,
+			pg:        db,
+			prepStmts: &dbPrepStmts{},
+			dynamo:    nil,
+			encoder:   nil,
+		}
+		defer db.Close()
+
+		Convey("when preparing statements returns an error", func() {
+			mockPreparedStatementsWithError(mock)
+
+			resp, err := mockDatastore.GetLinkPreviewsByPostID(ctx, postID)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when query execution returns an error", func() {
+			mockPreparedStatements(mock)
+			mock.ExpectQuery(getLinkPreviewsByPostIDString).WithArgs(postID).WillReturnError(fmt.Errorf("error"))
+
+			resp, err := mockDatastore.GetLinkPreviewsByPostID(ctx, postID)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when scanning returns an error", func() {
+			rs := sqlmock.NewRows([]string{"url"}).AddRow(previewObj.URL)
+
+			mockPreparedStatements(mock)
+			mock.ExpectQuery(getLinkPreviewsByPostIDString).WithArgs(postID).WillReturnRows(rs)
+
+			resp, err := mockDatastore.GetLinkPreviewsByPostID(ctx, postID)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when previews are found", func() {
+			rs := sqlmock.NewRows(linkPreviewColumns).
+				AddRow(previewObj.URL, previewObj.Title, nil, nil, nil, previewObj.FetchedAt)
+
+			mockPreparedStatements(mock)
+			mock.ExpectQuery(getLinkPreviewsByPostIDString).WithArgs(postID).WillReturnRows(rs)
+
+			resp, err := mockDatastore.GetLinkPreviewsByPostID(ctx, postID)
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldResemble, []*model.LinkPreview{&previewObj})
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+	})
+}
@@ -3,11 +3,11 @@ package datastore
 import (
 	"context"
 	"database/sql"
-	"strings"
 
 	"github.com/pkg/errors"
 
 	"github.com/delphis-inc/delphisbe/graph/model"
+	"github.com/delphis-inc/delphisbe/internal/util"
 	"github.com/sirupsen/logrus"
 )
 
@@ -18,17 +18,26 @@ func (d *delphisDB) PutActivity(ctx context.Context, tx *sql.Tx, post *model.Pos
 		return err
 	}
 
+	// An entity mentioned twice in one post is recorded once, since rows in
+	// the same transaction share created_at
+	recorded := map[string]bool{}
 	for _, entityID := range post.PostContent.MentionedEntities {
-		s := strings.Split(entityID, ":")
+		entity, err := util.ReturnParsedEntityID(entityID)
+		if err != nil || entity.Type == "" || entity.ID == "" {
+			// Mentions are validated before posting, so this is only old or hand-written data
+			logrus.Warnf("PutActivity::skipping malformed entity %q", entityID)
+			continue
+		}
 
 		// Don't record mentions where a user tags themselves. This can also be handled on the frontend
-		if s[1] != *post.ParticipantID {
+		if (post.ParticipantID == nil || entity.ID != *post.ParticipantID) && !recorded[entityID] {
+			recorded[entityID] = true
 			_, err := tx.StmtContext(ctx, d.prepStmts.putActivityStmt).ExecContext(
 				ctx,
 				post.ParticipantID,
 				post.PostContent.ID,
-				s[1],
-				s[0],
+				entity.ID,
+				entity.Type,
 			)
 			if err != nil {
 				logrus.WithError(err).Error("failed to execute putActivityStmt")
@@ -99,5 +99,49 @@ func TestDelphisDB_PutActivity(t *testing.T) {
 			So(err, ShouldBeNil)
 			So(mock.ExpectationsWereMet(), ShouldBeNil)
 		})
+
+		Convey("when an entity is mentioned more than once it is recorded once", func() {
+			repeatedObject := postObject
+			repeatedObject.PostContent = &model.PostContent{
+				ID:                postID,
+				Content:           "<0> <1> <2>",
+				MentionedEntities: []string{"tag:golang", "participant:1234", "tag:golang"},
+			}
+
+			mock.ExpectBegin()
+			mockPreparedStatements(mock)
+			mock.ExpectPrepare(putActivityString)
+			mock.ExpectExec(putActivityString).WithArgs(postObject.ParticipantID, postObject.PostContent.ID,
+				"golang", model.TagPrefix).WillReturnResult(sqlmock.NewResult(0, 0))
+			mock.ExpectExec(putActivityString).WithArgs(postObject.ParticipantID, postObject.PostContent.ID,
+				entity1[1], entity1[0]).WillReturnResult(sqlmock.NewResult(0, 0))
+
+			tx, err := mockDatastore.BeginTx(ctx)
+			err = mockDatastore.PutActivity(ctx, tx, &repeatedObject)
+
+			So(err, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when malformed entities are skipped instead of panicking", func() {
+			malformedObject := postObject
+			malformedObject.PostContent = &model.PostContent{
+				ID:                postID,
+				Content:           "<0> <1> <2>",
+				MentionedEntities: []string{"participant", "a:b:c", "participant:1234"},
+			}
+
+			mock.ExpectBegin()
+			mockPreparedStatements(mock)
+			mock.ExpectPrepare(putActivityString)
+			mock.ExpectExec(putActivityString).WithArgs(postObject.ParticipantID, postObject.PostContent.ID,
+				entity1[1], entity1[0]).WillReturnResult(sqlmock.NewResult(0, 0))
+
+			tx, err := mockDatastore.BeginTx(ctx)
+			err = mockDatastore.PutActivity(ctx, tx, &malformedObject)
+
+			So(err, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
 	})
 }
@@ -0,0 +1,125 @@
+package datastore
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/delphis-inc/delphisbe/graph/model"
+	"github.com/sirupsen/logrus"
+)
+
+func (d *delphisDB) PutModerationLogEntry(ctx context.Context, entry model.ModerationLogEntry) (*model.ModerationLogEntry, error) {
+	logrus.Debug("PutModerationLogEntry::SQL Insert")
+	if err := d.initializeStatements(ctx); err != nil {
+		logrus.WithError(err).Error("PutModerationLogEntry::failed to initialize statements")
+		return nil, err
+	}
+
+	if err := d.prepStmts.putModerationLogEntryStmt.QueryRowContext(
+		ctx,
+		entry.ID,
+		entry.DiscussionID,
+		entry.ActorUserID,
+		entry.Action,
+		entry.TargetType,
+		entry.TargetID,
+		entry.Reason,
+	).Scan(
+		&entry.ID,
+		&entry.DiscussionID,
+		&entry.ActorUserID,
+		&entry.Action,
+		&entry.TargetType,
+		&entry.TargetID,
+		&entry.Reason,
+		&entry.CreatedAt,
+	); err != nil {
+		logrus.WithError(err).Error("failed to execute putModerationLogEntryStmt")
+		return nil, err
+	}
+
+	return &entry, nil
+}
+
+func (d *delphisDB) GetModerationLogConnectionByDiscussionID(ctx context.Context, discussionID string, cursor string, limit int) (*model.ModerationLogConnection, error) {
+	if limit < 2 {
+		err := errors.New("Values of 'limit' is illegal")
+		logrus.WithError(err).Error("GetModerationLogConnectionByDiscussionID::illegal limit parameter")
+		return nil, err
+	}
+
+	logrus.Debug("GetModerationLogConnectionByDiscussionID::SQL Query")
+	if err := d.initializeStatements(ctx); err != nil {
+		logrus.WithError(err).Error("GetModerationLogConnectionByDiscussionID::failed to initialize statements")
+		return nil, err
+	}
+
+	rows, err := d.prepStmts.getModerationLogEntriesByDiscussionIDFromCursorStmt.QueryContext(
+		ctx,
+		discussionID,
+		cursor,
+		limit+1,
+	)
+	if err != nil {
+		logrus.WithError(err).Error("failed to query GetModerationLogConnectionByDiscussionID")
+		return nil, err
+	}
+	defer rows.Close()
+
+	entries := make([]*model.ModerationLogEntry, 0)
+	for rows.Next() {
+		entry := model.ModerationLogEntry{}
+		if err := rows.Scan(
+			&entry.ID,
+			&entry.DiscussionID,
+			&entry.ActorUserID,
+			&entry.Action,
+			&entry.TargetType,
+			&entry.TargetID,
+			&entry.Reason,
+			&entry.CreatedAt,
+		); err != nil {
+			logrus.WithError(err).Error("failed to scan moderation log entry")
+			return nil, err
+		}
+		entries = append(entries, &entry)
+	}
+	if err := rows.Err(); err != nil {
+		logrus.WithError(err).Error("failed to iterate moderation log entries")
+		return nil, err
+	}
+
+	return buildModerationLogConnection(entries, cursor, limit), nil
+}
+
+// entries holds up to limit+1 entries, the extra one only signalling that another page exists.
+func buildModerationLogConnection(entries []*model.ModerationLogEntry, cursor string, limit int) *model.ModerationLogConnection {
+	hasNextPage := len(entries) == limit+1
+	if hasNextPage {
+		entries = entries[:limit]
+	}
+
+	edges := make([]*model.ModerationLogEdge, 0)
+	for _, elem := range entries {
+		edges = append(edges, &model.ModerationLogEdge{
+			Cursor: elem.CreatedAt.Format(time.RFC3339Nano),
+			Node:   elem,
+		})
+	}
+
+	startCursor, endCursor := cursor, cursor
+	if len(edges) > 0 {
+		startCursor = edges[0].Cursor
+		endCursor = edges[len(edges)-1].Cursor
+	}
+
+	return &model.ModerationLogConnection{
+		Edges: edges,
+		PageInfo: model.PageInfo{
+			StartCursor: &startCursor,
+			EndCursor:   &endCursor,
+			HasNextPage: hasNextPage,
+		},
+	}
+}
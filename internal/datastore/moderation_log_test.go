@@ -0,0 +1,196 @@
+package datastore
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/delphis-inc/delphisbe/graph/model"
+	"github.com/delphis-inc/delphisbe/internal/config"
+	"github.com/jinzhu/gorm"
+	. "github.com/smartystreets/goconvey/convey"
+	"github.com/stretchr/testify/assert"
+)
+
+var moderationLogColumns = []string{"id", "discussion_id", "actor_user_id", "action", "target_type", "target_id", "reason", "created_at"}
+
+func TestDelphisDB_PutModerationLogEntry(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	targetID := "participant1"
+	entryObj := model.ModerationLogEntry{
+		ID:           "entry1",
+		DiscussionID: "discussion1",
+		ActorUserID:  "user1",
+		Action:       model.ModerationActionBanParticipant,
+		TargetType:   model.ModerationTargetTypeParticipant,
+		TargetID:     &targetID,
+		CreatedAt:    now,
+	}
+
+	Convey("PutModerationLogEntry", t, func() {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+
+		assert.Nil(t, err, "Failed setting up sqlmock db")
+
+		gormDB, _ := gorm.Open("postgres", db)
+		mockDatastore := &delphisDB{
+			dbConfig:  config.TablesConfig{},
+			sql:       gormDB,
+			pg:        db,
+			prepStmts: &dbPrepStmts{},
+			dynamo:    nil,
+			encoder:   nil,
+		}
+		defer db.Close()
+
+		Convey("when preparing statements returns an error", func() {
+			mockPreparedStatementsWithError(mock)
+
+			resp, err := mockDatastore.PutModerationLogEntry(ctx, entryObj)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when query execution returns an error", func() {
+			mockPreparedStatements(mock)
+			mock.ExpectQuery(putModerationLogEntryString).WithArgs(entryObj.ID, entryObj.DiscussionID, entryObj.ActorUserID,
+				entryObj.Action, entryObj.TargetType, entryObj.TargetID, entryObj.Reason).WillReturnError(fmt.Errorf("error"))
+
+			resp, err := mockDatastore.PutModerationLogEntry(ctx, entryObj)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when query execution succeeds", func() {
+			mockPreparedStatements(mock)
+			rs := sqlmock.NewRows(moderationLogColumns).
+				AddRow(entryObj.ID, entryObj.DiscussionID, entryObj.ActorUserID, entryObj.Action, entryObj.TargetType,
+					entryObj.TargetID, entryObj.Reason, entryObj.CreatedAt)
+			mock.ExpectQuery(putModerationLogEntryString).WithArgs(entryObj.ID, entryObj.DiscussionID, entryObj.ActorUserID,
+				entryObj.Action, entryObj.TargetType, entryObj.TargetID, entryObj.Reason).WillReturnRows(rs)
+
+			resp, err := mockDatastore.PutModerationLogEntry(ctx, entryObj)
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldResemble, &entryObj)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+	})
+}
+
+func TestDelphisDB_GetModerationLogConnectionByDiscussionID(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	discussionID := "discussion1"
+	cursor := now.String()
+	limit := 2
+	targetID := "post1"
+	entryObj := model.ModerationLogEntry{
+		ID:           "entry1",
+		DiscussionID: discussionID,
+		ActorUserID:  "user1",
+		Action:       model.ModerationActionDeletePost,
+		TargetType:   model.ModerationTargetTypePost,
+		TargetID:     &targetID,
+		CreatedAt:    now,
+	}
+
+	Convey("GetModerationLogConnectionByDiscussionID", t, func() {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+
+		assert.Nil(t, err, "Failed setting up sqlmock db")
+
+		gormDB, _ := gorm.Open("postgres", db)
+		mockDatastore := &delphisDB{
+			dbConfig:  config.TablesConfig{},
+			sql:       gormDB,
+			pg:        db,
+			prepStmts: &dbPrepStmts{},
+			dynamo:    nil,
+			encoder:   nil,
+		}
+		defer db.Close()
+
+		Convey("when limit less than two is passed in", func() {
+			resp, err := mockDatastore.GetModerationLogConnectionByDiscussionID(ctx, discussionID, cursor, 1)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when preparing statements returns an error", func() {
+			mockPreparedStatementsWithError(mock)
+
+			resp, err := mockDatastore.GetModerationLogConnectionByDiscussionID(ctx, discussionID, cursor, limit)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when query execution returns an error", func() {
+			mockPreparedStatements(mock)
+			mock.ExpectQuery(getModerationLogEntriesByDiscussionIDFromCursorString).WithArgs(discussionID, cursor, limit+1).WillReturnError(fmt.Errorf("error"))
+
+			resp, err := mockDatastore.GetModerationLogConnectionByDiscussionID(ctx, discussionID, cursor, limit)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when there are no entries", func() {
+			mockPreparedStatements(mock)
+			rs := sqlmock.NewRows(moderationLogColumns)
+			mock.ExpectQuery(getModerationLogEntriesByDiscussionIDFromCursorString).WithArgs(discussionID, cursor, limit+1).WillReturnRows(rs)
+
+			resp, err := mockDatastore.GetModerationLogConnectionByDiscussionID(ctx, discussionID, cursor, limit)
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldResemble, &model.ModerationLogConnection{
+				Edges: []*model.ModerationLogEdge{},
+				PageInfo: model.PageInfo{
+					StartCursor: &cursor,
+					EndCursor:   &cursor,
+					HasNextPage: false,
+				},
+			})
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when query execution succeeds and returns entries", func() {
+			mockPreparedStatements(mock)
+			rs := sqlmock.NewRows(moderationLogColumns)
+			for i := 0; i < limit+1; i++ {
+				rs = rs.AddRow(entryObj.ID, entryObj.DiscussionID, entryObj.ActorUserID, entryObj.Action, entryObj.TargetType,
+					entryObj.TargetID, entryObj.Reason, entryObj.CreatedAt)
+			}
+			mock.ExpectQuery(getModerationLogEntriesByDiscussionIDFromCursorString).WithArgs(discussionID, cursor, limit+1).WillReturnRows(rs)
+
+			resp, err := mockDatastore.GetModerationLogConnectionByDiscussionID(ctx, discussionID, cursor, limit)
+
+			entryCursor := now.Format(time.RFC3339Nano)
+			So(err, ShouldBeNil)
+			So(resp, ShouldResemble, &model.ModerationLogConnection{
+				Edges: []*model.ModerationLogEdge{
+					{Cursor: entryCursor, Node: &entryObj},
+					{Cursor: entryCursor, Node: &entryObj},
+				},
+				PageInfo: model.PageInfo{
+					StartCursor: &entryCursor,
+					EndCursor:   &entryCursor,
+					HasNextPage: true,
+				},
+			})
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+	})
+}
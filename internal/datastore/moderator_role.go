@@ -0,0 +1,127 @@
+package datastore
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/delphis-inc/delphisbe/graph/model"
+	"github.com/sirupsen/logrus"
+)
+
+func (d *delphisDB) GetModeratorRoleByUserIDAndDiscussionID(ctx context.Context, userID, discussionID string) (*model.ModeratorRoleGrant, error) {
+	logrus.Debug("GetModeratorRoleByUserIDAndDiscussionID::SQL Query")
+	if err := d.initializeStatements(ctx); err != nil {
+		logrus.WithError(err).Error("GetModeratorRoleByUserIDAndDiscussionID::failed to initialize statements")
+		return nil, err
+	}
+
+	grant := model.ModeratorRoleGrant{}
+	if err := d.prepStmts.getModeratorRoleByUserIDAndDiscussionIDStmt.QueryRowContext(
+		ctx,
+		userID,
+		discussionID,
+	).Scan(
+		&grant.DiscussionID,
+		&grant.UserID,
+		&grant.Role,
+		&grant.GrantedByUserID,
+		&grant.CreatedAt,
+		&grant.UpdatedAt,
+	); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		logrus.WithError(err).Error("failed to execute getModeratorRoleByUserIDAndDiscussionIDStmt")
+		return nil, err
+	}
+
+	return &grant, nil
+}
+
+func (d *delphisDB) GetModeratorRolesByDiscussionID(ctx context.Context, discussionID string) ([]*model.ModeratorRoleGrant, error) {
+	logrus.Debug("GetModeratorRolesByDiscussionID::SQL Query")
+	if err := d.initializeStatements(ctx); err != nil {
+		logrus.WithError(err).Error("GetModeratorRolesByDiscussionID::failed to initialize statements")
+		return nil, err
+	}
+
+	rows, err := d.prepStmts.getModeratorRolesByDiscussionIDStmt.QueryContext(
+		ctx,
+		discussionID,
+	)
+	if err != nil {
+		logrus.WithError(err).Error("failed to query GetModeratorRolesByDiscussionID")
+		return nil, err
+	}
+	defer rows.Close()
+
+	grants := make([]*model.ModeratorRoleGrant, 0)
+	for rows.Next() {
+		grant := model.ModeratorRoleGrant{}
+		if err := rows.Scan(
+			&grant.DiscussionID,
+			&grant.UserID,
+			&grant.Role,
+			&grant.GrantedByUserID,
+			&grant.CreatedAt,
+			&grant.UpdatedAt,
+		); err != nil {
+			logrus.WithError(err).Error("failed to scan moderator role")
+			return nil, err
+		}
+		grants = append(grants, &grant)
+	}
+	if err := rows.Err(); err != nil {
+		logrus.WithError(err).Error("failed to iterate moderator roles")
+		return nil, err
+	}
+
+	return grants, nil
+}
+
+func (d *delphisDB) UpsertModeratorRole(ctx context.Context, grant model.ModeratorRoleGrant) (*model.ModeratorRoleGrant, error) {
+	logrus.Debug("UpsertModeratorRole::SQL Upsert")
+	if err := d.initializeStatements(ctx); err != nil {
+		logrus.WithError(err).Error("UpsertModeratorRole::failed to initialize statements")
+		return nil, err
+	}
+
+	if err := d.prepStmts.upsertModeratorRoleStmt.QueryRowContext(
+		ctx,
+		grant.DiscussionID,
+		grant.UserID,
+		grant.Role,
+		grant.GrantedByUserID,
+	).Scan(
+		&grant.DiscussionID,
+		&grant.UserID,
+		&grant.Role,
+		&grant.GrantedByUserID,
+		&grant.CreatedAt,
+		&grant.UpdatedAt,
+	); err != nil {
+		logrus.WithError(err).Error("failed to execute upsertModeratorRoleStmt")
+		return nil, err
+	}
+
+	return &grant, nil
+}
+
+func (d *delphisDB) DeleteModeratorRole(ctx context.Context, userID, discussionID string) error {
+	logrus.Debug("DeleteModeratorRole::SQL Delete")
+	if err := d.initializeStatements(ctx); err != nil {
+		logrus.WithError(err).Error("DeleteModeratorRole::failed to initialize statements")
+		return err
+	}
+
+	if _, err := d.prepStmts.deleteModeratorRoleStmt.ExecContext(
+		ctx,
+		userID,
+		discussionID,
+	); err != nil {
+		logrus.WithError(err).Error("failed to execute deleteModeratorRoleStmt")
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,287 @@
+package datastore
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/delphis-inc/delphisbe/graph/model"
+	"github.com/delphis-inc/delphisbe/internal/config"
+	"github.com/jinzhu/gorm"
+	. "github.com/smartystreets/goconvey/convey"
+	"github.com/stretchr/testify/assert"
+)
+
+var moderatorRoleColumns = []string{"discussion_id", "user_id", "role", "granted_by_user_id", "created_at", "updated_at"}
+
+func TestDelphisDB_GetModeratorRoleByUserIDAndDiscussionID(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	grantObj := model.ModeratorRoleGrant{
+		DiscussionID:    "discussion1",
+		UserID:          "user1",
+		Role:            model.ModeratorRoleHelper,
+		GrantedByUserID: "user2",
+		CreatedAt:       now,
+		UpdatedAt:       now,
+	}
+
+	Convey("GetModeratorRoleByUserIDAndDiscussionID", t, func() {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+
+		assert.Nil(t, err, "Failed setting up sqlmock db")
+
+		gormDB, _ := gorm.Open("postgres", db)
+		mockDatastore := &delphisDB{
+			dbConfig:  config.TablesConfig{},
+			sql:       gormDB,
+			pg:        db,
+			prepStmts: &dbPrepStmts{},
+			dynamo:    nil,
+			encoder:   nil,
+		}
+		defer db.Close()
+
+		Convey("when preparing statements returns an error", func() {
+			mockPreparedStatementsWithError(mock)
+
+			resp, err := mockDatastore.GetModeratorRoleByUserIDAndDiscussionID(ctx, grantObj.UserID, grantObj.DiscussionID)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when query execution returns an error", func() {
+			mockPreparedStatements(mock)
+			mock.ExpectQuery(getModeratorRoleByUserIDAndDiscussionIDString).WithArgs(grantObj.UserID, grantObj.DiscussionID).WillReturnError(fmt.Errorf("error"))
+
+			resp, err := mockDatastore.GetModeratorRoleByUserIDAndDiscussionID(ctx, grantObj.UserID, grantObj.DiscussionID)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when the user has no role", func() {
+			mockPreparedStatements(mock)
+			rs := sqlmock.NewRows(moderatorRoleColumns)
+			mock.ExpectQuery(getModeratorRoleByUserIDAndDiscussionIDString).WithArgs(grantObj.UserID, grantObj.DiscussionID).WillReturnRows(rs)
+
+			resp, err := mockDatastore.GetModeratorRoleByUserIDAndDiscussionID(ctx, grantObj.UserID, grantObj.DiscussionID)
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when query execution succeeds and returns a role", func() {
+			mockPreparedStatements(mock)
+			rs := sqlmock.NewRows(moderatorRoleColumns).
+				AddRow(grantObj.DiscussionID, grantObj.UserID, grantObj.Role, grantObj.GrantedByUserID, grantObj.CreatedAt, grantObj.UpdatedAt)
+			mock.ExpectQuery(getModeratorRoleByUserIDAndDiscussionIDString).WithArgs(grantObj.UserID, grantObj.DiscussionID).WillReturnRows(rs)
+
+			resp, err := mockDatastore.GetModeratorRoleByUserIDAndDiscussionID(ctx, grantObj.UserID, grantObj.DiscussionID)
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldResemble, &grantObj)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+	})
+}
+
+func TestDelphisDB_GetModeratorRolesByDiscussionID(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	grantObj := model.ModeratorRoleGrant{
+		DiscussionID:    "discussion1",
+		UserID:          "user1",
+		Role:            model.ModeratorRoleModerator,
+		GrantedByUserID: "user2",
+		CreatedAt:       now,
+		UpdatedAt:       now,
+	}
+
+	Convey("GetModeratorRolesByDiscussionID", t, func() {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+
+		assert.Nil(t, err, "Failed setting up sqlmock db")
+
+		gormDB, _ := gorm.Open("postgres", db)
+		mockDatastore := &delphisDB{
+			dbConfig:  config.TablesConfig{},
+			sql:       gormDB,
+			pg:        db,
+			prepStmts: &dbPrepStmts{},
+			dynamo:    nil,
+			encoder:   nil,
+		}
+		defer db.Close()
+
+		Convey("when preparing statements returns an error", func() {
+			mockPreparedStatementsWithError(mock)
+
+			resp, err := mockDatastore.GetModeratorRolesByDiscussionID(ctx, grantObj.DiscussionID)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when query execution returns an error", func() {
+			mockPreparedStatements(mock)
+			mock.ExpectQuery(getModeratorRolesByDiscussionIDString).WithArgs(grantObj.DiscussionID).WillReturnError(fmt.Errorf("error"))
+
+			resp, err := mockDatastore.GetModeratorRolesByDiscussionID(ctx, grantObj.DiscussionID)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when scanning a row returns an error", func() {
+			mockPreparedStatements(mock)
+			rs := sqlmock.NewRows([]string{"discussion_id"}).AddRow(grantObj.DiscussionID)
+			mock.ExpectQuery(getModeratorRolesByDiscussionIDString).WithArgs(grantObj.DiscussionID).WillReturnRows(rs)
+
+			resp, err := mockDatastore.GetModeratorRolesByDiscussionID(ctx, grantObj.DiscussionID)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when query execution succeeds and returns roles", func() {
+			mockPreparedStatements(mock)
+			rs := sqlmock.NewRows(moderatorRoleColumns).
+				AddRow(grantObj.DiscussionID, grantObj.UserID, grantObj.Role, grantObj.GrantedByUserID, grantObj.CreatedAt, grantObj.UpdatedAt)
+			mock.ExpectQuery(getModeratorRolesByDiscussionIDString).WithArgs(grantObj.DiscussionID).WillReturnRows(rs)
+
+			resp, err := mockDatastore.GetModeratorRolesByDiscussionID(ctx, grantObj.DiscussionID)
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldResemble, []*model.ModeratorRoleGrant{&grantObj})
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+	})
+}
+
+func TestDelphisDB_UpsertModeratorRole(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	grantObj := model.ModeratorRoleGrant{
+		DiscussionID:    "discussion1",
+		UserID:          "user1",
+		Role:            model.ModeratorRoleHelper,
+		GrantedByUserID: "user2",
+		CreatedAt:       now,
+		UpdatedAt:       now,
+	}
+
+	Convey("UpsertModeratorRole", t, func() {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+
+		assert.Nil(t, err, "Failed setting up sqlmock db")
+
+		gormDB, _ := gorm.Open("postgres", db)
+		mockDatastore := &delphisDB{
+			dbConfig:  config.TablesConfig{},
+			sql:       gormDB,
+			pg:        db,
+			prepStmts: &dbPrepStmts{},
+			dynamo:    nil,
+			encoder:   nil,
+		}
+		defer db.Close()
+
+		Convey("when preparing statements returns an error", func() {
+			mockPreparedStatementsWithError(mock)
+
+			resp, err := mockDatastore.UpsertModeratorRole(ctx, grantObj)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when query execution returns an error", func() {
+			mockPreparedStatements(mock)
+			mock.ExpectQuery(upsertModeratorRoleString).WithArgs(grantObj.DiscussionID, grantObj.UserID, grantObj.Role, grantObj.GrantedByUserID).
+				WillReturnError(fmt.Errorf("error"))
+
+			resp, err := mockDatastore.UpsertModeratorRole(ctx, grantObj)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when the role is stored", func() {
+			mockPreparedStatements(mock)
+			rs := sqlmock.NewRows(moderatorRoleColumns).
+				AddRow(grantObj.DiscussionID, grantObj.UserID, grantObj.Role, grantObj.GrantedByUserID, grantObj.CreatedAt, grantObj.UpdatedAt)
+			mock.ExpectQuery(upsertModeratorRoleString).WithArgs(grantObj.DiscussionID, grantObj.UserID, grantObj.Role, grantObj.GrantedByUserID).
+				WillReturnRows(rs)
+
+			resp, err := mockDatastore.UpsertModeratorRole(ctx, grantObj)
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldResemble, &grantObj)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+	})
+}
+
+func TestDelphisDB_DeleteModeratorRole(t *testing.T) {
+	ctx := context.Background()
+	userID := "user1"
+	discussionID := "discussion1"
+
+	Convey("DeleteModeratorRole", t, func() {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+
+		assert.Nil(t, err, "Failed setting up sqlmock db")
+
+		gormDB, _ := gorm.Open("postgres", db)
+		mockDatastore := &delphisDB{
+			dbConfig:  config.TablesConfig{},
+			sql:       gormDB,
+			pg:        db,
+			prepStmts: &dbPrepStmts{},
+			dynamo:    nil,
+			encoder:   nil,
+		}
+		defer db.Close()
+
+		Convey("when preparing statements returns an error", func() {
+			mockPreparedStatementsWithError(mock)
+
+			err := mockDatastore.DeleteModeratorRole(ctx, userID, discussionID)
+
+			So(err, ShouldNotBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when query execution returns an error", func() {
+			mockPreparedStatements(mock)
+			mock.ExpectExec(deleteModeratorRoleString).WithArgs(userID, discussionID).WillReturnError(fmt.Errorf("error"))
+
+			err := mockDatastore.DeleteModeratorRole(ctx, userID, discussionID)
+
+			So(err, ShouldNotBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when the role is deleted", func() {
+			mockPreparedStatements(mock)
+			mock.ExpectExec(deleteModeratorRoleString).WithArgs(userID, discussionID).WillReturnResult(sqlmock.NewResult(0, 1))
+
+			err := mockDatastore.DeleteModeratorRole(ctx, userID, discussionID)
+
+			So(err, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+	})
+}
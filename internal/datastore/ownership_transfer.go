@@ -0,0 +1,98 @@
+package datastore
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/delphis-inc/delphisbe/graph/model"
+	"github.com/sirupsen/logrus"
+)
+
+// UpdateModeratorUserProfileID hands a discussion's Moderator to another
+// user, which keeps the discussion's moderator_id unchanged.
+func (d *delphisDB) UpdateModeratorUserProfileID(ctx context.Context, tx *sql.Tx, moderatorID string, userProfileID string) (*model.Moderator, error) {
+	logrus.Debug("UpdateModeratorUserProfileID::SQL Update")
+	if err := d.initializeStatements(ctx); err != nil {
+		logrus.WithError(err).Error("UpdateModeratorUserProfileID::failed to initialize statements")
+		return nil, err
+	}
+
+	moderator := model.Moderator{}
+	if err := tx.StmtContext(ctx, d.prepStmts.updateModeratorUserProfileIDStmt).QueryRowContext(
+		ctx,
+		moderatorID,
+		userProfileID,
+	).Scan(
+		&moderator.ID,
+		&moderator.CreatedAt,
+		&moderator.UpdatedAt,
+		&moderator.DeletedAt,
+		&moderator.UserProfileID,
+	); err != nil {
+		logrus.WithError(err).Error("failed to execute updateModeratorUserProfileIDStmt")
+		return nil, err
+	}
+
+	return &moderator, nil
+}
+
+func (d *delphisDB) PutDiscussionOwnershipTransfer(ctx context.Context, tx *sql.Tx, transfer model.DiscussionOwnershipTransfer) (*model.DiscussionOwnershipTransfer, error) {
+	logrus.Debug("PutDiscussionOwnershipTransfer::SQL Insert")
+	if err := d.initializeStatements(ctx); err != nil {
+		logrus.WithError(err).Error("PutDiscussionOwnershipTransfer::failed to initialize statements")
+		return nil, err
+	}
+
+	if err := tx.StmtContext(ctx, d.prepStmts.putDiscussionOwnershipTransferStmt).QueryRowContext(
+		ctx,
+		transfer.ID,
+		transfer.DiscussionID,
+		transfer.FromUserID,
+		transfer.ToUserID,
+		transfer.TransferredByUserID,
+	).Scan(
+		&transfer.ID,
+		&transfer.DiscussionID,
+		&transfer.FromUserID,
+		&transfer.ToUserID,
+		&transfer.TransferredByUserID,
+		&transfer.CreatedAt,
+	); err != nil {
+		logrus.WithError(err).Error("failed to execute putDiscussionOwnershipTransferStmt")
+		return nil, err
+	}
+
+	return &transfer, nil
+}
+
+// TransferModeratorRoles drops the new owner's grant and keeps the previous
+// owner on as a moderator, in the same tx as the moderator update.
+func (d *delphisDB) TransferModeratorRoles(ctx context.Context, tx *sql.Tx, discussionID, fromUserID, toUserID, grantedByUserID string) error {
+	logrus.Debug("TransferModeratorRoles::SQL Update")
+	if err := d.initializeStatements(ctx); err != nil {
+		logrus.WithError(err).Error("TransferModeratorRoles::failed to initialize statements")
+		return err
+	}
+
+	if _, err := tx.StmtContext(ctx, d.prepStmts.deleteModeratorRoleStmt).ExecContext(
+		ctx,
+		toUserID,
+		discussionID,
+	); err != nil {
+		logrus.WithError(err).Error("failed to execute deleteModeratorRoleStmt")
+		return err
+	}
+
+	if _, err := tx.StmtContext(ctx, d.prepStmts.upsertModeratorRoleStmt).ExecContext(
+		ctx,
+		discussionID,
+		fromUserID,
+		model.ModeratorRoleModerator,
+		grantedByUserID,
+	); err != nil {
+		logrus.WithError(err).Error("failed to execute upsertModeratorRoleStmt")
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,241 @@
+package datastore
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/delphis-inc/delphisbe/graph/model"
+	"github.com/delphis-inc/delphisbe/internal/config"
+	"github.com/jinzhu/gorm"
+	. "github.com/smartystreets/goconvey/convey"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDelphisDB_UpdateModeratorUserProfileID(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	profileID := "profile2"
+	moderatorObj := model.Moderator{
+		ID:            "moderator1",
+		CreatedAt:     now,
+		UpdatedAt:     now,
+		UserProfileID: &profileID,
+	}
+
+	Convey("UpdateModeratorUserProfileID", t, func() {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+
+		assert.Nil(t, err, "Failed setting up sqlmock db")
+
+		gormDB, _ := gorm.Open("postgres", db)
+		mockDatastore := &delphisDB{
+			dbConfig:  config.TablesConfig{},
+			sql:       gormDB,
+			pg:        db,
+			prepStmts: &dbPrepStmts{},
+			dynamo:    nil,
+			encoder:   nil,
+		}
+		defer db.Close()
+
+		Convey("when preparing statements returns an error", func() {
+			mock.ExpectBegin()
+			mockPreparedStatementsWithError(mock)
+
+			tx, err := mockDatastore.BeginTx(ctx)
+			resp, err := mockDatastore.UpdateModeratorUserProfileID(ctx, tx, moderatorObj.ID, profileID)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when query execution returns an error", func() {
+			mock.ExpectBegin()
+			mockPreparedStatements(mock)
+			mock.ExpectPrepare(updateModeratorUserProfileIDString)
+			mock.ExpectQuery(updateModeratorUserProfileIDString).WithArgs(moderatorObj.ID, profileID).WillReturnError(fmt.Errorf("error"))
+
+			tx, err := mockDatastore.BeginTx(ctx)
+			resp, err := mockDatastore.UpdateModeratorUserProfileID(ctx, tx, moderatorObj.ID, profileID)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when query execution succeeds", func() {
+			rs := sqlmock.NewRows([]string{"id", "created_at", "updated_at", "deleted_at", "user_profile_id"}).
+				AddRow(moderatorObj.ID, moderatorObj.CreatedAt, moderatorObj.UpdatedAt, moderatorObj.DeletedAt, moderatorObj.UserProfileID)
+
+			mock.ExpectBegin()
+			mockPreparedStatements(mock)
+			mock.ExpectPrepare(updateModeratorUserProfileIDString)
+			mock.ExpectQuery(updateModeratorUserProfileIDString).WithArgs(moderatorObj.ID, profileID).WillReturnRows(rs)
+
+			tx, err := mockDatastore.BeginTx(ctx)
+			resp, err := mockDatastore.UpdateModeratorUserProfileID(ctx, tx, moderatorObj.ID, profileID)
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldResemble, &moderatorObj)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+	})
+}
+
+func TestDelphisDB_PutDiscussionOwnershipTransfer(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	transferObj := model.DiscussionOwnershipTransfer{
+		ID:                  "transfer1",
+		DiscussionID:        "discussion1",
+		FromUserID:          "user1",
+		ToUserID:            "user2",
+		TransferredByUserID: "user1",
+		CreatedAt:           now,
+	}
+
+	Convey("PutDiscussionOwnershipTransfer", t, func() {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+
+		assert.Nil(t, err, "Failed setting up sqlmock db")
+
+		gormDB, _ := gorm.Open("postgres", db)
+		mockDatastore := &delphisDB{
+			dbConfig:  config.TablesConfig{},
+			sql:       gormDB,
+			pg:        db,
+			prepStmts: &dbPrepStmts{},
+			dynamo:    nil,
+			encoder:   nil,
+		}
+		defer db.Close()
+
+		Convey("when preparing statements returns an error", func() {
+			mock.ExpectBegin()
+			mockPreparedStatementsWithError(mock)
+
+			tx, err := mockDatastore.BeginTx(ctx)
+			resp, err := mockDatastore.PutDiscussionOwnershipTransfer(ctx, tx, transferObj)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when query execution returns an error", func() {
+			mock.ExpectBegin()
+			mockPreparedStatements(mock)
+			mock.ExpectPrepare(putDiscussionOwnershipTransferString)
+			mock.ExpectQuery(putDiscussionOwnershipTransferString).WithArgs(transferObj.ID, transferObj.DiscussionID,
+				transferObj.FromUserID, transferObj.ToUserID, transferObj.TransferredByUserID).WillReturnError(fmt.Errorf("error"))
+
+			tx, err := mockDatastore.BeginTx(ctx)
+			resp, err := mockDatastore.PutDiscussionOwnershipTransfer(ctx, tx, transferObj)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when query execution succeeds", func() {
+			rs := sqlmock.NewRows([]string{"id", "discussion_id", "from_user_id", "to_user_id", "transferred_by_user_id", "created_at"}).
+				AddRow(transferObj.ID, transferObj.DiscussionID, transferObj.FromUserID, transferObj.ToUserID,
+					transferObj.TransferredByUserID, transferObj.CreatedAt)
+
+			mock.ExpectBegin()
+			mockPreparedStatements(mock)
+			mock.ExpectPrepare(putDiscussionOwnershipTransferString)
+			mock.ExpectQuery(putDiscussionOwnershipTransferString).WithArgs(transferObj.ID, transferObj.DiscussionID,
+				transferObj.FromUserID, transferObj.ToUserID, transferObj.TransferredByUserID).WillReturnRows(rs)
+
+			tx, err := mockDatastore.BeginTx(ctx)
+			resp, err := mockDatastore.PutDiscussionOwnershipTransfer(ctx, tx, transferObj)
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldResemble, &transferObj)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+	})
+}
+
+func TestDelphisDB_TransferModeratorRoles(t *testing.T) {
+	ctx := context.Background()
+	discussionID := "discussion1"
+	fromUserID := "user1"
+	toUserID := "user2"
+
+	Convey("TransferModeratorRoles", t, func() {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+
+		assert.Nil(t, err, "Failed setting up sqlmock db")
+
+		gormDB, _ := gorm.Open("postgres", db)
+		mockDatastore := &delphisDB{
+			dbConfig:  config.TablesConfig{},
+			sql:       gormDB,
+			pg:        db,
+			prepStmts: &dbPrepStmts{},
+			dynamo:    nil,
+			encoder:   nil,
+		}
+		defer db.Close()
+
+		Convey("when preparing statements returns an error", func() {
+			mock.ExpectBegin()
+			mockPreparedStatementsWithError(mock)
+
+			tx, err := mockDatastore.BeginTx(ctx)
+			err = mockDatastore.TransferModeratorRoles(ctx, tx, discussionID, fromUserID, toUserID, fromUserID)
+
+			So(err, ShouldNotBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when deleting the new owner's role returns an error", func() {
+			mock.ExpectBegin()
+			mockPreparedStatements(mock)
+			mock.ExpectPrepare(deleteModeratorRoleString)
+			mock.ExpectExec(deleteModeratorRoleString).WithArgs(toUserID, discussionID).WillReturnError(fmt.Errorf("error"))
+
+			tx, err := mockDatastore.BeginTx(ctx)
+			err = mockDatastore.TransferModeratorRoles(ctx, tx, discussionID, fromUserID, toUserID, fromUserID)
+
+			So(err, ShouldNotBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when keeping the previous owner as moderator returns an error", func() {
+			mock.ExpectBegin()
+			mockPreparedStatements(mock)
+			mock.ExpectPrepare(deleteModeratorRoleString)
+			mock.ExpectExec(deleteModeratorRoleString).WithArgs(toUserID, discussionID).WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectPrepare(upsertModeratorRoleString)
+			mock.ExpectExec(upsertModeratorRoleString).WithArgs(discussionID, fromUserID, model.ModeratorRoleModerator, fromUserID).WillReturnError(fmt.Errorf("error"))
+
+			tx, err := mockDatastore.BeginTx(ctx)
+			err = mockDatastore.TransferModeratorRoles(ctx, tx, discussionID, fromUserID, toUserID, fromUserID)
+
+			So(err, ShouldNotBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when query execution succeeds", func() {
+			mock.ExpectBegin()
+			mockPreparedStatements(mock)
+			mock.ExpectPrepare(deleteModeratorRoleString)
+			mock.ExpectExec(deleteModeratorRoleString).WithArgs(toUserID, discussionID).WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectPrepare(upsertModeratorRoleString)
+			mock.ExpectExec(upsertModeratorRoleString).WithArgs(discussionID, fromUserID, model.ModeratorRoleModerator, fromUserID).WillReturnResult(sqlmock.NewResult(0, 1))
+
+			tx, err := mockDatastore.BeginTx(ctx)
+			err = mockDatastore.TransferModeratorRoles(ctx, tx, discussionID, fromUserID, toUserID, fromUserID)
+
+			So(err, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+	})
+}
@@ -0,0 +1,183 @@
+package datastore
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/delphis-inc/delphisbe/graph/model"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// PutPoll stores the poll and its options. It runs in the transaction that
+// creates the POLL post so a poll never exists without its options.
+func (d *delphisDB) PutPoll(ctx context.Context, tx *sql.Tx, poll model.Poll) error {
+	logrus.Debug("PutPoll::SQL Create")
+	if err := d.initializeStatements(ctx); err != nil {
+		logrus.WithError(err).Error("PutPoll::failed to initialize statements")
+		return err
+	}
+
+	if _, err := tx.StmtContext(ctx, d.prepStmts.putPollStmt).ExecContext(
+		ctx,
+		poll.PostID,
+		poll.AllowMultipleVotes,
+		poll.IsAnonymous,
+		poll.ClosesAt,
+	); err != nil {
+		logrus.WithError(err).Error("failed to execute putPollStmt")
+		return errors.Wrap(err, "failed to put poll")
+	}
+
+	putPollOptionStmt := tx.StmtContext(ctx, d.prepStmts.putPollOptionStmt)
+	for _, option := range poll.Options {
+		if _, err := putPollOptionStmt.ExecContext(
+			ctx,
+			option.ID,
+			poll.PostID,
+			option.Position,
+			option.Text,
+		); err != nil {
+			logrus.WithError(err).Error("failed to execute putPollOptionStmt")
+			return errors.Wrap(err, "failed to put poll option")
+		}
+	}
+
+	return nil
+}
+
+// GetPollByPostID returns the poll with its options tallied. MeVoted is set
+// for options voted for by any of the passed participants.
+func (d *delphisDB) GetPollByPostID(ctx context.Context, postID string, participantIDs []string) (*model.Poll, error) {
+	logrus.Debug("GetPollByPostID::SQL Query")
+	if err := d.initializeStatements(ctx); err != nil {
+		logrus.WithError(err).Error("GetPollByPostID::failed to initialize statements")
+		return nil, err
+	}
+
+	poll := model.Poll{}
+	if err := d.prepStmts.getPollByPostIDStmt.QueryRowContext(
+		ctx,
+		postID,
+	).Scan(
+		&poll.PostID,
+		&poll.AllowMultipleVotes,
+		&poll.IsAnonymous,
+		&poll.ClosesAt,
+		&poll.CreatedAt,
+	); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		logrus.WithError(err).Error("failed to execute getPollByPostIDStmt")
+		return nil, err
+	}
+
+	rows, err := d.prepStmts.getPollOptionTalliesByPostIDStmt.QueryContext(
+		ctx,
+		postID,
+		pq.Array(participantIDs),
+	)
+	if err != nil {
+		logrus.WithError(err).Error("failed to query getPollOptionTalliesByPostIDStmt")
+		return nil, err
+	}
+	defer rows.Close()
+
+	poll.Options = make([]*model.PollOption, 0)
+	for rows.Next() {
+		option := model.PollOption{}
+		if err := rows.Scan(
+			&option.ID,
+			&option.PostID,
+			&option.Position,
+			&option.Text,
+			&option.VoteCount,
+			&option.MeVoted,
+		); err != nil {
+			logrus.WithError(err).Error("failed to scan poll option")
+			return nil, err
+		}
+		option.PollIsAnonymous = poll.IsAnonymous
+		poll.Options = append(poll.Options, &option)
+	}
+	if err := rows.Err(); err != nil {
+		logrus.WithError(err).Error("failed iterating poll options")
+		return nil, err
+	}
+
+	return &poll, nil
+}
+
+// ReplacePollVotes swaps the votes cast on a poll by any of the user's
+// participants for the passed options, recorded under participantID. Passing
+// no options retracts the user's vote.
+func (d *delphisDB) ReplacePollVotes(ctx context.Context, tx *sql.Tx, postID string, participantID string, userParticipantIDs []string, optionIDs []string) error {
+	logrus.Debug("ReplacePollVotes::SQL Delete/Create")
+	if err := d.initializeStatements(ctx); err != nil {
+		logrus.WithError(err).Error("ReplacePollVotes::failed to initialize statements")
+		return err
+	}
+
+	deletePollVotesStmt := tx.StmtContext(ctx, d.prepStmts.deletePollVotesByParticipantIDStmt)
+	for _, userParticipantID := range userParticipantIDs {
+		if _, err := deletePollVotesStmt.ExecContext(
+			ctx,
+			postID,
+			userParticipantID,
+		); err != nil {
+			logrus.WithError(err).Error("failed to execute deletePollVotesByParticipantIDStmt")
+			return errors.Wrap(err, "failed to delete poll votes")
+		}
+	}
+
+	putPollVoteStmt := tx.StmtContext(ctx, d.prepStmts.putPollVoteStmt)
+	for _, optionID := range optionIDs {
+		if _, err := putPollVoteStmt.ExecContext(
+			ctx,
+			postID,
+			optionID,
+			participantID,
+		); err != nil {
+			logrus.WithError(err).Error("failed to execute putPollVoteStmt")
+			return errors.Wrap(err, "failed to put poll vote")
+		}
+	}
+
+	return nil
+}
+
+func (d *delphisDB) GetPollVoterIDsByOptionID(ctx context.Context, optionID string) ([]string, error) {
+	logrus.Debug("GetPollVoterIDsByOptionID::SQL Query")
+	if err := d.initializeStatements(ctx); err != nil {
+		logrus.WithError(err).Error("GetPollVoterIDsByOptionID::failed to initialize statements")
+		return nil, err
+	}
+
+	rows, err := d.prepStmts.getPollVoterIDsByOptionIDStmt.QueryContext(
+		ctx,
+		optionID,
+	)
+	if err != nil {
+		logrus.WithError(err).Error("failed to query getPollVoterIDsByOptionIDStmt")
+		return nil, err
+	}
+	defer rows.Close()
+
+	participantIDs := make([]string, 0)
+	for rows.Next() {
+		var participantID string
+		if err := rows.Scan(&participantID); err != nil {
+			logrus.WithError(err).Error("failed to scan poll voter")
+			return nil, err
+		}
+		participantIDs = append(participantIDs, participantID)
+	}
+	if err := rows.Err(); err != nil {
+		logrus.WithError(err).Error("failed iterating poll voters")
+		return nil, err
+	}
+
+	return participantIDs, nil
+}
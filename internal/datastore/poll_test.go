@@ -0,0 +1,337 @@
+package datastore
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/delphis-inc/delphisbe/graph/model"
+	"github.com/delphis-inc/delphisbe/internal/config"
+	"github.com/jinzhu/gorm"
+	"github.com/lib/pq"
+	. "github.com/smartystreets/goconvey/convey"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDelphisDB_PutPoll(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	pollObj := model.Poll{
+		PostID:             "post1",
+		AllowMultipleVotes: true,
+		IsAnonymous:        false,
+		ClosesAt:           &now,
+		Options: []*model.PollOption{
+			{ID: "option1", Position: 0, Text: "yes"},
+			{ID: "option2", Position: 1, Text: "no"},
+		},
+	}
+
+	Convey("PutPoll", t, func() {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+
+		assert.Nil(t, err, "Failed setting up sqlmock db")
+
+		gormDB, _ := gorm.Open("postgres", db)
+		mockDatastore := &delphisDB{
+			dbConfig:  config.TablesConfig{},
+			sql:       gormDB,
+			pg:        db,
+			prepStmts: &dbPrepStmts{},
+			dynamo:    nil,
+			encoder:   nil,
+		}
+		defer db.Close()
+
+		Convey("when preparing statements returns an error", func() {
+			mock.ExpectBegin()
+			mockPreparedStatementsWithError(mock)
+
+			tx, err := mockDatastore.BeginTx(ctx)
+			err = mockDatastore.PutPoll(ctx, tx, pollObj)
+
+			So(err, ShouldNotBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when putting the poll returns an error", func() {
+			mock.ExpectBegin()
+			mockPreparedStatements(mock)
+			mock.ExpectPrepare(putPollString)
+			mock.ExpectExec(putPollString).WithArgs(pollObj.PostID, pollObj.AllowMultipleVotes, pollObj.IsAnonymous, pollObj.ClosesAt).WillReturnError(fmt.Errorf("error"))
+
+			tx, err := mockDatastore.BeginTx(ctx)
+			err = mockDatastore.PutPoll(ctx, tx, pollObj)
+
+			So(err, ShouldNotBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when putting an option returns an error", func() {
+			mock.ExpectBegin()
+			mockPreparedStatements(mock)
+			mock.ExpectPrepare(putPollString)
+			mock.ExpectExec(putPollString).WithArgs(pollObj.PostID, pollObj.AllowMultipleVotes, pollObj.IsAnonymous, pollObj.ClosesAt).WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectPrepare(putPollOptionString)
+			mock.ExpectExec(putPollOptionString).WithArgs("option1", pollObj.PostID, 0, "yes").WillReturnError(fmt.Errorf("error"))
+
+			tx, err := mockDatastore.BeginTx(ctx)
+			err = mockDatastore.PutPoll(ctx, tx, pollObj)
+
+			So(err, ShouldNotBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when the poll and its options are stored", func() {
+			mock.ExpectBegin()
+			mockPreparedStatements(mock)
+			mock.ExpectPrepare(putPollString)
+			mock.ExpectExec(putPollString).WithArgs(pollObj.PostID, pollObj.AllowMultipleVotes, pollObj.IsAnonymous, pollObj.ClosesAt).WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectPrepare(putPollOptionString)
+			mock.ExpectExec(putPollOptionString).WithArgs("option1", pollObj.PostID, 0, "yes").WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectExec(putPollOptionString).WithArgs("option2", pollObj.PostID, 1, "no").WillReturnResult(sqlmock.NewResult(0, 1))
+
+			tx, err := mockDatastore.BeginTx(ctx)
+			err = mockDatastore.PutPoll(ctx, tx, pollObj)
+
+			So(err, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+	})
+}
+
+func TestDelphisDB_GetPollByPostID(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	postID := "post1"
+	participantIDs := []string{"participant1", "participant2"}
+
+	Convey("GetPollByPostID", t, func() {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+
+		assert.Nil(t, err, "Failed setting up sqlmock db")
+
+		gormDB, _ := gorm.Open("postgres", db)
+		mockDatastore := &delphisDB{
+			dbConfig:  config.TablesConfig{},
+			sql:       gormDB,
+			pg:        db,
+			prepStmts: &dbPrepStmts{},
+			dynamo:    nil,
+			encoder:   nil,
+		}
+		defer db.Close()
+
+		pollColumns := []string{"post_id", "allow_multiple_votes", "is_anonymous", "closes_at", "created_at"}
+		optionColumns := []string{"o.id", "o.post_id", "o.position", "o.text", "count", "coalesce"}
+
+		Convey("when preparing statements returns an error", func() {
+			mockPreparedStatementsWithError(mock)
+
+			resp, err := mockDatastore.GetPollByPostID(ctx, postID, participantIDs)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when the post has no poll", func() {
+			mockPreparedStatements(mock)
+			mock.ExpectQuery(getPollByPostIDString).WithArgs(postID).WillReturnRows(sqlmock.NewRows(pollColumns))
+
+			resp, err := mockDatastore.GetPollByPostID(ctx, postID, participantIDs)
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when querying the tallies returns an error", func() {
+			mockPreparedStatements(mock)
+			mock.ExpectQuery(getPollByPostIDString).WithArgs(postID).WillReturnRows(sqlmock.NewRows(pollColumns).AddRow(postID, false, true, nil, now))
+			mock.ExpectQuery(getPollOptionTalliesByPostIDString).WithArgs(postID, pq.Array(participantIDs)).WillReturnError(fmt.Errorf("error"))
+
+			resp, err := mockDatastore.GetPollByPostID(ctx, postID, participantIDs)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when the poll is returned with its tallies", func() {
+			mockPreparedStatements(mock)
+			mock.ExpectQuery(getPollByPostIDString).WithArgs(postID).WillReturnRows(sqlmock.NewRows(pollColumns).AddRow(postID, false, true, nil, now))
+			mock.ExpectQuery(getPollOptionTalliesByPostIDString).WithArgs(postID, pq.Array(participantIDs)).WillReturnRows(sqlmock.NewRows(optionColumns).
+				AddRow("option1", postID, 0, "yes", 3, true).
+				AddRow("option2", postID, 1, "no", 0, false))
+
+			resp, err := mockDatastore.GetPollByPostID(ctx, postID, participantIDs)
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldResemble, &model.Poll{
+				PostID:      postID,
+				IsAnonymous: true,
+				CreatedAt:   now,
+				Options: []*model.PollOption{
+					{ID: "option1", PostID: postID, Position: 0, Text: "yes", VoteCount: 3, MeVoted: true, PollIsAnonymous: true},
+					{ID: "option2", PostID: postID, Position: 1, Text: "no", VoteCount: 0, MeVoted: false, PollIsAnonymous: true},
+				},
+			})
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+	})
+}
+
+func TestDelphisDB_ReplacePollVotes(t *testing.T) {
+	ctx := context.Background()
+	postID := "post1"
+	participantID := "participant1"
+	otherParticipantID := "participant2"
+	userParticipantIDs := []string{participantID, otherParticipantID}
+	optionIDs := []string{"option1"}
+
+	Convey("ReplacePollVotes", t, func() {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+
+		assert.Nil(t, err, "Failed setting up sqlmock db")
+
+		gormDB, _ := gorm.Open("postgres", db)
+		mockDatastore := &delphisDB{
+			dbConfig:  config.TablesConfig{},
+			sql:       gormDB,
+			pg:        db,
+			prepStmts: &dbPrepStmts{},
+			dynamo:    nil,
+			encoder:   nil,
+		}
+		defer db.Close()
+
+		Convey("when preparing statements returns an error", func() {
+			mock.ExpectBegin()
+			mockPreparedStatementsWithError(mock)
+
+			tx, err := mockDatastore.BeginTx(ctx)
+			err = mockDatastore.ReplacePollVotes(ctx, tx, postID, participantID, userParticipantIDs, optionIDs)
+
+			So(err, ShouldNotBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when deleting the previous votes returns an error", func() {
+			mock.ExpectBegin()
+			mockPreparedStatements(mock)
+			mock.ExpectPrepare(deletePollVotesByParticipantIDString)
+			mock.ExpectExec(deletePollVotesByParticipantIDString).WithArgs(postID, participantID).WillReturnError(fmt.Errorf("error"))
+
+			tx, err := mockDatastore.BeginTx(ctx)
+			err = mockDatastore.ReplacePollVotes(ctx, tx, postID, participantID, userParticipantIDs, optionIDs)
+
+			So(err, ShouldNotBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when putting a vote returns an error", func() {
+			mock.ExpectBegin()
+			mockPreparedStatements(mock)
+			mock.ExpectPrepare(deletePollVotesByParticipantIDString)
+			mock.ExpectExec(deletePollVotesByParticipantIDString).WithArgs(postID, participantID).WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectExec(deletePollVotesByParticipantIDString).WithArgs(postID, otherParticipantID).WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectPrepare(putPollVoteString)
+			mock.ExpectExec(putPollVoteString).WithArgs(postID, "option1", participantID).WillReturnError(fmt.Errorf("error"))
+
+			tx, err := mockDatastore.BeginTx(ctx)
+			err = mockDatastore.ReplacePollVotes(ctx, tx, postID, participantID, userParticipantIDs, optionIDs)
+
+			So(err, ShouldNotBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when the votes are replaced", func() {
+			mock.ExpectBegin()
+			mockPreparedStatements(mock)
+			mock.ExpectPrepare(deletePollVotesByParticipantIDString)
+			mock.ExpectExec(deletePollVotesByParticipantIDString).WithArgs(postID, participantID).WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectExec(deletePollVotesByParticipantIDString).WithArgs(postID, otherParticipantID).WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectPrepare(putPollVoteString)
+			mock.ExpectExec(putPollVoteString).WithArgs(postID, "option1", participantID).WillReturnResult(sqlmock.NewResult(0, 1))
+
+			tx, err := mockDatastore.BeginTx(ctx)
+			err = mockDatastore.ReplacePollVotes(ctx, tx, postID, participantID, userParticipantIDs, optionIDs)
+
+			So(err, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when no options are passed the vote is retracted", func() {
+			mock.ExpectBegin()
+			mockPreparedStatements(mock)
+			mock.ExpectPrepare(deletePollVotesByParticipantIDString)
+			mock.ExpectExec(deletePollVotesByParticipantIDString).WithArgs(postID, participantID).WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectExec(deletePollVotesByParticipantIDString).WithArgs(postID, otherParticipantID).WillReturnResult(sqlmock.NewResult(0, 1))
+
+			tx, err := mockDatastore.BeginTx(ctx)
+			err = mockDatastore.ReplacePollVotes(ctx, tx, postID, participantID, userParticipantIDs, nil)
+
+			So(err, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+	})
+}
+
+func TestDelphisDB_GetPollVoterIDsByOptionID(t *testing.T) {
+	ctx := context.Background()
+	optionID := "option1"
+
+	Convey("GetPollVoterIDsByOptionID", t, func() {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+
+		assert.Nil(t, err, "Failed setting up sqlmock db")
+
+		gormDB, _ := gorm.Open("postgres", db)
+		mockDatastore := &delphisDB{
+			dbConfig:  config.TablesConfig{},
+			sql:       gormDB,
+			pg:        db,
+			prepStmts: &dbPrepStmts{},
+			dynamo:    nil,
+			encoder:   nil,
+		}
+		defer db.Close()
+
+		Convey("when preparing statements returns an error", func() {
+			mockPreparedStatementsWithError(mock)
+
+			resp, err := mockDatastore.GetPollVoterIDsByOptionID(ctx, optionID)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when query execution returns an error", func() {
+			mockPreparedStatements(mock)
+			mock.ExpectQuery(getPollVoterIDsByOptionIDString).WithArgs(optionID).WillReturnError(fmt.Errorf("error"))
+
+			resp, err := mockDatastore.GetPollVoterIDsByOptionID(ctx, optionID)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when the voters are returned", func() {
+			mockPreparedStatements(mock)
+			mock.ExpectQuery(getPollVoterIDsByOptionIDString).WithArgs(optionID).WillReturnRows(sqlmock.NewRows([]string{"participant_id"}).
+				AddRow("participant1").
+				AddRow("participant2"))
+
+			resp, err := mockDatastore.GetPollVoterIDsByOptionID(ctx, optionID)
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldResemble, []string{"participant1", "participant2"})
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+	})
+}
@@ -31,6 +31,7 @@ func (d *delphisDB) PutPost(ctx context.Context, tx *sql.Tx, post model.Post) (*
 		post.QuotedPostID,
 		post.MediaID,
 		post.PostType,
+		post.ParentPostID,
 	).Scan(
 		&post.ID,
 		&post.CreatedAt,
@@ -41,6 +42,7 @@ func (d *delphisDB) PutPost(ctx context.Context, tx *sql.Tx, post model.Post) (*
 		&post.QuotedPostID,
 		&post.MediaID,
 		&post.PostType,
+		&post.ParentPostID,
 	)
 	if err != nil {
 		logrus.WithError(err).Error("failed to execute putPostStmt")
@@ -50,6 +52,41 @@ func (d *delphisDB) PutPost(ctx context.Context, tx *sql.Tx, post model.Post) (*
 	return &post, nil
 }
 
+func (d *delphisDB) EditPost(ctx context.Context, tx *sql.Tx, post model.Post) (*model.Post, error) {
+	logrus.Debug("EditPost::SQL Update")
+	if err := d.initializeStatements(ctx); err != nil {
+		logrus.WithError(err).Error("EditPost::failed to initialize statements")
+		return nil, err
+	}
+
+	editHistory := make([]byte, 0)
+	err := tx.StmtContext(ctx, d.prepStmts.editPostStmt).QueryRowContext(
+		ctx,
+		post.ID,
+		post.PostContent.ID,
+		post.EditHistory,
+	).Scan(
+		&post.ID,
+		&post.CreatedAt,
+		&post.UpdatedAt,
+		&post.DiscussionID,
+		&post.ParticipantID,
+		&post.PostContentID,
+		&post.QuotedPostID,
+		&post.MediaID,
+		&post.PostType,
+		&editHistory,
+	)
+	if err != nil {
+		logrus.WithError(err).Error("failed to execute editPostStmt")
+		return nil, err
+	}
+
+	post.EditHistory.RawMessage = editHistory
+
+	return &post, nil
+}
+
 func (d *delphisDB) GetPostsByDiscussionIDIter(ctx context.Context, discussionID string) PostIter {
 	logrus.Debug("GetPostsByDiscussionID::SQL Query")
 	if err := d.initializeStatements(ctx); err != nil {
@@ -74,7 +111,7 @@ func (d *delphisDB) GetPostsByDiscussionIDIter(ctx context.Context, discussionID
 
 /* Equivalent of GetPostsByDiscussionIDIter, but accepting a cursor and a limit for fetching. In our implementation,
    the cursor indicates the creation timestamp of the posts, allowing to fetch contents up to a certain date and time. */
-func (d *delphisDB) GetPostsByDiscussionIDFromCursorIter(ctx context.Context, discussionID string, cursor string, limit int) PostIter {
+func (d *delphisDB) GetPostsByDiscussionIDFromCursorIter(ctx context.Context, discussionID string, cursor string, limit int, topLevelOnly bool) PostIter {
 	logrus.Debug("GetPostsByDiscussionIDFromCursorIter::SQL Query")
 	if err := d.initializeStatements(ctx); err != nil {
 		logrus.WithError(err).Error("GetPostsByDiscussionIDFromCursorIter::failed to initialize statements")
@@ -86,6 +123,7 @@ func (d *delphisDB) GetPostsByDiscussionIDFromCursorIter(ctx context.Context, di
 		discussionID,
 		cursor,
 		limit,
+		topLevelOnly,
 	)
 	if err != nil {
 		logrus.WithError(err).Error("failed to query GetPostsByDiscussionIDFromCursorIter")
@@ -98,7 +136,7 @@ func (d *delphisDB) GetPostsByDiscussionIDFromCursorIter(ctx context.Context, di
 	}
 }
 
-func (d *delphisDB) GetPostsConnectionByDiscussionID(ctx context.Context, discussionID string, cursor string, limit int) (*model.PostsConnection, error) {
+func (d *delphisDB) GetPostsConnectionByDiscussionID(ctx context.Context, discussionID string, cursor string, limit int, topLevelOnly bool) (*model.PostsConnection, error) {
 	if limit < 2 {
 		err := errors.New("Values of 'limit' is illegal")
 		logrus.WithError(err).Error("GetPostsConnectionByDiscussionID::illegal limit parameter")
@@ -113,13 +151,258 @@ func (d *delphisDB) GetPostsConnectionByDiscussionID(ctx context.Context, discus
 
 	/* Note: An additional item is fetched beyond the requested limit. This is required
 	   to determine if at least one next page is present after the current one. */
-	iter := d.GetPostsByDiscussionIDFromCursorIter(ctx, discussionID, cursor, limit+1)
+	iter := d.GetPostsByDiscussionIDFromCursorIter(ctx, discussionID, cursor, limit+1, topLevelOnly)
 	postArr, err := d.PostIterCollect(ctx, iter)
 	if err != nil {
 		logrus.WithError(err).Error("GetPostsConnectionByDiscussionID::failed to initialize statements")
 		return nil, err
 	}
 
+	hasPreviousPage, err := d.hasNewerPostsByDiscussionID(ctx, discussionID, cursor, topLevelOnly)
+	if err != nil {
+		logrus.WithError(err).Error("GetPostsConnectionByDiscussionID::failed to check for newer posts")
+		return nil, err
+	}
+
+	connection := buildPostsConnection(postArr, cursor, limit)
+	connection.PageInfo.HasPreviousPage = hasPreviousPage
+
+	return connection, nil
+}
+
+// Equivalent of GetPostsByDiscussionIDFromCursorIter in the other direction. Posts newer
+// than the cursor are returned oldest first, so the ones closest to the cursor come first.
+func (d *delphisDB) GetPostsByDiscussionIDBeforeCursorIter(ctx context.Context, discussionID string, cursor string, limit int, topLevelOnly bool) PostIter {
+	logrus.Debug("GetPostsByDiscussionIDBeforeCursorIter::SQL Query")
+	if err := d.initializeStatements(ctx); err != nil {
+		logrus.WithError(err).Error("GetPostsByDiscussionIDBeforeCursorIter::failed to initialize statements")
+		return &postIter{err: err}
+	}
+
+	rows, err := d.prepStmts.getPostsByDiscussionIDBeforeCursorStmt.QueryContext(
+		ctx,
+		discussionID,
+		cursor,
+		limit,
+		topLevelOnly,
+	)
+	if err != nil {
+		logrus.WithError(err).Error("failed to query GetPostsByDiscussionIDBeforeCursorIter")
+		return &postIter{err: err}
+	}
+
+	return &postIter{
+		ctx:  ctx,
+		rows: rows,
+	}
+}
+
+// The page of up to limit posts just newer than the cursor. Edges are still ordered
+// newest first, the same as GetPostsConnectionByDiscussionID.
+func (d *delphisDB) GetPostsConnectionByDiscussionIDBeforeCursor(ctx context.Context, discussionID string, cursor string, limit int, topLevelOnly bool) (*model.PostsConnection, error) {
+	if limit < 2 {
+		err := errors.New("Values of 'limit' is illegal")
+		logrus.WithError(err).Error("GetPostsConnectionByDiscussionIDBeforeCursor::illegal limit parameter")
+		return nil, err
+	}
+
+	logrus.Debug("GetPostsConnectionByDiscussionIDBeforeCursor::SQL Query")
+	if err := d.initializeStatements(ctx); err != nil {
+		logrus.WithError(err).Error("GetPostsConnectionByDiscussionIDBeforeCursor::failed to initialize statements")
+		return nil, err
+	}
+
+	iter := d.GetPostsByDiscussionIDBeforeCursorIter(ctx, discussionID, cursor, limit+1, topLevelOnly)
+	postArr, err := d.PostIterCollect(ctx, iter)
+	if err != nil {
+		logrus.WithError(err).Error("GetPostsConnectionByDiscussionIDBeforeCursor::failed to collect posts")
+		return nil, err
+	}
+
+	hasPreviousPage := len(postArr) > limit
+	if hasPreviousPage {
+		postArr = postArr[:limit]
+	}
+
+	hasNextPage, err := d.hasOlderPostsByDiscussionID(ctx, discussionID, cursor, topLevelOnly)
+	if err != nil {
+		logrus.WithError(err).Error("GetPostsConnectionByDiscussionIDBeforeCursor::failed to check for older posts")
+		return nil, err
+	}
+
+	return newPostsConnection(reversePosts(postArr), cursor, hasPreviousPage, hasNextPage), nil
+}
+
+// A page centered on anchorPost: up to newerLimit posts newer than it, the anchor
+// itself, then up to olderLimit posts older than it.
+func (d *delphisDB) GetPostsConnectionAroundPost(ctx context.Context, anchorPost model.Post, newerLimit int, olderLimit int, topLevelOnly bool) (*model.PostsConnection, error) {
+	if newerLimit < 1 || olderLimit < 1 {
+		err := errors.New("Values of 'limit' is illegal")
+		logrus.WithError(err).Error("GetPostsConnectionAroundPost::illegal limit parameter")
+		return nil, err
+	}
+	if anchorPost.DiscussionID == nil {
+		err := errors.New("Anchor post has no discussion")
+		logrus.WithError(err).Error("GetPostsConnectionAroundPost::illegal anchor post")
+		return nil, err
+	}
+
+	logrus.Debug("GetPostsConnectionAroundPost::SQL Query")
+	if err := d.initializeStatements(ctx); err != nil {
+		logrus.WithError(err).Error("GetPostsConnectionAroundPost::failed to initialize statements")
+		return nil, err
+	}
+
+	discussionID := *anchorPost.DiscussionID
+	cursor := anchorPost.CreatedAt.Format(time.RFC3339Nano)
+
+	iter := d.GetPostsByDiscussionIDBeforeCursorIter(ctx, discussionID, cursor, newerLimit+1, topLevelOnly)
+	newerPosts, err := d.PostIterCollect(ctx, iter)
+	if err != nil {
+		logrus.WithError(err).Error("GetPostsConnectionAroundPost::failed to collect newer posts")
+		return nil, err
+	}
+
+	iter = d.GetPostsByDiscussionIDFromCursorIter(ctx, discussionID, cursor, olderLimit+1, topLevelOnly)
+	olderPosts, err := d.PostIterCollect(ctx, iter)
+	if err != nil {
+		logrus.WithError(err).Error("GetPostsConnectionAroundPost::failed to collect older posts")
+		return nil, err
+	}
+
+	hasPreviousPage := len(newerPosts) > newerLimit
+	if hasPreviousPage {
+		newerPosts = newerPosts[:newerLimit]
+	}
+	hasNextPage := len(olderPosts) > olderLimit
+	if hasNextPage {
+		olderPosts = olderPosts[:olderLimit]
+	}
+
+	postArr := reversePosts(newerPosts)
+	if !topLevelOnly || anchorPost.ParentPostID == nil {
+		postArr = append(postArr, &anchorPost)
+	}
+	postArr = append(postArr, olderPosts...)
+
+	return newPostsConnection(postArr, cursor, hasPreviousPage, hasNextPage), nil
+}
+
+func (d *delphisDB) hasNewerPostsByDiscussionID(ctx context.Context, discussionID string, cursor string, topLevelOnly bool) (bool, error) {
+	exists := false
+	if err := d.prepStmts.hasNewerPostsByDiscussionIDStmt.QueryRowContext(
+		ctx,
+		discussionID,
+		cursor,
+		topLevelOnly,
+	).Scan(
+		&exists,
+	); err != nil {
+		logrus.WithError(err).Error("failed to execute hasNewerPostsByDiscussionIDStmt")
+		return false, err
+	}
+
+	return exists, nil
+}
+
+func (d *delphisDB) hasOlderPostsByDiscussionID(ctx context.Context, discussionID string, cursor string, topLevelOnly bool) (bool, error) {
+	exists := false
+	if err := d.prepStmts.hasOlderPostsByDiscussionIDStmt.QueryRowContext(
+		ctx,
+		discussionID,
+		cursor,
+		topLevelOnly,
+	).Scan(
+		&exists,
+	); err != nil {
+		logrus.WithError(err).Error("failed to execute hasOlderPostsByDiscussionIDStmt")
+		return false, err
+	}
+
+	return exists, nil
+}
+
+/* Equivalent of GetPostsByDiscussionIDFromCursorIter for the replies to a single post. */
+func (d *delphisDB) GetPostRepliesFromCursorIter(ctx context.Context, parentPostID string, cursor string, limit int) PostIter {
+	logrus.Debug("GetPostRepliesFromCursorIter::SQL Query")
+	if err := d.initializeStatements(ctx); err != nil {
+		logrus.WithError(err).Error("GetPostRepliesFromCursorIter::failed to initialize statements")
+		return &postIter{err: err}
+	}
+
+	rows, err := d.prepStmts.getPostRepliesFromCursorStmt.QueryContext(
+		ctx,
+		parentPostID,
+		cursor,
+		limit,
+	)
+	if err != nil {
+		logrus.WithError(err).Error("failed to query GetPostRepliesFromCursorIter")
+		return &postIter{err: err}
+	}
+
+	return &postIter{
+		ctx:  ctx,
+		rows: rows,
+	}
+}
+
+func (d *delphisDB) GetPostRepliesConnection(ctx context.Context, parentPostID string, cursor string, limit int) (*model.PostsConnection, error) {
+	if limit < 2 {
+		err := errors.New("Values of 'limit' is illegal")
+		logrus.WithError(err).Error("GetPostRepliesConnection::illegal limit parameter")
+		return nil, err
+	}
+
+	logrus.Debug("GetPostRepliesConnection::SQL Query")
+	if err := d.initializeStatements(ctx); err != nil {
+		logrus.WithError(err).Error("GetPostRepliesConnection::failed to initialize statements")
+		return nil, err
+	}
+
+	iter := d.GetPostRepliesFromCursorIter(ctx, parentPostID, cursor, limit+1)
+	postArr, err := d.PostIterCollect(ctx, iter)
+	if err != nil {
+		logrus.WithError(err).Error("GetPostRepliesConnection::failed to collect replies")
+		return nil, err
+	}
+
+	return buildPostsConnection(postArr, cursor, limit), nil
+}
+
+func (d *delphisDB) GetPostReplyCount(ctx context.Context, parentPostID string) (int, error) {
+	logrus.Debug("GetPostReplyCount::SQL Query")
+	if err := d.initializeStatements(ctx); err != nil {
+		logrus.WithError(err).Error("GetPostReplyCount::failed to initialize statements")
+		return 0, err
+	}
+
+	count := 0
+	if err := d.prepStmts.getPostReplyCountStmt.QueryRowContext(
+		ctx,
+		parentPostID,
+	).Scan(
+		&count,
+	); err != nil {
+		logrus.WithError(err).Error("failed to execute getPostReplyCountStmt")
+		return 0, err
+	}
+
+	return count, nil
+}
+
+/* postArr holds up to limit+1 posts, the extra one only signalling that another page exists. */
+func buildPostsConnection(postArr []*model.Post, cursor string, limit int) *model.PostsConnection {
+	hasNextPage := len(postArr) == limit+1
+	if hasNextPage {
+		postArr = postArr[:limit]
+	}
+
+	return newPostsConnection(postArr, cursor, false, hasNextPage)
+}
+
+/* postArr holds exactly the posts of the page, newest first. */
+func newPostsConnection(postArr []*model.Post, cursor string, hasPreviousPage bool, hasNextPage bool) *model.PostsConnection {
 	edges := make([]*model.PostsEdge, 0)
 	for _, elem := range postArr {
 		edges = append(edges, &model.PostsEdge{
@@ -136,30 +419,27 @@ func (d *delphisDB) GetPostsConnectionByDiscussionID(ctx context.Context, discus
 		return &model.PostsConnection{
 			Edges: edges,
 			PageInfo: model.PageInfo{
-				StartCursor: &cursor,
-				EndCursor:   &cursor,
-				HasNextPage: false,
+				StartCursor:     &cursor,
+				EndCursor:       &cursor,
+				HasNextPage:     false,
+				HasPreviousPage: hasPreviousPage,
 			},
-		}, nil
+		}
 	}
 
-	hasNextPage := len(postArr) == limit+1
 	startCursor := postArr[0].CreatedAt.Format(time.RFC3339Nano)
 	endCursor := postArr[len(postArr)-1].CreatedAt.Format(time.RFC3339Nano)
-	if hasNextPage {
-		endCursor = postArr[len(postArr)-2].CreatedAt.Format(time.RFC3339Nano)
-		edges = edges[:len(edges)-1]
-	}
 	pageInfo := model.PageInfo{
-		StartCursor: &startCursor,
-		EndCursor:   &endCursor,
-		HasNextPage: hasNextPage,
+		StartCursor:     &startCursor,
+		EndCursor:       &endCursor,
+		HasNextPage:     hasNextPage,
+		HasPreviousPage: hasPreviousPage,
 	}
 
 	return &model.PostsConnection{
 		Edges:    edges,
 		PageInfo: pageInfo,
-	}, nil
+	}
 }
 
 func (d *delphisDB) GetLastPostByDiscussionID(ctx context.Context, discussionID string) (*model.Post, error) {
@@ -171,6 +451,7 @@ func (d *delphisDB) GetLastPostByDiscussionID(ctx context.Context, discussionID
 
 	post := model.Post{}
 	postContent := model.PostContent{}
+	editHistory := make([]byte, 0)
 	if err := d.prepStmts.getLastPostByDiscussionIDStmt.QueryRowContext(
 		ctx,
 		discussionID,
@@ -185,6 +466,9 @@ func (d *delphisDB) GetLastPostByDiscussionID(ctx context.Context, discussionID
 		&post.QuotedPostID,
 		&post.MediaID,
 		&post.PostType,
+		&editHistory,
+		&post.ParentPostID,
+		&post.PinnedAt,
 		&postContent.ID,
 		&postContent.Content,
 		pq.Array(&postContent.MentionedEntities),
@@ -197,6 +481,7 @@ func (d *delphisDB) GetLastPostByDiscussionID(ctx context.Context, discussionID
 	}
 
 	post.PostContent = &postContent
+	post.EditHistory.RawMessage = editHistory
 
 	return &post, nil
 }
@@ -264,6 +549,46 @@ func (d *delphisDB) DeleteAllParticipantPosts(ctx context.Context, discussionID
 	return numReturned, nil
 }
 
+// RestoreParticipantPosts undoes DeleteAllParticipantPosts for the posts
+// deleted with the given reason and returns their IDs. Pins are left in place
+// by the delete, so restored posts come back pinned.
+func (d *delphisDB) RestoreParticipantPosts(ctx context.Context, discussionID string, participantID string, deletedReasonCode model.PostDeletedReason) ([]string, error) {
+	logrus.Debug("RestoreParticipantPosts::SQL Query")
+
+	if err := d.initializeStatements(ctx); err != nil {
+		logrus.WithError(err).Error("RestoreParticipantPosts::Failed to initialize statements")
+		return nil, err
+	}
+
+	rows, err := d.prepStmts.restoreParticipantPostsStmt.QueryContext(
+		ctx,
+		discussionID,
+		participantID,
+		string(deletedReasonCode),
+	)
+	if err != nil {
+		logrus.WithError(err).Error("Failed to restore participant posts")
+		return nil, err
+	}
+	defer rows.Close()
+
+	postIDs := make([]string, 0)
+	for rows.Next() {
+		var postID string
+		if err := rows.Scan(&postID); err != nil {
+			logrus.WithError(err).Error("failed to scan restored post")
+			return nil, err
+		}
+		postIDs = append(postIDs, postID)
+	}
+	if err := rows.Err(); err != nil {
+		logrus.WithError(err).Error("failed iterating restored posts")
+		return nil, err
+	}
+
+	return postIDs, nil
+}
+
 func (d *delphisDB) GetPostByID(ctx context.Context, postID string) (*model.Post, error) {
 	logrus.Debug("GetPostByID::SQL Query")
 	if err := d.initializeStatements(ctx); err != nil {
@@ -273,6 +598,7 @@ func (d *delphisDB) GetPostByID(ctx context.Context, postID string) (*model.Post
 
 	post := model.Post{}
 	postContent := model.PostContent{}
+	editHistory := make([]byte, 0)
 	if err := d.prepStmts.getPostByIDStmt.QueryRowContext(
 		ctx,
 		postID,
@@ -287,6 +613,9 @@ func (d *delphisDB) GetPostByID(ctx context.Context, postID string) (*model.Post
 		&post.QuotedPostID,
 		&post.MediaID,
 		&post.PostType,
+		&editHistory,
+		&post.ParentPostID,
+		&post.PinnedAt,
 		&postContent.ID,
 		&postContent.Content,
 		pq.Array(&postContent.MentionedEntities),
@@ -296,6 +625,7 @@ func (d *delphisDB) GetPostByID(ctx context.Context, postID string) (*model.Post
 	}
 
 	post.PostContent = &postContent
+	post.EditHistory.RawMessage = editHistory
 
 	return &post, nil
 }
@@ -320,9 +650,23 @@ func (iter *postIter) Next(post *model.Post) bool {
 	if !iter.rows.Next() {
 		return false
 	}
+
+	if iter.err = scanPostRow(iter.rows, post); iter.err != nil {
+		logrus.WithError(iter.err).Error("iterator failed to scan row")
+		return false
+	}
+
+	return true
+
+}
+
+// Scans the standard post and post content columns, followed by any extra
+// columns the query selects after them.
+func scanPostRow(rows *sql.Rows, post *model.Post, extra ...interface{}) error {
 	postContent := model.PostContent{}
+	editHistory := make([]byte, 0)
 
-	if iter.err = iter.rows.Scan(
+	dest := []interface{}{
 		&post.ID,
 		&post.CreatedAt,
 		&post.UpdatedAt,
@@ -333,18 +677,21 @@ func (iter *postIter) Next(post *model.Post) bool {
 		&post.QuotedPostID,
 		&post.MediaID,
 		&post.PostType,
+		&editHistory,
+		&post.ParentPostID,
+		&post.PinnedAt,
 		&postContent.ID,
 		&postContent.Content,
 		pq.Array(&postContent.MentionedEntities),
-	); iter.err != nil {
-		logrus.WithError(iter.err).Error("iterator failed to scan row")
-		return false
+	}
+	if err := rows.Scan(append(dest, extra...)...); err != nil {
+		return err
 	}
 
 	post.PostContent = &postContent
+	post.EditHistory.RawMessage = editHistory
 
-	return true
-
+	return nil
 }
 
 func (iter *postIter) Close() error {
@@ -370,8 +717,9 @@ func (d *delphisDB) PostIterCollect(ctx context.Context, iter PostIter) ([]*mode
 	for iter.Next(&post) {
 		tempPost := post
 
-		// Check if there is a quotedPostID. Fetch if so
-		if tempPost.QuotedPostID != nil {
+		// Check if there is a quotedPostID. Fetch if so. Posts deleted by a ban
+		// keep the column so unbanning can restore it, so skip deleted posts.
+		if tempPost.QuotedPostID != nil && tempPost.DeletedAt == nil {
 			var err error
 			// TODO: potentially optimize into joins
 			tempPost.QuotedPost, err = d.GetPostByID(ctx, *tempPost.QuotedPostID)
@@ -391,3 +739,52 @@ func (d *delphisDB) PostIterCollect(ctx context.Context, iter PostIter) ([]*mode
 
 	return posts, nil
 }
+
+func (d *delphisDB) UpdatePostPinnedAt(ctx context.Context, postID string, pinnedAt *time.Time) error {
+	logrus.Debug("UpdatePostPinnedAt::SQL Update")
+	if err := d.initializeStatements(ctx); err != nil {
+		logrus.WithError(err).Error("UpdatePostPinnedAt::failed to initialize statements")
+		return err
+	}
+
+	if _, err := d.prepStmts.updatePostPinnedAtStmt.ExecContext(
+		ctx,
+		postID,
+		pinnedAt,
+	); err != nil {
+		logrus.WithError(err).Error("failed to execute updatePostPinnedAtStmt")
+		return err
+	}
+
+	return nil
+}
+
+func (d *delphisDB) GetPinnedPostsByDiscussionIDIter(ctx context.Context, discussionID string) PostIter {
+	logrus.Debug("GetPinnedPostsByDiscussionIDIter::SQL Query")
+	if err := d.initializeStatements(ctx); err != nil {
+		logrus.WithError(err).Error("GetPinnedPostsByDiscussionIDIter::failed to initialize statements")
+		return &postIter{err: err}
+	}
+
+	rows, err := d.prepStmts.getPinnedPostsByDiscussionIDStmt.QueryContext(
+		ctx,
+		discussionID,
+	)
+	if err != nil {
+		logrus.WithError(err).Error("failed to query GetPinnedPostsByDiscussionIDIter")
+		return &postIter{err: err}
+	}
+
+	return &postIter{
+		ctx:  ctx,
+		rows: rows,
+	}
+}
+
+func reversePosts(postArr []*model.Post) []*model.Post {
+	reversed := make([]*model.Post, 0, len(postArr))
+	for i := len(postArr) - 1; i >= 0; i-- {
+		reversed = append(reversed, postArr[i])
+	}
+	return reversed
+}
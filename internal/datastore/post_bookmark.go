@@ -0,0 +1,126 @@
+package datastore
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/delphis-inc/delphisbe/graph/model"
+	"github.com/sirupsen/logrus"
+)
+
+func (d *delphisDB) PutPostBookmark(ctx context.Context, bookmark model.PostBookmark) error {
+	logrus.Debug("PutPostBookmark::SQL Insert")
+	if err := d.initializeStatements(ctx); err != nil {
+		logrus.WithError(err).Error("PutPostBookmark::failed to initialize statements")
+		return err
+	}
+
+	if _, err := d.prepStmts.putPostBookmarkStmt.ExecContext(
+		ctx,
+		bookmark.UserID,
+		bookmark.PostID,
+	); err != nil {
+		logrus.WithError(err).Error("failed to execute putPostBookmarkStmt")
+		return err
+	}
+
+	return nil
+}
+
+func (d *delphisDB) DeletePostBookmark(ctx context.Context, bookmark model.PostBookmark) error {
+	logrus.Debug("DeletePostBookmark::SQL Delete")
+	if err := d.initializeStatements(ctx); err != nil {
+		logrus.WithError(err).Error("DeletePostBookmark::failed to initialize statements")
+		return err
+	}
+
+	if _, err := d.prepStmts.deletePostBookmarkStmt.ExecContext(
+		ctx,
+		bookmark.UserID,
+		bookmark.PostID,
+	); err != nil {
+		logrus.WithError(err).Error("failed to execute deletePostBookmarkStmt")
+		return err
+	}
+
+	return nil
+}
+
+// The cursor is the creation time of the bookmark rather than of the post, so
+// the most recently bookmarked posts come first.
+func (d *delphisDB) GetPostBookmarksConnectionByUserID(ctx context.Context, userID string, cursor string, limit int) (*model.PostBookmarksConnection, error) {
+	if limit < 2 {
+		err := errors.New("Values of 'limit' is illegal")
+		logrus.WithError(err).Error("GetPostBookmarksConnectionByUserID::illegal limit parameter")
+		return nil, err
+	}
+
+	logrus.Debug("GetPostBookmarksConnectionByUserID::SQL Query")
+	if err := d.initializeStatements(ctx); err != nil {
+		logrus.WithError(err).Error("GetPostBookmarksConnectionByUserID::failed to initialize statements")
+		return nil, err
+	}
+
+	rows, err := d.prepStmts.getPostBookmarksByUserIDFromCursorStmt.QueryContext(
+		ctx,
+		userID,
+		cursor,
+		limit+1,
+	)
+	if err != nil {
+		logrus.WithError(err).Error("failed to query GetPostBookmarksConnectionByUserID")
+		return nil, err
+	}
+	defer rows.Close()
+
+	bookmarks := make([]*model.PostBookmark, 0)
+	for rows.Next() {
+		post := model.Post{}
+		bookmark := model.PostBookmark{UserID: userID}
+		if err := scanPostRow(rows, &post, &bookmark.CreatedAt); err != nil {
+			logrus.WithError(err).Error("failed to scan post bookmark")
+			return nil, err
+		}
+		bookmark.PostID = post.ID
+		bookmark.Post = &post
+		bookmarks = append(bookmarks, &bookmark)
+	}
+	if err := rows.Err(); err != nil {
+		logrus.WithError(err).Error("failed to iterate post bookmarks")
+		return nil, err
+	}
+
+	return buildPostBookmarksConnection(bookmarks, cursor, limit), nil
+}
+
+// bookmarks holds up to limit+1 bookmarks, the extra one only signalling that another page exists.
+func buildPostBookmarksConnection(bookmarks []*model.PostBookmark, cursor string, limit int) *model.PostBookmarksConnection {
+	hasNextPage := len(bookmarks) == limit+1
+	if hasNextPage {
+		bookmarks = bookmarks[:limit]
+	}
+
+	edges := make([]*model.PostBookmarksEdge, 0)
+	for _, elem := range bookmarks {
+		edges = append(edges, &model.PostBookmarksEdge{
+			Cursor: elem.CreatedAt.Format(time.RFC3339Nano),
+			Node:   elem,
+		})
+	}
+
+	startCursor, endCursor := cursor, cursor
+	if len(edges) > 0 {
+		startCursor = edges[0].Cursor
+		endCursor = edges[len(edges)-1].Cursor
+	}
+
+	return &model.PostBookmarksConnection{
+		Edges: edges,
+		PageInfo: model.PageInfo{
+			StartCursor: &startCursor,
+			EndCursor:   &endCursor,
+			HasNextPage: hasNextPage,
+		},
+	}
+}
@@ -0,0 +1,247 @@
+package datastore
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/delphis-inc/delphisbe/graph/model"
+	"github.com/delphis-inc/delphisbe/internal/config"
+	"github.com/jinzhu/gorm"
+	"github.com/lib/pq"
+	. "github.com/smartystreets/goconvey/convey"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDelphisDB_PutPostBookmark(t *testing.T) {
+	ctx := context.Background()
+	bookmarkObj := model.PostBookmark{
+		UserID: "user1",
+		PostID: "post1",
+	}
+
+	Convey("PutPostBookmark", t, func() {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+
+		assert.Nil(t, err, "Failed setting up sqlmock db")
+
+		gormDB, _ := gorm.Open("postgres", db)
+		mockDatastore := &delphisDB{
+			dbConfig:  config.TablesConfig{},
+			sql:       gormDB,
+			pg:        db,
+			prepStmts: &dbPrepStmts{},
+			dynamo:    nil,
+			encoder:   nil,
+		}
+		defer db.Close()
+
+		Convey("when preparing statements returns an error", func() {
+			mockPreparedStatementsWithError(mock)
+
+			err := mockDatastore.PutPostBookmark(ctx, bookmarkObj)
+
+			So(err, ShouldNotBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when query execution returns an error", func() {
+			mockPreparedStatements(mock)
+			mock.ExpectExec(putPostBookmarkString).WithArgs(bookmarkObj.UserID, bookmarkObj.PostID).WillReturnError(fmt.Errorf("error"))
+
+			err := mockDatastore.PutPostBookmark(ctx, bookmarkObj)
+
+			So(err, ShouldNotBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when the bookmark is stored", func() {
+			mockPreparedStatements(mock)
+			mock.ExpectExec(putPostBookmarkString).WithArgs(bookmarkObj.UserID, bookmarkObj.PostID).WillReturnResult(sqlmock.NewResult(0, 1))
+
+			err := mockDatastore.PutPostBookmark(ctx, bookmarkObj)
+
+			So(err, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+	})
+}
+
+func TestDelphisDB_DeletePostBookmark(t *testing.T) {
+	ctx := context.Background()
+	bookmarkObj := model.PostBookmark{
+		UserID: "user1",
+		PostID: "post1",
+	}
+
+	Convey("DeletePostBookmark", t, func() {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+
+		assert.Nil(t, err, "Failed setting up sqlmock db")
+
+		gormDB, _ := gorm.Open("postgres", db)
+		mockDatastore := &delphisDB{
+			dbConfig:  config.TablesConfig{},
+			sql:       gormDB,
+			pg:        db,
+			prepStmts: &dbPrepStmts{},
+			dynamo:    nil,
+			encoder:   nil,
+		}
+		defer db.Close()
+
+		Convey("when preparing statements returns an error", func() {
+			mockPreparedStatementsWithError(mock)
+
+			err := mockDatastore.DeletePostBookmark(ctx, bookmarkObj)
+
+			So(err, ShouldNotBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when query execution returns an error", func() {
+			mockPreparedStatements(mock)
+			mock.ExpectExec(deletePostBookmarkString).WithArgs(bookmarkObj.UserID, bookmarkObj.PostID).WillReturnError(fmt.Errorf("error"))
+
+			err := mockDatastore.DeletePostBookmark(ctx, bookmarkObj)
+
+			So(err, ShouldNotBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when the bookmark is deleted", func() {
+			mockPreparedStatements(mock)
+			mock.ExpectExec(deletePostBookmarkString).WithArgs(bookmarkObj.UserID, bookmarkObj.PostID).WillReturnResult(sqlmock.NewResult(0, 1))
+
+			err := mockDatastore.DeletePostBookmark(ctx, bookmarkObj)
+
+			So(err, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+	})
+}
+
+func TestDelphisDB_GetPostBookmarksConnectionByUserID(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	userID := "user1"
+	discussionID := "discussion1"
+	participantID := "participant1"
+	cursor := now.String()
+	limit := 2
+	postObject := model.Post{
+		ID:            "post1",
+		CreatedAt:     now,
+		UpdatedAt:     now,
+		DiscussionID:  &discussionID,
+		ParticipantID: &participantID,
+		PostContent: &model.PostContent{
+			ID:      "postContent1",
+			Content: "test",
+		},
+		PostType: model.PostTypeStandard,
+	}
+	bookmarkedAt := now.Add(time.Hour)
+
+	Convey("GetPostBookmarksConnectionByUserID", t, func() {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+
+		assert.Nil(t, err, "Failed setting up sqlmock db")
+
+		gormDB, _ := gorm.Open("postgres", db)
+		mockDatastore := &delphisDB{
+			dbConfig:  config.TablesConfig{},
+			sql:       gormDB,
+			pg:        db,
+			prepStmts: &dbPrepStmts{},
+			dynamo:    nil,
+			encoder:   nil,
+		}
+		defer db.Close()
+
+		Convey("when limit less than two is passed in", func() {
+			resp, err := mockDatastore.GetPostBookmarksConnectionByUserID(ctx, userID, cursor, 1)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when preparing statements returns an error", func() {
+			mockPreparedStatementsWithError(mock)
+
+			resp, err := mockDatastore.GetPostBookmarksConnectionByUserID(ctx, userID, cursor, limit)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when query execution returns an error", func() {
+			mockPreparedStatements(mock)
+			mock.ExpectQuery(getPostBookmarksByUserIDFromCursorString).WithArgs(userID, cursor, limit+1).WillReturnError(fmt.Errorf("error"))
+
+			resp, err := mockDatastore.GetPostBookmarksConnectionByUserID(ctx, userID, cursor, limit)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when there are no bookmarks", func() {
+			mockPreparedStatements(mock)
+			rs := sqlmock.NewRows([]string{"p.id", "p.created_at", "p.updated_at", "p.deleted_at", "p.deleted_reason_code", "p.discussion_id", "p.participant_id",
+				"p.quoted_post_id", "p.media_id", "p.post_type", "p.edit_history", "p.parent_post_id", "p.pinned_at", "pc.id", "pc.content", "pc.mentioned_entities", "b.created_at"})
+			mock.ExpectQuery(getPostBookmarksByUserIDFromCursorString).WithArgs(userID, cursor, limit+1).WillReturnRows(rs)
+
+			resp, err := mockDatastore.GetPostBookmarksConnectionByUserID(ctx, userID, cursor, limit)
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldResemble, &model.PostBookmarksConnection{
+				Edges: []*model.PostBookmarksEdge{},
+				PageInfo: model.PageInfo{
+					StartCursor: &cursor,
+					EndCursor:   &cursor,
+					HasNextPage: false,
+				},
+			})
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when query execution succeeds and returns bookmarks", func() {
+			mockPreparedStatements(mock)
+			rs := sqlmock.NewRows([]string{"p.id", "p.created_at", "p.updated_at", "p.deleted_at", "p.deleted_reason_code", "p.discussion_id", "p.participant_id",
+				"p.quoted_post_id", "p.media_id", "p.post_type", "p.edit_history", "p.parent_post_id", "p.pinned_at", "pc.id", "pc.content", "pc.mentioned_entities", "b.created_at"})
+			for i := 0; i < limit+1; i++ {
+				rs = rs.AddRow(postObject.ID, postObject.CreatedAt, postObject.UpdatedAt, postObject.DeletedAt, postObject.DeletedReasonCode, postObject.DiscussionID,
+					postObject.ParticipantID, postObject.QuotedPostID, postObject.MediaID, postObject.PostType, []byte(postObject.EditHistory.RawMessage), postObject.ParentPostID, postObject.PinnedAt, postObject.PostContent.ID, postObject.PostContent.Content, pq.Array(postObject.PostContent.MentionedEntities), bookmarkedAt)
+			}
+			mock.ExpectQuery(getPostBookmarksByUserIDFromCursorString).WithArgs(userID, cursor, limit+1).WillReturnRows(rs)
+
+			resp, err := mockDatastore.GetPostBookmarksConnectionByUserID(ctx, userID, cursor, limit)
+
+			bookmark := &model.PostBookmark{
+				UserID:    userID,
+				PostID:    postObject.ID,
+				CreatedAt: bookmarkedAt,
+				Post:      &postObject,
+			}
+			bookmarkCursor := bookmarkedAt.Format(time.RFC3339Nano)
+			So(err, ShouldBeNil)
+			So(resp, ShouldResemble, &model.PostBookmarksConnection{
+				Edges: []*model.PostBookmarksEdge{
+					{Cursor: bookmarkCursor, Node: bookmark},
+					{Cursor: bookmarkCursor, Node: bookmark},
+				},
+				PageInfo: model.PageInfo{
+					StartCursor: &bookmarkCursor,
+					EndCursor:   &bookmarkCursor,
+					HasNextPage: true,
+				},
+			})
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+	})
+}
@@ -0,0 +1,89 @@
+package datastore
+
+import (
+	"context"
+
+	"github.com/delphis-inc/delphisbe/graph/model"
+	"github.com/sirupsen/logrus"
+)
+
+func (d *delphisDB) PutPostReaction(ctx context.Context, reaction model.PostReaction) error {
+	logrus.Debug("PutPostReaction::SQL Insert")
+	if err := d.initializeStatements(ctx); err != nil {
+		logrus.WithError(err).Error("PutPostReaction::failed to initialize statements")
+		return err
+	}
+
+	if _, err := d.prepStmts.putPostReactionStmt.ExecContext(
+		ctx,
+		reaction.PostID,
+		reaction.ParticipantID,
+		reaction.Reaction,
+	); err != nil {
+		logrus.WithError(err).Error("failed to execute putPostReactionStmt")
+		return err
+	}
+
+	return nil
+}
+
+func (d *delphisDB) DeletePostReaction(ctx context.Context, reaction model.PostReaction) error {
+	logrus.Debug("DeletePostReaction::SQL Delete")
+	if err := d.initializeStatements(ctx); err != nil {
+		logrus.WithError(err).Error("DeletePostReaction::failed to initialize statements")
+		return err
+	}
+
+	if _, err := d.prepStmts.deletePostReactionStmt.ExecContext(
+		ctx,
+		reaction.PostID,
+		reaction.ParticipantID,
+		reaction.Reaction,
+	); err != nil {
+		logrus.WithError(err).Error("failed to execute deletePostReactionStmt")
+		return err
+	}
+
+	return nil
+}
+
+// GetPostReactionSummaries counts the reactions on a post, oldest reaction
+// first. MeReacted is set for reactions left by the passed participant.
+func (d *delphisDB) GetPostReactionSummaries(ctx context.Context, postID string, participantID *string) ([]*model.PostReactionSummary, error) {
+	logrus.Debug("GetPostReactionSummaries::SQL Query")
+	if err := d.initializeStatements(ctx); err != nil {
+		logrus.WithError(err).Error("GetPostReactionSummaries::failed to initialize statements")
+		return nil, err
+	}
+
+	rows, err := d.prepStmts.getPostReactionSummariesStmt.QueryContext(
+		ctx,
+		postID,
+		participantID,
+	)
+	if err != nil {
+		logrus.WithError(err).Error("failed to query getPostReactionSummariesStmt")
+		return nil, err
+	}
+	defer rows.Close()
+
+	summaries := make([]*model.PostReactionSummary, 0)
+	for rows.Next() {
+		summary := model.PostReactionSummary{}
+		if err := rows.Scan(
+			&summary.Reaction,
+			&summary.Count,
+			&summary.MeReacted,
+		); err != nil {
+			logrus.WithError(err).Error("failed to scan post reaction summary")
+			return nil, err
+		}
+		summaries = append(summaries, &summary)
+	}
+	if err := rows.Err(); err != nil {
+		logrus.WithError(err).Error("failed iterating post reaction summaries")
+		return nil, err
+	}
+
+	return summaries, nil
+}
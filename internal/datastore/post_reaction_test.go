@@ -0,0 +1,205 @@
+package datastore
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/delphis-inc/delphisbe/graph/model"
+	"github.com/delphis-inc/delphisbe/internal/config"
+	"github.com/jinzhu/gorm"
+	. "github.com/smartystreets/goconvey/convey"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDelphisDB_PutPostReaction(t *testing.T) {
+	ctx := context.Background()
+	reactionObj := model.PostReaction{
+		PostID:        "post1",
+		ParticipantID: "participant1",
+		Reaction:      "👍",
+	}
+
+	Convey("PutPostReaction", t, func() {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+
+		assert.Nil(t, err, "Failed setting up sqlmock db")
+
+		gormDB, _ := gorm.Open("postgres", db)
+		mockDatastore := &delphisDB{
+			dbConfig:  config.TablesConfig{},
+			sql:       gormDB,
+			pg:        db,
+			prepStmts: &dbPrepStmts{},
+			dynamo:    nil,
+			encoder:   nil,
+		}
+		defer db.Close()
+
+		Convey("when preparing statements returns an error", func() {
+			mockPreparedStatementsWithError(mock)
+
+			err := mockDatastore.PutPostReaction(ctx, reactionObj)
+
+			So(err, ShouldNotBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when query execution returns an error", func() {
+			mockPreparedStatements(mock)
+			mock.ExpectExec(putPostReactionString).WithArgs(reactionObj.PostID, reactionObj.ParticipantID, reactionObj.Reaction).
+				WillReturnError(fmt.Errorf("error"))
+
+			err := mockDatastore.PutPostReaction(ctx, reactionObj)
+
+			So(err, ShouldNotBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when put post reaction succeeds", func() {
+			mockPreparedStatements(mock)
+			mock.ExpectExec(putPostReactionString).WithArgs(reactionObj.PostID, reactionObj.ParticipantID, reactionObj.Reaction).
+				WillReturnResult(sqlmock.NewResult(0, 1))
+
+			err := mockDatastore.PutPostReaction(ctx, reactionObj)
+
+			So(err, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+	})
+}
+
+func TestDelphisDB_DeletePostReaction(t *testing.T) {
+	ctx := context.Background()
+	reactionObj := model.PostReaction{
+		PostID:        "post1",
+		ParticipantID: "participant1",
+		Reaction:      "👍",
+	}
+
+	Convey("DeletePostReaction", t, func() {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+
+		assert.Nil(t, err, "Failed setting up sqlmock db")
+
+		gormDB, _ := gorm.Open("postgres", db)
+		mockDatastore := &delphisDB{
+			dbConfig:  config.TablesConfig{},
+			sql:       gormDB,
+			pg:        db,
+			prepStmts: &dbPrepStmts{},
+			dynamo:    nil,
+			encoder:   nil,
+		}
+		defer db.Close()
+
+		Convey("when preparing statements returns an error", func() {
+			mockPreparedStatementsWithError(mock)
+
+			err := mockDatastore.DeletePostReaction(ctx, reactionObj)
+
+			So(err, ShouldNotBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when query execution returns an error", func() {
+			mockPreparedStatements(mock)
+			mock.ExpectExec(deletePostReactionString).WithArgs(reactionObj.PostID, reactionObj.ParticipantID, reactionObj.Reaction).
+				WillReturnError(fmt.Errorf("error"))
+
+			err := mockDatastore.DeletePostReaction(ctx, reactionObj)
+
+			So(err, ShouldNotBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when delete post reaction succeeds", func() {
+			mockPreparedStatements(mock)
+			mock.ExpectExec(deletePostReactionString).WithArgs(reactionObj.PostID, reactionObj.ParticipantID, reactionObj.Reaction).
+				WillReturnResult(sqlmock.NewResult(0, 1))
+
+			err := mockDatastore.DeletePostReaction(ctx, reactionObj)
+
+			So(err, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+	})
+}
+
+func TestDelphisDB_GetPostReactionSummaries(t *testing.T) {
+	ctx := context.Background()
+	postID := "post1"
+	participantID := "participant1"
+	summaryObjs := []*model.PostReactionSummary{
+		{Reaction: "👍", Count: 3, MeReacted: true},
+		{Reaction: "🎉", Count: 1, MeReacted: false},
+	}
+
+	Convey("GetPostReactionSummaries", t, func() {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+
+		assert.Nil(t, err, "Failed setting up sqlmock db")
+
+		gormDB, _ := gorm.Open("postgres", db)
+		mockDatastore := &delphisDB{
+			dbConfig:  config.TablesConfig{},
+			sql:       gormDB,
+			pg:        db,
+			prepStmts: &dbPrepStmts{},
+			dynamo:    nil,
+			encoder:   nil,
+		}
+		defer db.Close()
+
+		Convey("when preparing statements returns an error", func() {
+			mockPreparedStatementsWithError(mock)
+
+			resp, err := mockDatastore.GetPostReactionSummaries(ctx, postID, &participantID)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when query execution returns an error", func() {
+			mockPreparedStatements(mock)
+			mock.ExpectQuery(getPostReactionSummariesString).WithArgs(postID, &participantID).WillReturnError(fmt.Errorf("error"))
+
+			resp, err := mockDatastore.GetPostReactionSummaries(ctx, postID, &participantID)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when scanning a row returns an error", func() {
+			rs := sqlmock.NewRows([]string{"reaction", "count"}).AddRow("👍", 3)
+
+			mockPreparedStatements(mock)
+			mock.ExpectQuery(getPostReactionSummariesString).WithArgs(postID, &participantID).WillReturnRows(rs)
+
+			resp, err := mockDatastore.GetPostReactionSummaries(ctx, postID, &participantID)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when the summaries are returned", func() {
+			rs := sqlmock.NewRows([]string{"reaction", "count", "me_reacted"})
+			for _, summary := range summaryObjs {
+				rs = rs.AddRow(summary.Reaction, summary.Count, summary.MeReacted)
+			}
+
+			mockPreparedStatements(mock)
+			mock.ExpectQuery(getPostReactionSummariesString).WithArgs(postID, &participantID).WillReturnRows(rs)
+
+			resp, err := mockDatastore.GetPostReactionSummaries(ctx, postID, &participantID)
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldResemble, summaryObjs)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+	})
+}
@@ -0,0 +1,210 @@
+package datastore
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/delphis-inc/delphisbe/graph/model"
+	"github.com/sirupsen/logrus"
+)
+
+func (d *delphisDB) PutPostReport(ctx context.Context, report model.PostReport) (*model.PostReport, error) {
+	logrus.Debug("PutPostReport::SQL Insert")
+	if err := d.initializeStatements(ctx); err != nil {
+		logrus.WithError(err).Error("PutPostReport::failed to initialize statements")
+		return nil, err
+	}
+
+	resp, err := scanPostReport(d.prepStmts.putPostReportStmt.QueryRowContext(
+		ctx,
+		report.ID,
+		report.DiscussionID,
+		report.PostID,
+		report.ReporterUserID,
+		report.Reason,
+		report.Note,
+		report.Status,
+	))
+	if err != nil {
+		logrus.WithError(err).Error("failed to execute putPostReportStmt")
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (d *delphisDB) GetPostReportByID(ctx context.Context, id string) (*model.PostReport, error) {
+	logrus.Debug("GetPostReportByID::SQL Query")
+	if err := d.initializeStatements(ctx); err != nil {
+		logrus.WithError(err).Error("GetPostReportByID::failed to initialize statements")
+		return nil, err
+	}
+
+	resp, err := scanPostReport(d.prepStmts.getPostReportByIDStmt.QueryRowContext(
+		ctx,
+		id,
+	))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		logrus.WithError(err).Error("failed to execute getPostReportByIDStmt")
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (d *delphisDB) GetPostReportByPostIDReporterUserID(ctx context.Context, postID string, reporterUserID string) (*model.PostReport, error) {
+	logrus.Debug("GetPostReportByPostIDReporterUserID::SQL Query")
+	if err := d.initializeStatements(ctx); err != nil {
+		logrus.WithError(err).Error("GetPostReportByPostIDReporterUserID::failed to initialize statements")
+		return nil, err
+	}
+
+	resp, err := scanPostReport(d.prepStmts.getPostReportByPostIDReporterUserIDStmt.QueryRowContext(
+		ctx,
+		postID,
+		reporterUserID,
+	))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		logrus.WithError(err).Error("failed to execute getPostReportByPostIDReporterUserIDStmt")
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (d *delphisDB) GetPostReportsConnectionByDiscussionID(ctx context.Context, discussionID string, status model.PostReportStatus, cursor string, limit int) (*model.PostReportConnection, error) {
+	if limit < 2 {
+		err := errors.New("Values of 'limit' is illegal")
+		logrus.WithError(err).Error("GetPostReportsConnectionByDiscussionID::illegal limit parameter")
+		return nil, err
+	}
+
+	logrus.Debug("GetPostReportsConnectionByDiscussionID::SQL Query")
+	if err := d.initializeStatements(ctx); err != nil {
+		logrus.WithError(err).Error("GetPostReportsConnectionByDiscussionID::failed to initialize statements")
+		return nil, err
+	}
+
+	rows, err := d.prepStmts.getPostReportsByDiscussionIDStatusFromCursorStmt.QueryContext(
+		ctx,
+		discussionID,
+		status,
+		cursor,
+		limit+1,
+	)
+	if err != nil {
+		logrus.WithError(err).Error("failed to query GetPostReportsConnectionByDiscussionID")
+		return nil, err
+	}
+	defer rows.Close()
+
+	reports, err := collectPostReports(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	return buildPostReportConnection(reports, cursor, limit), nil
+}
+
+// ResolvePostReports resolves every open report on the post and returns them.
+func (d *delphisDB) ResolvePostReports(ctx context.Context, postID string, status model.PostReportStatus, action *model.PostReportAction, resolvedByUserID string) ([]*model.PostReport, error) {
+	logrus.Debug("ResolvePostReports::SQL Update")
+	if err := d.initializeStatements(ctx); err != nil {
+		logrus.WithError(err).Error("ResolvePostReports::failed to initialize statements")
+		return nil, err
+	}
+
+	rows, err := d.prepStmts.resolvePostReportsByPostIDStmt.QueryContext(
+		ctx,
+		postID,
+		status,
+		action,
+		resolvedByUserID,
+		model.PostReportStatusOpen,
+	)
+	if err != nil {
+		logrus.WithError(err).Error("failed to execute resolvePostReportsByPostIDStmt")
+		return nil, err
+	}
+	defer rows.Close()
+
+	return collectPostReports(rows)
+}
+
+func collectPostReports(rows *sql.Rows) ([]*model.PostReport, error) {
+	reports := make([]*model.PostReport, 0)
+	for rows.Next() {
+		report, err := scanPostReport(rows)
+		if err != nil {
+			logrus.WithError(err).Error("failed to scan post report")
+			return nil, err
+		}
+		reports = append(reports, report)
+	}
+	if err := rows.Err(); err != nil {
+		logrus.WithError(err).Error("failed to iterate post reports")
+		return nil, err
+	}
+
+	return reports, nil
+}
+
+func scanPostReport(row rowScanner) (*model.PostReport, error) {
+	report := model.PostReport{}
+	if err := row.Scan(
+		&report.ID,
+		&report.DiscussionID,
+		&report.PostID,
+		&report.ReporterUserID,
+		&report.Reason,
+		&report.Note,
+		&report.Status,
+		&report.Action,
+		&report.ResolvedByUserID,
+		&report.ResolvedAt,
+		&report.CreatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	return &report, nil
+}
+
+// reports holds up to limit+1 reports, the extra one only signalling that another page exists.
+func buildPostReportConnection(reports []*model.PostReport, cursor string, limit int) *model.PostReportConnection {
+	hasNextPage := len(reports) == limit+1
+	if hasNextPage {
+		reports = reports[:limit]
+	}
+
+	edges := make([]*model.PostReportEdge, 0)
+	for _, elem := range reports {
+		edges = append(edges, &model.PostReportEdge{
+			Cursor: elem.CreatedAt.Format(time.RFC3339Nano),
+			Node:   elem,
+		})
+	}
+
+	startCursor, endCursor := cursor, cursor
+	if len(edges) > 0 {
+		startCursor = edges[0].Cursor
+		endCursor = edges[len(edges)-1].Cursor
+	}
+
+	return &model.PostReportConnection{
+		Edges: edges,
+		PageInfo: model.PageInfo{
+			StartCursor: &startCursor,
+			EndCursor:   &endCursor,
+			HasNextPage: hasNextPage,
+		},
+	}
+}
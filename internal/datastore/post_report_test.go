@@ -0,0 +1,397 @@
+package datastore
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/delphis-inc/delphisbe/graph/model"
+	"github.com/delphis-inc/delphisbe/internal/config"
+	"github.com/jinzhu/gorm"
+	. "github.com/smartystreets/goconvey/convey"
+	"github.com/stretchr/testify/assert"
+)
+
+var postReportColumns = []string{"id", "discussion_id", "post_id", "reporter_user_id", "reason", "note", "status",
+	"action", "resolved_by_user_id", "resolved_at", "created_at"}
+
+func postReportRow(rs *sqlmock.Rows, report model.PostReport) *sqlmock.Rows {
+	return rs.AddRow(report.ID, report.DiscussionID, report.PostID, report.ReporterUserID, report.Reason, report.Note,
+		report.Status, report.Action, report.ResolvedByUserID, report.ResolvedAt, report.CreatedAt)
+}
+
+func TestDelphisDB_PutPostReport(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	note := "Posted the same link ten times"
+	reportObj := model.PostReport{
+		ID:             "report1",
+		DiscussionID:   "discussion1",
+		PostID:         "post1",
+		ReporterUserID: "user1",
+		Reason:         model.PostReportReasonSpam,
+		Note:           &note,
+		Status:         model.PostReportStatusOpen,
+		CreatedAt:      now,
+	}
+
+	Convey("PutPostReport", t, func() {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+
+		assert.Nil(t, err, "Failed setting up sqlmock db")
+
+		gormDB, _ := gorm.Open("postgres", db)
+		mockDatastore := &delphisDB{
+			dbConfig:  config.TablesConfig{},
+			sql:       gormDB,
+			pg:        db,
+			prepStmts: &dbPrepStmts{},
+			dynamo:    nil,
+			encoder:   nil,
+		}
+		defer db.Close()
+
+		Convey("when preparing statements returns an error", func() {
+			mockPreparedStatementsWithError(mock)
+
+			resp, err := mockDatastore.PutPostReport(ctx, reportObj)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when query execution returns an error", func() {
+			mockPreparedStatements(mock)
+			mock.ExpectQuery(putPostReportString).WithArgs(reportObj.ID, reportObj.DiscussionID, reportObj.PostID,
+				reportObj.ReporterUserID, reportObj.Reason, reportObj.Note, reportObj.Status).WillReturnError(fmt.Errorf("error"))
+
+			resp, err := mockDatastore.PutPostReport(ctx, reportObj)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when query execution succeeds", func() {
+			mockPreparedStatements(mock)
+			rs := postReportRow(sqlmock.NewRows(postReportColumns), reportObj)
+			mock.ExpectQuery(putPostReportString).WithArgs(reportObj.ID, reportObj.DiscussionID, reportObj.PostID,
+				reportObj.ReporterUserID, reportObj.Reason, reportObj.Note, reportObj.Status).WillReturnRows(rs)
+
+			resp, err := mockDatastore.PutPostReport(ctx, reportObj)
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldResemble, &reportObj)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+	})
+}
+
+func TestDelphisDB_GetPostReportByID(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	reportObj := model.PostReport{
+		ID:             "report1",
+		DiscussionID:   "discussion1",
+		PostID:         "post1",
+		ReporterUserID: "user1",
+		Reason:         model.PostReportReasonSpam,
+		Status:         model.PostReportStatusOpen,
+		CreatedAt:      now,
+	}
+
+	Convey("GetPostReportByID", t, func() {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+
+		assert.Nil(t, err, "Failed setting up sqlmock db")
+
+		gormDB, _ := gorm.Open("postgres", db)
+		mockDatastore := &delphisDB{
+			dbConfig:  config.TablesConfig{},
+			sql:       gormDB,
+			pg:        db,
+			prepStmts: &dbPrepStmts{},
+			dynamo:    nil,
+			encoder:   nil,
+		}
+		defer db.Close()
+
+		Convey("when preparing statements returns an error", func() {
+			mockPreparedStatementsWithError(mock)
+
+			resp, err := mockDatastore.GetPostReportByID(ctx, reportObj.ID)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when query execution returns an error", func() {
+			mockPreparedStatements(mock)
+			mock.ExpectQuery(getPostReportByIDString).WithArgs(reportObj.ID).WillReturnError(fmt.Errorf("error"))
+
+			resp, err := mockDatastore.GetPostReportByID(ctx, reportObj.ID)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when the report does not exist", func() {
+			mockPreparedStatements(mock)
+			mock.ExpectQuery(getPostReportByIDString).WithArgs(reportObj.ID).WillReturnError(sql.ErrNoRows)
+
+			resp, err := mockDatastore.GetPostReportByID(ctx, reportObj.ID)
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when query execution succeeds", func() {
+			mockPreparedStatements(mock)
+			rs := postReportRow(sqlmock.NewRows(postReportColumns), reportObj)
+			mock.ExpectQuery(getPostReportByIDString).WithArgs(reportObj.ID).WillReturnRows(rs)
+
+			resp, err := mockDatastore.GetPostReportByID(ctx, reportObj.ID)
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldResemble, &reportObj)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+	})
+}
+
+func TestDelphisDB_GetPostReportByPostIDReporterUserID(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	reportObj := model.PostReport{
+		ID:             "report1",
+		DiscussionID:   "discussion1",
+		PostID:         "post1",
+		ReporterUserID: "user1",
+		Reason:         model.PostReportReasonSpam,
+		Status:         model.PostReportStatusOpen,
+		CreatedAt:      now,
+	}
+
+	Convey("GetPostReportByPostIDReporterUserID", t, func() {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+
+		assert.Nil(t, err, "Failed setting up sqlmock db")
+
+		gormDB, _ := gorm.Open("postgres", db)
+		mockDatastore := &delphisDB{
+			dbConfig:  config.TablesConfig{},
+			sql:       gormDB,
+			pg:        db,
+			prepStmts: &dbPrepStmts{},
+			dynamo:    nil,
+			encoder:   nil,
+		}
+		defer db.Close()
+
+		Convey("when preparing statements returns an error", func() {
+			mockPreparedStatementsWithError(mock)
+
+			resp, err := mockDatastore.GetPostReportByPostIDReporterUserID(ctx, reportObj.PostID, reportObj.ReporterUserID)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when the user has not reported the post", func() {
+			mockPreparedStatements(mock)
+			mock.ExpectQuery(getPostReportByPostIDReporterUserIDString).WithArgs(reportObj.PostID, reportObj.ReporterUserID).
+				WillReturnError(sql.ErrNoRows)
+
+			resp, err := mockDatastore.GetPostReportByPostIDReporterUserID(ctx, reportObj.PostID, reportObj.ReporterUserID)
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when query execution succeeds", func() {
+			mockPreparedStatements(mock)
+			rs := postReportRow(sqlmock.NewRows(postReportColumns), reportObj)
+			mock.ExpectQuery(getPostReportByPostIDReporterUserIDString).WithArgs(reportObj.PostID, reportObj.ReporterUserID).
+				WillReturnRows(rs)
+
+			resp, err := mockDatastore.GetPostReportByPostIDReporterUserID(ctx, reportObj.PostID, reportObj.ReporterUserID)
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldResemble, &reportObj)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+	})
+}
+
+func TestDelphisDB_GetPostReportsConnectionByDiscussionID(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	discussionID := "discussion1"
+	status := model.PostReportStatusOpen
+	cursor := now.String()
+	limit := 2
+	reportObj := model.PostReport{
+		ID:             "report1",
+		DiscussionID:   discussionID,
+		PostID:         "post1",
+		ReporterUserID: "user1",
+		Reason:         model.PostReportReasonHarassment,
+		Status:         status,
+		CreatedAt:      now,
+	}
+
+	Convey("GetPostReportsConnectionByDiscussionID", t, func() {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+
+		assert.Nil(t, err, "Failed setting up sqlmock db")
+
+		gormDB, _ := gorm.Open("postgres", db)
+		mockDatastore := &delphisDB{
+			dbConfig:  config.TablesConfig{},
+			sql:       gormDB,
+			pg:        db,
+			prepStmts: &dbPrepStmts{},
+			dynamo:    nil,
+			encoder:   nil,
+		}
+		defer db.Close()
+
+		Convey("when limit less than two is passed in", func() {
+			resp, err := mockDatastore.GetPostReportsConnectionByDiscussionID(ctx, discussionID, status, cursor, 1)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when preparing statements returns an error", func() {
+			mockPreparedStatementsWithError(mock)
+
+			resp, err := mockDatastore.GetPostReportsConnectionByDiscussionID(ctx, discussionID, status, cursor, limit)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when query execution returns an error", func() {
+			mockPreparedStatements(mock)
+			mock.ExpectQuery(getPostReportsByDiscussionIDStatusFromCursorString).WithArgs(discussionID, status, cursor, limit+1).
+				WillReturnError(fmt.Errorf("error"))
+
+			resp, err := mockDatastore.GetPostReportsConnectionByDiscussionID(ctx, discussionID, status, cursor, limit)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when query execution succeeds and returns reports", func() {
+			mockPreparedStatements(mock)
+			rs := sqlmock.NewRows(postReportColumns)
+			for i := 0; i < limit+1; i++ {
+				rs = postReportRow(rs, reportObj)
+			}
+			mock.ExpectQuery(getPostReportsByDiscussionIDStatusFromCursorString).WithArgs(discussionID, status, cursor, limit+1).
+				WillReturnRows(rs)
+
+			resp, err := mockDatastore.GetPostReportsConnectionByDiscussionID(ctx, discussionID, status, cursor, limit)
+
+			reportCursor := now.Format(time.RFC3339Nano)
+			So(err, ShouldBeNil)
+			So(resp, ShouldResemble, &model.PostReportConnection{
+				Edges: []*model.PostReportEdge{
+					{Cursor: reportCursor, Node: &reportObj},
+					{Cursor: reportCursor, Node: &reportObj},
+				},
+				PageInfo: model.PageInfo{
+					StartCursor: &reportCursor,
+					EndCursor:   &reportCursor,
+					HasNextPage: true,
+				},
+			})
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+	})
+}
+
+func TestDelphisDB_ResolvePostReports(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	postID := "post1"
+	resolvedByUserID := "user2"
+	action := model.PostReportActionDeletePost
+	reportObj := model.PostReport{
+		ID:               "report1",
+		DiscussionID:     "discussion1",
+		PostID:           postID,
+		ReporterUserID:   "user1",
+		Reason:           model.PostReportReasonSpam,
+		Status:           model.PostReportStatusActioned,
+		Action:           &action,
+		ResolvedByUserID: &resolvedByUserID,
+		ResolvedAt:       &now,
+		CreatedAt:        now,
+	}
+
+	Convey("ResolvePostReports", t, func() {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+
+		assert.Nil(t, err, "Failed setting up sqlmock db")
+
+		gormDB, _ := gorm.Open("postgres", db)
+		mockDatastore := &delphisDB{
+			dbConfig:  config.TablesConfig{},
+			sql:       gormDB,
+			pg:        db,
+			prepStmts: &dbPrepStmts{},
+			dynamo:    nil,
+			encoder:   nil,
+		}
+		defer db.Close()
+
+		Convey("when preparing statements returns an error", func() {
+			mockPreparedStatementsWithError(mock)
+
+			resp, err := mockDatastore.ResolvePostReports(ctx, postID, model.PostReportStatusActioned, &action, resolvedByUserID)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when query execution returns an error", func() {
+			mockPreparedStatements(mock)
+			mock.ExpectQuery(resolvePostReportsByPostIDString).WithArgs(postID, model.PostReportStatusActioned, &action,
+				resolvedByUserID, model.PostReportStatusOpen).WillReturnError(fmt.Errorf("error"))
+
+			resp, err := mockDatastore.ResolvePostReports(ctx, postID, model.PostReportStatusActioned, &action, resolvedByUserID)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when query execution succeeds", func() {
+			mockPreparedStatements(mock)
+			rs := postReportRow(sqlmock.NewRows(postReportColumns), reportObj)
+			mock.ExpectQuery(resolvePostReportsByPostIDString).WithArgs(postID, model.PostReportStatusActioned, &action,
+				resolvedByUserID, model.PostReportStatusOpen).WillReturnRows(rs)
+
+			resp, err := mockDatastore.ResolvePostReports(ctx, postID, model.PostReportStatusActioned, &action, resolvedByUserID)
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldResemble, []*model.PostReport{&reportObj})
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+	})
+}
@@ -0,0 +1,109 @@
+package datastore
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"html"
+	"strings"
+
+	"github.com/delphis-inc/delphisbe/graph/model"
+	"github.com/sirupsen/logrus"
+)
+
+func (d *delphisDB) SearchPostsByDiscussionID(ctx context.Context, discussionID string, query string, cursor string, limit int) (*model.PostsConnection, error) {
+	if limit < 2 {
+		err := errors.New("Values of 'limit' is illegal")
+		logrus.WithError(err).Error("SearchPostsByDiscussionID::illegal limit parameter")
+		return nil, err
+	}
+
+	logrus.Debug("SearchPostsByDiscussionID::SQL Query")
+	if err := d.initializeStatements(ctx); err != nil {
+		logrus.WithError(err).Error("SearchPostsByDiscussionID::failed to initialize statements")
+		return nil, err
+	}
+
+	rows, err := d.prepStmts.searchPostsByDiscussionIDStmt.QueryContext(
+		ctx,
+		discussionID,
+		query,
+		cursor,
+		limit+1,
+	)
+	if err != nil {
+		logrus.WithError(err).Error("failed to query SearchPostsByDiscussionID")
+		return nil, err
+	}
+
+	return collectPostSearchConnection(rows, cursor, limit)
+}
+
+// Searches the posts of every discussion the user has ACTIVE access to.
+func (d *delphisDB) SearchPostsByUserAccess(ctx context.Context, userID string, query string, cursor string, limit int) (*model.PostsConnection, error) {
+	if limit < 2 {
+		err := errors.New("Values of 'limit' is illegal")
+		logrus.WithError(err).Error("SearchPostsByUserAccess::illegal limit parameter")
+		return nil, err
+	}
+
+	logrus.Debug("SearchPostsByUserAccess::SQL Query")
+	if err := d.initializeStatements(ctx); err != nil {
+		logrus.WithError(err).Error("SearchPostsByUserAccess::failed to initialize statements")
+		return nil, err
+	}
+
+	rows, err := d.prepStmts.searchPostsByUserAccessStmt.QueryContext(
+		ctx,
+		userID,
+		query,
+		cursor,
+		limit+1,
+	)
+	if err != nil {
+		logrus.WithError(err).Error("failed to query SearchPostsByUserAccess")
+		return nil, err
+	}
+
+	return collectPostSearchConnection(rows, cursor, limit)
+}
+
+// Each search row is a post followed by its ts_headline snippet, which is
+// attached to the matching edge of the connection.
+func collectPostSearchConnection(rows *sql.Rows, cursor string, limit int) (*model.PostsConnection, error) {
+	defer rows.Close()
+
+	postArr := make([]*model.Post, 0)
+	highlights := make([]string, 0)
+	for rows.Next() {
+		post := model.Post{}
+		highlight := ""
+		if err := scanPostRow(rows, &post, &highlight); err != nil {
+			logrus.WithError(err).Error("failed to scan post search row")
+			return nil, err
+		}
+		postArr = append(postArr, &post)
+		highlights = append(highlights, highlight)
+	}
+	if err := rows.Err(); err != nil {
+		logrus.WithError(err).Error("failed to iterate post search rows")
+		return nil, err
+	}
+
+	connection := buildPostsConnection(postArr, cursor, limit)
+	for i := range connection.Edges {
+		highlight := escapeSearchHighlight(highlights[i])
+		connection.Edges[i].Highlight = &highlight
+	}
+
+	return connection, nil
+}
+
+// The markers are what ts_headline wraps matches in, see searchPostsByDiscussionIDString.
+var searchHighlightReplacer = strings.NewReplacer("\x02", "<b>", "\x03", "</b>")
+
+// escapeSearchHighlight escapes the snippet, which holds raw post content,
+// and only then turns the match markers into <b></b> tags.
+func escapeSearchHighlight(highlight string) string {
+	return searchHighlightReplacer.Replace(html.EscapeString(highlight))
+}
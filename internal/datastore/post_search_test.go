@@ -0,0 +1,238 @@
+package datastore
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/delphis-inc/delphisbe/graph/model"
+	"github.com/delphis-inc/delphisbe/internal/config"
+	"github.com/jinzhu/gorm"
+	"github.com/lib/pq"
+	. "github.com/smartystreets/goconvey/convey"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_SearchPostsByDiscussionID(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	discussionID := "discussion1"
+	participantID := "participant1"
+	query := "agenda"
+	snippet := "the \x02agenda\x03 for today"
+	highlight := "the <b>agenda</b> for today"
+	cursor := now.String()
+	limit := 2
+	postObject := model.Post{
+		ID:            "post1",
+		CreatedAt:     now,
+		UpdatedAt:     now,
+		DiscussionID:  &discussionID,
+		ParticipantID: &participantID,
+		PostContent: &model.PostContent{
+			ID:      "postContent1",
+			Content: "the agenda for today",
+		},
+		PostType: model.PostTypeStandard,
+	}
+
+	Convey("SearchPostsByDiscussionID", t, func() {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+
+		assert.Nil(t, err, "Failed setting up sqlmock db")
+
+		gormDB, _ := gorm.Open("postgres", db)
+		mockDatastore := &delphisDB{
+			dbConfig:  config.TablesConfig{},
+			sql:       gormDB,
+			pg:        db,
+			prepStmts: &dbPrepStmts{},
+			dynamo:    nil,
+			encoder:   nil,
+		}
+		defer db.Close()
+
+		Convey("when limit less than two is passed in", func() {
+			postConns, err := mockDatastore.SearchPostsByDiscussionID(ctx, discussionID, query, cursor, 1)
+
+			So(err, ShouldNotBeNil)
+			So(postConns, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when preparing statements returns an error", func() {
+			mockPreparedStatementsWithError(mock)
+
+			postConns, err := mockDatastore.SearchPostsByDiscussionID(ctx, discussionID, query, cursor, limit)
+
+			So(err, ShouldNotBeNil)
+			So(postConns, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when query execution returns an error", func() {
+			mockPreparedStatements(mock)
+			mock.ExpectQuery(searchPostsByDiscussionIDString).WithArgs(discussionID, query, cursor, limit+1).WillReturnError(fmt.Errorf("error"))
+
+			postConns, err := mockDatastore.SearchPostsByDiscussionID(ctx, discussionID, query, cursor, limit)
+
+			So(err, ShouldNotBeNil)
+			So(postConns, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when query execution succeeds and returns highlighted posts", func() {
+			mockPreparedStatements(mock)
+			rs := sqlmock.NewRows([]string{"p.id", "p.created_at", "p.updated_at", "p.deleted_at", "p.deleted_reason_code", "p.discussion_id", "p.participant_id",
+				"p.quoted_post_id", "p.media_id", "p.post_type", "p.edit_history", "p.parent_post_id", "p.pinned_at", "pc.id", "pc.content", "pc.mentioned_entities", "ts_headline"}).
+				AddRow(postObject.ID, postObject.CreatedAt, postObject.UpdatedAt, postObject.DeletedAt, postObject.DeletedReasonCode, postObject.DiscussionID,
+					postObject.ParticipantID, postObject.QuotedPostID, postObject.MediaID, postObject.PostType, []byte(postObject.EditHistory.RawMessage), postObject.ParentPostID, postObject.PinnedAt, postObject.PostContent.ID, postObject.PostContent.Content, pq.Array(postObject.PostContent.MentionedEntities), snippet)
+
+			mock.ExpectQuery(searchPostsByDiscussionIDString).WithArgs(discussionID, query, cursor, limit+1).WillReturnRows(rs)
+
+			postConns, err := mockDatastore.SearchPostsByDiscussionID(ctx, discussionID, query, cursor, limit)
+
+			cursor := postObject.CreatedAt.Format(time.RFC3339Nano)
+			verifyPostConns := &model.PostsConnection{
+				Edges: []*model.PostsEdge{
+					{
+						Cursor:    cursor,
+						Node:      &postObject,
+						Highlight: &highlight,
+					},
+				},
+				PageInfo: model.PageInfo{
+					StartCursor: &cursor,
+					EndCursor:   &cursor,
+					HasNextPage: false,
+				},
+			}
+
+			So(err, ShouldBeNil)
+			So(postConns, ShouldResemble, verifyPostConns)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when the matched post content contains markup", func() {
+			mockPreparedStatements(mock)
+			rs := sqlmock.NewRows([]string{"p.id", "p.created_at", "p.updated_at", "p.deleted_at", "p.deleted_reason_code", "p.discussion_id", "p.participant_id",
+				"p.quoted_post_id", "p.media_id", "p.post_type", "p.edit_history", "p.parent_post_id", "p.pinned_at", "pc.id", "pc.content", "pc.mentioned_entities", "ts_headline"}).
+				AddRow(postObject.ID, postObject.CreatedAt, postObject.UpdatedAt, postObject.DeletedAt, postObject.DeletedReasonCode, postObject.DiscussionID,
+					postObject.ParticipantID, postObject.QuotedPostID, postObject.MediaID, postObject.PostType, []byte(postObject.EditHistory.RawMessage), postObject.ParentPostID, postObject.PinnedAt, postObject.PostContent.ID, postObject.PostContent.Content, pq.Array(postObject.PostContent.MentionedEntities),
+					"<script>alert(1)</script> the \x02agenda\x03 <b>for</b> today")
+
+			mock.ExpectQuery(searchPostsByDiscussionIDString).WithArgs(discussionID, query, cursor, limit+1).WillReturnRows(rs)
+
+			postConns, err := mockDatastore.SearchPostsByDiscussionID(ctx, discussionID, query, cursor, limit)
+
+			So(err, ShouldBeNil)
+			So(*postConns.Edges[0].Highlight, ShouldEqual, "&lt;script&gt;alert(1)&lt;/script&gt; the <b>agenda</b> &lt;b&gt;for&lt;/b&gt; today")
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+	})
+}
+
+func Test_SearchPostsByUserAccess(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	discussionID := "discussion1"
+	participantID := "participant1"
+	userID := "user1"
+	query := "agenda"
+	snippet := "the \x02agenda\x03 for today"
+	highlight := "the <b>agenda</b> for today"
+	cursor := now.String()
+	limit := 2
+	postObject := model.Post{
+		ID:            "post1",
+		CreatedAt:     now,
+		UpdatedAt:     now,
+		DiscussionID:  &discussionID,
+		ParticipantID: &participantID,
+		PostContent: &model.PostContent{
+			ID:      "postContent1",
+			Content: "the agenda for today",
+		},
+		PostType: model.PostTypeStandard,
+	}
+
+	Convey("SearchPostsByUserAccess", t, func() {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+
+		assert.Nil(t, err, "Failed setting up sqlmock db")
+
+		gormDB, _ := gorm.Open("postgres", db)
+		mockDatastore := &delphisDB{
+			dbConfig:  config.TablesConfig{},
+			sql:       gormDB,
+			pg:        db,
+			prepStmts: &dbPrepStmts{},
+			dynamo:    nil,
+			encoder:   nil,
+		}
+		defer db.Close()
+
+		Convey("when limit less than two is passed in", func() {
+			postConns, err := mockDatastore.SearchPostsByUserAccess(ctx, userID, query, cursor, 1)
+
+			So(err, ShouldNotBeNil)
+			So(postConns, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when preparing statements returns an error", func() {
+			mockPreparedStatementsWithError(mock)
+
+			postConns, err := mockDatastore.SearchPostsByUserAccess(ctx, userID, query, cursor, limit)
+
+			So(err, ShouldNotBeNil)
+			So(postConns, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when query execution returns an error", func() {
+			mockPreparedStatements(mock)
+			mock.ExpectQuery(searchPostsByUserAccessString).WithArgs(userID, query, cursor, limit+1).WillReturnError(fmt.Errorf("error"))
+
+			postConns, err := mockDatastore.SearchPostsByUserAccess(ctx, userID, query, cursor, limit)
+
+			So(err, ShouldNotBeNil)
+			So(postConns, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when query execution succeeds and returns highlighted posts", func() {
+			mockPreparedStatements(mock)
+			rs := sqlmock.NewRows([]string{"p.id", "p.created_at", "p.updated_at", "p.deleted_at", "p.deleted_reason_code", "p.discussion_id", "p.participant_id",
+				"p.quoted_post_id", "p.media_id", "p.post_type", "p.edit_history", "p.parent_post_id", "p.pinned_at", "pc.id", "pc.content", "pc.mentioned_entities", "ts_headline"}).
+				AddRow(postObject.ID, postObject.CreatedAt, postObject.UpdatedAt, postObject.DeletedAt, postObject.DeletedReasonCode, postObject.DiscussionID,
+					postObject.ParticipantID, postObject.QuotedPostID, postObject.MediaID, postObject.PostType, []byte(postObject.EditHistory.RawMessage), postObject.ParentPostID, postObject.PinnedAt, postObject.PostContent.ID, postObject.PostContent.Content, pq.Array(postObject.PostContent.MentionedEntities), snippet)
+
+			mock.ExpectQuery(searchPostsByUserAccessString).WithArgs(userID, query, cursor, limit+1).WillReturnRows(rs)
+
+			postConns, err := mockDatastore.SearchPostsByUserAccess(ctx, userID, query, cursor, limit)
+
+			cursor := postObject.CreatedAt.Format(time.RFC3339Nano)
+			verifyPostConns := &model.PostsConnection{
+				Edges: []*model.PostsEdge{
+					{
+						Cursor:    cursor,
+						Node:      &postObject,
+						Highlight: &highlight,
+					},
+				},
+				PageInfo: model.PageInfo{
+					StartCursor: &cursor,
+					EndCursor:   &cursor,
+					HasNextPage: false,
+				},
+			}
+
+			So(err, ShouldBeNil)
+			So(postConns, ShouldResemble, verifyPostConns)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+	})
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/delphis-inc/delphisbe/graph/model"
 	"github.com/delphis-inc/delphisbe/internal/config"
 	"github.com/jinzhu/gorm"
+	"github.com/jinzhu/gorm/dialects/postgres"
 	. "github.com/smartystreets/goconvey/convey"
 	"github.com/stretchr/testify/assert"
 )
@@ -69,7 +70,7 @@ func TestDelphisDB_PutPost(t *testing.T) {
 			mock.ExpectBegin()
 			mockPreparedStatements(mock)
 			mock.ExpectPrepare(putPostString)
-			mock.ExpectQuery(putPostString).WithArgs(postObject.ID, postObject.DiscussionID, postObject.ParticipantID, postObject.PostContent.ID, postObject.QuotedPostID, postObject.MediaID, postObject.PostType).WillReturnError(fmt.Errorf("error"))
+			mock.ExpectQuery(putPostString).WithArgs(postObject.ID, postObject.DiscussionID, postObject.ParticipantID, postObject.PostContent.ID, postObject.QuotedPostID, postObject.MediaID, postObject.PostType, postObject.ParentPostID).WillReturnError(fmt.Errorf("error"))
 
 			tx, err := mockDatastore.BeginTx(ctx)
 			resp, err := mockDatastore.PutPost(ctx, tx, postObject)
@@ -80,13 +81,13 @@ func TestDelphisDB_PutPost(t *testing.T) {
 		})
 
 		Convey("when put post succeeds and returns an object", func() {
-			rs := sqlmock.NewRows([]string{"id", "created_at", "updated_at", "discussion_id", "participant_id", "post_content_id", "quoted_post_id", "media_id", "post_type"}).
-				AddRow(postObject.ID, postObject.CreatedAt, postObject.UpdatedAt, postObject.DiscussionID, postObject.ParticipantID, postObject.PostContentID, postObject.QuotedPostID, postObject.MediaID, postObject.PostType)
+			rs := sqlmock.NewRows([]string{"id", "created_at", "updated_at", "discussion_id", "participant_id", "post_content_id", "quoted_post_id", "media_id", "post_type", "parent_post_id"}).
+				AddRow(postObject.ID, postObject.CreatedAt, postObject.UpdatedAt, postObject.DiscussionID, postObject.ParticipantID, postObject.PostContentID, postObject.QuotedPostID, postObject.MediaID, postObject.PostType, postObject.ParentPostID)
 
 			mock.ExpectBegin()
 			mockPreparedStatements(mock)
 			mock.ExpectPrepare(putPostString)
-			mock.ExpectQuery(putPostString).WithArgs(postObject.ID, postObject.DiscussionID, postObject.ParticipantID, postObject.PostContent.ID, postObject.QuotedPostID, postObject.MediaID, postObject.PostType).WillReturnRows(rs)
+			mock.ExpectQuery(putPostString).WithArgs(postObject.ID, postObject.DiscussionID, postObject.ParticipantID, postObject.PostContent.ID, postObject.QuotedPostID, postObject.MediaID, postObject.PostType, postObject.ParentPostID).WillReturnRows(rs)
 
 			tx, err := mockDatastore.BeginTx(ctx)
 			resp, err := mockDatastore.PutPost(ctx, tx, postObject)
@@ -99,6 +100,89 @@ func TestDelphisDB_PutPost(t *testing.T) {
 	})
 }
 
+func TestDelphisDB_EditPost(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	discussionID := "discussion1"
+	participantID := "participant1"
+	postContentID := "postContent2"
+	postObject := model.Post{
+		ID:            "post1",
+		CreatedAt:     now,
+		UpdatedAt:     now,
+		DiscussionID:  &discussionID,
+		ParticipantID: &participantID,
+		PostContentID: &postContentID,
+		PostContent: &model.PostContent{
+			ID:      postContentID,
+			Content: "edited",
+		},
+		PostType:    model.PostTypeStandard,
+		EditHistory: postgres.Jsonb{RawMessage: []byte(`[{"value":"test","createdAt":"2020-01-01T00:00:00Z"}]`)},
+	}
+
+	Convey("EditPost", t, func() {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+
+		assert.Nil(t, err, "Failed setting up sqlmock db")
+
+		gormDB, _ := gorm.Open("postgres", db)
+		mockDatastore := &delphisDB{
+			dbConfig:  config.TablesConfig{},
+			sql:       gormDB,
+			pg:        db,
+			prepStmts: &dbPrepStmts{},
+			dynamo:    nil,
+			encoder:   nil,
+		}
+		defer db.Close()
+
+		Convey("when preparing statements returns an error", func() {
+			mock.ExpectBegin()
+			mockPreparedStatementsWithError(mock)
+
+			tx, err := mockDatastore.BeginTx(ctx)
+			resp, err := mockDatastore.EditPost(ctx, tx, postObject)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when query execution returns an error", func() {
+			mock.ExpectBegin()
+			mockPreparedStatements(mock)
+			mock.ExpectPrepare(editPostString)
+			mock.ExpectQuery(editPostString).WithArgs(postObject.ID, postObject.PostContent.ID, postObject.EditHistory).WillReturnError(fmt.Errorf("error"))
+
+			tx, err := mockDatastore.BeginTx(ctx)
+			resp, err := mockDatastore.EditPost(ctx, tx, postObject)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when edit post succeeds and returns an object", func() {
+			rs := sqlmock.NewRows([]string{"id", "created_at", "updated_at", "discussion_id", "participant_id", "post_content_id", "quoted_post_id", "media_id", "post_type", "edit_history"}).
+				AddRow(postObject.ID, postObject.CreatedAt, postObject.UpdatedAt, postObject.DiscussionID, postObject.ParticipantID, postObject.PostContentID, postObject.QuotedPostID, postObject.MediaID, postObject.PostType, []byte(postObject.EditHistory.RawMessage))
+
+			mock.ExpectBegin()
+			mockPreparedStatements(mock)
+			mock.ExpectPrepare(editPostString)
+			mock.ExpectQuery(editPostString).WithArgs(postObject.ID, postObject.PostContent.ID, postObject.EditHistory).WillReturnRows(rs)
+
+			tx, err := mockDatastore.BeginTx(ctx)
+			resp, err := mockDatastore.EditPost(ctx, tx, postObject)
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldNotBeNil)
+			So(resp, ShouldResemble, &postObject)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+	})
+}
+
 func TestDelphisDB_GetPostsByDiscussionIDIter(t *testing.T) {
 	ctx := context.Background()
 	now := time.Now()
@@ -161,11 +245,11 @@ func TestDelphisDB_GetPostsByDiscussionIDIter(t *testing.T) {
 		Convey("when query execution succeeds and returns posts", func() {
 			mockPreparedStatements(mock)
 			rs := sqlmock.NewRows([]string{"p.id", "p.created_at", "p.updated_at", "p.deleted_at", "p.deleted_reason_code", "p.discussion_id", "p.participant_id",
-				"p.quoted_post_id", "p.media_id", "p.post_type", "pc.id", "pc.content", "pc.mentioned_entities"}).
+				"p.quoted_post_id", "p.media_id", "p.post_type", "p.edit_history", "p.parent_post_id", "p.pinned_at", "pc.id", "pc.content", "pc.mentioned_entities"}).
 				AddRow(postObject.ID, postObject.CreatedAt, postObject.UpdatedAt, postObject.DeletedAt, postObject.DeletedReasonCode, postObject.DiscussionID,
-					postObject.ParticipantID, postObject.QuotedPostID, postObject.MediaID, postObject.PostType, postObject.PostContent.ID, postObject.PostContent.Content, pq.Array(postObject.PostContent.MentionedEntities)).
+					postObject.ParticipantID, postObject.QuotedPostID, postObject.MediaID, postObject.PostType, []byte(postObject.EditHistory.RawMessage), postObject.ParentPostID, postObject.PinnedAt, postObject.PostContent.ID, postObject.PostContent.Content, pq.Array(postObject.PostContent.MentionedEntities)).
 				AddRow(postObject.ID, postObject.CreatedAt, postObject.UpdatedAt, postObject.DeletedAt, postObject.DeletedReasonCode, postObject.DiscussionID,
-					postObject.ParticipantID, postObject.QuotedPostID, postObject.MediaID, postObject.PostType, postObject.PostContent.ID, postObject.PostContent.Content, pq.Array(postObject.PostContent.MentionedEntities))
+					postObject.ParticipantID, postObject.QuotedPostID, postObject.MediaID, postObject.PostType, []byte(postObject.EditHistory.RawMessage), postObject.ParentPostID, postObject.PinnedAt, postObject.PostContent.ID, postObject.PostContent.Content, pq.Array(postObject.PostContent.MentionedEntities))
 
 			mock.ExpectQuery(getPostsByDiscussionIDString).WithArgs(discussionID).WillReturnRows(rs)
 
@@ -221,7 +305,7 @@ func TestDelphisDB_GetPostsByDiscussionIDFromCursorIter(t *testing.T) {
 		Convey("when preparing statements returns an error", func() {
 			mockPreparedStatementsWithError(mock)
 
-			iter := mockDatastore.GetPostsByDiscussionIDFromCursorIter(ctx, discussionID, cursor, limit)
+			iter := mockDatastore.GetPostsByDiscussionIDFromCursorIter(ctx, discussionID, cursor, limit, false)
 
 			So(iter.Next(&emptyPost), ShouldBeFalse)
 			So(iter.Close(), ShouldNotBeNil)
@@ -230,9 +314,9 @@ func TestDelphisDB_GetPostsByDiscussionIDFromCursorIter(t *testing.T) {
 
 		Convey("when query execution returns an error", func() {
 			mockPreparedStatements(mock)
-			mock.ExpectQuery(getPostsByDiscussionIDFromCursorString).WithArgs(discussionID, cursor, limit).WillReturnError(fmt.Errorf("error"))
+			mock.ExpectQuery(getPostsByDiscussionIDFromCursorString).WithArgs(discussionID, cursor, limit, false).WillReturnError(fmt.Errorf("error"))
 
-			iter := mockDatastore.GetPostsByDiscussionIDFromCursorIter(ctx, discussionID, cursor, limit)
+			iter := mockDatastore.GetPostsByDiscussionIDFromCursorIter(ctx, discussionID, cursor, limit, false)
 
 			So(iter.Next(&emptyPost), ShouldBeFalse)
 			So(iter.Close(), ShouldNotBeNil)
@@ -242,45 +326,455 @@ func TestDelphisDB_GetPostsByDiscussionIDFromCursorIter(t *testing.T) {
 		Convey("when query execution succeeds and returns posts", func() {
 			mockPreparedStatements(mock)
 			rs := sqlmock.NewRows([]string{"p.id", "p.created_at", "p.updated_at", "p.deleted_at", "p.deleted_reason_code", "p.discussion_id", "p.participant_id",
-				"p.quoted_post_id", "p.media_id", "p.post_type", "pc.id", "pc.content", "pc.mentioned_entities"}).
+				"p.quoted_post_id", "p.media_id", "p.post_type", "p.edit_history", "p.parent_post_id", "p.pinned_at", "pc.id", "pc.content", "pc.mentioned_entities"}).
+				AddRow(postObject.ID, postObject.CreatedAt, postObject.UpdatedAt, postObject.DeletedAt, postObject.DeletedReasonCode, postObject.DiscussionID,
+					postObject.ParticipantID, postObject.QuotedPostID, postObject.MediaID, postObject.PostType, []byte(postObject.EditHistory.RawMessage), postObject.ParentPostID, postObject.PinnedAt, postObject.PostContent.ID, postObject.PostContent.Content, pq.Array(postObject.PostContent.MentionedEntities)).
+				AddRow(postObject.ID, postObject.CreatedAt, postObject.UpdatedAt, postObject.DeletedAt, postObject.DeletedReasonCode, postObject.DiscussionID,
+					postObject.ParticipantID, postObject.QuotedPostID, postObject.MediaID, postObject.PostType, []byte(postObject.EditHistory.RawMessage), postObject.ParentPostID, postObject.PinnedAt, postObject.PostContent.ID, postObject.PostContent.Content, pq.Array(postObject.PostContent.MentionedEntities))
+
+			mock.ExpectQuery(getPostsByDiscussionIDFromCursorString).WithArgs(discussionID, cursor, limit, false).WillReturnRows(rs)
+
+			iter := mockDatastore.GetPostsByDiscussionIDFromCursorIter(ctx, discussionID, cursor, limit, false)
+
+			So(iter.Next(&emptyPost), ShouldBeTrue)
+			So(iter.Close(), ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+	})
+}
+
+func Test_GetPostsConnectionByDiscussionID(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	discussionID := "discussion1"
+	participantID := "participant1"
+	cursor := now.String()
+	limit := 2
+	postID := "post1"
+	postObject := model.Post{
+		ID:            "post1",
+		CreatedAt:     now,
+		UpdatedAt:     now,
+		DiscussionID:  &discussionID,
+		ParticipantID: &participantID,
+		PostContent: &model.PostContent{
+			ID:      postID,
+			Content: "test",
+		},
+		PostType: model.PostTypeStandard,
+	}
+
+	Convey("GetPostsByDiscussionIDFromCursorIter", t, func() {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+
+		assert.Nil(t, err, "Failed setting up sqlmock db")
+
+		gormDB, _ := gorm.Open("postgres", db)
+		mockDatastore := &delphisDB{
+			dbConfig:  config.TablesConfig{},
+			sql:       gormDB,
+			pg:        db,
+			prepStmts: &dbPrepStmts{},
+			dynamo:    nil,
+			encoder:   nil,
+		}
+		defer db.Close()
+
+		Convey("when limit less than two is passed in", func() {
+			postConns, err := mockDatastore.GetPostsConnectionByDiscussionID(ctx, discussionID, cursor, 1, false)
+
+			So(err, ShouldNotBeNil)
+			So(postConns, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when preparing statements returns an error", func() {
+			mockPreparedStatementsWithError(mock)
+
+			postConns, err := mockDatastore.GetPostsConnectionByDiscussionID(ctx, discussionID, cursor, limit, false)
+
+			So(err, ShouldNotBeNil)
+			So(postConns, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when postIterCollect returns an error", func() {
+			mockPreparedStatements(mock)
+
+			mock.ExpectQuery(getPostsByDiscussionIDFromCursorString).WithArgs(discussionID, cursor, limit+1, false).WillReturnError(fmt.Errorf("error"))
+
+			postConns, err := mockDatastore.GetPostsConnectionByDiscussionID(ctx, discussionID, cursor, limit, false)
+
+			So(err, ShouldNotBeNil)
+			So(postConns, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when checking for newer posts returns an error", func() {
+			mockPreparedStatements(mock)
+			rs := sqlmock.NewRows([]string{"p.id", "p.created_at", "p.updated_at", "p.deleted_at", "p.deleted_reason_code", "p.discussion_id", "p.participant_id",
+				"p.quoted_post_id", "p.media_id", "p.post_type", "p.edit_history", "p.parent_post_id", "p.pinned_at", "pc.id", "pc.content", "pc.mentioned_entities"})
+
+			mock.ExpectQuery(getPostsByDiscussionIDFromCursorString).WithArgs(discussionID, cursor, limit+1, false).WillReturnRows(rs)
+			mock.ExpectQuery(hasNewerPostsByDiscussionIDString).WithArgs(discussionID, cursor, false).WillReturnError(fmt.Errorf("error"))
+
+			postConns, err := mockDatastore.GetPostsConnectionByDiscussionID(ctx, discussionID, cursor, limit, false)
+
+			So(err, ShouldNotBeNil)
+			So(postConns, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("whenthere are no records for the query", func() {
+			mockPreparedStatements(mock)
+			rs := sqlmock.NewRows([]string{"p.id", "p.created_at", "p.updated_at", "p.deleted_at", "p.deleted_reason_code", "p.discussion_id", "p.participant_id",
+				"p.quoted_post_id", "p.media_id", "p.post_type", "p.edit_history", "p.parent_post_id", "p.pinned_at", "pc.id", "pc.content", "pc.mentioned_entities"})
+
+			mock.ExpectQuery(getPostsByDiscussionIDFromCursorString).WithArgs(discussionID, cursor, limit+1, false).WillReturnRows(rs)
+			mock.ExpectQuery(hasNewerPostsByDiscussionIDString).WithArgs(discussionID, cursor, false).WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+
+			postConns, err := mockDatastore.GetPostsConnectionByDiscussionID(ctx, discussionID, cursor, limit, false)
+
+			verifyPostConns := &model.PostsConnection{
+				Edges: []*model.PostsEdge{},
+				PageInfo: model.PageInfo{
+					StartCursor: &cursor,
+					EndCursor:   &cursor,
+					HasNextPage: false,
+				},
+			}
+
+			So(err, ShouldBeNil)
+			So(postConns, ShouldNotBeNil)
+			So(postConns, ShouldResemble, verifyPostConns)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when query execution succeeds and returns postConnections", func() {
+			mockPreparedStatements(mock)
+			rs := sqlmock.NewRows([]string{"p.id", "p.created_at", "p.updated_at", "p.deleted_at", "p.deleted_reason_code", "p.discussion_id", "p.participant_id",
+				"p.quoted_post_id", "p.media_id", "p.post_type", "p.edit_history", "p.parent_post_id", "p.pinned_at", "pc.id", "pc.content", "pc.mentioned_entities"}).
+				AddRow(postObject.ID, postObject.CreatedAt, postObject.UpdatedAt, postObject.DeletedAt, postObject.DeletedReasonCode, postObject.DiscussionID,
+					postObject.ParticipantID, postObject.QuotedPostID, postObject.MediaID, postObject.PostType, []byte(postObject.EditHistory.RawMessage), postObject.ParentPostID, postObject.PinnedAt, postObject.PostContent.ID, postObject.PostContent.Content, pq.Array(postObject.PostContent.MentionedEntities)).
 				AddRow(postObject.ID, postObject.CreatedAt, postObject.UpdatedAt, postObject.DeletedAt, postObject.DeletedReasonCode, postObject.DiscussionID,
-					postObject.ParticipantID, postObject.QuotedPostID, postObject.MediaID, postObject.PostType, postObject.PostContent.ID, postObject.PostContent.Content, pq.Array(postObject.PostContent.MentionedEntities)).
+					postObject.ParticipantID, postObject.QuotedPostID, postObject.MediaID, postObject.PostType, []byte(postObject.EditHistory.RawMessage), postObject.ParentPostID, postObject.PinnedAt, postObject.PostContent.ID, postObject.PostContent.Content, pq.Array(postObject.PostContent.MentionedEntities)).
 				AddRow(postObject.ID, postObject.CreatedAt, postObject.UpdatedAt, postObject.DeletedAt, postObject.DeletedReasonCode, postObject.DiscussionID,
-					postObject.ParticipantID, postObject.QuotedPostID, postObject.MediaID, postObject.PostType, postObject.PostContent.ID, postObject.PostContent.Content, pq.Array(postObject.PostContent.MentionedEntities))
+					postObject.ParticipantID, postObject.QuotedPostID, postObject.MediaID, postObject.PostType, []byte(postObject.EditHistory.RawMessage), postObject.ParentPostID, postObject.PinnedAt, postObject.PostContent.ID, postObject.PostContent.Content, pq.Array(postObject.PostContent.MentionedEntities))
 
-			mock.ExpectQuery(getPostsByDiscussionIDFromCursorString).WithArgs(discussionID, cursor, limit).WillReturnRows(rs)
+			mock.ExpectQuery(getPostsByDiscussionIDFromCursorString).WithArgs(discussionID, cursor, limit+1, false).WillReturnRows(rs)
+			mock.ExpectQuery(hasNewerPostsByDiscussionIDString).WithArgs(discussionID, cursor, false).WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
 
-			iter := mockDatastore.GetPostsByDiscussionIDFromCursorIter(ctx, discussionID, cursor, limit)
+			postConns, err := mockDatastore.GetPostsConnectionByDiscussionID(ctx, discussionID, cursor, limit, false)
+
+			cursor := postObject.CreatedAt.Format(time.RFC3339Nano)
+			verifyPostConns := &model.PostsConnection{
+				Edges: []*model.PostsEdge{
+					{
+						Cursor: postObject.CreatedAt.Format(time.RFC3339Nano),
+						Node:   &postObject,
+					},
+					{
+						Cursor: postObject.CreatedAt.Format(time.RFC3339Nano),
+						Node:   &postObject,
+					},
+				},
+				PageInfo: model.PageInfo{
+					StartCursor:     &cursor,
+					EndCursor:       &cursor,
+					HasNextPage:     true,
+					HasPreviousPage: true,
+				},
+			}
+
+			So(err, ShouldBeNil)
+			So(postConns, ShouldNotBeNil)
+			So(postConns, ShouldResemble, verifyPostConns)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+	})
+}
+
+func Test_GetPostsConnectionByDiscussionIDBeforeCursor(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	discussionID := "discussion1"
+	participantID := "participant1"
+	cursor := now.String()
+	limit := 2
+	newPost := func(id string, createdAt time.Time) model.Post {
+		return model.Post{
+			ID:            id,
+			CreatedAt:     createdAt,
+			UpdatedAt:     createdAt,
+			DiscussionID:  &discussionID,
+			ParticipantID: &participantID,
+			PostContent: &model.PostContent{
+				ID:      "content_" + id,
+				Content: "test",
+			},
+			PostType: model.PostTypeStandard,
+		}
+	}
+	postObjects := []model.Post{
+		newPost("post1", now.Add(time.Minute)),
+		newPost("post2", now.Add(2*time.Minute)),
+		newPost("post3", now.Add(3*time.Minute)),
+	}
+
+	Convey("GetPostsConnectionByDiscussionIDBeforeCursor", t, func() {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+
+		assert.Nil(t, err, "Failed setting up sqlmock db")
+
+		gormDB, _ := gorm.Open("postgres", db)
+		mockDatastore := &delphisDB{
+			dbConfig:  config.TablesConfig{},
+			sql:       gormDB,
+			pg:        db,
+			prepStmts: &dbPrepStmts{},
+			dynamo:    nil,
+			encoder:   nil,
+		}
+		defer db.Close()
+
+		rs := sqlmock.NewRows([]string{"p.id", "p.created_at", "p.updated_at", "p.deleted_at", "p.deleted_reason_code", "p.discussion_id", "p.participant_id",
+			"p.quoted_post_id", "p.media_id", "p.post_type", "p.edit_history", "p.parent_post_id", "p.pinned_at", "pc.id", "pc.content", "pc.mentioned_entities"})
+		for _, postObject := range postObjects {
+			rs = rs.AddRow(postObject.ID, postObject.CreatedAt, postObject.UpdatedAt, postObject.DeletedAt, postObject.DeletedReasonCode, postObject.DiscussionID,
+				postObject.ParticipantID, postObject.QuotedPostID, postObject.MediaID, postObject.PostType, []byte(postObject.EditHistory.RawMessage), postObject.ParentPostID, postObject.PinnedAt, postObject.PostContent.ID, postObject.PostContent.Content, pq.Array(postObject.PostContent.MentionedEntities))
+		}
+
+		Convey("when limit less than two is passed in", func() {
+			postConns, err := mockDatastore.GetPostsConnectionByDiscussionIDBeforeCursor(ctx, discussionID, cursor, 1, false)
+
+			So(err, ShouldNotBeNil)
+			So(postConns, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when preparing statements returns an error", func() {
+			mockPreparedStatementsWithError(mock)
+
+			postConns, err := mockDatastore.GetPostsConnectionByDiscussionIDBeforeCursor(ctx, discussionID, cursor, limit, false)
+
+			So(err, ShouldNotBeNil)
+			So(postConns, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when postIterCollect returns an error", func() {
+			mockPreparedStatements(mock)
+			mock.ExpectQuery(getPostsByDiscussionIDBeforeCursorString).WithArgs(discussionID, cursor, limit+1, false).WillReturnError(fmt.Errorf("error"))
+
+			postConns, err := mockDatastore.GetPostsConnectionByDiscussionIDBeforeCursor(ctx, discussionID, cursor, limit, false)
+
+			So(err, ShouldNotBeNil)
+			So(postConns, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when checking for older posts returns an error", func() {
+			mockPreparedStatements(mock)
+			mock.ExpectQuery(getPostsByDiscussionIDBeforeCursorString).WithArgs(discussionID, cursor, limit+1, false).WillReturnRows(rs)
+			mock.ExpectQuery(hasOlderPostsByDiscussionIDString).WithArgs(discussionID, cursor, false).WillReturnError(fmt.Errorf("error"))
+
+			postConns, err := mockDatastore.GetPostsConnectionByDiscussionIDBeforeCursor(ctx, discussionID, cursor, limit, false)
+
+			So(err, ShouldNotBeNil)
+			So(postConns, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when query execution succeeds and returns the closest newer posts newest first", func() {
+			mockPreparedStatements(mock)
+			mock.ExpectQuery(getPostsByDiscussionIDBeforeCursorString).WithArgs(discussionID, cursor, limit+1, false).WillReturnRows(rs)
+			mock.ExpectQuery(hasOlderPostsByDiscussionIDString).WithArgs(discussionID, cursor, false).WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+
+			postConns, err := mockDatastore.GetPostsConnectionByDiscussionIDBeforeCursor(ctx, discussionID, cursor, limit, false)
+
+			startCursor := postObjects[1].CreatedAt.Format(time.RFC3339Nano)
+			endCursor := postObjects[0].CreatedAt.Format(time.RFC3339Nano)
+			verifyPostConns := &model.PostsConnection{
+				Edges: []*model.PostsEdge{
+					{
+						Cursor: startCursor,
+						Node:   &postObjects[1],
+					},
+					{
+						Cursor: endCursor,
+						Node:   &postObjects[0],
+					},
+				},
+				PageInfo: model.PageInfo{
+					StartCursor:     &startCursor,
+					EndCursor:       &endCursor,
+					HasNextPage:     true,
+					HasPreviousPage: true,
+				},
+			}
+
+			So(err, ShouldBeNil)
+			So(postConns, ShouldResemble, verifyPostConns)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+	})
+}
+
+func Test_GetPostsConnectionAroundPost(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	discussionID := "discussion1"
+	participantID := "participant1"
+	newPost := func(id string, createdAt time.Time) model.Post {
+		return model.Post{
+			ID:            id,
+			CreatedAt:     createdAt,
+			UpdatedAt:     createdAt,
+			DiscussionID:  &discussionID,
+			ParticipantID: &participantID,
+			PostContent: &model.PostContent{
+				ID:      "content_" + id,
+				Content: "test",
+			},
+			PostType: model.PostTypeStandard,
+		}
+	}
+	anchorPost := newPost("anchor", now)
+	anchorCursor := now.Format(time.RFC3339Nano)
+	newerPost := newPost("newer", now.Add(time.Minute))
+	olderPosts := []model.Post{
+		newPost("older1", now.Add(-time.Minute)),
+		newPost("older2", now.Add(-2*time.Minute)),
+	}
+	newerLimit := 1
+	olderLimit := 1
+
+	Convey("GetPostsConnectionAroundPost", t, func() {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+
+		assert.Nil(t, err, "Failed setting up sqlmock db")
+
+		gormDB, _ := gorm.Open("postgres", db)
+		mockDatastore := &delphisDB{
+			dbConfig:  config.TablesConfig{},
+			sql:       gormDB,
+			pg:        db,
+			prepStmts: &dbPrepStmts{},
+			dynamo:    nil,
+			encoder:   nil,
+		}
+		defer db.Close()
+
+		columns := []string{"p.id", "p.created_at", "p.updated_at", "p.deleted_at", "p.deleted_reason_code", "p.discussion_id", "p.participant_id",
+			"p.quoted_post_id", "p.media_id", "p.post_type", "p.edit_history", "p.parent_post_id", "p.pinned_at", "pc.id", "pc.content", "pc.mentioned_entities"}
+		addRow := func(rs *sqlmock.Rows, postObject model.Post) *sqlmock.Rows {
+			return rs.AddRow(postObject.ID, postObject.CreatedAt, postObject.UpdatedAt, postObject.DeletedAt, postObject.DeletedReasonCode, postObject.DiscussionID,
+				postObject.ParticipantID, postObject.QuotedPostID, postObject.MediaID, postObject.PostType, []byte(postObject.EditHistory.RawMessage), postObject.ParentPostID, postObject.PinnedAt, postObject.PostContent.ID, postObject.PostContent.Content, pq.Array(postObject.PostContent.MentionedEntities))
+		}
+
+		Convey("when a limit less than one is passed in", func() {
+			postConns, err := mockDatastore.GetPostsConnectionAroundPost(ctx, anchorPost, 0, olderLimit, false)
+
+			So(err, ShouldNotBeNil)
+			So(postConns, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when preparing statements returns an error", func() {
+			mockPreparedStatementsWithError(mock)
+
+			postConns, err := mockDatastore.GetPostsConnectionAroundPost(ctx, anchorPost, newerLimit, olderLimit, false)
+
+			So(err, ShouldNotBeNil)
+			So(postConns, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when collecting newer posts returns an error", func() {
+			mockPreparedStatements(mock)
+			mock.ExpectQuery(getPostsByDiscussionIDBeforeCursorString).WithArgs(discussionID, anchorCursor, newerLimit+1, false).WillReturnError(fmt.Errorf("error"))
+
+			postConns, err := mockDatastore.GetPostsConnectionAroundPost(ctx, anchorPost, newerLimit, olderLimit, false)
+
+			So(err, ShouldNotBeNil)
+			So(postConns, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when collecting older posts returns an error", func() {
+			mockPreparedStatements(mock)
+			mock.ExpectQuery(getPostsByDiscussionIDBeforeCursorString).WithArgs(discussionID, anchorCursor, newerLimit+1, false).WillReturnRows(addRow(sqlmock.NewRows(columns), newerPost))
+			mock.ExpectQuery(getPostsByDiscussionIDFromCursorString).WithArgs(discussionID, anchorCursor, olderLimit+1, false).WillReturnError(fmt.Errorf("error"))
+
+			postConns, err := mockDatastore.GetPostsConnectionAroundPost(ctx, anchorPost, newerLimit, olderLimit, false)
+
+			So(err, ShouldNotBeNil)
+			So(postConns, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when the page is centered on the anchor post", func() {
+			mockPreparedStatements(mock)
+			mock.ExpectQuery(getPostsByDiscussionIDBeforeCursorString).WithArgs(discussionID, anchorCursor, newerLimit+1, false).WillReturnRows(addRow(sqlmock.NewRows(columns), newerPost))
+			mock.ExpectQuery(getPostsByDiscussionIDFromCursorString).WithArgs(discussionID, anchorCursor, olderLimit+1, false).WillReturnRows(addRow(addRow(sqlmock.NewRows(columns), olderPosts[0]), olderPosts[1]))
+
+			postConns, err := mockDatastore.GetPostsConnectionAroundPost(ctx, anchorPost, newerLimit, olderLimit, false)
+
+			startCursor := newerPost.CreatedAt.Format(time.RFC3339Nano)
+			endCursor := olderPosts[0].CreatedAt.Format(time.RFC3339Nano)
+			verifyPostConns := &model.PostsConnection{
+				Edges: []*model.PostsEdge{
+					{
+						Cursor: startCursor,
+						Node:   &newerPost,
+					},
+					{
+						Cursor: anchorCursor,
+						Node:   &anchorPost,
+					},
+					{
+						Cursor: endCursor,
+						Node:   &olderPosts[0],
+					},
+				},
+				PageInfo: model.PageInfo{
+					StartCursor:     &startCursor,
+					EndCursor:       &endCursor,
+					HasNextPage:     true,
+					HasPreviousPage: false,
+				},
+			}
 
-			So(iter.Next(&emptyPost), ShouldBeTrue)
-			So(iter.Close(), ShouldBeNil)
+			So(err, ShouldBeNil)
+			So(postConns, ShouldResemble, verifyPostConns)
 			So(mock.ExpectationsWereMet(), ShouldBeNil)
 		})
 	})
 }
 
-func Test_GetPostsConnectionByDiscussionID(t *testing.T) {
+func Test_GetPostRepliesConnection(t *testing.T) {
 	ctx := context.Background()
 	now := time.Now()
 	discussionID := "discussion1"
 	participantID := "participant1"
+	parentPostID := "post0"
 	cursor := now.String()
 	limit := 2
-	postID := "post1"
 	postObject := model.Post{
 		ID:            "post1",
 		CreatedAt:     now,
 		UpdatedAt:     now,
 		DiscussionID:  &discussionID,
 		ParticipantID: &participantID,
+		ParentPostID:  &parentPostID,
 		PostContent: &model.PostContent{
-			ID:      postID,
+			ID:      "postContent1",
 			Content: "test",
 		},
 		PostType: model.PostTypeStandard,
 	}
 
-	Convey("GetPostsByDiscussionIDFromCursorIter", t, func() {
+	Convey("GetPostRepliesConnection", t, func() {
 		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
 
 		assert.Nil(t, err, "Failed setting up sqlmock db")
@@ -297,7 +791,7 @@ func Test_GetPostsConnectionByDiscussionID(t *testing.T) {
 		defer db.Close()
 
 		Convey("when limit less than two is passed in", func() {
-			postConns, err := mockDatastore.GetPostsConnectionByDiscussionID(ctx, discussionID, cursor, 1)
+			postConns, err := mockDatastore.GetPostRepliesConnection(ctx, parentPostID, cursor, 1)
 
 			So(err, ShouldNotBeNil)
 			So(postConns, ShouldBeNil)
@@ -307,7 +801,7 @@ func Test_GetPostsConnectionByDiscussionID(t *testing.T) {
 		Convey("when preparing statements returns an error", func() {
 			mockPreparedStatementsWithError(mock)
 
-			postConns, err := mockDatastore.GetPostsConnectionByDiscussionID(ctx, discussionID, cursor, limit)
+			postConns, err := mockDatastore.GetPostRepliesConnection(ctx, parentPostID, cursor, limit)
 
 			So(err, ShouldNotBeNil)
 			So(postConns, ShouldBeNil)
@@ -316,27 +810,34 @@ func Test_GetPostsConnectionByDiscussionID(t *testing.T) {
 
 		Convey("when postIterCollect returns an error", func() {
 			mockPreparedStatements(mock)
+			mock.ExpectQuery(getPostRepliesFromCursorString).WithArgs(parentPostID, cursor, limit+1).WillReturnError(fmt.Errorf("error"))
 
-			mock.ExpectQuery(getPostsByDiscussionIDFromCursorString).WithArgs(discussionID, cursor, limit+1).WillReturnError(fmt.Errorf("error"))
-
-			postConns, err := mockDatastore.GetPostsConnectionByDiscussionID(ctx, discussionID, cursor, limit)
+			postConns, err := mockDatastore.GetPostRepliesConnection(ctx, parentPostID, cursor, limit)
 
 			So(err, ShouldNotBeNil)
 			So(postConns, ShouldBeNil)
 			So(mock.ExpectationsWereMet(), ShouldBeNil)
 		})
 
-		Convey("whenthere are no records for the query", func() {
+		Convey("when query execution succeeds and returns the replies", func() {
 			mockPreparedStatements(mock)
 			rs := sqlmock.NewRows([]string{"p.id", "p.created_at", "p.updated_at", "p.deleted_at", "p.deleted_reason_code", "p.discussion_id", "p.participant_id",
-				"p.quoted_post_id", "p.media_id", "p.post_type", "pc.id", "pc.content", "pc.mentioned_entities"})
+				"p.quoted_post_id", "p.media_id", "p.post_type", "p.edit_history", "p.parent_post_id", "p.pinned_at", "pc.id", "pc.content", "pc.mentioned_entities"}).
+				AddRow(postObject.ID, postObject.CreatedAt, postObject.UpdatedAt, postObject.DeletedAt, postObject.DeletedReasonCode, postObject.DiscussionID,
+					postObject.ParticipantID, postObject.QuotedPostID, postObject.MediaID, postObject.PostType, []byte(postObject.EditHistory.RawMessage), postObject.ParentPostID, postObject.PinnedAt, postObject.PostContent.ID, postObject.PostContent.Content, pq.Array(postObject.PostContent.MentionedEntities))
 
-			mock.ExpectQuery(getPostsByDiscussionIDFromCursorString).WithArgs(discussionID, cursor, limit+1).WillReturnRows(rs)
+			mock.ExpectQuery(getPostRepliesFromCursorString).WithArgs(parentPostID, cursor, limit+1).WillReturnRows(rs)
 
-			postConns, err := mockDatastore.GetPostsConnectionByDiscussionID(ctx, discussionID, cursor, limit)
+			postConns, err := mockDatastore.GetPostRepliesConnection(ctx, parentPostID, cursor, limit)
 
+			cursor := postObject.CreatedAt.Format(time.RFC3339Nano)
 			verifyPostConns := &model.PostsConnection{
-				Edges: []*model.PostsEdge{},
+				Edges: []*model.PostsEdge{
+					{
+						Cursor: cursor,
+						Node:   &postObject,
+					},
+				},
 				PageInfo: model.PageInfo{
 					StartCursor: &cursor,
 					EndCursor:   &cursor,
@@ -345,48 +846,63 @@ func Test_GetPostsConnectionByDiscussionID(t *testing.T) {
 			}
 
 			So(err, ShouldBeNil)
-			So(postConns, ShouldNotBeNil)
 			So(postConns, ShouldResemble, verifyPostConns)
 			So(mock.ExpectationsWereMet(), ShouldBeNil)
 		})
+	})
+}
 
-		Convey("when query execution succeeds and returns postConnections", func() {
+func TestDelphisDB_GetPostReplyCount(t *testing.T) {
+	ctx := context.Background()
+	parentPostID := "post0"
+
+	Convey("GetPostReplyCount", t, func() {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+
+		assert.Nil(t, err, "Failed setting up sqlmock db")
+
+		gormDB, _ := gorm.Open("postgres", db)
+		mockDatastore := &delphisDB{
+			dbConfig:  config.TablesConfig{},
+			sql:       gormDB,
+			pg:        db,
+			prepStmts: &dbPrepStmts{},
+			dynamo:    nil,
+			encoder:   nil,
+		}
+		defer db.Close()
+
+		Convey("when preparing statements returns an error", func() {
+			mockPreparedStatementsWithError(mock)
+
+			resp, err := mockDatastore.GetPostReplyCount(ctx, parentPostID)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldEqual, 0)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when query execution returns an error", func() {
 			mockPreparedStatements(mock)
-			rs := sqlmock.NewRows([]string{"p.id", "p.created_at", "p.updated_at", "p.deleted_at", "p.deleted_reason_code", "p.discussion_id", "p.participant_id",
-				"p.quoted_post_id", "p.media_id", "p.post_type", "pc.id", "pc.content", "pc.mentioned_entities"}).
-				AddRow(postObject.ID, postObject.CreatedAt, postObject.UpdatedAt, postObject.DeletedAt, postObject.DeletedReasonCode, postObject.DiscussionID,
-					postObject.ParticipantID, postObject.QuotedPostID, postObject.MediaID, postObject.PostType, postObject.PostContent.ID, postObject.PostContent.Content, pq.Array(postObject.PostContent.MentionedEntities)).
-				AddRow(postObject.ID, postObject.CreatedAt, postObject.UpdatedAt, postObject.DeletedAt, postObject.DeletedReasonCode, postObject.DiscussionID,
-					postObject.ParticipantID, postObject.QuotedPostID, postObject.MediaID, postObject.PostType, postObject.PostContent.ID, postObject.PostContent.Content, pq.Array(postObject.PostContent.MentionedEntities)).
-				AddRow(postObject.ID, postObject.CreatedAt, postObject.UpdatedAt, postObject.DeletedAt, postObject.DeletedReasonCode, postObject.DiscussionID,
-					postObject.ParticipantID, postObject.QuotedPostID, postObject.MediaID, postObject.PostType, postObject.PostContent.ID, postObject.PostContent.Content, pq.Array(postObject.PostContent.MentionedEntities))
+			mock.ExpectQuery(getPostReplyCountString).WithArgs(parentPostID).WillReturnError(fmt.Errorf("error"))
 
-			mock.ExpectQuery(getPostsByDiscussionIDFromCursorString).WithArgs(discussionID, cursor, limit+1).WillReturnRows(rs)
+			resp, err := mockDatastore.GetPostReplyCount(ctx, parentPostID)
 
-			postConns, err := mockDatastore.GetPostsConnectionByDiscussionID(ctx, discussionID, cursor, limit)
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldEqual, 0)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
 
-			cursor := postObject.CreatedAt.Format(time.RFC3339Nano)
-			verifyPostConns := &model.PostsConnection{
-				Edges: []*model.PostsEdge{
-					{
-						Cursor: postObject.CreatedAt.Format(time.RFC3339Nano),
-						Node:   &postObject,
-					},
-					{
-						Cursor: postObject.CreatedAt.Format(time.RFC3339Nano),
-						Node:   &postObject,
-					},
-				},
-				PageInfo: model.PageInfo{
-					StartCursor: &cursor,
-					EndCursor:   &cursor,
-					HasNextPage: true,
-				},
-			}
+		Convey("when query execution succeeds and returns a count", func() {
+			rs := sqlmock.NewRows([]string{"count"}).AddRow(3)
+
+			mockPreparedStatements(mock)
+			mock.ExpectQuery(getPostReplyCountString).WithArgs(parentPostID).WillReturnRows(rs)
+
+			resp, err := mockDatastore.GetPostReplyCount(ctx, parentPostID)
 
 			So(err, ShouldBeNil)
-			So(postConns, ShouldNotBeNil)
-			So(postConns, ShouldResemble, verifyPostConns)
+			So(resp, ShouldEqual, 3)
 			So(mock.ExpectationsWereMet(), ShouldBeNil)
 		})
 	})
@@ -463,9 +979,9 @@ func TestDelphisDB_GetLastPostByDiscussionID(t *testing.T) {
 		Convey("when query execution succeeds and returns a post", func() {
 			mockPreparedStatements(mock)
 			rs := sqlmock.NewRows([]string{"p.id", "p.created_at", "p.updated_at", "p.deleted_at", "p.deleted_reason_code", "p.discussion_id", "p.participant_id",
-				"p.quoted_post_id", "p.media_id", "p.post_type", "pc.id", "pc.content", "pc.mentioned_entities"}).
+				"p.quoted_post_id", "p.media_id", "p.post_type", "p.edit_history", "p.parent_post_id", "p.pinned_at", "pc.id", "pc.content", "pc.mentioned_entities"}).
 				AddRow(postObject.ID, postObject.CreatedAt, postObject.UpdatedAt, postObject.DeletedAt, postObject.DeletedReasonCode, postObject.DiscussionID,
-					postObject.ParticipantID, postObject.QuotedPostID, postObject.MediaID, postObject.PostType, postObject.PostContent.ID, postObject.PostContent.Content, pq.Array(postObject.PostContent.MentionedEntities))
+					postObject.ParticipantID, postObject.QuotedPostID, postObject.MediaID, postObject.PostType, []byte(postObject.EditHistory.RawMessage), postObject.ParentPostID, postObject.PinnedAt, postObject.PostContent.ID, postObject.PostContent.Content, pq.Array(postObject.PostContent.MentionedEntities))
 
 			mock.ExpectQuery(getLastPostByDiscussionIDStmt).WithArgs(discussionID).WillReturnRows(rs)
 
@@ -613,6 +1129,77 @@ func TestDelphisDB_DeleteAllParticipantPosts(t *testing.T) {
 	})
 }
 
+func TestDelphisDB_RestoreParticipantPosts(t *testing.T) {
+	ctx := context.Background()
+	discussionID := "discussion1"
+	participantID := "participant1"
+	reasonCode := model.PostDeletedReasonParticipantBanned
+
+	Convey("RestoreParticipantPosts", t, func() {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+
+		assert.Nil(t, err, "Failed setting up sqlmock db")
+
+		gormDB, _ := gorm.Open("postgres", db)
+		mockDatastore := &delphisDB{
+			dbConfig:  config.TablesConfig{},
+			sql:       gormDB,
+			pg:        db,
+			prepStmts: &dbPrepStmts{},
+			dynamo:    nil,
+			encoder:   nil,
+		}
+		defer db.Close()
+
+		Convey("when preparing statements returns an error", func() {
+			mockPreparedStatementsWithError(mock)
+
+			postIDs, err := mockDatastore.RestoreParticipantPosts(ctx, discussionID, participantID, reasonCode)
+
+			So(err, ShouldNotBeNil)
+			So(postIDs, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when query execution returns an error", func() {
+			mockPreparedStatements(mock)
+			mock.ExpectQuery(restoreParticipantPostsString).WithArgs(discussionID, participantID, reasonCode).WillReturnError(fmt.Errorf("error"))
+
+			postIDs, err := mockDatastore.RestoreParticipantPosts(ctx, discussionID, participantID, reasonCode)
+
+			So(err, ShouldNotBeNil)
+			So(postIDs, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when scanning the restored posts returns an error", func() {
+			mockPreparedStatements(mock)
+			rs := sqlmock.NewRows([]string{"id", "extra"}).AddRow("1", "2")
+
+			mock.ExpectQuery(restoreParticipantPostsString).WithArgs(discussionID, participantID, reasonCode).WillReturnRows(rs)
+
+			postIDs, err := mockDatastore.RestoreParticipantPosts(ctx, discussionID, participantID, reasonCode)
+
+			So(err, ShouldNotBeNil)
+			So(postIDs, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when restoring succeeds", func() {
+			mockPreparedStatements(mock)
+			rs := sqlmock.NewRows([]string{"id"}).AddRow("1").AddRow("2")
+
+			mock.ExpectQuery(restoreParticipantPostsString).WithArgs(discussionID, participantID, reasonCode).WillReturnRows(rs)
+
+			postIDs, err := mockDatastore.RestoreParticipantPosts(ctx, discussionID, participantID, reasonCode)
+
+			So(err, ShouldBeNil)
+			So(postIDs, ShouldResemble, []string{"1", "2"})
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+	})
+}
+
 func TestDelphisDB_GetPostByID(t *testing.T) {
 	ctx := context.Background()
 	now := time.Now()
@@ -673,9 +1260,9 @@ func TestDelphisDB_GetPostByID(t *testing.T) {
 		Convey("when query execution succeeds and returns a post", func() {
 			mockPreparedStatements(mock)
 			rs := sqlmock.NewRows([]string{"p.id", "p.created_at", "p.updated_at", "p.deleted_at", "p.deleted_reason_code", "p.discussion_id", "p.participant_id",
-				"p.quoted_post_id", "p.media_id", "p.post_type", "pc.id", "pc.content", "pc.mentioned_entities"}).
+				"p.quoted_post_id", "p.media_id", "p.post_type", "p.edit_history", "p.parent_post_id", "p.pinned_at", "pc.id", "pc.content", "pc.mentioned_entities"}).
 				AddRow(postObject.ID, postObject.CreatedAt, postObject.UpdatedAt, postObject.DeletedAt, postObject.DeletedReasonCode, postObject.DiscussionID,
-					postObject.ParticipantID, postObject.QuotedPostID, postObject.MediaID, postObject.PostType, postObject.PostContent.ID, postObject.PostContent.Content, pq.Array(postObject.PostContent.MentionedEntities))
+					postObject.ParticipantID, postObject.QuotedPostID, postObject.MediaID, postObject.PostType, []byte(postObject.EditHistory.RawMessage), postObject.ParentPostID, postObject.PinnedAt, postObject.PostContent.ID, postObject.PostContent.Content, pq.Array(postObject.PostContent.MentionedEntities))
 
 			mock.ExpectQuery(getPostByIDString).WithArgs(postID).WillReturnRows(rs)
 
@@ -742,7 +1329,7 @@ func TestPostIter_Next(t *testing.T) {
 
 		Convey("when the iterator has no more rows to iterate over", func() {
 			rs := sqlmock.NewRows([]string{"p.id", "p.created_at", "p.updated_at", "p.deleted_at", "p.deleted_reason_code", "p.discussion_id", "p.participant_id",
-				"p.quoted_post_id", "p.media_id", "p.post_type", "pc.id", "pc.content", "pc.mentioned_entities"})
+				"p.quoted_post_id", "p.media_id", "p.post_type", "p.edit_history", "p.parent_post_id", "p.pinned_at", "pc.id", "pc.content", "pc.mentioned_entities"})
 
 			// Convert mocked rows to sql.Rows
 			mock.ExpectQuery("SELECT").WillReturnRows(rs)
@@ -760,9 +1347,9 @@ func TestPostIter_Next(t *testing.T) {
 
 		Convey("when the iterator errors on scan", func() {
 			rs := sqlmock.NewRows([]string{"p.id", "p.created_at", "p.updated_at", "p.deleted_at", "p.deleted_reason_code", "p.discussion_id", "p.participant_id",
-				"p.quoted_post_id", "p.media_id", "p.post_type", "pc.id", "pc.content"}).
+				"p.quoted_post_id", "p.media_id", "p.post_type", "p.edit_history", "p.parent_post_id", "p.pinned_at", "pc.id", "pc.content"}).
 				AddRow(postObject.ID, postObject.CreatedAt, postObject.UpdatedAt, postObject.DeletedAt, postObject.DeletedReasonCode, postObject.DiscussionID,
-					postObject.ParticipantID, postObject.QuotedPostID, postObject.MediaID, postObject.PostType, postObject.PostContent.ID, postObject.PostContent.Content)
+					postObject.ParticipantID, postObject.QuotedPostID, postObject.MediaID, postObject.PostType, []byte(postObject.EditHistory.RawMessage), postObject.ParentPostID, postObject.PinnedAt, postObject.PostContent.ID, postObject.PostContent.Content)
 
 			// Convert mocked rows to sql.Rows
 			mock.ExpectQuery("SELECT").WillReturnRows(rs)
@@ -780,11 +1367,11 @@ func TestPostIter_Next(t *testing.T) {
 
 		Convey("when the iterator has rows to iterate over", func() {
 			rs := sqlmock.NewRows([]string{"p.id", "p.created_at", "p.updated_at", "p.deleted_at", "p.deleted_reason_code", "p.discussion_id", "p.participant_id",
-				"p.quoted_post_id", "p.media_id", "p.post_type", "pc.id", "pc.content", "pc.mentioned_entities"}).
+				"p.quoted_post_id", "p.media_id", "p.post_type", "p.edit_history", "p.parent_post_id", "p.pinned_at", "pc.id", "pc.content", "pc.mentioned_entities"}).
 				AddRow(postObject.ID, postObject.CreatedAt, postObject.UpdatedAt, postObject.DeletedAt, postObject.DeletedReasonCode, postObject.DiscussionID,
-					postObject.ParticipantID, postObject.QuotedPostID, postObject.MediaID, postObject.PostType, postObject.PostContent.ID, postObject.PostContent.Content, pq.Array(postObject.PostContent.MentionedEntities)).
+					postObject.ParticipantID, postObject.QuotedPostID, postObject.MediaID, postObject.PostType, []byte(postObject.EditHistory.RawMessage), postObject.ParentPostID, postObject.PinnedAt, postObject.PostContent.ID, postObject.PostContent.Content, pq.Array(postObject.PostContent.MentionedEntities)).
 				AddRow(postObject.ID, postObject.CreatedAt, postObject.UpdatedAt, postObject.DeletedAt, postObject.DeletedReasonCode, postObject.DiscussionID,
-					postObject.ParticipantID, postObject.QuotedPostID, postObject.MediaID, postObject.PostType, postObject.PostContent.ID, postObject.PostContent.Content, pq.Array(postObject.PostContent.MentionedEntities))
+					postObject.ParticipantID, postObject.QuotedPostID, postObject.MediaID, postObject.PostType, []byte(postObject.EditHistory.RawMessage), postObject.ParentPostID, postObject.PinnedAt, postObject.PostContent.ID, postObject.PostContent.Content, pq.Array(postObject.PostContent.MentionedEntities))
 
 			// Convert mocked rows to sql.Rows
 			mock.ExpectQuery("SELECT").WillReturnRows(rs)
@@ -822,7 +1409,7 @@ func TestPostIter_Close(t *testing.T) {
 
 		Convey("when the iterator errors on rows.Close", func() {
 			rs := mock.NewRows([]string{"p.id", "p.created_at", "p.updated_at", "p.deleted_at", "p.deleted_reason_code", "p.discussion_id", "p.participant_id",
-				"p.quoted_post_id", "p.media_id", "p.post_type", "pc.id", "pc.content", "pc.mentioned_entities"}).CloseError(fmt.Errorf("error"))
+				"p.quoted_post_id", "p.media_id", "p.post_type", "p.edit_history", "p.parent_post_id", "p.pinned_at", "pc.id", "pc.content", "pc.mentioned_entities"}).CloseError(fmt.Errorf("error"))
 
 			// Convert mocked rows to sql.Rows
 			mock.ExpectQuery("SELECT").WillReturnRows(rs)
@@ -906,9 +1493,9 @@ func TestDelphisDB_PostIterCollect(t *testing.T) {
 			basePost.QuotedPost = &quotePostObject
 
 			rs := sqlmock.NewRows([]string{"p.id", "p.created_at", "p.updated_at", "p.deleted_at", "p.deleted_reason_code", "p.discussion_id", "p.participant_id",
-				"p.quoted_post_id", "p.media_id", "p.post_type", "pc.id", "pc.content", "pc.mentioned_entities"}).
+				"p.quoted_post_id", "p.media_id", "p.post_type", "p.edit_history", "p.parent_post_id", "p.pinned_at", "pc.id", "pc.content", "pc.mentioned_entities"}).
 				AddRow(basePost.ID, basePost.CreatedAt, basePost.UpdatedAt, basePost.DeletedAt, basePost.DeletedReasonCode, basePost.DiscussionID,
-					basePost.ParticipantID, basePost.QuotedPostID, basePost.MediaID, basePost.PostType, basePost.PostContent.ID, basePost.PostContent.Content, pq.Array(basePost.PostContent.MentionedEntities))
+					basePost.ParticipantID, basePost.QuotedPostID, basePost.MediaID, basePost.PostType, []byte(basePost.EditHistory.RawMessage), basePost.ParentPostID, basePost.PinnedAt, basePost.PostContent.ID, basePost.PostContent.Content, pq.Array(basePost.PostContent.MentionedEntities))
 
 			// Convert mocked rows to sql.Rows
 			mock.ExpectQuery("SELECT").WillReturnRows(rs)
@@ -931,11 +1518,11 @@ func TestDelphisDB_PostIterCollect(t *testing.T) {
 
 		Convey("when the iterator has results and returns slice of Posts", func() {
 			rs := sqlmock.NewRows([]string{"p.id", "p.created_at", "p.updated_at", "p.deleted_at", "p.deleted_reason_code", "p.discussion_id", "p.participant_id",
-				"p.quoted_post_id", "p.media_id", "p.post_type", "pc.id", "pc.content", "pc.mentioned_entities"}).
+				"p.quoted_post_id", "p.media_id", "p.post_type", "p.edit_history", "p.parent_post_id", "p.pinned_at", "pc.id", "pc.content", "pc.mentioned_entities"}).
 				AddRow(postObject.ID, postObject.CreatedAt, postObject.UpdatedAt, postObject.DeletedAt, postObject.DeletedReasonCode, postObject.DiscussionID,
-					postObject.ParticipantID, postObject.QuotedPostID, postObject.MediaID, postObject.PostType, postObject.PostContent.ID, postObject.PostContent.Content, pq.Array(postObject.PostContent.MentionedEntities)).
+					postObject.ParticipantID, postObject.QuotedPostID, postObject.MediaID, postObject.PostType, []byte(postObject.EditHistory.RawMessage), postObject.ParentPostID, postObject.PinnedAt, postObject.PostContent.ID, postObject.PostContent.Content, pq.Array(postObject.PostContent.MentionedEntities)).
 				AddRow(postObject.ID, postObject.CreatedAt, postObject.UpdatedAt, postObject.DeletedAt, postObject.DeletedReasonCode, postObject.DiscussionID,
-					postObject.ParticipantID, postObject.QuotedPostID, postObject.MediaID, postObject.PostType, postObject.PostContent.ID, postObject.PostContent.Content, pq.Array(postObject.PostContent.MentionedEntities))
+					postObject.ParticipantID, postObject.QuotedPostID, postObject.MediaID, postObject.PostType, []byte(postObject.EditHistory.RawMessage), postObject.ParentPostID, postObject.PinnedAt, postObject.PostContent.ID, postObject.PostContent.Content, pq.Array(postObject.PostContent.MentionedEntities))
 
 			// Convert mocked rows to sql.Rows
 			mock.ExpectQuery("SELECT").WillReturnRows(rs)
@@ -954,6 +1541,35 @@ func TestDelphisDB_PostIterCollect(t *testing.T) {
 			So(mock.ExpectationsWereMet(), ShouldBeNil)
 		})
 
+		Convey("when a post deleted by a ban still has its quotedPostID", func() {
+			reasonCode := model.PostDeletedReasonParticipantBanned
+			basePost := postObject
+			basePost.QuotedPostID = &quotePostID
+			basePost.DeletedAt = &now
+			basePost.DeletedReasonCode = &reasonCode
+
+			rs := sqlmock.NewRows([]string{"p.id", "p.created_at", "p.updated_at", "p.deleted_at", "p.deleted_reason_code", "p.discussion_id", "p.participant_id",
+				"p.quoted_post_id", "p.media_id", "p.post_type", "p.edit_history", "p.parent_post_id", "p.pinned_at", "pc.id", "pc.content", "pc.mentioned_entities"}).
+				AddRow(basePost.ID, basePost.CreatedAt, basePost.UpdatedAt, basePost.DeletedAt, basePost.DeletedReasonCode, basePost.DiscussionID,
+					basePost.ParticipantID, basePost.QuotedPostID, basePost.MediaID, basePost.PostType, []byte(basePost.EditHistory.RawMessage), basePost.ParentPostID, basePost.PinnedAt, basePost.PostContent.ID, basePost.PostContent.Content, pq.Array(basePost.PostContent.MentionedEntities))
+
+			// Convert mocked rows to sql.Rows
+			mock.ExpectQuery("SELECT").WillReturnRows(rs)
+			rs1, _ := db.Query("SELECT")
+
+			iter := &postIter{
+				ctx:  ctx,
+				rows: rs1,
+			}
+
+			posts, err := mockDatastore.PostIterCollect(ctx, iter)
+
+			So(err, ShouldBeNil)
+			So(posts, ShouldHaveLength, 1)
+			So(posts[0].QuotedPost, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
 		Convey("when the iterator has results and one post is a quotedPost", func() {
 			// Setup up post with quotedPost
 			basePost := postObject
@@ -961,14 +1577,14 @@ func TestDelphisDB_PostIterCollect(t *testing.T) {
 			basePost.QuotedPost = &quotePostObject
 
 			rs := sqlmock.NewRows([]string{"p.id", "p.created_at", "p.updated_at", "p.deleted_at", "p.deleted_reason_code", "p.discussion_id", "p.participant_id",
-				"p.quoted_post_id", "p.media_id", "p.post_type", "pc.id", "pc.content", "pc.mentioned_entities"}).
+				"p.quoted_post_id", "p.media_id", "p.post_type", "p.edit_history", "p.parent_post_id", "p.pinned_at", "pc.id", "pc.content", "pc.mentioned_entities"}).
 				AddRow(basePost.ID, basePost.CreatedAt, basePost.UpdatedAt, basePost.DeletedAt, basePost.DeletedReasonCode, basePost.DiscussionID,
-					basePost.ParticipantID, basePost.QuotedPostID, basePost.MediaID, basePost.PostType, basePost.PostContent.ID, basePost.PostContent.Content, pq.Array(basePost.PostContent.MentionedEntities))
+					basePost.ParticipantID, basePost.QuotedPostID, basePost.MediaID, basePost.PostType, []byte(basePost.EditHistory.RawMessage), basePost.ParentPostID, basePost.PinnedAt, basePost.PostContent.ID, basePost.PostContent.Content, pq.Array(basePost.PostContent.MentionedEntities))
 
 			quoteRow := sqlmock.NewRows([]string{"p.id", "p.created_at", "p.updated_at", "p.deleted_at", "p.deleted_reason_code", "p.discussion_id", "p.participant_id",
-				"p.quoted_post_id", "p.media_id", "p.post_type", "pc.id", "pc.content", "pc.mentioned_entities"}).
+				"p.quoted_post_id", "p.media_id", "p.post_type", "p.edit_history", "p.parent_post_id", "p.pinned_at", "pc.id", "pc.content", "pc.mentioned_entities"}).
 				AddRow(quotePostObject.ID, quotePostObject.CreatedAt, quotePostObject.UpdatedAt, quotePostObject.DeletedAt, quotePostObject.DeletedReasonCode, quotePostObject.DiscussionID,
-					quotePostObject.ParticipantID, quotePostObject.QuotedPostID, quotePostObject.MediaID, quotePostObject.PostType, quotePostObject.PostContent.ID, quotePostObject.PostContent.Content, pq.Array(quotePostObject.PostContent.MentionedEntities))
+					quotePostObject.ParticipantID, quotePostObject.QuotedPostID, quotePostObject.MediaID, quotePostObject.PostType, []byte(quotePostObject.EditHistory.RawMessage), quotePostObject.ParentPostID, quotePostObject.PinnedAt, quotePostObject.PostContent.ID, quotePostObject.PostContent.Content, pq.Array(quotePostObject.PostContent.MentionedEntities))
 
 			// Convert mocked rows to sql.Rows
 			mock.ExpectQuery("SELECT").WillReturnRows(rs)
@@ -991,3 +1607,133 @@ func TestDelphisDB_PostIterCollect(t *testing.T) {
 		})
 	})
 }
+
+func TestDelphisDB_UpdatePostPinnedAt(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	postID := "post1"
+
+	Convey("UpdatePostPinnedAt", t, func() {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+
+		assert.Nil(t, err, "Failed setting up sqlmock db")
+
+		gormDB, _ := gorm.Open("postgres", db)
+		mockDatastore := &delphisDB{
+			dbConfig:  config.TablesConfig{},
+			sql:       gormDB,
+			pg:        db,
+			prepStmts: &dbPrepStmts{},
+			dynamo:    nil,
+			encoder:   nil,
+		}
+		defer db.Close()
+
+		Convey("when preparing statements returns an error", func() {
+			mockPreparedStatementsWithError(mock)
+
+			err := mockDatastore.UpdatePostPinnedAt(ctx, postID, &now)
+
+			So(err, ShouldNotBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when query execution returns an error", func() {
+			mockPreparedStatements(mock)
+			mock.ExpectExec(updatePostPinnedAtString).WithArgs(postID, &now).WillReturnError(fmt.Errorf("error"))
+
+			err := mockDatastore.UpdatePostPinnedAt(ctx, postID, &now)
+
+			So(err, ShouldNotBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when the post is unpinned", func() {
+			var pinnedAt *time.Time
+			mockPreparedStatements(mock)
+			mock.ExpectExec(updatePostPinnedAtString).WithArgs(postID, pinnedAt).WillReturnResult(sqlmock.NewResult(0, 1))
+
+			err := mockDatastore.UpdatePostPinnedAt(ctx, postID, pinnedAt)
+
+			So(err, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+	})
+}
+
+func TestDelphisDB_GetPinnedPostsByDiscussionIDIter(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	discussionID := "discussion1"
+	participantID := "participant1"
+	postObject := model.Post{
+		ID:            "post1",
+		CreatedAt:     now,
+		UpdatedAt:     now,
+		DiscussionID:  &discussionID,
+		ParticipantID: &participantID,
+		PinnedAt:      &now,
+		PostContent: &model.PostContent{
+			ID:      "postContent1",
+			Content: "test",
+		},
+		PostType: model.PostTypeStandard,
+	}
+
+	Convey("GetPinnedPostsByDiscussionIDIter", t, func() {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+
+		assert.Nil(t, err, "Failed setting up sqlmock db")
+
+		gormDB, _ := gorm.Open("postgres", db)
+		mockDatastore := &delphisDB{
+			dbConfig:  config.TablesConfig{},
+			sql:       gormDB,
+			pg:        db,
+			prepStmts: &dbPrepStmts{},
+			dynamo:    nil,
+			encoder:   nil,
+		}
+		defer db.Close()
+
+		Convey("when preparing statements returns an error", func() {
+			mockPreparedStatementsWithError(mock)
+
+			iter := mockDatastore.GetPinnedPostsByDiscussionIDIter(ctx, discussionID)
+
+			So(iter.Next(&model.Post{}), ShouldBeFalse)
+			So(iter.Close(), ShouldNotBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when query execution returns an error", func() {
+			mockPreparedStatements(mock)
+			mock.ExpectQuery(getPinnedPostsByDiscussionIDString).WithArgs(discussionID).WillReturnError(fmt.Errorf("error"))
+
+			iter := mockDatastore.GetPinnedPostsByDiscussionIDIter(ctx, discussionID)
+
+			So(iter.Next(&model.Post{}), ShouldBeFalse)
+			So(iter.Close(), ShouldNotBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when query execution succeeds and returns pinned posts", func() {
+			mockPreparedStatements(mock)
+			rs := sqlmock.NewRows([]string{"p.id", "p.created_at", "p.updated_at", "p.deleted_at", "p.deleted_reason_code", "p.discussion_id", "p.participant_id",
+				"p.quoted_post_id", "p.media_id", "p.post_type", "p.edit_history", "p.parent_post_id", "p.pinned_at", "pc.id", "pc.content", "pc.mentioned_entities"}).
+				AddRow(postObject.ID, postObject.CreatedAt, postObject.UpdatedAt, postObject.DeletedAt, postObject.DeletedReasonCode, postObject.DiscussionID,
+					postObject.ParticipantID, postObject.QuotedPostID, postObject.MediaID, postObject.PostType, []byte(postObject.EditHistory.RawMessage), postObject.ParentPostID, postObject.PinnedAt, postObject.PostContent.ID, postObject.PostContent.Content, pq.Array(postObject.PostContent.MentionedEntities))
+
+			mock.ExpectQuery(getPinnedPostsByDiscussionIDString).WithArgs(discussionID).WillReturnRows(rs)
+
+			iter := mockDatastore.GetPinnedPostsByDiscussionIDIter(ctx, discussionID)
+
+			post := model.Post{}
+			So(iter.Next(&post), ShouldBeTrue)
+			So(post, ShouldResemble, postObject)
+			So(iter.Next(&post), ShouldBeFalse)
+			So(iter.Close(), ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+	})
+}
@@ -66,6 +66,105 @@ type dbPrepStmts struct {
 	// Viewers
 	getViewerForDiscussionIDUserID *sql2.Stmt
 	updateViewerLastViewed         *sql2.Stmt
+
+	// DiscussionEvents
+	putDiscussionEventStmt               *sql2.Stmt
+	getDiscussionEventsAfterSequenceStmt *sql2.Stmt
+
+	// PostEdits
+	editPostStmt *sql2.Stmt
+
+	// PostReactions
+	putPostReactionStmt          *sql2.Stmt
+	deletePostReactionStmt       *sql2.Stmt
+	getPostReactionSummariesStmt *sql2.Stmt
+
+	// PostReplies
+	getPostRepliesFromCursorStmt *sql2.Stmt
+	getPostReplyCountStmt        *sql2.Stmt
+
+	// PinnedPosts
+	updatePostPinnedAtStmt           *sql2.Stmt
+	getPinnedPostsByDiscussionIDStmt *sql2.Stmt
+
+	// PostSearch
+	searchPostsByDiscussionIDStmt *sql2.Stmt
+	searchPostsByUserAccessStmt   *sql2.Stmt
+
+	// PostPagination
+	getPostsByDiscussionIDBeforeCursorStmt *sql2.Stmt
+	hasNewerPostsByDiscussionIDStmt        *sql2.Stmt
+	hasOlderPostsByDiscussionIDStmt        *sql2.Stmt
+
+	// UnreadCounts
+	getUnreadCountsByUserIDStmt *sql2.Stmt
+	markAllViewersReadStmt      *sql2.Stmt
+
+	// PostBookmarks
+	putPostBookmarkStmt                    *sql2.Stmt
+	deletePostBookmarkStmt                 *sql2.Stmt
+	getPostBookmarksByUserIDFromCursorStmt *sql2.Stmt
+
+	// Polls
+	putPollStmt                        *sql2.Stmt
+	putPollOptionStmt                  *sql2.Stmt
+	getPollByPostIDStmt                *sql2.Stmt
+	getPollOptionTalliesByPostIDStmt   *sql2.Stmt
+	deletePollVotesByParticipantIDStmt *sql2.Stmt
+	putPollVoteStmt                    *sql2.Stmt
+	getPollVoterIDsByOptionIDStmt      *sql2.Stmt
+
+	// ScheduledPosts
+	putScheduledPostStmt                       *sql2.Stmt
+	getScheduledPostByIDStmt                   *sql2.Stmt
+	getPendingScheduledPostsByDiscussionIDStmt *sql2.Stmt
+	claimScheduledPostDueBeforeTimeStmt        *sql2.Stmt
+	updateScheduledPostStatusStmt              *sql2.Stmt
+
+	// LinkPreviews
+	getLinkPreviewByURLStmt            *sql2.Stmt
+	upsertLinkPreviewStmt              *sql2.Stmt
+	deletePostLinkPreviewsByPostIDStmt *sql2.Stmt
+	putPostLinkPreviewStmt             *sql2.Stmt
+	getLinkPreviewsByPostIDStmt        *sql2.Stmt
+
+	// Tags
+	getPostsByTagFromCursorStmt  *sql2.Stmt
+	getTopTagsByDiscussionIDStmt *sql2.Stmt
+
+	// GroupMentions
+	getDUAForEveryoneMentionNotificationsStmt *sql2.Stmt
+
+	// SlowMode
+	getLastPostCreatedAtByDiscussionUserIDStmt *sql2.Stmt
+	lockPostingByDiscussionUserIDStmt          *sql2.Stmt
+
+	// ModeratorRoles
+	getModeratorRoleByUserIDAndDiscussionIDStmt *sql2.Stmt
+	getModeratorRolesByDiscussionIDStmt         *sql2.Stmt
+	upsertModeratorRoleStmt                     *sql2.Stmt
+	deleteModeratorRoleStmt                     *sql2.Stmt
+
+	// OwnershipTransfers
+	updateModeratorUserProfileIDStmt   *sql2.Stmt
+	putDiscussionOwnershipTransferStmt *sql2.Stmt
+
+	// ModerationLog
+	putModerationLogEntryStmt                           *sql2.Stmt
+	getModerationLogEntriesByDiscussionIDFromCursorStmt *sql2.Stmt
+
+	// DiscussionBans
+	putDiscussionBanStmt                     *sql2.Stmt
+	getDiscussionBanByDiscussionIDUserIDStmt *sql2.Stmt
+	deleteDiscussionBanStmt                  *sql2.Stmt
+	restoreParticipantPostsStmt              *sql2.Stmt
+
+	// PostReports
+	putPostReportStmt                                *sql2.Stmt
+	getPostReportByIDStmt                            *sql2.Stmt
+	getPostReportByPostIDReporterUserIDStmt          *sql2.Stmt
+	getPostReportsByDiscussionIDStatusFromCursorStmt *sql2.Stmt
+	resolvePostReportsByPostIDStmt                   *sql2.Stmt
 }
 
 const getPostByIDString = `
@@ -79,6 +178,9 @@ const getPostByIDString = `
 			p.quoted_post_id,
 			p.media_id,
 			p.post_type,
+			p.edit_history,
+			p.parent_post_id,
+			p.pinned_at,
 			pc.id,
 			pc.content,
 			pc.mentioned_entities
@@ -98,6 +200,9 @@ const getPostsByDiscussionIDString = `
 			p.quoted_post_id,
 			p.media_id,
 			p.post_type,
+			p.edit_history,
+			p.parent_post_id,
+			p.pinned_at,
 			pc.id,
 			pc.content,
 			pc.mentioned_entities
@@ -117,6 +222,9 @@ const getPostsByDiscussionIDFromCursorString = `
 			p.quoted_post_id,
 			p.media_id,
 			p.post_type,
+			p.edit_history,
+			p.parent_post_id,
+			p.pinned_at,
 			pc.id,
 			pc.content,
 			pc.mentioned_entities
@@ -125,6 +233,7 @@ const getPostsByDiscussionIDFromCursorString = `
 		ON p.post_content_id = pc.id
 		WHERE p.discussion_id = $1
 		AND p.created_at < $2
+		AND ($4 = false OR p.parent_post_id IS NULL)
 		ORDER BY p.created_at desc
 		LIMIT $3;`
 
@@ -133,7 +242,9 @@ const deletePostByIDString = `
 		SET deleted_at = now(),
 			deleted_reason_code = $2,
 			quoted_post_id = null,
-			media_id = null
+			media_id = null,
+			edit_history = null,
+			pinned_at = null
 		WHERE id = $1
 		RETURNING 
 			id,
@@ -146,14 +257,16 @@ const deletePostByIDString = `
 			post_type;
 `
 
+// Quotes, media and edit history are kept, unlike deletePostByIDString, so
+// restoreParticipantPostsString can bring the posts back. They are not
+// loaded or resolved on deleted posts.
 const deletePostByParticipantIDDiscussionIDString = `
 		UPDATE posts
 		SET deleted_at = now(),
-			deleted_reason_code = $3,
-			quoted_post_id = null,
-			media_id = null
+			deleted_reason_code = $3
 		WHERE discussion_id = $1 AND
-			participant_id = $2
+			participant_id = $2 AND
+			deleted_at IS NULL
 		RETURNING id;
 `
 
@@ -168,6 +281,9 @@ const getLastPostByDiscussionIDStmt = `
 			p.quoted_post_id,
 			p.media_id,
 			p.post_type,
+			p.edit_history,
+			p.parent_post_id,
+			p.pinned_at,
 			pc.id,
 			pc.content,
 			pc.mentioned_entities
@@ -186,8 +302,9 @@ const putPostString = `
 			post_content_id,
 			quoted_post_id,
 			media_id,
-			post_type
-		) VALUES ($1, $2, $3, $4, $5, $6, $7)
+			post_type,
+			parent_post_id
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
 		RETURNING
 			id,
 			created_at,
@@ -197,7 +314,8 @@ const putPostString = `
 			post_content_id,
 			quoted_post_id,
 			media_id,
-			post_type;`
+			post_type,
+			parent_post_id;`
 
 const putPostContentsString = `
 		INSERT INTO post_contents (
@@ -247,7 +365,9 @@ const getDiscussionByLinkSlugString = `
 			d.last_post_id,
 			d.last_post_created_at,
 			d.shuffle_count,
-			d.lock_status
+			d.lock_status,
+			d.everyone_mention_policy,
+			d.slow_mode_seconds
 		FROM discussion_access_link dal
 		INNER JOIN discussions d
 		ON dal.discussion_id = d.id
@@ -331,7 +451,9 @@ const getModeratedDiscussionsByUserIDString = `
 			d.last_post_id,
 			d.last_post_created_at,
 			d.shuffle_count,
-			d.lock_status
+			d.lock_status,
+			d.everyone_mention_policy,
+			d.slow_mode_seconds
 		FROM moderators m
 		INNER JOIN user_profiles u
 		ON m.user_profile_id = u.id
@@ -357,7 +479,9 @@ const getDiscussionsByUserAccessString = `
 			d.last_post_id,
 			d.last_post_created_at,
 			d.shuffle_count,
-			d.lock_status
+			d.lock_status,
+			d.everyone_mention_policy,
+			d.slow_mode_seconds
 		FROM discussion_user_access dua
 		INNER JOIN discussions d
 			ON dua.discussion_id = d.id
@@ -609,3 +733,914 @@ const updateViewerLastViewed = `
 			last_viewed_post_id,
 			discussion_id,
 			user_id;`
+
+const putDiscussionEventString = `
+		INSERT INTO discussion_events (
+			discussion_id,
+			event_type,
+			entity_type,
+			entity_id
+		) VALUES ($1, $2, $3, $4)
+		RETURNING
+			sequence,
+			discussion_id,
+			event_type,
+			entity_type,
+			entity_id,
+			created_at;`
+
+const getDiscussionEventsAfterSequenceString = `
+		SELECT
+			sequence,
+			discussion_id,
+			event_type,
+			entity_type,
+			entity_id,
+			created_at
+		FROM discussion_events
+		WHERE
+			discussion_id = $1
+			AND sequence > $2
+		ORDER BY sequence ASC
+		LIMIT $3;`
+
+const editPostString = `
+		UPDATE posts
+		SET post_content_id = $2,
+			edit_history = $3
+		WHERE id = $1 AND
+			deleted_at IS NULL
+		RETURNING
+			id,
+			created_at,
+			updated_at,
+			discussion_id,
+			participant_id,
+			post_content_id,
+			quoted_post_id,
+			media_id,
+			post_type,
+			edit_history;`
+
+const putPostReactionString = `
+		INSERT INTO post_reactions (
+			post_id,
+			participant_id,
+			reaction
+		) VALUES ($1, $2, $3)
+		ON CONFLICT (post_id, participant_id, reaction) DO NOTHING;`
+
+const deletePostReactionString = `
+		DELETE FROM post_reactions
+		WHERE post_id = $1 AND
+			participant_id = $2 AND
+			reaction = $3;`
+
+const getPostReactionSummariesString = `
+		SELECT reaction,
+			count(*),
+			coalesce(bool_or(participant_id = $2), false)
+		FROM post_reactions
+		WHERE post_id = $1
+		GROUP BY reaction
+		ORDER BY min(created_at) ASC;`
+
+const getPostRepliesFromCursorString = `
+		SELECT p.id,
+			p.created_at,
+			p.updated_at,
+			p.deleted_at,
+			p.deleted_reason_code,
+			p.discussion_id,
+			p.participant_id,
+			p.quoted_post_id,
+			p.media_id,
+			p.post_type,
+			p.edit_history,
+			p.parent_post_id,
+			p.pinned_at,
+			pc.id,
+			pc.content,
+			pc.mentioned_entities
+		FROM posts p
+		INNER JOIN post_contents pc
+		ON p.post_content_id = pc.id
+		WHERE p.parent_post_id = $1
+		AND p.deleted_at IS NULL
+		AND p.created_at < $2
+		ORDER BY p.created_at desc
+		LIMIT $3;`
+
+const getPostReplyCountString = `
+		SELECT count(*)
+		FROM posts
+		WHERE parent_post_id = $1
+			AND deleted_at IS NULL;`
+
+const updatePostPinnedAtString = `
+		UPDATE posts
+		SET pinned_at = $2
+		WHERE id = $1 AND
+			deleted_at IS NULL;`
+
+const getPinnedPostsByDiscussionIDString = `
+		SELECT p.id,
+			p.created_at,
+			p.updated_at,
+			p.deleted_at,
+			p.deleted_reason_code,
+			p.discussion_id,
+			p.participant_id,
+			p.quoted_post_id,
+			p.media_id,
+			p.post_type,
+			p.edit_history,
+			p.parent_post_id,
+			p.pinned_at,
+			pc.id,
+			pc.content,
+			pc.mentioned_entities
+		FROM posts p
+		INNER JOIN post_contents pc
+		ON p.post_content_id = pc.id
+		WHERE p.discussion_id = $1
+		AND p.pinned_at IS NOT NULL
+		AND p.deleted_at IS NULL
+		ORDER BY p.pinned_at desc;`
+
+// The to_tsvector expression must match post_contents_content_search_idx
+// for the index to be used. Matches are marked with the control characters
+// chr(2) and chr(3), which are stripped from the content first, so the
+// snippet can be escaped before the <b></b> tags go in.
+const searchPostsByDiscussionIDString = `
+		SELECT p.id,
+			p.created_at,
+			p.updated_at,
+			p.deleted_at,
+			p.deleted_reason_code,
+			p.discussion_id,
+			p.participant_id,
+			p.quoted_post_id,
+			p.media_id,
+			p.post_type,
+			p.edit_history,
+			p.parent_post_id,
+			p.pinned_at,
+			pc.id,
+			pc.content,
+			pc.mentioned_entities,
+			ts_headline('english', translate(pc.content, chr(2) || chr(3), ''), plainto_tsquery('english', $2),
+				'StartSel=' || chr(2) || ', StopSel=' || chr(3))
+		FROM posts p
+		INNER JOIN post_contents pc
+		ON p.post_content_id = pc.id
+		WHERE p.discussion_id = $1
+		AND to_tsvector('english', pc.content) @@ plainto_tsquery('english', $2)
+		AND p.deleted_at IS NULL
+		AND p.created_at < $3
+		ORDER BY p.created_at desc
+		LIMIT $4;`
+
+const searchPostsByUserAccessString = `
+		SELECT p.id,
+			p.created_at,
+			p.updated_at,
+			p.deleted_at,
+			p.deleted_reason_code,
+			p.discussion_id,
+			p.participant_id,
+			p.quoted_post_id,
+			p.media_id,
+			p.post_type,
+			p.edit_history,
+			p.parent_post_id,
+			p.pinned_at,
+			pc.id,
+			pc.content,
+			pc.mentioned_entities,
+			ts_headline('english', translate(pc.content, chr(2) || chr(3), ''), plainto_tsquery('english', $2),
+				'StartSel=' || chr(2) || ', StopSel=' || chr(3))
+		FROM posts p
+		INNER JOIN post_contents pc
+		ON p.post_content_id = pc.id
+		INNER JOIN discussion_user_access dua
+		ON p.discussion_id = dua.discussion_id
+		INNER JOIN discussions d
+		ON p.discussion_id = d.id
+		WHERE dua.user_id = $1
+		AND dua.state = 'ACTIVE'
+		AND dua.deleted_at IS NULL
+		AND d.deleted_at IS NULL
+		AND to_tsvector('english', pc.content) @@ plainto_tsquery('english', $2)
+		AND p.deleted_at IS NULL
+		AND p.created_at < $3
+		ORDER BY p.created_at desc
+		LIMIT $4;`
+
+// The reverse of getPostsByDiscussionIDFromCursorString: posts newer than the
+// cursor, closest to it first.
+const getPostsByDiscussionIDBeforeCursorString = `
+		SELECT p.id,
+			p.created_at,
+			p.updated_at,
+			p.deleted_at,
+			p.deleted_reason_code,
+			p.discussion_id,
+			p.participant_id,
+			p.quoted_post_id,
+			p.media_id,
+			p.post_type,
+			p.edit_history,
+			p.parent_post_id,
+			p.pinned_at,
+			pc.id,
+			pc.content,
+			pc.mentioned_entities
+		FROM posts p
+		INNER JOIN post_contents pc
+		ON p.post_content_id = pc.id
+		WHERE p.discussion_id = $1
+		AND p.created_at > $2
+		AND ($4 = false OR p.parent_post_id IS NULL)
+		ORDER BY p.created_at asc
+		LIMIT $3;`
+
+// The post at the cursor itself was returned on the adjacent page, so these
+// are inclusive of it.
+const hasNewerPostsByDiscussionIDString = `
+		SELECT EXISTS (
+			SELECT 1
+			FROM posts
+			WHERE discussion_id = $1
+			AND created_at >= $2
+			AND ($3 = false OR parent_post_id IS NULL)
+		);`
+
+const hasOlderPostsByDiscussionIDString = `
+		SELECT EXISTS (
+			SELECT 1
+			FROM posts
+			WHERE discussion_id = $1
+			AND created_at <= $2
+			AND ($3 = false OR parent_post_id IS NULL)
+		);`
+
+// Counts, per discussion the user has ACTIVE access to, the posts newer than
+// their viewer's last viewed post. The user's own posts are never unread.
+const getUnreadCountsByUserIDString = `
+		SELECT v.discussion_id,
+			count(p.id),
+			count(p.id) FILTER (WHERE EXISTS (
+				SELECT 1
+				FROM activity a
+				WHERE a.post_content_id = p.post_content_id
+				AND (
+					(a.entity_type = 'participant' AND EXISTS (
+						SELECT 1
+						FROM participants mp
+						WHERE mp.id = a.entity_id
+						AND mp.user_id = $1
+					))
+					OR (a.entity_type = 'group' AND a.entity_id = 'everyone')
+					OR (a.entity_type = 'group' AND a.entity_id = 'moderator' AND (
+						EXISTS (
+							SELECT 1
+							FROM moderators m
+							INNER JOIN user_profiles u
+							ON m.user_profile_id = u.id
+							WHERE m.id = d.moderator_id
+							AND u.user_id = $1
+						)
+						OR EXISTS (
+							SELECT 1
+							FROM moderator_roles mr
+							WHERE mr.discussion_id = v.discussion_id
+							AND mr.user_id = $1
+						)
+					))
+				)
+			))
+		FROM viewers v
+		INNER JOIN discussion_user_access dua
+		ON v.discussion_id = dua.discussion_id
+			AND v.user_id = dua.user_id
+		INNER JOIN discussions d
+		ON v.discussion_id = d.id
+		LEFT JOIN posts lvp
+		ON v.last_viewed_post_id = lvp.id
+		LEFT JOIN posts p
+		ON v.discussion_id = p.discussion_id
+			AND p.deleted_at IS NULL
+			AND p.created_at > coalesce(lvp.created_at, '-infinity')
+			AND NOT EXISTS (
+				SELECT 1
+				FROM participants op
+				WHERE op.id = p.participant_id
+				AND op.user_id = $1
+			)
+		WHERE v.user_id = $1
+		AND v.deleted_at IS NULL
+		AND dua.state = 'ACTIVE'
+		AND dua.deleted_at IS NULL
+		AND d.deleted_at IS NULL
+		GROUP BY v.discussion_id;`
+
+const markAllViewersReadString = `
+		UPDATE viewers v
+		SET last_viewed = $2,
+			last_viewed_post_id = d.last_post_id
+		FROM discussions d
+		WHERE v.discussion_id = d.id
+			AND v.user_id = $1
+			AND v.deleted_at IS NULL
+			AND d.last_post_id IS NOT NULL
+		RETURNING
+			v.id,
+			v.created_at,
+			v.updated_at,
+			v.last_viewed,
+			v.last_viewed_post_id,
+			v.discussion_id,
+			v.user_id;`
+
+const putPostBookmarkString = `
+		INSERT INTO post_bookmarks (
+			user_id,
+			post_id
+		) VALUES ($1, $2)
+		ON CONFLICT (user_id, post_id) DO NOTHING;`
+
+const deletePostBookmarkString = `
+		DELETE FROM post_bookmarks
+		WHERE user_id = $1
+			AND post_id = $2;`
+
+// Deleted posts are kept so they can be shown as tombstones.
+const getPostBookmarksByUserIDFromCursorString = `
+		SELECT p.id,
+			p.created_at,
+			p.updated_at,
+			p.deleted_at,
+			p.deleted_reason_code,
+			p.discussion_id,
+			p.participant_id,
+			p.quoted_post_id,
+			p.media_id,
+			p.post_type,
+			p.edit_history,
+			p.parent_post_id,
+			p.pinned_at,
+			pc.id,
+			pc.content,
+			pc.mentioned_entities,
+			b.created_at
+		FROM post_bookmarks b
+		INNER JOIN posts p
+		ON b.post_id = p.id
+		INNER JOIN post_contents pc
+		ON p.post_content_id = pc.id
+		WHERE b.user_id = $1
+		AND b.created_at < $2
+		ORDER BY b.created_at desc
+		LIMIT $3;`
+
+const putPollString = `
+		INSERT INTO polls (
+			post_id,
+			allow_multiple_votes,
+			is_anonymous,
+			closes_at
+		) VALUES ($1, $2, $3, $4);`
+
+const putPollOptionString = `
+		INSERT INTO poll_options (
+			id,
+			post_id,
+			position,
+			text
+		) VALUES ($1, $2, $3, $4);`
+
+// Options are tallied in position order. The second parameter is the
+// participant whose votes set me_voted, and may be null.
+const getPollByPostIDString = `
+		SELECT post_id,
+			allow_multiple_votes,
+			is_anonymous,
+			closes_at,
+			created_at
+		FROM polls
+		WHERE post_id = $1;`
+
+const getPollOptionTalliesByPostIDString = `
+		SELECT o.id,
+			o.post_id,
+			o.position,
+			o.text,
+			count(v.participant_id),
+			coalesce(bool_or(v.participant_id = ANY($2)), false)
+		FROM poll_options o
+		LEFT JOIN poll_votes v
+		ON v.option_id = o.id
+		WHERE o.post_id = $1
+		GROUP BY o.id
+		ORDER BY o.position asc;`
+
+const deletePollVotesByParticipantIDString = `
+		DELETE FROM poll_votes
+		WHERE post_id = $1
+			AND participant_id = $2;`
+
+const putPollVoteString = `
+		INSERT INTO poll_votes (
+			post_id,
+			option_id,
+			participant_id
+		) VALUES ($1, $2, $3);`
+
+const getPollVoterIDsByOptionIDString = `
+		SELECT participant_id
+		FROM poll_votes
+		WHERE option_id = $1
+		ORDER BY created_at asc;`
+
+const putScheduledPostString = `
+		INSERT INTO scheduled_posts (
+			id,
+			discussion_id,
+			participant_id,
+			user_id,
+			post_content,
+			publish_at
+		) VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING
+			id,
+			discussion_id,
+			participant_id,
+			user_id,
+			post_content,
+			publish_at,
+			status,
+			post_id,
+			failure_reason,
+			created_at,
+			updated_at;`
+
+const getScheduledPostByIDString = `
+		SELECT id,
+			discussion_id,
+			participant_id,
+			user_id,
+			post_content,
+			publish_at,
+			status,
+			post_id,
+			failure_reason,
+			created_at,
+			updated_at
+		FROM scheduled_posts
+		WHERE id = $1;`
+
+const getPendingScheduledPostsByDiscussionIDString = `
+		SELECT id,
+			discussion_id,
+			participant_id,
+			user_id,
+			post_content,
+			publish_at,
+			status,
+			post_id,
+			failure_reason,
+			created_at,
+			updated_at
+		FROM scheduled_posts
+		WHERE discussion_id = $1
+		AND status = 'PENDING'
+		ORDER BY publish_at asc;`
+
+// Claims the oldest due post by moving it out of PENDING in a single
+// statement. Rows locked by a concurrent claim are skipped, so concurrent
+// publishers never claim the same scheduled post.
+const claimScheduledPostDueBeforeTimeString = `
+		UPDATE scheduled_posts
+		SET status = 'PUBLISHING',
+			updated_at = now()
+		WHERE id = (
+			SELECT id
+			FROM scheduled_posts
+			WHERE status = 'PENDING'
+			AND publish_at <= $1
+			ORDER BY publish_at asc
+			LIMIT 1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING
+			id,
+			discussion_id,
+			participant_id,
+			user_id,
+			post_content,
+			publish_at,
+			status,
+			post_id,
+			failure_reason,
+			created_at,
+			updated_at;`
+
+// Only scheduled posts still in the expected status ($5) change status so a
+// post that was published or cancelled in the meantime is left untouched.
+const updateScheduledPostStatusString = `
+		UPDATE scheduled_posts
+		SET status = $2,
+			post_id = $3,
+			failure_reason = $4,
+			updated_at = now()
+		WHERE id = $1
+		AND status = $5
+		RETURNING
+			id,
+			discussion_id,
+			participant_id,
+			user_id,
+			post_content,
+			publish_at,
+			status,
+			post_id,
+			failure_reason,
+			created_at,
+			updated_at;`
+
+const getLinkPreviewByURLString = `
+		SELECT url,
+			title,
+			description,
+			image_url,
+			site_name,
+			fetched_at
+		FROM link_previews
+		WHERE url = $1;`
+
+const upsertLinkPreviewString = `
+		INSERT INTO link_previews (
+			url,
+			title,
+			description,
+			image_url,
+			site_name
+		) VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (url) DO UPDATE SET
+			title = $2,
+			description = $3,
+			image_url = $4,
+			site_name = $5,
+			fetched_at = now();`
+
+const deletePostLinkPreviewsByPostIDString = `
+		DELETE FROM post_link_previews
+		WHERE post_id = $1;`
+
+const putPostLinkPreviewString = `
+		INSERT INTO post_link_previews (
+			post_id,
+			url,
+			position
+		) VALUES ($1, $2, $3);`
+
+const getLinkPreviewsByPostIDString = `
+		SELECT l.url,
+			l.title,
+			l.description,
+			l.image_url,
+			l.site_name,
+			l.fetched_at
+		FROM post_link_previews p
+		INNER JOIN link_previews l
+			ON l.url = p.url
+		WHERE p.post_id = $1
+		ORDER BY p.position;`
+
+// Edits write new post contents, so only the tags of a post's current
+// content match.
+const getPostsByTagFromCursorString = `
+		SELECT p.id,
+			p.created_at,
+			p.updated_at,
+			p.deleted_at,
+			p.deleted_reason_code,
+			p.discussion_id,
+			p.participant_id,
+			p.quoted_post_id,
+			p.media_id,
+			p.post_type,
+			p.edit_history,
+			p.parent_post_id,
+			p.pinned_at,
+			pc.id,
+			pc.content,
+			pc.mentioned_entities
+		FROM posts p
+		INNER JOIN post_contents pc
+		ON p.post_content_id = pc.id
+		WHERE p.discussion_id = $1
+		AND EXISTS (
+			SELECT 1
+			FROM activity a
+			WHERE a.post_content_id = pc.id
+			AND a.entity_type = 'tag'
+			AND a.entity_id = $2
+		)
+		AND p.deleted_at IS NULL
+		AND p.created_at < $3
+		ORDER BY p.created_at desc
+		LIMIT $4;`
+
+const getTopTagsByDiscussionIDString = `
+		SELECT a.entity_id,
+			count(DISTINCT p.id) AS post_count
+		FROM activity a
+		INNER JOIN posts p
+		ON p.post_content_id = a.post_content_id
+		WHERE p.discussion_id = $1
+		AND a.entity_type = 'tag'
+		AND p.deleted_at IS NULL
+		GROUP BY a.entity_id
+		ORDER BY post_count desc, a.entity_id
+		LIMIT $2;`
+
+// Users with EVERYTHING are already notified of every post.
+const getDUAForEveryoneMentionNotificationsString = `
+		SELECT 	discussion_id,
+			user_id,
+			state,
+			request_id,
+			notif_setting,
+			created_at,
+			updated_at,
+			deleted_at
+		FROM discussion_user_access
+		WHERE discussion_id = $1
+			AND user_id != $2
+			AND state = 'ACTIVE'
+			AND notif_setting = 'MENTIONS';`
+
+// Deleted posts still count so deleting a post does not skip the wait.
+const getLastPostCreatedAtByDiscussionUserIDString = `
+		SELECT MAX(p.created_at)
+		FROM posts p
+		INNER JOIN participants pa
+			ON p.participant_id = pa.id
+		WHERE p.discussion_id = $1
+			AND pa.user_id = $2;`
+
+// Held until the transaction ends, so a user's posts in a discussion are
+// slow mode checked and inserted one at a time.
+const lockPostingByDiscussionUserIDString = `
+		SELECT pg_advisory_xact_lock(hashtext($1), hashtext($2));`
+
+const getModeratorRoleByUserIDAndDiscussionIDString = `
+		SELECT discussion_id,
+			user_id,
+			role,
+			granted_by_user_id,
+			created_at,
+			updated_at
+		FROM moderator_roles
+		WHERE user_id = $1
+			AND discussion_id = $2;`
+
+const getModeratorRolesByDiscussionIDString = `
+		SELECT discussion_id,
+			user_id,
+			role,
+			granted_by_user_id,
+			created_at,
+			updated_at
+		FROM moderator_roles
+		WHERE discussion_id = $1
+		ORDER BY created_at ASC;`
+
+const upsertModeratorRoleString = `
+		INSERT INTO moderator_roles (
+			discussion_id,
+			user_id,
+			role,
+			granted_by_user_id
+		) VALUES ($1, $2, $3, $4)
+		ON CONFLICT (discussion_id, user_id)
+		DO UPDATE SET role = $3,
+			granted_by_user_id = $4,
+			updated_at = now()
+		RETURNING
+			discussion_id,
+			user_id,
+			role,
+			granted_by_user_id,
+			created_at,
+			updated_at;`
+
+const deleteModeratorRoleString = `
+		DELETE FROM moderator_roles
+		WHERE user_id = $1
+			AND discussion_id = $2;`
+
+const updateModeratorUserProfileIDString = `
+		UPDATE moderators
+		SET user_profile_id = $2,
+			updated_at = now()
+		WHERE id = $1
+		RETURNING
+			id,
+			created_at,
+			updated_at,
+			deleted_at,
+			user_profile_id;`
+
+const putDiscussionOwnershipTransferString = `
+		INSERT INTO discussion_ownership_transfers (
+			id,
+			discussion_id,
+			from_user_id,
+			to_user_id,
+			transferred_by_user_id
+		) VALUES ($1, $2, $3, $4, $5)
+		RETURNING
+			id,
+			discussion_id,
+			from_user_id,
+			to_user_id,
+			transferred_by_user_id,
+			created_at;`
+
+const putModerationLogEntryString = `
+		INSERT INTO moderation_log_entries (
+			id,
+			discussion_id,
+			actor_user_id,
+			action,
+			target_type,
+			target_id,
+			reason
+		) VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING
+			id,
+			discussion_id,
+			actor_user_id,
+			action,
+			target_type,
+			target_id,
+			reason,
+			created_at;`
+
+const getModerationLogEntriesByDiscussionIDFromCursorString = `
+		SELECT id,
+			discussion_id,
+			actor_user_id,
+			action,
+			target_type,
+			target_id,
+			reason,
+			created_at
+		FROM moderation_log_entries
+		WHERE discussion_id = $1
+		AND created_at < $2
+		ORDER BY created_at desc
+		LIMIT $3;`
+
+const putDiscussionBanString = `
+		INSERT INTO discussion_bans (
+			participant_id,
+			discussion_id,
+			user_id,
+			reason,
+			banned_by_user_id
+		) VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (participant_id)
+		DO UPDATE SET reason = $4,
+			banned_by_user_id = $5,
+			created_at = now()
+		RETURNING
+			participant_id,
+			discussion_id,
+			user_id,
+			reason,
+			banned_by_user_id,
+			created_at;`
+
+const getDiscussionBanByDiscussionIDUserIDString = `
+		SELECT participant_id,
+			discussion_id,
+			user_id,
+			reason,
+			banned_by_user_id,
+			created_at
+		FROM discussion_bans
+		WHERE discussion_id = $1
+		AND user_id = $2
+		ORDER BY created_at desc
+		LIMIT 1;`
+
+const deleteDiscussionBanString = `
+		DELETE FROM discussion_bans
+		WHERE participant_id = $1;`
+
+const restoreParticipantPostsString = `
+		UPDATE posts
+		SET deleted_at = null,
+			deleted_reason_code = null
+		WHERE discussion_id = $1 AND
+			participant_id = $2 AND
+			deleted_reason_code = $3
+		RETURNING id;`
+
+const putPostReportString = `
+		INSERT INTO post_reports (
+			id,
+			discussion_id,
+			post_id,
+			reporter_user_id,
+			reason,
+			note,
+			status
+		) VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING
+			id,
+			discussion_id,
+			post_id,
+			reporter_user_id,
+			reason,
+			note,
+			status,
+			action,
+			resolved_by_user_id,
+			resolved_at,
+			created_at;`
+
+const getPostReportByIDString = `
+		SELECT id,
+			discussion_id,
+			post_id,
+			reporter_user_id,
+			reason,
+			note,
+			status,
+			action,
+			resolved_by_user_id,
+			resolved_at,
+			created_at
+		FROM post_reports
+		WHERE id = $1;`
+
+const getPostReportByPostIDReporterUserIDString = `
+		SELECT id,
+			discussion_id,
+			post_id,
+			reporter_user_id,
+			reason,
+			note,
+			status,
+			action,
+			resolved_by_user_id,
+			resolved_at,
+			created_at
+		FROM post_reports
+		WHERE post_id = $1
+		AND reporter_user_id = $2;`
+
+const getPostReportsByDiscussionIDStatusFromCursorString = `
+		SELECT id,
+			discussion_id,
+			post_id,
+			reporter_user_id,
+			reason,
+			note,
+			status,
+			action,
+			resolved_by_user_id,
+			resolved_at,
+			created_at
+		FROM post_reports
+		WHERE discussion_id = $1
+		AND status = $2
+		AND created_at < $3
+		ORDER BY created_at desc
+		LIMIT $4;`
+
+const resolvePostReportsByPostIDString = `
+		UPDATE post_reports
+		SET status = $2,
+			action = $3,
+			resolved_by_user_id = $4,
+			resolved_at = now()
+		WHERE post_id = $1
+		AND status = $5
+		RETURNING
+			id,
+			discussion_id,
+			post_id,
+			reporter_user_id,
+			reason,
+			note,
+			status,
+			action,
+			resolved_by_user_id,
+			resolved_at,
+			created_at;`
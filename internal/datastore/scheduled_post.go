@@ -0,0 +1,210 @@
+package datastore
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/delphis-inc/delphisbe/graph/model"
+	"github.com/sirupsen/logrus"
+)
+
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func (d *delphisDB) PutScheduledPost(ctx context.Context, scheduledPost model.ScheduledPost) (*model.ScheduledPost, error) {
+	logrus.Debug("PutScheduledPost::SQL Create")
+	if err := d.initializeStatements(ctx); err != nil {
+		logrus.WithError(err).Error("PutScheduledPost::failed to initialize statements")
+		return nil, err
+	}
+
+	postContent, err := json.Marshal(scheduledPost.PostContent)
+	if err != nil {
+		logrus.WithError(err).Error("failed to marshal scheduled post content")
+		return nil, err
+	}
+
+	resp, err := scanScheduledPost(d.prepStmts.putScheduledPostStmt.QueryRowContext(
+		ctx,
+		scheduledPost.ID,
+		scheduledPost.DiscussionID,
+		scheduledPost.ParticipantID,
+		scheduledPost.UserID,
+		postContent,
+		scheduledPost.PublishAt,
+	))
+	if err != nil {
+		logrus.WithError(err).Error("failed to execute putScheduledPostStmt")
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (d *delphisDB) GetScheduledPostByID(ctx context.Context, id string) (*model.ScheduledPost, error) {
+	logrus.Debug("GetScheduledPostByID::SQL Query")
+	if err := d.initializeStatements(ctx); err != nil {
+		logrus.WithError(err).Error("GetScheduledPostByID::failed to initialize statements")
+		return nil, err
+	}
+
+	resp, err := scanScheduledPost(d.prepStmts.getScheduledPostByIDStmt.QueryRowContext(
+		ctx,
+		id,
+	))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		logrus.WithError(err).Error("failed to execute getScheduledPostByIDStmt")
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (d *delphisDB) GetPendingScheduledPostsByDiscussionID(ctx context.Context, discussionID string) ([]*model.ScheduledPost, error) {
+	logrus.Debug("GetPendingScheduledPostsByDiscussionID::SQL Query")
+	if err := d.initializeStatements(ctx); err != nil {
+		logrus.WithError(err).Error("GetPendingScheduledPostsByDiscussionID::failed to initialize statements")
+		return nil, err
+	}
+
+	rows, err := d.prepStmts.getPendingScheduledPostsByDiscussionIDStmt.QueryContext(
+		ctx,
+		discussionID,
+	)
+	if err != nil {
+		logrus.WithError(err).Error("failed to query getPendingScheduledPostsByDiscussionIDStmt")
+		return nil, err
+	}
+
+	return collectScheduledPosts(rows)
+}
+
+// ClaimScheduledPostDueBeforeTime marks the oldest due scheduled post as
+// PUBLISHING and returns it, or nil if none are due.
+func (d *delphisDB) ClaimScheduledPostDueBeforeTime(ctx context.Context, epoc time.Time) (*model.ScheduledPost, error) {
+	logrus.Debug("ClaimScheduledPostDueBeforeTime::SQL Update")
+	if err := d.initializeStatements(ctx); err != nil {
+		logrus.WithError(err).Error("ClaimScheduledPostDueBeforeTime::failed to initialize statements")
+		return nil, err
+	}
+
+	resp, err := scanScheduledPost(d.prepStmts.claimScheduledPostDueBeforeTimeStmt.QueryRowContext(
+		ctx,
+		epoc,
+	))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		logrus.WithError(err).Error("failed to execute claimScheduledPostDueBeforeTimeStmt")
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// UpdateScheduledPostStatus returns nil if the scheduled post is no longer in fromStatus.
+func (d *delphisDB) UpdateScheduledPostStatus(ctx context.Context, id string, fromStatus model.ScheduledPostStatus, status model.ScheduledPostStatus, postID *string, failureReason *string) (*model.ScheduledPost, error) {
+	logrus.Debug("UpdateScheduledPostStatus::SQL Update")
+	if err := d.initializeStatements(ctx); err != nil {
+		logrus.WithError(err).Error("UpdateScheduledPostStatus::failed to initialize statements")
+		return nil, err
+	}
+
+	resp, err := scanScheduledPost(d.prepStmts.updateScheduledPostStatusStmt.QueryRowContext(
+		ctx,
+		id,
+		status,
+		postID,
+		failureReason,
+		fromStatus,
+	))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		logrus.WithError(err).Error("failed to execute updateScheduledPostStatusStmt")
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// CancelScheduledPost returns nil if the scheduled post is no longer pending.
+func (d *delphisDB) CancelScheduledPost(ctx context.Context, id string) (*model.ScheduledPost, error) {
+	logrus.Debug("CancelScheduledPost::SQL Update")
+	if err := d.initializeStatements(ctx); err != nil {
+		logrus.WithError(err).Error("CancelScheduledPost::failed to initialize statements")
+		return nil, err
+	}
+
+	resp, err := scanScheduledPost(d.prepStmts.updateScheduledPostStatusStmt.QueryRowContext(
+		ctx,
+		id,
+		model.ScheduledPostStatusCancelled,
+		nil,
+		nil,
+		model.ScheduledPostStatusPending,
+	))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		logrus.WithError(err).Error("failed to execute updateScheduledPostStatusStmt")
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func collectScheduledPosts(rows *sql.Rows) ([]*model.ScheduledPost, error) {
+	defer rows.Close()
+
+	scheduledPosts := make([]*model.ScheduledPost, 0)
+	for rows.Next() {
+		scheduledPost, err := scanScheduledPost(rows)
+		if err != nil {
+			logrus.WithError(err).Error("failed to scan scheduled post")
+			return nil, err
+		}
+		scheduledPosts = append(scheduledPosts, scheduledPost)
+	}
+	if err := rows.Err(); err != nil {
+		logrus.WithError(err).Error("failed iterating scheduled posts")
+		return nil, err
+	}
+
+	return scheduledPosts, nil
+}
+
+func scanScheduledPost(row rowScanner) (*model.ScheduledPost, error) {
+	scheduledPost := model.ScheduledPost{}
+	postContent := make([]byte, 0)
+	if err := row.Scan(
+		&scheduledPost.ID,
+		&scheduledPost.DiscussionID,
+		&scheduledPost.ParticipantID,
+		&scheduledPost.UserID,
+		&postContent,
+		&scheduledPost.PublishAt,
+		&scheduledPost.Status,
+		&scheduledPost.PostID,
+		&scheduledPost.FailureReason,
+		&scheduledPost.CreatedAt,
+		&scheduledPost.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(postContent, &scheduledPost.PostContent); err != nil {
+		return nil, err
+	}
+
+	return &scheduledPost, nil
+}
@@ -0,0 +1,407 @@
+package datastore
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/delphis-inc/delphisbe/graph/model"
+	"github.com/delphis-inc/delphisbe/internal/config"
+	"github.com/jinzhu/gorm"
+	. "github.com/smartystreets/goconvey/convey"
+	"github.com/stretchr/testify/assert"
+)
+
+var scheduledPostColumns = []string{"id", "discussion_id", "participant_id", "user_id", "post_content", "publish_at", "status", "post_id", "failure_reason", "created_at", "updated_at"}
+
+func testScheduledPost(now time.Time) model.ScheduledPost {
+	return model.ScheduledPost{
+		ID:            "scheduled1",
+		DiscussionID:  "discussion1",
+		ParticipantID: "participant1",
+		UserID:        "user1",
+		PostContent: model.PostContentInput{
+			PostText: "hello world",
+			PostType: model.PostTypeStandard,
+		},
+		PublishAt: now,
+		Status:    model.ScheduledPostStatusPending,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+func scheduledPostRow(rs *sqlmock.Rows, scheduledPost model.ScheduledPost) *sqlmock.Rows {
+	postContent, _ := json.Marshal(scheduledPost.PostContent)
+	return rs.AddRow(scheduledPost.ID, scheduledPost.DiscussionID, scheduledPost.ParticipantID, scheduledPost.UserID, postContent,
+		scheduledPost.PublishAt, scheduledPost.Status, scheduledPost.PostID, scheduledPost.FailureReason, scheduledPost.CreatedAt, scheduledPost.UpdatedAt)
+}
+
+func TestDelphisDB_PutScheduledPost(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	scheduledPostObj := testScheduledPost(now)
+	postContent, _ := json.Marshal(scheduledPostObj.PostContent)
+
+	Convey("PutScheduledPost", t, func() {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+
+		assert.Nil(t, err, "Failed setting up sqlmock db")
+
+		gormDB, _ := gorm.Open("postgres", db)
+		mockDatastore := &delphisDB{
+			dbConfig:  config.TablesConfig{},
+			sql:       gormDB,
+			pg:        db,
+			prepStmts: &dbPrepStmts{},
+			dynamo:    nil,
+			encoder:   nil,
+		}
+		defer db.Close()
+
+		Convey("when preparing statements returns an error", func() {
+			mockPreparedStatementsWithError(mock)
+
+			resp, err := mockDatastore.PutScheduledPost(ctx, scheduledPostObj)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when query execution returns an error", func() {
+			mockPreparedStatements(mock)
+			mock.ExpectQuery(putScheduledPostString).WithArgs(scheduledPostObj.ID, scheduledPostObj.DiscussionID, scheduledPostObj.ParticipantID,
+				scheduledPostObj.UserID, postContent, scheduledPostObj.PublishAt).WillReturnError(fmt.Errorf("error"))
+
+			resp, err := mockDatastore.PutScheduledPost(ctx, scheduledPostObj)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when the scheduled post is stored", func() {
+			mockPreparedStatements(mock)
+			mock.ExpectQuery(putScheduledPostString).WithArgs(scheduledPostObj.ID, scheduledPostObj.DiscussionID, scheduledPostObj.ParticipantID,
+				scheduledPostObj.UserID, postContent, scheduledPostObj.PublishAt).WillReturnRows(scheduledPostRow(sqlmock.NewRows(scheduledPostColumns), scheduledPostObj))
+
+			resp, err := mockDatastore.PutScheduledPost(ctx, scheduledPostObj)
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldResemble, &scheduledPostObj)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+	})
+}
+
+func TestDelphisDB_GetScheduledPostByID(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	scheduledPostObj := testScheduledPost(now)
+
+	Convey("GetScheduledPostByID", t, func() {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+
+		assert.Nil(t, err, "Failed setting up sqlmock db")
+
+		gormDB, _ := gorm.Open("postgres", db)
+		mockDatastore := &delphisDB{
+			dbConfig:  config.TablesConfig{},
+			sql:       gormDB,
+			pg:        db,
+			prepStmts: &dbPrepStmts{},
+			dynamo:    nil,
+			encoder:   nil,
+		}
+		defer db.Close()
+
+		Convey("when preparing statements returns an error", func() {
+			mockPreparedStatementsWithError(mock)
+
+			resp, err := mockDatastore.GetScheduledPostByID(ctx, scheduledPostObj.ID)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when the scheduled post is not found", func() {
+			mockPreparedStatements(mock)
+			mock.ExpectQuery(getScheduledPostByIDString).WithArgs(scheduledPostObj.ID).WillReturnRows(sqlmock.NewRows(scheduledPostColumns))
+
+			resp, err := mockDatastore.GetScheduledPostByID(ctx, scheduledPostObj.ID)
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when the scheduled post is returned", func() {
+			mockPreparedStatements(mock)
+			mock.ExpectQuery(getScheduledPostByIDString).WithArgs(scheduledPostObj.ID).WillReturnRows(scheduledPostRow(sqlmock.NewRows(scheduledPostColumns), scheduledPostObj))
+
+			resp, err := mockDatastore.GetScheduledPostByID(ctx, scheduledPostObj.ID)
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldResemble, &scheduledPostObj)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+	})
+}
+
+func TestDelphisDB_GetPendingScheduledPostsByDiscussionID(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	scheduledPostObj := testScheduledPost(now)
+
+	Convey("GetPendingScheduledPostsByDiscussionID", t, func() {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+
+		assert.Nil(t, err, "Failed setting up sqlmock db")
+
+		gormDB, _ := gorm.Open("postgres", db)
+		mockDatastore := &delphisDB{
+			dbConfig:  config.TablesConfig{},
+			sql:       gormDB,
+			pg:        db,
+			prepStmts: &dbPrepStmts{},
+			dynamo:    nil,
+			encoder:   nil,
+		}
+		defer db.Close()
+
+		Convey("when preparing statements returns an error", func() {
+			mockPreparedStatementsWithError(mock)
+
+			resp, err := mockDatastore.GetPendingScheduledPostsByDiscussionID(ctx, scheduledPostObj.DiscussionID)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when query execution returns an error", func() {
+			mockPreparedStatements(mock)
+			mock.ExpectQuery(getPendingScheduledPostsByDiscussionIDString).WithArgs(scheduledPostObj.DiscussionID).WillReturnError(fmt.Errorf("error"))
+
+			resp, err := mockDatastore.GetPendingScheduledPostsByDiscussionID(ctx, scheduledPostObj.DiscussionID)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when the scheduled posts are returned", func() {
+			mockPreparedStatements(mock)
+			rs := sqlmock.NewRows(scheduledPostColumns)
+			rs = scheduledPostRow(rs, scheduledPostObj)
+			rs = scheduledPostRow(rs, scheduledPostObj)
+			mock.ExpectQuery(getPendingScheduledPostsByDiscussionIDString).WithArgs(scheduledPostObj.DiscussionID).WillReturnRows(rs)
+
+			resp, err := mockDatastore.GetPendingScheduledPostsByDiscussionID(ctx, scheduledPostObj.DiscussionID)
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldResemble, []*model.ScheduledPost{&scheduledPostObj, &scheduledPostObj})
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+	})
+}
+
+func TestDelphisDB_ClaimScheduledPostDueBeforeTime(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	scheduledPostObj := testScheduledPost(now)
+	claimedObj := scheduledPostObj
+	claimedObj.Status = model.ScheduledPostStatusPublishing
+
+	Convey("ClaimScheduledPostDueBeforeTime", t, func() {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+
+		assert.Nil(t, err, "Failed setting up sqlmock db")
+
+		gormDB, _ := gorm.Open("postgres", db)
+		mockDatastore := &delphisDB{
+			dbConfig:  config.TablesConfig{},
+			sql:       gormDB,
+			pg:        db,
+			prepStmts: &dbPrepStmts{},
+			dynamo:    nil,
+			encoder:   nil,
+		}
+		defer db.Close()
+
+		Convey("when preparing statements returns an error", func() {
+			mockPreparedStatementsWithError(mock)
+
+			resp, err := mockDatastore.ClaimScheduledPostDueBeforeTime(ctx, now)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when query execution returns an error", func() {
+			mockPreparedStatements(mock)
+			mock.ExpectQuery(claimScheduledPostDueBeforeTimeString).WithArgs(now).WillReturnError(fmt.Errorf("error"))
+
+			resp, err := mockDatastore.ClaimScheduledPostDueBeforeTime(ctx, now)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when no scheduled post is due", func() {
+			mockPreparedStatements(mock)
+			mock.ExpectQuery(claimScheduledPostDueBeforeTimeString).WithArgs(now).WillReturnRows(sqlmock.NewRows(scheduledPostColumns))
+
+			resp, err := mockDatastore.ClaimScheduledPostDueBeforeTime(ctx, now)
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when a due scheduled post is claimed", func() {
+			mockPreparedStatements(mock)
+			mock.ExpectQuery(claimScheduledPostDueBeforeTimeString).WithArgs(now).WillReturnRows(scheduledPostRow(sqlmock.NewRows(scheduledPostColumns), claimedObj))
+
+			resp, err := mockDatastore.ClaimScheduledPostDueBeforeTime(ctx, now)
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldResemble, &claimedObj)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+	})
+}
+
+func TestDelphisDB_UpdateScheduledPostStatus(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	postID := "post1"
+	scheduledPostObj := testScheduledPost(now)
+	publishedObj := scheduledPostObj
+	publishedObj.Status = model.ScheduledPostStatusPublished
+	publishedObj.PostID = &postID
+
+	Convey("UpdateScheduledPostStatus", t, func() {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+
+		assert.Nil(t, err, "Failed setting up sqlmock db")
+
+		gormDB, _ := gorm.Open("postgres", db)
+		mockDatastore := &delphisDB{
+			dbConfig:  config.TablesConfig{},
+			sql:       gormDB,
+			pg:        db,
+			prepStmts: &dbPrepStmts{},
+			dynamo:    nil,
+			encoder:   nil,
+		}
+		defer db.Close()
+
+		Convey("when preparing statements returns an error", func() {
+			mockPreparedStatementsWithError(mock)
+
+			resp, err := mockDatastore.UpdateScheduledPostStatus(ctx, scheduledPostObj.ID, model.ScheduledPostStatusPublishing, model.ScheduledPostStatusPublished, &postID, nil)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when query execution returns an error", func() {
+			mockPreparedStatements(mock)
+			mock.ExpectQuery(updateScheduledPostStatusString).WithArgs(scheduledPostObj.ID, model.ScheduledPostStatusPublished, &postID, nil, model.ScheduledPostStatusPublishing).WillReturnError(fmt.Errorf("error"))
+
+			resp, err := mockDatastore.UpdateScheduledPostStatus(ctx, scheduledPostObj.ID, model.ScheduledPostStatusPublishing, model.ScheduledPostStatusPublished, &postID, nil)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when the scheduled post is no longer publishing", func() {
+			mockPreparedStatements(mock)
+			mock.ExpectQuery(updateScheduledPostStatusString).WithArgs(scheduledPostObj.ID, model.ScheduledPostStatusPublished, &postID, nil, model.ScheduledPostStatusPublishing).WillReturnRows(sqlmock.NewRows(scheduledPostColumns))
+
+			resp, err := mockDatastore.UpdateScheduledPostStatus(ctx, scheduledPostObj.ID, model.ScheduledPostStatusPublishing, model.ScheduledPostStatusPublished, &postID, nil)
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when the status is updated", func() {
+			mockPreparedStatements(mock)
+			mock.ExpectQuery(updateScheduledPostStatusString).WithArgs(scheduledPostObj.ID, model.ScheduledPostStatusPublished, &postID, nil, model.ScheduledPostStatusPublishing).WillReturnRows(scheduledPostRow(sqlmock.NewRows(scheduledPostColumns), publishedObj))
+
+			resp, err := mockDatastore.UpdateScheduledPostStatus(ctx, scheduledPostObj.ID, model.ScheduledPostStatusPublishing, model.ScheduledPostStatusPublished, &postID, nil)
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldResemble, &publishedObj)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+	})
+}
+
+func TestDelphisDB_CancelScheduledPost(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	scheduledPostObj := testScheduledPost(now)
+	cancelledObj := scheduledPostObj
+	cancelledObj.Status = model.ScheduledPostStatusCancelled
+
+	Convey("CancelScheduledPost", t, func() {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+
+		assert.Nil(t, err, "Failed setting up sqlmock db")
+
+		gormDB, _ := gorm.Open("postgres", db)
+		mockDatastore := &delphisDB{
+			dbConfig:  config.TablesConfig{},
+			sql:       gormDB,
+			pg:        db,
+			prepStmts: &dbPrepStmts{},
+			dynamo:    nil,
+			encoder:   nil,
+		}
+		defer db.Close()
+
+		Convey("when preparing statements returns an error", func() {
+			mockPreparedStatementsWithError(mock)
+
+			resp, err := mockDatastore.CancelScheduledPost(ctx, scheduledPostObj.ID)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when query execution returns an error", func() {
+			mockPreparedStatements(mock)
+			mock.ExpectQuery(updateScheduledPostStatusString).WithArgs(scheduledPostObj.ID, model.ScheduledPostStatusCancelled, nil, nil, model.ScheduledPostStatusPending).WillReturnError(fmt.Errorf("error"))
+
+			resp, err := mockDatastore.CancelScheduledPost(ctx, scheduledPostObj.ID)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when the scheduled post is cancelled", func() {
+			mockPreparedStatements(mock)
+			mock.ExpectQuery(updateScheduledPostStatusString).WithArgs(scheduledPostObj.ID, model.ScheduledPostStatusCancelled, nil, nil, model.ScheduledPostStatusPending).WillReturnRows(scheduledPostRow(sqlmock.NewRows(scheduledPostColumns), cancelledObj))
+
+			resp, err := mockDatastore.CancelScheduledPost(ctx, scheduledPostObj.ID)
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldResemble, &cancelledObj)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+	})
+}
@@ -0,0 +1,67 @@
+package datastore
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// GetLastPostCreatedAtByDiscussionUserID returns when the user last posted in
+// the discussion as either of their participants, or nil if they never have.
+func (d *delphisDB) GetLastPostCreatedAtByDiscussionUserID(ctx context.Context, discussionID string, userID string) (*time.Time, error) {
+	logrus.Debug("GetLastPostCreatedAtByDiscussionUserID::SQL Query")
+	if err := d.initializeStatements(ctx); err != nil {
+		logrus.WithError(err).Error("GetLastPostCreatedAtByDiscussionUserID::failed to initialize statements")
+		return nil, err
+	}
+
+	var createdAt *time.Time
+	if err := d.prepStmts.getLastPostCreatedAtByDiscussionUserIDStmt.QueryRowContext(
+		ctx,
+		discussionID,
+		userID,
+	).Scan(
+		&createdAt,
+	); err != nil {
+		logrus.WithError(err).Error("failed to execute getLastPostCreatedAtByDiscussionUserIDStmt")
+		return nil, err
+	}
+
+	return createdAt, nil
+}
+
+// LockLastPostCreatedAtByDiscussionUserID does the same as
+// GetLastPostCreatedAtByDiscussionUserID inside tx, after locking the user's
+// posting in the discussion until tx ends.
+func (d *delphisDB) LockLastPostCreatedAtByDiscussionUserID(ctx context.Context, tx *sql.Tx, discussionID string, userID string) (*time.Time, error) {
+	logrus.Debug("LockLastPostCreatedAtByDiscussionUserID::SQL Query")
+	if err := d.initializeStatements(ctx); err != nil {
+		logrus.WithError(err).Error("LockLastPostCreatedAtByDiscussionUserID::failed to initialize statements")
+		return nil, err
+	}
+
+	if _, err := tx.StmtContext(ctx, d.prepStmts.lockPostingByDiscussionUserIDStmt).ExecContext(
+		ctx,
+		discussionID,
+		userID,
+	); err != nil {
+		logrus.WithError(err).Error("failed to execute lockPostingByDiscussionUserIDStmt")
+		return nil, err
+	}
+
+	var createdAt *time.Time
+	if err := tx.StmtContext(ctx, d.prepStmts.getLastPostCreatedAtByDiscussionUserIDStmt).QueryRowContext(
+		ctx,
+		discussionID,
+		userID,
+	).Scan(
+		&createdAt,
+	); err != nil {
+		logrus.WithError(err).Error("failed to execute getLastPostCreatedAtByDiscussionUserIDStmt")
+		return nil, err
+	}
+
+	return createdAt, nil
+}
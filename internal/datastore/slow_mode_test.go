@@ -0,0 +1,169 @@
+package datastore
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/delphis-inc/delphisbe/internal/config"
+	"github.com/jinzhu/gorm"
+	. "github.com/smartystreets/goconvey/convey"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDelphisDB_GetLastPostCreatedAtByDiscussionUserID(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	discussionID := "discussion1"
+	userID := "user1"
+
+	Convey("GetLastPostCreatedAtByDiscussionUserID", t, func() {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+
+		assert.Nil(t, err, "Failed setting up sqlmock db")
+
+		gormDB, _ := gorm.Open("postgres", db)
+		mockDatastore := &delphisDB{
+			dbConfig:  config.TablesConfig{},
+			sql:       gormDB,
+			pg:        db,
+			prepStmts: &dbPrepStmts{},
+			dynamo:    nil,
+			encoder:   nil,
+		}
+		defer db.Close()
+
+		Convey("when preparing statements returns an error", func() {
+			mockPreparedStatementsWithError(mock)
+
+			resp, err := mockDatastore.GetLastPostCreatedAtByDiscussionUserID(ctx, discussionID, userID)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when query execution returns an error", func() {
+			mockPreparedStatements(mock)
+			mock.ExpectQuery(getLastPostCreatedAtByDiscussionUserIDString).WithArgs(discussionID, userID).WillReturnError(fmt.Errorf("error"))
+
+			resp, err := mockDatastore.GetLastPostCreatedAtByDiscussionUserID(ctx, discussionID, userID)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when the user has not posted", func() {
+			rs := sqlmock.NewRows([]string{"max"}).AddRow(nil)
+
+			mockPreparedStatements(mock)
+			mock.ExpectQuery(getLastPostCreatedAtByDiscussionUserIDString).WithArgs(discussionID, userID).WillReturnRows(rs)
+
+			resp, err := mockDatastore.GetLastPostCreatedAtByDiscussionUserID(ctx, discussionID, userID)
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when the user has posted", func() {
+			rs := sqlmock.NewRows([]string{"max"}).AddRow(now)
+
+			mockPreparedStatements(mock)
+			mock.ExpectQuery(getLastPostCreatedAtByDiscussionUserIDString).WithArgs(discussionID, userID).WillReturnRows(rs)
+
+			resp, err := mockDatastore.GetLastPostCreatedAtByDiscussionUserID(ctx, discussionID, userID)
+
+			So(err, ShouldBeNil)
+			So(*resp, ShouldEqual, now)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+	})
+}
+
+func TestDelphisDB_LockLastPostCreatedAtByDiscussionUserID(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	discussionID := "discussion1"
+	userID := "user1"
+
+	Convey("LockLastPostCreatedAtByDiscussionUserID", t, func() {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+
+		assert.Nil(t, err, "Failed setting up sqlmock db")
+
+		gormDB, _ := gorm.Open("postgres", db)
+		mockDatastore := &delphisDB{
+			dbConfig:  config.TablesConfig{},
+			sql:       gormDB,
+			pg:        db,
+			prepStmts: &dbPrepStmts{},
+			dynamo:    nil,
+			encoder:   nil,
+		}
+		defer db.Close()
+
+		Convey("when preparing statements returns an error", func() {
+			mock.ExpectBegin()
+			mockPreparedStatementsWithError(mock)
+
+			tx, err := mockDatastore.BeginTx(ctx)
+			resp, err := mockDatastore.LockLastPostCreatedAtByDiscussionUserID(ctx, tx, discussionID, userID)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when taking the lock returns an error", func() {
+			mock.ExpectBegin()
+			mockPreparedStatements(mock)
+			mock.ExpectPrepare(lockPostingByDiscussionUserIDString)
+			mock.ExpectExec(lockPostingByDiscussionUserIDString).WithArgs(discussionID, userID).WillReturnError(fmt.Errorf("error"))
+
+			tx, err := mockDatastore.BeginTx(ctx)
+			resp, err := mockDatastore.LockLastPostCreatedAtByDiscussionUserID(ctx, tx, discussionID, userID)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when query execution returns an error", func() {
+			mock.ExpectBegin()
+			mockPreparedStatements(mock)
+			mock.ExpectPrepare(lockPostingByDiscussionUserIDString)
+			mock.ExpectExec(lockPostingByDiscussionUserIDString).WithArgs(discussionID, userID).WillReturnResult(sqlmock.NewResult(0, 0))
+			mock.ExpectPrepare(getLastPostCreatedAtByDiscussionUserIDString)
+			mock.ExpectQuery(getLastPostCreatedAtByDiscussionUserIDString).WithArgs(discussionID, userID).WillReturnError(fmt.Errorf("error"))
+
+			tx, err := mockDatastore.BeginTx(ctx)
+			resp, err := mockDatastore.LockLastPostCreatedAtByDiscussionUserID(ctx, tx, discussionID, userID)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when the user has posted", func() {
+			rs := sqlmock.NewRows([]string{"max"}).AddRow(now)
+
+			mock.ExpectBegin()
+			mockPreparedStatements(mock)
+			mock.ExpectPrepare(lockPostingByDiscussionUserIDString)
+			mock.ExpectExec(lockPostingByDiscussionUserIDString).WithArgs(discussionID, userID).WillReturnResult(sqlmock.NewResult(0, 0))
+			mock.ExpectPrepare(getLastPostCreatedAtByDiscussionUserIDString)
+			mock.ExpectQuery(getLastPostCreatedAtByDiscussionUserIDString).WithArgs(discussionID, userID).WillReturnRows(rs)
+
+			tx, err := mockDatastore.BeginTx(ctx)
+			resp, err := mockDatastore.LockLastPostCreatedAtByDiscussionUserID(ctx, tx, discussionID, userID)
+
+			So(err, ShouldBeNil)
+			So(*resp, ShouldEqual, now)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+	})
+}
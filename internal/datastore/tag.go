@@ -0,0 +1,95 @@
+package datastore
+
+import (
+	"context"
+	"errors"
+
+	"github.com/delphis-inc/delphisbe/graph/model"
+	"github.com/sirupsen/logrus"
+)
+
+func (d *delphisDB) GetPostsByTagFromCursorIter(ctx context.Context, discussionID string, tag string, cursor string, limit int) PostIter {
+	logrus.Debug("GetPostsByTagFromCursorIter::SQL Query")
+	if err := d.initializeStatements(ctx); err != nil {
+		logrus.WithError(err).Error("GetPostsByTagFromCursorIter::failed to initialize statements")
+		return &postIter{err: err}
+	}
+
+	rows, err := d.prepStmts.getPostsByTagFromCursorStmt.QueryContext(
+		ctx,
+		discussionID,
+		tag,
+		cursor,
+		limit,
+	)
+	if err != nil {
+		logrus.WithError(err).Error("failed to query GetPostsByTagFromCursorIter")
+		return &postIter{err: err}
+	}
+
+	return &postIter{
+		ctx:  ctx,
+		rows: rows,
+	}
+}
+
+func (d *delphisDB) GetPostsConnectionByTag(ctx context.Context, discussionID string, tag string, cursor string, limit int) (*model.PostsConnection, error) {
+	if limit < 2 {
+		err := errors.New("Values of 'limit' is illegal")
+		logrus.WithError(err).Error("GetPostsConnectionByTag::illegal limit parameter")
+		return nil, err
+	}
+
+	logrus.Debug("GetPostsConnectionByTag::SQL Query")
+	if err := d.initializeStatements(ctx); err != nil {
+		logrus.WithError(err).Error("GetPostsConnectionByTag::failed to initialize statements")
+		return nil, err
+	}
+
+	iter := d.GetPostsByTagFromCursorIter(ctx, discussionID, tag, cursor, limit+1)
+	postArr, err := d.PostIterCollect(ctx, iter)
+	if err != nil {
+		logrus.WithError(err).Error("GetPostsConnectionByTag::failed to collect posts")
+		return nil, err
+	}
+
+	return buildPostsConnection(postArr, cursor, limit), nil
+}
+
+func (d *delphisDB) GetTopTagsByDiscussionID(ctx context.Context, discussionID string, limit int) ([]*model.TagSummary, error) {
+	logrus.Debug("GetTopTagsByDiscussionID::SQL Query")
+	if err := d.initializeStatements(ctx); err != nil {
+		logrus.WithError(err).Error("GetTopTagsByDiscussionID::failed to initialize statements")
+		return nil, err
+	}
+
+	rows, err := d.prepStmts.getTopTagsByDiscussionIDStmt.QueryContext(
+		ctx,
+		discussionID,
+		limit,
+	)
+	if err != nil {
+		logrus.WithError(err).Error("failed to query getTopTagsByDiscussionIDStmt")
+		return nil, err
+	}
+	defer rows.Close()
+
+	tags := make([]*model.TagSummary, 0)
+	for rows.Next() {
+		tag := model.TagSummary{}
+		if err := rows.Scan(
+			&tag.Name,
+			&tag.PostCount,
+		); err != nil {
+			logrus.WithError(err).Error("failed to scan tag summary")
+			return nil, err
+		}
+		tags = append(tags, &tag)
+	}
+	if err := rows.Err(); err != nil {
+		logrus.WithError(err).Error("failed iterating tag summaries")
+		return nil, err
+	}
+
+	return tags, nil
+}
@@ -0,0 +1,191 @@
+package datastore
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/delphis-inc/delphisbe/graph/model"
+	"github.com/delphis-inc/delphisbe/internal/config"
+	"github.com/jinzhu/gorm"
+	"github.com/lib/pq"
+	. "github.com/smartystreets/goconvey/convey"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDelphisDB_GetPostsConnectionByTag(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	discussionID := "discussion1"
+	participantID := "participant1"
+	tag := "golang"
+	cursor := now.String()
+	limit := 2
+	postObject := model.Post{
+		ID:            "post1",
+		CreatedAt:     now,
+		UpdatedAt:     now,
+		DiscussionID:  &discussionID,
+		ParticipantID: &participantID,
+		PostContent: &model.PostContent{
+			ID:                "postContent1",
+			Content:           "learning <0>",
+			MentionedEntities: []string{"tag:golang"},
+		},
+		PostType: model.PostTypeStandard,
+	}
+
+	Convey("GetPostsConnectionByTag", t, func() {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+
+		assert.Nil(t, err, "Failed setting up sqlmock db")
+
+		gormDB, _ := gorm.Open("postgres", db)
+		mockDatastore := &delphisDB{
+			dbConfig:  config.TablesConfig{},
+			sql:       gormDB,
+			pg:        db,
+			prepStmts: &dbPrepStmts{},
+			dynamo:    nil,
+			encoder:   nil,
+		}
+		defer db.Close()
+
+		Convey("when limit less than two is passed in", func() {
+			postConns, err := mockDatastore.GetPostsConnectionByTag(ctx, discussionID, tag, cursor, 1)
+
+			So(err, ShouldNotBeNil)
+			So(postConns, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when preparing statements returns an error", func() {
+			mockPreparedStatementsWithError(mock)
+
+			postConns, err := mockDatastore.GetPostsConnectionByTag(ctx, discussionID, tag, cursor, limit)
+
+			So(err, ShouldNotBeNil)
+			So(postConns, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when query execution returns an error", func() {
+			mockPreparedStatements(mock)
+			mock.ExpectQuery(getPostsByTagFromCursorString).WithArgs(discussionID, tag, cursor, limit+1).WillReturnError(fmt.Errorf("error"))
+
+			postConns, err := mockDatastore.GetPostsConnectionByTag(ctx, discussionID, tag, cursor, limit)
+
+			So(err, ShouldNotBeNil)
+			So(postConns, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when query execution succeeds and returns tagged posts", func() {
+			mockPreparedStatements(mock)
+			rs := sqlmock.NewRows([]string{"p.id", "p.created_at", "p.updated_at", "p.deleted_at", "p.deleted_reason_code", "p.discussion_id", "p.participant_id",
+				"p.quoted_post_id", "p.media_id", "p.post_type", "p.edit_history", "p.parent_post_id", "p.pinned_at", "pc.id", "pc.content", "pc.mentioned_entities"}).
+				AddRow(postObject.ID, postObject.CreatedAt, postObject.UpdatedAt, postObject.DeletedAt, postObject.DeletedReasonCode, postObject.DiscussionID,
+					postObject.ParticipantID, postObject.QuotedPostID, postObject.MediaID, postObject.PostType, []byte(postObject.EditHistory.RawMessage), postObject.ParentPostID, postObject.PinnedAt, postObject.PostContent.ID, postObject.PostContent.Content, pq.Array(postObject.PostContent.MentionedEntities))
+
+			mock.ExpectQuery(getPostsByTagFromCursorString).WithArgs(discussionID, tag, cursor, limit+1).WillReturnRows(rs)
+
+			postConns, err := mockDatastore.GetPostsConnectionByTag(ctx, discussionID, tag, cursor, limit)
+
+			cursor := postObject.CreatedAt.Format(time.RFC3339Nano)
+			verifyPostConns := &model.PostsConnection{
+				Edges: []*model.PostsEdge{
+					{
+						Cursor: cursor,
+						Node:   &postObject,
+					},
+				},
+				PageInfo: model.PageInfo{
+					StartCursor: &cursor,
+					EndCursor:   &cursor,
+					HasNextPage: false,
+				},
+			}
+
+			So(err, ShouldBeNil)
+			So(postConns, ShouldResemble, verifyPostConns)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+	})
+}
+
+func TestDelphisDB_GetTopTagsByDiscussionID(t *testing.T) {
+	ctx := context.Background()
+	discussionID := "discussion1"
+	limit := 10
+
+	Convey("GetTopTagsByDiscussionID", t, func() {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+
+		assert.Nil(t, err, "Failed setting up sqlmock db")
+
+		gormDB, _ := gorm.Open("postgres", db)
+		mockDatastore := &delphisDB{
+			dbConfig:  config.TablesConfig{},
+			sql:       gormDB,
+			pg:        db,
+			prepStmts: &dbPrepStmts{},
+			dynamo:    nil,
+			encoder:   nil,
+		}
+		defer db.Close()
+
+		Convey("when preparing statements returns an error", func() {
+			mockPreparedStatementsWithError(mock)
+
+			resp, err := mockDatastore.GetTopTagsByDiscussionID(ctx, discussionID, limit)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when query execution returns an error", func() {
+			mockPreparedStatements(mock)
+			mock.ExpectQuery(getTopTagsByDiscussionIDString).WithArgs(discussionID, limit).WillReturnError(fmt.Errorf("error"))
+
+			resp, err := mockDatastore.GetTopTagsByDiscussionID(ctx, discussionID, limit)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when scanning returns an error", func() {
+			rs := sqlmock.NewRows([]string{"entity_id"}).AddRow("golang")
+
+			mockPreparedStatements(mock)
+			mock.ExpectQuery(getTopTagsByDiscussionIDString).WithArgs(discussionID, limit).WillReturnRows(rs)
+
+			resp, err := mockDatastore.GetTopTagsByDiscussionID(ctx, discussionID, limit)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when tags are found", func() {
+			rs := sqlmock.NewRows([]string{"entity_id", "post_count"}).
+				AddRow("golang", 3).
+				AddRow("rust", 1)
+
+			mockPreparedStatements(mock)
+			mock.ExpectQuery(getTopTagsByDiscussionIDString).WithArgs(discussionID, limit).WillReturnRows(rs)
+
+			resp, err := mockDatastore.GetTopTagsByDiscussionID(ctx, discussionID, limit)
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldResemble, []*model.TagSummary{
+				{Name: "golang", PostCount: 3},
+				{Name: "rust", PostCount: 1},
+			})
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+	})
+}
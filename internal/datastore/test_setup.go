@@ -47,6 +47,65 @@ func mockPreparedStatements(mock sqlmock.Sqlmock) {
 	mock.ExpectPrepare(incrDiscussionShuffleCount)
 	mock.ExpectPrepare(getViewerForDiscussionIDUserID)
 	mock.ExpectPrepare(updateViewerLastViewed)
+	mock.ExpectPrepare(putDiscussionEventString)
+	mock.ExpectPrepare(getDiscussionEventsAfterSequenceString)
+	mock.ExpectPrepare(editPostString)
+	mock.ExpectPrepare(putPostReactionString)
+	mock.ExpectPrepare(deletePostReactionString)
+	mock.ExpectPrepare(getPostReactionSummariesString)
+	mock.ExpectPrepare(getPostRepliesFromCursorString)
+	mock.ExpectPrepare(getPostReplyCountString)
+	mock.ExpectPrepare(updatePostPinnedAtString)
+	mock.ExpectPrepare(getPinnedPostsByDiscussionIDString)
+	mock.ExpectPrepare(searchPostsByDiscussionIDString)
+	mock.ExpectPrepare(searchPostsByUserAccessString)
+	mock.ExpectPrepare(getPostsByDiscussionIDBeforeCursorString)
+	mock.ExpectPrepare(hasNewerPostsByDiscussionIDString)
+	mock.ExpectPrepare(hasOlderPostsByDiscussionIDString)
+	mock.ExpectPrepare(getUnreadCountsByUserIDString)
+	mock.ExpectPrepare(markAllViewersReadString)
+	mock.ExpectPrepare(putPostBookmarkString)
+	mock.ExpectPrepare(deletePostBookmarkString)
+	mock.ExpectPrepare(getPostBookmarksByUserIDFromCursorString)
+	mock.ExpectPrepare(putPollString)
+	mock.ExpectPrepare(putPollOptionString)
+	mock.ExpectPrepare(getPollByPostIDString)
+	mock.ExpectPrepare(getPollOptionTalliesByPostIDString)
+	mock.ExpectPrepare(deletePollVotesByParticipantIDString)
+	mock.ExpectPrepare(putPollVoteString)
+	mock.ExpectPrepare(getPollVoterIDsByOptionIDString)
+	mock.ExpectPrepare(putScheduledPostString)
+	mock.ExpectPrepare(getScheduledPostByIDString)
+	mock.ExpectPrepare(getPendingScheduledPostsByDiscussionIDString)
+	mock.ExpectPrepare(claimScheduledPostDueBeforeTimeString)
+	mock.ExpectPrepare(updateScheduledPostStatusString)
+	mock.ExpectPrepare(getLinkPreviewByURLString)
+	mock.ExpectPrepare(upsertLinkPreviewString)
+	mock.ExpectPrepare(deletePostLinkPreviewsByPostIDString)
+	mock.ExpectPrepare(putPostLinkPreviewString)
+	mock.ExpectPrepare(getLinkPreviewsByPostIDString)
+	mock.ExpectPrepare(getPostsByTagFromCursorString)
+	mock.ExpectPrepare(getTopTagsByDiscussionIDString)
+	mock.ExpectPrepare(getDUAForEveryoneMentionNotificationsString)
+	mock.ExpectPrepare(getLastPostCreatedAtByDiscussionUserIDString)
+	mock.ExpectPrepare(lockPostingByDiscussionUserIDString)
+	mock.ExpectPrepare(getModeratorRoleByUserIDAndDiscussionIDString)
+	mock.ExpectPrepare(getModeratorRolesByDiscussionIDString)
+	mock.ExpectPrepare(upsertModeratorRoleString)
+	mock.ExpectPrepare(deleteModeratorRoleString)
+	mock.ExpectPrepare(updateModeratorUserProfileIDString)
+	mock.ExpectPrepare(putDiscussionOwnershipTransferString)
+	mock.ExpectPrepare(putModerationLogEntryString)
+	mock.ExpectPrepare(getModerationLogEntriesByDiscussionIDFromCursorString)
+	mock.ExpectPrepare(putDiscussionBanString)
+	mock.ExpectPrepare(getDiscussionBanByDiscussionIDUserIDString)
+	mock.ExpectPrepare(deleteDiscussionBanString)
+	mock.ExpectPrepare(restoreParticipantPostsString)
+	mock.ExpectPrepare(putPostReportString)
+	mock.ExpectPrepare(getPostReportByIDString)
+	mock.ExpectPrepare(getPostReportByPostIDReporterUserIDString)
+	mock.ExpectPrepare(getPostReportsByDiscussionIDStatusFromCursorString)
+	mock.ExpectPrepare(resolvePostReportsByPostIDString)
 }
 
 func mockPreparedStatementsWithError(mock sqlmock.Sqlmock) {
@@ -132,3 +132,85 @@ func (d *delphisDB) GetViewersByIDs(ctx context.Context, viewerIDs []string) (ma
 	}
 	return retVal, nil
 }
+
+func (d *delphisDB) GetUnreadCountsByUserID(ctx context.Context, userID string) (map[string]*model.DiscussionUnreadCount, error) {
+	logrus.Debug("GetUnreadCountsByUserID::SQL Query")
+	if err := d.initializeStatements(ctx); err != nil {
+		logrus.WithError(err).Error("GetUnreadCountsByUserID::failed to initialize statements")
+		return nil, err
+	}
+
+	rows, err := d.prepStmts.getUnreadCountsByUserIDStmt.QueryContext(
+		ctx,
+		userID,
+	)
+	if err != nil {
+		logrus.WithError(err).Error("failed to query GetUnreadCountsByUserID")
+		return nil, err
+	}
+	defer rows.Close()
+
+	counts := map[string]*model.DiscussionUnreadCount{}
+	for rows.Next() {
+		count := model.DiscussionUnreadCount{}
+		if err := rows.Scan(
+			&count.DiscussionID,
+			&count.UnreadCount,
+			&count.UnreadMentionCount,
+		); err != nil {
+			logrus.WithError(err).Error("failed to scan unread count")
+			return nil, err
+		}
+		counts[count.DiscussionID] = &count
+	}
+	if err := rows.Err(); err != nil {
+		logrus.WithError(err).Error("failed to iterate unread counts")
+		return nil, err
+	}
+
+	return counts, nil
+}
+
+// Moves every viewer of the user to the latest post of its discussion.
+func (d *delphisDB) MarkAllViewersRead(ctx context.Context, userID string, viewedTime time.Time) ([]*model.Viewer, error) {
+	logrus.Debug("MarkAllViewersRead::SQL Query")
+	if err := d.initializeStatements(ctx); err != nil {
+		logrus.WithError(err).Error("MarkAllViewersRead::failed to initialize statements")
+		return nil, err
+	}
+
+	rows, err := d.prepStmts.markAllViewersReadStmt.QueryContext(
+		ctx,
+		userID,
+		viewedTime,
+	)
+	if err != nil {
+		logrus.WithError(err).Error("failed to execute markAllViewersReadStmt")
+		return nil, err
+	}
+	defer rows.Close()
+
+	viewers := make([]*model.Viewer, 0)
+	for rows.Next() {
+		viewer := model.Viewer{}
+		if err := rows.Scan(
+			&viewer.ID,
+			&viewer.CreatedAt,
+			&viewer.UpdatedAt,
+			&viewer.LastViewed,
+			&viewer.LastViewedPostID,
+			&viewer.DiscussionID,
+			&viewer.UserID,
+		); err != nil {
+			logrus.WithError(err).Error("failed to scan viewer")
+			return nil, err
+		}
+		viewers = append(viewers, &viewer)
+	}
+	if err := rows.Err(); err != nil {
+		logrus.WithError(err).Error("failed to iterate viewers")
+		return nil, err
+	}
+
+	return viewers, nil
+}
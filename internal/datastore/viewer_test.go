@@ -444,3 +444,144 @@ func TestDelphisDB_GetViewersByIDs(t *testing.T) {
 		})
 	})
 }
+
+func TestDelphisDB_GetUnreadCountsByUserID(t *testing.T) {
+	ctx := context.Background()
+	userID := test_utils.UserID
+	discussionID := test_utils.DiscussionID
+
+	Convey("GetUnreadCountsByUserID", t, func() {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+
+		assert.Nil(t, err, "Failed setting up sqlmock db")
+
+		gormDB, _ := gorm.Open("postgres", db)
+		mockDatastore := &delphisDB{
+			dbConfig:  config.TablesConfig{},
+			sql:       gormDB,
+			pg:        db,
+			prepStmts: &dbPrepStmts{},
+			dynamo:    nil,
+			encoder:   nil,
+		}
+		defer db.Close()
+
+		Convey("when preparing statements returns an error", func() {
+			mockPreparedStatementsWithError(mock)
+
+			resp, err := mockDatastore.GetUnreadCountsByUserID(ctx, userID)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when query execution returns an error", func() {
+			mockPreparedStatements(mock)
+			mock.ExpectQuery(getUnreadCountsByUserIDString).WithArgs(userID).WillReturnError(fmt.Errorf("error"))
+
+			resp, err := mockDatastore.GetUnreadCountsByUserID(ctx, userID)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when scanning a row returns an error", func() {
+			mockPreparedStatements(mock)
+			rs := sqlmock.NewRows([]string{"discussion_id", "count", "count"}).
+				AddRow(discussionID, "not a number", 0)
+
+			mock.ExpectQuery(getUnreadCountsByUserIDString).WithArgs(userID).WillReturnRows(rs)
+
+			resp, err := mockDatastore.GetUnreadCountsByUserID(ctx, userID)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when query execution succeeds and returns counts", func() {
+			mockPreparedStatements(mock)
+			rs := sqlmock.NewRows([]string{"discussion_id", "count", "count"}).
+				AddRow(discussionID, 5, 2)
+
+			mock.ExpectQuery(getUnreadCountsByUserIDString).WithArgs(userID).WillReturnRows(rs)
+
+			resp, err := mockDatastore.GetUnreadCountsByUserID(ctx, userID)
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldResemble, map[string]*model.DiscussionUnreadCount{
+				discussionID: {
+					DiscussionID:       discussionID,
+					UnreadCount:        5,
+					UnreadMentionCount: 2,
+				},
+			})
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+	})
+}
+
+func TestDelphisDB_MarkAllViewersRead(t *testing.T) {
+	ctx := context.Background()
+	userID := test_utils.UserID
+	postID := test_utils.PostID
+	now := time.Now()
+	testViewer := test_utils.TestViewer()
+
+	Convey("MarkAllViewersRead", t, func() {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+
+		assert.Nil(t, err, "Failed setting up sqlmock db")
+
+		gormDB, _ := gorm.Open("postgres", db)
+		mockDatastore := &delphisDB{
+			dbConfig:  config.TablesConfig{},
+			sql:       gormDB,
+			pg:        db,
+			prepStmts: &dbPrepStmts{},
+			dynamo:    nil,
+			encoder:   nil,
+		}
+		defer db.Close()
+
+		Convey("when preparing statements returns an error", func() {
+			mockPreparedStatementsWithError(mock)
+
+			resp, err := mockDatastore.MarkAllViewersRead(ctx, userID, now)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when query execution returns an error", func() {
+			mockPreparedStatements(mock)
+			mock.ExpectQuery(markAllViewersReadString).WithArgs(userID, now).WillReturnError(fmt.Errorf("error"))
+
+			resp, err := mockDatastore.MarkAllViewersRead(ctx, userID, now)
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+
+		Convey("when query execution succeeds and returns the updated viewers", func() {
+			expected := testViewer
+			expected.LastViewed = &now
+			expected.LastViewedPostID = &postID
+			mockPreparedStatements(mock)
+			rs := sqlmock.NewRows([]string{"id", "created_at", "updated_at", "last_viewed", "last_viewed_post_id", "discussion_id", "user_id"}).
+				AddRow(testViewer.ID, testViewer.CreatedAt, testViewer.UpdatedAt, now, postID, testViewer.DiscussionID, testViewer.UserID)
+
+			mock.ExpectQuery(markAllViewersReadString).WithArgs(userID, now).WillReturnRows(rs)
+
+			resp, err := mockDatastore.MarkAllViewersRead(ctx, userID, now)
+
+			So(err, ShouldBeNil)
+			So(resp, ShouldResemble, []*model.Viewer{&expected})
+			So(mock.ExpectationsWereMet(), ShouldBeNil)
+		})
+	})
+}
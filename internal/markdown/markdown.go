@@ -0,0 +1,288 @@
+// Package markdown parses the small Markdown subset allowed in posts: bold,
+// italics, inline code, links and block quotes. Anything else, including raw
+// HTML, is kept as plain text so every client renders a post the same way.
+package markdown
+
+import (
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+type NodeType string
+
+const (
+	NodeDocument   NodeType = "DOCUMENT"
+	NodeParagraph  NodeType = "PARAGRAPH"
+	NodeBlockQuote NodeType = "BLOCK_QUOTE"
+	NodeText       NodeType = "TEXT"
+	NodeBold       NodeType = "BOLD"
+	NodeItalic     NodeType = "ITALIC"
+	NodeCode       NodeType = "CODE"
+	NodeLink       NodeType = "LINK"
+	NodeLineBreak  NodeType = "LINE_BREAK"
+	// A <n> token referring to the post's mentioned entities
+	NodeMention NodeType = "MENTION"
+)
+
+const (
+	// Deeper nesting is left as plain text so crafted input cannot recurse
+	// without bound.
+	maxDepth = 8
+	// Keeps an unclosed '[' from scanning the rest of a long post.
+	maxLinkLength = 2560
+)
+
+// Node is an element of the parsed post. Text is set for text, code and
+// mention nodes, URL for links, and Children for everything else.
+type Node struct {
+	Type     NodeType
+	Text     string
+	URL      string
+	Children []*Node
+}
+
+var (
+	blockQuoteRegex = regexp.MustCompile(`^ {0,3}> ?`)
+	mentionRegex    = regexp.MustCompile(`^<(\d+)>`)
+)
+
+// Characters a backslash makes literal.
+const escapable = "\\`*_[]()<>#"
+
+// Parse returns the document tree for text. It never fails: markup that is
+// unterminated or unsafe is kept as text.
+func Parse(text string) *Node {
+	text = strings.Replace(text, "\r\n", "\n", -1)
+	return &Node{
+		Type:     NodeDocument,
+		Children: parseBlocks(strings.Split(text, "\n"), 0),
+	}
+}
+
+func parseBlocks(lines []string, depth int) []*Node {
+	blocks := make([]*Node, 0)
+	for i := 0; i < len(lines); {
+		if strings.TrimSpace(lines[i]) == "" {
+			i++
+			continue
+		}
+
+		if depth < maxDepth && blockQuoteRegex.MatchString(lines[i]) {
+			quoted := make([]string, 0)
+			for ; i < len(lines) && blockQuoteRegex.MatchString(lines[i]); i++ {
+				quoted = append(quoted, blockQuoteRegex.ReplaceAllString(lines[i], ""))
+			}
+			blocks = append(blocks, &Node{
+				Type:     NodeBlockQuote,
+				Children: parseBlocks(quoted, depth+1),
+			})
+			continue
+		}
+
+		paragraph := make([]string, 0)
+		for ; i < len(lines) && strings.TrimSpace(lines[i]) != ""; i++ {
+			if depth < maxDepth && blockQuoteRegex.MatchString(lines[i]) {
+				break
+			}
+			paragraph = append(paragraph, lines[i])
+		}
+		blocks = append(blocks, &Node{
+			Type:     NodeParagraph,
+			Children: parseInlines(strings.Join(paragraph, "\n"), depth+1),
+		})
+	}
+	return blocks
+}
+
+// parseInlines works on bytes since every delimiter is ASCII and so can never
+// match part of a multi-byte character.
+func parseInlines(s string, depth int) []*Node {
+	nodes := make([]*Node, 0)
+	// Once a delimiter has no closer, no later opener of it will either, so
+	// a post full of stray '*' is not rescanned for each one
+	unclosed := map[string]bool{}
+	closer := func(from int, delim string) int {
+		if unclosed[delim] {
+			return -1
+		}
+		end := findCloser(s, from, delim)
+		unclosed[delim] = end < 0
+		return end
+	}
+	var text strings.Builder
+	flush := func() {
+		if text.Len() > 0 {
+			nodes = appendText(nodes, text.String())
+			text.Reset()
+		}
+	}
+
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch {
+		case c == '\\' && i+1 < len(s) && strings.IndexByte(escapable, s[i+1]) >= 0:
+			text.WriteByte(s[i+1])
+			i += 2
+			continue
+
+		case c == '\n':
+			flush()
+			nodes = append(nodes, &Node{Type: NodeLineBreak})
+			i++
+			continue
+
+		case c == '<':
+			if match := mentionRegex.FindStringSubmatch(s[i:]); match != nil {
+				flush()
+				nodes = append(nodes, &Node{Type: NodeMention, Text: match[1]})
+				i += len(match[0])
+				continue
+			}
+
+		case c == '`':
+			if end := strings.IndexByte(s[i+1:], '`'); end > 0 {
+				flush()
+				nodes = append(nodes, &Node{Type: NodeCode, Text: s[i+1 : i+1+end]})
+				i += end + 2
+				continue
+			}
+
+		case depth >= maxDepth:
+			// Only code spans, escapes and mentions are recognized this deep
+
+		case c == '*' && strings.HasPrefix(s[i:], "**"):
+			if end := closer(i+2, "**"); end >= 0 && isEmphasisContent(s[i+2:end]) {
+				flush()
+				nodes = append(nodes, &Node{Type: NodeBold, Children: parseInlines(s[i+2:end], depth+1)})
+				i = end + 2
+				continue
+			}
+
+		case c == '*' || (c == '_' && !isWordByteBefore(s, i)):
+			if end := closer(i+1, string(c)); end >= 0 && isEmphasisContent(s[i+1:end]) {
+				flush()
+				nodes = append(nodes, &Node{Type: NodeItalic, Children: parseInlines(s[i+1:end], depth+1)})
+				i = end + 1
+				continue
+			}
+
+		case c == '[':
+			if labelEnd, urlEnd := findLink(s, i); urlEnd >= 0 {
+				flush()
+				label := parseInlines(s[i+1:labelEnd], depth+1)
+				if href, ok := safeURL(s[labelEnd+2 : urlEnd]); ok {
+					nodes = append(nodes, &Node{Type: NodeLink, URL: href, Children: label})
+				} else {
+					// Unsafe links keep their label but lose the link
+					nodes = append(nodes, label...)
+				}
+				i = urlEnd + 1
+				continue
+			}
+		}
+
+		text.WriteByte(c)
+		i++
+	}
+	flush()
+
+	return nodes
+}
+
+// appendText merges adjacent text so escapes do not split it into pieces.
+func appendText(nodes []*Node, text string) []*Node {
+	if len(nodes) > 0 && nodes[len(nodes)-1].Type == NodeText {
+		nodes[len(nodes)-1].Text += text
+		return nodes
+	}
+	return append(nodes, &Node{Type: NodeText, Text: text})
+}
+
+// findCloser returns the index of the delimiter closing one opened before
+// from, skipping escapes, code spans and, for single '*', bold delimiters.
+func findCloser(s string, from int, delim string) int {
+	for i := from; i < len(s); i++ {
+		switch {
+		case s[i] == '\\':
+			i++
+		case s[i] == '`':
+			if end := strings.IndexByte(s[i+1:], '`'); end >= 0 {
+				i += end + 1
+			}
+		case delim == "*" && strings.HasPrefix(s[i:], "**"):
+			i++
+		case strings.HasPrefix(s[i:], delim):
+			if delim == "_" && i+1 < len(s) && isWordByte(s[i+1]) {
+				continue
+			}
+			return i
+		}
+	}
+	return -1
+}
+
+// findLink matches [label](url) starting at the '[' at i, returning the index
+// of the closing ']' and ')' or -1 if there is no link.
+func findLink(s string, i int) (int, int) {
+	depth := 0
+	for j := i; j < len(s) && j-i < maxLinkLength; j++ {
+		switch s[j] {
+		case '\\':
+			j++
+		case '\n':
+			return -1, -1
+		case '[':
+			depth++
+		case ']':
+			depth--
+			if depth == 0 {
+				if j+1 >= len(s) || s[j+1] != '(' {
+					return -1, -1
+				}
+				end := strings.IndexAny(s[j+2:], ")\n ")
+				if end < 0 || s[j+2+end] != ')' {
+					return -1, -1
+				}
+				return j, j + 2 + end
+			}
+		}
+	}
+	return -1, -1
+}
+
+// Emphasis needs content that does not start or end with a space, so
+// "2 * 3 * 4" stays as typed.
+func isEmphasisContent(s string) bool {
+	return s != "" && strings.TrimSpace(s) == s && !strings.Contains(s, "\n\n")
+}
+
+func isWordByteBefore(s string, i int) bool {
+	return i > 0 && isWordByte(s[i-1])
+}
+
+func isWordByte(c byte) bool {
+	return c == '_' || c >= 0x80 || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
+
+// safeURL only lets through absolute http(s) and mailto links, so a post can
+// never carry a javascript: or data: url.
+func safeURL(raw string) (string, bool) {
+	parsed, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil {
+		return "", false
+	}
+	switch strings.ToLower(parsed.Scheme) {
+	case "http", "https":
+		if parsed.Host == "" {
+			return "", false
+		}
+	case "mailto":
+		if parsed.Opaque == "" {
+			return "", false
+		}
+	default:
+		return "", false
+	}
+	return parsed.String(), true
+}
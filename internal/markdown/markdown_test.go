@@ -0,0 +1,94 @@
+package markdown
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestMarkdown_ToHTML(t *testing.T) {
+	Convey("ToHTML", t, func() {
+		Convey("when text has no markup", func() {
+			So(ToHTML("hello world"), ShouldEqual, "<p>hello world</p>")
+		})
+
+		Convey("when text is empty", func() {
+			So(ToHTML(""), ShouldEqual, "")
+		})
+
+		Convey("when text has bold, italics and code", func() {
+			So(ToHTML("**bold** *it* _also it_ `x *y*`"), ShouldEqual,
+				"<p><strong>bold</strong> <em>it</em> <em>also it</em> <code>x *y*</code></p>")
+		})
+
+		Convey("when emphasis is nested", func() {
+			So(ToHTML("*a **b** c*"), ShouldEqual, "<p><em>a <strong>b</strong> c</em></p>")
+		})
+
+		Convey("when delimiters are not emphasis", func() {
+			So(ToHTML("2 * 3 * 4 and snake_case_name and **open"), ShouldEqual,
+				"<p>2 * 3 * 4 and snake_case_name and **open</p>")
+		})
+
+		Convey("when markup is escaped", func() {
+			So(ToHTML(`\*not it\* \<0>`), ShouldEqual, "<p>*not it* &lt;0&gt;</p>")
+		})
+
+		Convey("when text has raw html", func() {
+			So(ToHTML(`<script>alert("x")</script> <b onclick=x>hi</b>`), ShouldEqual,
+				"<p>&lt;script&gt;alert(&#34;x&#34;)&lt;/script&gt; &lt;b onclick=x&gt;hi&lt;/b&gt;</p>")
+		})
+
+		Convey("when text has a link", func() {
+			So(ToHTML(`see [the **docs**](https://example.com/a?b=1&c="2")`), ShouldEqual,
+				`<p>see <a href="https://example.com/a?b=1&amp;c=&#34;2&#34;" rel="nofollow noopener noreferrer">the <strong>docs</strong></a></p>`)
+		})
+
+		Convey("when a link is not http or mailto", func() {
+			So(ToHTML("[click](javascript:alert) [me](data:text/html,x) [rel](/path)"), ShouldEqual,
+				"<p>click me rel</p>")
+		})
+
+		Convey("when a link is unterminated", func() {
+			So(ToHTML("[label](https://example.com"), ShouldEqual, "<p>[label](https://example.com</p>")
+		})
+
+		Convey("when text has mention tokens", func() {
+			So(ToHTML("hi <0>"), ShouldEqual, `<p>hi <span data-mention="0">&lt;0&gt;</span></p>`)
+		})
+
+		Convey("when text has paragraphs, line breaks and block quotes", func() {
+			So(ToHTML("> quoted *text*\n>> nested\n\nfirst\nsecond\r\n\r\nthird"), ShouldEqual,
+				"<blockquote><p>quoted <em>text</em></p><blockquote><p>nested</p></blockquote></blockquote>"+
+					"<p>first<br>second</p><p>third</p>")
+		})
+
+		Convey("when nesting is deeper than the limit", func() {
+			html := ToHTML(strings.Repeat(">", 20) + " deep")
+
+			So(strings.Count(html, "<blockquote>"), ShouldEqual, maxDepth)
+		})
+
+		Convey("when text is full of unclosed delimiters", func() {
+			start := time.Now()
+			ToHTML(strings.Repeat("*_[", 20000))
+
+			So(time.Since(start), ShouldBeLessThan, 2*time.Second)
+		})
+	})
+}
+
+func TestMarkdown_ToPlainText(t *testing.T) {
+	Convey("ToPlainText", t, func() {
+		Convey("when text has markup", func() {
+			So(ToPlainText("**hey** <0>, see [this](https://example.com) and `code`"), ShouldEqual,
+				"hey <0>, see this and code")
+		})
+
+		Convey("when text has paragraphs and quotes", func() {
+			So(ToPlainText("> quote\n\nreply"), ShouldEqual, "quote\nreply")
+		})
+	})
+}
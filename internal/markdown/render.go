@@ -0,0 +1,88 @@
+package markdown
+
+import (
+	"html"
+	"strings"
+)
+
+// ToHTML renders text as HTML that is safe to embed: all text is escaped and
+// the only elements produced are p, blockquote, strong, em, code, a and br.
+// Mention tokens become <span data-mention="n"> for clients to fill in.
+func ToHTML(text string) string {
+	var b strings.Builder
+	writeHTML(&b, Parse(text))
+	return b.String()
+}
+
+// ToPlainText strips the markup from text, e.g. for push notifications.
+// Mention tokens are kept as typed.
+func ToPlainText(text string) string {
+	var b strings.Builder
+	writePlainText(&b, Parse(text))
+	return strings.TrimSpace(b.String())
+}
+
+func writeHTML(b *strings.Builder, node *Node) {
+	switch node.Type {
+	case NodeText:
+		b.WriteString(html.EscapeString(node.Text))
+	case NodeCode:
+		b.WriteString("<code>")
+		b.WriteString(html.EscapeString(node.Text))
+		b.WriteString("</code>")
+	case NodeMention:
+		b.WriteString(`<span data-mention="`)
+		b.WriteString(node.Text)
+		b.WriteString(`">&lt;`)
+		b.WriteString(node.Text)
+		b.WriteString("&gt;</span>")
+	case NodeLineBreak:
+		b.WriteString("<br>")
+	case NodeLink:
+		b.WriteString(`<a href="`)
+		b.WriteString(html.EscapeString(node.URL))
+		b.WriteString(`" rel="nofollow noopener noreferrer">`)
+		writeChildrenHTML(b, node)
+		b.WriteString("</a>")
+	case NodeParagraph:
+		writeElementHTML(b, "p", node)
+	case NodeBlockQuote:
+		writeElementHTML(b, "blockquote", node)
+	case NodeBold:
+		writeElementHTML(b, "strong", node)
+	case NodeItalic:
+		writeElementHTML(b, "em", node)
+	default:
+		writeChildrenHTML(b, node)
+	}
+}
+
+func writeElementHTML(b *strings.Builder, tag string, node *Node) {
+	b.WriteString("<" + tag + ">")
+	writeChildrenHTML(b, node)
+	b.WriteString("</" + tag + ">")
+}
+
+func writeChildrenHTML(b *strings.Builder, node *Node) {
+	for _, child := range node.Children {
+		writeHTML(b, child)
+	}
+}
+
+func writePlainText(b *strings.Builder, node *Node) {
+	switch node.Type {
+	case NodeText, NodeCode:
+		b.WriteString(node.Text)
+	case NodeMention:
+		b.WriteString("<" + node.Text + ">")
+	case NodeLineBreak:
+		b.WriteString("\n")
+	default:
+		for _, child := range node.Children {
+			writePlainText(b, child)
+		}
+		if node.Type == NodeParagraph {
+			b.WriteString("\n")
+		}
+	}
+}
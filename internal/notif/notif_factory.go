@@ -3,8 +3,10 @@ package notif
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/delphis-inc/delphisbe/graph/model"
+	"github.com/delphis-inc/delphisbe/internal/markdown"
 )
 
 // This is stupid for now but we will make it smarter
@@ -22,7 +24,19 @@ func BuildPushNotification(ctx context.Context, discussion model.Discussion, pos
 		content = *contentPreview
 	}
 	title := truncateNotificationText(fmt.Sprintf("New post in %s", discussion.Title), 65)
-	body := truncateNotificationText(content, 156)
+	// Devices show notifications as plain text, so drop the markup
+	body := truncateNotificationText(markdown.ToPlainText(content), 156)
+
+	return &PushNotificationBody{
+		Title: title,
+		Body:  body,
+	}, nil
+}
+
+func BuildPostReportNotification(ctx context.Context, discussion model.Discussion, reason model.PostReportReason) (*PushNotificationBody, error) {
+	title := truncateNotificationText(fmt.Sprintf("New report in %s", discussion.Title), 65)
+	reasonText := strings.ToLower(strings.ReplaceAll(reason.String(), "_", " "))
+	body := truncateNotificationText(fmt.Sprintf("A post was reported (%s) and is waiting for review", reasonText), 156)
 
 	return &PushNotificationBody{
 		Title: title,
@@ -0,0 +1,56 @@
+package notif
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/delphis-inc/delphisbe/graph/model"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestBuildPushNotification(t *testing.T) {
+	ctx := context.Background()
+	discussion := model.Discussion{Title: "Chatham"}
+
+	Convey("BuildPushNotification", t, func() {
+		post := model.Post{PostContent: &model.PostContent{Content: "**Big** news, see [this](https://example.com)"}}
+
+		Convey("when the post content has markup", func() {
+			resp, err := BuildPushNotification(ctx, discussion, post, nil)
+
+			So(err, ShouldBeNil)
+			So(resp.Title, ShouldEqual, "New post in Chatham")
+			So(resp.Body, ShouldEqual, "Big news, see this")
+		})
+
+		Convey("when a preview with markup is given", func() {
+			preview := "> *quoted* reply"
+			resp, err := BuildPushNotification(ctx, discussion, post, &preview)
+
+			So(err, ShouldBeNil)
+			So(resp.Body, ShouldEqual, "quoted reply")
+		})
+
+		Convey("when the content is longer than a notification", func() {
+			post.PostContent.Content = "_" + strings.Repeat("a", 200) + "_"
+			resp, err := BuildPushNotification(ctx, discussion, post, nil)
+
+			So(err, ShouldBeNil)
+			So(resp.Body, ShouldEqual, strings.Repeat("a", 156))
+		})
+	})
+}
+
+func TestBuildPostReportNotification(t *testing.T) {
+	ctx := context.Background()
+	discussion := model.Discussion{Title: "Chatham"}
+
+	Convey("BuildPostReportNotification", t, func() {
+		resp, err := BuildPostReportNotification(ctx, discussion, model.PostReportReasonHateSpeech)
+
+		So(err, ShouldBeNil)
+		So(resp.Title, ShouldEqual, "New report in Chatham")
+		So(resp.Body, ShouldEqual, "A post was reported (hate speech) and is waiting for review")
+	})
+}
@@ -0,0 +1,43 @@
+package pubsub
+
+import (
+	"context"
+	"sync"
+)
+
+type inMemoryPubSub struct {
+	handlers map[string][]Handler
+	mu       sync.RWMutex
+}
+
+// NewInMemoryPubSub only delivers messages to subscribers within this process.
+func NewInMemoryPubSub() PubSub {
+	return &inMemoryPubSub{
+		handlers: map[string][]Handler{},
+	}
+}
+
+func (p *inMemoryPubSub) Publish(ctx context.Context, channel string, payload []byte) error {
+	p.mu.RLock()
+	handlers := append([]Handler{}, p.handlers[channel]...)
+	p.mu.RUnlock()
+
+	for _, handler := range handlers {
+		handler(ctx, payload)
+	}
+	return nil
+}
+
+func (p *inMemoryPubSub) Subscribe(ctx context.Context, channel string, handler Handler) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.handlers[channel] = append(p.handlers[channel], handler)
+	return nil
+}
+
+func (p *inMemoryPubSub) Close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.handlers = map[string][]Handler{}
+	return nil
+}
@@ -0,0 +1,54 @@
+package pubsub
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestInMemoryPubSub(t *testing.T) {
+	ctx := context.Background()
+	channel := "channel"
+
+	Convey("InMemoryPubSub", t, func() {
+		ps := NewInMemoryPubSub()
+
+		Convey("when nobody is subscribed", func() {
+			err := ps.Publish(ctx, channel, []byte("payload"))
+
+			So(err, ShouldBeNil)
+		})
+
+		Convey("when handlers are subscribed", func() {
+			var received [][]byte
+			handler := func(ctx context.Context, payload []byte) {
+				received = append(received, payload)
+			}
+			So(ps.Subscribe(ctx, channel, handler), ShouldBeNil)
+			So(ps.Subscribe(ctx, channel, handler), ShouldBeNil)
+
+			Convey("it delivers to every handler on the channel", func() {
+				err := ps.Publish(ctx, channel, []byte("payload"))
+
+				So(err, ShouldBeNil)
+				So(received, ShouldResemble, [][]byte{[]byte("payload"), []byte("payload")})
+			})
+
+			Convey("it does not deliver to other channels", func() {
+				err := ps.Publish(ctx, "other", []byte("payload"))
+
+				So(err, ShouldBeNil)
+				So(received, ShouldBeEmpty)
+			})
+
+			Convey("it stops delivering after close", func() {
+				So(ps.Close(), ShouldBeNil)
+				err := ps.Publish(ctx, channel, []byte("payload"))
+
+				So(err, ShouldBeNil)
+				So(received, ShouldBeEmpty)
+			})
+		})
+	})
+}
@@ -0,0 +1,133 @@
+package pubsub
+
+import (
+	"context"
+	sql2 "database/sql"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/delphis-inc/delphisbe/internal/config"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// Postgres rejects NOTIFY payloads of 8000 bytes or more.
+	maxNotifyPayloadBytes = 7999
+
+	listenerMinReconnect = 10 * time.Second
+	listenerMaxReconnect = time.Minute
+	listenerPingInterval = 90 * time.Second
+
+	publishStmtString = `SELECT pg_notify($1, $2);`
+)
+
+var ErrPayloadTooLarge = errors.New("pubsub payload exceeds the postgres notify limit")
+
+// postgresPubSub uses LISTEN/NOTIFY so that every instance connected to the
+// same database receives every published message.
+type postgresPubSub struct {
+	db       *sql2.DB
+	listener *pq.Listener
+
+	handlers map[string][]Handler
+	mu       sync.RWMutex
+
+	done chan struct{}
+}
+
+func NewPostgresPubSub(sqlDbConfig config.SQLDBConfig) (PubSub, error) {
+	dbURI := fmt.Sprintf("host=%s port=%d user=%s dbname=%s sslmode=disable password=%s", sqlDbConfig.Host, sqlDbConfig.Port, sqlDbConfig.Username, sqlDbConfig.DBName, sqlDbConfig.Password)
+
+	db, err := sql2.Open("postgres", dbURI)
+	if err != nil {
+		logrus.WithError(err).Error("failed to open pubsub db connection")
+		return nil, err
+	}
+
+	listener := pq.NewListener(dbURI, listenerMinReconnect, listenerMaxReconnect, func(ev pq.ListenerEventType, err error) {
+		if err != nil {
+			logrus.WithError(err).Error("pubsub listener event error")
+		}
+	})
+
+	p := &postgresPubSub{
+		db:       db,
+		listener: listener,
+		handlers: map[string][]Handler{},
+		done:     make(chan struct{}),
+	}
+
+	go p.listen()
+
+	return p, nil
+}
+
+func (p *postgresPubSub) Publish(ctx context.Context, channel string, payload []byte) error {
+	if len(payload) > maxNotifyPayloadBytes {
+		logrus.Errorf("Publish::payload of %d bytes is too large for channel %s", len(payload), channel)
+		return ErrPayloadTooLarge
+	}
+
+	if _, err := p.db.ExecContext(ctx, publishStmtString, channel, string(payload)); err != nil {
+		logrus.WithError(err).Error("failed to notify pubsub channel")
+		return errors.Wrap(err, "failed to notify pubsub channel")
+	}
+	return nil
+}
+
+func (p *postgresPubSub) Subscribe(ctx context.Context, channel string, handler Handler) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if _, ok := p.handlers[channel]; !ok {
+		if err := p.listener.Listen(channel); err != nil && err != pq.ErrChannelAlreadyOpen {
+			logrus.WithError(err).Error("failed to listen on pubsub channel")
+			return errors.Wrap(err, "failed to listen on pubsub channel")
+		}
+	}
+	p.handlers[channel] = append(p.handlers[channel], handler)
+	return nil
+}
+
+func (p *postgresPubSub) Close() error {
+	close(p.done)
+	if err := p.listener.Close(); err != nil {
+		logrus.WithError(err).Error("failed to close pubsub listener")
+		return err
+	}
+	return p.db.Close()
+}
+
+func (p *postgresPubSub) listen() {
+	for {
+		select {
+		case <-p.done:
+			return
+		case n := <-p.listener.Notify:
+			// A nil notification is sent after the listener reconnects. Anything
+			// published while we were disconnected has been lost.
+			if n == nil {
+				logrus.Warnf("pubsub listener reconnected")
+				continue
+			}
+			p.dispatch(n.Channel, []byte(n.Extra))
+		case <-time.After(listenerPingInterval):
+			if err := p.listener.Ping(); err != nil {
+				logrus.WithError(err).Error("failed to ping pubsub listener")
+			}
+		}
+	}
+}
+
+func (p *postgresPubSub) dispatch(channel string, payload []byte) {
+	p.mu.RLock()
+	handlers := append([]Handler{}, p.handlers[channel]...)
+	p.mu.RUnlock()
+
+	for _, handler := range handlers {
+		handler(context.Background(), payload)
+	}
+}
@@ -0,0 +1,40 @@
+package pubsub
+
+import (
+	"context"
+
+	"github.com/delphis-inc/delphisbe/internal/config"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	InMemoryPubSubType = "in_memory"
+	PostgresPubSubType = "postgres"
+)
+
+// Handler is invoked with the payload of every message published to a channel
+// the handler is subscribed to.
+type Handler func(ctx context.Context, payload []byte)
+
+// PubSub fans messages out to every subscriber of a channel. Depending on the
+// implementation subscribers may live in this process only or in every
+// instance of the server.
+type PubSub interface {
+	Publish(ctx context.Context, channel string, payload []byte) error
+	Subscribe(ctx context.Context, channel string, handler Handler) error
+	Close() error
+}
+
+func NewPubSub(conf config.Config) PubSub {
+	switch conf.PubSubConfig.Type {
+	case PostgresPubSubType:
+		ps, err := NewPostgresPubSub(conf.SQLDBConfig)
+		if err != nil {
+			logrus.WithError(err).Fatalf("Failed to create postgres pubsub")
+			return nil
+		}
+		return ps
+	default:
+		return NewInMemoryPubSub()
+	}
+}
@@ -0,0 +1,129 @@
+package unfurl
+
+import (
+	"html"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+const (
+	maxTitleLength       = 256
+	maxDescriptionLength = 1024
+	maxURLLength         = 2048
+)
+
+var (
+	urlRegex       = regexp.MustCompile(`(?i)\bhttps?://[^\s<>"']+`)
+	headCloseRegex = regexp.MustCompile(`(?i)</head\s*>`)
+	metaTagRegex   = regexp.MustCompile(`(?is)<meta\s[^>]*>`)
+	attrRegex      = regexp.MustCompile(`(?is)([a-z:_-]+)\s*=\s*(?:"([^"]*)"|'([^']*)'|([^\s"'>]+))`)
+	titleRegex     = regexp.MustCompile(`(?is)<title[^>]*>(.*?)</title\s*>`)
+)
+
+// ExtractURLs returns the distinct http(s) links in text in the order they
+// appear, capped at MaxLinksPerPost.
+func ExtractURLs(text string) []string {
+	seen := map[string]bool{}
+	urls := make([]string, 0)
+	for _, match := range urlRegex.FindAllString(text, -1) {
+		// Trailing punctuation is almost always part of the sentence, not the link
+		match = strings.TrimRight(match, ".,;:!?)]}")
+		if len(match) > maxURLLength || seen[match] {
+			continue
+		}
+		if parsed, err := url.Parse(match); err != nil || !isFetchableScheme(parsed) {
+			continue
+		}
+		seen[match] = true
+		urls = append(urls, match)
+		if len(urls) == MaxLinksPerPost {
+			break
+		}
+	}
+	return urls
+}
+
+// ParseHTML pulls OpenGraph and Twitter card metadata out of a document,
+// falling back to the <title> element. Relative image urls are resolved
+// against base.
+func ParseHTML(doc string, base *url.URL) *Preview {
+	if loc := headCloseRegex.FindStringIndex(doc); loc != nil {
+		doc = doc[:loc[0]]
+	}
+
+	meta := map[string]string{}
+	for _, tag := range metaTagRegex.FindAllString(doc, -1) {
+		attrs := map[string]string{}
+		for _, attr := range attrRegex.FindAllStringSubmatch(tag, -1) {
+			attrs[strings.ToLower(attr[1])] = attr[2] + attr[3] + attr[4]
+		}
+		key := strings.ToLower(attrs["property"])
+		if key == "" {
+			key = strings.ToLower(attrs["name"])
+		}
+		if key == "" {
+			continue
+		}
+		// The first occurrence wins, matching how most unfurlers behave
+		if _, ok := meta[key]; !ok {
+			meta[key] = cleanText(attrs["content"])
+		}
+	}
+
+	preview := &Preview{
+		Title:       firstNonEmpty(meta["og:title"], meta["twitter:title"]),
+		Description: firstNonEmpty(meta["og:description"], meta["twitter:description"], meta["description"]),
+		ImageURL:    firstNonEmpty(meta["og:image"], meta["og:image:url"], meta["twitter:image"], meta["twitter:image:src"]),
+		SiteName:    firstNonEmpty(meta["og:site_name"], meta["twitter:site"]),
+	}
+	if preview.Title == "" {
+		if match := titleRegex.FindStringSubmatch(doc); match != nil {
+			preview.Title = cleanText(match[1])
+		}
+	}
+
+	preview.Title = truncate(preview.Title, maxTitleLength)
+	preview.Description = truncate(preview.Description, maxDescriptionLength)
+	preview.ImageURL = resolveImageURL(preview.ImageURL, base)
+
+	return preview
+}
+
+func resolveImageURL(raw string, base *url.URL) string {
+	if raw == "" {
+		return ""
+	}
+	ref, err := url.Parse(raw)
+	if err != nil {
+		return ""
+	}
+	if base != nil {
+		ref = base.ResolveReference(ref)
+	}
+	if !isFetchableScheme(ref) || len(ref.String()) > maxURLLength {
+		return ""
+	}
+	return ref.String()
+}
+
+func cleanText(s string) string {
+	return strings.Join(strings.Fields(html.UnescapeString(s)), " ")
+}
+
+func truncate(s string, max int) string {
+	runes := []rune(s)
+	if len(runes) <= max {
+		return s
+	}
+	return string(runes[:max])
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
@@ -0,0 +1,175 @@
+package unfurl
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+)
+
+const (
+	DefaultTimeout  = 5 * time.Second
+	DefaultMaxBytes = 512 * 1024
+
+	// MaxLinksPerPost caps how many links in a single post are unfurled.
+	MaxLinksPerPost = 3
+
+	maxRedirects = 3
+	userAgent    = "DelphisLinkPreview/1.0"
+)
+
+// Preview is the metadata scraped from a linked page.
+type Preview struct {
+	URL         string
+	Title       string
+	Description string
+	ImageURL    string
+	SiteName    string
+}
+
+// IsEmpty returns true if the page exposed nothing worth rendering.
+func (p *Preview) IsEmpty() bool {
+	return p == nil || (p.Title == "" && p.Description == "" && p.ImageURL == "")
+}
+
+// Fetcher abstracts retrieving preview metadata for a URL. The backend only
+// talks to this interface so tests can point it at a local server.
+type Fetcher interface {
+	Fetch(ctx context.Context, rawURL string) (*Preview, error)
+}
+
+type httpFetcher struct {
+	client   *http.Client
+	maxBytes int64
+}
+
+// NewHTTPFetcher returns a Fetcher that requests pages from the server itself.
+// Requests carry no cookies or user headers, so the poster is never exposed
+// to the linked site, and only public addresses may be dialed.
+func NewHTTPFetcher(timeout time.Duration, maxBytes int64) Fetcher {
+	return newHTTPFetcher(timeout, maxBytes, false)
+}
+
+func newHTTPFetcher(timeout time.Duration, maxBytes int64, allowPrivate bool) *httpFetcher {
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	if maxBytes <= 0 {
+		maxBytes = DefaultMaxBytes
+	}
+
+	dialer := &net.Dialer{Timeout: timeout}
+	if !allowPrivate {
+		dialer.Control = rejectPrivateAddress
+	}
+
+	return &httpFetcher{
+		client: &http.Client{
+			Timeout: timeout,
+			Transport: &http.Transport{
+				Proxy:                 nil,
+				DialContext:           dialer.DialContext,
+				TLSHandshakeTimeout:   timeout,
+				ResponseHeaderTimeout: timeout,
+				MaxIdleConns:          10,
+				IdleConnTimeout:       30 * time.Second,
+			},
+			CheckRedirect: func(req *http.Request, via []*http.Request) error {
+				if len(via) >= maxRedirects {
+					return fmt.Errorf("stopped after %d redirects", maxRedirects)
+				}
+				if !isFetchableScheme(req.URL) {
+					return fmt.Errorf("refusing to follow redirect to %s", req.URL.Scheme)
+				}
+				return nil
+			},
+		},
+		maxBytes: maxBytes,
+	}
+}
+
+func (f *httpFetcher) Fetch(ctx context.Context, rawURL string) (*Preview, error) {
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	if !isFetchableScheme(parsed) {
+		return nil, fmt.Errorf("unsupported url scheme %s", parsed.Scheme)
+	}
+
+	req, err := http.NewRequest(http.MethodGet, parsed.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("User-Agent", userAgent)
+	req.Header.Set("Accept", "text/html,application/xhtml+xml")
+
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+	if mediaType, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type")); err != nil ||
+		(mediaType != "text/html" && mediaType != "application/xhtml+xml") {
+		return nil, fmt.Errorf("unsupported content type %q", resp.Header.Get("Content-Type"))
+	}
+
+	body, err := ioutil.ReadAll(io.LimitReader(resp.Body, f.maxBytes))
+	if err != nil {
+		return nil, err
+	}
+
+	preview := ParseHTML(string(body), resp.Request.URL)
+	preview.URL = rawURL
+	return preview, nil
+}
+
+func isFetchableScheme(u *url.URL) bool {
+	scheme := strings.ToLower(u.Scheme)
+	return (scheme == "http" || scheme == "https") && u.Host != ""
+}
+
+// rejectPrivateAddress runs after DNS resolution so a public hostname cannot
+// be used to reach loopback, link-local or internal addresses.
+func rejectPrivateAddress(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return fmt.Errorf("unresolved address %s", address)
+	}
+	if ip.IsLoopback() || ip.IsUnspecified() || ip.IsLinkLocalUnicast() ||
+		ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast() || ip.IsMulticast() {
+		return fmt.Errorf("refusing to fetch non-public address %s", ip)
+	}
+	for _, block := range privateBlocks {
+		if block.Contains(ip) {
+			return fmt.Errorf("refusing to fetch non-public address %s", ip)
+		}
+	}
+	return nil
+}
+
+var privateBlocks = func() []*net.IPNet {
+	cidrs := []string{"10.0.0.0/8", "172.16.0.0/12", "192.168.0.0/16", "100.64.0.0/10", "fc00::/7"}
+	blocks := make([]*net.IPNet, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		_, block, _ := net.ParseCIDR(cidr)
+		blocks = append(blocks, block)
+	}
+	return blocks
+}()
@@ -0,0 +1,166 @@
+package unfurl
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+const testPage = `<!DOCTYPE html>
+<html>
+<head>
+	<title>Fallback title</title>
+	<meta property="og:title" content="Chatham &amp; Co">
+	<meta name='twitter:description' content='A   place
+		to talk'>
+	<meta property="og:image" content="/static/card.png" />
+	<meta property="og:site_name" content="Chatham">
+</head>
+<body><meta property="og:title" content="ignored"></body>
+</html>`
+
+func TestUnfurl_ExtractURLs(t *testing.T) {
+	Convey("ExtractURLs", t, func() {
+		Convey("when text has no links", func() {
+			So(ExtractURLs("hello world"), ShouldBeEmpty)
+		})
+
+		Convey("when text has links with trailing punctuation and duplicates", func() {
+			urls := ExtractURLs("see https://example.com/a, and (http://example.org/b). again https://example.com/a")
+			So(urls, ShouldResemble, []string{"https://example.com/a", "http://example.org/b"})
+		})
+
+		Convey("when text has unsupported schemes", func() {
+			So(ExtractURLs("ftp://example.com javascript:alert(1)"), ShouldBeEmpty)
+		})
+
+		Convey("when text has more links than the cap", func() {
+			urls := ExtractURLs("https://a.com https://b.com https://c.com https://d.com")
+			So(len(urls), ShouldEqual, MaxLinksPerPost)
+		})
+	})
+}
+
+func TestUnfurl_ParseHTML(t *testing.T) {
+	base, _ := url.Parse("https://example.com/articles/1")
+
+	Convey("ParseHTML", t, func() {
+		Convey("when page has opengraph and twitter metadata", func() {
+			preview := ParseHTML(testPage, base)
+
+			So(preview.Title, ShouldEqual, "Chatham & Co")
+			So(preview.Description, ShouldEqual, "A place to talk")
+			So(preview.ImageURL, ShouldEqual, "https://example.com/static/card.png")
+			So(preview.SiteName, ShouldEqual, "Chatham")
+		})
+
+		Convey("when page only has a title", func() {
+			preview := ParseHTML("<html><head><title> Just a title </title></head></html>", base)
+
+			So(preview.Title, ShouldEqual, "Just a title")
+			So(preview.ImageURL, ShouldEqual, "")
+			So(preview.IsEmpty(), ShouldBeFalse)
+		})
+
+		Convey("when the image is not an http url", func() {
+			preview := ParseHTML(`<meta property="og:image" content="javascript:alert(1)">`, base)
+
+			So(preview.ImageURL, ShouldEqual, "")
+			So(preview.IsEmpty(), ShouldBeTrue)
+		})
+	})
+}
+
+func TestUnfurl_Fetch(t *testing.T) {
+	ctx := context.Background()
+
+	Convey("Fetch", t, func() {
+		var receivedHeaders http.Header
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			receivedHeaders = r.Header
+			switch r.URL.Path {
+			case "/page":
+				w.Header().Set("Content-Type", "text/html; charset=utf-8")
+				fmt.Fprint(w, testPage)
+			case "/large":
+				w.Header().Set("Content-Type", "text/html")
+				fmt.Fprint(w, strings.Repeat(" ", 1024)+testPage)
+			case "/image":
+				w.Header().Set("Content-Type", "image/png")
+				fmt.Fprint(w, "png")
+			case "/slow":
+				time.Sleep(200 * time.Millisecond)
+				w.Header().Set("Content-Type", "text/html")
+				fmt.Fprint(w, testPage)
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}))
+		defer server.Close()
+
+		fetcher := newHTTPFetcher(time.Second, DefaultMaxBytes, true)
+
+		Convey("when the scheme is unsupported", func() {
+			resp, err := fetcher.Fetch(ctx, "file:///etc/passwd")
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		Convey("when the server returns an error status", func() {
+			resp, err := fetcher.Fetch(ctx, server.URL+"/missing")
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		Convey("when the content is not html", func() {
+			resp, err := fetcher.Fetch(ctx, server.URL+"/image")
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		Convey("when the page is larger than the size cap", func() {
+			fetcher = newHTTPFetcher(time.Second, 512, true)
+			resp, err := fetcher.Fetch(ctx, server.URL+"/large")
+
+			So(err, ShouldBeNil)
+			So(resp.IsEmpty(), ShouldBeTrue)
+		})
+
+		Convey("when the server is slower than the timeout", func() {
+			fetcher = newHTTPFetcher(50*time.Millisecond, DefaultMaxBytes, true)
+			resp, err := fetcher.Fetch(ctx, server.URL+"/slow")
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		Convey("when private addresses are not allowed", func() {
+			resp, err := NewHTTPFetcher(time.Second, DefaultMaxBytes).Fetch(ctx, server.URL+"/page")
+
+			So(err, ShouldNotBeNil)
+			So(resp, ShouldBeNil)
+		})
+
+		Convey("when the page is fetched successfully", func() {
+			resp, err := fetcher.Fetch(ctx, server.URL+"/page")
+
+			So(err, ShouldBeNil)
+			So(resp.URL, ShouldEqual, server.URL+"/page")
+			So(resp.Title, ShouldEqual, "Chatham & Co")
+			So(resp.ImageURL, ShouldEqual, server.URL+"/static/card.png")
+			So(receivedHeaders.Get("User-Agent"), ShouldEqual, userAgent)
+			So(receivedHeaders.Get("Cookie"), ShouldEqual, "")
+			So(receivedHeaders.Get("Authorization"), ShouldEqual, "")
+		})
+	})
+}
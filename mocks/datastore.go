@@ -89,6 +89,52 @@ func (_m *Datastore) BeginTx(ctx context.Context) (*sql.Tx, error) {
 	return r0, r1
 }
 
+// CancelScheduledPost provides a mock function with given fields: ctx, id
+func (_m *Datastore) CancelScheduledPost(ctx context.Context, id string) (*model.ScheduledPost, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *model.ScheduledPost
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.ScheduledPost); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ScheduledPost)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ClaimScheduledPostDueBeforeTime provides a mock function with given fields: ctx, epoc
+func (_m *Datastore) ClaimScheduledPostDueBeforeTime(ctx context.Context, epoc time.Time) (*model.ScheduledPost, error) {
+	ret := _m.Called(ctx, epoc)
+
+	var r0 *model.ScheduledPost
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) *model.ScheduledPost); ok {
+		r0 = rf(ctx, epoc)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ScheduledPost)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, epoc)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CommitTx provides a mock function with given fields: ctx, tx
 func (_m *Datastore) CommitTx(ctx context.Context, tx *sql.Tx) error {
 	ret := _m.Called(ctx, tx)
@@ -177,6 +223,20 @@ func (_m *Datastore) DeleteAllParticipantPosts(ctx context.Context, discussionID
 	return r0, r1
 }
 
+// DeleteDiscussionBan provides a mock function with given fields: ctx, participantID
+func (_m *Datastore) DeleteDiscussionBan(ctx context.Context, participantID string) error {
+	ret := _m.Called(ctx, participantID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, participantID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteDiscussionUserAccess provides a mock function with given fields: ctx, tx, discussionID, userID
 func (_m *Datastore) DeleteDiscussionUserAccess(ctx context.Context, tx *sql.Tx, discussionID string, userID string) (*model.DiscussionUserAccess, error) {
 	ret := _m.Called(ctx, tx, discussionID, userID)
@@ -200,6 +260,34 @@ func (_m *Datastore) DeleteDiscussionUserAccess(ctx context.Context, tx *sql.Tx,
 	return r0, r1
 }
 
+// DeleteModeratorRole provides a mock function with given fields: ctx, userID, discussionID
+func (_m *Datastore) DeleteModeratorRole(ctx context.Context, userID string, discussionID string) error {
+	ret := _m.Called(ctx, userID, discussionID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, userID, discussionID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeletePostBookmark provides a mock function with given fields: ctx, bookmark
+func (_m *Datastore) DeletePostBookmark(ctx context.Context, bookmark model.PostBookmark) error {
+	ret := _m.Called(ctx, bookmark)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.PostBookmark) error); ok {
+		r0 = rf(ctx, bookmark)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeletePostByID provides a mock function with given fields: ctx, postID, deletedReasonCode
 func (_m *Datastore) DeletePostByID(ctx context.Context, postID string, deletedReasonCode model.PostDeletedReason) (*model.Post, error) {
 	ret := _m.Called(ctx, postID, deletedReasonCode)
@@ -223,6 +311,20 @@ func (_m *Datastore) DeletePostByID(ctx context.Context, postID string, deletedR
 	return r0, r1
 }
 
+// DeletePostReaction provides a mock function with given fields: ctx, reaction
+func (_m *Datastore) DeletePostReaction(ctx context.Context, reaction model.PostReaction) error {
+	ret := _m.Called(ctx, reaction)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.PostReaction) error); ok {
+		r0 = rf(ctx, reaction)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DiscussionIterCollect provides a mock function with given fields: ctx, iter
 func (_m *Datastore) DiscussionIterCollect(ctx context.Context, iter datastore.DiscussionIter) ([]*model.Discussion, error) {
 	ret := _m.Called(ctx, iter)
@@ -269,6 +371,29 @@ func (_m *Datastore) DuaIterCollect(ctx context.Context, iter datastore.Discussi
 	return r0, r1
 }
 
+// EditPost provides a mock function with given fields: ctx, tx, post
+func (_m *Datastore) EditPost(ctx context.Context, tx *sql.Tx, post model.Post) (*model.Post, error) {
+	ret := _m.Called(ctx, tx, post)
+
+	var r0 *model.Post
+	if rf, ok := ret.Get(0).(func(context.Context, *sql.Tx, model.Post) *model.Post); ok {
+		r0 = rf(ctx, tx, post)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Post)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *sql.Tx, model.Post) error); ok {
+		r1 = rf(ctx, tx, post)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetAccessLinkByDiscussionID provides a mock function with given fields: ctx, discussionID
 func (_m *Datastore) GetAccessLinkByDiscussionID(ctx context.Context, discussionID string) (*model.DiscussionAccessLink, error) {
 	ret := _m.Called(ctx, discussionID)
@@ -315,6 +440,22 @@ func (_m *Datastore) GetAccessLinkBySlug(ctx context.Context, slug string) (*mod
 	return r0, r1
 }
 
+// GetDUAForEveryoneMentionNotifications provides a mock function with given fields: ctx, discussionID, userID
+func (_m *Datastore) GetDUAForEveryoneMentionNotifications(ctx context.Context, discussionID string, userID string) datastore.DiscussionUserAccessIter {
+	ret := _m.Called(ctx, discussionID, userID)
+
+	var r0 datastore.DiscussionUserAccessIter
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) datastore.DiscussionUserAccessIter); ok {
+		r0 = rf(ctx, discussionID, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(datastore.DiscussionUserAccessIter)
+		}
+	}
+
+	return r0
+}
+
 // GetDUAForEverythingNotifications provides a mock function with given fields: ctx, discussionID, userID
 func (_m *Datastore) GetDUAForEverythingNotifications(ctx context.Context, discussionID string, userID string) datastore.DiscussionUserAccessIter {
 	ret := _m.Called(ctx, discussionID, userID)
@@ -409,6 +550,29 @@ func (_m *Datastore) GetDiscussionArchiveByDiscussionID(ctx context.Context, dis
 	return r0, r1
 }
 
+// GetDiscussionBanByDiscussionIDUserID provides a mock function with given fields: ctx, discussionID, userID
+func (_m *Datastore) GetDiscussionBanByDiscussionIDUserID(ctx context.Context, discussionID string, userID string) (*model.DiscussionBan, error) {
+	ret := _m.Called(ctx, discussionID, userID)
+
+	var r0 *model.DiscussionBan
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *model.DiscussionBan); ok {
+		r0 = rf(ctx, discussionID, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.DiscussionBan)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, discussionID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetDiscussionByID provides a mock function with given fields: ctx, id
 func (_m *Datastore) GetDiscussionByID(ctx context.Context, id string) (*model.Discussion, error) {
 	ret := _m.Called(ctx, id)
@@ -478,6 +642,29 @@ func (_m *Datastore) GetDiscussionByModeratorID(ctx context.Context, moderatorID
 	return r0, r1
 }
 
+// GetDiscussionEventsAfterSequence provides a mock function with given fields: ctx, discussionID, afterSequence, limit
+func (_m *Datastore) GetDiscussionEventsAfterSequence(ctx context.Context, discussionID string, afterSequence int, limit int) ([]*model.DiscussionEvent, error) {
+	ret := _m.Called(ctx, discussionID, afterSequence, limit)
+
+	var r0 []*model.DiscussionEvent
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, int) []*model.DiscussionEvent); ok {
+		r0 = rf(ctx, discussionID, afterSequence, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.DiscussionEvent)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, int, int) error); ok {
+		r1 = rf(ctx, discussionID, afterSequence, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetDiscussionRequestAccessByID provides a mock function with given fields: ctx, id
 func (_m *Datastore) GetDiscussionRequestAccessByID(ctx context.Context, id string) (*model.DiscussionAccessRequest, error) {
 	ret := _m.Called(ctx, id)
@@ -609,6 +796,75 @@ func (_m *Datastore) GetLastPostByDiscussionID(ctx context.Context, discussionID
 	return r0, r1
 }
 
+// GetLastPostCreatedAtByDiscussionUserID provides a mock function with given fields: ctx, discussionID, userID
+func (_m *Datastore) GetLastPostCreatedAtByDiscussionUserID(ctx context.Context, discussionID string, userID string) (*time.Time, error) {
+	ret := _m.Called(ctx, discussionID, userID)
+
+	var r0 *time.Time
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *time.Time); ok {
+		r0 = rf(ctx, discussionID, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*time.Time)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, discussionID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetLinkPreviewByURL provides a mock function with given fields: ctx, url
+func (_m *Datastore) GetLinkPreviewByURL(ctx context.Context, url string) (*model.LinkPreview, error) {
+	ret := _m.Called(ctx, url)
+
+	var r0 *model.LinkPreview
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.LinkPreview); ok {
+		r0 = rf(ctx, url)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.LinkPreview)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, url)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetLinkPreviewsByPostID provides a mock function with given fields: ctx, postID
+func (_m *Datastore) GetLinkPreviewsByPostID(ctx context.Context, postID string) ([]*model.LinkPreview, error) {
+	ret := _m.Called(ctx, postID)
+
+	var r0 []*model.LinkPreview
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*model.LinkPreview); ok {
+		r0 = rf(ctx, postID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.LinkPreview)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, postID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetMediaRecordByID provides a mock function with given fields: ctx, mediaID
 func (_m *Datastore) GetMediaRecordByID(ctx context.Context, mediaID string) (*model.Media, error) {
 	ret := _m.Called(ctx, mediaID)
@@ -648,6 +904,29 @@ func (_m *Datastore) GetModeratedDiscussionsByUserID(ctx context.Context, userID
 	return r0
 }
 
+// GetModerationLogConnectionByDiscussionID provides a mock function with given fields: ctx, discussionID, cursor, limit
+func (_m *Datastore) GetModerationLogConnectionByDiscussionID(ctx context.Context, discussionID string, cursor string, limit int) (*model.ModerationLogConnection, error) {
+	ret := _m.Called(ctx, discussionID, cursor, limit)
+
+	var r0 *model.ModerationLogConnection
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int) *model.ModerationLogConnection); ok {
+		r0 = rf(ctx, discussionID, cursor, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ModerationLogConnection)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, int) error); ok {
+		r1 = rf(ctx, discussionID, cursor, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetModeratorByDiscussionID provides a mock function with given fields: ctx, discussionID
 func (_m *Datastore) GetModeratorByDiscussionID(ctx context.Context, discussionID string) (*model.Moderator, error) {
 	ret := _m.Called(ctx, discussionID)
@@ -763,6 +1042,52 @@ func (_m *Datastore) GetModeratorParticipantsByDiscussionID(ctx context.Context,
 	return r0, r1
 }
 
+// GetModeratorRoleByUserIDAndDiscussionID provides a mock function with given fields: ctx, userID, discussionID
+func (_m *Datastore) GetModeratorRoleByUserIDAndDiscussionID(ctx context.Context, userID string, discussionID string) (*model.ModeratorRoleGrant, error) {
+	ret := _m.Called(ctx, userID, discussionID)
+
+	var r0 *model.ModeratorRoleGrant
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *model.ModeratorRoleGrant); ok {
+		r0 = rf(ctx, userID, discussionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ModeratorRoleGrant)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, userID, discussionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetModeratorRolesByDiscussionID provides a mock function with given fields: ctx, discussionID
+func (_m *Datastore) GetModeratorRolesByDiscussionID(ctx context.Context, discussionID string) ([]*model.ModeratorRoleGrant, error) {
+	ret := _m.Called(ctx, discussionID)
+
+	var r0 []*model.ModeratorRoleGrant
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*model.ModeratorRoleGrant); ok {
+		r0 = rf(ctx, discussionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.ModeratorRoleGrant)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, discussionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetNextShuffleTimeForDiscussionID provides a mock function with given fields: ctx, id
 func (_m *Datastore) GetNextShuffleTimeForDiscussionID(ctx context.Context, id string) (*model.DiscussionShuffleTime, error) {
 	ret := _m.Called(ctx, id)
@@ -878,22 +1203,22 @@ func (_m *Datastore) GetParticipantsByIDs(ctx context.Context, ids []string) (ma
 	return r0, r1
 }
 
-// GetPostByID provides a mock function with given fields: ctx, postID
-func (_m *Datastore) GetPostByID(ctx context.Context, postID string) (*model.Post, error) {
-	ret := _m.Called(ctx, postID)
+// GetPendingScheduledPostsByDiscussionID provides a mock function with given fields: ctx, discussionID
+func (_m *Datastore) GetPendingScheduledPostsByDiscussionID(ctx context.Context, discussionID string) ([]*model.ScheduledPost, error) {
+	ret := _m.Called(ctx, discussionID)
 
-	var r0 *model.Post
-	if rf, ok := ret.Get(0).(func(context.Context, string) *model.Post); ok {
-		r0 = rf(ctx, postID)
+	var r0 []*model.ScheduledPost
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*model.ScheduledPost); ok {
+		r0 = rf(ctx, discussionID)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*model.Post)
+			r0 = ret.Get(0).([]*model.ScheduledPost)
 		}
 	}
 
 	var r1 error
 	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, postID)
+		r1 = rf(ctx, discussionID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -901,7 +1226,115 @@ func (_m *Datastore) GetPostByID(ctx context.Context, postID string) (*model.Pos
 	return r0, r1
 }
 
-// GetPostContentByID provides a mock function with given fields: ctx, id
+// GetPinnedPostsByDiscussionIDIter provides a mock function with given fields: ctx, discussionID
+func (_m *Datastore) GetPinnedPostsByDiscussionIDIter(ctx context.Context, discussionID string) datastore.PostIter {
+	ret := _m.Called(ctx, discussionID)
+
+	var r0 datastore.PostIter
+	if rf, ok := ret.Get(0).(func(context.Context, string) datastore.PostIter); ok {
+		r0 = rf(ctx, discussionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(datastore.PostIter)
+		}
+	}
+
+	return r0
+}
+
+// GetPollByPostID provides a mock function with given fields: ctx, postID, participantIDs
+func (_m *Datastore) GetPollByPostID(ctx context.Context, postID string, participantIDs []string) (*model.Poll, error) {
+	ret := _m.Called(ctx, postID, participantIDs)
+
+	var r0 *model.Poll
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string) *model.Poll); ok {
+		r0 = rf(ctx, postID, participantIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Poll)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, []string) error); ok {
+		r1 = rf(ctx, postID, participantIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPollVoterIDsByOptionID provides a mock function with given fields: ctx, optionID
+func (_m *Datastore) GetPollVoterIDsByOptionID(ctx context.Context, optionID string) ([]string, error) {
+	ret := _m.Called(ctx, optionID)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(context.Context, string) []string); ok {
+		r0 = rf(ctx, optionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, optionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPostBookmarksConnectionByUserID provides a mock function with given fields: ctx, userID, cursor, limit
+func (_m *Datastore) GetPostBookmarksConnectionByUserID(ctx context.Context, userID string, cursor string, limit int) (*model.PostBookmarksConnection, error) {
+	ret := _m.Called(ctx, userID, cursor, limit)
+
+	var r0 *model.PostBookmarksConnection
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int) *model.PostBookmarksConnection); ok {
+		r0 = rf(ctx, userID, cursor, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.PostBookmarksConnection)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, int) error); ok {
+		r1 = rf(ctx, userID, cursor, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPostByID provides a mock function with given fields: ctx, postID
+func (_m *Datastore) GetPostByID(ctx context.Context, postID string) (*model.Post, error) {
+	ret := _m.Called(ctx, postID)
+
+	var r0 *model.Post
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.Post); ok {
+		r0 = rf(ctx, postID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Post)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, postID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPostContentByID provides a mock function with given fields: ctx, id
 func (_m *Datastore) GetPostContentByID(ctx context.Context, id string) (*model.PostContent, error) {
 	ret := _m.Called(ctx, id)
 
@@ -924,13 +1357,181 @@ func (_m *Datastore) GetPostContentByID(ctx context.Context, id string) (*model.
 	return r0, r1
 }
 
-// GetPostsByDiscussionIDFromCursorIter provides a mock function with given fields: ctx, discussionID, cursor, limit
-func (_m *Datastore) GetPostsByDiscussionIDFromCursorIter(ctx context.Context, discussionID string, cursor string, limit int) datastore.PostIter {
-	ret := _m.Called(ctx, discussionID, cursor, limit)
+// GetPostReactionSummaries provides a mock function with given fields: ctx, postID, participantID
+func (_m *Datastore) GetPostReactionSummaries(ctx context.Context, postID string, participantID *string) ([]*model.PostReactionSummary, error) {
+	ret := _m.Called(ctx, postID, participantID)
+
+	var r0 []*model.PostReactionSummary
+	if rf, ok := ret.Get(0).(func(context.Context, string, *string) []*model.PostReactionSummary); ok {
+		r0 = rf(ctx, postID, participantID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.PostReactionSummary)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, *string) error); ok {
+		r1 = rf(ctx, postID, participantID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPostRepliesConnection provides a mock function with given fields: ctx, parentPostID, cursor, limit
+func (_m *Datastore) GetPostRepliesConnection(ctx context.Context, parentPostID string, cursor string, limit int) (*model.PostsConnection, error) {
+	ret := _m.Called(ctx, parentPostID, cursor, limit)
+
+	var r0 *model.PostsConnection
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int) *model.PostsConnection); ok {
+		r0 = rf(ctx, parentPostID, cursor, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.PostsConnection)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, int) error); ok {
+		r1 = rf(ctx, parentPostID, cursor, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPostRepliesFromCursorIter provides a mock function with given fields: ctx, parentPostID, cursor, limit
+func (_m *Datastore) GetPostRepliesFromCursorIter(ctx context.Context, parentPostID string, cursor string, limit int) datastore.PostIter {
+	ret := _m.Called(ctx, parentPostID, cursor, limit)
 
 	var r0 datastore.PostIter
 	if rf, ok := ret.Get(0).(func(context.Context, string, string, int) datastore.PostIter); ok {
-		r0 = rf(ctx, discussionID, cursor, limit)
+		r0 = rf(ctx, parentPostID, cursor, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(datastore.PostIter)
+		}
+	}
+
+	return r0
+}
+
+// GetPostReplyCount provides a mock function with given fields: ctx, parentPostID
+func (_m *Datastore) GetPostReplyCount(ctx context.Context, parentPostID string) (int, error) {
+	ret := _m.Called(ctx, parentPostID)
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func(context.Context, string) int); ok {
+		r0 = rf(ctx, parentPostID)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, parentPostID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPostReportByID provides a mock function with given fields: ctx, id
+func (_m *Datastore) GetPostReportByID(ctx context.Context, id string) (*model.PostReport, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *model.PostReport
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.PostReport); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.PostReport)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPostReportByPostIDReporterUserID provides a mock function with given fields: ctx, postID, reporterUserID
+func (_m *Datastore) GetPostReportByPostIDReporterUserID(ctx context.Context, postID string, reporterUserID string) (*model.PostReport, error) {
+	ret := _m.Called(ctx, postID, reporterUserID)
+
+	var r0 *model.PostReport
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *model.PostReport); ok {
+		r0 = rf(ctx, postID, reporterUserID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.PostReport)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, postID, reporterUserID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPostReportsConnectionByDiscussionID provides a mock function with given fields: ctx, discussionID, status, cursor, limit
+func (_m *Datastore) GetPostReportsConnectionByDiscussionID(ctx context.Context, discussionID string, status model.PostReportStatus, cursor string, limit int) (*model.PostReportConnection, error) {
+	ret := _m.Called(ctx, discussionID, status, cursor, limit)
+
+	var r0 *model.PostReportConnection
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.PostReportStatus, string, int) *model.PostReportConnection); ok {
+		r0 = rf(ctx, discussionID, status, cursor, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.PostReportConnection)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, model.PostReportStatus, string, int) error); ok {
+		r1 = rf(ctx, discussionID, status, cursor, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPostsByDiscussionIDBeforeCursorIter provides a mock function with given fields: ctx, discussionID, cursor, limit, topLevelOnly
+func (_m *Datastore) GetPostsByDiscussionIDBeforeCursorIter(ctx context.Context, discussionID string, cursor string, limit int, topLevelOnly bool) datastore.PostIter {
+	ret := _m.Called(ctx, discussionID, cursor, limit, topLevelOnly)
+
+	var r0 datastore.PostIter
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int, bool) datastore.PostIter); ok {
+		r0 = rf(ctx, discussionID, cursor, limit, topLevelOnly)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(datastore.PostIter)
+		}
+	}
+
+	return r0
+}
+
+// GetPostsByDiscussionIDFromCursorIter provides a mock function with given fields: ctx, discussionID, cursor, limit, topLevelOnly
+func (_m *Datastore) GetPostsByDiscussionIDFromCursorIter(ctx context.Context, discussionID string, cursor string, limit int, topLevelOnly bool) datastore.PostIter {
+	ret := _m.Called(ctx, discussionID, cursor, limit, topLevelOnly)
+
+	var r0 datastore.PostIter
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int, bool) datastore.PostIter); ok {
+		r0 = rf(ctx, discussionID, cursor, limit, topLevelOnly)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(datastore.PostIter)
@@ -953,25 +1554,133 @@ func (_m *Datastore) GetPostsByDiscussionIDIter(ctx context.Context, discussionI
 		}
 	}
 
-	return r0
+	return r0
+}
+
+// GetPostsByTagFromCursorIter provides a mock function with given fields: ctx, discussionID, tag, cursor, limit
+func (_m *Datastore) GetPostsByTagFromCursorIter(ctx context.Context, discussionID string, tag string, cursor string, limit int) datastore.PostIter {
+	ret := _m.Called(ctx, discussionID, tag, cursor, limit)
+
+	var r0 datastore.PostIter
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, int) datastore.PostIter); ok {
+		r0 = rf(ctx, discussionID, tag, cursor, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(datastore.PostIter)
+		}
+	}
+
+	return r0
+}
+
+// GetPostsConnectionAroundPost provides a mock function with given fields: ctx, anchorPost, newerLimit, olderLimit, topLevelOnly
+func (_m *Datastore) GetPostsConnectionAroundPost(ctx context.Context, anchorPost model.Post, newerLimit int, olderLimit int, topLevelOnly bool) (*model.PostsConnection, error) {
+	ret := _m.Called(ctx, anchorPost, newerLimit, olderLimit, topLevelOnly)
+
+	var r0 *model.PostsConnection
+	if rf, ok := ret.Get(0).(func(context.Context, model.Post, int, int, bool) *model.PostsConnection); ok {
+		r0 = rf(ctx, anchorPost, newerLimit, olderLimit, topLevelOnly)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.PostsConnection)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, model.Post, int, int, bool) error); ok {
+		r1 = rf(ctx, anchorPost, newerLimit, olderLimit, topLevelOnly)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPostsConnectionByDiscussionID provides a mock function with given fields: ctx, discussionID, cursor, limit, topLevelOnly
+func (_m *Datastore) GetPostsConnectionByDiscussionID(ctx context.Context, discussionID string, cursor string, limit int, topLevelOnly bool) (*model.PostsConnection, error) {
+	ret := _m.Called(ctx, discussionID, cursor, limit, topLevelOnly)
+
+	var r0 *model.PostsConnection
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int, bool) *model.PostsConnection); ok {
+		r0 = rf(ctx, discussionID, cursor, limit, topLevelOnly)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.PostsConnection)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, int, bool) error); ok {
+		r1 = rf(ctx, discussionID, cursor, limit, topLevelOnly)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPostsConnectionByDiscussionIDBeforeCursor provides a mock function with given fields: ctx, discussionID, cursor, limit, topLevelOnly
+func (_m *Datastore) GetPostsConnectionByDiscussionIDBeforeCursor(ctx context.Context, discussionID string, cursor string, limit int, topLevelOnly bool) (*model.PostsConnection, error) {
+	ret := _m.Called(ctx, discussionID, cursor, limit, topLevelOnly)
+
+	var r0 *model.PostsConnection
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int, bool) *model.PostsConnection); ok {
+		r0 = rf(ctx, discussionID, cursor, limit, topLevelOnly)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.PostsConnection)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, int, bool) error); ok {
+		r1 = rf(ctx, discussionID, cursor, limit, topLevelOnly)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPostsConnectionByTag provides a mock function with given fields: ctx, discussionID, tag, cursor, limit
+func (_m *Datastore) GetPostsConnectionByTag(ctx context.Context, discussionID string, tag string, cursor string, limit int) (*model.PostsConnection, error) {
+	ret := _m.Called(ctx, discussionID, tag, cursor, limit)
+
+	var r0 *model.PostsConnection
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, int) *model.PostsConnection); ok {
+		r0 = rf(ctx, discussionID, tag, cursor, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.PostsConnection)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, int) error); ok {
+		r1 = rf(ctx, discussionID, tag, cursor, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// GetPostsConnectionByDiscussionID provides a mock function with given fields: ctx, discussionID, cursor, limit
-func (_m *Datastore) GetPostsConnectionByDiscussionID(ctx context.Context, discussionID string, cursor string, limit int) (*model.PostsConnection, error) {
-	ret := _m.Called(ctx, discussionID, cursor, limit)
+// GetScheduledPostByID provides a mock function with given fields: ctx, id
+func (_m *Datastore) GetScheduledPostByID(ctx context.Context, id string) (*model.ScheduledPost, error) {
+	ret := _m.Called(ctx, id)
 
-	var r0 *model.PostsConnection
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, int) *model.PostsConnection); ok {
-		r0 = rf(ctx, discussionID, cursor, limit)
+	var r0 *model.ScheduledPost
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.ScheduledPost); ok {
+		r0 = rf(ctx, id)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*model.PostsConnection)
+			r0 = ret.Get(0).(*model.ScheduledPost)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, string, string, int) error); ok {
-		r1 = rf(ctx, discussionID, cursor, limit)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1018,6 +1727,29 @@ func (_m *Datastore) GetSocialInfosByUserProfileID(ctx context.Context, userProf
 	return r0, r1
 }
 
+// GetTopTagsByDiscussionID provides a mock function with given fields: ctx, discussionID, limit
+func (_m *Datastore) GetTopTagsByDiscussionID(ctx context.Context, discussionID string, limit int) ([]*model.TagSummary, error) {
+	ret := _m.Called(ctx, discussionID, limit)
+
+	var r0 []*model.TagSummary
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) []*model.TagSummary); ok {
+		r0 = rf(ctx, discussionID, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.TagSummary)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = rf(ctx, discussionID, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetTotalParticipantCountByDiscussionID provides a mock function with given fields: ctx, discussionID
 func (_m *Datastore) GetTotalParticipantCountByDiscussionID(ctx context.Context, discussionID string) int {
 	ret := _m.Called(ctx, discussionID)
@@ -1032,6 +1764,29 @@ func (_m *Datastore) GetTotalParticipantCountByDiscussionID(ctx context.Context,
 	return r0
 }
 
+// GetUnreadCountsByUserID provides a mock function with given fields: ctx, userID
+func (_m *Datastore) GetUnreadCountsByUserID(ctx context.Context, userID string) (map[string]*model.DiscussionUnreadCount, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 map[string]*model.DiscussionUnreadCount
+	if rf, ok := ret.Get(0).(func(context.Context, string) map[string]*model.DiscussionUnreadCount); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]*model.DiscussionUnreadCount)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetUserByID provides a mock function with given fields: ctx, userID
 func (_m *Datastore) GetUserByID(ctx context.Context, userID string) (*model.User, error) {
 	ret := _m.Called(ctx, userID)
@@ -1202,13 +1957,271 @@ func (_m *Datastore) ListDiscussions(ctx context.Context) (*model.DiscussionsCon
 		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*model.DiscussionsConnection)
+			r0 = ret.Get(0).(*model.DiscussionsConnection)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListDiscussionsByUserID provides a mock function with given fields: ctx, userID, state
+func (_m *Datastore) ListDiscussionsByUserID(ctx context.Context, userID string, state model.DiscussionUserAccessState) (*model.DiscussionsConnection, error) {
+	ret := _m.Called(ctx, userID, state)
+
+	var r0 *model.DiscussionsConnection
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.DiscussionUserAccessState) *model.DiscussionsConnection); ok {
+		r0 = rf(ctx, userID, state)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.DiscussionsConnection)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, model.DiscussionUserAccessState) error); ok {
+		r1 = rf(ctx, userID, state)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LockLastPostCreatedAtByDiscussionUserID provides a mock function with given fields: ctx, tx, discussionID, userID
+func (_m *Datastore) LockLastPostCreatedAtByDiscussionUserID(ctx context.Context, tx *sql.Tx, discussionID string, userID string) (*time.Time, error) {
+	ret := _m.Called(ctx, tx, discussionID, userID)
+
+	var r0 *time.Time
+	if rf, ok := ret.Get(0).(func(context.Context, *sql.Tx, string, string) *time.Time); ok {
+		r0 = rf(ctx, tx, discussionID, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*time.Time)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *sql.Tx, string, string) error); ok {
+		r1 = rf(ctx, tx, discussionID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MarkAllViewersRead provides a mock function with given fields: ctx, userID, viewedTime
+func (_m *Datastore) MarkAllViewersRead(ctx context.Context, userID string, viewedTime time.Time) ([]*model.Viewer, error) {
+	ret := _m.Called(ctx, userID, viewedTime)
+
+	var r0 []*model.Viewer
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) []*model.Viewer); ok {
+		r0 = rf(ctx, userID, viewedTime)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Viewer)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, time.Time) error); ok {
+		r1 = rf(ctx, userID, viewedTime)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PostIterCollect provides a mock function with given fields: ctx, iter
+func (_m *Datastore) PostIterCollect(ctx context.Context, iter datastore.PostIter) ([]*model.Post, error) {
+	ret := _m.Called(ctx, iter)
+
+	var r0 []*model.Post
+	if rf, ok := ret.Get(0).(func(context.Context, datastore.PostIter) []*model.Post); ok {
+		r0 = rf(ctx, iter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Post)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, datastore.PostIter) error); ok {
+		r1 = rf(ctx, iter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PutAccessLinkForDiscussion provides a mock function with given fields: ctx, tx, input
+func (_m *Datastore) PutAccessLinkForDiscussion(ctx context.Context, tx *sql.Tx, input model.DiscussionAccessLink) (*model.DiscussionAccessLink, error) {
+	ret := _m.Called(ctx, tx, input)
+
+	var r0 *model.DiscussionAccessLink
+	if rf, ok := ret.Get(0).(func(context.Context, *sql.Tx, model.DiscussionAccessLink) *model.DiscussionAccessLink); ok {
+		r0 = rf(ctx, tx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.DiscussionAccessLink)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *sql.Tx, model.DiscussionAccessLink) error); ok {
+		r1 = rf(ctx, tx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PutActivity provides a mock function with given fields: ctx, tx, post
+func (_m *Datastore) PutActivity(ctx context.Context, tx *sql.Tx, post *model.Post) error {
+	ret := _m.Called(ctx, tx, post)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *sql.Tx, *model.Post) error); ok {
+		r0 = rf(ctx, tx, post)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PutDiscussionAccessRequestRecord provides a mock function with given fields: ctx, tx, request
+func (_m *Datastore) PutDiscussionAccessRequestRecord(ctx context.Context, tx *sql.Tx, request model.DiscussionAccessRequest) (*model.DiscussionAccessRequest, error) {
+	ret := _m.Called(ctx, tx, request)
+
+	var r0 *model.DiscussionAccessRequest
+	if rf, ok := ret.Get(0).(func(context.Context, *sql.Tx, model.DiscussionAccessRequest) *model.DiscussionAccessRequest); ok {
+		r0 = rf(ctx, tx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.DiscussionAccessRequest)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *sql.Tx, model.DiscussionAccessRequest) error); ok {
+		r1 = rf(ctx, tx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PutDiscussionBan provides a mock function with given fields: ctx, ban
+func (_m *Datastore) PutDiscussionBan(ctx context.Context, ban model.DiscussionBan) (*model.DiscussionBan, error) {
+	ret := _m.Called(ctx, ban)
+
+	var r0 *model.DiscussionBan
+	if rf, ok := ret.Get(0).(func(context.Context, model.DiscussionBan) *model.DiscussionBan); ok {
+		r0 = rf(ctx, ban)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.DiscussionBan)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, model.DiscussionBan) error); ok {
+		r1 = rf(ctx, ban)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PutDiscussionEvent provides a mock function with given fields: ctx, event
+func (_m *Datastore) PutDiscussionEvent(ctx context.Context, event model.DiscussionEvent) (*model.DiscussionEvent, error) {
+	ret := _m.Called(ctx, event)
+
+	var r0 *model.DiscussionEvent
+	if rf, ok := ret.Get(0).(func(context.Context, model.DiscussionEvent) *model.DiscussionEvent); ok {
+		r0 = rf(ctx, event)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.DiscussionEvent)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, model.DiscussionEvent) error); ok {
+		r1 = rf(ctx, event)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PutDiscussionOwnershipTransfer provides a mock function with given fields: ctx, tx, transfer
+func (_m *Datastore) PutDiscussionOwnershipTransfer(ctx context.Context, tx *sql.Tx, transfer model.DiscussionOwnershipTransfer) (*model.DiscussionOwnershipTransfer, error) {
+	ret := _m.Called(ctx, tx, transfer)
+
+	var r0 *model.DiscussionOwnershipTransfer
+	if rf, ok := ret.Get(0).(func(context.Context, *sql.Tx, model.DiscussionOwnershipTransfer) *model.DiscussionOwnershipTransfer); ok {
+		r0 = rf(ctx, tx, transfer)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.DiscussionOwnershipTransfer)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *sql.Tx, model.DiscussionOwnershipTransfer) error); ok {
+		r1 = rf(ctx, tx, transfer)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PutMediaRecord provides a mock function with given fields: ctx, tx, media
+func (_m *Datastore) PutMediaRecord(ctx context.Context, tx *sql.Tx, media model.Media) error {
+	ret := _m.Called(ctx, tx, media)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *sql.Tx, model.Media) error); ok {
+		r0 = rf(ctx, tx, media)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PutModerationLogEntry provides a mock function with given fields: ctx, entry
+func (_m *Datastore) PutModerationLogEntry(ctx context.Context, entry model.ModerationLogEntry) (*model.ModerationLogEntry, error) {
+	ret := _m.Called(ctx, entry)
+
+	var r0 *model.ModerationLogEntry
+	if rf, ok := ret.Get(0).(func(context.Context, model.ModerationLogEntry) *model.ModerationLogEntry); ok {
+		r0 = rf(ctx, entry)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ModerationLogEntry)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	if rf, ok := ret.Get(1).(func(context.Context, model.ModerationLogEntry) error); ok {
+		r1 = rf(ctx, entry)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1216,22 +2229,22 @@ func (_m *Datastore) ListDiscussions(ctx context.Context) (*model.DiscussionsCon
 	return r0, r1
 }
 
-// ListDiscussionsByUserID provides a mock function with given fields: ctx, userID, state
-func (_m *Datastore) ListDiscussionsByUserID(ctx context.Context, userID string, state model.DiscussionUserAccessState) (*model.DiscussionsConnection, error) {
-	ret := _m.Called(ctx, userID, state)
+// PutNextShuffleTimeForDiscussionID provides a mock function with given fields: ctx, tx, id, shuffleTime
+func (_m *Datastore) PutNextShuffleTimeForDiscussionID(ctx context.Context, tx *sql.Tx, id string, shuffleTime *time.Time) (*model.DiscussionShuffleTime, error) {
+	ret := _m.Called(ctx, tx, id, shuffleTime)
 
-	var r0 *model.DiscussionsConnection
-	if rf, ok := ret.Get(0).(func(context.Context, string, model.DiscussionUserAccessState) *model.DiscussionsConnection); ok {
-		r0 = rf(ctx, userID, state)
+	var r0 *model.DiscussionShuffleTime
+	if rf, ok := ret.Get(0).(func(context.Context, *sql.Tx, string, *time.Time) *model.DiscussionShuffleTime); ok {
+		r0 = rf(ctx, tx, id, shuffleTime)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*model.DiscussionsConnection)
+			r0 = ret.Get(0).(*model.DiscussionShuffleTime)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, string, model.DiscussionUserAccessState) error); ok {
-		r1 = rf(ctx, userID, state)
+	if rf, ok := ret.Get(1).(func(context.Context, *sql.Tx, string, *time.Time) error); ok {
+		r1 = rf(ctx, tx, id, shuffleTime)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1239,22 +2252,36 @@ func (_m *Datastore) ListDiscussionsByUserID(ctx context.Context, userID string,
 	return r0, r1
 }
 
-// PostIterCollect provides a mock function with given fields: ctx, iter
-func (_m *Datastore) PostIterCollect(ctx context.Context, iter datastore.PostIter) ([]*model.Post, error) {
-	ret := _m.Called(ctx, iter)
+// PutPoll provides a mock function with given fields: ctx, tx, poll
+func (_m *Datastore) PutPoll(ctx context.Context, tx *sql.Tx, poll model.Poll) error {
+	ret := _m.Called(ctx, tx, poll)
 
-	var r0 []*model.Post
-	if rf, ok := ret.Get(0).(func(context.Context, datastore.PostIter) []*model.Post); ok {
-		r0 = rf(ctx, iter)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *sql.Tx, model.Poll) error); ok {
+		r0 = rf(ctx, tx, poll)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PutPost provides a mock function with given fields: ctx, tx, post
+func (_m *Datastore) PutPost(ctx context.Context, tx *sql.Tx, post model.Post) (*model.Post, error) {
+	ret := _m.Called(ctx, tx, post)
+
+	var r0 *model.Post
+	if rf, ok := ret.Get(0).(func(context.Context, *sql.Tx, model.Post) *model.Post); ok {
+		r0 = rf(ctx, tx, post)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*model.Post)
+			r0 = ret.Get(0).(*model.Post)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, datastore.PostIter) error); ok {
-		r1 = rf(ctx, iter)
+	if rf, ok := ret.Get(1).(func(context.Context, *sql.Tx, model.Post) error); ok {
+		r1 = rf(ctx, tx, post)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1262,36 +2289,41 @@ func (_m *Datastore) PostIterCollect(ctx context.Context, iter datastore.PostIte
 	return r0, r1
 }
 
-// PutAccessLinkForDiscussion provides a mock function with given fields: ctx, tx, input
-func (_m *Datastore) PutAccessLinkForDiscussion(ctx context.Context, tx *sql.Tx, input model.DiscussionAccessLink) (*model.DiscussionAccessLink, error) {
-	ret := _m.Called(ctx, tx, input)
+// PutPostBookmark provides a mock function with given fields: ctx, bookmark
+func (_m *Datastore) PutPostBookmark(ctx context.Context, bookmark model.PostBookmark) error {
+	ret := _m.Called(ctx, bookmark)
 
-	var r0 *model.DiscussionAccessLink
-	if rf, ok := ret.Get(0).(func(context.Context, *sql.Tx, model.DiscussionAccessLink) *model.DiscussionAccessLink); ok {
-		r0 = rf(ctx, tx, input)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.PostBookmark) error); ok {
+		r0 = rf(ctx, bookmark)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*model.DiscussionAccessLink)
-		}
+		r0 = ret.Error(0)
 	}
 
-	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *sql.Tx, model.DiscussionAccessLink) error); ok {
-		r1 = rf(ctx, tx, input)
+	return r0
+}
+
+// PutPostContent provides a mock function with given fields: ctx, tx, postContent
+func (_m *Datastore) PutPostContent(ctx context.Context, tx *sql.Tx, postContent model.PostContent) error {
+	ret := _m.Called(ctx, tx, postContent)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *sql.Tx, model.PostContent) error); ok {
+		r0 = rf(ctx, tx, postContent)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// PutActivity provides a mock function with given fields: ctx, tx, post
-func (_m *Datastore) PutActivity(ctx context.Context, tx *sql.Tx, post *model.Post) error {
-	ret := _m.Called(ctx, tx, post)
+// PutPostReaction provides a mock function with given fields: ctx, reaction
+func (_m *Datastore) PutPostReaction(ctx context.Context, reaction model.PostReaction) error {
+	ret := _m.Called(ctx, reaction)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *sql.Tx, *model.Post) error); ok {
-		r0 = rf(ctx, tx, post)
+	if rf, ok := ret.Get(0).(func(context.Context, model.PostReaction) error); ok {
+		r0 = rf(ctx, reaction)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -1299,22 +2331,22 @@ func (_m *Datastore) PutActivity(ctx context.Context, tx *sql.Tx, post *model.Po
 	return r0
 }
 
-// PutDiscussionAccessRequestRecord provides a mock function with given fields: ctx, tx, request
-func (_m *Datastore) PutDiscussionAccessRequestRecord(ctx context.Context, tx *sql.Tx, request model.DiscussionAccessRequest) (*model.DiscussionAccessRequest, error) {
-	ret := _m.Called(ctx, tx, request)
+// PutPostReport provides a mock function with given fields: ctx, report
+func (_m *Datastore) PutPostReport(ctx context.Context, report model.PostReport) (*model.PostReport, error) {
+	ret := _m.Called(ctx, report)
 
-	var r0 *model.DiscussionAccessRequest
-	if rf, ok := ret.Get(0).(func(context.Context, *sql.Tx, model.DiscussionAccessRequest) *model.DiscussionAccessRequest); ok {
-		r0 = rf(ctx, tx, request)
+	var r0 *model.PostReport
+	if rf, ok := ret.Get(0).(func(context.Context, model.PostReport) *model.PostReport); ok {
+		r0 = rf(ctx, report)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*model.DiscussionAccessRequest)
+			r0 = ret.Get(0).(*model.PostReport)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *sql.Tx, model.DiscussionAccessRequest) error); ok {
-		r1 = rf(ctx, tx, request)
+	if rf, ok := ret.Get(1).(func(context.Context, model.PostReport) error); ok {
+		r1 = rf(ctx, report)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1322,13 +2354,36 @@ func (_m *Datastore) PutDiscussionAccessRequestRecord(ctx context.Context, tx *s
 	return r0, r1
 }
 
-// PutMediaRecord provides a mock function with given fields: ctx, tx, media
-func (_m *Datastore) PutMediaRecord(ctx context.Context, tx *sql.Tx, media model.Media) error {
-	ret := _m.Called(ctx, tx, media)
+// PutScheduledPost provides a mock function with given fields: ctx, scheduledPost
+func (_m *Datastore) PutScheduledPost(ctx context.Context, scheduledPost model.ScheduledPost) (*model.ScheduledPost, error) {
+	ret := _m.Called(ctx, scheduledPost)
+
+	var r0 *model.ScheduledPost
+	if rf, ok := ret.Get(0).(func(context.Context, model.ScheduledPost) *model.ScheduledPost); ok {
+		r0 = rf(ctx, scheduledPost)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ScheduledPost)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, model.ScheduledPost) error); ok {
+		r1 = rf(ctx, scheduledPost)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReplacePollVotes provides a mock function with given fields: ctx, tx, postID, participantID, userParticipantIDs, optionIDs
+func (_m *Datastore) ReplacePollVotes(ctx context.Context, tx *sql.Tx, postID string, participantID string, userParticipantIDs []string, optionIDs []string) error {
+	ret := _m.Called(ctx, tx, postID, participantID, userParticipantIDs, optionIDs)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *sql.Tx, model.Media) error); ok {
-		r0 = rf(ctx, tx, media)
+	if rf, ok := ret.Get(0).(func(context.Context, *sql.Tx, string, string, []string, []string) error); ok {
+		r0 = rf(ctx, tx, postID, participantID, userParticipantIDs, optionIDs)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -1336,22 +2391,36 @@ func (_m *Datastore) PutMediaRecord(ctx context.Context, tx *sql.Tx, media model
 	return r0
 }
 
-// PutNextShuffleTimeForDiscussionID provides a mock function with given fields: ctx, tx, id, shuffleTime
-func (_m *Datastore) PutNextShuffleTimeForDiscussionID(ctx context.Context, tx *sql.Tx, id string, shuffleTime *time.Time) (*model.DiscussionShuffleTime, error) {
-	ret := _m.Called(ctx, tx, id, shuffleTime)
+// ReplacePostLinkPreviews provides a mock function with given fields: ctx, tx, postID, urls
+func (_m *Datastore) ReplacePostLinkPreviews(ctx context.Context, tx *sql.Tx, postID string, urls []string) error {
+	ret := _m.Called(ctx, tx, postID, urls)
 
-	var r0 *model.DiscussionShuffleTime
-	if rf, ok := ret.Get(0).(func(context.Context, *sql.Tx, string, *time.Time) *model.DiscussionShuffleTime); ok {
-		r0 = rf(ctx, tx, id, shuffleTime)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *sql.Tx, string, []string) error); ok {
+		r0 = rf(ctx, tx, postID, urls)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ResolvePostReports provides a mock function with given fields: ctx, postID, status, action, resolvedByUserID
+func (_m *Datastore) ResolvePostReports(ctx context.Context, postID string, status model.PostReportStatus, action *model.PostReportAction, resolvedByUserID string) ([]*model.PostReport, error) {
+	ret := _m.Called(ctx, postID, status, action, resolvedByUserID)
+
+	var r0 []*model.PostReport
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.PostReportStatus, *model.PostReportAction, string) []*model.PostReport); ok {
+		r0 = rf(ctx, postID, status, action, resolvedByUserID)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*model.DiscussionShuffleTime)
+			r0 = ret.Get(0).([]*model.PostReport)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *sql.Tx, string, *time.Time) error); ok {
-		r1 = rf(ctx, tx, id, shuffleTime)
+	if rf, ok := ret.Get(1).(func(context.Context, string, model.PostReportStatus, *model.PostReportAction, string) error); ok {
+		r1 = rf(ctx, postID, status, action, resolvedByUserID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1359,22 +2428,22 @@ func (_m *Datastore) PutNextShuffleTimeForDiscussionID(ctx context.Context, tx *
 	return r0, r1
 }
 
-// PutPost provides a mock function with given fields: ctx, tx, post
-func (_m *Datastore) PutPost(ctx context.Context, tx *sql.Tx, post model.Post) (*model.Post, error) {
-	ret := _m.Called(ctx, tx, post)
+// RestoreParticipantPosts provides a mock function with given fields: ctx, discussionID, participantID, deletedReasonCode
+func (_m *Datastore) RestoreParticipantPosts(ctx context.Context, discussionID string, participantID string, deletedReasonCode model.PostDeletedReason) ([]string, error) {
+	ret := _m.Called(ctx, discussionID, participantID, deletedReasonCode)
 
-	var r0 *model.Post
-	if rf, ok := ret.Get(0).(func(context.Context, *sql.Tx, model.Post) *model.Post); ok {
-		r0 = rf(ctx, tx, post)
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, model.PostDeletedReason) []string); ok {
+		r0 = rf(ctx, discussionID, participantID, deletedReasonCode)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*model.Post)
+			r0 = ret.Get(0).([]string)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *sql.Tx, model.Post) error); ok {
-		r1 = rf(ctx, tx, post)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, model.PostDeletedReason) error); ok {
+		r1 = rf(ctx, discussionID, participantID, deletedReasonCode)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1382,13 +2451,13 @@ func (_m *Datastore) PutPost(ctx context.Context, tx *sql.Tx, post model.Post) (
 	return r0, r1
 }
 
-// PutPostContent provides a mock function with given fields: ctx, tx, postContent
-func (_m *Datastore) PutPostContent(ctx context.Context, tx *sql.Tx, postContent model.PostContent) error {
-	ret := _m.Called(ctx, tx, postContent)
+// RollbackTx provides a mock function with given fields: ctx, tx
+func (_m *Datastore) RollbackTx(ctx context.Context, tx *sql.Tx) error {
+	ret := _m.Called(ctx, tx)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *sql.Tx, model.PostContent) error); ok {
-		r0 = rf(ctx, tx, postContent)
+	if rf, ok := ret.Get(0).(func(context.Context, *sql.Tx) error); ok {
+		r0 = rf(ctx, tx)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -1396,18 +2465,50 @@ func (_m *Datastore) PutPostContent(ctx context.Context, tx *sql.Tx, postContent
 	return r0
 }
 
-// RollbackTx provides a mock function with given fields: ctx, tx
-func (_m *Datastore) RollbackTx(ctx context.Context, tx *sql.Tx) error {
-	ret := _m.Called(ctx, tx)
+// SearchPostsByDiscussionID provides a mock function with given fields: ctx, discussionID, query, cursor, limit
+func (_m *Datastore) SearchPostsByDiscussionID(ctx context.Context, discussionID string, query string, cursor string, limit int) (*model.PostsConnection, error) {
+	ret := _m.Called(ctx, discussionID, query, cursor, limit)
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *sql.Tx) error); ok {
-		r0 = rf(ctx, tx)
+	var r0 *model.PostsConnection
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, int) *model.PostsConnection); ok {
+		r0 = rf(ctx, discussionID, query, cursor, limit)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.PostsConnection)
+		}
 	}
 
-	return r0
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, int) error); ok {
+		r1 = rf(ctx, discussionID, query, cursor, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SearchPostsByUserAccess provides a mock function with given fields: ctx, userID, query, cursor, limit
+func (_m *Datastore) SearchPostsByUserAccess(ctx context.Context, userID string, query string, cursor string, limit int) (*model.PostsConnection, error) {
+	ret := _m.Called(ctx, userID, query, cursor, limit)
+
+	var r0 *model.PostsConnection
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, int) *model.PostsConnection); ok {
+		r0 = rf(ctx, userID, query, cursor, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.PostsConnection)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, int) error); ok {
+		r1 = rf(ctx, userID, query, cursor, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
 // SetParticipantsMutedUntil provides a mock function with given fields: ctx, participants, mutedUntil
@@ -1456,6 +2557,20 @@ func (_m *Datastore) SetViewerLastPostViewed(ctx context.Context, viewerID strin
 	return r0, r1
 }
 
+// TransferModeratorRoles provides a mock function with given fields: ctx, tx, discussionID, fromUserID, toUserID, grantedByUserID
+func (_m *Datastore) TransferModeratorRoles(ctx context.Context, tx *sql.Tx, discussionID string, fromUserID string, toUserID string, grantedByUserID string) error {
+	ret := _m.Called(ctx, tx, discussionID, fromUserID, toUserID, grantedByUserID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *sql.Tx, string, string, string, string) error); ok {
+		r0 = rf(ctx, tx, discussionID, fromUserID, toUserID, grantedByUserID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpdateDiscussionAccessRequestRecord provides a mock function with given fields: ctx, tx, request
 func (_m *Datastore) UpdateDiscussionAccessRequestRecord(ctx context.Context, tx *sql.Tx, request model.DiscussionAccessRequest) (*model.DiscussionAccessRequest, error) {
 	ret := _m.Called(ctx, tx, request)
@@ -1479,6 +2594,66 @@ func (_m *Datastore) UpdateDiscussionAccessRequestRecord(ctx context.Context, tx
 	return r0, r1
 }
 
+// UpdateModeratorUserProfileID provides a mock function with given fields: ctx, tx, moderatorID, userProfileID
+func (_m *Datastore) UpdateModeratorUserProfileID(ctx context.Context, tx *sql.Tx, moderatorID string, userProfileID string) (*model.Moderator, error) {
+	ret := _m.Called(ctx, tx, moderatorID, userProfileID)
+
+	var r0 *model.Moderator
+	if rf, ok := ret.Get(0).(func(context.Context, *sql.Tx, string, string) *model.Moderator); ok {
+		r0 = rf(ctx, tx, moderatorID, userProfileID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Moderator)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *sql.Tx, string, string) error); ok {
+		r1 = rf(ctx, tx, moderatorID, userProfileID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdatePostPinnedAt provides a mock function with given fields: ctx, postID, pinnedAt
+func (_m *Datastore) UpdatePostPinnedAt(ctx context.Context, postID string, pinnedAt *time.Time) error {
+	ret := _m.Called(ctx, postID, pinnedAt)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *time.Time) error); ok {
+		r0 = rf(ctx, postID, pinnedAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateScheduledPostStatus provides a mock function with given fields: ctx, id, fromStatus, status, postID, failureReason
+func (_m *Datastore) UpdateScheduledPostStatus(ctx context.Context, id string, fromStatus model.ScheduledPostStatus, status model.ScheduledPostStatus, postID *string, failureReason *string) (*model.ScheduledPost, error) {
+	ret := _m.Called(ctx, id, fromStatus, status, postID, failureReason)
+
+	var r0 *model.ScheduledPost
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.ScheduledPostStatus, model.ScheduledPostStatus, *string, *string) *model.ScheduledPost); ok {
+		r0 = rf(ctx, id, fromStatus, status, postID, failureReason)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ScheduledPost)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, model.ScheduledPostStatus, model.ScheduledPostStatus, *string, *string) error); ok {
+		r1 = rf(ctx, id, fromStatus, status, postID, failureReason)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpsertDiscussion provides a mock function with given fields: ctx, discussion
 func (_m *Datastore) UpsertDiscussion(ctx context.Context, discussion model.Discussion) (*model.Discussion, error) {
 	ret := _m.Called(ctx, discussion)
@@ -1548,6 +2723,43 @@ func (_m *Datastore) UpsertDiscussionUserAccess(ctx context.Context, tx *sql.Tx,
 	return r0, r1
 }
 
+// UpsertLinkPreview provides a mock function with given fields: ctx, tx, preview
+func (_m *Datastore) UpsertLinkPreview(ctx context.Context, tx *sql.Tx, preview model.LinkPreview) error {
+	ret := _m.Called(ctx, tx, preview)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *sql.Tx, model.LinkPreview) error); ok {
+		r0 = rf(ctx, tx, preview)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpsertModeratorRole provides a mock function with given fields: ctx, grant
+func (_m *Datastore) UpsertModeratorRole(ctx context.Context, grant model.ModeratorRoleGrant) (*model.ModeratorRoleGrant, error) {
+	ret := _m.Called(ctx, grant)
+
+	var r0 *model.ModeratorRoleGrant
+	if rf, ok := ret.Get(0).(func(context.Context, model.ModeratorRoleGrant) *model.ModeratorRoleGrant); ok {
+		r0 = rf(ctx, grant)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ModeratorRoleGrant)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, model.ModeratorRoleGrant) error); ok {
+		r1 = rf(ctx, grant)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpsertParticipant provides a mock function with given fields: ctx, participant
 func (_m *Datastore) UpsertParticipant(ctx context.Context, participant model.Participant) (*model.Participant, error) {
 	ret := _m.Called(ctx, participant)
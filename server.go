@@ -133,6 +133,7 @@ func main() {
 	// Kickoff cron job
 	c := cron.New()
 	c.AddFunc("@every 1m", delphisBackend.ShuffleDiscussionsIfNecessary)
+	c.AddFunc("@every 1m", delphisBackend.PublishScheduledPostsIfNecessary)
 	c.Start()
 
 	http.Handle("/.well-known/apple-app-site-association", appleSiteAssociationHandler(conf))